@@ -0,0 +1,18 @@
+data "snowflake_dynamic_table_refresh_history" "product" {
+  database = "mydb"
+  schema   = "myschema"
+  name     = "product"
+}
+
+# only failed refreshes
+data "snowflake_dynamic_table_refresh_history" "product_errors" {
+  database     = "mydb"
+  schema       = "myschema"
+  name         = "product"
+  result_limit = 10
+  error_only   = true
+}
+
+output "product_freshness" {
+  value = data.snowflake_dynamic_table_refresh_history.product.latest_data_timestamp
+}
@@ -0,0 +1,25 @@
+resource "snowflake_database" "d" {
+  name = "TEST_DB"
+}
+
+// read all parameters of database TEST_DB together with the level they are set at
+data "snowflake_effective_parameters" "p" {
+  object_type = "DATABASE"
+  object_name = snowflake_database.d.name
+}
+
+// read all parameters of a table
+data "snowflake_effective_parameters" "p2" {
+  object_type = "TABLE"
+  object_name = "TEST_DB.TEST_SCHEMA.TEST_TABLE"
+}
+
+// read all account parameters
+data "snowflake_effective_parameters" "p3" {
+  object_type = "ACCOUNT"
+}
+
+// list only parameters set directly on the database
+output "database_level_parameters" {
+  value = { for p in data.snowflake_effective_parameters.p.parameters : p.key => p.value if p.is_set_on_object }
+}
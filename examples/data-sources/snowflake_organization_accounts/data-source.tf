@@ -0,0 +1,8 @@
+data "snowflake_organization_accounts" "dropped" {
+  pattern = "SNOWFLAKE_TEST_%"
+  history = true
+}
+
+output "dropped_accounts" {
+  value = [for account in data.snowflake_organization_accounts.dropped.accounts : account.account_name if account.dropped_on != ""]
+}
@@ -0,0 +1,9 @@
+data "snowflake_pipe_status" "status" {
+  database = "db"
+  schema   = "schema"
+  name     = "pipe"
+}
+
+output "pipe_backlog" {
+  value = data.snowflake_pipe_status.status.pending_file_count
+}
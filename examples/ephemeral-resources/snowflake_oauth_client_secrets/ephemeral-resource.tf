@@ -0,0 +1,3 @@
+ephemeral "snowflake_oauth_client_secrets" "secrets" {
+  integration_name = "CUSTOM_OAUTH_INTEGRATION"
+}
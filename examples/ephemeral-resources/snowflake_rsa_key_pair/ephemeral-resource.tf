@@ -0,0 +1,9 @@
+ephemeral "snowflake_rsa_key_pair" "key" {
+  key_size = 4096
+}
+
+resource "snowflake_user" "user" {
+  name                      = "service_user"
+  rsa_public_key_wo         = ephemeral.snowflake_rsa_key_pair.key.public_key
+  rsa_public_key_wo_version = 1
+}
@@ -0,0 +1,3 @@
+ephemeral "snowflake_scim_access_token" "scim" {
+  integration_name = "AAD_PROVISIONING"
+}
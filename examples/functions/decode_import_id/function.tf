@@ -0,0 +1,9 @@
+# ["MY_DB", "MY_SCHEMA", "MY_TABLE"]
+output "table_id_parts" {
+  value = provider::snowflake::decode_import_id("snowflake_id", snowflake_table.table.id)
+}
+
+# ["\"MY_ROLE\"", "false", "false", "USAGE", "OnAccountObject", "DATABASE", "\"MY_DB\""]
+output "grant_id_parts" {
+  value = provider::snowflake::decode_import_id("grant_privileges_to_account_role", snowflake_grant_privileges_to_account_role.grant.id)
+}
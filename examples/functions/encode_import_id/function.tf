@@ -0,0 +1,11 @@
+import {
+  to = snowflake_table.table
+  # MY_DB|MY_SCHEMA|MY_TABLE
+  id = provider::snowflake::encode_import_id("snowflake_id", ["MY_DB", "MY_SCHEMA", "MY_TABLE"])
+}
+
+import {
+  to = snowflake_grant_privileges_to_account_role.grant
+  # "MY_ROLE"|false|false|USAGE|OnAccountObject|DATABASE|"MY_DB"
+  id = provider::snowflake::encode_import_id("grant_privileges_to_account_role", ["\"MY_ROLE\"", "false", "false", "USAGE", "OnAccountObject", "DATABASE", "\"MY_DB\""])
+}
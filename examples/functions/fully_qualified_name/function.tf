@@ -0,0 +1,14 @@
+# "MY_DB"."MY_SCHEMA"."MY_TABLE"
+output "table_fully_qualified_name" {
+  value = provider::snowflake::fully_qualified_name("MY_DB", "MY_SCHEMA", "MY_TABLE")
+}
+
+# using attributes of other resources
+resource "snowflake_grant_privileges_to_account_role" "grant" {
+  account_role_name = "MY_ROLE"
+  privileges        = ["SELECT"]
+  on_schema_object {
+    object_type = "TABLE"
+    object_name = provider::snowflake::fully_qualified_name(snowflake_table.table.database, snowflake_table.table.schema, snowflake_table.table.name)
+  }
+}
@@ -0,0 +1,9 @@
+# "MY_DB"."MY_SCHEMA"."MY_FUNCTION"(NUMBER, VARCHAR)
+output "function_id" {
+  value = provider::snowflake::function_signature("MY_DB", "MY_SCHEMA", "MY_FUNCTION", ["INT", "STRING"])
+}
+
+import {
+  to = snowflake_function.function
+  id = provider::snowflake::function_signature("MY_DB", "MY_SCHEMA", "MY_FUNCTION", ["NUMBER", "VARCHAR"])
+}
@@ -0,0 +1,12 @@
+locals {
+  # { database = "MY_DB", schema = "MY_SCHEMA", table = null, name = "MY.TABLE", arguments = null, fully_qualified_name = "\"MY_DB\".\"MY_SCHEMA\".\"MY.TABLE\"" }
+  table = provider::snowflake::parse_identifier("MY_DB.MY_SCHEMA.\"MY.TABLE\"")
+
+  # arguments = ["NUMBER", "VARCHAR"]
+  function = provider::snowflake::parse_identifier("\"MY_DB\".\"MY_SCHEMA\".\"MY_FUNCTION\"(INT, STRING)")
+}
+
+data "snowflake_tables" "tables" {
+  database = local.table.database
+  schema   = local.table.schema
+}
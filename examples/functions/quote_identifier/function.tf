@@ -0,0 +1,4 @@
+# "my ""quoted"" role"
+output "quoted_role_name" {
+  value = provider::snowflake::quote_identifier("my \"quoted\" role")
+}
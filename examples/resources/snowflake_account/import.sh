@@ -0,0 +1,2 @@
+# format is the account locator
+terraform import snowflake_account.ac1 accountLocator
@@ -15,4 +15,12 @@ resource "snowflake_account" "ac1" {
   edition              = "STANDARD"
   comment              = "Snowflake Test Account"
   region               = "AWS_US_WEST_2"
+  grace_period_in_days = 3
+
+  # renaming the account is applied in place; the old URL is kept unless save_old_url is false
+  save_old_url     = true
+  resource_monitor = "ACCOUNT_MONITOR"
+  parameters = {
+    STATEMENT_TIMEOUT_IN_SECONDS = "3600"
+  }
 }
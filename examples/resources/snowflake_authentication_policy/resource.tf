@@ -0,0 +1,19 @@
+## Minimal
+resource "snowflake_authentication_policy" "basic" {
+  database = "prod"
+  schema   = "security"
+  name     = "basic_policy"
+}
+
+## Complete (with every optional set)
+resource "snowflake_authentication_policy" "complete" {
+  database                   = "prod"
+  schema                     = "security"
+  name                       = "complete_policy"
+  authentication_methods     = ["PASSWORD", "SAML"]
+  mfa_authentication_methods = ["PASSWORD"]
+  mfa_enrollment             = "REQUIRED"
+  client_types               = ["SNOWFLAKE_UI", "DRIVERS"]
+  security_integrations      = ["OKTA_INTEGRATION"]
+  comment                    = "Requires MFA for all human users"
+}
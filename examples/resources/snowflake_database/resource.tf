@@ -28,3 +28,14 @@ resource "snowflake_database" "from_share" {
     share    = "share1"
   }
 }
+
+resource "snowflake_database" "clone" {
+  name    = "testing_5"
+  comment = "development copy of the production database"
+  clone {
+    source = "production"
+    at {
+      timestamp = "2024-01-01T00:00:00Z"
+    }
+  }
+}
@@ -10,3 +10,21 @@ resource "snowflake_dynamic_table" "dt" {
   query     = "SELECT product_id, product_name FROM \"mydb\".\"myschema\".\"staging_table\""
   comment   = "example comment"
 }
+
+resource "snowflake_dynamic_table" "complete" {
+  name     = "product_summary"
+  database = "mydb"
+  schema   = "myschema"
+  target_lag {
+    downstream = true
+  }
+  warehouse                       = "mywh"
+  query                           = "SELECT product_id, count(*) AS cnt FROM \"mydb\".\"myschema\".\"staging_table\" GROUP BY product_id"
+  refresh_mode                    = "INCREMENTAL"
+  initialize                      = "ON_SCHEDULE"
+  cluster_by                      = ["product_id"]
+  data_retention_time_in_days     = 7
+  max_data_extension_time_in_days = 14
+  suspended                       = false
+  refresh_on_apply                = true
+}
@@ -0,0 +1,7 @@
+resource "snowflake_file_format_avro" "example" {
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  name     = "EXAMPLE_AVRO_FORMAT"
+
+  compression = "DEFLATE"
+}
@@ -0,0 +1,12 @@
+resource "snowflake_file_format_csv" "example" {
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  name     = "EXAMPLE_CSV_FORMAT"
+
+  field_delimiter              = "|"
+  skip_header                  = 1
+  field_optionally_enclosed_by = "\""
+  null_if                      = ["NULL", ""]
+  trim_space                   = "true"
+  comment                      = "CSV files exported from the ERP"
+}
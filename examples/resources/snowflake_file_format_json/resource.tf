@@ -0,0 +1,8 @@
+resource "snowflake_file_format_json" "example" {
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  name     = "EXAMPLE_JSON_FORMAT"
+
+  compression       = "GZIP"
+  strip_outer_array = "true"
+}
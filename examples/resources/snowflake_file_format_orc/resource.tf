@@ -0,0 +1,7 @@
+resource "snowflake_file_format_orc" "example" {
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  name     = "EXAMPLE_ORC_FORMAT"
+
+  trim_space = "true"
+}
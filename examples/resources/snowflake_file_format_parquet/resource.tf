@@ -0,0 +1,8 @@
+resource "snowflake_file_format_parquet" "example" {
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  name     = "EXAMPLE_PARQUET_FORMAT"
+
+  compression    = "SNAPPY"
+  binary_as_text = "false"
+}
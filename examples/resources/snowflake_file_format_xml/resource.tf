@@ -0,0 +1,8 @@
+resource "snowflake_file_format_xml" "example" {
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  name     = "EXAMPLE_XML_FORMAT"
+
+  strip_outer_element = "true"
+  preserve_space      = "true"
+}
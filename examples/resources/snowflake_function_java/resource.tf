@@ -0,0 +1,34 @@
+resource "snowflake_function_java" "example" {
+  database = "db"
+  schema   = "schema"
+  name     = "echo"
+  arguments {
+    arg_name      = "x"
+    arg_data_type = "VARCHAR"
+  }
+  return_type         = "VARCHAR"
+  runtime_version     = "17"
+  handler             = "Echo.run"
+  target_path         = "@stage/echo.jar"
+  function_definition = <<EOT
+class Echo {
+  public static String run(String x) {
+    return x;
+  }
+}
+EOT
+}
+
+# handler shipped in a staged jar
+resource "snowflake_function_java" "staged" {
+  database = "db"
+  schema   = "schema"
+  name     = "echo_staged"
+  arguments {
+    arg_name      = "x"
+    arg_data_type = "VARCHAR"
+  }
+  return_type = "VARCHAR"
+  handler     = "Echo.run"
+  imports     = ["@stage/echo.jar"]
+}
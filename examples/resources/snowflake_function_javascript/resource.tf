@@ -0,0 +1,12 @@
+resource "snowflake_function_javascript" "example" {
+  database = "db"
+  schema   = "schema"
+  name     = "add_one"
+  arguments {
+    arg_name      = "X"
+    arg_data_type = "FLOAT"
+  }
+  return_type         = "FLOAT"
+  null_input_behavior = "RETURNS NULL ON NULL INPUT"
+  function_definition = "return X + 1;"
+}
@@ -0,0 +1,2 @@
+# format is "<database_name>"."<schema_name>"."<function_name>"(<argument_data_types, separated with ', '>)
+terraform import snowflake_function_python.example '"db"."schema"."function_name"(VARCHAR, NUMBER)'
@@ -0,0 +1,26 @@
+resource "snowflake_function_python" "example" {
+  database = "db"
+  schema   = "schema"
+  name     = "fetch"
+  arguments {
+    arg_name      = "url"
+    arg_data_type = "VARCHAR"
+  }
+  return_type                  = "VARCHAR"
+  runtime_version              = "3.11"
+  handler                      = "fetch"
+  packages                     = ["requests"]
+  external_access_integrations = ["API_INTEGRATION"]
+  secrets {
+    secret_variable_name = "token"
+    secret_id            = "\"db\".\"schema\".\"api_token\""
+  }
+  function_definition = <<EOT
+import _snowflake
+import requests
+
+def fetch(url):
+    token = _snowflake.get_generic_secret_string('token')
+    return requests.get(url, headers={"Authorization": token}).text
+EOT
+}
@@ -0,0 +1,17 @@
+resource "snowflake_function_scala" "example" {
+  database = "db"
+  schema   = "schema"
+  name     = "echo"
+  arguments {
+    arg_name      = "x"
+    arg_data_type = "VARCHAR"
+  }
+  return_type         = "VARCHAR"
+  runtime_version     = "2.12"
+  handler             = "Echo.run"
+  function_definition = <<EOT
+object Echo {
+  def run(x: String): String = x
+}
+EOT
+}
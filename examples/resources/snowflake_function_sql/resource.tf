@@ -0,0 +1,20 @@
+resource "snowflake_function_sql" "example" {
+  database = "db"
+  schema   = "schema"
+  name     = "add_one"
+  arguments {
+    arg_name      = "x"
+    arg_data_type = "NUMBER"
+  }
+  return_type         = "NUMBER"
+  function_definition = "x + 1"
+  comment             = "Adds one to the argument."
+}
+
+resource "snowflake_function_sql" "table" {
+  database            = "db"
+  schema              = "schema"
+  name                = "numbers"
+  return_type         = "TABLE (n NUMBER)"
+  function_definition = "SELECT 1 UNION ALL SELECT 2"
+}
@@ -0,0 +1 @@
+terraform import snowflake_legacy_service_user.example userName
@@ -0,0 +1,8 @@
+resource "snowflake_legacy_service_user" "bi" {
+  name                = "BI_TOOL"
+  comment             = "Used by the BI tool which does not support key-pair authentication yet."
+  default_warehouse   = "BI_WH"
+  default_role        = "BI_ROLE"
+  password_wo         = var.bi_password
+  password_wo_version = 1
+}
@@ -0,0 +1,3 @@
+# format is object_type|object_name
+terraform import snowflake_parameter_set.example 'DATABASE|"TEST_DB"'
+terraform import snowflake_parameter_set.example 'ACCOUNT|'
@@ -0,0 +1,31 @@
+resource "snowflake_database" "d" {
+  name = "TEST_DB"
+}
+
+// manage only the listed parameters of the database
+resource "snowflake_parameter_set" "database" {
+  object_type = "DATABASE"
+  object_name = snowflake_database.d.name
+  parameters = {
+    DATA_RETENTION_TIME_IN_DAYS     = "7"
+    SUSPEND_TASK_AFTER_NUM_FAILURES = "3"
+  }
+}
+
+// manage all parameters set on the table; parameters set outside of Terraform are reset
+resource "snowflake_parameter_set" "table" {
+  object_type     = "TABLE"
+  object_name     = "TEST_DB.TEST_SCHEMA.TEST_TABLE"
+  reset_unmanaged = true
+  parameters = {
+    DATA_RETENTION_TIME_IN_DAYS = "1"
+  }
+}
+
+// manage parameters set on the account
+resource "snowflake_parameter_set" "account" {
+  object_type = "ACCOUNT"
+  parameters = {
+    TIMEZONE = "Europe/Warsaw"
+  }
+}
@@ -11,3 +11,17 @@ resource "snowflake_pipe" "pipe" {
   aws_sns_topic_arn    = "..."
   notification_channel = "..."
 }
+
+# paused pipe backfilling the files of one day
+resource "snowflake_pipe" "backfill" {
+  database       = "db"
+  schema         = "schema"
+  name           = "backfill"
+  copy_statement = "copy into mytable from @mystage"
+  auto_ingest    = true
+
+  paused                 = true
+  refresh_trigger        = "2024-01-02"
+  refresh_prefix         = "/2024/01/02"
+  refresh_modified_after = "2024-01-02T00:00:00Z"
+}
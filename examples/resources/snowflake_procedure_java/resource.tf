@@ -0,0 +1,18 @@
+resource "snowflake_procedure_java" "example" {
+  database             = "db"
+  schema               = "schema"
+  name                 = "hello"
+  return_type          = "VARCHAR"
+  runtime_version      = "11"
+  handler              = "Hello.run"
+  packages             = ["com.snowflake:snowpark:1.14.0"]
+  procedure_definition = <<EOT
+import com.snowflake.snowpark_java.Session;
+
+class Hello {
+  public static String run(Session session) {
+    return "hello";
+  }
+}
+EOT
+}
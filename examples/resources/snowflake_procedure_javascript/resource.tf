@@ -0,0 +1,11 @@
+resource "snowflake_procedure_javascript" "example" {
+  database             = "db"
+  schema               = "schema"
+  name                 = "row_count"
+  return_type          = "FLOAT"
+  procedure_definition = <<EOT
+var result = snowflake.execute({sqlText: "SELECT COUNT(*) FROM my_table"});
+result.next();
+return result.getColumnValue(1);
+EOT
+}
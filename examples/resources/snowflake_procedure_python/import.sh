@@ -0,0 +1,2 @@
+# format is "<database_name>"."<schema_name>"."<procedure_name>"(<argument_data_types, separated with ', '>)
+terraform import snowflake_procedure_python.example '"db"."schema"."procedure_name"(VARCHAR, NUMBER)'
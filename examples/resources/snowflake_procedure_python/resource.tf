@@ -0,0 +1,18 @@
+resource "snowflake_procedure_python" "example" {
+  database = "db"
+  schema   = "schema"
+  name     = "load"
+  arguments {
+    arg_name      = "table_name"
+    arg_data_type = "VARCHAR"
+  }
+  return_type          = "TABLE (id NUMBER, name VARCHAR)"
+  runtime_version      = "3.11"
+  handler              = "run"
+  packages             = ["snowflake-snowpark-python==1.14.0"]
+  artifact_repository  = "snowflake.snowpark.pypi_shared_repository"
+  procedure_definition = <<EOT
+def run(session, table_name):
+    return session.table(table_name).select("id", "name")
+EOT
+}
@@ -0,0 +1,16 @@
+resource "snowflake_procedure_scala" "example" {
+  database             = "db"
+  schema               = "schema"
+  name                 = "hello"
+  return_type          = "VARCHAR"
+  runtime_version      = "2.12"
+  handler              = "Hello.run"
+  packages             = ["com.snowflake:snowpark:1.14.0"]
+  procedure_definition = <<EOT
+import com.snowflake.snowpark.Session
+
+object Hello {
+  def run(session: Session): String = "hello"
+}
+EOT
+}
@@ -0,0 +1,16 @@
+resource "snowflake_procedure_sql" "example" {
+  database = "db"
+  schema   = "schema"
+  name     = "echo"
+  arguments {
+    arg_name      = "x"
+    arg_data_type = "VARCHAR"
+  }
+  return_type          = "VARCHAR"
+  execute_as           = "CALLER"
+  procedure_definition = <<EOT
+BEGIN
+  RETURN x;
+END;
+EOT
+}
@@ -7,3 +7,14 @@ resource "snowflake_schema" "schema" {
   is_managed          = false
   data_retention_days = 1
 }
+
+resource "snowflake_schema" "clone" {
+  database = "database"
+  name     = "schema_clone"
+  clone {
+    source = "production_database.schema"
+    before {
+      statement = "01b2c3d4-0000-0000-0000-000000000000"
+    }
+  }
+}
@@ -0,0 +1,13 @@
+resource "snowflake_service_user" "etl" {
+  name              = "ETL_SERVICE"
+  comment           = "Used by the nightly ETL."
+  default_warehouse = "ETL_WH"
+  default_role      = "ETL_ROLE"
+  rsa_public_key    = "..."
+  days_to_expiry    = 90
+
+  parameters = {
+    STATEMENT_TIMEOUT_IN_SECONDS = "3600"
+    QUERY_TAG                    = "etl"
+  }
+}
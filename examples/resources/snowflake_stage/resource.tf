@@ -1,10 +1,47 @@
-
 resource "snowflake_stage" "example_stage" {
-  name        = "EXAMPLE_STAGE"
-  url         = "s3://com.example.bucket/prefix"
-  database    = "EXAMPLE_DB"
-  schema      = "EXAMPLE_SCHEMA"
-  credentials = "AWS_KEY_ID='${var.example_aws_key_id}' AWS_SECRET_KEY='${var.example_aws_secret_key}'"
+  name     = "EXAMPLE_STAGE"
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+
+  s3 {
+    url = "s3://com.example.bucket/prefix"
+    credentials {
+      aws_key_id     = var.example_aws_key_id
+      aws_secret_key = var.example_aws_secret_key
+    }
+    encryption {
+      type = "AWS_SSE_S3"
+    }
+  }
+
+  file_format {
+    type            = "CSV"
+    field_delimiter = "|"
+    skip_header     = 1
+    null_if         = ["NULL", ""]
+    compression     = "GZIP"
+  }
+
+  copy_options {
+    on_error = "SKIP_FILE_10%"
+    purge    = "true"
+  }
+
+  directory {
+    enable          = true
+    refresh_trigger = "1"
+  }
+}
+
+resource "snowflake_stage" "example_internal_stage" {
+  name                = "EXAMPLE_INTERNAL_STAGE"
+  database            = "EXAMPLE_DB"
+  schema              = "EXAMPLE_SCHEMA"
+  internal_encryption = "SNOWFLAKE_SSE"
+
+  file_format {
+    format_name = "\"EXAMPLE_DB\".\"EXAMPLE_SCHEMA\".\"EXAMPLE_FILE_FORMAT\""
+  }
 }
 
 resource "snowflake_stage_grant" "grant_example_stage" {
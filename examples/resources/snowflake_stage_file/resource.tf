@@ -0,0 +1,19 @@
+# a single file, uploaded compressed as imports/python/handler.py.gz
+resource "snowflake_stage_file" "handler" {
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  stage    = "EXAMPLE_STAGE"
+  path     = "imports/python"
+  source   = "${path.module}/src/handler.py"
+}
+
+# all the files of a directory, keeping their relative paths
+resource "snowflake_stage_file" "app" {
+  database      = "EXAMPLE_DB"
+  schema        = "EXAMPLE_SCHEMA"
+  stage         = "EXAMPLE_STAGE"
+  path          = "app/v1"
+  source        = "${path.module}/app"
+  auto_compress = false
+  overwrite     = true
+}
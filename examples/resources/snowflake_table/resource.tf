@@ -44,6 +44,13 @@ resource "snowflake_table" "table" {
     name     = "data"
     type     = "text"
     nullable = false
+
+    tag {
+      name     = "classification"
+      value    = "confidential"
+      database = "governance"
+      schema   = "tags"
+    }
   }
 
   column {
@@ -61,4 +68,37 @@ resource "snowflake_table" "table" {
     name = "my_key"
     keys = ["data"]
   }
+
+  row_access_policy {
+    policy_name = "governance.policies.by_region"
+    on          = ["data"]
+  }
+
+  search_optimization {
+    method = "EQUALITY"
+    column = "id"
+  }
+
+  search_optimization {
+    method = "SUBSTRING"
+    column = "data"
+  }
+}
+
+resource "snowflake_table" "clone" {
+  database = snowflake_schema.schema.database
+  schema   = snowflake_schema.schema.name
+  name     = "table_clone"
+
+  column {
+    name = "id"
+    type = "NUMBER(38,0)"
+  }
+
+  clone {
+    source = "production_database.schema.table"
+    at {
+      offset = -3600
+    }
+  }
 }
@@ -0,0 +1,2 @@
+# format is "user name"|"database name"."schema name"."policy name"
+terraform import snowflake_user_authentication_policy_attachment.example '"USER_NAME"|"MY_DATABASE"."MY_SCHEMA"."AUTHENTICATION_POLICY_NAME"'
@@ -0,0 +1,16 @@
+resource "snowflake_user" "user" {
+  name = "USER_NAME"
+}
+
+resource "snowflake_authentication_policy" "ap" {
+  database       = "prod"
+  schema         = "security"
+  name           = "mfa_policy"
+  mfa_enrollment = "REQUIRED"
+  client_types   = ["SNOWFLAKE_UI", "DRIVERS"]
+}
+
+resource "snowflake_user_authentication_policy_attachment" "apa" {
+  authentication_policy_name = snowflake_authentication_policy.ap.qualified_name
+  user_name                  = snowflake_user.user.name
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/youmark/pkcs8"
+)
+
+func TestEphemeralResources_Definitions(t *testing.T) {
+	ctx := context.Background()
+	names := make([]string, 0)
+	for _, newEphemeralResource := range New("test", nil)().(*SnowflakeProvider).EphemeralResources(ctx) {
+		r := newEphemeralResource()
+
+		metadataResp := ephemeral.MetadataResponse{}
+		r.Metadata(ctx, ephemeral.MetadataRequest{ProviderTypeName: "snowflake"}, &metadataResp)
+		names = append(names, metadataResp.TypeName)
+
+		schemaResp := ephemeral.SchemaResponse{}
+		r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+		require.False(t, schemaResp.Diagnostics.HasError(), "%v", schemaResp.Diagnostics)
+		require.False(t, schemaResp.Schema.ValidateImplementation(ctx).HasError())
+	}
+	assert.Equal(t, []string{"snowflake_oauth_client_secrets", "snowflake_rsa_key_pair", "snowflake_scim_access_token"}, names)
+}
+
+func TestGenerateRsaKeyPair(t *testing.T) {
+	t.Run("unencrypted", func(t *testing.T) {
+		keyPair, err := generateRsaKeyPair(2048, "")
+		require.NoError(t, err)
+
+		privateKeyBlock, _ := pem.Decode([]byte(keyPair.privateKeyPem))
+		require.NotNil(t, privateKeyBlock)
+		assert.Equal(t, "PRIVATE KEY", privateKeyBlock.Type)
+		privateKey, err := pkcs8.ParsePKCS8PrivateKeyRSA(privateKeyBlock.Bytes)
+		require.NoError(t, err)
+		assert.Equal(t, 2048, privateKey.N.BitLen())
+
+		publicKeyBlock, _ := pem.Decode([]byte(keyPair.publicKeyPem))
+		require.NotNil(t, publicKeyBlock)
+		assert.Equal(t, base64.StdEncoding.EncodeToString(publicKeyBlock.Bytes), keyPair.publicKey)
+		publicKeyBytes, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+		require.NoError(t, err)
+		assert.Equal(t, publicKeyBytes, publicKeyBlock.Bytes)
+
+		fingerprint := sha256.Sum256(publicKeyBytes)
+		assert.Equal(t, "SHA256:"+base64.StdEncoding.EncodeToString(fingerprint[:]), keyPair.publicKeyFingerprint)
+	})
+
+	t.Run("encrypted", func(t *testing.T) {
+		keyPair, err := generateRsaKeyPair(2048, "passphrase")
+		require.NoError(t, err)
+
+		privateKeyBlock, _ := pem.Decode([]byte(keyPair.privateKeyPem))
+		require.NotNil(t, privateKeyBlock)
+		assert.Equal(t, "ENCRYPTED PRIVATE KEY", privateKeyBlock.Type)
+		_, err = pkcs8.ParsePKCS8PrivateKeyRSA(privateKeyBlock.Bytes, []byte("passphrase"))
+		require.NoError(t, err)
+		_, err = pkcs8.ParsePKCS8PrivateKeyRSA(privateKeyBlock.Bytes, []byte("other"))
+		require.Error(t, err)
+	})
+}
+
+func TestRsaKeyPairEphemeralResource_Open(t *testing.T) {
+	ctx := context.Background()
+	r := NewRsaKeyPairEphemeralResource()
+	schemaResp := ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+	config := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"key_size":               tftypes.NewValue(tftypes.Number, nil),
+		"passphrase":             tftypes.NewValue(tftypes.String, nil),
+		"private_key_pem":        tftypes.NewValue(tftypes.String, nil),
+		"public_key_pem":         tftypes.NewValue(tftypes.String, nil),
+		"public_key":             tftypes.NewValue(tftypes.String, nil),
+		"public_key_fingerprint": tftypes.NewValue(tftypes.String, nil),
+	})
+	req := ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config}}
+	resp := ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: config}}
+	r.Open(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var result rsaKeyPairModel
+	require.False(t, resp.Result.Get(ctx, &result).HasError())
+	assert.Equal(t, types.Int64Value(defaultRsaKeySize), result.KeySize)
+	assert.NotEmpty(t, result.PrivateKeyPem.ValueString())
+	assert.NotEmpty(t, result.PublicKey.ValueString())
+	assert.Contains(t, result.PublicKeyFingerprint.ValueString(), "SHA256:")
+}
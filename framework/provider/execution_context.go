@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// executionContextAttributes are the attributes running the operations of the resource with another role and warehouse
+// than the ones of the provider. They match the fields added to the SDKv2 resources.
+func executionContextAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"execution_role": schema.StringAttribute{
+			Description: "Role the statements of the resource are executed with instead of the role of the provider, e.g. to create the object owned by a functional role without a separate provider alias. The operations with an execution context use a separate connection to Snowflake per context.",
+			Optional:    true,
+		},
+		"execution_secondary_roles": schema.ListAttribute{
+			Description: "Secondary roles activated for the statements of the resource: the role names, or a single `ALL` or `NONE`.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"execution_warehouse": schema.StringAttribute{
+			Description: "Warehouse the statements of the resource are executed with instead of the warehouse of the provider.",
+			Optional:    true,
+		},
+	}
+}
+
+// executionContextModel holds the values of executionContextAttributes.
+type executionContextModel struct {
+	Role           types.String
+	SecondaryRoles types.List
+	Warehouse      types.String
+}
+
+// executionContextClient returns the client executing the statements with the execution context (see
+// sdk.DBWithExecutionContext); without the execution context, it returns the client of the provider.
+func executionContextClient(ctx context.Context, client *sdk.Client, model executionContextModel) (*sdk.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	ec := sdk.ExecutionContext{
+		Role:      model.Role.ValueString(),
+		Warehouse: model.Warehouse.ValueString(),
+	}
+	if isKnown(model.SecondaryRoles) {
+		diags.Append(model.SecondaryRoles.ElementsAs(ctx, &ec.SecondaryRoles, false)...)
+	}
+	if diags.HasError() || ec.IsEmpty() {
+		return client, diags
+	}
+	db, err := sdk.DBWithExecutionContext(client.GetConn().DB, ec)
+	if err != nil {
+		diags.AddError("Client Error", err.Error())
+		return nil, diags
+	}
+	return sdk.NewClientFromDB(db), diags
+}
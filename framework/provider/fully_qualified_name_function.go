@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = new(FullyQualifiedNameFunction)
+
+// FullyQualifiedNameFunction builds a quoted, fully qualified name out of the identifier parts.
+type FullyQualifiedNameFunction struct{}
+
+func NewFullyQualifiedNameFunction() function.Function {
+	return &FullyQualifiedNameFunction{}
+}
+
+func (f *FullyQualifiedNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "fully_qualified_name"
+}
+
+func (f *FullyQualifiedNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a fully qualified name of a Snowflake object.",
+		MarkdownDescription: "Builds a fully qualified name out of 1 to 4 identifier parts (e.g. database, schema and table name), quoting each of them, e.g. `\"db\".\"schema\".\"table\"`.",
+		VariadicParameter: function.StringParameter{
+			Name:                "parts",
+			MarkdownDescription: "Identifier parts, starting from the outermost one (database).",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FullyQualifiedNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var parts []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &parts))
+	if resp.Error != nil {
+		return
+	}
+
+	id, err := sdk.NewObjectIdentifierFromParts(parts...)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, id.FullyQualifiedName()))
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = new(FunctionSignatureFunction)
+
+// FunctionSignatureFunction builds the identifier of a function or procedure, in the format used by their resource ids.
+type FunctionSignatureFunction struct{}
+
+func NewFunctionSignatureFunction() function.Function {
+	return &FunctionSignatureFunction{}
+}
+
+func (f *FunctionSignatureFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "function_signature"
+}
+
+func (f *FunctionSignatureFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a signature of a Snowflake function or procedure.",
+		MarkdownDescription: "Builds a fully qualified signature of a function or procedure, e.g. `\"db\".\"schema\".\"name\"(NUMBER, VARCHAR)`. Argument types are normalized (e.g. `INT` becomes `NUMBER`). The result can be used to import `snowflake_function` and `snowflake_procedure` resources.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "database",
+				MarkdownDescription: "Database of the function or procedure.",
+			},
+			function.StringParameter{
+				Name:                "schema",
+				MarkdownDescription: "Schema of the function or procedure.",
+			},
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Name of the function or procedure.",
+			},
+			function.ListParameter{
+				Name:                "argument_types",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Data types of the arguments.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FunctionSignatureFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var database, schema, name string
+	var argumentTypes []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &database, &schema, &name, &argumentTypes))
+	if resp.Error != nil {
+		return
+	}
+
+	for i, part := range []string{database, schema, name} {
+		if part == "" {
+			resp.Error = function.NewArgumentFuncError(int64(i), "identifier parts cannot be empty")
+			return
+		}
+	}
+	dataTypes := make([]sdk.DataType, len(argumentTypes))
+	for i, argumentType := range argumentTypes {
+		dataType, err := sdk.ToDataType(argumentType)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(3, err.Error())
+			return
+		}
+		dataTypes[i] = dataType
+	}
+
+	id := sdk.NewSchemaObjectIdentifierWithArguments(database, schema, name, dataTypes)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, id.FullyQualifiedName()))
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runFunction(t *testing.T, f function.Function, result attr.Value, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	req := function.RunRequest{Arguments: function.NewArgumentsData(arguments)}
+	resp := function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), req, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func stringList(values ...string) types.List {
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = types.StringValue(v)
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func stringTuple(values ...string) types.Tuple {
+	elementTypes := make([]attr.Type, len(values))
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elementTypes[i] = types.StringType
+		elements[i] = types.StringValue(v)
+	}
+	return types.TupleValueMust(elementTypes, elements)
+}
+
+func TestFunctions_Definitions(t *testing.T) {
+	ctx := context.Background()
+	names := make([]string, 0)
+	for _, newFunction := range New("test", nil)().(*SnowflakeProvider).Functions(ctx) {
+		f := newFunction()
+
+		metadataResp := function.MetadataResponse{}
+		f.Metadata(ctx, function.MetadataRequest{}, &metadataResp)
+		names = append(names, metadataResp.Name)
+
+		definitionResp := function.DefinitionResponse{}
+		f.Definition(ctx, function.DefinitionRequest{}, &definitionResp)
+		validateResp := function.DefinitionValidateResponse{}
+		definitionResp.Definition.ValidateImplementation(ctx, function.DefinitionValidateRequest{FuncName: metadataResp.Name}, &validateResp)
+		require.False(t, validateResp.Diagnostics.HasError(), "%v", validateResp.Diagnostics)
+	}
+	assert.Equal(t, []string{"decode_import_id", "encode_import_id", "fully_qualified_name", "function_signature", "parse_identifier", "quote_identifier"}, names)
+}
+
+func TestFullyQualifiedNameFunction(t *testing.T) {
+	result, err := runFunction(t, NewFullyQualifiedNameFunction(), types.StringUnknown(), stringTuple("db", "schema", "table"))
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue(`"db"."schema"."table"`), result)
+
+	result, err = runFunction(t, NewFullyQualifiedNameFunction(), types.StringUnknown(), stringTuple("db", `sche"ma`))
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue(`"db"."sche""ma"`), result)
+
+	_, err = runFunction(t, NewFullyQualifiedNameFunction(), types.StringUnknown(), stringTuple("a", "b", "c", "d", "e"))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Text, "identifier should consist of 1 to 4 parts, got 5")
+}
+
+func TestQuoteIdentifierFunction(t *testing.T) {
+	result, err := runFunction(t, NewQuoteIdentifierFunction(), types.StringUnknown(), types.StringValue(`na"me`))
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue(`"na""me"`), result)
+
+	_, err = runFunction(t, NewQuoteIdentifierFunction(), types.StringUnknown(), types.StringValue(""))
+	require.NotNil(t, err)
+}
+
+func TestFunctionSignatureFunction(t *testing.T) {
+	result, err := runFunction(t, NewFunctionSignatureFunction(), types.StringUnknown(),
+		types.StringValue("db"), types.StringValue("schema"), types.StringValue("fn"), stringList("int", "string"))
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue(`"db"."schema"."fn"(NUMBER, VARCHAR)`), result)
+
+	_, err = runFunction(t, NewFunctionSignatureFunction(), types.StringUnknown(),
+		types.StringValue("db"), types.StringValue("schema"), types.StringValue("fn"), stringList("NOT_A_TYPE"))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Text, "invalid data type: NOT_A_TYPE")
+}
+
+func TestParseIdentifierFunction(t *testing.T) {
+	emptyResult := types.ObjectUnknown(parsedIdentifierAttributeTypes)
+
+	t.Run("schema object", func(t *testing.T) {
+		result, err := runFunction(t, NewParseIdentifierFunction(), emptyResult, types.StringValue(`"db"."schema"."table.name"`))
+		require.Nil(t, err)
+		attributes := result.(types.Object).Attributes()
+		assert.Equal(t, types.StringValue("db"), attributes["database"])
+		assert.Equal(t, types.StringValue("schema"), attributes["schema"])
+		assert.Equal(t, types.StringNull(), attributes["table"])
+		assert.Equal(t, types.StringValue("table.name"), attributes["name"])
+		assert.True(t, attributes["arguments"].IsNull())
+		assert.Equal(t, types.StringValue(`"db"."schema"."table.name"`), attributes["fully_qualified_name"])
+	})
+
+	t.Run("function signature", func(t *testing.T) {
+		result, err := runFunction(t, NewParseIdentifierFunction(), emptyResult, types.StringValue(`db.schema.fn(int, varchar)`))
+		require.Nil(t, err)
+		attributes := result.(types.Object).Attributes()
+		assert.Equal(t, types.StringValue("fn"), attributes["name"])
+		assert.Equal(t, stringList("NUMBER", "VARCHAR"), attributes["arguments"])
+		assert.Equal(t, types.StringValue(`"db"."schema"."fn"(NUMBER, VARCHAR)`), attributes["fully_qualified_name"])
+	})
+
+	t.Run("table column", func(t *testing.T) {
+		result, err := runFunction(t, NewParseIdentifierFunction(), emptyResult, types.StringValue(`db.schema.table.column`))
+		require.Nil(t, err)
+		attributes := result.(types.Object).Attributes()
+		assert.Equal(t, types.StringValue("table"), attributes["table"])
+		assert.Equal(t, types.StringValue("column"), attributes["name"])
+	})
+
+	t.Run("account object", func(t *testing.T) {
+		result, err := runFunction(t, NewParseIdentifierFunction(), emptyResult, types.StringValue(`warehouse`))
+		require.Nil(t, err)
+		attributes := result.(types.Object).Attributes()
+		assert.Equal(t, types.StringNull(), attributes["database"])
+		assert.Equal(t, types.StringValue("warehouse"), attributes["name"])
+	})
+}
+
+func TestImportIdFunctions(t *testing.T) {
+	testCases := []struct {
+		format string
+		parts  []string
+		id     string
+	}{
+		{format: "snowflake_id", parts: []string{"db", "schema", "table"}, id: "db|schema|table"},
+		{format: "fully_qualified_name", parts: []string{"db", "schema.name"}, id: `"db"."schema.name"`},
+		{format: "grant_privileges_to_account_role", parts: []string{`"role"`, "false", "false", "ALL", "OnAccount"}, id: `"role"|false|false|ALL|OnAccount`},
+		{format: "grant_privileges_to_database_role", parts: []string{`"db"."role"`, "true", "false", "USAGE", "OnDatabase", `"db"`}, id: `"db"."role"|true|false|USAGE|OnDatabase|"db"`},
+		{format: "grant_privileges_to_share", parts: []string{`"share"`, "USAGE", "OnDatabase", `"db"`}, id: `"share"|USAGE|OnDatabase|"db"`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.format, func(t *testing.T) {
+			id, err := runFunction(t, NewEncodeImportIdFunction(), types.StringUnknown(), types.StringValue(tc.format), stringList(tc.parts...))
+			require.Nil(t, err)
+			assert.Equal(t, types.StringValue(tc.id), id)
+
+			parts, err := runFunction(t, NewDecodeImportIdFunction(), types.ListUnknown(types.StringType), types.StringValue(tc.format), types.StringValue(tc.id))
+			require.Nil(t, err)
+			assert.Equal(t, stringList(tc.parts...), parts)
+		})
+	}
+
+	t.Run("invalid format", func(t *testing.T) {
+		_, err := runFunction(t, NewDecodeImportIdFunction(), types.ListUnknown(types.StringType), types.StringValue("unknown"), types.StringValue("id"))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Text, "invalid import id format: unknown")
+	})
+
+	t.Run("invalid grant id", func(t *testing.T) {
+		_, err := runFunction(t, NewDecodeImportIdFunction(), types.ListUnknown(types.StringType), types.StringValue("grant_privileges_to_share"), types.StringValue(`"share"|USAGE`))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Text, "snowflake_grant_privileges_to_share id is composed out of 4 parts")
+	})
+}
@@ -1,13 +1,19 @@
 package provider
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
 	"sync"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/tracing"
 	"github.com/gookit/color"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type tfOperation string
@@ -17,8 +23,24 @@ const (
 	ReadOperation   tfOperation = "READ"
 	UpdateOperation tfOperation = "UPDATE"
 	DeleteOperation tfOperation = "DELETE"
+	OpenOperation   tfOperation = "OPEN"
 )
 
+// startOperation starts the tracing span of the operation and sets the resource part of the query tag of the
+// statements executed with the returned context. The returned function ends the span; it should be deferred with
+// the diagnostics of the response.
+func startOperation(ctx context.Context, resourceType string, id string, operation tfOperation) (context.Context, func(*diag.Diagnostics)) {
+	ctx, span := tracing.StartOperation(ctx, resourceType, id, string(operation))
+	ctx = sdk.ContextWithQueryTag(ctx, sdk.QueryTag{
+		ResourceType: resourceType,
+		ResourceId:   id,
+		Operation:    string(operation),
+	})
+	return ctx, func(diags *diag.Diagnostics) {
+		tracing.EndOperation(span, diags.HasError())
+	}
+}
+
 func formatSQLPreview(operation tfOperation, resourceName string, id string, commands []string) string {
 	var c color.Color
 	switch operation {
@@ -34,7 +56,7 @@ func formatSQLPreview(operation tfOperation, resourceName string, id string, com
 	var sb strings.Builder
 	sb.WriteString(c.Sprintf("\n[ %s %s %s ]", operation, resourceName, id))
 	for _, command := range commands {
-		sb.WriteString(c.Sprintf("\n  - %s", command))
+		sb.WriteString(c.Sprintf("\n  - %s", sdk.RedactSecrets(command)))
 	}
 	sb.WriteString("\n")
 	return sb.String()
@@ -82,3 +104,46 @@ func isSensitive(s string) bool {
 	}
 	return false
 }
+
+// isKnown returns true when the value is neither null nor unknown.
+func isKnown(v attr.Value) bool {
+	return !v.IsNull() && !v.IsUnknown()
+}
+
+func stringElements(ctx context.Context, set types.Set, diags *diag.Diagnostics) []string {
+	if !isKnown(set) {
+		return nil
+	}
+	var elements []string
+	diags.Append(set.ElementsAs(ctx, &elements, false)...)
+	return elements
+}
+
+func int64Elements(ctx context.Context, set types.Set, diags *diag.Diagnostics) []int64 {
+	if !isKnown(set) {
+		return nil
+	}
+	var elements []int64
+	diags.Append(set.ElementsAs(ctx, &elements, false)...)
+	return elements
+}
+
+func setValue(ctx context.Context, elemType attr.Type, values any, diags *diag.Diagnostics) types.Set {
+	set, d := types.SetValueFrom(ctx, elemType, values)
+	diags.Append(d...)
+	return set
+}
+
+func stringValueOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+func int64ValueOrNull(i *int) types.Int64 {
+	if i == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*i))
+}
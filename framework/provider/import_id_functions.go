@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = new(EncodeImportIdFunction)
+	_ function.Function = new(DecodeImportIdFunction)
+)
+
+type importIdFormat string
+
+const (
+	// SnowflakeIdImportIdFormat is the pipe-separated format used by most of the resources, e.g. db|schema|table.
+	SnowflakeIdImportIdFormat importIdFormat = "snowflake_id"
+	// FullyQualifiedNameImportIdFormat is the quoted, dot-separated format, e.g. "db"."schema"."table".
+	FullyQualifiedNameImportIdFormat            importIdFormat = "fully_qualified_name"
+	GrantPrivilegesToAccountRoleImportIdFormat  importIdFormat = "grant_privileges_to_account_role"
+	GrantPrivilegesToDatabaseRoleImportIdFormat importIdFormat = "grant_privileges_to_database_role"
+	GrantPrivilegesToShareImportIdFormat        importIdFormat = "grant_privileges_to_share"
+)
+
+const (
+	importIdFormatParameterIndex int64 = 0
+	importIdValueParameterIndex  int64 = 1
+)
+
+var allImportIdFormats = []importIdFormat{
+	SnowflakeIdImportIdFormat,
+	FullyQualifiedNameImportIdFormat,
+	GrantPrivilegesToAccountRoleImportIdFormat,
+	GrantPrivilegesToDatabaseRoleImportIdFormat,
+	GrantPrivilegesToShareImportIdFormat,
+}
+
+func toImportIdFormat(s string) (importIdFormat, error) {
+	format := importIdFormat(strings.ToLower(s))
+	if !slices.Contains(allImportIdFormats, format) {
+		return "", fmt.Errorf("invalid import id format: %s, valid formats are: %v", s, allImportIdFormats)
+	}
+	return format, nil
+}
+
+func importIdFormatsDescription() string {
+	formats := make([]string, len(allImportIdFormats))
+	for i, format := range allImportIdFormats {
+		formats[i] = fmt.Sprintf("`%s`", format)
+	}
+	return strings.Join(formats, ", ")
+}
+
+// normalizeGrantId parses the grant id with the parser of the given grant resource and returns its canonical representation.
+func normalizeGrantId(format importIdFormat, id string) (string, error) {
+	switch format {
+	case GrantPrivilegesToAccountRoleImportIdFormat:
+		grantId, err := resources.ParseGrantPrivilegesToAccountRoleId(id)
+		if err != nil {
+			return "", err
+		}
+		return grantId.String(), nil
+	case GrantPrivilegesToDatabaseRoleImportIdFormat:
+		grantId, err := resources.ParseGrantPrivilegesToDatabaseRoleId(id)
+		if err != nil {
+			return "", err
+		}
+		return grantId.String(), nil
+	case GrantPrivilegesToShareImportIdFormat:
+		grantId, err := resources.ParseGrantPrivilegesToShareId(id)
+		if err != nil {
+			return "", err
+		}
+		return grantId.String(), nil
+	}
+	return "", fmt.Errorf("import id format %s is not a grant format", format)
+}
+
+func identifierParts(id sdk.ObjectIdentifier) []string {
+	switch v := id.(type) {
+	case sdk.DatabaseObjectIdentifier:
+		return []string{v.DatabaseName(), v.Name()}
+	case sdk.SchemaObjectIdentifier:
+		return []string{v.DatabaseName(), v.SchemaName(), v.Name()}
+	case sdk.TableColumnIdentifier:
+		return []string{v.DatabaseName(), v.SchemaName(), v.TableName(), v.Name()}
+	}
+	return []string{id.Name()}
+}
+
+func encodeImportId(format importIdFormat, parts []string) (string, error) {
+	switch format {
+	case SnowflakeIdImportIdFormat, FullyQualifiedNameImportIdFormat:
+		id, err := sdk.NewObjectIdentifierFromParts(parts...)
+		if err != nil {
+			return "", err
+		}
+		if format == SnowflakeIdImportIdFormat {
+			return helpers.EncodeSnowflakeID(id), nil
+		}
+		return id.FullyQualifiedName(), nil
+	default:
+		return normalizeGrantId(format, strings.Join(parts, helpers.IDDelimiter))
+	}
+}
+
+func decodeImportId(format importIdFormat, id string) ([]string, error) {
+	switch format {
+	case SnowflakeIdImportIdFormat:
+		objectId := helpers.DecodeSnowflakeID(id)
+		if objectId == nil {
+			return nil, fmt.Errorf("unable to decode identifier: %s, expected 1 to 4 parts separated by %s", id, helpers.IDDelimiter)
+		}
+		return identifierParts(objectId), nil
+	case FullyQualifiedNameImportIdFormat:
+		objectId, err := helpers.DecodeSnowflakeParameterID(id)
+		if err != nil {
+			return nil, err
+		}
+		return identifierParts(objectId), nil
+	default:
+		normalizedId, err := normalizeGrantId(format, id)
+		if err != nil {
+			return nil, err
+		}
+		return strings.Split(normalizedId, helpers.IDDelimiter), nil
+	}
+}
+
+// EncodeImportIdFunction builds a resource import id out of its parts.
+type EncodeImportIdFunction struct{}
+
+func NewEncodeImportIdFunction() function.Function {
+	return &EncodeImportIdFunction{}
+}
+
+func (f *EncodeImportIdFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "encode_import_id"
+}
+
+func (f *EncodeImportIdFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a resource import id out of its parts.",
+		MarkdownDescription: "Builds a resource import id in the given format out of its parts. Grant ids are validated and normalized " +
+			"with the same parser the grant resources use. Supported formats: " + importIdFormatsDescription() + ".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "format",
+				MarkdownDescription: "Format of the import id. Supported formats: " + importIdFormatsDescription() + ".",
+			},
+			function.ListParameter{
+				Name:                "parts",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Parts of the import id, e.g. `[\"db\", \"schema\", \"table\"]`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *EncodeImportIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var formatString string
+	var parts []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &formatString, &parts))
+	if resp.Error != nil {
+		return
+	}
+
+	format, err := toImportIdFormat(formatString)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(importIdFormatParameterIndex, err.Error())
+		return
+	}
+	id, err := encodeImportId(format, parts)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(importIdValueParameterIndex, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, id))
+}
+
+// DecodeImportIdFunction splits a resource import id into its parts.
+type DecodeImportIdFunction struct{}
+
+func NewDecodeImportIdFunction() function.Function {
+	return &DecodeImportIdFunction{}
+}
+
+func (f *DecodeImportIdFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "decode_import_id"
+}
+
+func (f *DecodeImportIdFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Splits a resource import id into its parts.",
+		MarkdownDescription: "Splits a resource import id in the given format into its parts. Grant ids are validated and normalized " +
+			"with the same parser the grant resources use. Supported formats: " + importIdFormatsDescription() + ".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "format",
+				MarkdownDescription: "Format of the import id. Supported formats: " + importIdFormatsDescription() + ".",
+			},
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "Import id to decode.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *DecodeImportIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var formatString, id string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &formatString, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	format, err := toImportIdFormat(formatString)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(importIdFormatParameterIndex, err.Error())
+		return
+	}
+	parts, err := decodeImportId(format, id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(importIdValueParameterIndex, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, parts))
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	ctymsgpack "github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NewMuxServer combines the SDKv2 provider and the plugin framework provider into one provider server. Resources are
+// served by exactly one of them, so they can be migrated to the plugin framework one at a time.
+//
+// The SDKv2 server goes first, because the mux server configures the servers in order and the plugin framework
+// provider reuses the connection configured by the SDKv2 one.
+func NewMuxServer(ctx context.Context, version string, sdkV2Provider *schema.Provider) (tfprotov6.ProviderServer, error) {
+	upgradedSdkServer, err := tf5to6server.UpgradeServer(ctx, sdkV2Provider.GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+	sdkServer := &moveStateServer{
+		ProviderServer: upgradedSdkServer,
+		sdkV2Provider:  sdkV2Provider,
+	}
+
+	providers := []func() tfprotov6.ProviderServer{
+		func() tfprotov6.ProviderServer {
+			return sdkServer
+		},
+		providerserver.NewProtocol6(New(version, sdkV2Provider)()),
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+	return &providerSchemaServer{
+		ProviderServer: muxServer.ProviderServer(),
+		sdkServer:      sdkServer,
+	}, nil
+}
+
+// providerSchemaServer serves the provider block of the SDKv2 server. The mux server returns the provider block of
+// the last server, and the plugin framework does not put the block sizes (MaxItems) in its schema, so they would be
+// lost from the served schema and the generated documentation.
+type providerSchemaServer struct {
+	tfprotov6.ProviderServer
+	sdkServer tfprotov6.ProviderServer
+}
+
+func (s *providerSchemaServer) GetProviderSchema(ctx context.Context, req *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	resp, err := s.ProviderServer.GetProviderSchema(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	sdkResp, err := s.sdkServer.GetProviderSchema(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if sdkResp.Provider != nil {
+		resp.Provider = sdkResp.Provider
+	}
+	return resp, nil
+}
+
+// moveStateServer adds MoveResourceState, which SDKv2 does not implement, for moving the generic snowflake_file_format
+// resource to the typed file format resources. Other calls go to the wrapped server.
+type moveStateServer struct {
+	tfprotov6.ProviderServer
+	sdkV2Provider *schema.Provider
+}
+
+func (s *moveStateServer) MoveResourceState(ctx context.Context, req *tfprotov6.MoveResourceStateRequest) (*tfprotov6.MoveResourceStateResponse, error) {
+	if req == nil || req.SourceTypeName != "snowflake_file_format" {
+		return s.ProviderServer.MoveResourceState(ctx, req)
+	}
+	upgrader, ok := resources.FileFormatMoveStateUpgrader(req.TargetTypeName)
+	if !ok {
+		return s.ProviderServer.MoveResourceState(ctx, req)
+	}
+
+	targetState, err := s.moveFileFormatState(ctx, req, upgrader)
+	if err != nil {
+		return &tfprotov6.MoveResourceStateResponse{
+			Diagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  fmt.Sprintf("Unable to move %s to %s", req.SourceTypeName, req.TargetTypeName),
+					Detail:   err.Error(),
+				},
+			},
+		}, nil
+	}
+	return &tfprotov6.MoveResourceStateResponse{
+		TargetState: targetState,
+	}, nil
+}
+
+func (s *moveStateServer) moveFileFormatState(ctx context.Context, req *tfprotov6.MoveResourceStateRequest, upgrader schema.StateUpgradeFunc) (*tfprotov6.DynamicValue, error) {
+	if req.SourceState == nil || len(req.SourceState.JSON) == 0 {
+		return nil, fmt.Errorf("source state is empty")
+	}
+	rawState := make(map[string]interface{})
+	if err := json.Unmarshal(req.SourceState.JSON, &rawState); err != nil {
+		return nil, err
+	}
+	upgraded, err := upgrader(ctx, rawState, s.sdkV2Provider.Meta())
+	if err != nil {
+		return nil, err
+	}
+	// the execution context is added to every resource by the provider, so the upgrader does not know about it
+	for name := range executionContextAttributes() {
+		if v, ok := rawState[name]; ok {
+			upgraded[name] = v
+		}
+	}
+
+	target, ok := s.sdkV2Provider.ResourcesMap[req.TargetTypeName]
+	if !ok {
+		return nil, fmt.Errorf("resource %s not found", req.TargetTypeName)
+	}
+	ty := target.CoreConfigSchema().ImpliedType()
+	b, err := json.Marshal(upgraded)
+	if err != nil {
+		return nil, err
+	}
+	value, err := ctyjson.Unmarshal(b, ty)
+	if err != nil {
+		return nil, err
+	}
+	msgpack, err := ctymsgpack.Marshal(value, ty)
+	if err != nil {
+		return nil, err
+	}
+	return &tfprotov6.DynamicValue{MsgPack: msgpack}, nil
+}
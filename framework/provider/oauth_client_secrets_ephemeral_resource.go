@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &OAuthClientSecretsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &OAuthClientSecretsEphemeralResource{}
+)
+
+func NewOAuthClientSecretsEphemeralResource() ephemeral.EphemeralResource {
+	return &OAuthClientSecretsEphemeralResource{}
+}
+
+// OAuthClientSecretsEphemeralResource reads the client secrets of a custom OAuth security integration without storing
+// them in the plan or the state.
+type OAuthClientSecretsEphemeralResource struct {
+	client *sdk.Client
+}
+
+type oauthClientSecretsModel struct {
+	IntegrationName    types.String `tfsdk:"integration_name"`
+	OAuthClientId      types.String `tfsdk:"oauth_client_id"`
+	OAuthClientSecret  types.String `tfsdk:"oauth_client_secret"`
+	OAuthClientSecret2 types.String `tfsdk:"oauth_client_secret_2"`
+}
+
+func (r *OAuthClientSecretsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oauth_client_secrets"
+}
+
+func (r *OAuthClientSecretsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the client id and the client secrets of a custom OAuth security integration with [SYSTEM$SHOW_OAUTH_CLIENT_SECRETS](https://docs.snowflake.com/en/sql-reference/functions/system_show_oauth_client_secrets). The secrets are not stored in the plan or the state.",
+		Attributes: map[string]schema.Attribute{
+			"integration_name": schema.StringAttribute{
+				Description: "Name of the custom OAuth security integration.",
+				Required:    true,
+			},
+			"oauth_client_id": schema.StringAttribute{
+				Description: "The client id of the integration.",
+				Computed:    true,
+			},
+			"oauth_client_secret": schema.StringAttribute{
+				Description: "The primary client secret of the integration.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"oauth_client_secret_2": schema.StringAttribute{
+				Description: "The secondary client secret of the integration; used during the rotation of the secrets.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *OAuthClientSecretsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+}
+
+func (r *OAuthClientSecretsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data oauthClientSecretsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := sdk.NewAccountObjectIdentifier(data.IntegrationName.ValueString())
+	ctx, endOperation := startOperation(ctx, "snowflake_oauth_client_secrets", id.Name(), OpenOperation)
+	defer endOperation(&resp.Diagnostics)
+	secrets, err := r.client.SystemFunctions.ShowOAuthClientSecrets(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("error reading OAuth client secrets of integration %v err = %s", id.Name(), err))
+		return
+	}
+	data.OAuthClientId = types.StringValue(secrets.ClientId)
+	data.OAuthClientSecret = types.StringValue(secrets.ClientSecret)
+	data.OAuthClientSecret2 = types.StringValue(secrets.ClientSecret2)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = new(ParseIdentifierFunction)
+
+var parsedIdentifierAttributeTypes = map[string]attr.Type{
+	"database":             types.StringType,
+	"schema":               types.StringType,
+	"table":                types.StringType,
+	"name":                 types.StringType,
+	"arguments":            types.ListType{ElemType: types.StringType},
+	"fully_qualified_name": types.StringType,
+}
+
+type parsedIdentifierModel struct {
+	Database           types.String `tfsdk:"database"`
+	Schema             types.String `tfsdk:"schema"`
+	Table              types.String `tfsdk:"table"`
+	Name               types.String `tfsdk:"name"`
+	Arguments          []string     `tfsdk:"arguments"`
+	FullyQualifiedName types.String `tfsdk:"fully_qualified_name"`
+}
+
+// ParseIdentifierFunction splits an identifier into its parts.
+type ParseIdentifierFunction struct{}
+
+func NewParseIdentifierFunction() function.Function {
+	return &ParseIdentifierFunction{}
+}
+
+func (f *ParseIdentifierFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_identifier"
+}
+
+func (f *ParseIdentifierFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parses a Snowflake identifier into its parts.",
+		MarkdownDescription: "Parses a (possibly quoted) identifier, e.g. `db.schema.table` or `\"db\".\"schema\".\"name\"(NUMBER, VARCHAR)`, into an object with " +
+			"`database`, `schema`, `table`, `name`, `arguments` and `fully_qualified_name` attributes. Parts that are not present in the identifier are null; " +
+			"`table` is set only for column identifiers and `arguments` only for function and procedure signatures.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "identifier",
+				MarkdownDescription: "Identifier to parse. Parts containing dots have to be quoted.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parsedIdentifierAttributeTypes,
+		},
+	}
+}
+
+func (f *ParseIdentifierFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var identifier string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &identifier))
+	if resp.Error != nil {
+		return
+	}
+
+	id, err := helpers.DecodeSnowflakeParameterIDWithArguments(identifier)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result := parsedIdentifierModel{
+		Database:           types.StringNull(),
+		Schema:             types.StringNull(),
+		Table:              types.StringNull(),
+		Name:               types.StringValue(id.Name()),
+		FullyQualifiedName: types.StringValue(id.FullyQualifiedName()),
+	}
+	switch v := id.(type) {
+	case sdk.DatabaseObjectIdentifier:
+		result.Database = types.StringValue(v.DatabaseName())
+	case sdk.SchemaObjectIdentifier:
+		result.Database = types.StringValue(v.DatabaseName())
+		result.Schema = types.StringValue(v.SchemaName())
+		if v.Arguments() != nil {
+			result.Arguments = make([]string, len(v.Arguments()))
+			for i, argument := range v.Arguments() {
+				result.Arguments[i] = string(argument)
+			}
+		}
+	case sdk.TableColumnIdentifier:
+		result.Database = types.StringValue(v.DatabaseName())
+		result.Schema = types.StringValue(v.SchemaName())
+		result.Table = types.StringValue(v.TableName())
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	sdkv2provider "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvider_SchemaParity(t *testing.T) {
+	ctx := context.Background()
+
+	sdkV2Server, err := tf5to6server.UpgradeServer(ctx, sdkv2provider.Provider().GRPCProvider)
+	require.NoError(t, err)
+	sdkV2Schema, err := sdkV2Server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, sdkV2Schema.Diagnostics)
+
+	frameworkServer := providerserver.NewProtocol6(New("test", nil)())()
+	frameworkSchema, err := frameworkServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, frameworkSchema.Diagnostics)
+
+	// the mux server rejects providers with different provider blocks, so the conversion of the SDKv2 schema has to
+	// produce the same block
+	sdkV2Attributes := make(map[string]*tfprotov6.SchemaAttribute)
+	for _, attribute := range sdkV2Schema.Provider.Block.Attributes {
+		sdkV2Attributes[attribute.Name] = attribute
+	}
+	frameworkAttributes := make(map[string]*tfprotov6.SchemaAttribute)
+	for _, attribute := range frameworkSchema.Provider.Block.Attributes {
+		frameworkAttributes[attribute.Name] = attribute
+	}
+	require.ElementsMatch(t, keys(sdkV2Attributes), keys(frameworkAttributes))
+	for name, attribute := range sdkV2Attributes {
+		assert.Equal(t, attribute, frameworkAttributes[name], "attribute %s differs", name)
+	}
+
+	// the plugin framework enforces the block size with validators, so MaxItems is not part of its schema
+	require.Len(t, frameworkSchema.Provider.Block.BlockTypes, len(sdkV2Schema.Provider.Block.BlockTypes))
+	for i, block := range sdkV2Schema.Provider.Block.BlockTypes {
+		frameworkBlock := *frameworkSchema.Provider.Block.BlockTypes[i]
+		frameworkBlock.MaxItems = block.MaxItems
+		assert.Equal(t, *block, frameworkBlock, "block %s differs", block.TypeName)
+	}
+}
+
+func TestNewMuxServer(t *testing.T) {
+	ctx := context.Background()
+
+	server, err := NewMuxServer(ctx, "test", sdkv2provider.Provider())
+	require.NoError(t, err)
+
+	resp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+
+	// served by the plugin framework provider
+	require.Contains(t, resp.ResourceSchemas, "snowflake_resource_monitor")
+	assert.Equal(t, int64(0), resp.ResourceSchemas["snowflake_resource_monitor"].Version)
+	require.Contains(t, resp.Functions, "fully_qualified_name")
+	require.Contains(t, resp.EphemeralResourceSchemas, "snowflake_scim_access_token")
+	// served by the SDKv2 provider
+	require.Contains(t, resp.ResourceSchemas, "snowflake_database")
+	require.Contains(t, resp.DataSourceSchemas, "snowflake_databases")
+	// the block sizes of the SDKv2 provider block are kept
+	require.Len(t, resp.Provider.Block.BlockTypes, 1)
+	assert.Equal(t, "token_accessor", resp.Provider.Block.BlockTypes[0].TypeName)
+	assert.Equal(t, int64(1), resp.Provider.Block.BlockTypes[0].MaxItems)
+}
+
+func TestResourceMonitorResource_SDKv2StateCompatibility(t *testing.T) {
+	ctx := context.Background()
+
+	server, err := NewMuxServer(ctx, "test", sdkv2provider.Provider())
+	require.NoError(t, err)
+
+	// state written by the SDKv2 implementation of snowflake_resource_monitor
+	sdkV2State := []byte(`{
+		"id": "RM",
+		"name": "RM",
+		"notify_users": ["USER"],
+		"credit_quota": 100,
+		"frequency": "MONTHLY",
+		"start_timestamp": "2024-01-01 00:00",
+		"end_timestamp": "",
+		"suspend_trigger": 90,
+		"suspend_triggers": null,
+		"suspend_immediate_trigger": 100,
+		"suspend_immediate_triggers": null,
+		"notify_triggers": [50, 75],
+		"set_for_account": false,
+		"warehouses": ["WH"]
+	}`)
+
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: "snowflake_resource_monitor",
+		Version:  0,
+		RawState: &tfprotov6.RawState{JSON: sdkV2State},
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+	require.NotNil(t, resp.UpgradedState)
+
+	// unknown attributes are dropped silently during the upgrade, so the attribute sets are compared explicitly
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	attributeNames := make([]string, 0)
+	for _, attribute := range schemaResp.ResourceSchemas["snowflake_resource_monitor"].Block.Attributes {
+		attributeNames = append(attributeNames, attribute.Name)
+	}
+	var sdkV2Attributes map[string]any
+	require.NoError(t, json.Unmarshal(sdkV2State, &sdkV2Attributes))
+	// the execution context attributes were added to all resources after the migration
+	assert.ElementsMatch(t, append(keys(sdkV2Attributes), keys(executionContextAttributes())...), attributeNames)
+}
+
+func TestNewMuxServer_MoveFileFormatState(t *testing.T) {
+	ctx := context.Background()
+
+	server, err := NewMuxServer(ctx, "test", sdkv2provider.Provider())
+	require.NoError(t, err)
+
+	// state written by the generic snowflake_file_format resource
+	sourceState := []byte(`{
+		"id": "db|schema|format",
+		"name": "format",
+		"database": "db",
+		"schema": "schema",
+		"comment": "",
+		"format_type": "PARQUET",
+		"compression": "SNAPPY",
+		"binary_as_text": true,
+		"trim_space": false,
+		"null_if": ["\\N"],
+		"field_delimiter": "",
+		"execution_role": "LOADER"
+	}`)
+
+	resp, err := server.MoveResourceState(ctx, &tfprotov6.MoveResourceStateRequest{
+		SourceTypeName: "snowflake_file_format",
+		SourceState:    &tfprotov6.RawState{JSON: sourceState},
+		TargetTypeName: "snowflake_file_format_parquet",
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+	require.NotNil(t, resp.TargetState)
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	targetType := schemaResp.ResourceSchemas["snowflake_file_format_parquet"].ValueType()
+	value, err := resp.TargetState.Unmarshal(targetType)
+	require.NoError(t, err)
+	var attributes map[string]tftypes.Value
+	require.NoError(t, value.As(&attributes))
+
+	var id, compression, executionRole string
+	require.NoError(t, attributes["id"].As(&id))
+	require.NoError(t, attributes["compression"].As(&compression))
+	require.NoError(t, attributes["execution_role"].As(&executionRole))
+	assert.Equal(t, "db|schema|format", id)
+	assert.Equal(t, "SNAPPY", compression)
+	assert.Equal(t, "LOADER", executionRole)
+	// equal to the Snowflake defaults, so left out
+	assert.True(t, attributes["binary_as_text"].IsNull())
+	assert.True(t, attributes["null_if"].IsNull())
+
+	t.Run("different format type", func(t *testing.T) {
+		resp, err := server.MoveResourceState(ctx, &tfprotov6.MoveResourceStateRequest{
+			SourceTypeName: "snowflake_file_format",
+			SourceState:    &tfprotov6.RawState{JSON: sourceState},
+			TargetTypeName: "snowflake_file_format_csv",
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Diagnostics, 1)
+		assert.Contains(t, resp.Diagnostics[0].Detail, `file format with format_type "PARQUET" cannot be moved to snowflake_file_format_csv`)
+	})
+}
+
+func keys[T any](m map[string]T) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	return result
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = new(QuoteIdentifierFunction)
+
+// QuoteIdentifierFunction quotes a single identifier part.
+type QuoteIdentifierFunction struct{}
+
+func NewQuoteIdentifierFunction() function.Function {
+	return &QuoteIdentifierFunction{}
+}
+
+func (f *QuoteIdentifierFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "quote_identifier"
+}
+
+func (f *QuoteIdentifierFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Quotes a single Snowflake identifier.",
+		MarkdownDescription: "Wraps a single identifier in double quotes, escaping double quotes inside of it, so that it can be safely used in SQL statements.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Identifier to quote.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *QuoteIdentifierFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+	if name == "" {
+		resp.Error = function.NewArgumentFuncError(0, "identifier cannot be empty")
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, sdk.QuoteIdentifier(name)))
+}
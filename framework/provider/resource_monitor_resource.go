@@ -2,21 +2,21 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -26,370 +26,150 @@ import (
 
 var (
 	_ resource.Resource                = &ResourceMonitorResource{}
+	_ resource.ResourceWithConfigure   = &ResourceMonitorResource{}
 	_ resource.ResourceWithImportState = &ResourceMonitorResource{}
+	_ resource.ResourceWithModifyPlan  = &ResourceMonitorResource{}
 )
 
 func NewResourceMonitorResource() resource.Resource {
 	return &ResourceMonitorResource{}
 }
 
+// ResourceMonitorResource is the plugin framework implementation of snowflake_resource_monitor. Its schema (including
+// the version and the id format) matches the former SDKv2 implementation, so the existing states are used as they are.
 type ResourceMonitorResource struct {
 	client *sdk.Client
 }
 
-type resourceMonitorModelV0 struct {
-	Name                     types.String  `tfsdk:"name"`
-	NotifyUsers              types.Set     `tfsdk:"notify_users"`
-	CreditQuota              types.Float64 `tfsdk:"credit_quota"`
-	Frequency                types.String  `tfsdk:"frequency"`
-	StartTimestamp           types.String  `tfsdk:"start_timestamp"`
-	EndTimestamp             types.String  `tfsdk:"end_timestamp"`
-	SuspendTrigger           types.Int64   `tfsdk:"suspend_trigger"`
-	SuspendTriggers          types.Set     `tfsdk:"suspend_triggers"`
-	SuspendImmediateTrigger  types.Int64   `tfsdk:"suspend_immediate_trigger"`
-	SuspendImmediateTriggers types.Set     `tfsdk:"suspend_immediate_triggers"`
-	NotifyTriggers           types.Set     `tfsdk:"notify_triggers"`
-	SetForAccount            types.Bool    `tfsdk:"set_for_account"`
-	Warehouses               types.Set     `tfsdk:"warehouses"`
-	Id                       types.String  `tfsdk:"id"`
+type resourceMonitorModel struct {
+	Name                     types.String `tfsdk:"name"`
+	NotifyUsers              types.Set    `tfsdk:"notify_users"`
+	CreditQuota              types.Int64  `tfsdk:"credit_quota"`
+	Frequency                types.String `tfsdk:"frequency"`
+	StartTimestamp           types.String `tfsdk:"start_timestamp"`
+	EndTimestamp             types.String `tfsdk:"end_timestamp"`
+	SuspendTrigger           types.Int64  `tfsdk:"suspend_trigger"`
+	SuspendTriggers          types.Set    `tfsdk:"suspend_triggers"`
+	SuspendImmediateTrigger  types.Int64  `tfsdk:"suspend_immediate_trigger"`
+	SuspendImmediateTriggers types.Set    `tfsdk:"suspend_immediate_triggers"`
+	NotifyTriggers           types.Set    `tfsdk:"notify_triggers"`
+	SetForAccount            types.Bool   `tfsdk:"set_for_account"`
+	Warehouses               types.Set    `tfsdk:"warehouses"`
+	ExecutionRole            types.String `tfsdk:"execution_role"`
+	ExecutionSecondaryRoles  types.List   `tfsdk:"execution_secondary_roles"`
+	ExecutionWarehouse       types.String `tfsdk:"execution_warehouse"`
+	Id                       types.String `tfsdk:"id"`
 }
 
-func resourceMonitorSchemaV0() schema.Schema {
-	return schema.Schema{
-		Version: 0,
-		Attributes: map[string]schema.Attribute{
-			"name": schema.StringAttribute{
-				Description: "Identifier for the resource monitor; must be unique for your account.",
-				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-			},
-			"notify_users": schema.SetAttribute{
-				Description: "Specifies the list of users to receive email notifications on resource monitors.",
-				Optional:    true,
-				ElementType: types.StringType,
-			},
-			"credit_quota": schema.Int64Attribute{
-				Description: "The number of credits allocated monthly to the resource monitor.",
-				Optional:    true,
-				Computed:    true,
-			},
-			"frequency": schema.StringAttribute{
-				Description: "The frequency interval at which the credit usage resets to 0. If you set a frequency for a resource monitor, you must also set START_TIMESTAMP.",
-				Optional:    true,
-				Computed:    true,
-				Validators: []validator.String{
-					stringvalidator.OneOfCaseInsensitive([]string{"MONTHLY", "DAILY", "WEEKLY", "YEARLY", "NEVER"}...),
-				},
-			},
-			"start_timestamp": schema.StringAttribute{
-				Description: "The date and time when the resource monitor starts monitoring credit usage for the assigned warehouses.",
-				Optional:    true,
-				Computed:    true,
-			},
-			"end_timestamp": schema.StringAttribute{
-				Description: "The date and time when the resource monitor suspends the assigned warehouses.",
-				Optional:    true,
-				Computed:    true,
-			},
-			"suspend_trigger": schema.Int64Attribute{
-				Description: "The number that represents the percentage threshold at which to suspend all warehouses.",
-				Optional:    true,
-				Validators: []validator.Int64{
-					int64validator.ConflictsWith(path.MatchRoot("suspend_triggers")),
-				},
-			},
-			"suspend_triggers": schema.SetAttribute{
-				Description: "A list of percentage thresholds at which to suspend all warehouses.",
-				Optional:    true,
-				ElementType: types.Int64Type,
-				Validators: []validator.Set{
-					setvalidator.ConflictsWith(path.MatchRoot("suspend_trigger")),
-				},
-				DeprecationMessage: "Use suspend_trigger instead",
-			},
-			"suspend_immediate_trigger": schema.Int64Attribute{
-				Description: "The number that represents the percentage threshold at which to immediately suspend all warehouses.", Optional: true,
-				Validators: []validator.Int64{
-					int64validator.ConflictsWith(path.MatchRoot("suspend_immediate_triggers")),
-				},
-			},
-			"suspend_immediate_triggers": schema.SetAttribute{
-				Description: "A list of percentage thresholds at which to suspend all warehouses.",
-				Optional:    true,
-				ElementType: types.Int64Type,
-				Validators: []validator.Set{
-					setvalidator.ConflictsWith(path.MatchRoot("suspend_immediate_trigger")),
-				},
-				DeprecationMessage: "Use suspend_immediate_trigger instead",
-			},
-			"notify_triggers": schema.SetAttribute{
-				Description: "A list of percentage thresholds at which to send an alert to subscribed users.",
-				Optional:    true,
-				ElementType: types.Int64Type,
-			},
-			"set_for_account": schema.BoolAttribute{
-				Description: "Specifies whether the resource monitor should be applied globally to your Snowflake account (defaults to false).",
-				Optional:    true,
-				Default:     booldefault.StaticBool(false),
-				// todo: create a snowflake_resource_monitor_association resource
-				// DeprecationMessage: "Use snowflake_resource_monitor_association instead",
-			},
-			"warehouses": schema.SetAttribute{
-				Description: "A list of warehouses to apply the resource monitor to.",
-				Optional:    true,
-				ElementType: types.StringType,
-				// todo: add the `resource_monitor` attribute to the `snowflake_warehouse` resource
-				// DeprecationMessage: "Set the `resource_monitor` attribute on the `snowflake_warehouse` resource instead",
-			},
-		},
-	}
+func (m *resourceMonitorModel) executionContext() executionContextModel {
+	return executionContextModel{Role: m.ExecutionRole, SecondaryRoles: m.ExecutionSecondaryRoles, Warehouse: m.ExecutionWarehouse}
 }
 
-func upgradeResourceMonitorStateV0toV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
-	var resourceMonitorDataV0 resourceMonitorModelV0
-	resp.Diagnostics.Append(req.State.Get(ctx, &resourceMonitorDataV0)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	name := resourceMonitorDataV0.Name
-	notifyUsers := resourceMonitorDataV0.NotifyUsers
-	creditQuota := resourceMonitorDataV0.CreditQuota
-	frequency := resourceMonitorDataV0.Frequency
-	startTimestamp := resourceMonitorDataV0.StartTimestamp
-	endTimestamp := resourceMonitorDataV0.EndTimestamp
-	suspendTrigger := resourceMonitorDataV0.SuspendTrigger
-	suspendTriggers := resourceMonitorDataV0.SuspendTriggers
-	if !suspendTriggers.IsNull() {
-		suspendTriggersElements := make([]types.Int64, 0, len(suspendTriggers.Elements()))
-		suspendTriggers.ElementsAs(ctx, &suspendTriggersElements, false)
-		if len(suspendTriggersElements) > 0 {
-			suspendTrigger = suspendTriggersElements[0]
-		}
-	}
-	suspendImmediateTrigger := resourceMonitorDataV0.SuspendImmediateTrigger
-	suspendImmediateTriggers := resourceMonitorDataV0.SuspendImmediateTriggers
-	if !suspendImmediateTriggers.IsNull() {
-		suspendImmediateTriggersElements := make([]types.Int64, 0, len(suspendImmediateTriggers.Elements()))
-		suspendImmediateTriggers.ElementsAs(ctx, &suspendImmediateTriggersElements, false)
-		if len(suspendImmediateTriggersElements) > 0 {
-			suspendImmediateTrigger = suspendImmediateTriggersElements[0]
-		}
-	}
-	notifyTriggers := resourceMonitorDataV0.NotifyTriggers
-
-	trigggers := make([]resourceMonitorTriggerModel, 0)
-	if !suspendTrigger.IsNull() {
-		trigggers = append(trigggers, resourceMonitorTriggerModel{
-			Threshold:     suspendTrigger,
-			TriggerAction: types.StringValue("SUSPEND"),
-		})
-	}
-	if !suspendImmediateTrigger.IsNull() {
-		trigggers = append(trigggers, resourceMonitorTriggerModel{
-			Threshold:     suspendImmediateTrigger,
-			TriggerAction: types.StringValue("SUSPEND_IMMEDIATE"),
-		})
-	}
-	if !notifyTriggers.IsNull() {
-		notifyTriggersElements := make([]types.Int64, 0, len(notifyTriggers.Elements()))
-		notifyTriggers.ElementsAs(ctx, &notifyTriggersElements, false)
-		for _, v := range notifyTriggersElements {
-			trigggers = append(trigggers, resourceMonitorTriggerModel{
-				Threshold:     v,
-				TriggerAction: types.StringValue("NOTIFY"),
-			})
-		}
-	}
-	triggersObjectType := types.ObjectType{}.WithAttributeTypes(map[string]attr.Type{
-		"threshold":      types.Int64Type,
-		"trigger_action": types.StringType,
-	})
-	triggersSet, diags := types.SetValueFrom(ctx, triggersObjectType, trigggers)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resourceMonitorV1 := &resourceMonitorModelV1{
-		Name:           name,
-		NotifyUsers:    notifyUsers,
-		CreditQuota:    creditQuota,
-		Frequency:      frequency,
-		StartTimestamp: startTimestamp,
-		EndTimestamp:   endTimestamp,
-		Triggers:       triggersSet,
-		Id:             resourceMonitorDataV0.Id,
-	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, resourceMonitorV1)...)
-}
-
-type resourceMonitorModelV1 struct {
-	OrReplace        types.Bool    `tfsdk:"or_replace"`
-	Name             types.String  `tfsdk:"name"`
-	CreditQuota      types.Float64 `tfsdk:"credit_quota"`
-	UsedCredits      types.Float64 `tfsdk:"used_credits"`
-	RemainingCredits types.Float64 `tfsdk:"remaining_credits"`
-	Frequency        types.String  `tfsdk:"frequency"`
-	StartTimestamp   types.String  `tfsdk:"start_timestamp"`
-	EndTimestamp     types.String  `tfsdk:"end_timestamp"`
-	Level            types.String  `tfsdk:"level"`
-	NotifyUsers      types.Set     `tfsdk:"notify_users"`
-	Triggers         types.Set     `tfsdk:"triggers"`
-	Id               types.String  `tfsdk:"id"`
-}
-
-func resourceMonitorSchemaV1() schema.Schema {
-	return schema.Schema{
-		Description: "Snowflake resource monitor resource",
-		Version:     1,
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Computed:            true,
-				MarkdownDescription: "ID of the database",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
-			"or_replace": schema.BoolAttribute{
-				Description: "Specifies whether to replace the resource monitor if it exists and has the same name as the one being created",
-				Optional:    true,
-				Computed:    true,
-				Sensitive:   isSensitive("snowflake_resource_monitor.*.or_replace"),
-				Default:     booldefault.StaticBool(false),
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.UseStateForUnknown(),
-				},
-			},
-			"name": schema.StringAttribute{
-				Description: "Specifies the object identifier for the database",
-				Required:    true,
-				Sensitive:   isSensitive("snowflake_resource_monitor.*.name"),
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+func resourceMonitorSchema() schema.Schema {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 			},
-			"credit_quota": schema.Float64Attribute{
-				Description: "The number of credits allocated to the resource monitor per frequency interval.",
-				Optional:    true,
-				Computed:    true,
-				Default:     float64default.StaticFloat64(0),
-				Sensitive:   isSensitive("snowflake_resource_monitor.*.credit_quota"),
+		},
+		"name": schema.StringAttribute{
+			Description: "Identifier for the resource monitor; must be unique for your account.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
 			},
-			"used_credits": schema.Float64Attribute{
-				Description: "The number of credits used by the resource monitor.",
-				Computed:    true,
-				PlanModifiers: []planmodifier.Float64{
-					float64planmodifier.UseStateForUnknown(),
-				},
+		},
+		"notify_users": schema.SetAttribute{
+			Description: "Specifies the list of users to receive email notifications on resource monitors.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"credit_quota": schema.Int64Attribute{
+			Description: "The number of credits allocated monthly to the resource monitor.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
 			},
-			"remaining_credits": schema.Float64Attribute{
-				Description: "The number of credits remaining for the resource monitor.",
-				Computed:    true,
-				PlanModifiers: []planmodifier.Float64{
-					float64planmodifier.UseStateForUnknown(),
-				},
+		},
+		"frequency": schema.StringAttribute{
+			Description: "The frequency interval at which the credit usage resets to 0. If you set a frequency for a resource monitor, you must also set START_TIMESTAMP.",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{"MONTHLY", "DAILY", "WEEKLY", "YEARLY", "NEVER"}...),
 			},
-			"level": schema.StringAttribute{
-				Description: "resource monitor level",
-				Computed:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 			},
-			"frequency": schema.StringAttribute{
-				Description: "Specifies the maximum number of days to extend the Fail-safe storage retention period for the database",
-				Optional:    true,
-				Computed:    true,
-				Sensitive:   isSensitive("snowflake_resource_monitor.*.frequency"),
-				Validators: []validator.String{
-					stringvalidator.OneOf([]string{"MONTHLY", "DAILY", "WEEKLY", "YEARLY", "NEVER"}...),
-					stringvalidator.AlsoRequires(path.MatchRoot("start_timestamp")),
-				},
+		},
+		"start_timestamp": schema.StringAttribute{
+			Description: "The date and time when the resource monitor starts monitoring credit usage for the assigned warehouses.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 			},
-			"start_timestamp": schema.StringAttribute{
-				Description: "Specifies the start time of the resource monitor",
-				Optional:    true,
-				Computed:    true,
-				Validators: []validator.String{
-					stringvalidator.AlsoRequires(path.MatchRoot("frequency")),
-				},
+		},
+		"end_timestamp": schema.StringAttribute{
+			Description: "The date and time when the resource monitor suspends the assigned warehouses.",
+			Optional:    true,
+		},
+		"suspend_trigger": schema.Int64Attribute{
+			Description: "The number that represents the percentage threshold at which to suspend all warehouses.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.ConflictsWith(path.MatchRoot("suspend_triggers")),
 			},
-			"end_timestamp": schema.StringAttribute{
-				Description: "Specifies the end time of the resource monitor",
-				Optional:    true,
+		},
+		"suspend_triggers": schema.SetAttribute{
+			Description:        "A list of percentage thresholds at which to suspend all warehouses.",
+			Optional:           true,
+			ElementType:        types.Int64Type,
+			DeprecationMessage: "Use suspend_trigger instead",
+			Validators: []validator.Set{
+				setvalidator.ConflictsWith(path.MatchRoot("suspend_trigger")),
 			},
-			"notify_users": schema.SetAttribute{
-				Description: "Specifies the list of users to receive email notifications on resource monitors",
-				Optional:    true,
-				ElementType: types.StringType,
+		},
+		"suspend_immediate_trigger": schema.Int64Attribute{
+			Description: "The number that represents the percentage threshold at which to immediately suspend all warehouses.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.ConflictsWith(path.MatchRoot("suspend_immediate_triggers")),
 			},
-			"triggers": schema.SetNestedAttribute{
-				Description: "Specifies the list of triggers to receive email notifications on resource monitors",
-				Optional:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"threshold": schema.Int64Attribute{
-							Description: "Specifies the percentage of credits used to trigger an email notification",
-							Required:    true,
-							Validators: []validator.Int64{
-								int64validator.AtLeast(0),
-							},
-						},
-						"trigger_action": schema.StringAttribute{
-							Description: "Specifies the action to take when the trigger is activated",
-							Required:    true,
-							Validators: []validator.String{
-								stringvalidator.OneOf([]string{"SUSPEND", "SUSPEND_IMMEDIATE", "NOTIFY"}...),
-							},
-						},
-					},
-				},
+		},
+		"suspend_immediate_triggers": schema.SetAttribute{
+			Description:        "A list of percentage thresholds at which to suspend all warehouses.",
+			Optional:           true,
+			ElementType:        types.Int64Type,
+			DeprecationMessage: "Use suspend_immediate_trigger instead",
+			Validators: []validator.Set{
+				setvalidator.ConflictsWith(path.MatchRoot("suspend_immediate_trigger")),
 			},
 		},
+		"notify_triggers": schema.SetAttribute{
+			Description: "A list of percentage thresholds at which to send an alert to subscribed users.",
+			Optional:    true,
+			ElementType: types.Int64Type,
+		},
+		"set_for_account": schema.BoolAttribute{
+			Description: "Specifies whether the resource monitor should be applied globally to your Snowflake account (defaults to false).",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+		"warehouses": schema.SetAttribute{
+			Description: "A list of warehouses to apply the resource monitor to.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
 	}
-}
-
-type resourceMonitorTriggerModel struct {
-	Threshold     types.Int64  `tfsdk:"threshold"`
-	TriggerAction types.String `tfsdk:"trigger_action"`
-}
-
-func (old *resourceMonitorModelV1) Equals(new *resourceMonitorModelV1, ctx context.Context) bool {
-	if old == nil || new == nil {
-		return false
-	}
-	if !old.Id.Equal(new.Id) {
-		return false
-	}
-	if !old.OrReplace.Equal(new.OrReplace) {
-		return false
-	}
-	if !old.Name.Equal(new.Name) {
-		return false
-	}
-	if !old.CreditQuota.Equal(new.CreditQuota) {
-		return false
-	}
-	if !old.Frequency.Equal(new.Frequency) {
-		return false
-	}
-	if !old.StartTimestamp.Equal(new.StartTimestamp) {
-		return false
-	}
-	if !old.EndTimestamp.Equal(new.EndTimestamp) {
-		return false
-	}
-	if !old.Triggers.Equal(new.Triggers) {
-		return false
-	}
-	if !old.NotifyUsers.Equal(new.NotifyUsers) {
-		return false
+	maps.Copy(attributes, executionContextAttributes())
+	return schema.Schema{
+		Version:    0,
+		Attributes: attributes,
 	}
-
-	return true
 }
 
 func (r *ResourceMonitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -397,18 +177,7 @@ func (r *ResourceMonitorResource) Metadata(ctx context.Context, req resource.Met
 }
 
 func (r *ResourceMonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resourceMonitorSchemaV1()
-}
-
-func (r *ResourceMonitorResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
-	schemaV0 := resourceMonitorSchemaV0()
-	return map[int64]resource.StateUpgrader{
-		// State upgrade implementation from 0 to 1
-		0: {
-			PriorSchema:   &schemaV0,
-			StateUpgrader: upgradeResourceMonitorStateV0toV1,
-		},
-	}
+	resp.Schema = resourceMonitorSchema()
 }
 
 func (r *ResourceMonitorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -418,390 +187,395 @@ func (r *ResourceMonitorResource) Configure(ctx context.Context, req resource.Co
 	}
 
 	providerData, ok := req.ProviderData.(*ProviderData)
-
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sdk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
-
 		return
 	}
 
 	r.client = providerData.client
 }
 
+// ModifyPlan does not modify the plan; it logs the statements the plan is going to run.
 func (r *ResourceMonitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	// we aren't really modifying the plan, just logging what the plan intends to do
-	resp.Plan = req.Plan
-	var plan, state *resourceMonitorModelV1
+	resourceName := "snowflake_resource_monitor"
+	client := sdk.NewDryRunClient()
+	var plan, state *resourceMonitorModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	resourceName := "snowflake_database"
-	// DELETE
-	if req.Plan.Raw.IsNull() {
-		_, readLogs, _ := r.read(ctx, state, true)
-		_, deleteLogs, _ := r.delete(ctx, state, true)
-		deleteLogs = append(deleteLogs, readLogs...)
-		tflog.Debug(ctx, formatSQLPreview(DeleteOperation, resourceName, state.Id.ValueString(), deleteLogs))
-		return
-	}
-
-	// CREATE
-	if plan.Id.IsUnknown() {
-		_, createLogs, _ := r.create(ctx, plan, true)
-		plan.Id = types.StringValue(sdk.NewAccountObjectIdentifier(plan.Name.ValueString()).FullyQualifiedName())
-		_, readLogs, _ := r.read(ctx, plan, true)
-		createLogs = append(createLogs, readLogs...)
-		tflog.Debug(ctx, formatSQLPreview(CreateOperation, resourceName, "", createLogs))
-		return
-	}
 
-	if plan.Equals(state, ctx) {
-		// READ
-		_, logs, _ := r.read(ctx, state, true)
-		tflog.Debug(ctx, formatSQLPreview(ReadOperation, resourceName, state.Id.ValueString(), logs))
-		return
-	} else {
-		// UPDATE
-		_, updateLogs, _ := r.update(ctx, plan, state, true)
-		_, readLogs, _ := r.read(ctx, plan, true)
-		updateLogs = append(updateLogs, readLogs...)
-		tflog.Debug(ctx, formatSQLPreview(UpdateOperation, resourceName, state.Id.ValueString(), updateLogs))
+	switch {
+	case plan == nil:
+		_ = r.delete(ctx, client, state)
+		tflog.Debug(ctx, formatSQLPreview(DeleteOperation, resourceName, state.Id.ValueString(), client.TraceLogs()))
+	case state == nil:
+		_ = r.create(ctx, client, plan)
+		tflog.Debug(ctx, formatSQLPreview(CreateOperation, resourceName, "", client.TraceLogs()))
+	default:
+		_ = r.update(ctx, client, plan, state)
+		tflog.Debug(ctx, formatSQLPreview(UpdateOperation, resourceName, state.Id.ValueString(), client.TraceLogs()))
 	}
 }
 
 func (r *ResourceMonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data *resourceMonitorModelV1
+	var data *resourceMonitorModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-	data, _, diags := r.create(ctx, data, false)
-	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
 
-func (r *ResourceMonitorResource) create(ctx context.Context, data *resourceMonitorModelV1, dryRun bool) (*resourceMonitorModelV1, []string, diag.Diagnostics) {
-	diags := diag.Diagnostics{}
-	client := r.client
-	if dryRun {
-		client = sdk.NewDryRunClient()
+	ctx, endOperation := startOperation(ctx, "snowflake_resource_monitor", data.Name.ValueString(), CreateOperation)
+	defer endOperation(&resp.Diagnostics)
+	client, diags := executionContextClient(ctx, r.client, data.executionContext())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.create(ctx, client, data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	resp.Diagnostics.Append(r.read(ctx, client, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
 
+func (r *ResourceMonitorResource) create(ctx context.Context, client *sdk.Client, data *resourceMonitorModel) diag.Diagnostics {
+	var diags diag.Diagnostics
 	name := data.Name.ValueString()
-
 	id := sdk.NewAccountObjectIdentifier(name)
 
-	opts := &sdk.CreateResourceMonitorOptions{
-		OrReplace: data.OrReplace.ValueBoolPointer(),
+	warehouses := stringElements(ctx, data.Warehouses, &diags)
+	if data.SetForAccount.ValueBool() && len(warehouses) > 0 {
+		diags.AddError("Invalid configuration", fmt.Sprintf("error creating resource monitor %v on account err = set_for_account cannot be true and give warehouses", name))
+		return diags
 	}
 
-	with := &sdk.ResourceMonitorWith{}
-	setWith := false
-	if !data.CreditQuota.IsNull() && !data.CreditQuota.IsUnknown() && data.CreditQuota.ValueFloat64() > 0 {
-		setWith = true
-		with.CreditQuota = sdk.Int(int(data.CreditQuota.ValueFloat64()))
+	with := sdk.ResourceMonitorWith{}
+	if notifyUsers := stringElements(ctx, data.NotifyUsers, &diags); len(notifyUsers) > 0 {
+		with.NotifyUsers = &sdk.NotifyUsers{Users: toNotifiedUsers(notifyUsers)}
+	}
+	if isKnown(data.CreditQuota) && data.CreditQuota.ValueInt64() != 0 {
+		with.CreditQuota = sdk.Int(int(data.CreditQuota.ValueInt64()))
 	}
-	if !data.Frequency.IsNull() && data.Frequency.ValueString() != "" {
-		setWith = true
+	if isKnown(data.Frequency) && data.Frequency.ValueString() != "" {
 		frequency, err := sdk.FrequencyFromString(data.Frequency.ValueString())
 		if err != nil {
-			diags.AddError("Client Error", fmt.Sprintf("Unable to create resource monitor, got error: %s", err))
+			diags.AddError("Invalid configuration", err.Error())
+			return diags
 		}
 		with.Frequency = frequency
 	}
-	if !data.StartTimestamp.IsNull() && data.StartTimestamp.ValueString() != "" {
-		setWith = true
-		with.StartTimestamp = data.StartTimestamp.ValueStringPointer()
+	if isKnown(data.StartTimestamp) && data.StartTimestamp.ValueString() != "" {
+		with.StartTimestamp = sdk.String(data.StartTimestamp.ValueString())
 	}
-
-	if !data.EndTimestamp.IsNull() && data.EndTimestamp.ValueString() != "" {
-		setWith = true
-		with.EndTimestamp = data.EndTimestamp.ValueStringPointer()
-	}
-
-	if !data.NotifyUsers.IsNull() && len(data.NotifyUsers.Elements()) > 0 {
-		setWith = true
-		elements := make([]types.String, 0, len(data.NotifyUsers.Elements()))
-		var notifiedUsers []sdk.NotifiedUser
-		for _, e := range elements {
-			notifiedUsers = append(notifiedUsers, sdk.NotifiedUser{Name: e.ValueString()})
-		}
-		with.NotifyUsers = &sdk.NotifyUsers{
-			Users: notifiedUsers,
-		}
+	if isKnown(data.EndTimestamp) && data.EndTimestamp.ValueString() != "" {
+		with.EndTimestamp = sdk.String(data.EndTimestamp.ValueString())
 	}
-
-	if !data.Triggers.IsNull() && len(data.Triggers.Elements()) > 0 {
-		setWith = true
-		elements := make([]resourceMonitorTriggerModel, 0, len(data.Triggers.Elements()))
-		data.Triggers.ElementsAs(ctx, &elements, false)
-		var triggers []sdk.TriggerDefinition
-		for _, e := range elements {
-			triggers = append(triggers, sdk.TriggerDefinition{
-				Threshold:     int(e.Threshold.ValueInt64()),
-				TriggerAction: sdk.TriggerAction(e.TriggerAction.ValueString()),
-			})
-		}
+	if triggers := collectResourceMonitorTriggers(ctx, data, &diags); len(triggers) > 0 {
 		with.Triggers = triggers
 	}
-
-	if setWith {
-		opts.With = with
+	if diags.HasError() {
+		return diags
 	}
-	err := client.ResourceMonitors.Create(ctx, id, opts)
 
-	if dryRun {
-		return data, client.TraceLogs(), diags
+	opts := &sdk.CreateResourceMonitorOptions{}
+	if !isZeroResourceMonitorWith(with) {
+		opts.With = &with
 	}
-	if err != nil {
-		diags.AddError("Client Error", fmt.Sprintf("Unable to create resource monitor, got error: %s", err))
+	if err := client.ResourceMonitors.Create(ctx, id, opts); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("error creating resource monitor %v err = %s", name, err))
+		return diags
 	}
+	data.Id = types.StringValue(name)
 
-	data.Id = types.StringValue(id.FullyQualifiedName())
-	r.read(ctx, data, false)
-	return data, nil, diags
+	if data.SetForAccount.ValueBool() {
+		if err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{Set: &sdk.AccountSet{ResourceMonitor: id}}); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("error setting resource monitor %v on account err = %s", name, err))
+			return diags
+		}
+	}
+	for _, warehouse := range warehouses {
+		warehouseId := sdk.NewAccountObjectIdentifier(warehouse)
+		if err := client.Warehouses.Alter(ctx, warehouseId, &sdk.AlterWarehouseOptions{Set: &sdk.WarehouseSet{ResourceMonitor: id}}); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("error setting resource monitor %v on warehouse %v err = %s", name, warehouseId.Name(), err))
+			return diags
+		}
+	}
+	return diags
 }
 
 func (r *ResourceMonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data *resourceMonitorModelV1
+	var data *resourceMonitorModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	data, _, diags := r.read(ctx, data, false)
+
+	ctx, endOperation := startOperation(ctx, "snowflake_resource_monitor", data.Id.ValueString(), ReadOperation)
+	defer endOperation(&resp.Diagnostics)
+	client, diags := executionContextClient(ctx, r.client, data.executionContext())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	diags.Append(resp.State.Set(ctx, &data)...)
-}
-
-func (r *ResourceMonitorResource) read(ctx context.Context, data *resourceMonitorModelV1, dryRun bool) (*resourceMonitorModelV1, []string, diag.Diagnostics) {
-	diags := diag.Diagnostics{}
-	client := r.client
-	if dryRun {
-		client = sdk.NewDryRunClient()
+	diags = r.read(ctx, client, data)
+	if data.Id.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
 
-	id := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(data.Id.ValueString())
+// read refreshes the data with the resource monitor returned by Snowflake. The id is set to null if the resource
+// monitor does not exist anymore. Warehouses and the deprecated trigger lists are not returned by Snowflake, so they
+// keep their values.
+func (r *ResourceMonitorResource) read(ctx context.Context, client *sdk.Client, data *resourceMonitorModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	id := sdk.NewAccountObjectIdentifier(data.Id.ValueString())
 	resourceMonitor, err := client.ResourceMonitors.ShowByID(ctx, id)
-	if dryRun {
-		return data, client.TraceLogs(), diags
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		tflog.Warn(ctx, fmt.Sprintf("resource monitor %v not found, removing from state", id.Name()))
+		data.Id = types.StringNull()
+		return diags
 	}
 	if err != nil {
-		diags.AddError("Client Error", fmt.Sprintf("Unable to read database, got error: %s", err))
-		return data, nil, diags
+		diags.AddError("Client Error", fmt.Sprintf("error reading resource monitor %v err = %s", id.Name(), err))
+		return diags
 	}
 
-	data.CreditQuota = types.Float64Value(resourceMonitor.CreditQuota)
+	data.Name = types.StringValue(resourceMonitor.Name)
 	data.Frequency = types.StringValue(string(resourceMonitor.Frequency))
-	switch resourceMonitor.Level {
-	case sdk.ResourceMonitorLevelAccount:
-		data.Level = types.StringValue("ACCOUNT")
-	case sdk.ResourceMonitorLevelWarehouse:
-		data.Level = types.StringValue("WAREHOUSE")
-	case sdk.ResourceMonitorLevelNull:
-		data.Level = types.StringValue("NULL")
-	}
-	data.UsedCredits = types.Float64Value(resourceMonitor.UsedCredits)
-	data.RemainingCredits = types.Float64Value(resourceMonitor.RemainingCredits)
-
-	if resourceMonitor.StartTime != "" {
-		if data.StartTimestamp.ValueString() != "IMMEDIATELY" {
-			data.StartTimestamp = types.StringValue(resourceMonitor.StartTime)
-		}
-	} else {
-		data.StartTimestamp = types.StringNull()
-	}
-	if resourceMonitor.EndTime != "" {
-		data.EndTimestamp = types.StringValue(resourceMonitor.EndTime)
-	}
-	if len(resourceMonitor.NotifyUsers) == 0 {
+	data.StartTimestamp = types.StringValue(resourceMonitor.StartTime)
+	data.EndTimestamp = stringValueOrNull(resourceMonitor.EndTime)
+	// Snowflake returns credit_quota as a float, but only accepts input as an int
+	data.CreditQuota = types.Int64Value(int64(resourceMonitor.CreditQuota))
+	if len(resourceMonitor.NotifyUsers) > 0 {
+		data.NotifyUsers = setValue(ctx, types.StringType, resourceMonitor.NotifyUsers, &diags)
+	} else if !data.NotifyUsers.IsNull() && len(data.NotifyUsers.Elements()) > 0 {
 		data.NotifyUsers = types.SetNull(types.StringType)
-	} else {
-		var notifyUsers []types.String
-		for _, e := range resourceMonitor.NotifyUsers {
-			notifyUsers = append(notifyUsers, types.StringValue(e))
-		}
-		var diag diag.Diagnostics
-		data.NotifyUsers, diag = types.SetValueFrom(ctx, types.StringType, notifyUsers)
-		diags = append(diags, diag...)
-	}
-
-	triggersObjectType := types.ObjectType{}.WithAttributeTypes(map[string]attr.Type{
-		"threshold":      types.Int64Type,
-		"trigger_action": types.StringType,
-	})
-	if len(resourceMonitor.NotifyTriggers) == 0 && resourceMonitor.SuspendAt == nil && resourceMonitor.SuspendImmediateAt == nil {
-		data.Triggers = types.SetNull(triggersObjectType)
-	} else {
-		var triggers []resourceMonitorTriggerModel
-		for _, e := range resourceMonitor.NotifyTriggers {
-			triggers = append(triggers, resourceMonitorTriggerModel{
-				Threshold:     types.Int64Value(int64(e)),
-				TriggerAction: types.StringValue(string(sdk.TriggerActionNotify)),
-			})
-		}
-		if resourceMonitor.SuspendAt != nil {
-			triggers = append(triggers, resourceMonitorTriggerModel{
-				Threshold:     types.Int64Value(int64(*resourceMonitor.SuspendAt)),
-				TriggerAction: types.StringValue(string(sdk.TriggerActionSuspend)),
-			})
-		}
+	}
 
-		var diag diag.Diagnostics
-		data.Triggers, diag = types.SetValueFrom(ctx, triggersObjectType, triggers)
-		diags = append(diags, diag...)
+	// the deprecated lists are reduced to a single trigger, so the single value is only set when the list is not used
+	if data.SuspendTriggers.IsNull() {
+		data.SuspendTrigger = int64ValueOrNull(resourceMonitor.SuspendAt)
+	}
+	if data.SuspendImmediateTriggers.IsNull() {
+		data.SuspendImmediateTrigger = int64ValueOrNull(resourceMonitor.SuspendImmediateAt)
+	}
+	if len(resourceMonitor.NotifyTriggers) > 0 {
+		data.NotifyTriggers = setValue(ctx, types.Int64Type, resourceMonitor.NotifyTriggers, &diags)
+	} else if !data.NotifyTriggers.IsNull() && len(data.NotifyTriggers.Elements()) > 0 {
+		data.NotifyTriggers = types.SetNull(types.Int64Type)
 	}
 
-	data.Id = types.StringValue(id.FullyQualifiedName())
-	return data, nil, diags
+	data.SetForAccount = types.BoolValue(resourceMonitor.Level == sdk.ResourceMonitorLevelAccount)
+	data.Id = types.StringValue(resourceMonitor.Name)
+	return diags
 }
 
 func (r *ResourceMonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state resourceMonitorModelV1
+	var plan, state *resourceMonitorModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data, _, diags := r.update(ctx, &plan, &state, false)
+	ctx, endOperation := startOperation(ctx, "snowflake_resource_monitor", state.Id.ValueString(), UpdateOperation)
+	defer endOperation(&resp.Diagnostics)
+	client, diags := executionContextClient(ctx, r.client, plan.executionContext())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	diags.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.update(ctx, client, plan, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Id = state.Id
+	resp.Diagnostics.Append(r.read(ctx, client, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-func (r *ResourceMonitorResource) update(ctx context.Context, plan *resourceMonitorModelV1, state *resourceMonitorModelV1, dryRun bool) (*resourceMonitorModelV1, []string, diag.Diagnostics) {
-	diags := diag.Diagnostics{}
-	client := r.client
-	if dryRun {
-		client = sdk.NewDryRunClient()
-	}
-	id := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(state.Id.ValueString())
-	opts := &sdk.AlterResourceMonitorOptions{}
-	runUpdate := false
-	if !plan.CreditQuota.Equal(state.CreditQuota) {
-		runUpdate = true
-		if opts.Set == nil {
-			opts.Set = &sdk.ResourceMonitorSet{}
-		}
-		opts.Set.CreditQuota = sdk.Int(int(plan.CreditQuota.ValueFloat64()))
+func (r *ResourceMonitorResource) update(ctx context.Context, client *sdk.Client, plan *resourceMonitorModel, state *resourceMonitorModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	name := plan.Name.ValueString()
+	id := sdk.NewAccountObjectIdentifier(state.Id.ValueString())
+
+	planWarehouses := stringElements(ctx, plan.Warehouses, &diags)
+	stateWarehouses := stringElements(ctx, state.Warehouses, &diags)
+	if plan.SetForAccount.ValueBool() && len(planWarehouses) > 0 {
+		diags.AddError("Invalid configuration", fmt.Sprintf("error creating resource monitor %v on account err = set_for_account cannot be true and give warehouses", name))
+		return diags
 	}
-	if !plan.Frequency.Equal(state.Frequency) {
-		runUpdate = true
-		if opts.Set == nil {
-			opts.Set = &sdk.ResourceMonitorSet{}
-		}
+
+	opts := sdk.AlterResourceMonitorOptions{}
+	set := sdk.ResourceMonitorSet{}
+	if isKnown(plan.CreditQuota) && !plan.CreditQuota.Equal(state.CreditQuota) {
+		set.CreditQuota = sdk.Int(int(plan.CreditQuota.ValueInt64()))
+	}
+	if (isKnown(plan.Frequency) && !plan.Frequency.Equal(state.Frequency)) || (isKnown(plan.StartTimestamp) && !plan.StartTimestamp.Equal(state.StartTimestamp)) {
 		frequency, err := sdk.FrequencyFromString(plan.Frequency.ValueString())
 		if err != nil {
-			diags.AddError("Client Error", fmt.Sprintf("Unable to update resource monitor, got error: %s", err))
-			return plan, nil, diags
+			diags.AddError("Invalid configuration", err.Error())
+			return diags
 		}
-		opts.Set.Frequency = frequency
-		opts.Set.StartTimestamp = plan.StartTimestamp.ValueStringPointer()
+		set.Frequency = frequency
+		set.StartTimestamp = sdk.String(plan.StartTimestamp.ValueString())
+	}
+	if !plan.EndTimestamp.Equal(state.EndTimestamp) {
+		set.EndTimestamp = sdk.String(plan.EndTimestamp.ValueString())
+	}
+	if !plan.NotifyUsers.Equal(state.NotifyUsers) {
+		set.NotifyUsers = &sdk.NotifyUsers{Users: toNotifiedUsers(stringElements(ctx, plan.NotifyUsers, &diags))}
+	}
+	if set != (sdk.ResourceMonitorSet{}) {
+		opts.Set = &set
 	}
-	if !plan.StartTimestamp.Equal(state.StartTimestamp) {
-		runUpdate = true
-		if opts.Set == nil {
-			opts.Set = &sdk.ResourceMonitorSet{}
+
+	// if ANY of the triggers changed, we collect all triggers and set them
+	if !plan.SuspendTrigger.Equal(state.SuspendTrigger) || !plan.SuspendTriggers.Equal(state.SuspendTriggers) ||
+		!plan.SuspendImmediateTrigger.Equal(state.SuspendImmediateTrigger) || !plan.SuspendImmediateTriggers.Equal(state.SuspendImmediateTriggers) ||
+		!plan.NotifyTriggers.Equal(state.NotifyTriggers) {
+		opts.Triggers = collectResourceMonitorTriggers(ctx, plan, &diags)
+		if opts.Triggers == nil {
+			opts.Triggers = []sdk.TriggerDefinition{}
 		}
-		frequency, err := sdk.FrequencyFromString(plan.Frequency.ValueString())
-		if err != nil {
-			diags.AddError("Client Error", fmt.Sprintf("Unable to update resource monitor, got error: %s", err))
-			return plan, nil, diags
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if opts.Set != nil || opts.Triggers != nil {
+		if err := client.ResourceMonitors.Alter(ctx, id, &opts); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("error updating resource monitor %v err = %s", id.Name(), err))
+			return diags
 		}
-		opts.Set.Frequency = frequency
-		opts.Set.StartTimestamp = plan.StartTimestamp.ValueStringPointer()
 	}
-	if !plan.EndTimestamp.Equal(state.EndTimestamp) && plan.EndTimestamp.ValueString() != "" {
-		runUpdate = true
-		if opts.Set == nil {
-			opts.Set = &sdk.ResourceMonitorSet{}
+
+	// remove from account
+	if state.SetForAccount.ValueBool() && !plan.SetForAccount.ValueBool() {
+		if err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{Set: &sdk.AccountSet{ResourceMonitor: sdk.NewAccountObjectIdentifier("NULL")}}); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("error unsetting resource monitor %v on account err = %s", id.Name(), err))
+			return diags
 		}
-		opts.Set.EndTimestamp = plan.EndTimestamp.ValueStringPointer()
 	}
-	if !plan.NotifyUsers.Equal(state.NotifyUsers) {
-		runUpdate = true
-		var notifiedUsers []sdk.NotifiedUser
-		elements := make([]types.String, 0, len(plan.NotifyUsers.Elements()))
-		plan.NotifyUsers.ElementsAs(ctx, &elements, false)
-		for _, e := range elements {
-			notifiedUsers = append(notifiedUsers, sdk.NotifiedUser{Name: e.ValueString()})
+
+	// remove from all old warehouses
+	for _, warehouse := range stateWarehouses {
+		if slices.Contains(planWarehouses, warehouse) {
+			continue
 		}
-		opts.Set.NotifyUsers = &sdk.NotifyUsers{
-			Users: notifiedUsers,
+		warehouseId := sdk.NewAccountObjectIdentifier(warehouse)
+		if err := client.Warehouses.Alter(ctx, warehouseId, &sdk.AlterWarehouseOptions{Unset: &sdk.WarehouseUnset{ResourceMonitor: sdk.Bool(true)}}); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("error unsetting resource monitor %v on warehouse %v err = %s", name, warehouseId.Name(), err))
+			return diags
 		}
 	}
 
-	if !plan.Triggers.Equal(state.Triggers) {
-		runUpdate = true
-		var triggers []sdk.TriggerDefinition
-		elements := make([]resourceMonitorTriggerModel, 0, len(plan.Triggers.Elements()))
-		plan.Triggers.ElementsAs(ctx, &elements, false)
-		for _, e := range elements {
-			triggers = append(triggers, sdk.TriggerDefinition{
-				Threshold:     int(e.Threshold.ValueInt64()),
-				TriggerAction: sdk.TriggerAction(e.TriggerAction.ValueString()),
-			})
+	// add to account
+	if !state.SetForAccount.ValueBool() && plan.SetForAccount.ValueBool() {
+		if err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{Set: &sdk.AccountSet{ResourceMonitor: id}}); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("error setting resource monitor %v on account err = %s", name, err))
+			return diags
 		}
-		opts.Triggers = triggers
 	}
 
-	if runUpdate {
-		err := client.ResourceMonitors.Alter(ctx, id, opts)
-		if dryRun {
-			return plan, client.TraceLogs(), diags
+	// add to all new warehouses
+	for _, warehouse := range planWarehouses {
+		if slices.Contains(stateWarehouses, warehouse) {
+			continue
 		}
-		if err != nil {
-			diags.AddError("Client Error", fmt.Sprintf("Unable to update resource monitor, got error: %s", err))
-			return plan, nil, diags
+		warehouseId := sdk.NewAccountObjectIdentifier(warehouse)
+		if err := client.Warehouses.Alter(ctx, warehouseId, &sdk.AlterWarehouseOptions{Set: &sdk.WarehouseSet{ResourceMonitor: id}}); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("error setting resource monitor %v on warehouse %v err = %s", name, warehouseId.Name(), err))
+			return diags
 		}
 	}
-	data, _, readDiags := r.read(ctx, plan, false)
-	diags.Append(readDiags...)
-	return data, nil, diags
+	return diags
 }
 
 func (r *ResourceMonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data *resourceMonitorModelV1
+	var data *resourceMonitorModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	_, _, diags := r.delete(ctx, data, false)
+	ctx, endOperation := startOperation(ctx, "snowflake_resource_monitor", data.Id.ValueString(), DeleteOperation)
+	defer endOperation(&resp.Diagnostics)
+	client, diags := executionContextClient(ctx, r.client, data.executionContext())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(r.delete(ctx, client, data)...)
 }
 
-func (r *ResourceMonitorResource) delete(ctx context.Context, data *resourceMonitorModelV1, dryRun bool) (*resourceMonitorModelV1, []string, diag.Diagnostics) {
-	client := r.client
-	if dryRun {
-		client = sdk.NewDryRunClient()
+func (r *ResourceMonitorResource) delete(ctx context.Context, client *sdk.Client, data *resourceMonitorModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	id := sdk.NewAccountObjectIdentifier(data.Id.ValueString())
+	if err := client.ResourceMonitors.Drop(ctx, id); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("error deleting resource monitor %v err = %s", id.Name(), err))
 	}
+	return diags
+}
+
+func (r *ResourceMonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
 
-	diags := diag.Diagnostics{}
-	id := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(data.Id.ValueString())
-	err := client.ResourceMonitors.Drop(ctx, id)
-	if dryRun {
-		return data, client.TraceLogs(), diags
+// collectResourceMonitorTriggers reduces the deprecated trigger lists to the lowest threshold, as Snowflake allows only
+// one suspend and one suspend immediate trigger.
+func collectResourceMonitorTriggers(ctx context.Context, data *resourceMonitorModel, diags *diag.Diagnostics) []sdk.TriggerDefinition {
+	var triggers []sdk.TriggerDefinition
+	lowestThreshold := func(single types.Int64, list types.Set) *int {
+		var threshold *int
+		if isKnown(single) {
+			threshold = sdk.Int(int(single.ValueInt64()))
+		}
+		for _, v := range int64Elements(ctx, list, diags) {
+			if threshold == nil || *threshold > int(v) {
+				threshold = sdk.Int(int(v))
+			}
+		}
+		return threshold
 	}
-	if err != nil {
-		diags.AddError("Client Error", fmt.Sprintf("Unable to delete database, got error: %s", err))
-		return data, nil, diags
+	if threshold := lowestThreshold(data.SuspendTrigger, data.SuspendTriggers); threshold != nil {
+		triggers = append(triggers, sdk.TriggerDefinition{Threshold: *threshold, TriggerAction: sdk.TriggerActionSuspend})
+	}
+	if threshold := lowestThreshold(data.SuspendImmediateTrigger, data.SuspendImmediateTriggers); threshold != nil {
+		triggers = append(triggers, sdk.TriggerDefinition{Threshold: *threshold, TriggerAction: sdk.TriggerActionSuspendImmediate})
+	}
+	for _, v := range int64Elements(ctx, data.NotifyTriggers, diags) {
+		triggers = append(triggers, sdk.TriggerDefinition{Threshold: int(v), TriggerAction: sdk.TriggerActionNotify})
 	}
-	return data, nil, diags
+	return triggers
 }
 
-func (r *ResourceMonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func isZeroResourceMonitorWith(with sdk.ResourceMonitorWith) bool {
+	return with.CreditQuota == nil && with.Frequency == nil && with.StartTimestamp == nil && with.EndTimestamp == nil &&
+		with.NotifyUsers == nil && len(with.Triggers) == 0
+}
+
+func toNotifiedUsers(names []string) []sdk.NotifiedUser {
+	users := make([]sdk.NotifiedUser, len(names))
+	for i, name := range names {
+		users[i] = sdk.NotifiedUser{Name: name}
+	}
+	return users
 }
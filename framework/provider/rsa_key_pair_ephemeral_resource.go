@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/youmark/pkcs8"
+)
+
+const defaultRsaKeySize = 2048
+
+var _ ephemeral.EphemeralResource = &RsaKeyPairEphemeralResource{}
+
+func NewRsaKeyPairEphemeralResource() ephemeral.EphemeralResource {
+	return &RsaKeyPairEphemeralResource{}
+}
+
+// RsaKeyPairEphemeralResource generates an RSA key pair for the key-pair authentication locally. It does not connect
+// to Snowflake.
+type RsaKeyPairEphemeralResource struct{}
+
+type rsaKeyPairModel struct {
+	KeySize              types.Int64  `tfsdk:"key_size"`
+	Passphrase           types.String `tfsdk:"passphrase"`
+	PrivateKeyPem        types.String `tfsdk:"private_key_pem"`
+	PublicKeyPem         types.String `tfsdk:"public_key_pem"`
+	PublicKey            types.String `tfsdk:"public_key"`
+	PublicKeyFingerprint types.String `tfsdk:"public_key_fingerprint"`
+}
+
+func (r *RsaKeyPairEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rsa_key_pair"
+}
+
+func (r *RsaKeyPairEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates an RSA key pair for the [key-pair authentication](https://docs.snowflake.com/en/user-guide/key-pair-auth). A new key pair is generated on every open and it is not stored in the plan or the state, so it should be passed to write-only attributes (e.g. `rsa_public_key_wo` of `snowflake_user`) or to external secret stores.",
+		Attributes: map[string]schema.Attribute{
+			"key_size": schema.Int64Attribute{
+				Description: fmt.Sprintf("Size of the key in bits. Valid values are 2048, 3072 and 4096. Defaults to %d.", defaultRsaKeySize),
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(2048, 3072, 4096),
+				},
+			},
+			"passphrase": schema.StringAttribute{
+				Description: "Passphrase used to encrypt the private key. When set, the private key is an encrypted PKCS #8 key.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"private_key_pem": schema.StringAttribute{
+				Description: "The private key in the PKCS #8 PEM format.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"public_key_pem": schema.StringAttribute{
+				Description: "The public key in the PEM format.",
+				Computed:    true,
+			},
+			"public_key": schema.StringAttribute{
+				Description: "The public key on one line without the header and the trailer, as expected by Snowflake.",
+				Computed:    true,
+			},
+			"public_key_fingerprint": schema.StringAttribute{
+				Description: "The fingerprint of the public key, in the same format as the `RSA_PUBLIC_KEY_FP` property of a user.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *RsaKeyPairEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data rsaKeyPairModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.KeySize.IsNull() || data.KeySize.IsUnknown() {
+		data.KeySize = types.Int64Value(defaultRsaKeySize)
+	}
+	keyPair, err := generateRsaKeyPair(int(data.KeySize.ValueInt64()), data.Passphrase.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Key Generation Error", err.Error())
+		return
+	}
+	data.PrivateKeyPem = types.StringValue(keyPair.privateKeyPem)
+	data.PublicKeyPem = types.StringValue(keyPair.publicKeyPem)
+	data.PublicKey = types.StringValue(keyPair.publicKey)
+	data.PublicKeyFingerprint = types.StringValue(keyPair.publicKeyFingerprint)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+type rsaKeyPair struct {
+	privateKeyPem        string
+	publicKeyPem         string
+	publicKey            string
+	publicKeyFingerprint string
+}
+
+func generateRsaKeyPair(keySize int, passphrase string) (*rsaKeyPair, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, keySize)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate the private key: %w", err)
+	}
+
+	var password []byte
+	privateKeyType := "PRIVATE KEY"
+	if passphrase != "" {
+		password = []byte(passphrase)
+		privateKeyType = "ENCRYPTED PRIVATE KEY"
+	}
+	privateKeyBytes, err := pkcs8.MarshalPrivateKey(privateKey, password, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal the private key: %w", err)
+	}
+
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal the public key: %w", err)
+	}
+	publicKeyPem := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyBytes}))
+	fingerprint := sha256.Sum256(publicKeyBytes)
+
+	return &rsaKeyPair{
+		privateKeyPem:        string(pem.EncodeToMemory(&pem.Block{Type: privateKeyType, Bytes: privateKeyBytes})),
+		publicKeyPem:         publicKeyPem,
+		publicKey:            base64.StdEncoding.EncodeToString(publicKeyBytes),
+		publicKeyFingerprint: "SHA256:" + base64.StdEncoding.EncodeToString(fingerprint[:]),
+	}, nil
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &ScimAccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &ScimAccessTokenEphemeralResource{}
+)
+
+func NewScimAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &ScimAccessTokenEphemeralResource{}
+}
+
+// ScimAccessTokenEphemeralResource replaces the snowflake_system_generate_scim_access_token data source. The token is
+// generated on every open and is never written to the plan or the state.
+type ScimAccessTokenEphemeralResource struct {
+	client *sdk.Client
+}
+
+type scimAccessTokenModel struct {
+	IntegrationName types.String `tfsdk:"integration_name"`
+	AccessToken     types.String `tfsdk:"access_token"`
+}
+
+func (r *ScimAccessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scim_access_token"
+}
+
+func (r *ScimAccessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a new access token for a SCIM security integration with [SYSTEM$GENERATE_SCIM_ACCESS_TOKEN](https://docs.snowflake.com/en/sql-reference/functions/system_generate_scim_access_token). The token is not stored in the plan or the state.",
+		Attributes: map[string]schema.Attribute{
+			"integration_name": schema.StringAttribute{
+				Description: "Name of the SCIM security integration.",
+				Required:    true,
+			},
+			"access_token": schema.StringAttribute{
+				Description: "The generated SCIM access token. It is valid for six months.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *ScimAccessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+}
+
+func (r *ScimAccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data scimAccessTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := sdk.NewAccountObjectIdentifier(data.IntegrationName.ValueString())
+	ctx, endOperation := startOperation(ctx, "snowflake_scim_access_token", id.Name(), OpenOperation)
+	defer endOperation(&resp.Diagnostics)
+	accessToken, err := r.client.SystemFunctions.GenerateSCIMAccessToken(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("error generating SCIM access token for integration %v err = %s", id.Name(), err))
+		return
+	}
+	data.AccessToken = types.StringValue(accessToken)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
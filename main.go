@@ -2,15 +2,17 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"flag"
 	"log"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/framework/provider"
 	oldprovider "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/tracing"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
-	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
-	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 )
 
 var version string = "dev" // goreleaser can pass other information to the main package, such as the specific commit
@@ -24,23 +26,13 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	upgradedSdkServer, err := tf5to6server.UpgradeServer(
-		ctx,
-		oldprovider.Provider().GRPCProvider,
-	)
+	shutdownTracing, err := tracing.Setup(ctx, version)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	providers := []func() tfprotov6.ProviderServer{
-		// disabled until ready to start using
-		//	providerserver.NewProtocol6(provider.New(version)()),
-		func() tfprotov6.ProviderServer {
-			return upgradedSdkServer
-		},
-	}
-
-	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	sdkProvider := oldprovider.Provider()
+	muxServer, err := provider.NewMuxServer(ctx, version, sdkProvider)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -53,9 +45,18 @@ func main() {
 
 	err = tf6server.Serve(
 		"registry.terraform.io/Snowflake-Labs/snowflake",
-		muxServer.ProviderServer,
+		func() tfprotov6.ProviderServer {
+			return muxServer
+		},
 		serveOpts...,
 	)
+	// Serve returns when Terraform stops the provider, i.e. at the end of the plan or apply
+	if db, ok := sdkProvider.Meta().(*sql.DB); ok && db != nil {
+		sdk.LogConnectionPoolStats(db)
+	}
+	if shutdownErr := shutdownTracing(ctx); shutdownErr != nil {
+		log.Printf("[WARN] could not flush the traces: %v", shutdownErr)
+	}
 
 	if err != nil {
 		log.Fatal(err)
@@ -7,10 +7,10 @@ import (
 	"sync"
 	"testing"
 
+	frameworkprovider "github.com/Snowflake-Labs/terraform-provider-snowflake/framework/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
-	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 )
@@ -26,9 +26,10 @@ var TestAccProvider *schema.Provider
 
 var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"snowflake": func() (tfprotov6.ProviderServer, error) {
-		return tf5to6server.UpgradeServer(
+		return frameworkprovider.NewMuxServer(
 			context.Background(),
-			TestAccProvider.GRPCProvider,
+			"test",
+			TestAccProvider,
 		)
 	},
 }
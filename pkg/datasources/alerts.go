@@ -7,6 +7,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -75,16 +76,15 @@ var alertsSchema = map[string]*schema.Schema{
 // Alerts Snowflake Roles resource.
 func Alerts() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadAlerts,
-		Schema: alertsSchema,
+		ReadContext: ReadAlerts,
+		Schema:      alertsSchema,
 	}
 }
 
 // ReadAlerts Reads the database metadata information.
-func ReadAlerts(d *schema.ResourceData, meta interface{}) error {
+func ReadAlerts(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	d.SetId("alerts_read")
 
@@ -114,7 +114,7 @@ func ReadAlerts(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		log.Printf("[DEBUG] failed to list alerts in schema (%s)", d.Id())
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 
 	alerts := make([]map[string]any, 0, len(listAlerts))
@@ -127,7 +127,7 @@ func ReadAlerts(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("alerts", alerts); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
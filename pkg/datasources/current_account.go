@@ -7,6 +7,7 @@ import (
 	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -33,15 +34,14 @@ var currentAccountSchema = map[string]*schema.Schema{
 // CurrentAccount the Snowflake current account resource.
 func CurrentAccount() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadCurrentAccount,
-		Schema: currentAccountSchema,
+		ReadContext: ReadCurrentAccount,
+		Schema:      currentAccountSchema,
 	}
 }
 
 // ReadCurrentAccount read the current snowflake account information.
-func ReadCurrentAccount(d *schema.ResourceData, meta interface{}) error {
+func ReadCurrentAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	current, err := client.ContextFunctions.CurrentSessionDetails(ctx)
@@ -54,11 +54,11 @@ func ReadCurrentAccount(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(fmt.Sprintf("%s.%s", current.Account, current.Region))
 	accountErr := d.Set("account", current.Account)
 	if accountErr != nil {
-		return accountErr
+		return diag.FromErr(accountErr)
 	}
 	regionErr := d.Set("region", current.Region)
 	if regionErr != nil {
-		return regionErr
+		return diag.FromErr(regionErr)
 	}
 	url, err := current.AccountURL()
 	if err != nil {
@@ -68,7 +68,7 @@ func ReadCurrentAccount(d *schema.ResourceData, meta interface{}) error {
 
 	urlErr := d.Set("url", url)
 	if urlErr != nil {
-		return urlErr
+		return diag.FromErr(urlErr)
 	}
 	return nil
 }
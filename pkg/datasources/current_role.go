@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,14 +20,13 @@ var currentRoleSchema = map[string]*schema.Schema{
 
 func CurrentRole() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadCurrentRole,
-		Schema: currentRoleSchema,
+		ReadContext: ReadCurrentRole,
+		Schema:      currentRoleSchema,
 	}
 }
 
-func ReadCurrentRole(d *schema.ResourceData, meta interface{}) error {
+func ReadCurrentRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	role, err := client.ContextFunctions.CurrentRole(ctx)
@@ -39,7 +39,7 @@ func ReadCurrentRole(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(role)
 	err = d.Set("name", role)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
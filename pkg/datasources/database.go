@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -51,49 +52,48 @@ var databaseSchema = map[string]*schema.Schema{
 // Database the Snowflake Database resource.
 func Database() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadDatabase,
-		Schema: databaseSchema,
+		ReadContext: ReadDatabase,
+		Schema:      databaseSchema,
 	}
 }
 
 // ReadDatabase read the database meta-data information.
-func ReadDatabase(d *schema.ResourceData, meta interface{}) error {
+func ReadDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	name := d.Get("name").(string)
 	id := sdk.NewAccountObjectIdentifier(name)
 	database, err := client.Databases.ShowByID(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(database.Name)
 	if err := d.Set("name", database.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("comment", database.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("owner", database.Owner); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("is_default", database.IsDefault); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("is_current", database.IsCurrent); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("origin", database.Origin); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("retention_time", database.RetentionTime); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("created_on", database.CreatedOn.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("options", database.Options); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -44,26 +45,25 @@ var databaseRolesSchema = map[string]*schema.Schema{
 // DatabaseRoles Snowflake Database Roles resource.
 func DatabaseRoles() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadDatabaseRoles,
-		Schema: databaseRolesSchema,
+		ReadContext: ReadDatabaseRoles,
+		Schema:      databaseRolesSchema,
 	}
 }
 
 // ReadDatabaseRoles Reads the database metadata information.
-func ReadDatabaseRoles(d *schema.ResourceData, meta interface{}) error {
+func ReadDatabaseRoles(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 	d.SetId("database_roles_read")
 
 	databaseName := d.Get("database").(string)
 
-	ctx := context.Background()
 	showRequest := sdk.NewShowDatabaseRoleRequest(sdk.NewAccountObjectIdentifier(databaseName))
 	extractedDatabaseRoles, err := client.DatabaseRoles.Show(ctx, showRequest)
 	if err != nil {
 		log.Printf("[DEBUG] unable to show database roles in db (%s)", databaseName)
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 
 	databaseRoles := make([]map[string]any, 0, len(extractedDatabaseRoles))
@@ -77,5 +77,5 @@ func ReadDatabaseRoles(d *schema.ResourceData, meta interface{}) error {
 		databaseRoles = append(databaseRoles, databaseRoleMap)
 	}
 
-	return d.Set("database_roles", databaseRoles)
+	return diag.FromErr(d.Set("database_roles", databaseRoles))
 }
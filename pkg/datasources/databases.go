@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -98,16 +99,15 @@ var databasesSchema = map[string]*schema.Schema{
 // Databases the Snowflake current account resource.
 func Databases() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadDatabases,
-		Schema: databasesSchema,
+		ReadContext: ReadDatabases,
+		Schema:      databasesSchema,
 	}
 }
 
 // ReadDatabases read the current snowflake account information.
-func ReadDatabases(d *schema.ResourceData, meta interface{}) error {
+func ReadDatabases(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	opts := sdk.ShowDatabasesOptions{}
 	if terse, ok := d.GetOk("terse"); ok {
 		opts.Terse = sdk.Bool(terse.(bool))
@@ -125,7 +125,7 @@ func ReadDatabases(d *schema.ResourceData, meta interface{}) error {
 	}
 	databases, err := client.Databases.Show(ctx, &opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("databases_read")
 	flattenedDatabases := []map[string]interface{}{}
@@ -144,7 +144,7 @@ func ReadDatabases(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("databases", flattenedDatabases)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
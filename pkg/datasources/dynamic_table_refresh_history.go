@@ -0,0 +1,172 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var dynamicTableRefreshHistorySchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database in which the dynamic table is.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema in which the dynamic table is.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the dynamic table.",
+	},
+	"result_limit": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntBetween(1, 10000),
+		Description:  "The maximum number of rows returned. Snowflake returns 100 rows by default.",
+	},
+	"error_only": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "If true, only refreshes that failed or were cancelled are returned.",
+	},
+	"latest_data_timestamp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Data timestamp of the most recent successful refresh. Empty if there is no successful refresh in the returned history.",
+	},
+	"refresh_history": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The list of refreshes of the dynamic table.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"state": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Status of the refresh: SCHEDULED, EXECUTING, SUCCEEDED, FAILED, CANCELLED or UPSTREAM_FAILED.",
+				},
+				"state_code": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Code representing the current state of the refresh.",
+				},
+				"state_message": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Description of the current state of the refresh.",
+				},
+				"query_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "ID of the SQL statement that produced the results for the dynamic table.",
+				},
+				"data_timestamp": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Transactional timestamp when the refresh was evaluated.",
+				},
+				"refresh_start_time": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Time when the refresh job started.",
+				},
+				"refresh_end_time": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Time when the refresh completed.",
+				},
+				"completion_target": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Time by which the refresh should complete to keep lag under the target lag.",
+				},
+				"refresh_action": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Describes the type of refresh action performed: NO_DATA, REINITIALIZE, FULL or INCREMENTAL.",
+				},
+				"refresh_trigger": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Describes the trigger of the refresh: SCHEDULED, MANUAL or CREATION.",
+				},
+			},
+		},
+	},
+}
+
+// DynamicTableRefreshHistory returns a data source listing the refreshes of a dynamic table.
+func DynamicTableRefreshHistory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadDynamicTableRefreshHistory,
+		Schema:      dynamicTableRefreshHistorySchema,
+	}
+}
+
+func ReadDynamicTableRefreshHistory(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	request := sdk.NewDynamicTableRefreshHistoryRequest(id)
+	if v, ok := d.GetOk("result_limit"); ok {
+		request.WithResultLimit(sdk.Int(v.(int)))
+	}
+	if d.Get("error_only").(bool) {
+		request.WithErrorOnly(sdk.Bool(true))
+	}
+
+	history, err := client.DynamicTables.RefreshHistory(ctx, request)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading refresh history of dynamic table %s: %w", id.FullyQualifiedName(), err))
+	}
+
+	var latest *time.Time
+	records := make([]map[string]any, 0, len(history))
+	for _, refresh := range history {
+		refresh := refresh
+		if refresh.State == sdk.DynamicTableRefreshStateSucceeded && (latest == nil || refresh.DataTimestamp.After(*latest)) {
+			latest = &refresh.DataTimestamp
+		}
+		records = append(records, map[string]any{
+			"state":              string(refresh.State),
+			"state_code":         refresh.StateCode,
+			"state_message":      refresh.StateMessage,
+			"query_id":           refresh.QueryId,
+			"data_timestamp":     refresh.DataTimestamp.Format(time.RFC3339),
+			"refresh_start_time": formatOptionalTime(refresh.RefreshStartTime),
+			"refresh_end_time":   formatOptionalTime(refresh.RefreshEndTime),
+			"completion_target":  formatOptionalTime(refresh.CompletionTarget),
+			"refresh_action":     refresh.RefreshAction,
+			"refresh_trigger":    string(refresh.RefreshTrigger),
+		})
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(id))
+	if err := d.Set("refresh_history", records); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("latest_data_timestamp", formatOptionalTime(latest)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,74 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_DynamicTableRefreshHistory(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	dataSourceName := "data.snowflake_dynamic_table_refresh_history.h"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: dynamicTableRefreshHistoryConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", name),
+					resource.TestCheckResourceAttrSet(dataSourceName, "refresh_history.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "refresh_history.*", map[string]string{
+						"state":           string(sdk.DynamicTableRefreshStateSucceeded),
+						"refresh_trigger": string(sdk.DynamicTableRefreshTriggerCreation),
+					}),
+					resource.TestCheckResourceAttrSet(dataSourceName, "latest_data_timestamp"),
+				),
+			},
+		},
+	})
+}
+
+func dynamicTableRefreshHistoryConfig(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "t" {
+  database        = "%[1]s"
+  schema          = "%[2]s"
+  name            = "%[3]s_TABLE"
+  change_tracking = true
+  column {
+    name = "id"
+    type = "NUMBER(38,0)"
+  }
+}
+
+resource "snowflake_dynamic_table" "dt" {
+  depends_on = [snowflake_table.t]
+  database   = "%[1]s"
+  schema     = "%[2]s"
+  name       = "%[3]s"
+  target_lag {
+    maximum_duration = "2 minutes"
+  }
+  warehouse = "%[4]s"
+  query     = "select \"id\" from \"%[1]s\".\"%[2]s\".\"%[3]s_TABLE\""
+}
+
+data "snowflake_dynamic_table_refresh_history" "h" {
+  database = snowflake_dynamic_table.dt.database
+  schema   = snowflake_dynamic_table.dt.schema
+  name     = snowflake_dynamic_table.dt.name
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, acc.TestWarehouseName)
+}
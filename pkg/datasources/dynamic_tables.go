@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -193,13 +194,13 @@ var dynamicTablesSchema = map[string]*schema.Schema{
 // DynamicTables Snowflake Dynamic Tables resource.
 func DynamicTables() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadDynamicTables,
-		Schema: dynamicTablesSchema,
+		ReadContext: ReadDynamicTables,
+		Schema:      dynamicTablesSchema,
 	}
 }
 
 // ReadDynamicTables Reads the dynamic tables metadata information.
-func ReadDynamicTables(d *schema.ResourceData, meta interface{}) error {
+func ReadDynamicTables(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 	request := sdk.NewShowDynamicTableRequest()
@@ -248,11 +249,11 @@ func ReadDynamicTables(d *schema.ResourceData, meta interface{}) error {
 		request.WithLimit(&limit)
 	}
 
-	dts, err := client.DynamicTables.Show(context.Background(), request)
+	dts, err := client.DynamicTables.Show(ctx, request)
 	if err != nil {
 		log.Printf("[DEBUG] snowflake_dynamic_tables.go: %v", err)
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("dynamic_tables")
 	records := make([]map[string]any, 0, len(dts))
@@ -287,7 +288,7 @@ func ReadDynamicTables(d *schema.ResourceData, meta interface{}) error {
 		records = append(records, record)
 	}
 	if err := d.Set("records", records); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
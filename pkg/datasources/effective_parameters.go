@@ -0,0 +1,120 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var parameterObjectTypes = []string{
+	string(sdk.ObjectTypeAccount),
+	string(sdk.ObjectTypeUser),
+	string(sdk.ObjectTypeWarehouse),
+	string(sdk.ObjectTypeDatabase),
+	string(sdk.ObjectTypeSchema),
+	string(sdk.ObjectTypeTable),
+	string(sdk.ObjectTypeTask),
+}
+
+var effectiveParametersSchema = map[string]*schema.Schema{
+	"object_type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  fmt.Sprintf("Type of the object to show parameters for. Valid values are: %s.", strings.Join(parameterObjectTypes, " | ")),
+		ValidateFunc: validation.StringInSlice(parameterObjectTypes, true),
+	},
+	"object_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Fully qualified name of the object to show parameters for (e.g. `database.schema.table`). Has to be omitted for ACCOUNT.",
+	},
+	"parameters": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "All parameters available for the object with their effective values.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the parameter.",
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The effective value of the parameter.",
+				},
+				"default": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The default value of the parameter.",
+				},
+				"level": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The level at which the effective value is set (e.g. ACCOUNT, DATABASE). Empty if the default value is used.",
+				},
+				"is_set_on_object": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "True if the effective value is set directly on the object, false if it is inherited or default.",
+				},
+				"description": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The description of the parameter.",
+				},
+			},
+		},
+	},
+}
+
+// EffectiveParameters returns a data source listing every parameter of an object with the level it is inherited from.
+func EffectiveParameters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadEffectiveParameters,
+		Schema:      effectiveParametersSchema,
+	}
+}
+
+func ReadEffectiveParameters(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+
+	objectType := sdk.ObjectType(strings.ToUpper(d.Get("object_type").(string)))
+	objectName := d.Get("object_name").(string)
+	object, err := helpers.DecodeParameterObject(objectType, objectName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parameters, err := client.Parameters.ShowParametersForObject(ctx, object)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing parameters for %s %s: %w", objectType, objectName, err))
+	}
+
+	params := make([]map[string]any, 0, len(parameters))
+	for _, parameter := range parameters {
+		params = append(params, map[string]any{
+			"key":              parameter.Key,
+			"value":            parameter.Value,
+			"default":          parameter.Default,
+			"level":            string(parameter.Level),
+			"is_set_on_object": string(parameter.Level) == string(objectType),
+			"description":      parameter.Description,
+		})
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(string(objectType), objectName))
+	if err := d.Set("parameters", params); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
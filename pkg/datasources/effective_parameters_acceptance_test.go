@@ -0,0 +1,75 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_EffectiveParameters(t *testing.T) {
+	databaseName := "TEST_DB_" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schemaName := "TEST_SCHEMA_" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: effectiveParametersConfig(databaseName, schemaName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_effective_parameters.p", "object_type", "SCHEMA"),
+					resource.TestCheckResourceAttrSet("data.snowflake_effective_parameters.p", "parameters.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.snowflake_effective_parameters.p", "parameters.*", map[string]string{
+						"key":              "DATA_RETENTION_TIME_IN_DAYS",
+						"value":            "5",
+						"level":            "DATABASE",
+						"is_set_on_object": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.snowflake_effective_parameters.p", "parameters.*", map[string]string{
+						"key":              "MAX_DATA_EXTENSION_TIME_IN_DAYS",
+						"value":            "10",
+						"level":            "SCHEMA",
+						"is_set_on_object": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func effectiveParametersConfig(databaseName string, schemaName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "d" {
+	name                        = "%[1]s"
+	data_retention_time_in_days = 5
+}
+
+resource "snowflake_schema" "s" {
+	database = snowflake_database.d.name
+	name     = "%[2]s"
+}
+
+resource "snowflake_parameter_set" "s" {
+	object_type = "SCHEMA"
+	object_name = "${snowflake_database.d.name}.${snowflake_schema.s.name}"
+	parameters = {
+		MAX_DATA_EXTENSION_TIME_IN_DAYS = "10"
+	}
+}
+
+data "snowflake_effective_parameters" "p" {
+	depends_on  = [snowflake_parameter_set.s]
+	object_type = "SCHEMA"
+	object_name = "${snowflake_database.d.name}.${snowflake_schema.s.name}"
+}
+`, databaseName, schemaName)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -53,14 +54,13 @@ var externalTablesSchema = map[string]*schema.Schema{
 
 func ExternalTables() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadExternalTables,
-		Schema: externalTablesSchema,
+		ReadContext: ReadExternalTables,
+		Schema:      externalTablesSchema,
 	}
 }
 
-func ReadExternalTables(d *schema.ResourceData, meta interface{}) error {
+func ReadExternalTables(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
@@ -86,5 +86,5 @@ func ReadExternalTables(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(helpers.EncodeSnowflakeID(schemaId))
 
-	return d.Set("external_tables", externalTablesObjects)
+	return diag.FromErr(d.Set("external_tables", externalTablesObjects))
 }
@@ -51,7 +51,9 @@ func externalTables(databaseName string, schemaName string, stageName string, ex
 
 	resource "snowflake_stage" "test" {
 		name = "%v"
-		url = "s3://snowflake-workshop-lab/weather-nyc"
+		s3 {
+			url = "s3://snowflake-workshop-lab/weather-nyc"
+		}
 		database = snowflake_database.test.name
 		schema = snowflake_schema.test.name
 		comment = "Terraform acceptance test"
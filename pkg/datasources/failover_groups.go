@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -116,16 +117,15 @@ var failoverGroupsSchema = map[string]*schema.Schema{
 // FailoverGroups Snowflake FailoverGroups resource.
 func FailoverGroups() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadFailoverGroups,
-		Schema: failoverGroupsSchema,
+		ReadContext: ReadFailoverGroups,
+		Schema:      failoverGroupsSchema,
 	}
 }
 
 // ReadFailoverGroups lists failover groups.
-func ReadFailoverGroups(d *schema.ResourceData, meta interface{}) error {
+func ReadFailoverGroups(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	inAccount := d.Get("in_account").(string)
 	opts := sdk.ShowFailoverGroupOptions{}
@@ -134,7 +134,7 @@ func ReadFailoverGroups(d *schema.ResourceData, meta interface{}) error {
 	}
 	failoverGroups, err := client.FailoverGroups.Show(ctx, &opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("failover_groups")
 	failoverGroupsFlatten := []map[string]interface{}{}
@@ -173,7 +173,7 @@ func ReadFailoverGroups(d *schema.ResourceData, meta interface{}) error {
 		failoverGroupsFlatten = append(failoverGroupsFlatten, m)
 	}
 	if err := d.Set("failover_groups", failoverGroupsFlatten); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -55,15 +56,14 @@ var fileFormatsSchema = map[string]*schema.Schema{
 
 func FileFormats() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadFileFormats,
-		Schema: fileFormatsSchema,
+		ReadContext: ReadFileFormats,
+		Schema:      fileFormatsSchema,
 	}
 }
 
-func ReadFileFormats(d *schema.ResourceData, meta interface{}) error {
+func ReadFileFormats(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
@@ -75,7 +75,7 @@ func ReadFileFormats(d *schema.ResourceData, meta interface{}) error {
 	})
 	if err != nil {
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 
 	fileFormats := []map[string]interface{}{}
@@ -93,5 +93,5 @@ func ReadFileFormats(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
-	return d.Set("file_formats", fileFormats)
+	return diag.FromErr(d.Set("file_formats", fileFormats))
 }
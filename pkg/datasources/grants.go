@@ -1,9 +1,11 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -249,12 +251,12 @@ var grantsSchema = map[string]*schema.Schema{
 
 func Grants() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadGrants,
-		Schema: grantsSchema,
+		ReadContext: ReadGrants,
+		Schema:      grantsSchema,
 	}
 }
 
-func ReadGrants(d *schema.ResourceData, meta interface{}) error {
+func ReadGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 
 	var grantDetails []snowflake.GrantDetail
@@ -266,14 +268,14 @@ func ReadGrants(d *schema.ResourceData, meta interface{}) error {
 		account := grantsOn["account"].(bool)
 
 		if account {
-			grantDetails, err = snowflake.ShowGrantsOnAccount(db)
+			grantDetails, err = snowflake.ShowGrantsOnAccount(ctx, db)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		} else if objectType != "" && objectName != "" {
-			grantDetails, err = snowflake.ShowGrantsOn(db, objectType, objectName)
+			grantDetails, err = snowflake.ShowGrantsOn(ctx, db, objectType, objectName)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -282,23 +284,23 @@ func ReadGrants(d *schema.ResourceData, meta interface{}) error {
 		grantsTo := v.([]interface{})[0].(map[string]interface{})
 		role := grantsTo["role"].(string)
 		if role != "" {
-			grantDetails, err = snowflake.ShowGrantsTo(db, "ROLE", role)
+			grantDetails, err = snowflake.ShowGrantsTo(ctx, db, "ROLE", role)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		user := grantsTo["user"].(string)
 		if user != "" {
-			grantDetails, err = snowflake.ShowGrantsTo(db, "USER", user)
+			grantDetails, err = snowflake.ShowGrantsTo(ctx, db, "USER", user)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		share := grantsTo["share"].(string)
 		if share != "" {
-			grantDetails, err = snowflake.ShowGrantsTo(db, "SHARE", share)
+			grantDetails, err = snowflake.ShowGrantsTo(ctx, db, "SHARE", share)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -307,16 +309,16 @@ func ReadGrants(d *schema.ResourceData, meta interface{}) error {
 		grantsOf := v.([]interface{})[0].(map[string]interface{})
 		role := grantsOf["role"].(string)
 		if role != "" {
-			grantDetails, err = snowflake.ShowGrantsOf(db, "ROLE", role)
+			grantDetails, err = snowflake.ShowGrantsOf(ctx, db, "ROLE", role)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		share := grantsOf["share"].(string)
 		if share != "" {
-			grantDetails, err = snowflake.ShowGrantsOf(db, "SHARE", share)
+			grantDetails, err = snowflake.ShowGrantsOf(ctx, db, "SHARE", share)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -325,9 +327,9 @@ func ReadGrants(d *schema.ResourceData, meta interface{}) error {
 		futureGrantsIn := v.([]interface{})[0].(map[string]interface{})
 		database := futureGrantsIn["database"].(string)
 		if database != "" {
-			grantDetails, err = snowflake.ShowFutureGrantsIn(db, "DATABASE", database)
+			grantDetails, err = snowflake.ShowFutureGrantsIn(ctx, db, "DATABASE", database)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		schema := futureGrantsIn["schema"].([]interface{})
@@ -339,9 +341,9 @@ func ReadGrants(d *schema.ResourceData, meta interface{}) error {
 				schemaName = databaseName + "." + schemaName
 			}
 
-			grantDetails, err = snowflake.ShowFutureGrantsIn(db, "SCHEMA", schemaName)
+			grantDetails, err = snowflake.ShowFutureGrantsIn(ctx, db, "SCHEMA", schemaName)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -350,16 +352,16 @@ func ReadGrants(d *schema.ResourceData, meta interface{}) error {
 		futureGrantsTo := v.([]interface{})[0].(map[string]interface{})
 		role := futureGrantsTo["role"].(string)
 		if role != "" {
-			grantDetails, err = snowflake.ShowFutureGrantsTo(db, "ROLE", role)
+			grantDetails, err = snowflake.ShowFutureGrantsTo(ctx, db, "ROLE", role)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
 	err = d.Set("grants", flattenGrants(grantDetails))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("grants")
 	return nil
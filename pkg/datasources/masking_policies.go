@@ -6,6 +6,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -55,24 +56,23 @@ var maskingPoliciesSchema = map[string]*schema.Schema{
 
 func MaskingPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadMaskingPolicies,
-		Schema: maskingPoliciesSchema,
+		ReadContext: ReadMaskingPolicies,
+		Schema:      maskingPoliciesSchema,
 	}
 }
 
-func ReadMaskingPolicies(d *schema.ResourceData, meta interface{}) error {
+func ReadMaskingPolicies(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	maskingPolicies, err := client.MaskingPolicies.Show(ctx, &sdk.ShowMaskingPolicyOptions{
 		In: &sdk.In{
 			Schema: sdk.NewDatabaseObjectIdentifier(databaseName, schemaName),
 		},
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	maskingPoliciesList := []map[string]interface{}{}
 	for _, maskingPolicy := range maskingPolicies {
@@ -85,7 +85,7 @@ func ReadMaskingPolicies(d *schema.ResourceData, meta interface{}) error {
 		maskingPoliciesList = append(maskingPoliciesList, maskingPolicyMap)
 	}
 	if err := d.Set("masking_policies", maskingPoliciesList); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName))
 	return nil
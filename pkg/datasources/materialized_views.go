@@ -7,6 +7,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -51,14 +52,13 @@ var materializedViewsSchema = map[string]*schema.Schema{
 
 func MaterializedViews() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadMaterializedViews,
-		Schema: materializedViewsSchema,
+		ReadContext: ReadMaterializedViews,
+		Schema:      materializedViewsSchema,
 	}
 }
 
-func ReadMaterializedViews(d *schema.ResourceData, meta interface{}) error {
+func ReadMaterializedViews(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
@@ -85,5 +85,5 @@ func ReadMaterializedViews(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName))
-	return d.Set("materialized_views", materializedViews)
+	return diag.FromErr(d.Set("materialized_views", materializedViews))
 }
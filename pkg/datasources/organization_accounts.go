@@ -0,0 +1,102 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"maps"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// organizationAccountSchema extends accountSchema with the columns describing the dropped accounts.
+var organizationAccountSchema = func() map[string]*schema.Schema {
+	s := maps.Clone(accountSchema)
+	s["dropped_on"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the account was dropped, if it was.",
+	}
+	s["scheduled_deletion_time"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the dropped account is scheduled to be deleted permanently. The account can be undropped until then.",
+	}
+	s["restored_on"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the account was last restored, if it was.",
+	}
+	return s
+}()
+
+var organizationAccountsSchema = map[string]*schema.Schema{
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies an account name pattern. If a pattern is specified, only accounts matching the pattern are returned.",
+	},
+	"history": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Includes the dropped accounts which have not been deleted permanently yet.",
+	},
+	"accounts": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "List of the accounts in the organization, as returned by SHOW ORGANIZATION ACCOUNTS.",
+		Elem: &schema.Resource{
+			Schema: organizationAccountSchema,
+		},
+	},
+}
+
+// OrganizationAccounts returns a data source listing the accounts of the organization, optionally with the dropped ones.
+func OrganizationAccounts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadOrganizationAccounts,
+		Schema:      organizationAccountsSchema,
+	}
+}
+
+func ReadOrganizationAccounts(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := sdk.NewClientFromDB(meta.(*sql.DB))
+
+	ok, err := client.ContextFunctions.IsRoleInSession(ctx, sdk.NewAccountObjectIdentifier("ORGADMIN"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !ok {
+		log.Printf("[DEBUG] ORGADMIN role is not in current session, cannot read organization accounts")
+		return nil
+	}
+	opts := &sdk.ShowAccountOptions{}
+	if d.Get("history").(bool) {
+		opts.History = sdk.Bool(true)
+	}
+	if pattern, ok := d.GetOk("pattern"); ok {
+		opts.Like = &sdk.Like{Pattern: sdk.String(pattern.(string))}
+	}
+	accounts, err := client.Accounts.Show(ctx, opts)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error showing organization accounts: %w", err))
+	}
+
+	d.SetId("organization_accounts")
+	accountsFlatten := make([]map[string]any, 0, len(accounts))
+	for _, account := range accounts {
+		m := flattenAccount(account)
+		m["dropped_on"] = formatOptionalTime(account.DroppedOn)
+		m["scheduled_deletion_time"] = formatOptionalTime(account.ScheduledDeletionTime)
+		m["restored_on"] = formatOptionalTime(account.RestoredOn)
+		accountsFlatten = append(accountsFlatten, m)
+	}
+	if err := d.Set("accounts", accountsFlatten); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
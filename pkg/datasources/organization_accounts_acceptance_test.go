@@ -0,0 +1,44 @@
+package datasources_test
+
+import (
+	"os"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_OrganizationAccounts(t *testing.T) {
+	if _, ok := os.LookupEnv("SNOWFLAKE_TEST_ACCOUNTS_SHOW"); !ok {
+		t.Skip("Skipping TestAcc_OrganizationAccounts")
+	}
+	dataSourceName := "data.snowflake_organization_accounts.accounts"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: organizationAccountsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "history", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "accounts.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "accounts.0.account_locator"),
+				),
+			},
+		},
+	})
+}
+
+func organizationAccountsConfig() string {
+	return `
+data "snowflake_organization_accounts" "accounts" {
+	history = true
+}
+`
+}
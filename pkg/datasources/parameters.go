@@ -8,6 +8,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -78,15 +79,14 @@ var parametersSchema = map[string]*schema.Schema{
 
 func Parameters() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadParameters,
-		Schema: parametersSchema,
+		ReadContext: ReadParameters,
+		Schema:      parametersSchema,
 	}
 }
 
-func ReadParameters(d *schema.ResourceData, meta interface{}) error {
+func ReadParameters(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	p, ok := d.GetOk("pattern")
 	pattern := ""
 	if ok {
@@ -107,7 +107,7 @@ func ReadParameters(d *schema.ResourceData, meta interface{}) error {
 	case "SESSION":
 		user := d.Get("user").(string)
 		if user == "" {
-			return fmt.Errorf("user is required when parameter_type is set to SESSION")
+			return diag.FromErr(fmt.Errorf("user is required when parameter_type is set to SESSION"))
 		}
 		opts.In.User = sdk.NewAccountObjectIdentifier(user)
 	case "OBJECT":
@@ -125,13 +125,13 @@ func ReadParameters(d *schema.ResourceData, meta interface{}) error {
 		case sdk.ObjectTypeTable:
 			opts.In.Table = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(objectName)
 		default:
-			return fmt.Errorf("object_type %s is not supported", objectType)
+			return diag.FromErr(fmt.Errorf("object_type %s is not supported", objectType))
 		}
 	}
 	parameters, err = client.Parameters.ShowParameters(ctx, &opts)
 
 	if err != nil {
-		return fmt.Errorf("error listing parameters: %w", err)
+		return diag.FromErr(fmt.Errorf("error listing parameters: %w", err))
 	}
 	d.SetId("parameters")
 
@@ -147,5 +147,5 @@ func ReadParameters(d *schema.ResourceData, meta interface{}) error {
 
 		params = append(params, paramMap)
 	}
-	return d.Set("parameters", params)
+	return diag.FromErr(d.Set("parameters", params))
 }
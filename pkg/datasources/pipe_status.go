@@ -0,0 +1,113 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var pipeStatusSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database in which the pipe is.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema in which the pipe is.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the pipe.",
+	},
+	"execution_state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Current execution state of the pipe, e.g. RUNNING, PAUSED, STOPPED_STAGE_DROPPED or STALLED_COMPILATION_ERROR.",
+	},
+	"pending_file_count": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Number of files queued for loading by the pipe.",
+	},
+	"last_ingested_timestamp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Timestamp when the most recent file was loaded successfully by the pipe.",
+	},
+	"last_ingested_file_path": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Path of the file loaded at the time specified in `last_ingested_timestamp`.",
+	},
+	"notification_channel_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Queue that receives the event notifications for the pipe (auto-ingest pipes only).",
+	},
+	"num_outstanding_messages_on_channel": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Number of messages in the queue that have been queued but not received yet.",
+	},
+	"last_received_message_timestamp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Timestamp of the last event message received from the queue.",
+	},
+	"last_forwarded_message_timestamp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Timestamp of the last event message forwarded to the pipe.",
+	},
+	"error": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Error message produced when the pipe was last compiled for execution, if any.",
+	},
+}
+
+// PipeStatus returns a data source exposing the result of SYSTEM$PIPE_STATUS.
+func PipeStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadPipeStatus,
+		Schema:      pipeStatusSchema,
+	}
+}
+
+func ReadPipeStatus(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	status, err := client.SystemFunctions.PipeStatus(ctx, id)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading status of pipe %s: %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(id))
+	values := map[string]any{
+		"execution_state":                     status.ExecutionState,
+		"pending_file_count":                  status.PendingFileCount,
+		"last_ingested_timestamp":             status.LastIngestedTimestamp,
+		"last_ingested_file_path":             status.LastIngestedFilePath,
+		"notification_channel_name":           status.NotificationChannelName,
+		"num_outstanding_messages_on_channel": status.NumOutstandingMessagesOnChannel,
+		"last_received_message_timestamp":     status.LastReceivedMessageTimestamp,
+		"last_forwarded_message_timestamp":    status.LastForwardedMessageTimestamp,
+		"error":                               status.Error,
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
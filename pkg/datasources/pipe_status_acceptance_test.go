@@ -0,0 +1,75 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_PipeStatus(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	dataSourceName := "data.snowflake_pipe_status.s"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: pipeStatusConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "execution_state", "PAUSED"),
+					resource.TestCheckResourceAttr(dataSourceName, "pending_file_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "error", ""),
+				),
+			},
+		},
+	})
+}
+
+func pipeStatusConfig(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "t" {
+  database = "%[1]s"
+  schema   = "%[2]s"
+  name     = "%[3]s"
+  column {
+    name = "id"
+    type = "NUMBER(5,0)"
+  }
+}
+
+resource "snowflake_stage" "s" {
+  database = "%[1]s"
+  schema   = "%[2]s"
+  name     = "%[3]s"
+}
+
+resource "snowflake_pipe" "p" {
+  database       = "%[1]s"
+  schema         = "%[2]s"
+  name           = "%[3]s"
+  paused         = true
+  copy_statement = <<CMD
+COPY INTO "${snowflake_table.t.database}"."${snowflake_table.t.schema}"."${snowflake_table.t.name}"
+  FROM @"${snowflake_stage.s.database}"."${snowflake_stage.s.schema}"."${snowflake_stage.s.name}"
+  FILE_FORMAT = (TYPE = CSV)
+CMD
+}
+
+data "snowflake_pipe_status" "s" {
+  database = snowflake_pipe.p.database
+  schema   = snowflake_pipe.p.schema
+  name     = snowflake_pipe.p.name
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name)
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -56,15 +57,14 @@ var pipesSchema = map[string]*schema.Schema{
 
 func Pipes() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadPipes,
-		Schema: pipesSchema,
+		ReadContext: ReadPipes,
+		Schema:      pipesSchema,
 	}
 }
 
-func ReadPipes(d *schema.ResourceData, meta interface{}) error {
+func ReadPipes(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
@@ -77,7 +77,7 @@ func ReadPipes(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		log.Printf("[DEBUG] unable to parse pipes in schema (%s)", d.Id())
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 
 	pipes := make([]map[string]any, 0, len(extractedPipes))
@@ -94,5 +94,5 @@ func ReadPipes(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
-	return d.Set("pipes", pipes)
+	return diag.FromErr(d.Set("pipes", pipes))
 }
@@ -7,6 +7,7 @@ import (
 	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -42,15 +43,14 @@ var resourceMonitorsSchema = map[string]*schema.Schema{
 
 func ResourceMonitors() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadResourceMonitors,
-		Schema: resourceMonitorsSchema,
+		ReadContext: ReadResourceMonitors,
+		Schema:      resourceMonitorsSchema,
 	}
 }
 
-func ReadResourceMonitors(d *schema.ResourceData, meta interface{}) error {
+func ReadResourceMonitors(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	account, err := client.ContextFunctions.CurrentSessionDetails(ctx)
 	if err != nil {
@@ -79,5 +79,5 @@ func ReadResourceMonitors(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return d.Set("resource_monitors", resourceMonitors)
+	return diag.FromErr(d.Set("resource_monitors", resourceMonitors))
 }
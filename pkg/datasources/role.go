@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,7 +26,7 @@ var roleSchema = map[string]*schema.Schema{
 // Role Snowflake Role resource.
 func Role() *schema.Resource {
 	return &schema.Resource{
-		Read:               ReadRole,
+		ReadContext:        ReadRole,
 		Schema:             roleSchema,
 		DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_roles instead.",
 		Importer: &schema.ResourceImporter{
@@ -35,10 +36,9 @@ func Role() *schema.Resource {
 }
 
 // ReadRole Reads the database metadata information.
-func ReadRole(d *schema.ResourceData, meta interface{}) error {
+func ReadRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	roleName := d.Get("name").(string)
 
@@ -51,10 +51,10 @@ func ReadRole(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(role.Name)
 	if err := d.Set("name", role.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("comment", role.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
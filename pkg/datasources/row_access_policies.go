@@ -7,6 +7,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -51,15 +52,14 @@ var rowAccessPoliciesSchema = map[string]*schema.Schema{
 
 func RowAccessPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadRowAccessPolicies,
-		Schema: rowAccessPoliciesSchema,
+		ReadContext: ReadRowAccessPolicies,
+		Schema:      rowAccessPoliciesSchema,
 	}
 }
 
-func ReadRowAccessPolicies(d *schema.ResourceData, meta interface{}) error {
+func ReadRowAccessPolicies(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
@@ -86,5 +86,5 @@ func ReadRowAccessPolicies(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName))
-	return d.Set("row_access_policies", rowAccessPolicies)
+	return diag.FromErr(d.Set("row_access_policies", rowAccessPolicies))
 }
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -41,15 +42,14 @@ var schemasSchema = map[string]*schema.Schema{
 
 func Schemas() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadSchemas,
-		Schema: schemasSchema,
+		ReadContext: ReadSchemas,
+		Schema:      schemasSchema,
 	}
 }
 
-func ReadSchemas(d *schema.ResourceData, meta interface{}) error {
+func ReadSchemas(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	databaseName := d.Get("database").(string)
 	databaseID := sdk.NewAccountObjectIdentifier(databaseName)
 
@@ -75,5 +75,5 @@ func ReadSchemas(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(databaseName)
-	return d.Set("schemas", schemas)
+	return diag.FromErr(d.Set("schemas", schemas))
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -50,15 +51,14 @@ var sequencesSchema = map[string]*schema.Schema{
 
 func Sequences() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadSequences,
-		Schema: sequencesSchema,
+		ReadContext: ReadSequences,
+		Schema:      sequencesSchema,
 	}
 }
 
-func ReadSequences(d *schema.ResourceData, meta interface{}) error {
+func ReadSequences(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 
@@ -67,7 +67,7 @@ func ReadSequences(d *schema.ResourceData, meta interface{}) error {
 	})
 	seqs, err := client.Sequences.Show(ctx, req)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	sequences := []map[string]interface{}{}
 	for _, seq := range seqs {
@@ -81,5 +81,5 @@ func ReadSequences(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
-	return d.Set("sequences", sequences)
+	return diag.FromErr(d.Set("sequences", sequences))
 }
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -54,18 +55,17 @@ var sharesSchema = map[string]*schema.Schema{
 // Shares Snowflake Shares resource.
 func Shares() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadShares,
-		Schema: sharesSchema,
+		ReadContext: ReadShares,
+		Schema:      sharesSchema,
 	}
 }
 
 // ReadShares Reads the database metadata information.
-func ReadShares(d *schema.ResourceData, meta interface{}) error {
+func ReadShares(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	d.SetId("shares_read")
 	pattern := d.Get("pattern").(string)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	var opts sdk.ShowShareOptions
 	if pattern != "" {
 		opts.Like = &sdk.Like{
@@ -74,7 +74,7 @@ func ReadShares(d *schema.ResourceData, meta interface{}) error {
 	}
 	shares, err := client.Shares.Show(ctx, &opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	sharesFlatten := []map[string]interface{}{}
 	for _, share := range shares {
@@ -92,7 +92,7 @@ func ReadShares(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("shares", sharesFlatten); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
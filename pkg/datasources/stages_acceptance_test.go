@@ -64,8 +64,10 @@ func stages(databaseName string, schemaName string, storageIntegrationName strin
 		name 	 				= "%s"
 		database 				= snowflake_schema.test.database
 		schema 	 				= snowflake_schema.test.name
-		url 					= "s3://foo/"
-		storage_integration 	= snowflake_storage_integration.test.name
+		s3 {
+			url                 = "s3://foo/"
+			storage_integration = snowflake_storage_integration.test.name
+		}
 		comment  				= "%s"
 	}
 
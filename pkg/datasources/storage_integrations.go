@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -41,26 +42,25 @@ var storageIntegrationsSchema = map[string]*schema.Schema{
 
 func StorageIntegrations() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadStorageIntegrations,
-		Schema: storageIntegrationsSchema,
+		ReadContext: ReadStorageIntegrations,
+		Schema:      storageIntegrationsSchema,
 	}
 }
 
-func ReadStorageIntegrations(d *schema.ResourceData, meta interface{}) error {
+func ReadStorageIntegrations(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	account, err := client.ContextFunctions.CurrentAccount(ctx)
 	if err != nil {
 		d.SetId("")
-		return fmt.Errorf("[DEBUG] unable to retrieve current account")
+		return diag.FromErr(fmt.Errorf("[DEBUG] unable to retrieve current account"))
 	}
 
 	region, err := client.ContextFunctions.CurrentRegion(ctx)
 	if err != nil {
 		d.SetId("")
-		return fmt.Errorf("[DEBUG] unable to retrieve current region")
+		return diag.FromErr(fmt.Errorf("[DEBUG] unable to retrieve current region"))
 	}
 
 	d.SetId(fmt.Sprintf("%s.%s", account, region))
@@ -68,7 +68,7 @@ func ReadStorageIntegrations(d *schema.ResourceData, meta interface{}) error {
 	storageIntegrations, err := client.StorageIntegrations.Show(ctx, sdk.NewShowStorageIntegrationRequest())
 	if err != nil {
 		d.SetId("")
-		return fmt.Errorf("unable to retrieve storage integrations in account (%s), err = %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("unable to retrieve storage integrations in account (%s), err = %w", d.Id(), err))
 	}
 
 	storageIntegrationMaps := make([]map[string]any, len(storageIntegrations))
@@ -82,5 +82,5 @@ func ReadStorageIntegrations(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return d.Set("storage_integrations", storageIntegrationMaps)
+	return diag.FromErr(d.Set("storage_integrations", storageIntegrationMaps))
 }
@@ -7,6 +7,7 @@ import (
 	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -56,15 +57,14 @@ var streamsSchema = map[string]*schema.Schema{
 
 func Streams() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadStreams,
-		Schema: streamsSchema,
+		ReadContext: ReadStreams,
+		Schema:      streamsSchema,
 	}
 }
 
-func ReadStreams(d *schema.ResourceData, meta interface{}) error {
+func ReadStreams(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 
@@ -90,5 +90,5 @@ func ReadStreams(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
-	return d.Set("streams", streams)
+	return diag.FromErr(d.Set("streams", streams))
 }
@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -24,18 +26,19 @@ var systemGenerateSCIMAccesstokenSchema = map[string]*schema.Schema{
 
 func SystemGenerateSCIMAccessToken() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadSystemGenerateSCIMAccessToken,
-		Schema: systemGenerateSCIMAccesstokenSchema,
+		ReadContext:        ReadSystemGenerateSCIMAccessToken,
+		Schema:             systemGenerateSCIMAccesstokenSchema,
+		DeprecationMessage: "This data source is deprecated and will be removed in a future major version release. Please use the snowflake_scim_access_token ephemeral resource instead; it does not store the token in the state.",
 	}
 }
 
-// ReadSystemGetAWSSNSIAMPolicy implements schema.ReadFunc.
-func ReadSystemGenerateSCIMAccessToken(d *schema.ResourceData, meta interface{}) error {
+// ReadSystemGetAWSSNSIAMPolicy implements schema.ReadContextFunc.
+func ReadSystemGenerateSCIMAccessToken(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	integrationName := d.Get("integration_name").(string)
 
 	sel := snowflake.NewSystemGenerateSCIMAccessTokenBuilder(integrationName).Select()
-	row := snowflake.QueryRow(db, sel)
+	row := snowflake.QueryRow(ctx, db, sel)
 	accessToken, err := snowflake.ScanSCIMAccessToken(row)
 	if errors.Is(err, sql.ErrNoRows) {
 		// If not found, mark resource to be removed from state file during apply or refresh
@@ -51,5 +54,5 @@ func ReadSystemGenerateSCIMAccessToken(d *schema.ResourceData, meta interface{})
 	}
 
 	d.SetId(integrationName)
-	return d.Set("access_token", accessToken.Token)
+	return diag.FromErr(d.Set("access_token", accessToken.Token))
 }
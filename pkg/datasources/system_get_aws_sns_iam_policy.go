@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,18 +27,18 @@ var systemGetAWSSNSIAMPolicySchema = map[string]*schema.Schema{
 
 func SystemGetAWSSNSIAMPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadSystemGetAWSSNSIAMPolicy,
-		Schema: systemGetAWSSNSIAMPolicySchema,
+		ReadContext: ReadSystemGetAWSSNSIAMPolicy,
+		Schema:      systemGetAWSSNSIAMPolicySchema,
 	}
 }
 
-// ReadSystemGetAWSSNSIAMPolicy implements schema.ReadFunc.
-func ReadSystemGetAWSSNSIAMPolicy(d *schema.ResourceData, meta interface{}) error {
+// ReadSystemGetAWSSNSIAMPolicy implements schema.ReadContextFunc.
+func ReadSystemGetAWSSNSIAMPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	awsSNSTopicArn := d.Get("aws_sns_topic_arn").(string)
 
 	sel := snowflake.NewSystemGetAWSSNSIAMPolicyBuilder(awsSNSTopicArn).Select()
-	row := snowflake.QueryRow(db, sel)
+	row := snowflake.QueryRow(ctx, db, sel)
 	policy, err := snowflake.ScanAWSSNSIAMPolicy(row)
 	if errors.Is(err, sql.ErrNoRows) {
 		// If not found, mark resource to be removed from state file during apply or refresh
@@ -45,9 +47,9 @@ func ReadSystemGetAWSSNSIAMPolicy(d *schema.ResourceData, meta interface{}) erro
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(awsSNSTopicArn)
-	return d.Set("aws_sns_topic_policy_json", policy.Policy)
+	return diag.FromErr(d.Set("aws_sns_topic_policy_json", policy.Policy))
 }
@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -67,17 +69,17 @@ var systemGetPrivateLinkConfigSchema = map[string]*schema.Schema{
 
 func SystemGetPrivateLinkConfig() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadSystemGetPrivateLinkConfig,
-		Schema: systemGetPrivateLinkConfigSchema,
+		ReadContext: ReadSystemGetPrivateLinkConfig,
+		Schema:      systemGetPrivateLinkConfigSchema,
 	}
 }
 
-// ReadSystemGetPrivateLinkConfig implements schema.ReadFunc.
-func ReadSystemGetPrivateLinkConfig(d *schema.ResourceData, meta interface{}) error {
+// ReadSystemGetPrivateLinkConfig implements schema.ReadContextFunc.
+func ReadSystemGetPrivateLinkConfig(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 
 	sel := snowflake.SystemGetPrivateLinkConfigQuery()
-	row := snowflake.QueryRow(db, sel)
+	row := snowflake.QueryRow(ctx, db, sel)
 	rawConfig, err := snowflake.ScanPrivateLinkConfig(row)
 
 	if errors.Is(err, sql.ErrNoRows) {
@@ -97,56 +99,56 @@ func ReadSystemGetPrivateLinkConfig(d *schema.ResourceData, meta interface{}) er
 	d.SetId(config.AccountName)
 	accNameErr := d.Set("account_name", config.AccountName)
 	if accNameErr != nil {
-		return accNameErr
+		return diag.FromErr(accNameErr)
 	}
 	accURLErr := d.Set("account_url", config.AccountURL)
 	if accURLErr != nil {
-		return accURLErr
+		return diag.FromErr(accURLErr)
 	}
 	ocspURLErr := d.Set("ocsp_url", config.OCSPURL)
 	if ocspURLErr != nil {
-		return ocspURLErr
+		return diag.FromErr(ocspURLErr)
 	}
 
 	if config.AwsVpceID != "" {
 		awsVpceIDErr := d.Set("aws_vpce_id", config.AwsVpceID)
 		if awsVpceIDErr != nil {
-			return awsVpceIDErr
+			return diag.FromErr(awsVpceIDErr)
 		}
 	}
 
 	if config.AzurePrivateLinkServiceID != "" {
 		azurePlsIDErr := d.Set("azure_pls_id", config.AzurePrivateLinkServiceID)
 		if azurePlsIDErr != nil {
-			return azurePlsIDErr
+			return diag.FromErr(azurePlsIDErr)
 		}
 	}
 
 	if config.InternalStage != "" {
 		intStgErr := d.Set("internal_stage", config.InternalStage)
 		if intStgErr != nil {
-			return intStgErr
+			return diag.FromErr(intStgErr)
 		}
 	}
 
 	if config.SnowsightURL != "" {
 		snowSigURLErr := d.Set("snowsight_url", config.SnowsightURL)
 		if snowSigURLErr != nil {
-			return snowSigURLErr
+			return diag.FromErr(snowSigURLErr)
 		}
 	}
 
 	if config.RegionlessSnowsightURL != "" {
 		reglssSnowURLErr := d.Set("regionless_snowsight_url", config.RegionlessSnowsightURL)
 		if reglssSnowURLErr != nil {
-			return reglssSnowURLErr
+			return diag.FromErr(reglssSnowURLErr)
 		}
 	}
 
 	if config.RegionlessAccountURL != "" {
 		reglssAccURLErr := d.Set("regionless_account_url", config.RegionlessAccountURL)
 		if reglssAccURLErr != nil {
-			return reglssAccURLErr
+			return diag.FromErr(reglssAccURLErr)
 		}
 	}
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -29,25 +30,25 @@ var systemGetSnowflakePlatformInfoSchema = map[string]*schema.Schema{
 
 func SystemGetSnowflakePlatformInfo() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadSystemGetSnowflakePlatformInfo,
-		Schema: systemGetSnowflakePlatformInfoSchema,
+		ReadContext: ReadSystemGetSnowflakePlatformInfo,
+		Schema:      systemGetSnowflakePlatformInfoSchema,
 	}
 }
 
-// ReadSystemGetSnowflakePlatformInfo implements schema.ReadFunc.
-func ReadSystemGetSnowflakePlatformInfo(d *schema.ResourceData, meta interface{}) error {
+// ReadSystemGetSnowflakePlatformInfo implements schema.ReadContextFunc.
+func ReadSystemGetSnowflakePlatformInfo(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 
 	sel := snowflake.SystemGetSnowflakePlatformInfoQuery()
-	row := snowflake.QueryRow(db, sel)
+	row := snowflake.QueryRow(ctx, db, sel)
 
-	acc, err := client.ContextFunctions.CurrentSessionDetails(context.Background())
+	acc, err := client.ContextFunctions.CurrentSessionDetails(ctx)
 	if err != nil {
 		// If not found, mark resource to be removed from state file during apply or refresh
 		d.SetId("")
 		log.Println("[DEBUG] current_account failed to decode")
-		return fmt.Errorf("error current_account err = %w", err)
+		return diag.FromErr(fmt.Errorf("error current_account err = %w", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s.%s", acc.Account, acc.Region))
@@ -56,22 +57,22 @@ func ReadSystemGetSnowflakePlatformInfo(d *schema.ResourceData, meta interface{}
 	if errors.Is(err, sql.ErrNoRows) {
 		// If not found, mark resource to be removed from state file during apply or refresh
 		log.Println("[DEBUG] system_get_snowflake_platform_info not found")
-		return fmt.Errorf("error system_get_snowflake_platform_info err = %w", err)
+		return diag.FromErr(fmt.Errorf("error system_get_snowflake_platform_info err = %w", err))
 	}
 
 	info, err := rawInfo.GetStructuredConfig()
 	if err != nil {
 		log.Println("[DEBUG] system_get_snowflake_platform_info failed to decode")
 		d.SetId("")
-		return fmt.Errorf("error system_get_snowflake_platform_info err = %w", err)
+		return diag.FromErr(fmt.Errorf("error system_get_snowflake_platform_info err = %w", err))
 	}
 
 	if err := d.Set("azure_vnet_subnet_ids", info.AzureVnetSubnetIds); err != nil {
-		return fmt.Errorf("error system_get_snowflake_platform_info err = %w", err)
+		return diag.FromErr(fmt.Errorf("error system_get_snowflake_platform_info err = %w", err))
 	}
 
 	if err := d.Set("aws_vpc_ids", info.AwsVpcIds); err != nil {
-		return fmt.Errorf("error system_get_snowflake_platform_info err = %w", err)
+		return diag.FromErr(fmt.Errorf("error system_get_snowflake_platform_info err = %w", err))
 	}
 
 	return nil
@@ -7,6 +7,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -51,14 +52,13 @@ var tablesSchema = map[string]*schema.Schema{
 
 func Tables() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadTables,
-		Schema: tablesSchema,
+		ReadContext: ReadTables,
+		Schema:      tablesSchema,
 	}
 }
 
-func ReadTables(d *schema.ResourceData, meta interface{}) error {
+func ReadTables(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
@@ -91,5 +91,5 @@ func ReadTables(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName))
-	return d.Set("tables", tables)
+	return diag.FromErr(d.Set("tables", tables))
 }
@@ -64,7 +64,9 @@ func tables(databaseName string, schemaName string, tableName string, stageName
 
 	resource "snowflake_stage" "s" {
 		name = "%v"
-		url = "s3://snowflake-workshop-lab/weather-nyc"
+		s3 {
+			url = "s3://snowflake-workshop-lab/weather-nyc"
+		}
 		database = snowflake_database.d.name
 		schema = snowflake_schema.s.name
 	}
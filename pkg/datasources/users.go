@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -96,17 +97,16 @@ var usersSchema = map[string]*schema.Schema{
 
 func Users() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadUsers,
-		Schema: usersSchema,
+		ReadContext: ReadUsers,
+		Schema:      usersSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 
-func ReadUsers(d *schema.ResourceData, meta interface{}) error {
+func ReadUsers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	userPattern := d.Get("pattern").(string)
@@ -149,5 +149,5 @@ func ReadUsers(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return d.Set("users", users)
+	return diag.FromErr(d.Set("users", users))
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -51,14 +52,13 @@ var viewsSchema = map[string]*schema.Schema{
 
 func Views() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadViews,
-		Schema: viewsSchema,
+		ReadContext: ReadViews,
+		Schema:      viewsSchema,
 	}
 }
 
-func ReadViews(d *schema.ResourceData, meta interface{}) error {
+func ReadViews(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
@@ -85,5 +85,5 @@ func ReadViews(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName))
-	return d.Set("views", views)
+	return diag.FromErr(d.Set("views", views))
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -51,15 +52,14 @@ var warehousesSchema = map[string]*schema.Schema{
 
 func Warehouses() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadWarehouses,
-		Schema: warehousesSchema,
+		ReadContext: ReadWarehouses,
+		Schema:      warehousesSchema,
 	}
 }
 
-func ReadWarehouses(d *schema.ResourceData, meta interface{}) error {
+func ReadWarehouses(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	account, err := client.ContextFunctions.CurrentSessionDetails(ctx)
 	if err != nil {
@@ -70,7 +70,7 @@ func ReadWarehouses(d *schema.ResourceData, meta interface{}) error {
 
 	result, err := client.Warehouses.Show(ctx, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	warehouses := []map[string]interface{}{}
@@ -88,5 +88,5 @@ func ReadWarehouses(d *schema.ResourceData, meta interface{}) error {
 		warehouses = append(warehouses, warehouseMap)
 	}
 
-	return d.Set("warehouses", warehouses)
+	return diag.FromErr(d.Set("warehouses", warehouses))
 }
@@ -0,0 +1,50 @@
+package helpers
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+// CreateStatement is a CREATE statement returned by Snowflake (e.g. in the text column of SHOW DYNAMIC TABLES),
+// split into the object-level parameters and the query following the AS keyword.
+type CreateStatement struct {
+	// Parameters contains top-level `KEY = value` pairs preceding the query. Keys are upper-cased, string values are unquoted.
+	Parameters map[string]string
+	// Query is the part of the statement following the top-level AS keyword, exactly as returned by Snowflake.
+	Query string
+}
+
+// ParseCreateStatement parses a CREATE ... AS <query> statement. Parameters and keywords inside parentheses (e.g.
+// column lists) and inside string literals are ignored, so only the top-level AS keyword starts the query.
+func ParseCreateStatement(statement string) (CreateStatement, error) {
+	result := CreateStatement{Parameters: make(map[string]string)}
+	runes := []rune(statement)
+	tokens := tokenizeSQL(statement)
+
+	depth := 0
+	for i, token := range tokens {
+		switch {
+		case token.kind == sqlTokenSymbol && token.value == "(":
+			depth++
+		case token.kind == sqlTokenSymbol && token.value == ")":
+			depth--
+		case depth != 0 || token.kind != sqlTokenWord:
+			continue
+		case strings.EqualFold(token.value, "AS"):
+			result.Query = strings.TrimLeftFunc(string(runes[token.start+len([]rune(token.value)):]), unicode.IsSpace)
+			return result, nil
+		case i+2 < len(tokens) && tokens[i+1].kind == sqlTokenSymbol && tokens[i+1].value == "=":
+			result.Parameters[strings.ToUpper(token.value)] = unquoteSQLValue(tokens[i+2])
+		}
+	}
+	return result, errors.New("unable to find the query in the create statement: missing AS keyword")
+}
+
+func unquoteSQLValue(token sqlToken) string {
+	if token.kind != sqlTokenString || !strings.HasPrefix(token.value, "'") {
+		return token.value
+	}
+	value := strings.TrimSuffix(strings.TrimPrefix(token.value, "'"), "'")
+	return strings.NewReplacer(`''`, `'`, `\'`, `'`, `\\`, `\`).Replace(value)
+}
@@ -0,0 +1,76 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCreateStatement(t *testing.T) {
+	testCases := map[string]struct {
+		input              string
+		expectedParameters map[string]string
+		expectedQuery      string
+	}{
+		"dynamic table": {
+			input: `create or replace dynamic table "db"."schema"."dt"(ID, NAME) target_lag = '1 minute' refresh_mode = AUTO initialize = ON_CREATE warehouse = WH as select id, name from t`,
+			expectedParameters: map[string]string{
+				"TARGET_LAG":   "1 minute",
+				"REFRESH_MODE": "AUTO",
+				"INITIALIZE":   "ON_CREATE",
+				"WAREHOUSE":    "WH",
+			},
+			expectedQuery: `select id, name from t`,
+		},
+		"quoted parameters and comment with keywords": {
+			input: "CREATE DYNAMIC TABLE dt TARGET_LAG = DOWNSTREAM REFRESH_MODE = 'INCREMENTAL' COMMENT = 'computed as sum, it''s = ok' WAREHOUSE = \"wh\"\nAS\n  SELECT a AS b FROM t",
+			expectedParameters: map[string]string{
+				"TARGET_LAG":   "DOWNSTREAM",
+				"REFRESH_MODE": "INCREMENTAL",
+				"COMMENT":      "computed as sum, it's = ok",
+				"WAREHOUSE":    `"wh"`,
+			},
+			expectedQuery: "SELECT a AS b FROM t",
+		},
+		"ignores parameters and keywords in parentheses": {
+			input: `create dynamic table dt (id number comment 'as', x as (id + 1)) cluster by (id) target_lag = '5 minutes' warehouse = wh as select 1`,
+			expectedParameters: map[string]string{
+				"TARGET_LAG": "5 minutes",
+				"WAREHOUSE":  "wh",
+			},
+			expectedQuery: `select 1`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			statement, err := ParseCreateStatement(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedParameters, statement.Parameters)
+			require.Equal(t, tc.expectedQuery, statement.Query)
+		})
+	}
+
+	queries := map[string]struct {
+		input         string
+		expectedQuery string
+	}{
+		"caps":            {"CREATE DYNAMIC TABLE FOO TARGET_LAG = 'DOWNSTREAM' WAREHOUSE = COMPUTE_WH AS SELECT * FROM BAR;", "SELECT * FROM BAR;"},
+		"parens":          {"create dynamic table foo target_lag = 'DOWNSTREAM' warehouse = COMPUTE_WH as (select * from bar);", "(select * from bar);"},
+		"multiline":       {"\ncreate dynamic table foo\ntarget_lag = 'DOWNSTREAM'\nwarehouse = COMPUTE_WH\nas select *\nfrom bar;", "select *\nfrom bar;"},
+		"comment in body": {"create dynamic table foo target_lag = 'DOWNSTREAM' warehouse = COMPUTE_WH as\n-- comment\nselect *\nfrom bar;", "-- comment\nselect *\nfrom bar;"},
+		"escaped comment": {`create dynamic table foo comment = 'asdf\'s are fun' target_lag = 'DOWNSTREAM' warehouse = COMPUTE_WH as select * from bar;`, "select * from bar;"},
+	}
+	for name, tc := range queries {
+		t.Run(name, func(t *testing.T) {
+			statement, err := ParseCreateStatement(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedQuery, statement.Query)
+		})
+	}
+
+	t.Run("missing query", func(t *testing.T) {
+		_, err := ParseCreateStatement(`create dynamic table dt target_lag = 'as'`)
+		require.ErrorContains(t, err, "missing AS keyword")
+	})
+}
@@ -152,6 +152,103 @@ func DecodeSnowflakeParameterID(identifier string) (sdk.ObjectIdentifier, error)
 	}
 }
 
+// DecodeSnowflakeParameterIDWithArguments works like DecodeSnowflakeParameterID, but it additionally accepts function
+// and procedure signatures, e.g. "db"."schema"."name"(NUMBER, VARCHAR). The argument types are validated with
+// sdk.ToDataType and returned as a part of sdk.SchemaObjectIdentifier.
+func DecodeSnowflakeParameterIDWithArguments(identifier string) (sdk.ObjectIdentifier, error) {
+	name, arguments, hasArguments := splitSignature(strings.TrimSpace(identifier))
+	id, err := DecodeSnowflakeParameterID(name)
+	if err != nil {
+		return nil, err
+	}
+	if !hasArguments {
+		return id, nil
+	}
+	schemaObjectId, ok := id.(sdk.SchemaObjectIdentifier)
+	if !ok {
+		return nil, fmt.Errorf("signature can only be specified for schema objects, got: %s", identifier)
+	}
+	dataTypes := make([]sdk.DataType, 0, len(arguments))
+	for _, argument := range arguments {
+		dataType, err := sdk.ToDataType(argument)
+		if err != nil {
+			return nil, err
+		}
+		dataTypes = append(dataTypes, dataType)
+	}
+	return sdk.NewSchemaObjectIdentifierWithArguments(schemaObjectId.DatabaseName(), schemaObjectId.SchemaName(), schemaObjectId.Name(), dataTypes), nil
+}
+
+// splitSignature splits an identifier like "db"."schema"."name"(NUMBER(38, 0), VARCHAR) into the name part and the
+// list of argument types. Parentheses and commas inside quoted parts or type modifiers are not treated as separators.
+func splitSignature(identifier string) (string, []string, bool) {
+	if !strings.HasSuffix(identifier, ")") {
+		return identifier, nil, false
+	}
+	inQuotes := false
+	for i, r := range identifier {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+		case r == '(' && !inQuotes:
+			arguments := make([]string, 0)
+			depth, start := 0, i+1
+			for j := i + 1; j < len(identifier)-1; j++ {
+				switch identifier[j] {
+				case '(':
+					depth++
+				case ')':
+					depth--
+				case ',':
+					if depth == 0 {
+						arguments = append(arguments, strings.TrimSpace(identifier[start:j]))
+						start = j + 1
+					}
+				}
+			}
+			if last := strings.TrimSpace(identifier[start : len(identifier)-1]); last != "" || len(arguments) > 0 {
+				arguments = append(arguments, last)
+			}
+			return identifier[:i], arguments, true
+		}
+	}
+	return identifier, nil, false
+}
+
+// DecodeParameterObject returns the object on which parameters can be shown, set, or unset. The objectName is parsed
+// with DecodeSnowflakeParameterID and has to match the given object type: it should be empty for the account, a single
+// part for users, warehouses and databases, two parts for schemas, and three parts for tables and tasks.
+func DecodeParameterObject(objectType sdk.ObjectType, objectName string) (sdk.Object, error) {
+	if objectType == sdk.ObjectTypeAccount {
+		if objectName != "" {
+			return sdk.Object{}, fmt.Errorf("object name must be empty for object type %s", objectType)
+		}
+		return sdk.Object{ObjectType: objectType}, nil
+	}
+	if objectName == "" {
+		return sdk.Object{}, fmt.Errorf("object name is required for object type %s", objectType)
+	}
+	id, err := DecodeSnowflakeParameterID(objectName)
+	if err != nil {
+		return sdk.Object{}, err
+	}
+	var ok bool
+	switch objectType {
+	case sdk.ObjectTypeUser, sdk.ObjectTypeWarehouse, sdk.ObjectTypeDatabase:
+		_, ok = id.(sdk.AccountObjectIdentifier)
+	case sdk.ObjectTypeSchema:
+		_, ok = id.(sdk.DatabaseObjectIdentifier)
+	case sdk.ObjectTypeTable, sdk.ObjectTypeTask:
+		_, ok = id.(sdk.SchemaObjectIdentifier)
+	default:
+		return sdk.Object{}, fmt.Errorf("object type %s does not support parameters", objectType)
+	}
+	if !ok {
+		return sdk.Object{}, fmt.Errorf("object name %s is not a valid identifier for object type %s", objectName, objectType)
+	}
+	return sdk.Object{ObjectType: objectType, Name: id}, nil
+}
+
 func Retry(attempts int, sleepDuration time.Duration, f func() (error, bool)) error {
 	for i := 0; i < attempts; i++ {
 		err, done := f()
@@ -74,6 +74,52 @@ func TestDecodeSnowflakeParameterID(t *testing.T) {
 	})
 }
 
+func TestDecodeSnowflakeParameterIDWithArguments(t *testing.T) {
+	testCases := map[string]struct {
+		id                 string
+		fullyQualifiedName string
+	}{
+		"decodes identifier without arguments": {
+			id:                 `db.schema.name`,
+			fullyQualifiedName: `"db"."schema"."name"`,
+		},
+		"decodes quoted signature": {
+			id:                 `"db"."schema"."test.name"(NUMBER, VARCHAR)`,
+			fullyQualifiedName: `"db"."schema"."test.name"(NUMBER, VARCHAR)`,
+		},
+		"decodes unquoted signature with synonyms and type modifiers": {
+			id:                 `db.schema.name(int, number(38, 0), string)`,
+			fullyQualifiedName: `"db"."schema"."name"(NUMBER, NUMBER, VARCHAR)`,
+		},
+		"decodes signature without arguments": {
+			id:                 `"db"."schema"."name"()`,
+			fullyQualifiedName: `"db"."schema"."name"`,
+		},
+		"decodes quoted name with parentheses": {
+			id:                 `"db"."schema"."na(m)e"`,
+			fullyQualifiedName: `"db"."schema"."na(m)e"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			id, err := DecodeSnowflakeParameterIDWithArguments(tc.id)
+			require.NoError(t, err)
+			require.Equal(t, tc.fullyQualifiedName, id.FullyQualifiedName())
+		})
+	}
+
+	t.Run("signature for database object", func(t *testing.T) {
+		_, err := DecodeSnowflakeParameterIDWithArguments(`db.name(VARCHAR)`)
+		require.ErrorContains(t, err, "signature can only be specified for schema objects")
+	})
+
+	t.Run("invalid argument type", func(t *testing.T) {
+		_, err := DecodeSnowflakeParameterIDWithArguments(`db.schema.name(NOT_A_TYPE)`)
+		require.ErrorContains(t, err, "invalid data type: NOT_A_TYPE")
+	})
+}
+
 // TODO: add tests for non object identifiers
 func TestEncodeSnowflakeID(t *testing.T) {
 	testCases := map[string]struct {
@@ -185,3 +231,37 @@ func (i unsupportedObjectIdentifier) Name() string {
 func (i unsupportedObjectIdentifier) FullyQualifiedName() string {
 	return "fully qualified name"
 }
+
+func TestDecodeParameterObject(t *testing.T) {
+	testCases := map[string]struct {
+		objectType         sdk.ObjectType
+		objectName         string
+		fullyQualifiedName string
+		err                string
+	}{
+		"account":             {objectType: sdk.ObjectTypeAccount},
+		"account with name":   {objectType: sdk.ObjectTypeAccount, objectName: "acc", err: "object name must be empty"},
+		"user":                {objectType: sdk.ObjectTypeUser, objectName: "usr", fullyQualifiedName: `"usr"`},
+		"schema":              {objectType: sdk.ObjectTypeSchema, objectName: `db."sch.ema"`, fullyQualifiedName: `"db"."sch.ema"`},
+		"table":               {objectType: sdk.ObjectTypeTable, objectName: "db.sch.tab", fullyQualifiedName: `"db"."sch"."tab"`},
+		"missing name":        {objectType: sdk.ObjectTypeDatabase, err: "object name is required"},
+		"mismatched name":     {objectType: sdk.ObjectTypeTask, objectName: "db.sch", err: "is not a valid identifier"},
+		"unsupported type":    {objectType: sdk.ObjectTypeRole, objectName: "role", err: "does not support parameters"},
+		"invalid object name": {objectType: sdk.ObjectTypeDatabase, objectName: `"db`, err: "unable to read identifier"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			object, err := DecodeParameterObject(tc.objectType, tc.objectName)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.objectType, object.ObjectType)
+			if tc.fullyQualifiedName != "" {
+				require.Equal(t, tc.fullyQualifiedName, object.Name.FullyQualifiedName())
+			}
+		})
+	}
+}
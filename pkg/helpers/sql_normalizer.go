@@ -0,0 +1,175 @@
+package helpers
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SQLNormalizationOptions controls how NormalizeSQL canonicalizes a statement.
+type SQLNormalizationOptions struct {
+	// IgnoreComments drops line (--, //) and block (/* */) comments from the output.
+	IgnoreComments bool
+}
+
+type sqlTokenKind int
+
+const (
+	sqlTokenWord sqlTokenKind = iota
+	sqlTokenNumber
+	sqlTokenString
+	sqlTokenQuotedIdentifier
+	sqlTokenComment
+	sqlTokenSymbol
+)
+
+type sqlToken struct {
+	kind  sqlTokenKind
+	value string
+	// start is the index of the first rune of the token in the tokenized statement.
+	start int
+}
+
+// NormalizeSQL returns a canonical form of a Snowflake SQL statement which can be used to compare
+// statements for semantic equality. Snowflake does not round-trip statements faithfully: it may change
+// the case of keywords, strip trailing semicolons and rewrite line endings. The canonical form:
+//   - folds unquoted words (keywords and identifiers) to upper case,
+//   - collapses all whitespace outside string literals and quoted identifiers into a single space between tokens,
+//   - keeps single-quoted, dollar-quoted and double-quoted text verbatim,
+//   - removes trailing semicolons,
+//   - drops comments if requested in opts.
+func NormalizeSQL(sql string, opts SQLNormalizationOptions) string {
+	tokens := tokenizeSQL(sql)
+
+	parts := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		switch token.kind {
+		case sqlTokenWord:
+			parts = append(parts, strings.ToUpper(token.value))
+		case sqlTokenComment:
+			if !opts.IgnoreComments {
+				parts = append(parts, strings.TrimRightFunc(token.value, unicode.IsSpace))
+			}
+		default:
+			parts = append(parts, token.value)
+		}
+	}
+
+	for len(parts) > 0 && parts[len(parts)-1] == ";" {
+		parts = parts[:len(parts)-1]
+	}
+	return strings.Join(parts, " ")
+}
+
+// NormalizeCodeBody returns a canonical form of a non-SQL function or procedure body (JavaScript, Python, Java, Scala).
+// Only line endings and trailing whitespace are normalized, because both case and indentation may be significant.
+func NormalizeCodeBody(body string) string {
+	body = strings.ReplaceAll(body, "\r\n", "\n")
+	body = strings.ReplaceAll(body, "\r", "\n")
+	lines := strings.Split(body, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRightFunc(line, unicode.IsSpace)
+	}
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
+
+func tokenizeSQL(sql string) []sqlToken {
+	runes := []rune(sql)
+	tokens := make([]sqlToken, 0)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '-' && peek(runes, i+1) == '-', r == '/' && peek(runes, i+1) == '/':
+			end := indexFrom(runes, i, "\n")
+			tokens = append(tokens, sqlToken{kind: sqlTokenComment, value: string(runes[i:end]), start: i})
+			i = end
+		case r == '/' && peek(runes, i+1) == '*':
+			end := indexFrom(runes, i+2, "*/")
+			if end < len(runes) {
+				end += 2
+			}
+			tokens = append(tokens, sqlToken{kind: sqlTokenComment, value: string(runes[i:end]), start: i})
+			i = end
+		case r == '$' && peek(runes, i+1) == '$':
+			end := indexFrom(runes, i+2, "$$")
+			if end < len(runes) {
+				end += 2
+			}
+			tokens = append(tokens, sqlToken{kind: sqlTokenString, value: string(runes[i:end]), start: i})
+			i = end
+		case r == '\'':
+			end := scanQuoted(runes, i, '\'', true)
+			tokens = append(tokens, sqlToken{kind: sqlTokenString, value: string(runes[i:end]), start: i})
+			i = end
+		case r == '"':
+			end := scanQuoted(runes, i, '"', false)
+			tokens = append(tokens, sqlToken{kind: sqlTokenQuotedIdentifier, value: string(runes[i:end]), start: i})
+			i = end
+		case unicode.IsDigit(r) || (r == '.' && unicode.IsDigit(peek(runes, i+1))):
+			end := i + 1
+			for end < len(runes) && (unicode.IsDigit(runes[end]) || runes[end] == '.' || unicode.ToLower(runes[end]) == 'e' ||
+				((runes[end] == '+' || runes[end] == '-') && unicode.ToLower(runes[end-1]) == 'e')) {
+				end++
+			}
+			tokens = append(tokens, sqlToken{kind: sqlTokenNumber, value: string(runes[i:end]), start: i})
+			i = end
+		case isSQLWordStart(r):
+			end := i + 1
+			for end < len(runes) && isSQLWordPart(runes[end]) {
+				end++
+			}
+			tokens = append(tokens, sqlToken{kind: sqlTokenWord, value: string(runes[i:end]), start: i})
+			i = end
+		default:
+			tokens = append(tokens, sqlToken{kind: sqlTokenSymbol, value: string(r), start: i})
+			i++
+		}
+	}
+	return tokens
+}
+
+func isSQLWordStart(r rune) bool {
+	return unicode.IsLetter(r) || r == '_' || r == '$'
+}
+
+func isSQLWordPart(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '$'
+}
+
+func peek(runes []rune, i int) rune {
+	if i < len(runes) {
+		return runes[i]
+	}
+	return 0
+}
+
+// indexFrom returns the index of the first occurrence of substr in runes starting at from, or len(runes) if not found.
+func indexFrom(runes []rune, from int, substr string) int {
+	if from >= len(runes) {
+		return len(runes)
+	}
+	idx := strings.Index(string(runes[from:]), substr)
+	if idx < 0 {
+		return len(runes)
+	}
+	return from + len([]rune(string(runes[from:])[:idx]))
+}
+
+// scanQuoted returns the index right after the closing quote of the literal starting at start.
+// Doubled quotes are treated as escaped quotes; backslash escapes are honored when allowBackslash is set.
+func scanQuoted(runes []rune, start int, quote rune, allowBackslash bool) int {
+	for i := start + 1; i < len(runes); i++ {
+		switch {
+		case allowBackslash && runes[i] == '\\':
+			i++
+		case runes[i] == quote:
+			if peek(runes, i+1) == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(runes)
+}
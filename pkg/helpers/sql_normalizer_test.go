@@ -0,0 +1,83 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeSQL(t *testing.T) {
+	testCases := map[string]struct {
+		input    string
+		opts     SQLNormalizationOptions
+		expected string
+	}{
+		"folds keyword case": {
+			input:    `select id from my_table`,
+			expected: `SELECT ID FROM MY_TABLE`,
+		},
+		"collapses whitespace and line endings": {
+			input:    "SELECT\r\n\tid ,\n  name\r\nFROM   t",
+			expected: `SELECT ID , NAME FROM T`,
+		},
+		"keeps string literals verbatim": {
+			input:    `select 'Hello   World', 'it''s', 'a\'b' from t`,
+			expected: `SELECT 'Hello   World' , 'it''s' , 'a\'b' FROM T`,
+		},
+		"keeps quoted identifiers verbatim": {
+			input:    `select "MixedCase  Column" from "db"."schema"."t"`,
+			expected: `SELECT "MixedCase  Column" FROM "db" . "schema" . "t"`,
+		},
+		"keeps dollar quoted strings verbatim": {
+			input:    "select $$ Some\n  Text $$",
+			expected: "SELECT $$ Some\n  Text $$",
+		},
+		"removes trailing semicolons": {
+			input:    "select 1;\n;  ",
+			expected: `SELECT 1`,
+		},
+		"keeps comments by default": {
+			input:    "select 1 -- one  \n/* block */ + 2",
+			expected: `SELECT 1 -- one /* block */ + 2`,
+		},
+		"drops comments when configured": {
+			input:    "select 1 -- one\n// two\n/* block */ + 2",
+			opts:     SQLNormalizationOptions{IgnoreComments: true},
+			expected: `SELECT 1 + 2`,
+		},
+		"handles numbers and column references": {
+			input:    `select $1, 1.5e-3, .5 from @stage`,
+			expected: `SELECT $1 , 1.5e-3 , .5 FROM @ STAGE`,
+		},
+		"handles unterminated literals": {
+			input:    `select 'abc`,
+			expected: `SELECT 'abc`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, NormalizeSQL(tc.input, tc.opts))
+		})
+	}
+
+	t.Run("statements differing only in formatting are equal", func(t *testing.T) {
+		configured := "SELECT id, name\nFROM users\nWHERE name = 'Bob';\n"
+		returned := "select id,name from users where name = 'Bob'"
+		require.Equal(t, NormalizeSQL(configured, SQLNormalizationOptions{}), NormalizeSQL(returned, SQLNormalizationOptions{}))
+	})
+
+	t.Run("statements differing in string literal case are not equal", func(t *testing.T) {
+		require.NotEqual(t, NormalizeSQL(`select 'bob'`, SQLNormalizationOptions{}), NormalizeSQL(`select 'BOB'`, SQLNormalizationOptions{}))
+	})
+}
+
+func TestNormalizeCodeBody(t *testing.T) {
+	t.Run("normalizes line endings and trailing whitespace", func(t *testing.T) {
+		require.Equal(t, "def f():\n    return 1", NormalizeCodeBody("\r\ndef f():  \r\n    return 1\r\n"))
+	})
+
+	t.Run("keeps case and indentation", func(t *testing.T) {
+		require.NotEqual(t, NormalizeCodeBody("def f():\n    return X"), NormalizeCodeBody("def f():\n  return x"))
+	})
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// reportedAttributes are the provider fields listed in the configuration report, with the connection settings they set
+// and the environment variables they can be sourced from. The deprecated username is listed before user, so that user
+// wins when both are set (as in ConfigureProvider).
+var reportedAttributes = []struct {
+	attribute string
+	setting   string
+	envVar    string
+}{
+	{"account", "account", "SNOWFLAKE_ACCOUNT"},
+	{"username", "user", "SNOWFLAKE_USERNAME"},
+	{"user", "user", "SNOWFLAKE_USER"},
+	{"password", "password", "SNOWFLAKE_PASSWORD"},
+	{"host", "host", "SNOWFLAKE_HOST"},
+	{"port", "port", "SNOWFLAKE_PORT"},
+	{"protocol", "protocol", "SNOWFLAKE_PROTOCOL"},
+	{"region", "region", "SNOWFLAKE_REGION"},
+	{"role", "role", "SNOWFLAKE_ROLE"},
+	{"warehouse", "warehouse", "SNOWFLAKE_WAREHOUSE"},
+	{"authenticator", "authenticator", "SNOWFLAKE_AUTHENTICATOR"},
+	{"token", "token", "SNOWFLAKE_TOKEN"},
+	{"private_key", "private_key", "SNOWFLAKE_PRIVATE_KEY"},
+	{"private_key_path", "private_key_path", "SNOWFLAKE_PRIVATE_KEY_PATH"},
+	{"private_key_passphrase", "private_key_passphrase", "SNOWFLAKE_PRIVATE_KEY_PASSPHRASE"},
+	{"profile", "profile", "SNOWFLAKE_PROFILE"},
+}
+
+// configurationReport lists the effective connection settings together with the source that supplied them: the
+// provider block (HCL), an environment variable or the line of the profile file. The secrets are masked.
+type configurationReport struct {
+	settings map[string]sdk.ConnectionSetting
+}
+
+func newConfigurationReport(s *schema.ResourceData) *configurationReport {
+	report := &configurationReport{settings: make(map[string]sdk.ConnectionSetting)}
+	for _, reported := range reportedAttributes {
+		v, ok := s.GetOk(reported.attribute)
+		if !ok {
+			continue
+		}
+		value := fmt.Sprintf("%v", v)
+		report.set(reported.setting, sdk.ConnectionSetting{Value: value, Source: attributeSource(s, reported.attribute, reported.envVar, value)})
+	}
+	return report
+}
+
+// attributeSource tells if the attribute was set in the provider block, sourced from the environment variable or left
+// with its default value. Without the raw configuration, the values equal to the environment variable are attributed
+// to it.
+func attributeSource(s *schema.ResourceData, attribute string, envVar string, value string) string {
+	envValue, envSet := os.LookupEnv(envVar)
+	inConfig := !envSet || envValue != value
+	if raw := s.GetRawConfig(); !raw.IsNull() && raw.Type().HasAttribute(attribute) {
+		inConfig = !raw.GetAttr(attribute).IsNull()
+	}
+	switch {
+	case inConfig:
+		return "HCL"
+	case envSet:
+		return "environment variable " + envVar
+	default:
+		return "default"
+	}
+}
+
+func (r *configurationReport) set(key string, setting sdk.ConnectionSetting) {
+	r.settings[key] = setting
+}
+
+func (r *configurationReport) String() string {
+	keys := make([]string, 0, len(r.settings))
+	width := 0
+	for key := range r.settings {
+		keys = append(keys, key)
+		width = max(width, len(key))
+	}
+	slices.Sort(keys)
+	var b strings.Builder
+	for _, key := range keys {
+		setting := r.settings[key]
+		value := setting.Value
+		if key == "private_key" || sdk.IsSecretConnectionKey(key) {
+			value = sdk.RedactedValue
+		}
+		fmt.Fprintf(&b, "%-*s = %s (%s)\n", width, key, value, setting.Source)
+	}
+	return b.String()
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigurationReport_ProfilePrecedence(t *testing.T) {
+	home := t.TempDir()
+	connectionsPath := filepath.Join(home, "connections.toml")
+	require.NoError(t, os.WriteFile(connectionsPath, []byte(`
+[prod]
+account = "FILE-ACCOUNT"
+user = "FILE-USER"
+password = "file-password"
+role = "FILE-ROLE"
+warehouse = "FILE-WAREHOUSE"
+`), 0o600))
+	t.Setenv("SNOWFLAKE_HOME", home)
+	t.Setenv("SNOWFLAKE_CONFIG_PATH", filepath.Join(home, "config"))
+	t.Setenv("SNOWFLAKE_ROLE", "ENV-ROLE")
+	t.Setenv("SNOWFLAKE_CONNECTIONS_PROD_WAREHOUSE", "CONNECTION-ENV-WAREHOUSE")
+
+	s := schema.TestResourceDataRaw(t, Provider().Schema, map[string]any{
+		"account": "HCL-ACCOUNT",
+		"profile": "prod",
+	})
+	config := &gosnowflake.Config{Account: "HCL-ACCOUNT", Role: "ENV-ROLE"}
+	report := newConfigurationReport(s)
+	connection, err := loadProfile(s)
+	require.NoError(t, err)
+	require.NoError(t, applyConnection(config, connection, report))
+
+	assert.Equal(t, "HCL-ACCOUNT", config.Account)
+	assert.Equal(t, "ENV-ROLE", config.Role)
+	assert.Equal(t, "FILE-USER", config.User)
+	assert.Equal(t, "file-password", config.Password)
+	assert.Equal(t, "CONNECTION-ENV-WAREHOUSE", config.Warehouse)
+	assert.Equal(t, `account   = HCL-ACCOUNT (HCL)
+password  = *** (`+connectionsPath+`:5)
+profile   = prod (HCL)
+role      = ENV-ROLE (environment variable SNOWFLAKE_ROLE)
+user      = FILE-USER (`+connectionsPath+`:4)
+warehouse = CONNECTION-ENV-WAREHOUSE (environment variable SNOWFLAKE_CONNECTIONS_PROD_WAREHOUSE)
+`, report.String())
+}
+
+func TestConfigurationReport_MissingProfile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("SNOWFLAKE_HOME", home)
+	t.Setenv("SNOWFLAKE_CONFIG_PATH", filepath.Join(home, "config"))
+
+	t.Run("named profile", func(t *testing.T) {
+		s := schema.TestResourceDataRaw(t, Provider().Schema, map[string]any{"profile": "prod"})
+		_, err := loadProfile(s)
+		require.ErrorContains(t, err, "profile with name: prod not found in config file")
+	})
+
+	t.Run("default profile", func(t *testing.T) {
+		s := schema.TestResourceDataRaw(t, Provider().Schema, map[string]any{})
+		connection, err := loadProfile(s)
+		require.NoError(t, err)
+		assert.Nil(t, connection)
+	})
+}
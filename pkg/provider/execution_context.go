@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"maps"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// executionContextSchema holds the fields added to every resource to run its operations with another role and
+// warehouse than the ones of the provider.
+var executionContextSchema = map[string]*schema.Schema{
+	"execution_role": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Role the statements of the resource are executed with instead of the role of the provider, e.g. to create the object owned by a functional role without a separate provider alias. The operations with an execution context use a separate connection to Snowflake per context.",
+	},
+	"execution_secondary_roles": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Secondary roles activated for the statements of the resource: the role names, or a single `ALL` or `NONE`.",
+	},
+	"execution_warehouse": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Warehouse the statements of the resource are executed with instead of the warehouse of the provider.",
+	},
+}
+
+// withExecutionContext adds the execution context fields to the given resources and runs their CRUD and CustomizeDiff
+// functions with the connection of the configured context (see sdk.DBWithExecutionContext). The resources without the
+// update get one, as the execution context can be changed in place.
+func withExecutionContext(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for _, resource := range resources {
+		resource.Schema = maps.Clone(resource.Schema)
+		for name, fieldSchema := range executionContextSchema {
+			resource.Schema[name] = fieldSchema
+		}
+		if resource.UpdateContext == nil && resource.UpdateWithoutTimeout == nil {
+			// nothing but the execution context can change, so only the state has to be refreshed
+			resource.UpdateContext = schema.UpdateContextFunc(resource.ReadContext)
+			resource.UpdateWithoutTimeout = schema.UpdateContextFunc(resource.ReadWithoutTimeout)
+		}
+		resource.CreateContext = withExecutionContextDB(resource.CreateContext)
+		resource.ReadContext = withExecutionContextDB(resource.ReadContext)
+		resource.UpdateContext = withExecutionContextDB(resource.UpdateContext)
+		resource.DeleteContext = withExecutionContextDB(resource.DeleteContext)
+		resource.CreateWithoutTimeout = withExecutionContextDB(resource.CreateWithoutTimeout)
+		resource.ReadWithoutTimeout = withExecutionContextDB(resource.ReadWithoutTimeout)
+		resource.UpdateWithoutTimeout = withExecutionContextDB(resource.UpdateWithoutTimeout)
+		resource.DeleteWithoutTimeout = withExecutionContextDB(resource.DeleteWithoutTimeout)
+		resource.CustomizeDiff = withExecutionContextDiffDB(resource.CustomizeDiff)
+	}
+	return resources
+}
+
+func withExecutionContextDB[T ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](f T) T {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		db, ok := meta.(*sql.DB)
+		if !ok {
+			return f(ctx, d, meta)
+		}
+		contextDB, err := sdk.DBWithExecutionContext(db, executionContextOf(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return f(ctx, d, contextDB)
+	}
+}
+
+func withExecutionContextDiffDB(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		db, ok := meta.(*sql.DB)
+		if !ok {
+			return f(ctx, d, meta)
+		}
+		contextDB, err := sdk.DBWithExecutionContext(db, executionContextOf(d))
+		if err != nil {
+			return err
+		}
+		return f(ctx, d, contextDB)
+	}
+}
+
+// executionContextOf reads the execution context of the resource from its data or its planned diff.
+func executionContextOf(d interface{ Get(string) any }) sdk.ExecutionContext {
+	ec := sdk.ExecutionContext{
+		Role:      d.Get("execution_role").(string),
+		Warehouse: d.Get("execution_warehouse").(string),
+	}
+	for _, role := range d.Get("execution_secondary_roles").([]any) {
+		ec.SecondaryRoles = append(ec.SecondaryRoles, role.(string))
+	}
+	return ec
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithExecutionContext(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	var metas []any
+	recordMeta := func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		metas = append(metas, meta)
+		return nil
+	}
+	resourceSchema := map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Required: true, ForceNew: true},
+	}
+	resources := withExecutionContext(map[string]*schema.Resource{
+		"snowflake_test": {
+			Schema:               resourceSchema,
+			CreateWithoutTimeout: recordMeta,
+			ReadWithoutTimeout:   recordMeta,
+			DeleteWithoutTimeout: recordMeta,
+			CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+				metas = append(metas, meta)
+				return nil
+			},
+		},
+	})
+	resource := resources["snowflake_test"]
+	require.NoError(t, resource.InternalValidate(nil, true))
+
+	assert.NotContains(t, resourceSchema, "execution_role", "the schema of the resource can be shared with other resources")
+	for name := range executionContextSchema {
+		assert.Contains(t, resource.Schema, name)
+	}
+	require.NotNil(t, resource.UpdateWithoutTimeout, "the execution context can be changed in place")
+
+	t.Run("without the execution context", func(t *testing.T) {
+		metas = nil
+		d := resource.TestResourceData()
+		require.False(t, resource.CreateWithoutTimeout(context.Background(), d, db).HasError())
+		require.False(t, resource.UpdateWithoutTimeout(context.Background(), d, db).HasError())
+		assert.Equal(t, []any{db, db}, metas)
+	})
+
+	t.Run("with the execution context", func(t *testing.T) {
+		metas = nil
+		d := resource.TestResourceData()
+		require.NoError(t, d.Set("execution_role", "OWNER_ROLE"))
+
+		// the connection was not opened by the provider, so it cannot be reopened with another role
+		diags := resource.ReadWithoutTimeout(context.Background(), d, db)
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Summary, `role: "OWNER_ROLE"`)
+		assert.Empty(t, metas)
+	})
+
+	t.Run("customize diff", func(t *testing.T) {
+		metas = nil
+		_, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]any{"name": "test"}), db)
+		require.NoError(t, err)
+		require.NotEmpty(t, metas)
+		for _, meta := range metas {
+			assert.Same(t, db, meta)
+		}
+
+		metas = nil
+		_, err = resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]any{"name": "test", "execution_role": "OWNER_ROLE"}), db)
+		require.ErrorContains(t, err, `role: "OWNER_ROLE"`)
+		assert.Empty(t, metas)
+	})
+
+	t.Run("meta other than the connection", func(t *testing.T) {
+		metas = nil
+		d := resource.TestResourceData()
+		require.NoError(t, d.Set("execution_role", "OWNER_ROLE"))
+		require.False(t, resource.DeleteWithoutTimeout(context.Background(), d, "meta").HasError())
+		assert.Equal(t, []any{"meta"}, metas)
+	})
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/tracing"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// withInstrumentation wraps the CRUD functions of the given resources, so that each call gets its own tracing span.
+// The span is passed in the context, so the spans of the executed statements are its children.
+func withInstrumentation(resourceTypePrefix string, resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for name, resource := range resources {
+		resourceType := resourceTypePrefix + name
+		resource.CreateContext = instrumented(resourceType, "CREATE", resource.CreateContext)
+		resource.ReadContext = instrumented(resourceType, "READ", resource.ReadContext)
+		resource.UpdateContext = instrumented(resourceType, "UPDATE", resource.UpdateContext)
+		resource.DeleteContext = instrumented(resourceType, "DELETE", resource.DeleteContext)
+		resource.CreateWithoutTimeout = instrumented(resourceType, "CREATE", resource.CreateWithoutTimeout)
+		resource.ReadWithoutTimeout = instrumented(resourceType, "READ", resource.ReadWithoutTimeout)
+		resource.UpdateWithoutTimeout = instrumented(resourceType, "UPDATE", resource.UpdateWithoutTimeout)
+		resource.DeleteWithoutTimeout = instrumented(resourceType, "DELETE", resource.DeleteWithoutTimeout)
+	}
+	return resources
+}
+
+func instrumented[T ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](resourceType string, operation string, f T) T {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		ctx, span := tracing.StartOperation(ctx, resourceType, d.Id(), operation)
+		diags := f(ctx, d, meta)
+		tracing.EndOperation(span, diags.HasError())
+		return diags
+	}
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestWithInstrumentation(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previousProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previousProvider) })
+
+	var spanContexts []trace.SpanContext
+	resources := withInstrumentation("", map[string]*schema.Resource{
+		"snowflake_test": {
+			Schema: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Required: true, ForceNew: true},
+			},
+			CreateContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				spanContexts = append(spanContexts, trace.SpanContextFromContext(ctx))
+				d.SetId("ID")
+				return nil
+			},
+			ReadContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				spanContexts = append(spanContexts, trace.SpanContextFromContext(ctx))
+				return diag.FromErr(errors.New("read failed"))
+			},
+			DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics { return nil },
+		},
+	})
+
+	resource := resources["snowflake_test"]
+	require.NoError(t, resource.InternalValidate(nil, true))
+	assert.Nil(t, resource.UpdateContext)
+	assert.Nil(t, resource.CreateWithoutTimeout)
+
+	d := resource.Data(nil)
+	assert.False(t, resource.CreateContext(context.Background(), d, nil).HasError())
+	diags := resource.ReadContext(context.Background(), d, nil)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "read failed", diags[0].Summary)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "snowflake_test CREATE", spans[0].Name())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, "snowflake_test READ", spans[1].Name())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Contains(t, spans[1].Attributes(), attribute.String("terraform.resource_id", "ID"))
+	// the statement spans started by the functions are children of the operation spans
+	require.Len(t, spanContexts, 2)
+	assert.Equal(t, spans[0].SpanContext(), spanContexts[0])
+	assert.Equal(t, spans[1].SpanContext(), spanContexts[1])
+}
@@ -1,13 +1,17 @@
 package provider
 
 import (
-	"errors"
+	"context"
 	"fmt"
+	"log"
 	"net"
 	"net/url"
+	"os"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/snowflakedb/gosnowflake"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
@@ -18,381 +22,439 @@ import (
 // Provider returns a Terraform Provider using configuration from https://pkg.go.dev/github.com/snowflakedb/gosnowflake#Config
 func Provider() *schema.Provider {
 	return &schema.Provider{
-		Schema: map[string]*schema.Schema{
-			"account": {
-				Type:        schema.TypeString,
-				Description: "Specifies your Snowflake account identifier assigned, by Snowflake. For information about account identifiers, see the [Snowflake documentation](https://docs.snowflake.com/en/user-guide/admin-account-identifier.html). Can also be sourced from the `SNOWFLAKE_ACCOUNT` environment variable. Required unless using `profile`.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_ACCOUNT", nil),
-			},
-			"user": {
-				Type:        schema.TypeString,
-				Description: "Username. Can also be sourced from the `SNOWFLAKE_USER` environment variable. Required unless using `profile`.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_USER", nil),
-			},
-			"username": {
-				Type:        schema.TypeString,
-				Description: "Username for username+password authentication. Can also be sourced from the `SNOWFLAKE_USERNAME` environment variable. Required unless using `profile`.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_USERNAME", nil),
-				Deprecated:  "Use `user` instead of `username`",
-			},
-			"password": {
-				Type:          schema.TypeString,
-				Description:   "Password for username+password auth. Cannot be used with `browser_auth` or `private_key_path`. Can also be sourced from the `SNOWFLAKE_PASSWORD` environment variable.",
-				Optional:      true,
-				Sensitive:     true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_PASSWORD", nil),
-				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "oauth_access_token", "oauth_refresh_token"},
-			},
-			// todo: add database and schema once unqualified identifiers are supported
-			"warehouse": {
-				Type:        schema.TypeString,
-				Description: "Specifies the virtual warehouse to use by default for queries, loading, etc. in the client session. Can also be sourced from the `SNOWFLAKE_WAREHOUSE` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_WAREHOUSE", nil),
-			},
-			"role": {
-				Type:        schema.TypeString,
-				Description: "Specifies the role to use by default for accessing Snowflake objects in the client session. Can also be sourced from the `SNOWFLAKE_ROLE` environment variable. .",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_ROLE", nil),
-			},
-			"validate_default_parameters": {
-				Type:        schema.TypeBool,
-				Description: "True by default. If false, disables the validation checks for Database, Schema, Warehouse and Role at the time a connection is established. Can also be sourced from the `SNOWFLAKE_VALIDATE_DEFAULT_PARAMETERS` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_VALIDATE_DEFAULT_PARAMETERS", nil),
-			},
-			"params": {
-				Type:        schema.TypeMap,
-				Description: "Sets other connection (i.e. session) parameters. [Parameters](https://docs.snowflake.com/en/sql-reference/parameters)",
-				Optional:    true,
-			},
-			"client_ip": {
-				Type:        schema.TypeString,
-				Description: "IP address for network checks. Can also be sourced from the `SNOWFLAKE_CLIENT_IP` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_CLIENT_IP", nil),
-			},
-			"protocol": {
-				Type:        schema.TypeString,
-				Description: "Either http or https, defaults to https. Can also be sourced from the `SNOWFLAKE_PROTOCOL` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_PROTOCOL", nil),
-				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-					switch val.(string) {
-					case "http", "https":
-						return nil, nil
-					default:
-						errs := append(errs, fmt.Errorf("%q must be one of http or https", key))
-						return warns, errs
-					}
-				},
-			},
-			"host": {
-				Type:        schema.TypeString,
-				Description: "Supports passing in a custom host value to the snowflake go driver for use with privatelink. Can also be sourced from the `SNOWFLAKE_HOST` environment variable. ",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_HOST", nil),
-			},
-			"port": {
-				Type:        schema.TypeInt,
-				Description: "Support custom port values to snowflake go driver for use with privatelink. Can also be sourced from the `SNOWFLAKE_PORT` environment variable. ",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_PORT", nil),
-			},
-			"authenticator": {
-				Type:        schema.TypeString,
-				Description: "Specifies the [authentication type](https://pkg.go.dev/github.com/snowflakedb/gosnowflake#AuthType) to use when connecting to Snowflake. Valid values include: Snowflake, OAuth, ExternalBrowser, Okta, JWT, TokenAccessor, UsernamePasswordMFA. Can also be sourced from the `SNOWFLAKE_AUTHENTICATOR` environment variable. It has to be set explicitly to JWT for private key authentication.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_AUTHENTICATOR", nil),
-				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-					switch val.(string) {
-					case "Snowflake", "OAuth", "ExternalBrowser", "Okta", "JWT", "TokenAccessor", "UsernamePasswordMFA":
-						return nil, nil
-					default:
-						errs := append(errs, fmt.Errorf("%q must be one of Snowflake, OAuth, ExternalBrowser, Okta, JWT, TokenAccessor or UsernamePasswordMFA", key))
-						return warns, errs
-					}
-				},
-			},
-			"passcode": {
-				Type:          schema.TypeString,
-				Description:   "Specifies the passcode provided by Duo when using multi-factor authentication (MFA) for login. Can also be sourced from the `SNOWFLAKE_PASSCODE` environment variable. ",
-				Optional:      true,
-				ConflictsWith: []string{"passcode_in_password"},
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_PASSCODE", nil),
-			},
-			"passcode_in_password": {
-				Type:          schema.TypeBool,
-				Description:   "False by default. Set to true if the MFA passcode is embedded in the login password. Appends the MFA passcode to the end of the password. Can also be sourced from the `SNOWFLAKE_PASSCODE_IN_PASSWORD` environment variable. ",
-				Optional:      true,
-				ConflictsWith: []string{"passcode"},
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_PASSCODE_IN_PASSWORD", nil),
-			},
-			"okta_url": {
-				Type:        schema.TypeString,
-				Description: "The URL of the Okta server. e.g. https://example.okta.com. Can also be sourced from the `SNOWFLAKE_OKTA_URL` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_OKTA_URL", nil),
-			},
-			"login_timeout": {
-				Type:        schema.TypeInt,
-				Description: "Login retry timeout EXCLUDING network roundtrip and read out http response. Can also be sourced from the `SNOWFLAKE_LOGIN_TIMEOUT` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_LOGIN_TIMEOUT", nil),
-			},
-			"request_timeout": {
-				Type:        schema.TypeInt,
-				Description: "request retry timeout EXCLUDING network roundtrip and read out http response. Can also be sourced from the `SNOWFLAKE_REQUEST_TIMEOUT` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_REQUEST_TIMEOUT", nil),
-			},
-			"jwt_expire_timeout": {
-				Type:        schema.TypeInt,
-				Description: "JWT expire after timeout in seconds. Can also be sourced from the `SNOWFLAKE_JWT_EXPIRE_TIMEOUT` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_JWT_EXPIRE_TIMEOUT", nil),
-			},
-			"client_timeout": {
-				Type:        schema.TypeInt,
-				Description: "The timeout in seconds for the client to complete the authentication. Default is 900 seconds. Can also be sourced from the `SNOWFLAKE_CLIENT_TIMEOUT` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_CLIENT_TIMEOUT", nil),
-			},
-			"jwt_client_timeout": {
-				Type:        schema.TypeInt,
-				Description: "The timeout in seconds for the JWT client to complete the authentication. Default is 10 seconds. Can also be sourced from the `SNOWFLAKE_JWT_CLIENT_TIMEOUT` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_JWT_CLIENT_TIMEOUT", nil),
-			},
-			"external_browser_timeout": {
-				Type:        schema.TypeInt,
-				Description: "The timeout in seconds for the external browser to complete the authentication. Default is 120 seconds. Can also be sourced from the `SNOWFLAKE_EXTERNAL_BROWSER_TIMEOUT` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_EXTERNAL_BROWSER_TIMEOUT", nil),
-			},
-			"insecure_mode": {
-				Type:        schema.TypeBool,
-				Description: "If true, bypass the Online Certificate Status Protocol (OCSP) certificate revocation check. IMPORTANT: Change the default value for testing or emergency situations only. Can also be sourced from the `SNOWFLAKE_INSECURE_MODE` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_INSECURE_MODE", nil),
-			},
-			"ocsp_fail_open": {
-				Type:        schema.TypeBool,
-				Description: "True represents OCSP fail open mode. False represents OCSP fail closed mode. Fail open true by default. Can also be sourced from the `SNOWFLAKE_OCSP_FAIL_OPEN` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_OCSP_FAIL_OPEN", nil),
+		Schema:               ProviderSchema(),
+		ResourcesMap:         withExecutionContext(withInstrumentation("", withQueryTagAnnotations("", getResources()))),
+		DataSourcesMap:       withInstrumentation("data.", withQueryTagAnnotations("data.", getDataSources())),
+		ConfigureContextFunc: ConfigureProvider,
+		ProviderMetaSchema:   map[string]*schema.Schema{},
+	}
+}
+
+// ProviderSchema returns the schema of the provider block. It is shared with the plugin framework provider
+// (framework/provider), which is served next to this one by the same mux server.
+func ProviderSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account": {
+			Type:        schema.TypeString,
+			Description: "Specifies your Snowflake account identifier assigned, by Snowflake. For information about account identifiers, see the [Snowflake documentation](https://docs.snowflake.com/en/user-guide/admin-account-identifier.html). Can also be sourced from the `SNOWFLAKE_ACCOUNT` environment variable. Required unless using `profile`.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_ACCOUNT", nil),
+		},
+		"user": {
+			Type:        schema.TypeString,
+			Description: "Username. Can also be sourced from the `SNOWFLAKE_USER` environment variable. Required unless using `profile`.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_USER", nil),
+		},
+		"username": {
+			Type:        schema.TypeString,
+			Description: "Username for username+password authentication. Can also be sourced from the `SNOWFLAKE_USERNAME` environment variable. Required unless using `profile`.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_USERNAME", nil),
+			Deprecated:  "Use `user` instead of `username`",
+		},
+		"password": {
+			Type:          schema.TypeString,
+			Description:   "Password for username+password auth. Cannot be used with `browser_auth` or `private_key_path`. Can also be sourced from the `SNOWFLAKE_PASSWORD` environment variable.",
+			Optional:      true,
+			Sensitive:     true,
+			DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_PASSWORD", nil),
+			ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "oauth_access_token", "oauth_refresh_token"},
+		},
+		// todo: add database and schema once unqualified identifiers are supported
+		"warehouse": {
+			Type:        schema.TypeString,
+			Description: "Specifies the virtual warehouse to use by default for queries, loading, etc. in the client session. Can also be sourced from the `SNOWFLAKE_WAREHOUSE` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_WAREHOUSE", nil),
+		},
+		"role": {
+			Type:        schema.TypeString,
+			Description: "Specifies the role to use by default for accessing Snowflake objects in the client session. Can also be sourced from the `SNOWFLAKE_ROLE` environment variable. .",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_ROLE", nil),
+		},
+		"validate_default_parameters": {
+			Type:        schema.TypeBool,
+			Description: "True by default. If false, disables the validation checks for Database, Schema, Warehouse and Role at the time a connection is established. Can also be sourced from the `SNOWFLAKE_VALIDATE_DEFAULT_PARAMETERS` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_VALIDATE_DEFAULT_PARAMETERS", nil),
+		},
+		"params": {
+			Type:        schema.TypeMap,
+			Description: "Sets other connection (i.e. session) parameters. [Parameters](https://docs.snowflake.com/en/sql-reference/parameters)",
+			Optional:    true,
+		},
+		"client_ip": {
+			Type:        schema.TypeString,
+			Description: "IP address for network checks. Can also be sourced from the `SNOWFLAKE_CLIENT_IP` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_CLIENT_IP", nil),
+		},
+		"protocol": {
+			Type:        schema.TypeString,
+			Description: "Either http or https, defaults to https. Can also be sourced from the `SNOWFLAKE_PROTOCOL` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_PROTOCOL", nil),
+			ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+				switch val.(string) {
+				case "http", "https":
+					return nil, nil
+				default:
+					errs := append(errs, fmt.Errorf("%q must be one of http or https", key))
+					return warns, errs
+				}
 			},
-			"token": {
-				Type:        schema.TypeString,
-				Description: "Token to use for OAuth and other forms of token based auth. Can also be sourced from the `SNOWFLAKE_TOKEN` environment variable.",
-				Sensitive:   true,
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_TOKEN", nil),
+		},
+		"host": {
+			Type:        schema.TypeString,
+			Description: "Supports passing in a custom host value to the snowflake go driver for use with privatelink. Can also be sourced from the `SNOWFLAKE_HOST` environment variable. ",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_HOST", nil),
+		},
+		"port": {
+			Type:        schema.TypeInt,
+			Description: "Support custom port values to snowflake go driver for use with privatelink. Can also be sourced from the `SNOWFLAKE_PORT` environment variable. ",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_PORT", nil),
+		},
+		"authenticator": {
+			Type:        schema.TypeString,
+			Description: "Specifies the [authentication type](https://pkg.go.dev/github.com/snowflakedb/gosnowflake#AuthType) to use when connecting to Snowflake. Valid values include: Snowflake, OAuth, ExternalBrowser, Okta, JWT, TokenAccessor, UsernamePasswordMFA. Can also be sourced from the `SNOWFLAKE_AUTHENTICATOR` environment variable. It has to be set explicitly to JWT for private key authentication.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_AUTHENTICATOR", nil),
+			ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+				switch val.(string) {
+				case "Snowflake", "OAuth", "ExternalBrowser", "Okta", "JWT", "TokenAccessor", "UsernamePasswordMFA":
+					return nil, nil
+				default:
+					errs := append(errs, fmt.Errorf("%q must be one of Snowflake, OAuth, ExternalBrowser, Okta, JWT, TokenAccessor or UsernamePasswordMFA", key))
+					return warns, errs
+				}
 			},
-			"token_accessor": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"token_endpoint": {
-							Type:        schema.TypeString,
-							Description: "The token endpoint for the OAuth provider e.g. https://{yourDomain}/oauth/token when using a refresh token to renew access token. Can also be sourced from the `SNOWFLAKE_TOKEN_ACCESSOR_TOKEN_ENDPOINT` environment variable.",
-							Required:    true,
-							Sensitive:   true,
-							DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_TOKEN_ACCESSOR_TOKEN_ENDPOINT", nil),
-						},
-						"refresh_token": {
-							Type:        schema.TypeString,
-							Description: "The refresh token for the OAuth provider when using a refresh token to renew access token. Can also be sourced from the `SNOWFLAKE_TOKEN_ACCESSOR_REFRESH_TOKEN` environment variable.",
-							Required:    true,
-							Sensitive:   true,
-							DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_TOKEN_ACCESSOR_REFRESH_TOKEN", nil),
-						},
-						"client_id": {
-							Type:        schema.TypeString,
-							Description: "The client ID for the OAuth provider when using a refresh token to renew access token. Can also be sourced from the `SNOWFLAKE_TOKEN_ACCESSOR_CLIENT_ID` environment variable.",
-							Required:    true,
-							Sensitive:   true,
-							DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_TOKEN_ACCESSOR_CLIENT_ID", nil),
-						},
-						"client_secret": {
-							Type:        schema.TypeString,
-							Description: "The client secret for the OAuth provider when using a refresh token to renew access token. Can also be sourced from the `SNOWFLAKE_TOKEN_ACCESSOR_CLIENT_SECRET` environment variable.",
-							Required:    true,
-							Sensitive:   true,
-							DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_TOKEN_ACCESSOR_CLIENT_SECRET", nil),
-						},
-						"redirect_uri": {
-							Type:        schema.TypeString,
-							Description: "The redirect URI for the OAuth provider when using a refresh token to renew access token. Can also be sourced from the `SNOWFLAKE_TOKEN_ACCESSOR_REDIRECT_URI` environment variable.",
-							Required:    true,
-							Sensitive:   true,
-							DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_TOKEN_ACCESSOR_REDIRECT_URI", nil),
-						},
+		},
+		"passcode": {
+			Type:          schema.TypeString,
+			Description:   "Specifies the passcode provided by Duo when using multi-factor authentication (MFA) for login. Can also be sourced from the `SNOWFLAKE_PASSCODE` environment variable. ",
+			Optional:      true,
+			ConflictsWith: []string{"passcode_in_password"},
+			DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_PASSCODE", nil),
+		},
+		"passcode_in_password": {
+			Type:          schema.TypeBool,
+			Description:   "False by default. Set to true if the MFA passcode is embedded in the login password. Appends the MFA passcode to the end of the password. Can also be sourced from the `SNOWFLAKE_PASSCODE_IN_PASSWORD` environment variable. ",
+			Optional:      true,
+			ConflictsWith: []string{"passcode"},
+			DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_PASSCODE_IN_PASSWORD", nil),
+		},
+		"okta_url": {
+			Type:        schema.TypeString,
+			Description: "The URL of the Okta server. e.g. https://example.okta.com. Can also be sourced from the `SNOWFLAKE_OKTA_URL` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_OKTA_URL", nil),
+		},
+		"login_timeout": {
+			Type:        schema.TypeInt,
+			Description: "Login retry timeout EXCLUDING network roundtrip and read out http response. Can also be sourced from the `SNOWFLAKE_LOGIN_TIMEOUT` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_LOGIN_TIMEOUT", nil),
+		},
+		"request_timeout": {
+			Type:        schema.TypeInt,
+			Description: "request retry timeout EXCLUDING network roundtrip and read out http response. Can also be sourced from the `SNOWFLAKE_REQUEST_TIMEOUT` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_REQUEST_TIMEOUT", nil),
+		},
+		"jwt_expire_timeout": {
+			Type:        schema.TypeInt,
+			Description: "JWT expire after timeout in seconds. Can also be sourced from the `SNOWFLAKE_JWT_EXPIRE_TIMEOUT` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_JWT_EXPIRE_TIMEOUT", nil),
+		},
+		"client_timeout": {
+			Type:        schema.TypeInt,
+			Description: "The timeout in seconds for the client to complete the authentication. Default is 900 seconds. Can also be sourced from the `SNOWFLAKE_CLIENT_TIMEOUT` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_CLIENT_TIMEOUT", nil),
+		},
+		"jwt_client_timeout": {
+			Type:        schema.TypeInt,
+			Description: "The timeout in seconds for the JWT client to complete the authentication. Default is 10 seconds. Can also be sourced from the `SNOWFLAKE_JWT_CLIENT_TIMEOUT` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_JWT_CLIENT_TIMEOUT", nil),
+		},
+		"external_browser_timeout": {
+			Type:        schema.TypeInt,
+			Description: "The timeout in seconds for the external browser to complete the authentication. Default is 120 seconds. Can also be sourced from the `SNOWFLAKE_EXTERNAL_BROWSER_TIMEOUT` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_EXTERNAL_BROWSER_TIMEOUT", nil),
+		},
+		"insecure_mode": {
+			Type:        schema.TypeBool,
+			Description: "If true, bypass the Online Certificate Status Protocol (OCSP) certificate revocation check. IMPORTANT: Change the default value for testing or emergency situations only. Can also be sourced from the `SNOWFLAKE_INSECURE_MODE` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_INSECURE_MODE", nil),
+		},
+		"ocsp_fail_open": {
+			Type:        schema.TypeBool,
+			Description: "True represents OCSP fail open mode. False represents OCSP fail closed mode. Fail open true by default. Can also be sourced from the `SNOWFLAKE_OCSP_FAIL_OPEN` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_OCSP_FAIL_OPEN", nil),
+		},
+		"token": {
+			Type:        schema.TypeString,
+			Description: "Token to use for OAuth and other forms of token based auth. Can also be sourced from the `SNOWFLAKE_TOKEN` environment variable.",
+			Sensitive:   true,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_TOKEN", nil),
+		},
+		"token_accessor": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"token_endpoint": {
+						Type:        schema.TypeString,
+						Description: "The token endpoint for the OAuth provider e.g. https://{yourDomain}/oauth/token when using a refresh token to renew access token. Can also be sourced from the `SNOWFLAKE_TOKEN_ACCESSOR_TOKEN_ENDPOINT` environment variable.",
+						Required:    true,
+						Sensitive:   true,
+						DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_TOKEN_ACCESSOR_TOKEN_ENDPOINT", nil),
+					},
+					"refresh_token": {
+						Type:        schema.TypeString,
+						Description: "The refresh token for the OAuth provider when using a refresh token to renew access token. Can also be sourced from the `SNOWFLAKE_TOKEN_ACCESSOR_REFRESH_TOKEN` environment variable.",
+						Required:    true,
+						Sensitive:   true,
+						DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_TOKEN_ACCESSOR_REFRESH_TOKEN", nil),
+					},
+					"client_id": {
+						Type:        schema.TypeString,
+						Description: "The client ID for the OAuth provider when using a refresh token to renew access token. Can also be sourced from the `SNOWFLAKE_TOKEN_ACCESSOR_CLIENT_ID` environment variable.",
+						Required:    true,
+						Sensitive:   true,
+						DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_TOKEN_ACCESSOR_CLIENT_ID", nil),
+					},
+					"client_secret": {
+						Type:        schema.TypeString,
+						Description: "The client secret for the OAuth provider when using a refresh token to renew access token. Can also be sourced from the `SNOWFLAKE_TOKEN_ACCESSOR_CLIENT_SECRET` environment variable.",
+						Required:    true,
+						Sensitive:   true,
+						DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_TOKEN_ACCESSOR_CLIENT_SECRET", nil),
+					},
+					"redirect_uri": {
+						Type:        schema.TypeString,
+						Description: "The redirect URI for the OAuth provider when using a refresh token to renew access token. Can also be sourced from the `SNOWFLAKE_TOKEN_ACCESSOR_REDIRECT_URI` environment variable.",
+						Required:    true,
+						Sensitive:   true,
+						DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_TOKEN_ACCESSOR_REDIRECT_URI", nil),
 					},
 				},
 			},
-			"keep_session_alive": {
-				Type:        schema.TypeBool,
-				Description: "Enables the session to persist even after the connection is closed. Can also be sourced from the `SNOWFLAKE_KEEP_SESSION_ALIVE` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_KEEP_SESSION_ALIVE", nil),
-			},
-			"private_key": {
-				Type:          schema.TypeString,
-				Description:   "Private Key for username+private-key auth. Cannot be used with `browser_auth` or `password`. Can also be sourced from `SNOWFLAKE_PRIVATE_KEY` environment variable.",
-				Optional:      true,
-				Sensitive:     true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_PRIVATE_KEY", nil),
-				ConflictsWith: []string{"browser_auth", "password", "oauth_access_token", "private_key_path", "oauth_refresh_token"},
-			},
-			"private_key_passphrase": {
-				Type:          schema.TypeString,
-				Description:   "Supports the encryption ciphers aes-128-cbc, aes-128-gcm, aes-192-cbc, aes-192-gcm, aes-256-cbc, aes-256-gcm, and des-ede3-cbc. Can also be sourced from `SNOWFLAKE_PRIVATE_KEY_PASSPHRASE` environment variable.",
-				Optional:      true,
-				Sensitive:     true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_PRIVATE_KEY_PASSPHRASE", nil),
-				ConflictsWith: []string{"browser_auth", "password", "oauth_access_token", "oauth_refresh_token"},
-			},
-			"disable_telemetry": {
-				Type:        schema.TypeBool,
-				Description: "Indicates whether to disable telemetry. Can also be sourced from the `SNOWFLAKE_DISABLE_TELEMETRY` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_DISABLE_TELEMETRY", nil),
-			},
-			"client_request_mfa_token": {
-				Type:        schema.TypeBool,
-				Description: "When true the MFA token is cached in the credential manager. True by default in Windows/OSX. False for Linux. Can also be sourced from the `SNOWFLAKE_CLIENT_REQUEST_MFA_TOKEN` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_CLIENT_REQUEST_MFA_TOKEN", nil),
-			},
-			"client_store_temporary_credential": {
-				Type:        schema.TypeBool,
-				Description: "When true the ID token is cached in the credential manager. True by default in Windows/OSX. False for Linux. Can also be sourced from the `SNOWFLAKE_CLIENT_STORE_TEMPORARY_CREDENTIAL` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_CLIENT_STORE_TEMPORARY_CREDENTIAL", nil),
-			},
-			"disable_query_context_cache": {
-				Type:        schema.TypeBool,
-				Description: "Should HTAP query context cache be disabled. Can also be sourced from the `SNOWFLAKE_DISABLE_QUERY_CONTEXT_CACHE` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_DISABLE_QUERY_CONTEXT_CACHE", nil),
-			},
-			/*
-				Feature not yet released as of latest gosnowflake release
-				https://github.com/snowflakedb/gosnowflake/blob/master/dsn.go#L103
-				"include_retry_reason": {
-					Type:        schema.TypeBool,
-					Description: "Should retried request contain retry reason. Can also be sourced from the `SNOWFLAKE_INCLUDE_RETRY_REASON` environment variable.",
-					Optional:    true,
-				},
-			*/
-			"profile": {
-				Type:        schema.TypeString,
-				Description: "Sets the profile to read from ~/.snowflake/config file. Can also be sourced from the `SNOWFLAKE_PROFILE` environment variable.",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_PROFILE", "default"),
-			},
-			// Deprecated attributes
-			"region": {
-				Type:        schema.TypeString,
-				Description: "Snowflake region, such as \"eu-central-1\", with this parameter. However, since this parameter is deprecated, it is best to specify the region as part of the account parameter. For details, see the description of the account parameter. [Snowflake region](https://docs.snowflake.com/en/user-guide/intro-regions.html) to use.  Required if using the [legacy format for the `account` identifier](https://docs.snowflake.com/en/user-guide/admin-account-identifier.html#format-2-legacy-account-locator-in-a-region) in the form of `<cloud_region_id>.<cloud>`. Can also be sourced from the `SNOWFLAKE_REGION` environment variable. ",
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_REGION", nil),
-				Deprecated:  "Specify the region as part of the account parameter",
-			},
-			"session_params": {
-				Type:        schema.TypeMap,
-				Description: "Sets session parameters. [Parameters](https://docs.snowflake.com/en/sql-reference/parameters)",
-				Optional:    true,
-				Deprecated:  "Use `params` instead",
-			},
-			"oauth_access_token": {
-				Type:          schema.TypeString,
-				Description:   "Token for use with OAuth. Generating the token is left to other tools. Cannot be used with `browser_auth`, `private_key_path`, `oauth_refresh_token` or `password`. Can also be sourced from `SNOWFLAKE_OAUTH_ACCESS_TOKEN` environment variable.",
-				Optional:      true,
-				Sensitive:     true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_ACCESS_TOKEN", nil),
-				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_refresh_token"},
-				Deprecated:    "Use `token` instead",
-			},
-			"oauth_refresh_token": {
-				Type:          schema.TypeString,
-				Description:   "Token for use with OAuth. Setup and generation of the token is left to other tools. Should be used in conjunction with `oauth_client_id`, `oauth_client_secret`, `oauth_endpoint`, `oauth_redirect_url`. Cannot be used with `browser_auth`, `private_key_path`, `oauth_access_token` or `password`. Can also be sourced from `SNOWFLAKE_OAUTH_REFRESH_TOKEN` environment variable.",
-				Optional:      true,
-				Sensitive:     true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_REFRESH_TOKEN", nil),
-				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
-				RequiredWith:  []string{"oauth_client_id", "oauth_client_secret", "oauth_endpoint", "oauth_redirect_url"},
-				Deprecated:    "Use `token_accessor.0.refresh_token` instead",
-			},
-			"oauth_client_id": {
-				Type:          schema.TypeString,
-				Description:   "Required when `oauth_refresh_token` is used. Can also be sourced from `SNOWFLAKE_OAUTH_CLIENT_ID` environment variable.",
-				Optional:      true,
-				Sensitive:     true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_CLIENT_ID", nil),
-				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
-				RequiredWith:  []string{"oauth_refresh_token", "oauth_client_secret", "oauth_endpoint", "oauth_redirect_url"},
-				Deprecated:    "Use `token_accessor.0.client_id` instead",
-			},
-			"oauth_client_secret": {
-				Type:          schema.TypeString,
-				Description:   "Required when `oauth_refresh_token` is used. Can also be sourced from `SNOWFLAKE_OAUTH_CLIENT_SECRET` environment variable.",
-				Optional:      true,
-				Sensitive:     true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_CLIENT_SECRET", nil),
-				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
-				RequiredWith:  []string{"oauth_client_id", "oauth_refresh_token", "oauth_endpoint", "oauth_redirect_url"},
-				Deprecated:    "Use `token_accessor.0.client_secret` instead",
-			},
-			"oauth_endpoint": {
-				Type:          schema.TypeString,
-				Description:   "Required when `oauth_refresh_token` is used. Can also be sourced from `SNOWFLAKE_OAUTH_ENDPOINT` environment variable.",
-				Optional:      true,
-				Sensitive:     true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_ENDPOINT", nil),
-				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
-				RequiredWith:  []string{"oauth_client_id", "oauth_client_secret", "oauth_refresh_token", "oauth_redirect_url"},
-				Deprecated:    "Use `token_accessor.0.token_endpoint` instead",
-			},
-			"oauth_redirect_url": {
-				Type:          schema.TypeString,
-				Description:   "Required when `oauth_refresh_token` is used. Can also be sourced from `SNOWFLAKE_OAUTH_REDIRECT_URL` environment variable.",
-				Optional:      true,
-				Sensitive:     true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_REDIRECT_URL", nil),
-				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
-				RequiredWith:  []string{"oauth_client_id", "oauth_client_secret", "oauth_endpoint", "oauth_refresh_token"},
-				Deprecated:    "Use `token_accessor.0.redirect_uri` instead",
-			},
-			"browser_auth": {
+		},
+		"keep_session_alive": {
+			Type:        schema.TypeBool,
+			Description: "Enables the session to persist even after the connection is closed. Can also be sourced from the `SNOWFLAKE_KEEP_SESSION_ALIVE` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_KEEP_SESSION_ALIVE", nil),
+		},
+		"private_key": {
+			Type:          schema.TypeString,
+			Description:   "Private Key for username+private-key auth. Cannot be used with `browser_auth` or `password`. Can also be sourced from `SNOWFLAKE_PRIVATE_KEY` environment variable.",
+			Optional:      true,
+			Sensitive:     true,
+			DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_PRIVATE_KEY", nil),
+			ConflictsWith: []string{"browser_auth", "password", "oauth_access_token", "private_key_path", "oauth_refresh_token"},
+		},
+		"private_key_passphrase": {
+			Type:          schema.TypeString,
+			Description:   "Supports the encryption ciphers aes-128-cbc, aes-128-gcm, aes-192-cbc, aes-192-gcm, aes-256-cbc, aes-256-gcm, and des-ede3-cbc. Can also be sourced from `SNOWFLAKE_PRIVATE_KEY_PASSPHRASE` environment variable.",
+			Optional:      true,
+			Sensitive:     true,
+			DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_PRIVATE_KEY_PASSPHRASE", nil),
+			ConflictsWith: []string{"browser_auth", "password", "oauth_access_token", "oauth_refresh_token"},
+		},
+		"disable_telemetry": {
+			Type:        schema.TypeBool,
+			Description: "Indicates whether to disable telemetry. Can also be sourced from the `SNOWFLAKE_DISABLE_TELEMETRY` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_DISABLE_TELEMETRY", nil),
+		},
+		"client_request_mfa_token": {
+			Type:        schema.TypeBool,
+			Description: "When true the MFA token is cached in the credential manager. True by default in Windows/OSX. False for Linux. Can also be sourced from the `SNOWFLAKE_CLIENT_REQUEST_MFA_TOKEN` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_CLIENT_REQUEST_MFA_TOKEN", nil),
+		},
+		"client_store_temporary_credential": {
+			Type:        schema.TypeBool,
+			Description: "When true the ID token is cached in the credential manager. True by default in Windows/OSX. False for Linux. Can also be sourced from the `SNOWFLAKE_CLIENT_STORE_TEMPORARY_CREDENTIAL` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_CLIENT_STORE_TEMPORARY_CREDENTIAL", nil),
+		},
+		"disable_query_context_cache": {
+			Type:        schema.TypeBool,
+			Description: "Should HTAP query context cache be disabled. Can also be sourced from the `SNOWFLAKE_DISABLE_QUERY_CONTEXT_CACHE` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_DISABLE_QUERY_CONTEXT_CACHE", nil),
+		},
+		"query_tag_annotation": {
+			Type:        schema.TypeBool,
+			Description: "If true, every statement executed by the provider is annotated with a JSON `QUERY_TAG` containing the provider version, `query_tag_run_id`, and (for the resources that support it) the resource type, the resource id and the operation. Can also be sourced from the `SNOWFLAKE_QUERY_TAG_ANNOTATION` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_QUERY_TAG_ANNOTATION", nil),
+		},
+		"query_tag_run_id": {
+			Type:        schema.TypeString,
+			Description: "Identifier of the workspace or the run added to the query tag when `query_tag_annotation` is enabled. Can also be sourced from the `SNOWFLAKE_QUERY_TAG_RUN_ID` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_QUERY_TAG_RUN_ID", nil),
+		},
+		/*
+			Feature not yet released as of latest gosnowflake release
+			https://github.com/snowflakedb/gosnowflake/blob/master/dsn.go#L103
+			"include_retry_reason": {
 				Type:        schema.TypeBool,
-				Description: "Required when `oauth_refresh_token` is used. Can also be sourced from `SNOWFLAKE_USE_BROWSER_AUTH` environment variable.",
+				Description: "Should retried request contain retry reason. Can also be sourced from the `SNOWFLAKE_INCLUDE_RETRY_REASON` environment variable.",
 				Optional:    true,
-				Sensitive:   false,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_USE_BROWSER_AUTH", nil),
-				Deprecated:  "Use `authenticator` instead",
-			},
-			"private_key_path": {
-				Type:          schema.TypeString,
-				Description:   "Path to a private key for using keypair authentication. Cannot be used with `browser_auth`, `oauth_access_token` or `password`. Can also be sourced from `SNOWFLAKE_PRIVATE_KEY_PATH` environment variable.",
-				Optional:      true,
-				Sensitive:     true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_PRIVATE_KEY_PATH", nil),
-				ConflictsWith: []string{"browser_auth", "password", "oauth_access_token", "private_key"},
-				Deprecated:    "use the [file Function](https://developer.hashicorp.com/terraform/language/functions/file) instead",
 			},
+		*/
+		"max_open_connections": {
+			Type:         schema.TypeInt,
+			Description:  "Maximum number of open connections (sessions) to Snowflake. By default, the number is not limited. Can also be sourced from the `SNOWFLAKE_MAX_OPEN_CONNECTIONS` environment variable.",
+			Optional:     true,
+			DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_MAX_OPEN_CONNECTIONS", nil),
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"max_idle_connections": {
+			Type:         schema.TypeInt,
+			Description:  "Maximum number of idle connections kept open for reuse; 0 closes the connections as soon as they are released. By default, two connections are kept. Can also be sourced from the `SNOWFLAKE_MAX_IDLE_CONNECTIONS` environment variable.",
+			Optional:     true,
+			DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_MAX_IDLE_CONNECTIONS", nil),
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"connection_max_lifetime": {
+			Type:         schema.TypeInt,
+			Description:  "Maximum time (in seconds) a connection is reused for. By default, the connections are reused forever. Can also be sourced from the `SNOWFLAKE_CONNECTION_MAX_LIFETIME` environment variable.",
+			Optional:     true,
+			DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_CONNECTION_MAX_LIFETIME", nil),
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"max_concurrent_statements": {
+			Type:         schema.TypeInt,
+			Description:  "Maximum number of statements executed by the provider at the same time; the other statements wait for their turn. By default, the number is not limited. Can also be sourced from the `SNOWFLAKE_MAX_CONCURRENT_STATEMENTS` environment variable.",
+			Optional:     true,
+			DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_MAX_CONCURRENT_STATEMENTS", nil),
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"session_warm_up_statements": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Statements executed on every new session before it is used by the provider, e.g. `ALTER SESSION SET ...` or `USE SECONDARY ROLES ALL`.",
+			Optional:    true,
+		},
+		"show_result_cache": {
+			Type:        schema.TypeBool,
+			Description: "If true, the ShowByID lookups of databases, schemas, tables, views and roles, and the grant lookups, fetch the SHOW results of the whole container (e.g. all schemas in a database) once per plan or apply and answer the following lookups from them. The cached results of a container are dropped when a statement of the provider mentioning it completes (the cached grants are dropped by the grant statements mentioning the object or the grantee and by all other statements). It reduces the number of statements executed for large configurations. Can also be sourced from the `SNOWFLAKE_SHOW_RESULT_CACHE` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_SHOW_RESULT_CACHE", nil),
+		},
+		"profile": {
+			Type:        schema.TypeString,
+			Description: "Sets the profile (connection) to read from the connections.toml file (in the directory set by the `SNOWFLAKE_HOME` environment variable, ~/.snowflake by default) or from the ~/.snowflake/config file. The `default` profile stands for the connection named by the `SNOWFLAKE_DEFAULT_CONNECTION_NAME` environment variable or by `default_connection_name` in connections.toml. The settings of the profile are used only when not set in the provider block or by the environment variables; they can be overridden with the `SNOWFLAKE_CONNECTIONS_<NAME>_<KEY>` environment variables. Can also be sourced from the `SNOWFLAKE_PROFILE` environment variable.",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_PROFILE", "default"),
+		},
+		// Deprecated attributes
+		"region": {
+			Type:        schema.TypeString,
+			Description: "Snowflake region, such as \"eu-central-1\", with this parameter. However, since this parameter is deprecated, it is best to specify the region as part of the account parameter. For details, see the description of the account parameter. [Snowflake region](https://docs.snowflake.com/en/user-guide/intro-regions.html) to use.  Required if using the [legacy format for the `account` identifier](https://docs.snowflake.com/en/user-guide/admin-account-identifier.html#format-2-legacy-account-locator-in-a-region) in the form of `<cloud_region_id>.<cloud>`. Can also be sourced from the `SNOWFLAKE_REGION` environment variable. ",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_REGION", nil),
+			Deprecated:  "Specify the region as part of the account parameter",
+		},
+		"session_params": {
+			Type:        schema.TypeMap,
+			Description: "Sets session parameters. [Parameters](https://docs.snowflake.com/en/sql-reference/parameters)",
+			Optional:    true,
+			Deprecated:  "Use `params` instead",
+		},
+		"oauth_access_token": {
+			Type:          schema.TypeString,
+			Description:   "Token for use with OAuth. Generating the token is left to other tools. Cannot be used with `browser_auth`, `private_key_path`, `oauth_refresh_token` or `password`. Can also be sourced from `SNOWFLAKE_OAUTH_ACCESS_TOKEN` environment variable.",
+			Optional:      true,
+			Sensitive:     true,
+			DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_ACCESS_TOKEN", nil),
+			ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_refresh_token"},
+			Deprecated:    "Use `token` instead",
+		},
+		"oauth_refresh_token": {
+			Type:          schema.TypeString,
+			Description:   "Token for use with OAuth. Setup and generation of the token is left to other tools. Should be used in conjunction with `oauth_client_id`, `oauth_client_secret`, `oauth_endpoint`, `oauth_redirect_url`. Cannot be used with `browser_auth`, `private_key_path`, `oauth_access_token` or `password`. Can also be sourced from `SNOWFLAKE_OAUTH_REFRESH_TOKEN` environment variable.",
+			Optional:      true,
+			Sensitive:     true,
+			DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_REFRESH_TOKEN", nil),
+			ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
+			RequiredWith:  []string{"oauth_client_id", "oauth_client_secret", "oauth_endpoint", "oauth_redirect_url"},
+			Deprecated:    "Use `token_accessor.0.refresh_token` instead",
+		},
+		"oauth_client_id": {
+			Type:          schema.TypeString,
+			Description:   "Required when `oauth_refresh_token` is used. Can also be sourced from `SNOWFLAKE_OAUTH_CLIENT_ID` environment variable.",
+			Optional:      true,
+			Sensitive:     true,
+			DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_CLIENT_ID", nil),
+			ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
+			RequiredWith:  []string{"oauth_refresh_token", "oauth_client_secret", "oauth_endpoint", "oauth_redirect_url"},
+			Deprecated:    "Use `token_accessor.0.client_id` instead",
+		},
+		"oauth_client_secret": {
+			Type:          schema.TypeString,
+			Description:   "Required when `oauth_refresh_token` is used. Can also be sourced from `SNOWFLAKE_OAUTH_CLIENT_SECRET` environment variable.",
+			Optional:      true,
+			Sensitive:     true,
+			DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_CLIENT_SECRET", nil),
+			ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
+			RequiredWith:  []string{"oauth_client_id", "oauth_refresh_token", "oauth_endpoint", "oauth_redirect_url"},
+			Deprecated:    "Use `token_accessor.0.client_secret` instead",
+		},
+		"oauth_endpoint": {
+			Type:          schema.TypeString,
+			Description:   "Required when `oauth_refresh_token` is used. Can also be sourced from `SNOWFLAKE_OAUTH_ENDPOINT` environment variable.",
+			Optional:      true,
+			Sensitive:     true,
+			DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_ENDPOINT", nil),
+			ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
+			RequiredWith:  []string{"oauth_client_id", "oauth_client_secret", "oauth_refresh_token", "oauth_redirect_url"},
+			Deprecated:    "Use `token_accessor.0.token_endpoint` instead",
+		},
+		"oauth_redirect_url": {
+			Type:          schema.TypeString,
+			Description:   "Required when `oauth_refresh_token` is used. Can also be sourced from `SNOWFLAKE_OAUTH_REDIRECT_URL` environment variable.",
+			Optional:      true,
+			Sensitive:     true,
+			DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_REDIRECT_URL", nil),
+			ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
+			RequiredWith:  []string{"oauth_client_id", "oauth_client_secret", "oauth_endpoint", "oauth_refresh_token"},
+			Deprecated:    "Use `token_accessor.0.redirect_uri` instead",
+		},
+		"browser_auth": {
+			Type:        schema.TypeBool,
+			Description: "Required when `oauth_refresh_token` is used. Can also be sourced from `SNOWFLAKE_USE_BROWSER_AUTH` environment variable.",
+			Optional:    true,
+			Sensitive:   false,
+			DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_USE_BROWSER_AUTH", nil),
+			Deprecated:  "Use `authenticator` instead",
+		},
+		"private_key_path": {
+			Type:          schema.TypeString,
+			Description:   "Path to a private key for using keypair authentication. Cannot be used with `browser_auth`, `oauth_access_token` or `password`. Can also be sourced from `SNOWFLAKE_PRIVATE_KEY_PATH` environment variable.",
+			Optional:      true,
+			Sensitive:     true,
+			DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_PRIVATE_KEY_PATH", nil),
+			ConflictsWith: []string{"browser_auth", "password", "oauth_access_token", "private_key"},
+			Deprecated:    "use the [file Function](https://developer.hashicorp.com/terraform/language/functions/file) instead",
 		},
-		ResourcesMap:       getResources(),
-		DataSourcesMap:     getDataSources(),
-		ConfigureFunc:      ConfigureProvider,
-		ProviderMetaSchema: map[string]*schema.Schema{},
 	}
 }
 
@@ -428,66 +490,88 @@ func GetGrantResources() resources.TerraformGrantResources {
 func getResources() map[string]*schema.Resource {
 	// NOTE(): do not add grant resources here
 	others := map[string]*schema.Resource{
-		"snowflake_account":                                 resources.Account(),
-		"snowflake_account_password_policy_attachment":      resources.AccountPasswordPolicyAttachment(),
-		"snowflake_account_parameter":                       resources.AccountParameter(),
-		"snowflake_alert":                                   resources.Alert(),
-		"snowflake_api_integration":                         resources.APIIntegration(),
-		"snowflake_database":                                resources.Database(),
-		"snowflake_database_role":                           resources.DatabaseRole(),
-		"snowflake_dynamic_table":                           resources.DynamicTable(),
-		"snowflake_email_notification_integration":          resources.EmailNotificationIntegration(),
-		"snowflake_external_function":                       resources.ExternalFunction(),
-		"snowflake_external_oauth_integration":              resources.ExternalOauthIntegration(),
-		"snowflake_external_table":                          resources.ExternalTable(),
-		"snowflake_failover_group":                          resources.FailoverGroup(),
-		"snowflake_file_format":                             resources.FileFormat(),
-		"snowflake_function":                                resources.Function(),
-		"snowflake_grant_account_role":                      resources.GrantAccountRole(),
-		"snowflake_grant_database_role":                     resources.GrantDatabaseRole(),
-		"snowflake_grant_privileges_to_role":                resources.GrantPrivilegesToRole(),
-		"snowflake_grant_privileges_to_account_role":        resources.GrantPrivilegesToAccountRole(),
-		"snowflake_grant_privileges_to_database_role":       resources.GrantPrivilegesToDatabaseRole(),
-		"snowflake_grant_privileges_to_share":               resources.GrantPrivilegesToShare(),
-		"snowflake_managed_account":                         resources.ManagedAccount(),
-		"snowflake_masking_policy":                          resources.MaskingPolicy(),
-		"snowflake_materialized_view":                       resources.MaterializedView(),
-		"snowflake_network_policy":                          resources.NetworkPolicy(),
-		"snowflake_network_policy_attachment":               resources.NetworkPolicyAttachment(),
-		"snowflake_notification_integration":                resources.NotificationIntegration(),
-		"snowflake_oauth_integration":                       resources.OAuthIntegration(),
-		"snowflake_object_parameter":                        resources.ObjectParameter(),
-		"snowflake_password_policy":                         resources.PasswordPolicy(),
-		"snowflake_pipe":                                    resources.Pipe(),
-		"snowflake_procedure":                               resources.Procedure(),
-		"snowflake_resource_monitor":                        resources.ResourceMonitor(),
-		"snowflake_role":                                    resources.Role(),
-		"snowflake_role_grants":                             resources.RoleGrants(),
-		"snowflake_role_ownership_grant":                    resources.RoleOwnershipGrant(),
-		"snowflake_row_access_policy":                       resources.RowAccessPolicy(),
-		"snowflake_saml_integration":                        resources.SAMLIntegration(),
-		"snowflake_schema":                                  resources.Schema(),
-		"snowflake_scim_integration":                        resources.SCIMIntegration(),
-		"snowflake_sequence":                                resources.Sequence(),
-		"snowflake_session_parameter":                       resources.SessionParameter(),
-		"snowflake_share":                                   resources.Share(),
-		"snowflake_stage":                                   resources.Stage(),
-		"snowflake_storage_integration":                     resources.StorageIntegration(),
-		"snowflake_stream":                                  resources.Stream(),
-		"snowflake_table":                                   resources.Table(),
-		"snowflake_table_column_masking_policy_application": resources.TableColumnMaskingPolicyApplication(),
-		"snowflake_table_constraint":                        resources.TableConstraint(),
-		"snowflake_tag":                                     resources.Tag(),
-		"snowflake_tag_association":                         resources.TagAssociation(),
-		"snowflake_tag_masking_policy_association":          resources.TagMaskingPolicyAssociation(),
-		"snowflake_task":                                    resources.Task(),
-		"snowflake_unsafe_execute":                          resources.UnsafeExecute(),
-		"snowflake_user":                                    resources.User(),
-		"snowflake_user_ownership_grant":                    resources.UserOwnershipGrant(),
-		"snowflake_user_password_policy_attachment":         resources.UserPasswordPolicyAttachment(),
-		"snowflake_user_public_keys":                        resources.UserPublicKeys(),
-		"snowflake_view":                                    resources.View(),
-		"snowflake_warehouse":                               resources.Warehouse(),
+		"snowflake_account": resources.Account(),
+		"snowflake_account_authentication_policy_attachment": resources.AccountAuthenticationPolicyAttachment(),
+		"snowflake_account_password_policy_attachment":       resources.AccountPasswordPolicyAttachment(),
+		"snowflake_account_parameter":                        resources.AccountParameter(),
+		"snowflake_alert":                                    resources.Alert(),
+		"snowflake_api_integration":                          resources.APIIntegration(),
+		"snowflake_authentication_policy":                    resources.AuthenticationPolicy(),
+		"snowflake_database":                                 resources.Database(),
+		"snowflake_database_role":                            resources.DatabaseRole(),
+		"snowflake_dynamic_table":                            resources.DynamicTable(),
+		"snowflake_email_notification_integration":           resources.EmailNotificationIntegration(),
+		"snowflake_external_function":                        resources.ExternalFunction(),
+		"snowflake_external_oauth_integration":               resources.ExternalOauthIntegration(),
+		"snowflake_external_table":                           resources.ExternalTable(),
+		"snowflake_failover_group":                           resources.FailoverGroup(),
+		"snowflake_file_format":                              resources.FileFormat(),
+		"snowflake_file_format_avro":                         resources.FileFormatAvro(),
+		"snowflake_file_format_csv":                          resources.FileFormatCSV(),
+		"snowflake_file_format_json":                         resources.FileFormatJSON(),
+		"snowflake_file_format_orc":                          resources.FileFormatORC(),
+		"snowflake_file_format_parquet":                      resources.FileFormatParquet(),
+		"snowflake_file_format_xml":                          resources.FileFormatXML(),
+		"snowflake_function":                                 resources.Function(),
+		"snowflake_function_java":                            resources.FunctionJava(),
+		"snowflake_function_javascript":                      resources.FunctionJavascript(),
+		"snowflake_function_python":                          resources.FunctionPython(),
+		"snowflake_function_scala":                           resources.FunctionScala(),
+		"snowflake_function_sql":                             resources.FunctionSql(),
+		"snowflake_grant_account_role":                       resources.GrantAccountRole(),
+		"snowflake_grant_database_role":                      resources.GrantDatabaseRole(),
+		"snowflake_grant_privileges_to_role":                 resources.GrantPrivilegesToRole(),
+		"snowflake_grant_privileges_to_account_role":         resources.GrantPrivilegesToAccountRole(),
+		"snowflake_grant_privileges_to_database_role":        resources.GrantPrivilegesToDatabaseRole(),
+		"snowflake_grant_privileges_to_share":                resources.GrantPrivilegesToShare(),
+		"snowflake_legacy_service_user":                      resources.LegacyServiceUser(),
+		"snowflake_managed_account":                          resources.ManagedAccount(),
+		"snowflake_masking_policy":                           resources.MaskingPolicy(),
+		"snowflake_materialized_view":                        resources.MaterializedView(),
+		"snowflake_network_policy":                           resources.NetworkPolicy(),
+		"snowflake_network_policy_attachment":                resources.NetworkPolicyAttachment(),
+		"snowflake_notification_integration":                 resources.NotificationIntegration(),
+		"snowflake_oauth_integration":                        resources.OAuthIntegration(),
+		"snowflake_object_parameter":                         resources.ObjectParameter(),
+		"snowflake_parameter_set":                            resources.ParameterSet(),
+		"snowflake_password_policy":                          resources.PasswordPolicy(),
+		"snowflake_pipe":                                     resources.Pipe(),
+		"snowflake_procedure":                                resources.Procedure(),
+		"snowflake_procedure_java":                           resources.ProcedureJava(),
+		"snowflake_procedure_javascript":                     resources.ProcedureJavascript(),
+		"snowflake_procedure_python":                         resources.ProcedurePython(),
+		"snowflake_procedure_scala":                          resources.ProcedureScala(),
+		"snowflake_procedure_sql":                            resources.ProcedureSql(),
+		"snowflake_role":                                     resources.Role(),
+		"snowflake_role_grants":                              resources.RoleGrants(),
+		"snowflake_role_ownership_grant":                     resources.RoleOwnershipGrant(),
+		"snowflake_row_access_policy":                        resources.RowAccessPolicy(),
+		"snowflake_saml_integration":                         resources.SAMLIntegration(),
+		"snowflake_schema":                                   resources.Schema(),
+		"snowflake_scim_integration":                         resources.SCIMIntegration(),
+		"snowflake_sequence":                                 resources.Sequence(),
+		"snowflake_service_user":                             resources.ServiceUser(),
+		"snowflake_session_parameter":                        resources.SessionParameter(),
+		"snowflake_share":                                    resources.Share(),
+		"snowflake_stage":                                    resources.Stage(),
+		"snowflake_stage_file":                               resources.StageFile(),
+		"snowflake_storage_integration":                      resources.StorageIntegration(),
+		"snowflake_stream":                                   resources.Stream(),
+		"snowflake_table":                                    resources.Table(),
+		"snowflake_table_column_masking_policy_application":  resources.TableColumnMaskingPolicyApplication(),
+		"snowflake_table_constraint":                         resources.TableConstraint(),
+		"snowflake_tag":                                      resources.Tag(),
+		"snowflake_tag_association":                          resources.TagAssociation(),
+		"snowflake_tag_masking_policy_association":           resources.TagMaskingPolicyAssociation(),
+		"snowflake_task":                                     resources.Task(),
+		"snowflake_unsafe_execute":                           resources.UnsafeExecute(),
+		"snowflake_user":                                     resources.User(),
+		"snowflake_user_authentication_policy_attachment":    resources.UserAuthenticationPolicyAttachment(),
+		"snowflake_user_ownership_grant":                     resources.UserOwnershipGrant(),
+		"snowflake_user_password_policy_attachment":          resources.UserPasswordPolicyAttachment(),
+		"snowflake_user_public_keys":                         resources.UserPublicKeys(),
+		"snowflake_view":                                     resources.View(),
+		"snowflake_warehouse":                                resources.Warehouse(),
 	}
 
 	return mergeSchemas(
@@ -505,7 +589,9 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_database":                           datasources.Database(),
 		"snowflake_database_roles":                     datasources.DatabaseRoles(),
 		"snowflake_databases":                          datasources.Databases(),
+		"snowflake_dynamic_table_refresh_history":      datasources.DynamicTableRefreshHistory(),
 		"snowflake_dynamic_tables":                     datasources.DynamicTables(),
+		"snowflake_effective_parameters":               datasources.EffectiveParameters(),
 		"snowflake_external_functions":                 datasources.ExternalFunctions(),
 		"snowflake_external_tables":                    datasources.ExternalTables(),
 		"snowflake_failover_groups":                    datasources.FailoverGroups(),
@@ -514,7 +600,9 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_grants":                             datasources.Grants(),
 		"snowflake_masking_policies":                   datasources.MaskingPolicies(),
 		"snowflake_materialized_views":                 datasources.MaterializedViews(),
+		"snowflake_organization_accounts":              datasources.OrganizationAccounts(),
 		"snowflake_parameters":                         datasources.Parameters(),
+		"snowflake_pipe_status":                        datasources.PipeStatus(),
 		"snowflake_pipes":                              datasources.Pipes(),
 		"snowflake_procedures":                         datasources.Procedures(),
 		"snowflake_resource_monitors":                  datasources.ResourceMonitors(),
@@ -541,7 +629,7 @@ func getDataSources() map[string]*schema.Resource {
 	return dataSources
 }
 
-func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
+func ConfigureProvider(_ context.Context, s *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	config := &gosnowflake.Config{
 		Application: "terraform-provider-snowflake",
 	}
@@ -629,7 +717,7 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 	if v, ok := s.GetOk("okta_url"); ok && v.(string) != "" {
 		oktaURL, err := url.Parse(v.(string))
 		if err != nil {
-			return nil, fmt.Errorf("could not parse okta_url err = %w", err)
+			return nil, diag.Errorf("could not parse okta_url err = %s", err)
 		}
 		config.OktaURL = oktaURL
 	}
@@ -681,7 +769,7 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 			redirectURI := tokenAccessor["redirect_uri"].(string)
 			accessToken, err := GetAccessTokenWithRefreshToken(tokenEndpoint, clientID, clientSecret, refreshToken, redirectURI)
 			if err != nil {
-				return nil, fmt.Errorf("could not retrieve access token from refresh token")
+				return nil, diag.Errorf("could not retrieve access token from refresh token")
 			}
 			config.Token = accessToken
 			config.Authenticator = gosnowflake.AuthTypeOAuth
@@ -692,17 +780,6 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 		config.KeepSessionAlive = v.(bool)
 	}
 
-	privateKeyPath := s.Get("private_key_path").(string)
-	privateKey := s.Get("private_key").(string)
-	privateKeyPassphrase := s.Get("private_key_passphrase").(string)
-	v, err := getPrivateKey(privateKeyPath, privateKey, privateKeyPassphrase)
-	if err != nil {
-		return nil, fmt.Errorf("could not retrieve private key: %w", err)
-	}
-	if v != nil {
-		config.PrivateKey = v
-	}
-
 	if v, ok := s.GetOk("disable_telemetry"); ok && v.(bool) {
 		config.DisableTelemetry = v.(bool)
 	}
@@ -719,6 +796,9 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 		config.DisableQueryContextCache = v.(bool)
 	}
 
+	// query_tag_annotation and query_tag_run_id are handled by the plugin framework provider (see framework/provider),
+	// because only it knows the provider version.
+
 	/*
 		Feature not yet released as of latest gosnowflake release
 		https://github.com/snowflakedb/gosnowflake/blob/master/dsn.go#L103
@@ -726,26 +806,222 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 			config.IncludeRetryParameters = v.(bool)
 		}
 	*/
-	if v, ok := s.GetOk("profile"); ok && v.(string) != "" {
-		profile := v.(string)
-		if profile == "default" {
-			defaultConfig := sdk.DefaultConfig()
-			config = sdk.MergeConfig(config, defaultConfig)
-		} else {
-			profileConfig, err := sdk.ProfileConfig(profile)
-			if err != nil {
-				return "", errors.New("could not retrieve profile config: " + err.Error())
+	// the settings of the profile are used only where the provider block and the environment variables left them unset
+	report := newConfigurationReport(s)
+	connection, err := loadProfile(s)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	privateKeyPath := s.Get("private_key_path").(string)
+	privateKey := s.Get("private_key").(string)
+	privateKeyPassphrase := s.Get("private_key_passphrase").(string)
+	if connection != nil {
+		if err := applyConnection(config, connection, report); err != nil {
+			return nil, diag.FromErr(err)
+		}
+		if setting, ok := connection.PrivateKeyPath(); ok && privateKeyPath == "" && privateKey == "" {
+			privateKeyPath = setting.Value
+			report.set("private_key_path", setting)
+		}
+		if setting, ok := connection.Settings["private_key_raw"]; ok && privateKeyPath == "" && privateKey == "" {
+			privateKey = setting.Value
+			report.set("private_key", setting)
+		}
+		if setting, ok := connection.PrivateKeyPassphrase(); ok && privateKeyPassphrase == "" {
+			privateKeyPassphrase = setting.Value
+			report.set("private_key_passphrase", setting)
+		}
+	}
+	v, err := getPrivateKey(privateKeyPath, privateKey, privateKeyPassphrase)
+	if err != nil {
+		return nil, diag.Errorf("could not retrieve private key: %s", err)
+	}
+	if v != nil {
+		config.PrivateKey = v
+	}
+
+	log.Printf("[DEBUG] effective connection settings:\n%s", report)
+	client, err := sdk.NewClientWithOptions(config, connectionOptions(s))
+	if err != nil {
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Could not connect to Snowflake",
+			Detail:   fmt.Sprintf("%s\n\nThe effective connection settings and their sources:\n%s", err, report),
+		}}
+	}
+	db := client.GetConn().DB
+	if v, ok := s.GetOk("show_result_cache"); ok && v.(bool) {
+		sdk.EnableShowCache(db)
+	}
+	return db, nil
+}
+
+// loadProfile reads the connection named by the profile field from connections.toml or the legacy config file. The
+// "default" profile stands for the default connection (see sdk.LoadConnection) and may be missing.
+func loadProfile(s *schema.ResourceData) (*sdk.Connection, error) {
+	v, ok := s.GetOk("profile")
+	if !ok || v.(string) == "" {
+		return nil, nil
+	}
+	profile := v.(string)
+	name := profile
+	if profile == "default" {
+		name = ""
+	}
+	connection, err := sdk.LoadConnection(name)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve profile config: %w", err)
+	}
+	if connection == nil && profile != "default" {
+		return nil, fmt.Errorf("profile with name: %s not found in config file", profile)
+	}
+	return connection, nil
+}
+
+// applyConnection sets the settings of the connection left unset by the provider block and the environment variables.
+// The private key settings are handled by the caller.
+func applyConnection(config *gosnowflake.Config, connection *sdk.Connection, report *configurationReport) error {
+	profileConfig, err := connection.Config()
+	if err != nil {
+		return err
+	}
+	setString := func(target *string, value string) bool {
+		if *target != "" {
+			return false
+		}
+		*target = value
+		return true
+	}
+	setDuration := func(target *time.Duration, value time.Duration) bool {
+		if *target != 0 {
+			return false
+		}
+		*target = value
+		return true
+	}
+	// the provider fields set only true, so false is the unset value
+	setBool := func(target *bool, value bool) bool {
+		if *target {
+			return false
+		}
+		*target = value
+		return true
+	}
+	setConfigBool := func(target *gosnowflake.ConfigBool, value gosnowflake.ConfigBool) bool {
+		if *target != 0 {
+			return false
+		}
+		*target = value
+		return true
+	}
+	for key, setting := range connection.Settings {
+		var applied bool
+		switch key {
+		case "account":
+			applied = setString(&config.Account, profileConfig.Account)
+		case "user":
+			applied = setString(&config.User, profileConfig.User)
+		case "password":
+			applied = setString(&config.Password, profileConfig.Password)
+		case "host":
+			applied = setString(&config.Host, profileConfig.Host)
+		case "protocol":
+			applied = setString(&config.Protocol, profileConfig.Protocol)
+		case "region":
+			applied = setString(&config.Region, profileConfig.Region)
+		case "role":
+			applied = setString(&config.Role, profileConfig.Role)
+		case "warehouse":
+			applied = setString(&config.Warehouse, profileConfig.Warehouse)
+		case "database":
+			applied = setString(&config.Database, profileConfig.Database)
+		case "schema":
+			applied = setString(&config.Schema, profileConfig.Schema)
+		case "token":
+			applied = setString(&config.Token, profileConfig.Token)
+		case "passcode":
+			applied = setString(&config.Passcode, profileConfig.Passcode)
+		case "port":
+			if applied = config.Port == 0; applied {
+				config.Port = profileConfig.Port
 			}
-			if profileConfig == nil {
-				return "", errors.New("profile with name: " + profile + " not found in config file")
+		case "authenticator":
+			if applied = config.Authenticator == gosnowflake.AuthTypeSnowflake; applied {
+				config.Authenticator = profileConfig.Authenticator
+				if config.OktaURL == nil {
+					config.OktaURL = profileConfig.OktaURL
+				}
 			}
-			// merge any credentials found in profile with config
-			config = sdk.MergeConfig(config, profileConfig)
+		case "okta_url":
+			if applied = config.OktaURL == nil; applied {
+				config.OktaURL = profileConfig.OktaURL
+			}
+		case "login_timeout":
+			applied = setDuration(&config.LoginTimeout, profileConfig.LoginTimeout)
+		case "request_timeout":
+			applied = setDuration(&config.RequestTimeout, profileConfig.RequestTimeout)
+		case "jwt_expire_timeout":
+			applied = setDuration(&config.JWTExpireTimeout, profileConfig.JWTExpireTimeout)
+		case "client_timeout":
+			applied = setDuration(&config.ClientTimeout, profileConfig.ClientTimeout)
+		case "jwt_client_timeout":
+			applied = setDuration(&config.JWTClientTimeout, profileConfig.JWTClientTimeout)
+		case "external_browser_timeout":
+			applied = setDuration(&config.ExternalBrowserTimeout, profileConfig.ExternalBrowserTimeout)
+		case "passcode_in_password":
+			applied = setBool(&config.PasscodeInPassword, profileConfig.PasscodeInPassword)
+		case "client_session_keep_alive":
+			applied = setBool(&config.KeepSessionAlive, profileConfig.KeepSessionAlive)
+		case "insecure_mode":
+			applied = setBool(&config.InsecureMode, profileConfig.InsecureMode)
+		case "disable_telemetry":
+			applied = setBool(&config.DisableTelemetry, profileConfig.DisableTelemetry)
+		case "disable_query_context_cache":
+			applied = setBool(&config.DisableQueryContextCache, profileConfig.DisableQueryContextCache)
+		case "ocsp_fail_open":
+			if applied = config.OCSPFailOpen == 0; applied {
+				config.OCSPFailOpen = profileConfig.OCSPFailOpen
+			}
+		case "validate_default_parameters":
+			applied = setConfigBool(&config.ValidateDefaultParameters, profileConfig.ValidateDefaultParameters)
+		case "client_request_mfa_token":
+			applied = setConfigBool(&config.ClientRequestMfaToken, profileConfig.ClientRequestMfaToken)
+		case "client_store_temporary_credential":
+			applied = setConfigBool(&config.ClientStoreTemporaryCredential, profileConfig.ClientStoreTemporaryCredential)
+		}
+		if applied {
+			report.set(key, setting)
 		}
 	}
-	client, err := sdk.NewClient(config)
-	if err != nil {
-		return nil, err
+	return nil
+}
+
+func connectionOptions(s *schema.ResourceData) *sdk.ConnectionOptions {
+	opts := &sdk.ConnectionOptions{
+		MaxOpenConnections:      s.Get("max_open_connections").(int),
+		ConnectionMaxLifetime:   time.Second * time.Duration(int64(s.Get("connection_max_lifetime").(int))),
+		MaxConcurrentStatements: s.Get("max_concurrent_statements").(int),
+	}
+	// zero disables the idle connections, so it is told apart from the unset field
+	if attributeSet(s, "max_idle_connections", "SNOWFLAKE_MAX_IDLE_CONNECTIONS") {
+		maxIdleConnections := s.Get("max_idle_connections").(int)
+		opts.MaxIdleConnections = &maxIdleConnections
+	}
+	for _, statement := range s.Get("session_warm_up_statements").([]any) {
+		opts.WarmUpStatements = append(opts.WarmUpStatements, statement.(string))
+	}
+	return opts
+}
+
+// attributeSet tells if the attribute was set in the provider block or sourced from the environment variable, also when
+// it was set to the zero value. Without the raw configuration, the zero values are treated as unset.
+func attributeSet(s *schema.ResourceData, attribute string, envVar string) bool {
+	if _, ok := os.LookupEnv(envVar); ok {
+		return true
+	}
+	if raw := s.GetRawConfig(); !raw.IsNull() && raw.Type().HasAttribute(attribute) {
+		return !raw.GetAttr(attribute).IsNull()
 	}
-	return client.GetConn().DB, nil
+	_, ok := s.GetOk(attribute)
+	return ok
 }
@@ -1,9 +1,18 @@
 package provider
 
 import (
+	"context"
+	"maps"
+	"os"
 	"testing"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var TestAccProvider *schema.Provider
@@ -17,3 +26,40 @@ func TestProvider(t *testing.T) {
 		t.Fatalf("err: %s", err)
 	}
 }
+
+func TestConnectionOptions_MaxIdleConnections(t *testing.T) {
+	t.Setenv("SNOWFLAKE_MAX_IDLE_CONNECTIONS", "")
+	require.NoError(t, os.Unsetenv("SNOWFLAKE_MAX_IDLE_CONNECTIONS"))
+
+	configuredOptions := func(t *testing.T, values map[string]cty.Value) *sdk.ConnectionOptions {
+		t.Helper()
+		block := schema.InternalMap(ProviderSchema()).CoreConfigSchema()
+		attributes := make(map[string]cty.Value)
+		for name, attributeType := range block.ImpliedType().AttributeTypes() {
+			attributes[name] = cty.NullVal(attributeType)
+			if attributeType.IsListType() {
+				attributes[name] = cty.ListValEmpty(attributeType.ElementType())
+			}
+		}
+		maps.Copy(attributes, values)
+
+		var opts *sdk.ConnectionOptions
+		p := Provider()
+		p.ConfigureContextFunc = func(_ context.Context, s *schema.ResourceData) (any, diag.Diagnostics) {
+			opts = connectionOptions(s)
+			return nil, nil
+		}
+		// as in the plugin server
+		config := terraform.NewResourceConfigShimmed(cty.ObjectVal(attributes), block)
+		config.CtyValue = cty.ObjectVal(attributes)
+		diags := p.Configure(context.Background(), config)
+		require.False(t, diags.HasError(), "%v", diags)
+		return opts
+	}
+
+	assert.Nil(t, configuredOptions(t, nil).MaxIdleConnections)
+	assert.Equal(t, sdk.Int(0), configuredOptions(t, map[string]cty.Value{"max_idle_connections": cty.NumberIntVal(0)}).MaxIdleConnections)
+
+	t.Setenv("SNOWFLAKE_MAX_IDLE_CONNECTIONS", "0")
+	assert.Equal(t, sdk.Int(0), configuredOptions(t, nil).MaxIdleConnections)
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// withQueryTagAnnotations sets the resource type, the resource id and the operation on the query tag of the statements
+// executed by the CRUD functions of the given resources (see sdk.ContextWithQueryTag).
+func withQueryTagAnnotations(resourceTypePrefix string, resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for name, resource := range resources {
+		resourceType := resourceTypePrefix + name
+		resource.CreateContext = withQueryTag(resourceType, "CREATE", resource.CreateContext)
+		resource.ReadContext = withQueryTag(resourceType, "READ", resource.ReadContext)
+		resource.UpdateContext = withQueryTag(resourceType, "UPDATE", resource.UpdateContext)
+		resource.DeleteContext = withQueryTag(resourceType, "DELETE", resource.DeleteContext)
+	}
+	return resources
+}
+
+func withQueryTag[T ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](resourceType string, operation string, f T) T {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		ctx = sdk.ContextWithQueryTag(ctx, sdk.QueryTag{
+			ResourceType: resourceType,
+			ResourceId:   d.Id(),
+			Operation:    operation,
+		})
+		return f(ctx, d, meta)
+	}
+}
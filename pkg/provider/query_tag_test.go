@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithQueryTagAnnotations(t *testing.T) {
+	var tags []sdk.QueryTag
+	recordTag := func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		tag, ok := sdk.QueryTagFromContext(ctx)
+		require.True(t, ok)
+		tags = append(tags, tag)
+		return nil
+	}
+	resources := withQueryTagAnnotations("data.", map[string]*schema.Resource{
+		"snowflake_test": {
+			Schema:      map[string]*schema.Schema{},
+			ReadContext: recordTag,
+		},
+	})
+
+	resource := resources["snowflake_test"]
+	assert.Nil(t, resource.CreateContext)
+	assert.Nil(t, resource.DeleteContext)
+
+	d := resource.Data(nil)
+	d.SetId("ID")
+	resource.ReadContext(context.Background(), d, nil)
+	assert.Equal(t, []sdk.QueryTag{{ResourceType: "data.snowflake_test", ResourceId: "ID", Operation: "READ"}}, tags)
+}
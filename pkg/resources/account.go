@@ -3,19 +3,22 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-// Note: no test case was created for account since we cannot actually delete them after creation, which is a critical part of the test suite. Instead, this resource
-// was manually tested
+// Note: the acceptance test for account is skipped by default since we cannot actually delete the accounts after creation, which is a critical part of the test suite.
+// Instead, this resource was mostly manually tested
 
 var accountSchema = map[string]*schema.Schema{
 	"name": {
@@ -28,6 +31,12 @@ var accountSchema = map[string]*schema.Schema{
 			return strings.ToUpper(val.(string))
 		},
 	},
+	"save_old_url": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether the original account URL is kept after the account is renamed, so that it can still be used to access the account. The old URL can be dropped later on outside of Terraform. The default is true.",
+	},
 	"admin_name": {
 		Type:         schema.TypeString,
 		Required:     true,
@@ -202,17 +211,57 @@ var accountSchema = map[string]*schema.Schema{
 		Type:        schema.TypeInt,
 		Optional:    true,
 		Default:     3,
-		Description: "Specifies the number of days to wait before dropping the account. The default is 3 days.",
+		Description: "Specifies the number of days to wait before dropping the account. The default is 3 days. The account dropped by Terraform can be restored by creating the resource with the same name again within this period.",
+	},
+	"resource_monitor": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Name of the resource monitor assigned to the account. It has to exist in the account managing the resource. The value is not read back from Snowflake, so the changes made outside of Terraform are not detected.",
+	},
+	"parameters": {
+		Type:             schema.TypeMap,
+		Optional:         true,
+		Elem:             &schema.Schema{Type: schema.TypeString},
+		Description:      "Map of account-level parameter names (in upper case, e.g. `STATEMENT_TIMEOUT_IN_SECONDS`) to their values, set on the account. The parameters removed from the map are unset. The values are not read back from Snowflake, since the parameters can only be shown for the current account, so the changes made outside of Terraform are not detected.",
+		ValidateDiagFunc: parameterSetSchema["parameters"].ValidateDiagFunc,
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
+	},
+	"organization_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the organization the account belongs to.",
+	},
+	"account_locator": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "System-assigned identifier of the account, which does not change when the account is renamed.",
+	},
+	"account_url": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Preferred Snowflake account URL, which includes the organization and account names.",
+	},
+	"account_locator_url": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Legacy Snowflake account URL, which includes the account locator.",
+	},
+	"old_account_url": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Original account URL kept after the account was renamed, if any.",
 	},
 }
 
 func Account() *schema.Resource {
 	return &schema.Resource{
-		Description: "The account resource allows you to create and manage Snowflake accounts.",
-		Create:      CreateAccount,
-		Read:        ReadAccount,
-		Update:      UpdateAccount,
-		Delete:      DeleteAccount,
+		Description:   "The account resource allows you to create and manage Snowflake accounts. When an account with the same name was dropped and is still within its grace period, it is restored with UNDROP ACCOUNT instead of being created; the creation fails if its edition, region or region group differ from the configured ones. The restored account keeps its users, so the admin_name, admin_password, admin_rsa_public_key, email, first_name, last_name and must_change_password fields are not applied to it and a warning lists the configured ones.",
+		CreateContext: CreateAccount,
+		ReadContext:   ReadAccount,
+		UpdateContext: UpdateAccount,
+		DeleteContext: DeleteAccount,
 
 		Schema: accountSchema,
 		Importer: &schema.ResourceImporter{
@@ -221,11 +270,10 @@ func Account() *schema.Resource {
 	}
 }
 
-// CreateAccount implements schema.CreateFunc.
-func CreateAccount(d *schema.ResourceData, meta interface{}) error {
+// CreateAccount implements schema.CreateContextFunc.
+func CreateAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	name := d.Get("name").(string)
 	objectIdentifier := sdk.NewAccountObjectIdentifier(name)
@@ -260,7 +308,7 @@ func CreateAccount(d *schema.ResourceData, meta interface{}) error {
 		// For organizations that have accounts in multiple region groups, returns <region_group>.<region> so we need to split on "."
 		currentRegion, err := client.ContextFunctions.CurrentRegion(ctx)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		regionParts := strings.Split(currentRegion, ".")
 		if len(regionParts) == 2 {
@@ -273,7 +321,7 @@ func CreateAccount(d *schema.ResourceData, meta interface{}) error {
 		// For organizations that have accounts in multiple region groups, returns <region_group>.<region> so we need to split on "."
 		currentRegion, err := client.ContextFunctions.CurrentRegion(ctx)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		regionParts := strings.Split(currentRegion, ".")
 		if len(regionParts) == 2 {
@@ -286,9 +334,25 @@ func CreateAccount(d *schema.ResourceData, meta interface{}) error {
 		createOptions.Comment = sdk.String(v.(string))
 	}
 
-	err := client.Accounts.Create(ctx, objectIdentifier, createOptions)
+	// A dropped account blocks its name until the grace period ends, so it is restored instead of being created again.
+	var diags diag.Diagnostics
+	dropped, err := findDroppedAccount(ctx, client, objectIdentifier)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	if dropped != nil {
+		if err := checkDroppedAccount(dropped, createOptions); err != nil {
+			return diag.FromErr(err)
+		}
+		log.Printf("[DEBUG] account %s was dropped on %v, undropping it instead of creating a new one", name, dropped.DroppedOn)
+		if err := client.Accounts.Undrop(ctx, objectIdentifier); err != nil {
+			return diag.FromErr(fmt.Errorf("error undropping account %s: %w", name, err))
+		}
+		diags = append(diags, undroppedAccountWarning(dropped, createOptions))
+	} else {
+		if err := client.Accounts.Create(ctx, objectIdentifier, createOptions); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	var account *sdk.Account
@@ -300,95 +364,231 @@ func CreateAccount(d *schema.ResourceData, meta interface{}) error {
 		return nil, true
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(account.AccountLocator))
-	return nil
+
+	if v, ok := d.GetOk("resource_monitor"); ok {
+		err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+			Name: &objectIdentifier,
+			Set:  &sdk.AccountSet{ResourceMonitor: sdk.NewAccountObjectIdentifier(v.(string))},
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error setting resource monitor on account %s: %w", name, err))
+		}
+	}
+	if err := client.Parameters.SetParametersOnObject(ctx, sdk.Object{ObjectType: sdk.ObjectTypeAccount, Name: objectIdentifier}, expandParameterSet(d.Get("parameters"))); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting parameters on account %s: %w", name, err))
+	}
+
+	return append(diags, ReadAccount(ctx, d, meta)...)
 }
 
-// ReadAccount implements schema.ReadFunc.
-func ReadAccount(d *schema.ResourceData, meta interface{}) error {
+// findDroppedAccount returns the account with the given name dropped within its grace period, or nil if there is none.
+func findDroppedAccount(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier) (*sdk.Account, error) {
+	accounts, err := client.Accounts.Show(ctx, &sdk.ShowAccountOptions{
+		History: sdk.Bool(true),
+		Like:    &sdk.Like{Pattern: sdk.String(id.Name())},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range accounts {
+		if account.AccountName == id.Name() && account.IsDropped() {
+			return &account, nil
+		}
+	}
+	return nil, nil
+}
+
+// checkDroppedAccount returns an error when the edition, the region or the region group of the dropped account differ
+// from the configured ones, as UNDROP ACCOUNT restores the account as it was.
+func checkDroppedAccount(dropped *sdk.Account, opts *sdk.CreateAccountOptions) error {
+	var mismatches []string
+	mismatch := func(attribute string, dropped string, configured string) {
+		if dropped != "" && configured != "" && !strings.EqualFold(dropped, configured) {
+			mismatches = append(mismatches, fmt.Sprintf("%s %s (configured %s)", attribute, dropped, configured))
+		}
+	}
+	mismatch("edition", string(dropped.Edition), string(opts.Edition))
+	if opts.Region != nil {
+		mismatch("region", dropped.SnowflakeRegion, *opts.Region)
+	}
+	if opts.RegionGroup != nil {
+		mismatch("region_group", dropped.RegionGroup, *opts.RegionGroup)
+	}
+	if len(mismatches) == 0 {
+		return nil
+	}
+	return fmt.Errorf("account %s was dropped on %v and it would be restored instead of creating a new one, but it has the %s; change the configuration to match the dropped account, or use another name until the dropped account is deleted", dropped.AccountName, dropped.DroppedOn, strings.Join(mismatches, ", "))
+}
+
+// undroppedAccountWarning lists the configured fields of the initial administrative user, which UNDROP ACCOUNT does not
+// apply, as the restored account keeps its users.
+func undroppedAccountWarning(dropped *sdk.Account, opts *sdk.CreateAccountOptions) diag.Diagnostic {
+	var ignored []string
+	for _, field := range []struct {
+		attribute string
+		set       bool
+	}{
+		{"admin_name", opts.AdminName != ""},
+		{"admin_password", opts.AdminPassword != nil},
+		{"admin_rsa_public_key", opts.AdminRSAPublicKey != nil},
+		{"email", opts.Email != ""},
+		{"first_name", opts.FirstName != nil},
+		{"last_name", opts.LastName != nil},
+		{"must_change_password", opts.MustChangePassword != nil && *opts.MustChangePassword},
+	} {
+		if field.set {
+			ignored = append(ignored, field.attribute)
+		}
+	}
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Account %s was restored with UNDROP ACCOUNT", dropped.AccountName),
+		Detail:   fmt.Sprintf("The account was dropped on %v and it was restored instead of being created, together with its users. The following configured fields were not applied: %s.", dropped.DroppedOn, strings.Join(ignored, ", ")),
+	}
+}
+
+// showAccount looks the account up by the name kept in the state first, since the ID is the account locator, which
+// does not change when the account is renamed. The locator is used when the name is unknown (e.g. during the import)
+// or when the account was renamed outside of Terraform.
+func showAccount(ctx context.Context, client *sdk.Client, d *schema.ResourceData) (*sdk.Account, error) {
+	locator := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+	if name := d.Get("name").(string); name != "" {
+		account, err := client.Accounts.ShowByID(ctx, sdk.NewAccountObjectIdentifier(name))
+		if err == nil && account.AccountLocator == locator.Name() {
+			return account, nil
+		}
+	}
+	return client.Accounts.ShowByID(ctx, locator)
+}
+
+// ReadAccount implements schema.ReadContextFunc.
+func ReadAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
-
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
 
 	var acc *sdk.Account
-	var err error
-	err = helpers.Retry(5, 3*time.Second, func() (error, bool) {
-		acc, err = client.Accounts.ShowByID(ctx, id)
-		if err != nil {
+	var showErr error
+	err := helpers.Retry(5, 3*time.Second, func() (error, bool) {
+		acc, showErr = showAccount(ctx, client, d)
+		if showErr != nil {
 			return nil, false
 		}
 		return nil, true
 	})
 	if err != nil {
-		return err
+		if errors.Is(showErr, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[DEBUG] account (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("name", acc.AccountName); err != nil {
-		return fmt.Errorf("error setting name: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting name: %w", err))
 	}
 
 	if err = d.Set("edition", acc.Edition); err != nil {
-		return fmt.Errorf("error setting edition: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting edition: %w", err))
 	}
 
 	if err = d.Set("region_group", acc.RegionGroup); err != nil {
-		return fmt.Errorf("error setting region_group: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting region_group: %w", err))
 	}
 
 	if err = d.Set("region", acc.SnowflakeRegion); err != nil {
-		return fmt.Errorf("error setting region: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting region: %w", err))
 	}
 
 	if err = d.Set("comment", acc.Comment); err != nil {
-		return fmt.Errorf("error setting comment: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting comment: %w", err))
 	}
 
 	if err = d.Set("is_org_admin", acc.IsOrgAdmin); err != nil {
-		return fmt.Errorf("error setting is_org_admin: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting is_org_admin: %w", err))
+	}
+
+	if err = d.Set("organization_name", acc.OrganizationName); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting organization_name: %w", err))
+	}
+
+	if err = d.Set("account_locator", acc.AccountLocator); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting account_locator: %w", err))
+	}
+
+	if err = d.Set("account_url", acc.AccountURL); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting account_url: %w", err))
+	}
+
+	if err = d.Set("account_locator_url", acc.AccountLocatorURL); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting account_locator_url: %w", err))
+	}
+
+	if err = d.Set("old_account_url", acc.OldAccountURL); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting old_account_url: %w", err))
 	}
 
 	return nil
 }
 
-// UpdateAccount implements schema.UpdateFunc.
-func UpdateAccount(d *schema.ResourceData, meta interface{}) error {
-	/*
-		todo: comments may eventually work again for accounts, so this can be uncommented when that happens
-		db := meta.(*sql.DB)
-		client := sdk.NewClientFromDB(db)
-		ctx := context.Background()
-
-		id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
-
-		// Change comment
-		if d.HasChange("comment") {
-			// changing comment isn't supported for accounts
-			err := client.Comments.Set(ctx, &sdk.SetCommentOptions{
-				ObjectType: sdk.ObjectTypeAccount,
-				ObjectName: sdk.NewAccountObjectIdentifier(d.Get("name").(string)),
-				Value:      sdk.String(d.Get("comment").(string)),
-			})
-			if err != nil {
-				return err
-			}
+// UpdateAccount implements schema.UpdateContextFunc.
+func UpdateAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+
+	if d.HasChange("name") {
+		o, n := d.GetChange("name")
+		err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+			Rename: &sdk.AccountRename{
+				Name:       sdk.NewAccountObjectIdentifier(o.(string)),
+				NewName:    sdk.NewAccountObjectIdentifier(n.(string)),
+				SaveOldURL: sdk.Bool(d.Get("save_old_url").(bool)),
+			},
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming account %s to %s: %w", o, n, err))
 		}
-	*/
-	return nil
+	}
+
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+	if d.HasChange("resource_monitor") {
+		opts := &sdk.AlterAccountOptions{Name: &id}
+		if v, ok := d.GetOk("resource_monitor"); ok {
+			opts.Set = &sdk.AccountSet{ResourceMonitor: sdk.NewAccountObjectIdentifier(v.(string))}
+		} else {
+			opts.Unset = &sdk.AccountUnset{ResourceMonitor: sdk.Bool(true)}
+		}
+		if err := client.Accounts.Alter(ctx, opts); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating resource monitor on account %s: %w", id.Name(), err))
+		}
+	}
+
+	if d.HasChange("parameters") {
+		object := sdk.Object{ObjectType: sdk.ObjectTypeAccount, Name: id}
+		toUnset, toSet := diffParameterSet(d.GetChange("parameters"))
+		if err := client.Parameters.UnsetParametersOnObject(ctx, object, toUnset); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting parameters on account %s: %w", id.Name(), err))
+		}
+		if err := client.Parameters.SetParametersOnObject(ctx, object, toSet); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting parameters on account %s: %w", id.Name(), err))
+		}
+	}
+
+	return ReadAccount(ctx, d, meta)
 }
 
-// DeleteAccount implements schema.DeleteFunc.
-func DeleteAccount(d *schema.ResourceData, meta interface{}) error {
+// DeleteAccount implements schema.DeleteContextFunc.
+func DeleteAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	gracePeriodInDays := d.Get("grace_period_in_days").(int)
-	err := client.Accounts.Drop(ctx, helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier), gracePeriodInDays, &sdk.DropAccountOptions{
+	err := client.Accounts.Drop(ctx, sdk.NewAccountObjectIdentifier(d.Get("name").(string)), gracePeriodInDays, &sdk.DropAccountOptions{
 		IfExists: sdk.Bool(true),
 	})
-	return err
+	return diag.FromErr(err)
 }
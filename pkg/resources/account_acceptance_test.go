@@ -9,6 +9,7 @@ import (
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAcc_Account_complete(t *testing.T) {
@@ -17,6 +18,7 @@ func TestAcc_Account_complete(t *testing.T) {
 		t.Skip("Skipping TestInt_AccountCreate")
 	}
 	accountName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	newAccountName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	password := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha) + "123ABC"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -27,7 +29,7 @@ func TestAcc_Account_complete(t *testing.T) {
 		// unless we change the resource to return nil on destroy then this is unavoidable
 		Steps: []resource.TestStep{
 			{
-				Config: accountConfig(accountName, password, "Terraform acceptance test", 3),
+				Config: accountConfig(accountName, password, "Terraform acceptance test", 3, ""),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("snowflake_account.test", "name", accountName),
 					resource.TestCheckResourceAttr("snowflake_account.test", "admin_name", "someadmin"),
@@ -43,9 +45,38 @@ func TestAcc_Account_complete(t *testing.T) {
 			},
 			// Change Grace Period In Days
 			{
-				Config: accountConfig(accountName, password, "Terraform acceptance test", 4),
+				Config: accountConfig(accountName, password, "Terraform acceptance test", 4, ""),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("snowflake_account.test", "grace_period_in_days", "4"),
+					resource.TestCheckResourceAttrSet("snowflake_account.test", "organization_name"),
+					resource.TestCheckResourceAttrSet("snowflake_account.test", "account_locator"),
+					resource.TestCheckResourceAttrSet("snowflake_account.test", "account_url"),
+					resource.TestCheckResourceAttrSet("snowflake_account.test", "account_locator_url"),
+				),
+			},
+			// Rename keeping the old URL and set parameters in place
+			{
+				Config: accountConfig(newAccountName, password, "Terraform acceptance test", 4, `
+  parameters = {
+    STATEMENT_TIMEOUT_IN_SECONDS = "3600"
+  }
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_account.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account.test", "name", newAccountName),
+					resource.TestCheckResourceAttrSet("snowflake_account.test", "old_account_url"),
+					resource.TestCheckResourceAttr("snowflake_account.test", "parameters.STATEMENT_TIMEOUT_IN_SECONDS", "3600"),
+				),
+			},
+			// Unset parameters
+			{
+				Config: accountConfig(newAccountName, password, "Terraform acceptance test", 4, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account.test", "parameters.%", "0"),
 				),
 			},
 			// IMPORT
@@ -62,13 +93,14 @@ func TestAcc_Account_complete(t *testing.T) {
 					"first_name",
 					"last_name",
 					"grace_period_in_days",
+					"save_old_url",
 				},
 			},
 		},
 	})
 }
 
-func accountConfig(name string, password string, comment string, gracePeriodInDays int) string {
+func accountConfig(name string, password string, comment string, gracePeriodInDays int, properties string) string {
 	return fmt.Sprintf(`
 data "snowflake_current_account" "current" {}
 
@@ -84,6 +116,7 @@ resource "snowflake_account" "test" {
   comment = "%s"
   region = data.snowflake_current_account.current.region
   grace_period_in_days = %d
+%s
 }
-`, name, password, comment, gracePeriodInDays)
+`, name, password, comment, gracePeriodInDays, properties)
 }
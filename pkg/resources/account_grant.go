@@ -7,6 +7,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -83,10 +84,10 @@ var accountGrantSchema = map[string]*schema.Schema{
 func AccountGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateAccountGrant,
-			Read:   ReadAccountGrant,
-			Delete: DeleteAccountGrant,
-			Update: UpdateAccountGrant,
+			CreateContext: CreateAccountGrant,
+			ReadContext:   ReadAccountGrant,
+			DeleteContext: DeleteAccountGrant,
+			UpdateContext: UpdateAccountGrant,
 
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             accountGrantSchema,
@@ -113,12 +114,12 @@ func AccountGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateAccountGrant implements schema.CreateFunc.
-func CreateAccountGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateAccountGrant implements schema.CreateContextFunc.
+func CreateAccountGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	builder := snowflake.AccountGrant()
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 
 	privilege := d.Get("privilege").(string)
@@ -127,19 +128,19 @@ func CreateAccountGrant(d *schema.ResourceData, meta interface{}) error {
 	grantID := helpers.EncodeSnowflakeID(privilege, withGrantOption, roles)
 	d.SetId(grantID)
 
-	return ReadAccountGrant(d, meta)
+	return ReadAccountGrant(ctx, d, meta)
 }
 
-// ReadAccountGrant implements schema.ReadFunc.
-func ReadAccountGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadAccountGrant implements schema.ReadContextFunc.
+func ReadAccountGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	privilege := d.Get("privilege").(string)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 	withGrantOption := d.Get("with_grant_option").(bool)
 
 	builder := snowflake.AccountGrant()
-	err := readGenericGrant(d, meta, accountGrantSchema, builder, false, false, validAccountPrivileges)
+	err := readGenericGrant(ctx, d, meta, accountGrantSchema, builder, false, false, validAccountPrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(privilege, withGrantOption, roles)
@@ -150,14 +151,14 @@ func ReadAccountGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteAccountGrant implements schema.DeleteFunc.
-func DeleteAccountGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteAccountGrant implements schema.DeleteContextFunc.
+func DeleteAccountGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	builder := snowflake.AccountGrant()
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateAccountGrant implements schema.UpdateFunc.
-func UpdateAccountGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateAccountGrant implements schema.UpdateContextFunc.
+func UpdateAccountGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update is roles.
 	// if nothing changed, nothing to update and we're done.
 	if !d.HasChanges("roles") {
@@ -171,15 +172,15 @@ func UpdateAccountGrant(d *schema.ResourceData, meta interface{}) error {
 	withGrantOption := d.Get("with_grant_option").(bool)
 
 	// first revoke
-	if err := deleteGenericGrantRolesAndShares(meta, builder, privilege, "", rolesToRevoke, nil); err != nil {
-		return err
+	if err := deleteGenericGrantRolesAndShares(ctx, meta, builder, privilege, "", rolesToRevoke, nil); err != nil {
+		return diag.FromErr(err)
 	}
 
 	// then add
-	if err := createGenericGrantRolesAndShares(meta, builder, privilege, withGrantOption, rolesToAdd, nil); err != nil {
-		return err
+	if err := createGenericGrantRolesAndShares(ctx, meta, builder, privilege, withGrantOption, rolesToAdd, nil); err != nil {
+		return diag.FromErr(err)
 	}
 
 	// done, refresh state
-	return ReadAccountGrant(d, meta)
+	return ReadAccountGrant(ctx, d, meta)
 }
@@ -0,0 +1,62 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDroppedAccount(t *testing.T) {
+	droppedOn := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	dropped := &sdk.Account{
+		AccountName:     "ACCOUNT",
+		RegionGroup:     "PUBLIC",
+		SnowflakeRegion: "AWS_US_WEST_2",
+		Edition:         sdk.EditionEnterprise,
+		DroppedOn:       &droppedOn,
+	}
+
+	t.Run("matching account", func(t *testing.T) {
+		require.NoError(t, checkDroppedAccount(dropped, &sdk.CreateAccountOptions{
+			Edition:     sdk.EditionEnterprise,
+			Region:      sdk.String("aws_us_west_2"),
+			RegionGroup: sdk.String("PUBLIC"),
+		}))
+	})
+
+	t.Run("without the region group", func(t *testing.T) {
+		require.NoError(t, checkDroppedAccount(dropped, &sdk.CreateAccountOptions{Edition: sdk.EditionEnterprise, Region: sdk.String("AWS_US_WEST_2")}))
+	})
+
+	t.Run("different edition and region", func(t *testing.T) {
+		err := checkDroppedAccount(dropped, &sdk.CreateAccountOptions{
+			Edition:     sdk.EditionBusinessCritical,
+			Region:      sdk.String("AWS_EU_WEST_1"),
+			RegionGroup: sdk.String("PUBLIC"),
+		})
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "account ACCOUNT was dropped on 2024-01-01 10:00:00 +0000 UTC")
+		assert.ErrorContains(t, err, "edition ENTERPRISE (configured BUSINESS_CRITICAL), region AWS_US_WEST_2 (configured AWS_EU_WEST_1);")
+	})
+}
+
+func TestUndroppedAccountWarning(t *testing.T) {
+	droppedOn := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	dropped := &sdk.Account{AccountName: "ACCOUNT", DroppedOn: &droppedOn}
+
+	warning := undroppedAccountWarning(dropped, &sdk.CreateAccountOptions{
+		AdminName:          "ADMIN",
+		AdminRSAPublicKey:  sdk.String("key"),
+		Email:              "admin@example.com",
+		LastName:           sdk.String("Doe"),
+		MustChangePassword: sdk.Bool(false),
+	})
+	assert.Equal(t, diag.Warning, warning.Severity)
+	assert.Equal(t, "Account ACCOUNT was restored with UNDROP ACCOUNT", warning.Summary)
+	assert.Contains(t, warning.Detail, "dropped on 2024-01-01 10:00:00 +0000 UTC")
+	assert.Contains(t, warning.Detail, "not applied: admin_name, admin_rsa_public_key, email, last_name.")
+}
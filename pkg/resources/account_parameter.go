@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,10 +26,10 @@ var accountParameterSchema = map[string]*schema.Schema{
 
 func AccountParameter() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateAccountParameter,
-		Read:   ReadAccountParameter,
-		Update: UpdateAccountParameter,
-		Delete: DeleteAccountParameter,
+		CreateContext: CreateAccountParameter,
+		ReadContext:   ReadAccountParameter,
+		UpdateContext: UpdateAccountParameter,
+		DeleteContext: DeleteAccountParameter,
 
 		Schema: accountParameterSchema,
 		Importer: &schema.ResourceImporter{
@@ -37,59 +38,56 @@ func AccountParameter() *schema.Resource {
 	}
 }
 
-// CreateAccountParameter implements schema.CreateFunc.
-func CreateAccountParameter(d *schema.ResourceData, meta interface{}) error {
+// CreateAccountParameter implements schema.CreateContextFunc.
+func CreateAccountParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	key := d.Get("key").(string)
 	value := d.Get("value").(string)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	parameter := sdk.AccountParameter(key)
 	err := client.Parameters.SetAccountParameter(ctx, parameter, value)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(key)
-	return ReadAccountParameter(d, meta)
+	return ReadAccountParameter(ctx, d, meta)
 }
 
-// ReadAccountParameter implements schema.ReadFunc.
-func ReadAccountParameter(d *schema.ResourceData, meta interface{}) error {
+// ReadAccountParameter implements schema.ReadContextFunc.
+func ReadAccountParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	parameterName := d.Id()
 	parameter, err := client.Parameters.ShowAccountParameter(ctx, sdk.AccountParameter(parameterName))
 	if err != nil {
-		return fmt.Errorf("error reading account parameter err = %w", err)
+		return diag.FromErr(fmt.Errorf("error reading account parameter err = %w", err))
 	}
 	err = d.Set("value", parameter.Value)
 	if err != nil {
-		return fmt.Errorf("error setting account parameter err = %w", err)
+		return diag.FromErr(fmt.Errorf("error setting account parameter err = %w", err))
 	}
 	return nil
 }
 
-// UpdateAccountParameter implements schema.UpdateFunc.
-func UpdateAccountParameter(d *schema.ResourceData, meta interface{}) error {
-	return CreateAccountParameter(d, meta)
+// UpdateAccountParameter implements schema.UpdateContextFunc.
+func UpdateAccountParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return CreateAccountParameter(ctx, d, meta)
 }
 
-// DeleteAccountParameter implements schema.DeleteFunc.
-func DeleteAccountParameter(d *schema.ResourceData, meta interface{}) error {
+// DeleteAccountParameter implements schema.DeleteContextFunc.
+func DeleteAccountParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	key := d.Get("key").(string)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	parameter := sdk.AccountParameter(key)
 	defaultParameter, err := client.Parameters.ShowAccountParameter(ctx, sdk.AccountParameter(key))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	defaultValue := defaultParameter.Default
 	err = client.Parameters.SetAccountParameter(ctx, parameter, defaultValue)
 	if err != nil {
-		return fmt.Errorf("error resetting account parameter err = %w", err)
+		return diag.FromErr(fmt.Errorf("error resetting account parameter err = %w", err))
 	}
 
 	d.SetId("")
@@ -7,6 +7,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -24,9 +25,9 @@ func AccountPasswordPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		Description: "Specifies the password policy to use for the current account. To set the password policy of a different account, use a provider alias.",
 
-		Create: CreateAccountPasswordPolicyAttachment,
-		Read:   ReadAccountPasswordPolicyAttachment,
-		Delete: DeleteAccountPasswordPolicyAttachment,
+		CreateContext: CreateAccountPasswordPolicyAttachment,
+		ReadContext:   ReadAccountPasswordPolicyAttachment,
+		DeleteContext: DeleteAccountPasswordPolicyAttachment,
 
 		Schema: accountPasswordPolicyAttachmentSchema,
 		Importer: &schema.ResourceImporter{
@@ -35,15 +36,14 @@ func AccountPasswordPolicyAttachment() *schema.Resource {
 	}
 }
 
-// CreateAccountPasswordPolicyAttachment implements schema.CreateFunc.
-func CreateAccountPasswordPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+// CreateAccountPasswordPolicyAttachment implements schema.CreateContextFunc.
+func CreateAccountPasswordPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	passwordPolicy, ok := sdk.NewObjectIdentifierFromFullyQualifiedName(d.Get("password_policy").(string)).(sdk.SchemaObjectIdentifier)
 	if !ok {
-		return fmt.Errorf("password_policy %s is not a valid password policy qualified name, expected format: `\"db\".\"schema\".\"policy\"`", d.Get("password_policy"))
+		return diag.FromErr(fmt.Errorf("password_policy %s is not a valid password policy qualified name, expected format: `\"db\".\"schema\".\"policy\"`", d.Get("password_policy")))
 	}
 	// passwordPolicy := sdk.NewAccountObjectIdentifier(d.Get("password_policy").(string))
 
@@ -53,7 +53,7 @@ func CreateAccountPasswordPolicyAttachment(d *schema.ResourceData, meta interfac
 		},
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(passwordPolicy))
@@ -61,20 +61,19 @@ func CreateAccountPasswordPolicyAttachment(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func ReadAccountPasswordPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+func ReadAccountPasswordPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	passwordPolicy := helpers.DecodeSnowflakeID(d.Id())
 	if err := d.Set("password_policy", passwordPolicy.FullyQualifiedName()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-// DeleteAccountPasswordPolicyAttachment implements schema.DeleteFunc.
-func DeleteAccountPasswordPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+// DeleteAccountPasswordPolicyAttachment implements schema.DeleteContextFunc.
+func DeleteAccountPasswordPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
 		Unset: &sdk.AccountUnset{
@@ -82,7 +81,7 @@ func DeleteAccountPasswordPolicyAttachment(d *schema.ResourceData, meta interfac
 		},
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -11,6 +11,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -101,15 +102,16 @@ var alertSchema = map[string]*schema.Schema{
 		Default:     false,
 		Description: "Specifies if an alert should be 'started' (enabled) after creation or should remain 'suspended' (default).",
 	},
+	"statement_comparison": statementComparisonSchema,
 }
 
 // Alert returns a pointer to the resource representing an alert.
 func Alert() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateAlert,
-		Read:   ReadAlert,
-		Update: UpdateAlert,
-		Delete: DeleteAlert,
+		CreateContext: CreateAlert,
+		ReadContext:   ReadAlert,
+		UpdateContext: UpdateAlert,
+		DeleteContext: DeleteAlert,
 
 		Schema: alertSchema,
 		Importer: &schema.ResourceImporter{
@@ -118,13 +120,12 @@ func Alert() *schema.Resource {
 	}
 }
 
-// ReadAlert implements schema.ReadFunc.
-func ReadAlert(d *schema.ResourceData, meta interface{}) error {
+// ReadAlert implements schema.ReadContextFunc.
+func ReadAlert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
-	ctx := context.Background()
 	alert, err := client.Alerts.ShowByID(ctx, objectIdentifier)
 	if err != nil {
 		// If not found, mark resource to be removed from state file during apply or refresh
@@ -134,15 +135,15 @@ func ReadAlert(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("enabled", alert.State == sdk.AlertStateStarted); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("name", alert.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("database", alert.DatabaseName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	alertSchedule := alert.Schedule
@@ -150,7 +151,7 @@ func ReadAlert(d *schema.ResourceData, meta interface{}) error {
 		if strings.Contains(alertSchedule, "MINUTE") {
 			interval, err := strconv.Atoi(strings.TrimSuffix(alertSchedule, " MINUTE"))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			err = d.Set("alert_schedule", []interface{}{
 				map[string]interface{}{
@@ -158,7 +159,7 @@ func ReadAlert(d *schema.ResourceData, meta interface{}) error {
 				},
 			})
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		} else {
 			repScheduleParts := strings.Split(alertSchedule, " ")
@@ -175,35 +176,35 @@ func ReadAlert(d *schema.ResourceData, meta interface{}) error {
 				},
 			})
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
 	if err := d.Set("schema", alert.SchemaName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("warehouse", alert.Warehouse); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("comment", alert.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("condition", alert.Condition); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("action", alert.Action); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-// CreateAlert implements schema.CreateFunc.
-func CreateAlert(d *schema.ResourceData, meta interface{}) error {
+// CreateAlert implements schema.CreateContextFunc.
+func CreateAlert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 
@@ -211,7 +212,6 @@ func CreateAlert(d *schema.ResourceData, meta interface{}) error {
 	schemaName := d.Get("schema").(string)
 	name := d.Get("name").(string)
 
-	ctx := context.Background()
 	objectIdentifier := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
 	alertSchedule := getAlertSchedule(d.Get("alert_schedule"))
@@ -231,7 +231,7 @@ func CreateAlert(d *schema.ResourceData, meta interface{}) error {
 
 	err := client.Alerts.Create(ctx, objectIdentifier, warehouse, alertSchedule, condition, action, opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	enabled := d.Get("enabled").(bool)
@@ -240,13 +240,13 @@ func CreateAlert(d *schema.ResourceData, meta interface{}) error {
 		opts := sdk.AlterAlertOptions{Action: &sdk.AlertActionResume}
 		err := client.Alerts.Alter(ctx, objectIdentifier, &opts)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
 
-	return ReadAlert(d, meta)
+	return ReadAlert(ctx, d, meta)
 }
 
 func getAlertSchedule(v interface{}) string {
@@ -270,12 +270,11 @@ func getAlertSchedule(v interface{}) string {
 	return alertSchedule
 }
 
-// UpdateAlert implements schema.UpdateFunc.
-func UpdateAlert(d *schema.ResourceData, meta interface{}) error {
+// UpdateAlert implements schema.UpdateContextFunc.
+func UpdateAlert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
-	ctx := context.Background()
 
 	enabled := d.Get("enabled").(bool)
 	if d.HasChanges("enabled", "warehouse", "alert_schedule", "condition", "action", "comment") {
@@ -316,7 +315,7 @@ func UpdateAlert(d *schema.ResourceData, meta interface{}) error {
 		setOptions := &sdk.AlterAlertOptions{Set: opts.Set}
 		err := client.Alerts.Alter(ctx, objectIdentifier, setOptions)
 		if err != nil {
-			return fmt.Errorf("error updating alert %v: %w", objectIdentifier.Name(), err)
+			return diag.FromErr(fmt.Errorf("error updating alert %v: %w", objectIdentifier.Name(), err))
 		}
 	}
 
@@ -326,7 +325,7 @@ func UpdateAlert(d *schema.ResourceData, meta interface{}) error {
 		alterOptions.ModifyCondition = &[]string{condition}
 		err := client.Alerts.Alter(ctx, objectIdentifier, alterOptions)
 		if err != nil {
-			return fmt.Errorf("error updating schedule on condition %v: %w", objectIdentifier.Name(), err)
+			return diag.FromErr(fmt.Errorf("error updating schedule on condition %v: %w", objectIdentifier.Name(), err))
 		}
 	}
 
@@ -336,7 +335,7 @@ func UpdateAlert(d *schema.ResourceData, meta interface{}) error {
 		alterOptions.ModifyAction = &action
 		err := client.Alerts.Alter(ctx, objectIdentifier, alterOptions)
 		if err != nil {
-			return fmt.Errorf("error updating schedule on action %v: %w", objectIdentifier.Name(), err)
+			return diag.FromErr(fmt.Errorf("error updating schedule on action %v: %w", objectIdentifier.Name(), err))
 		}
 	}
 
@@ -349,19 +348,18 @@ func UpdateAlert(d *schema.ResourceData, meta interface{}) error {
 			log.Printf("[WARN] failed to suspend alert %s", objectIdentifier.Name())
 		}
 	}
-	return ReadAlert(d, meta)
+	return ReadAlert(ctx, d, meta)
 }
 
-// DeleteAlert implements schema.DeleteFunc.
-func DeleteAlert(d *schema.ResourceData, meta interface{}) error {
+// DeleteAlert implements schema.DeleteContextFunc.
+func DeleteAlert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
 	err := client.Alerts.Drop(ctx, objectIdentifier)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -0,0 +1,339 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var authenticationPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the authentication policy. Renaming the policy is applied in place.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the authentication policy.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the authentication policy.",
+	},
+	"authentication_methods": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(sdk.AsStringList(sdk.AllAuthenticationMethods), false),
+		},
+		Optional:    true,
+		Description: "A list of authentication methods that are allowed during login. Available options are ALL, SAML, PASSWORD, OAUTH and KEYPAIR. Snowflake allows all of them (ALL) when not set.",
+	},
+	"mfa_authentication_methods": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(sdk.AsStringList(sdk.AllMfaAuthenticationMethods), false),
+		},
+		Optional:    true,
+		Description: "A list of authentication methods that enforce multi-factor authentication (MFA) during login. Available options are ALL, SAML and PASSWORD. Snowflake uses PASSWORD and SAML when not set.",
+	},
+	"mfa_enrollment": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(sdk.AsStringList(sdk.AllMfaEnrollmentOptions), false),
+		Description:  "Determines whether a user must enroll in multi-factor authentication. Available options are REQUIRED and OPTIONAL. When REQUIRED is set, client_types must include SNOWFLAKE_UI. Snowflake uses OPTIONAL when not set.",
+	},
+	"client_types": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(sdk.AsStringList(sdk.AllClientTypes), false),
+		},
+		Optional:    true,
+		Description: "A list of clients that can authenticate with Snowflake. Available options are ALL, SNOWFLAKE_UI, DRIVERS and SNOWSQL. Snowflake allows all of them (ALL) when not set.",
+	},
+	"security_integrations": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of security integrations the authentication policy is associated with. Use ALL to allow all of them. The names should be provided in upper case, the way Snowflake returns them. Snowflake allows all of them (ALL) when not set.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the authentication policy.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The fully qualified name of the authentication policy, to be used in the policy attachments.",
+	},
+}
+
+// AuthenticationPolicy returns a pointer to the resource representing an authentication policy.
+func AuthenticationPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "An authentication policy specifies the authentication methods, clients and security integrations allowed to log in to Snowflake, and whether the users must enroll in multi-factor authentication.",
+
+		CreateContext: CreateAuthenticationPolicy,
+		ReadContext:   ReadAuthenticationPolicy,
+		UpdateContext: UpdateAuthenticationPolicy,
+		DeleteContext: DeleteAuthenticationPolicy,
+
+		Schema: authenticationPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateAuthenticationPolicy implements schema.CreateContextFunc.
+func CreateAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := sdk.NewClientFromDB(meta.(*sql.DB))
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateAuthenticationPolicyRequest(id)
+	if v, ok := d.GetOk("authentication_methods"); ok {
+		request.WithAuthenticationMethods(expandAuthenticationMethods(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("mfa_authentication_methods"); ok {
+		request.WithMfaAuthenticationMethods(expandMfaAuthenticationMethods(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("mfa_enrollment"); ok {
+		request.WithMfaEnrollment(sdk.Pointer(sdk.MfaEnrollmentOption(v.(string))))
+	}
+	if v, ok := d.GetOk("client_types"); ok {
+		request.WithClientTypes(expandClientTypes(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("security_integrations"); ok {
+		request.WithSecurityIntegrations(expandSecurityIntegrations(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.AuthenticationPolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating authentication policy %s: %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadAuthenticationPolicy(ctx, d, meta)
+}
+
+// ReadAuthenticationPolicy implements schema.ReadContextFunc.
+func ReadAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := sdk.NewClientFromDB(meta.(*sql.DB))
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	authenticationPolicy, err := client.AuthenticationPolicies.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[DEBUG] authentication policy (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	values := map[string]any{
+		"name":           authenticationPolicy.Name,
+		"database":       authenticationPolicy.DatabaseName,
+		"schema":         authenticationPolicy.SchemaName,
+		"comment":        authenticationPolicy.Comment,
+		"qualified_name": id.FullyQualifiedName(),
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	properties, err := client.AuthenticationPolicies.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error describing authentication policy %s: %w", id.FullyQualifiedName(), err))
+	}
+	for _, property := range properties {
+		key := strings.ToLower(property.Property)
+		switch key {
+		case "authentication_methods", "mfa_authentication_methods", "client_types", "security_integrations":
+			// Note: the value equal to the server default is only kept when it was set explicitly, so that the optional lists do not diff.
+			value := parseAuthenticationPolicyList(property.Value)
+			if property.Value == property.Default && d.Get(key).(*schema.Set).Len() == 0 {
+				value = []string{}
+			}
+			if err := d.Set(key, value); err != nil {
+				return diag.FromErr(err)
+			}
+		case "mfa_enrollment":
+			value := property.Value
+			if property.Value == property.Default && d.Get(key).(string) == "" {
+				value = ""
+			}
+			if err := d.Set(key, value); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateAuthenticationPolicy implements schema.UpdateContextFunc.
+func UpdateAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := sdk.NewClientFromDB(meta.(*sql.DB))
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), d.Get("name").(string))
+		if err := client.AuthenticationPolicies.Alter(ctx, sdk.NewAlterAuthenticationPolicyRequest(id).WithRenameTo(&newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming authentication policy %s: %w", id.FullyQualifiedName(), err))
+		}
+		d.SetId(helpers.EncodeSnowflakeID(newId))
+		id = newId
+	}
+
+	set, unset := sdk.NewAuthenticationPolicySetRequest(), sdk.NewAuthenticationPolicyUnsetRequest()
+	runSet, runUnset := false, false
+
+	if d.HasChange("authentication_methods") {
+		if v := d.Get("authentication_methods").(*schema.Set); v.Len() > 0 {
+			set.WithAuthenticationMethods(expandAuthenticationMethods(v))
+			runSet = true
+		} else {
+			unset.WithAuthenticationMethods(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if d.HasChange("mfa_authentication_methods") {
+		if v := d.Get("mfa_authentication_methods").(*schema.Set); v.Len() > 0 {
+			set.WithMfaAuthenticationMethods(expandMfaAuthenticationMethods(v))
+			runSet = true
+		} else {
+			unset.WithMfaAuthenticationMethods(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if d.HasChange("mfa_enrollment") {
+		if v := d.Get("mfa_enrollment").(string); v != "" {
+			set.WithMfaEnrollment(sdk.Pointer(sdk.MfaEnrollmentOption(v)))
+			runSet = true
+		} else {
+			unset.WithMfaEnrollment(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if d.HasChange("client_types") {
+		if v := d.Get("client_types").(*schema.Set); v.Len() > 0 {
+			set.WithClientTypes(expandClientTypes(v))
+			runSet = true
+		} else {
+			unset.WithClientTypes(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if d.HasChange("security_integrations") {
+		if v := d.Get("security_integrations").(*schema.Set); v.Len() > 0 {
+			set.WithSecurityIntegrations(expandSecurityIntegrations(v))
+			runSet = true
+		} else {
+			unset.WithSecurityIntegrations(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if d.HasChange("comment") {
+		if v := d.Get("comment").(string); v != "" {
+			set.WithComment(sdk.String(v))
+			runSet = true
+		} else {
+			unset.WithComment(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
+	if runSet {
+		if err := client.AuthenticationPolicies.Alter(ctx, sdk.NewAlterAuthenticationPolicyRequest(id).WithSet(set)); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating authentication policy %s: %w", id.FullyQualifiedName(), err))
+		}
+	}
+	if runUnset {
+		if err := client.AuthenticationPolicies.Alter(ctx, sdk.NewAlterAuthenticationPolicyRequest(id).WithUnset(unset)); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting authentication policy %s properties: %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	return ReadAuthenticationPolicy(ctx, d, meta)
+}
+
+// DeleteAuthenticationPolicy implements schema.DeleteContextFunc.
+func DeleteAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := sdk.NewClientFromDB(meta.(*sql.DB))
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.AuthenticationPolicies.Drop(ctx, sdk.NewDropAuthenticationPolicyRequest(id)); err != nil {
+		return diag.FromErr(fmt.Errorf("error dropping authentication policy %s: %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// parseAuthenticationPolicyList parses the list properties returned by DESCRIBE AUTHENTICATION POLICY, e.g. `[PASSWORD, SAML]`.
+func parseAuthenticationPolicyList(value string) []string {
+	trimmed := strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(value), "["), "]")
+	if strings.TrimSpace(trimmed) == "" {
+		return []string{}
+	}
+	parts := strings.Split(trimmed, ",")
+	result := make([]string, 0, len(parts))
+	for _, part := range parts {
+		result = append(result, strings.Trim(strings.TrimSpace(part), `'"`))
+	}
+	return result
+}
+
+func expandAuthenticationMethods(set *schema.Set) []sdk.AuthenticationMethodsRequest {
+	methods := make([]sdk.AuthenticationMethodsRequest, 0, set.Len())
+	for _, v := range expandStringList(set.List()) {
+		methods = append(methods, sdk.AuthenticationMethodsRequest{Method: sdk.AuthenticationMethodsOption(v)})
+	}
+	return methods
+}
+
+func expandMfaAuthenticationMethods(set *schema.Set) []sdk.MfaAuthenticationMethodsRequest {
+	methods := make([]sdk.MfaAuthenticationMethodsRequest, 0, set.Len())
+	for _, v := range expandStringList(set.List()) {
+		methods = append(methods, sdk.MfaAuthenticationMethodsRequest{Method: sdk.MfaAuthenticationMethodsOption(v)})
+	}
+	return methods
+}
+
+func expandClientTypes(set *schema.Set) []sdk.ClientTypesRequest {
+	clientTypes := make([]sdk.ClientTypesRequest, 0, set.Len())
+	for _, v := range expandStringList(set.List()) {
+		clientTypes = append(clientTypes, sdk.ClientTypesRequest{ClientType: sdk.ClientTypesOption(v)})
+	}
+	return clientTypes
+}
+
+func expandSecurityIntegrations(set *schema.Set) []sdk.SecurityIntegrationsOptionRequest {
+	securityIntegrations := make([]sdk.SecurityIntegrationsOptionRequest, 0, set.Len())
+	for _, v := range expandStringList(set.List()) {
+		securityIntegrations = append(securityIntegrations, sdk.SecurityIntegrationsOptionRequest{Name: v})
+	}
+	return securityIntegrations
+}
@@ -0,0 +1,104 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_AuthenticationPolicy(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	newName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_authentication_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: authenticationPolicyConfig(name, `
+	authentication_methods = ["PASSWORD", "SAML"]
+	mfa_enrollment         = "REQUIRED"
+	client_types           = ["SNOWFLAKE_UI", "DRIVERS"]
+	comment                = "first"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "qualified_name", sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, name).FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "authentication_methods.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "authentication_methods.*", "PASSWORD"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "authentication_methods.*", "SAML"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_enrollment", "REQUIRED"),
+					resource.TestCheckResourceAttr(resourceName, "client_types.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_authentication_methods.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "security_integrations.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "first"),
+				),
+			},
+			// renaming and unsetting the properties are applied in place and fall back to the server defaults without a diff
+			{
+				Config: authenticationPolicyConfig(newName, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", newName),
+					resource.TestCheckResourceAttr(resourceName, "authentication_methods.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_enrollment", ""),
+					resource.TestCheckResourceAttr(resourceName, "client_types.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+				),
+			},
+			// setting the value equal to the server default explicitly is kept in the state
+			{
+				Config: authenticationPolicyConfig(newName, `
+	mfa_enrollment = "OPTIONAL"
+	client_types   = ["ALL"]
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mfa_enrollment", "OPTIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "client_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "client_types.*", "ALL"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"mfa_enrollment", "client_types"},
+			},
+		},
+	})
+}
+
+func authenticationPolicyConfig(name string, properties string) string {
+	return fmt.Sprintf(`
+resource "snowflake_authentication_policy" "test" {
+	database = "%s"
+	schema   = "%s"
+	name     = "%s"
+%s
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, properties)
+}
@@ -0,0 +1,69 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAuthenticationPolicyList(t *testing.T) {
+	assert.Equal(t, []string{}, parseAuthenticationPolicyList(""))
+	assert.Equal(t, []string{}, parseAuthenticationPolicyList("[]"))
+	assert.Equal(t, []string{"ALL"}, parseAuthenticationPolicyList("[ALL]"))
+	assert.Equal(t, []string{"PASSWORD", "SAML"}, parseAuthenticationPolicyList("[PASSWORD, SAML]"))
+	assert.Equal(t, []string{"OKTA_INTEGRATION"}, parseAuthenticationPolicyList("['OKTA_INTEGRATION']"))
+}
+
+func TestAuthenticationPolicyValidation(t *testing.T) {
+	s := AuthenticationPolicy().Schema
+	isValid := func(key string, value string) bool {
+		validate := s[key].ValidateFunc
+		if elem, ok := s[key].Elem.(*schema.Schema); ok {
+			validate = elem.ValidateFunc
+		}
+		_, errs := validate(value, key)
+		return len(errs) == 0
+	}
+
+	assert.True(t, isValid("authentication_methods", "KEYPAIR"))
+	assert.False(t, isValid("authentication_methods", "keypair"))
+	assert.True(t, isValid("mfa_authentication_methods", "SAML"))
+	assert.False(t, isValid("mfa_authentication_methods", "OAUTH"))
+	assert.True(t, isValid("mfa_enrollment", "REQUIRED"))
+	assert.False(t, isValid("mfa_enrollment", "ALWAYS"))
+	assert.True(t, isValid("client_types", "SNOWSQL"))
+	assert.False(t, isValid("client_types", "JDBC"))
+}
+
+func TestReadAuthenticationPolicyErrors(t *testing.T) {
+	id := sdk.NewSchemaObjectIdentifier("db", "schema", "policy")
+	query := `SHOW AUTHENTICATION POLICIES LIKE 'policy' IN SCHEMA "db"."schema"`
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	// a policy missing from SHOW is removed from the state
+	d := schema.TestResourceDataRaw(t, authenticationPolicySchema, map[string]any{})
+	d.SetId(helpers.EncodeSnowflakeID(id))
+	mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"created_on", "name"}))
+	diags := ReadAuthenticationPolicy(context.Background(), d, db)
+	require.False(t, diags.HasError())
+	assert.Empty(t, d.Id())
+
+	// any other error is returned and the policy stays in the state
+	d = schema.TestResourceDataRaw(t, authenticationPolicySchema, map[string]any{})
+	d.SetId(helpers.EncodeSnowflakeID(id))
+	mock.ExpectQuery(query).WillReturnError(errors.New("warehouse is suspended"))
+	diags = ReadAuthenticationPolicy(context.Background(), d, db)
+	require.True(t, diags.HasError())
+	assert.Equal(t, helpers.EncodeSnowflakeID(id), d.Id())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,25 +1,239 @@
 package resources
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-// DiffSuppressStatement will suppress diffs between statements if they differ in only case or in
-// runs of whitespace (\s+ = \s). This is needed because the snowflake api does not faithfully
-// round-trip queries, so we cannot do a simple character-wise comparison to detect changes.
-//
-// Warnings: We will have false positives in cases where a change in case or run of whitespace is
-// semantically significant.
+type StatementComparison string
+
+const (
+	// StatementComparisonSemantic compares statements after normalizing keyword case, whitespace and trailing semicolons.
+	StatementComparisonSemantic StatementComparison = "SEMANTIC"
+	// StatementComparisonIgnoreComments works like StatementComparisonSemantic and additionally ignores comments.
+	StatementComparisonIgnoreComments StatementComparison = "IGNORE_COMMENTS"
+	// StatementComparisonExact reports every character-wise difference.
+	StatementComparisonExact StatementComparison = "EXACT"
+)
+
+var allStatementComparisons = []string{
+	string(StatementComparisonSemantic),
+	string(StatementComparisonIgnoreComments),
+	string(StatementComparisonExact),
+}
+
+// statementComparisonSchema is shared by all resources that use DiffSuppressStatement. It is intentionally left without
+// a default, so that existing states do not produce a diff after upgrading the provider.
+var statementComparisonSchema = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validation.StringInSlice(allStatementComparisons, true),
+	Description: "Specifies how SQL statements of this resource are compared with the ones stored in Snowflake. " +
+		"SEMANTIC (default) ignores differences in keyword case, whitespace outside of string literals and trailing semicolons. " +
+		"IGNORE_COMMENTS additionally ignores comments. EXACT reports every difference.",
+}
+
+// DiffSuppressStatement will suppress diffs between statements if they are equal after normalization with
+// helpers.NormalizeSQL. This is needed because the snowflake api does not faithfully round-trip queries,
+// so we cannot do a simple character-wise comparison to detect changes.
 //
-// If we can find a sql parser that can handle the snowflake dialect then we should switch to parsing
-// queries and either comparing ASTs or emitting a canonical serialization for comparison. I couldn't
-// find such a library.
-func DiffSuppressStatement(_, old, new string, _ *schema.ResourceData) bool {
-	return strings.EqualFold(normalizeQuery(old), normalizeQuery(new))
+// The comparison mode can be changed per resource with the statement_comparison attribute. For functions and
+// procedures written in languages other than SQL only line endings and trailing whitespace are normalized.
+func DiffSuppressStatement(k, old, new string, d *schema.ResourceData) bool {
+	language := "SQL"
+	if d != nil {
+		if v, ok := d.GetOk("language"); ok {
+			language = v.(string)
+		}
+	}
+	return diffSuppressStatementForLanguage(language)(k, old, new, d)
+}
+
+// diffSuppressStatementForLanguage works like DiffSuppressStatement for resources that have a fixed language instead of
+// the language attribute, e.g. the language-specific function and procedure resources.
+func diffSuppressStatementForLanguage(language string) schema.SchemaDiffSuppressFunc {
+	return func(_, old, new string, d *schema.ResourceData) bool {
+		comparison := StatementComparisonSemantic
+		if d != nil {
+			if v, ok := d.GetOk("statement_comparison"); ok {
+				comparison = StatementComparison(strings.ToUpper(v.(string)))
+			}
+		}
+
+		switch {
+		case comparison == StatementComparisonExact:
+			return old == new
+		case !strings.EqualFold(language, "SQL"):
+			return helpers.NormalizeCodeBody(old) == helpers.NormalizeCodeBody(new)
+		default:
+			opts := helpers.SQLNormalizationOptions{IgnoreComments: comparison == StatementComparisonIgnoreComments}
+			return helpers.NormalizeSQL(old, opts) == helpers.NormalizeSQL(new, opts)
+		}
+	}
+}
+
+var timeTravelSchema = map[string]*schema.Schema{
+	"timestamp": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsRFC3339Time,
+		Description:  "Exact date and time to use for Time Travel, in the RFC 3339 format (e.g. `2024-01-01T12:00:00Z`).",
+	},
+	"offset": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtMost(-1),
+		Description:  "Difference in seconds from the current time to use for Time Travel, as a negative integer (e.g. -120 is 120 seconds, -1800 is 30 minutes).",
+	},
+	"statement": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Query ID of a statement to use as the reference point for Time Travel.",
+	},
+}
+
+// cloneSchema is shared by all resources that can be created as a clone of an existing object. The clone origin cannot
+// be read from Snowflake, so it is only recorded in the state. Changes of the source object do not produce a diff.
+func cloneSchema(objectType string, sourceDescription string, conflictsWith ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeList,
+		Optional:         true,
+		ForceNew:         true,
+		MaxItems:         1,
+		ConflictsWith:    conflictsWith,
+		DiffSuppressFunc: suppressCloneDiffOnExistingObject,
+		Description: fmt.Sprintf("Creates the %[1]s as a clone of an existing %[1]s, optionally at or before a specific point in time (Time Travel). "+
+			"The clone origin is only recorded in the state; changing it recreates the %[1]s. Adding the block to an already existing (e.g. imported) %[1]s has no effect.", objectType),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"source": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					DiffSuppressFunc: suppressCloneDiffOnExistingObject,
+					Description:      sourceDescription,
+				},
+				"at": {
+					Type:             schema.TypeList,
+					Optional:         true,
+					ForceNew:         true,
+					MaxItems:         1,
+					ConflictsWith:    []string{"clone.0.before"},
+					DiffSuppressFunc: suppressCloneDiffOnExistingObject,
+					Description:      "Clones the source at the specified point in time (inclusive). Exactly one of `timestamp`, `offset` or `statement` has to be set.",
+					Elem:             &schema.Resource{Schema: timeTravelSchema},
+				},
+				"before": {
+					Type:             schema.TypeList,
+					Optional:         true,
+					ForceNew:         true,
+					MaxItems:         1,
+					ConflictsWith:    []string{"clone.0.at"},
+					DiffSuppressFunc: suppressCloneDiffOnExistingObject,
+					Description:      "Clones the source immediately preceding the specified point in time. Exactly one of `timestamp`, `offset` or `statement` has to be set.",
+					Elem:             &schema.Resource{Schema: timeTravelSchema},
+				},
+			},
+		},
+	}
+}
+
+// suppressCloneDiffOnExistingObject suppresses the diff of the clone block for objects which were not created by this
+// resource as a clone (e.g. imported objects or objects created before the block was introduced).
+func suppressCloneDiffOnExistingObject(_, _, _ string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+	oldClone, _ := d.GetChange("clone")
+	return len(oldClone.([]any)) == 0
+}
+
+// cloneSettings contains the configuration of the clone block. Moment is empty if the source is cloned in its current state.
+type cloneSettings struct {
+	Source     string
+	Moment     sdk.CloneMoment
+	TimeTravel sdk.TimeTravel
+}
+
+func getCloneSettings(d *schema.ResourceData) (*cloneSettings, error) {
+	v, ok := d.GetOk("clone")
+	if !ok || len(v.([]any)) == 0 {
+		return nil, nil
+	}
+	clone := v.([]any)[0].(map[string]any)
+	settings := &cloneSettings{Source: clone["source"].(string)}
+	for moment, key := range map[sdk.CloneMoment]string{sdk.CloneMomentAt: "at", sdk.CloneMomentBefore: "before"} {
+		timeTravels := clone[key].([]any)
+		if len(timeTravels) == 0 {
+			continue
+		}
+		// an empty block is read as a nil element
+		timeTravel, _ := timeTravels[0].(map[string]any)
+		timestamp, _ := timeTravel["timestamp"].(string)
+		offset, _ := timeTravel["offset"].(int)
+		statement, _ := timeTravel["statement"].(string)
+		var setCount int
+		for _, set := range []bool{timestamp != "", offset != 0, statement != ""} {
+			if set {
+				setCount++
+			}
+		}
+		if setCount != 1 {
+			return nil, fmt.Errorf("exactly one of timestamp, offset or statement has to be set in clone.0.%s", key)
+		}
+		settings.Moment = moment
+		switch {
+		case timestamp != "":
+			t, err := time.Parse(time.RFC3339, timestamp)
+			if err != nil {
+				return nil, err
+			}
+			settings.TimeTravel.Timestamp = &t
+		case statement != "":
+			settings.TimeTravel.Statement = sdk.String(statement)
+		default:
+			settings.TimeTravel.Offset = sdk.Int(offset)
+		}
+	}
+	return settings, nil
+}
+
+// sourceParts parses the clone source, which is a name or a dot-separated qualified name with optionally quoted parts
+// (e.g. `"db.with.dots".schema`), and returns its parts. At most maxParts parts are accepted.
+func (c *cloneSettings) sourceParts(maxParts int) ([]string, error) {
+	id, err := helpers.DecodeSnowflakeParameterID(c.Source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid clone source %s: %w", c.Source, err)
+	}
+	var parts []string
+	switch id := id.(type) {
+	case sdk.AccountObjectIdentifier:
+		parts = []string{id.Name()}
+	case sdk.DatabaseObjectIdentifier:
+		parts = []string{id.DatabaseName(), id.Name()}
+	case sdk.SchemaObjectIdentifier:
+		parts = []string{id.DatabaseName(), id.SchemaName(), id.Name()}
+	}
+	if len(parts) == 0 || len(parts) > maxParts {
+		return nil, fmt.Errorf("invalid clone source %s: expected at most %d dot-separated parts", c.Source, maxParts)
+	}
+	return parts, nil
 }
 
-func normalizeQuery(str string) string {
-	return strings.TrimSpace(space.ReplaceAllString(str, " "))
+// toSdkClone returns the clone clause used in CREATE DATABASE and CREATE SCHEMA.
+func (c *cloneSettings) toSdkClone(source sdk.ObjectIdentifier) *sdk.Clone {
+	clone := &sdk.Clone{SourceObject: source}
+	switch c.Moment {
+	case sdk.CloneMomentAt:
+		clone.At = &c.TimeTravel
+	case sdk.CloneMomentBefore:
+		clone.Before = &c.TimeTravel
+	}
+	return clone
 }
@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCloneSettings(t *testing.T) {
+	cloneResourceSchema := map[string]*schema.Schema{
+		"clone": cloneSchema("database", "Name of the database to clone."),
+	}
+
+	t.Run("no clone", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, cloneResourceSchema, map[string]any{})
+
+		clone, err := getCloneSettings(d)
+		require.NoError(t, err)
+		assert.Nil(t, clone)
+	})
+
+	t.Run("current state of the source", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, cloneResourceSchema, map[string]any{
+			"clone": []any{map[string]any{"source": "PROD"}},
+		})
+
+		clone, err := getCloneSettings(d)
+		require.NoError(t, err)
+		assert.Equal(t, &cloneSettings{Source: "PROD"}, clone)
+		assert.Equal(t, &sdk.Clone{SourceObject: sdk.NewAccountObjectIdentifier("PROD")}, clone.toSdkClone(sdk.NewAccountObjectIdentifier("PROD")))
+	})
+
+	t.Run("at timestamp", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, cloneResourceSchema, map[string]any{
+			"clone": []any{map[string]any{
+				"source": "PROD",
+				"at":     []any{map[string]any{"timestamp": "2024-01-01T12:00:00Z"}},
+			}},
+		})
+
+		clone, err := getCloneSettings(d)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.CloneMomentAt, clone.Moment)
+		assert.Equal(t, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), *clone.TimeTravel.Timestamp)
+		assert.Nil(t, clone.TimeTravel.Offset)
+		assert.Nil(t, clone.TimeTravel.Statement)
+		assert.NotNil(t, clone.toSdkClone(sdk.NewAccountObjectIdentifier("PROD")).At)
+	})
+
+	t.Run("before statement", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, cloneResourceSchema, map[string]any{
+			"clone": []any{map[string]any{
+				"source": "PROD",
+				"before": []any{map[string]any{"statement": "01b2c3d4-0000-0000-0000-000000000000"}},
+			}},
+		})
+
+		clone, err := getCloneSettings(d)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.CloneMomentBefore, clone.Moment)
+		assert.Equal(t, sdk.TimeTravel{Statement: sdk.String("01b2c3d4-0000-0000-0000-000000000000")}, clone.TimeTravel)
+		assert.NotNil(t, clone.toSdkClone(sdk.NewAccountObjectIdentifier("PROD")).Before)
+	})
+
+	t.Run("at offset", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, cloneResourceSchema, map[string]any{
+			"clone": []any{map[string]any{
+				"source": "PROD",
+				"at":     []any{map[string]any{"offset": -3600}},
+			}},
+		})
+
+		clone, err := getCloneSettings(d)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.TimeTravel{Offset: sdk.Int(-3600)}, clone.TimeTravel)
+	})
+
+	t.Run("more than one point in time", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, cloneResourceSchema, map[string]any{
+			"clone": []any{map[string]any{
+				"source": "PROD",
+				"at":     []any{map[string]any{"offset": -3600, "statement": "01b2c3d4-0000-0000-0000-000000000000"}},
+			}},
+		})
+
+		_, err := getCloneSettings(d)
+		require.ErrorContains(t, err, "exactly one of timestamp, offset or statement has to be set in clone.0.at")
+	})
+
+	t.Run("empty point in time", func(t *testing.T) {
+		for _, timeTravel := range []any{nil, map[string]any{}} {
+			d := schema.TestResourceDataRaw(t, cloneResourceSchema, map[string]any{
+				"clone": []any{map[string]any{
+					"source": "PROD",
+					"before": []any{timeTravel},
+				}},
+			})
+
+			_, err := getCloneSettings(d)
+			require.ErrorContains(t, err, "exactly one of timestamp, offset or statement has to be set in clone.0.before")
+		}
+	})
+}
+
+func TestCloneSettings_SourceParts(t *testing.T) {
+	testCases := []struct {
+		source   string
+		expected []string
+	}{
+		{source: "table", expected: []string{"table"}},
+		{source: "schema.table", expected: []string{"schema", "table"}},
+		{source: "db.schema.table", expected: []string{"db", "schema", "table"}},
+		{source: `"db.with.dots"."schema"."table.v1"`, expected: []string{"db.with.dots", "schema", "table.v1"}},
+		{source: `schema."table.v1"`, expected: []string{"schema", "table.v1"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.source, func(t *testing.T) {
+			parts, err := (&cloneSettings{Source: tc.source}).sourceParts(3)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, parts)
+		})
+	}
+
+	t.Run("too many parts", func(t *testing.T) {
+		_, err := (&cloneSettings{Source: "db.schema"}).sourceParts(1)
+		require.ErrorContains(t, err, "expected at most 1 dot-separated parts")
+	})
+}
@@ -0,0 +1,47 @@
+package resources_test
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffSuppressStatement(t *testing.T) {
+	resourceData := func(t *testing.T, raw map[string]any) *schema.ResourceData {
+		t.Helper()
+		return schema.TestResourceDataRaw(t, resources.Function().Schema, raw)
+	}
+
+	t.Run("suppresses formatting differences by default", func(t *testing.T) {
+		d := resourceData(t, map[string]any{})
+		require.True(t, resources.DiffSuppressStatement("", "SELECT 1;", "select 1", d))
+	})
+
+	t.Run("does not suppress comment differences by default", func(t *testing.T) {
+		d := resourceData(t, map[string]any{})
+		require.False(t, resources.DiffSuppressStatement("", "SELECT 1 -- one", "select 1", d))
+	})
+
+	t.Run("suppresses comment differences when configured", func(t *testing.T) {
+		d := resourceData(t, map[string]any{"statement_comparison": "IGNORE_COMMENTS"})
+		require.True(t, resources.DiffSuppressStatement("", "SELECT 1 -- one", "select 1", d))
+	})
+
+	t.Run("requires exact match when configured", func(t *testing.T) {
+		d := resourceData(t, map[string]any{"statement_comparison": "EXACT"})
+		require.False(t, resources.DiffSuppressStatement("", "SELECT 1;", "select 1", d))
+		require.True(t, resources.DiffSuppressStatement("", "select 1", "select 1", d))
+	})
+
+	t.Run("keeps case of non-SQL bodies", func(t *testing.T) {
+		d := resourceData(t, map[string]any{"language": "javascript"})
+		require.False(t, resources.DiffSuppressStatement("", "return X;", "return x;", d))
+		require.True(t, resources.DiffSuppressStatement("", "return x;\r\n", "return x;", d))
+	})
+
+	t.Run("works without resource data", func(t *testing.T) {
+		require.True(t, resources.DiffSuppressStatement("", "SELECT 1;", "select 1", nil))
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"slices"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -39,21 +40,27 @@ var databaseSchema = map[string]*schema.Schema{
 		Description:   "Specify a provider and a share in this map to create a database from a share.",
 		Optional:      true,
 		ForceNew:      true,
-		ConflictsWith: []string{"from_database", "from_replica"},
+		ConflictsWith: []string{"from_database", "from_replica", "clone"},
 	},
 	"from_database": {
 		Type:          schema.TypeString,
 		Description:   "Specify a database to create a clone from.",
 		Optional:      true,
 		ForceNew:      true,
-		ConflictsWith: []string{"from_share", "from_replica"},
+		ConflictsWith: []string{"from_share", "from_replica", "clone"},
+		Deprecated:    "Use clone block instead",
+		// allows replacing from_database with the clone block without recreating the database
+		DiffSuppressFunc: func(_, old, new string, d *schema.ResourceData) bool {
+			return new == "" && d.Get("clone.0.source").(string) == old
+		},
 	},
+	"clone": cloneSchema("database", "Name of the database to clone.", "from_share", "from_database", "from_replica"),
 	"from_replica": {
 		Type:          schema.TypeString,
 		Description:   "Specify a fully-qualified path to a database to create a replica from. A fully qualified path follows the format of \"<organization_name>\".\"<account_name>\".\"<db_name>\". An example would be: \"myorg1\".\"account1\".\"db1\"",
 		Optional:      true,
 		ForceNew:      true,
-		ConflictsWith: []string{"from_share", "from_database"},
+		ConflictsWith: []string{"from_share", "from_database", "clone"},
 	},
 	"replication_configuration": {
 		Type:        schema.TypeList,
@@ -81,10 +88,10 @@ var databaseSchema = map[string]*schema.Schema{
 // Database returns a pointer to the resource representing a database.
 func Database() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateDatabase,
-		Read:   ReadDatabase,
-		Delete: DeleteDatabase,
-		Update: UpdateDatabase,
+		CreateContext: CreateDatabase,
+		ReadContext:   ReadDatabase,
+		DeleteContext: DeleteDatabase,
+		UpdateContext: UpdateDatabase,
 
 		Schema: databaseSchema,
 		Importer: &schema.ResourceImporter{
@@ -93,11 +100,10 @@ func Database() *schema.Resource {
 	}
 }
 
-// CreateDatabase implements schema.CreateFunc.
-func CreateDatabase(d *schema.ResourceData, meta interface{}) error {
+// CreateDatabase implements schema.CreateContextFunc.
+func CreateDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	name := d.Get("name").(string)
 	id := sdk.NewAccountObjectIdentifier(name)
 
@@ -112,7 +118,7 @@ func CreateDatabase(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.Databases.CreateShared(ctx, id, shareID, opts)
 		if err != nil {
-			return fmt.Errorf("error creating database %v: %w", name, err)
+			return diag.FromErr(fmt.Errorf("error creating database %v: %w", name, err))
 		}
 		d.SetId(name)
 		if v, ok := d.GetOk("replication_configuration"); ok {
@@ -132,10 +138,10 @@ func CreateDatabase(d *schema.ResourceData, meta interface{}) error {
 			}
 			err := client.Databases.AlterReplication(ctx, id, opts)
 			if err != nil {
-				return fmt.Errorf("error enabling replication for database %v: %w", name, err)
+				return diag.FromErr(fmt.Errorf("error enabling replication for database %v: %w", name, err))
 			}
 		}
-		return ReadDatabase(d, meta)
+		return ReadDatabase(ctx, d, meta)
 	}
 	// Is it a Secondary Database?
 	if primaryName, ok := d.GetOk("from_replica"); ok {
@@ -145,11 +151,11 @@ func CreateDatabase(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.Databases.CreateSecondary(ctx, id, primaryID, opts)
 		if err != nil {
-			return fmt.Errorf("error creating database %v: %w", name, err)
+			return diag.FromErr(fmt.Errorf("error creating database %v: %w", name, err))
 		}
 		d.SetId(name)
 		// todo: add failover_configuration block
-		return ReadDatabase(d, meta)
+		return ReadDatabase(ctx, d, meta)
 	}
 
 	// Otherwise it is a Standard Database
@@ -168,22 +174,33 @@ func CreateDatabase(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	clone, err := getCloneSettings(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if clone != nil {
+		parts, err := clone.sourceParts(1)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		opts.Clone = clone.toSdkClone(sdk.NewAccountObjectIdentifier(parts[0]))
+	}
+
 	if v, ok := d.GetOk("data_retention_time_in_days"); ok {
 		opts.DataRetentionTimeInDays = sdk.Int(v.(int))
 	}
 
-	err := client.Databases.Create(ctx, id, &opts)
+	err = client.Databases.Create(ctx, id, &opts)
 	if err != nil {
-		return fmt.Errorf("error creating database %v: %w", name, err)
+		return diag.FromErr(fmt.Errorf("error creating database %v: %w", name, err))
 	}
 	d.SetId(name)
-	return ReadDatabase(d, meta)
+	return ReadDatabase(ctx, d, meta)
 }
 
-func ReadDatabase(d *schema.ResourceData, meta interface{}) error {
+func ReadDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	name := d.Id()
 	id := sdk.NewAccountObjectIdentifier(name)
 
@@ -194,29 +211,28 @@ func ReadDatabase(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("name", database.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("comment", database.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("data_retention_time_in_days", database.RetentionTime); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("is_transient", database.Transient); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func UpdateDatabase(d *schema.ResourceData, meta interface{}) error {
+func UpdateDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Id()
 	id := sdk.NewAccountObjectIdentifier(name)
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	if d.HasChange("name") {
 		newName := d.Get("name").(string)
@@ -225,7 +241,7 @@ func UpdateDatabase(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.Databases.Alter(ctx, id, opts)
 		if err != nil {
-			return fmt.Errorf("error updating database name on %v err = %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating database name on %v err = %w", d.Id(), err))
 		}
 		d.SetId(newName)
 		id = sdk.NewAccountObjectIdentifier(newName)
@@ -243,7 +259,7 @@ func UpdateDatabase(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.Databases.Alter(ctx, id, opts)
 		if err != nil {
-			return fmt.Errorf("error updating database comment on %v err = %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating database comment on %v err = %w", d.Id(), err))
 		}
 	}
 
@@ -256,7 +272,7 @@ func UpdateDatabase(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.Databases.Alter(ctx, id, opts)
 		if err != nil {
-			return fmt.Errorf("error updating database data retention time on %v err = %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating database data retention time on %v err = %w", d.Id(), err))
 		}
 	}
 
@@ -308,7 +324,7 @@ func UpdateDatabase(d *schema.ResourceData, meta interface{}) error {
 			}
 			err := client.Databases.AlterReplication(ctx, id, opts)
 			if err != nil {
-				return fmt.Errorf("error enabling replication configuration on %v err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error enabling replication configuration on %v err = %w", d.Id(), err))
 			}
 		}
 
@@ -320,25 +336,24 @@ func UpdateDatabase(d *schema.ResourceData, meta interface{}) error {
 			}
 			err := client.Databases.AlterReplication(ctx, id, opts)
 			if err != nil {
-				return fmt.Errorf("error disabling replication configuration on %v err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error disabling replication configuration on %v err = %w", d.Id(), err))
 			}
 		}
 	}
 
-	return ReadDatabase(d, meta)
+	return ReadDatabase(ctx, d, meta)
 }
 
-func DeleteDatabase(d *schema.ResourceData, meta interface{}) error {
+func DeleteDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	name := d.Id()
 	id := sdk.NewAccountObjectIdentifier(name)
 	err := client.Databases.Drop(ctx, id, &sdk.DropDatabaseOptions{
 		IfExists: sdk.Bool(true),
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
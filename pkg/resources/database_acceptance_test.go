@@ -150,6 +150,69 @@ func TestAcc_DatabaseRemovedOutsideOfTerraform(t *testing.T) {
 	})
 }
 
+func TestAcc_Database_clone(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_DATABASE_TESTS"); ok {
+		t.Skip("Skipping TestAcc_Database_clone")
+	}
+
+	sourceName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	cloneName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: dbCloneConfig(sourceName, cloneName, "source"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_database.clone", "name", cloneName),
+					resource.TestCheckResourceAttr("snowflake_database.clone", "clone.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_database.clone", "clone.0.source", sourceName),
+					resource.TestCheckResourceAttr("snowflake_database.clone", "clone.0.at.0.offset", "0"),
+					testAccCheckDatabaseExistence(t, cloneName, true),
+				),
+			},
+			// changes of the source do not affect the clone
+			{
+				Config: dbCloneConfig(sourceName, cloneName, "source updated"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_database.source", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("snowflake_database.clone", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_database.clone", "clone.0.source", sourceName),
+				),
+			},
+		},
+	})
+}
+
+func dbCloneConfig(sourceName string, cloneName string, sourceComment string) string {
+	s := `
+resource "snowflake_database" "source" {
+	name    = "%[1]s"
+	comment = "%[3]s"
+}
+
+resource "snowflake_database" "clone" {
+	name = "%[2]s"
+	clone {
+		source = snowflake_database.source.name
+		at {
+			offset = 0
+		}
+	}
+}
+`
+	return fmt.Sprintf(s, sourceName, cloneName, sourceComment)
+}
+
 func dbConfig(prefix string) string {
 	s := `
 resource "snowflake_database" "db" {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -81,10 +82,10 @@ var databaseGrantSchema = map[string]*schema.Schema{
 func DatabaseGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateDatabaseGrant,
-			Read:   ReadDatabaseGrant,
-			Delete: DeleteDatabaseGrant,
-			Update: UpdateDatabaseGrant,
+			CreateContext: CreateDatabaseGrant,
+			ReadContext:   ReadDatabaseGrant,
+			DeleteContext: DeleteDatabaseGrant,
+			UpdateContext: UpdateDatabaseGrant,
 
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             databaseGrantSchema,
@@ -117,12 +118,12 @@ func DatabaseGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateDatabaseGrant implements schema.CreateFunc.
-func CreateDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateDatabaseGrant implements schema.CreateContextFunc.
+func CreateDatabaseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	builder := snowflake.DatabaseGrant(databaseName)
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return fmt.Errorf("error creating database grant err = %w", err)
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating database grant err = %w", err))
 	}
 
 	privilege := d.Get("privilege").(string)
@@ -132,11 +133,11 @@ func CreateDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
 	grantID := helpers.EncodeSnowflakeID(databaseName, privilege, withGrantOption, roles, shares)
 	d.SetId(grantID)
 
-	return ReadDatabaseGrant(d, meta)
+	return ReadDatabaseGrant(ctx, d, meta)
 }
 
-// ReadDatabaseGrant implements schema.ReadFunc.
-func ReadDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadDatabaseGrant implements schema.ReadContextFunc.
+func ReadDatabaseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	privilege := d.Get("privilege").(string)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
@@ -149,21 +150,21 @@ func ReadDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
 	if privilege == "IMPORTED PRIVILEGES" {
 		err := d.Set("privilege", "USAGE")
 		if err != nil {
-			return fmt.Errorf("error setting privilege to USAGE: %w", err)
+			return diag.FromErr(fmt.Errorf("error setting privilege to USAGE: %w", err))
 		}
 	}
 
 	builder := snowflake.DatabaseGrant(databaseName)
-	err := readGenericGrant(d, meta, databaseGrantSchema, builder, false, false, validDatabasePrivileges)
+	err := readGenericGrant(ctx, d, meta, databaseGrantSchema, builder, false, false, validDatabasePrivileges)
 	if err != nil {
-		return fmt.Errorf("error reading database grant: %w", err)
+		return diag.FromErr(fmt.Errorf("error reading database grant: %w", err))
 	}
 
 	// Then set it back to imported privledges for Terraform to execute the grant.
 	if privilege == "IMPORTED PRIVILEGES" {
 		err := d.Set("privilege", "IMPORTED PRIVILEGES")
 		if err != nil {
-			return fmt.Errorf("error setting privilege to IMPORTED PRIVILEGES: %w", err)
+			return diag.FromErr(fmt.Errorf("error setting privilege to IMPORTED PRIVILEGES: %w", err))
 		}
 	}
 
@@ -174,16 +175,16 @@ func ReadDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteDatabaseGrant implements schema.DeleteFunc.
-func DeleteDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteDatabaseGrant implements schema.DeleteContextFunc.
+func DeleteDatabaseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	builder := snowflake.DatabaseGrant(databaseName)
 
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateDatabaseGrant implements schema.UpdateFunc.
-func UpdateDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateDatabaseGrant implements schema.UpdateContextFunc.
+func UpdateDatabaseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles", "shares") {
@@ -210,6 +211,7 @@ func UpdateDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
+		ctx,
 		meta,
 		builder,
 		privilege,
@@ -217,11 +219,12 @@ func UpdateDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
 		rolesToRevoke,
 		sharesToRevoke,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// then add
 	if err := createGenericGrantRolesAndShares(
+		ctx,
 		meta,
 		builder,
 		privilege,
@@ -229,9 +232,9 @@ func UpdateDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
 		rolesToAdd,
 		sharesToAdd,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadDatabaseGrant(d, meta)
+	return ReadDatabaseGrant(ctx, d, meta)
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -34,10 +35,10 @@ var databaseRoleSchema = map[string]*schema.Schema{
 // DatabaseRole returns a pointer to the resource representing a database role.
 func DatabaseRole() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateDatabaseRole,
-		Read:   ReadDatabaseRole,
-		Update: UpdateDatabaseRole,
-		Delete: DeleteDatabaseRole,
+		CreateContext: CreateDatabaseRole,
+		ReadContext:   ReadDatabaseRole,
+		UpdateContext: UpdateDatabaseRole,
+		DeleteContext: DeleteDatabaseRole,
 
 		Schema: databaseRoleSchema,
 		Importer: &schema.ResourceImporter{
@@ -46,14 +47,13 @@ func DatabaseRole() *schema.Resource {
 	}
 }
 
-// ReadDatabaseRole implements schema.ReadFunc.
-func ReadDatabaseRole(d *schema.ResourceData, meta interface{}) error {
+// ReadDatabaseRole implements schema.ReadContextFunc.
+func ReadDatabaseRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 
 	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.DatabaseObjectIdentifier)
 
-	ctx := context.Background()
 	databaseRole, err := client.DatabaseRoles.ShowByID(ctx, objectIdentifier)
 	if err != nil {
 		// If not found, mark resource to be removed from state file during apply or refresh
@@ -63,21 +63,21 @@ func ReadDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("name", databaseRole.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("database", objectIdentifier.DatabaseName()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("comment", databaseRole.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-// CreateDatabaseRole implements schema.CreateFunc.
-func CreateDatabaseRole(d *schema.ResourceData, meta interface{}) error {
+// CreateDatabaseRole implements schema.CreateContextFunc.
+func CreateDatabaseRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 
@@ -91,19 +91,18 @@ func CreateDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 		createRequest.WithComment(sdk.String(v.(string)))
 	}
 
-	ctx := context.Background()
 	err := client.DatabaseRoles.Create(ctx, createRequest)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
 
-	return ReadDatabaseRole(d, meta)
+	return ReadDatabaseRole(ctx, d, meta)
 }
 
-// UpdateDatabaseRole implements schema.UpdateFunc.
-func UpdateDatabaseRole(d *schema.ResourceData, meta interface{}) error {
+// UpdateDatabaseRole implements schema.UpdateContextFunc.
+func UpdateDatabaseRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 
@@ -112,29 +111,27 @@ func UpdateDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("comment") {
 		_, newVal := d.GetChange("comment")
 
-		ctx := context.Background()
 		alterRequest := sdk.NewAlterDatabaseRoleRequest(objectIdentifier).WithSetComment(newVal.(string))
 		err := client.DatabaseRoles.Alter(ctx, alterRequest)
 		if err != nil {
-			return fmt.Errorf("error updating database role %v: %w", objectIdentifier.Name(), err)
+			return diag.FromErr(fmt.Errorf("error updating database role %v: %w", objectIdentifier.Name(), err))
 		}
 	}
 
-	return ReadDatabaseRole(d, meta)
+	return ReadDatabaseRole(ctx, d, meta)
 }
 
-// DeleteDatabaseRole implements schema.DeleteFunc.
-func DeleteDatabaseRole(d *schema.ResourceData, meta interface{}) error {
+// DeleteDatabaseRole implements schema.DeleteContextFunc.
+func DeleteDatabaseRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 
 	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.DatabaseObjectIdentifier)
 
-	ctx := context.Background()
 	dropRequest := sdk.NewDropDatabaseRoleRequest(objectIdentifier)
 	err := client.DatabaseRoles.Drop(ctx, dropRequest)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -3,20 +3,20 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log"
-	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var refreshModePattern = regexp.MustCompile(`refresh_mode = '(\w+)'`)
-
 var dynamicTableSchema = map[string]*schema.Schema{
 	"or_replace": {
 		Type:        schema.TypeBool,
@@ -34,11 +34,13 @@ var dynamicTableSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Required:    true,
 		Description: "The database in which to create the dynamic table.",
+		ForceNew:    true,
 	},
 	"schema": {
 		Type:        schema.TypeString,
 		Required:    true,
 		Description: "The schema in which to create the dynamic table.",
+		ForceNew:    true,
 	},
 	"target_lag": {
 		Type:        schema.TypeList,
@@ -79,6 +81,43 @@ var dynamicTableSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies a comment for the dynamic table.",
 	},
+	"cluster_by": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of one or more table columns/expressions to be used as clustering key(s) for the dynamic table.",
+	},
+	"data_retention_time_in_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      -1,
+		ValidateFunc: validation.IntBetween(-1, 90),
+		Description:  "Specifies the retention period for the dynamic table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the dynamic table. Default value (-1) means the value is inherited from the schema.",
+	},
+	"max_data_extension_time_in_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      -1,
+		ValidateFunc: validation.IntBetween(-1, 90),
+		Description:  "Maximum number of days for which Snowflake can extend the data retention period for the dynamic table to prevent streams on the dynamic table from becoming stale. Default value (-1) means the value is inherited from the schema.",
+	},
+	"suspended": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether the dynamic table is suspended. Suspended dynamic tables are not refreshed on schedule.",
+	},
+	"refresh_on_apply": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "If true, the dynamic table is refreshed manually on every apply (unless it is suspended). Important note: this flag is not compliant with the Terraform assumptions of the config being eventually convergent (producing an empty plan).",
+	},
+	"last_refresh_on_apply": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Time of the last manual refresh triggered by refresh_on_apply.",
+	},
 	"refresh_mode": {
 		Type:         schema.TypeString,
 		Optional:     true,
@@ -86,6 +125,9 @@ var dynamicTableSchema = map[string]*schema.Schema{
 		Description:  "INCREMENTAL to use incremental refreshes, FULL to recompute the whole table on every refresh, or AUTO to let Snowflake decide.",
 		ValidateFunc: validation.StringInSlice(sdk.AsStringList(sdk.AllDynamicRefreshModes), true),
 		ForceNew:     true,
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
 	},
 	"initialize": {
 		Type:         schema.TypeString,
@@ -94,17 +136,15 @@ var dynamicTableSchema = map[string]*schema.Schema{
 		Description:  "Initialize trigger for the dynamic table. Can only be set on creation. Available options are ON_CREATE and ON_SCHEDULE.",
 		ValidateFunc: validation.StringInSlice(sdk.AsStringList(sdk.AllDynamicTableInitializes), true),
 		ForceNew:     true,
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
 	},
 	"created_on": {
 		Type:        schema.TypeString,
 		Description: "Time when this dynamic table was created.",
 		Computed:    true,
 	},
-	"cluster_by": {
-		Type:        schema.TypeString,
-		Description: "The clustering key for the dynamic table.",
-		Computed:    true,
-	},
 	"rows": {
 		Type:        schema.TypeInt,
 		Description: "Number of rows in the table.",
@@ -155,109 +195,151 @@ var dynamicTableSchema = map[string]*schema.Schema{
 		Description: "Timestamp of the data in the base object(s) that is included in the dynamic table.",
 		Computed:    true,
 	},
+	"statement_comparison": statementComparisonSchema,
 }
 
 // DynamicTable returns a pointer to the resource representing a dynamic table.
 func DynamicTable() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateDynamicTable,
-		Read:   ReadDynamicTable,
-		Update: UpdateDynamicTable,
-		Delete: DeleteDynamicTable,
+		SchemaVersion: 1,
+
+		CreateContext: CreateDynamicTable,
+		ReadContext:   ReadDynamicTable,
+		UpdateContext: UpdateDynamicTable,
+		DeleteContext: DeleteDynamicTable,
 
 		Schema: dynamicTableSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportDynamicTable,
 		},
+
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+			if diff.Id() != "" && diff.Get("refresh_on_apply").(bool) {
+				return diff.SetNewComputed("last_refresh_on_apply")
+			}
+			return nil
+		},
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				// setting type to cty.EmptyObject is a bit hacky here but following https://developer.hashicorp.com/terraform/plugin/framework/migrating/resources/state-upgrade#sdkv2-1 would require lots of repetitive code; this should work with cty.EmptyObject
+				Type:    cty.EmptyObject,
+				Upgrade: v086DynamicTableStateUpgrader,
+			},
+		},
+	}
+}
+
+// ImportDynamicTable sets refresh_on_apply, which cannot be read from Snowflake, to its default value.
+func ImportDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if err := d.Set("refresh_on_apply", false); err != nil {
+		return nil, err
 	}
+	return []*schema.ResourceData{d}, nil
 }
 
-// ReadDynamicTable implements schema.ReadFunc.
-func ReadDynamicTable(d *schema.ResourceData, meta interface{}) error {
+// ReadDynamicTable implements schema.ReadContextFunc.
+func ReadDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
-	dynamicTable, err := client.DynamicTables.ShowByID(context.Background(), id)
+	dynamicTable, err := client.DynamicTables.ShowByID(ctx, id)
 	if err != nil {
 		log.Printf("[DEBUG] dynamic table (%s) not found", d.Id())
 		d.SetId("")
 		return nil
 	}
 	if err := d.Set("name", dynamicTable.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("database", dynamicTable.DatabaseName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("schema", dynamicTable.SchemaName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("warehouse", dynamicTable.Warehouse); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("comment", dynamicTable.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	tl := map[string]interface{}{}
+	tl := map[string]any{}
 	if dynamicTable.TargetLag == "DOWNSTREAM" {
 		tl["downstream"] = true
-		if err := d.Set("target_lag", []interface{}{tl}); err != nil {
-			return err
-		}
 	} else {
 		tl["maximum_duration"] = dynamicTable.TargetLag
-		if err := d.Set("target_lag", []interface{}{tl}); err != nil {
-			return err
-		}
 	}
-	if strings.Contains(dynamicTable.Text, "OR REPLACE") {
-		if err := d.Set("or_replace", true); err != nil {
-			return err
-		}
-	} else {
-		if err := d.Set("or_replace", false); err != nil {
-			return err
-		}
+	if err := d.Set("target_lag", []any{tl}); err != nil {
+		return diag.FromErr(err)
 	}
-	if strings.Contains(dynamicTable.Text, "initialize = 'ON_CREATE'") {
-		if err := d.Set("initialize", "ON_CREATE"); err != nil {
-			return err
-		}
-	} else if strings.Contains(dynamicTable.Text, "initialize = 'ON_SCHEDULE'") {
-		if err := d.Set("initialize", "ON_SCHEDULE"); err != nil {
-			return err
-		}
+
+	// The text column contains the create statement exactly as it was sent to Snowflake, so refresh_mode and initialize
+	// are read from it (the refresh_mode column contains the mode chosen by Snowflake for AUTO).
+	createStatement, err := helpers.ParseCreateStatement(dynamicTable.Text)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to parse the definition of dynamic table %s: %w", id.FullyQualifiedName(), err))
 	}
-	m := refreshModePattern.FindStringSubmatch(dynamicTable.Text)
-	if len(m) > 1 {
-		if err := d.Set("refresh_mode", m[1]); err != nil {
-			return err
-		}
+	if err := d.Set("or_replace", strings.HasPrefix(helpers.NormalizeSQL(dynamicTable.Text, helpers.SQLNormalizationOptions{IgnoreComments: true}), "CREATE OR REPLACE ")); err != nil {
+		return diag.FromErr(err)
 	}
-	if err := d.Set("created_on", dynamicTable.CreatedOn.Format(time.RFC3339)); err != nil {
-		return err
+	refreshMode := string(sdk.DynamicTableRefreshModeAuto)
+	if v, ok := createStatement.Parameters["REFRESH_MODE"]; ok {
+		refreshMode = strings.ToUpper(v)
 	}
-	if err := d.Set("cluster_by", dynamicTable.ClusterBy); err != nil {
-		return err
+	if err := d.Set("refresh_mode", refreshMode); err != nil {
+		return diag.FromErr(err)
+	}
+	initialize := string(sdk.DynamicTableInitializeOnCreate)
+	if v, ok := createStatement.Parameters["INITIALIZE"]; ok {
+		initialize = strings.ToUpper(v)
+	}
+	if err := d.Set("initialize", initialize); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("query", createStatement.Query); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("cluster_by", dynamicTable.GetClusterByKeys()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("suspended", dynamicTable.SchedulingState == sdk.DynamicTableSchedulingStateSuspended); err != nil {
+		return diag.FromErr(err)
+	}
+
+	parameters, err := client.Parameters.ShowParametersForObject(ctx, sdk.Object{ObjectType: sdk.ObjectTypeTable, Name: id})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setIntParameterSetOnObject(d, parameters, sdk.ObjectTypeTable, "data_retention_time_in_days", "DATA_RETENTION_TIME_IN_DAYS"); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setIntParameterSetOnObject(d, parameters, sdk.ObjectTypeTable, "max_data_extension_time_in_days", "MAX_DATA_EXTENSION_TIME_IN_DAYS"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("created_on", dynamicTable.CreatedOn.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
 	}
 	if err := d.Set("rows", dynamicTable.Rows); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("bytes", dynamicTable.Bytes); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("owner", dynamicTable.Owner); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("refresh_mode_reason", dynamicTable.RefreshModeReason); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("automatic_clustering", dynamicTable.AutomaticClustering); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("scheduling_state", string(dynamicTable.SchedulingState)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	/*
 		guides on time formatting
@@ -266,30 +348,38 @@ func ReadDynamicTable(d *schema.ResourceData, meta interface{}) error {
 		note: format may depend on what the account parameter for TIMESTAMP_OUTPUT_FORMAT is set to. Perhaps we should return this as a string rather than a time.Time?
 	*/
 	if err := d.Set("last_suspended_on", dynamicTable.LastSuspendedOn.Format("2006-01-02T16:04:05.000 -0700")); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("is_clone", dynamicTable.IsClone); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("is_replica", dynamicTable.IsReplica); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("data_timestamp", dynamicTable.DataTimestamp.Format("2006-01-02T16:04:05.000 -0700")); err != nil {
-		return err
-	}
-
-	extractor := snowflake.NewViewSelectStatementExtractor(dynamicTable.Text)
-	query, err := extractor.ExtractDynamicTable()
-	if err != nil {
-		return err
-	}
-	if err := d.Set("query", query); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
+// setIntParameterSetOnObject sets the given key to the parameter value if the parameter is set directly on the object
+// and to -1 if it is inherited.
+func setIntParameterSetOnObject(d *schema.ResourceData, parameters []*sdk.Parameter, objectType sdk.ObjectType, key string, parameterKey string) error {
+	value := -1
+	for _, parameter := range parameters {
+		if parameter.Key != parameterKey || string(parameter.Level) != string(objectType) {
+			continue
+		}
+		v, err := strconv.Atoi(parameter.Value)
+		if err != nil {
+			return fmt.Errorf("unable to parse parameter %s value %s: %w", parameterKey, parameter.Value, err)
+		}
+		value = v
+	}
+	return d.Set(key, value)
+}
+
 func parseTargetLag(v interface{}) sdk.TargetLag {
 	var result sdk.TargetLag
 	tl := v.([]interface{})[0].(map[string]interface{})
@@ -303,8 +393,8 @@ func parseTargetLag(v interface{}) sdk.TargetLag {
 	return result
 }
 
-// CreateDynamicTable implements schema.CreateFunc.
-func CreateDynamicTable(d *schema.ResourceData, meta interface{}) error {
+// CreateDynamicTable implements schema.CreateContextFunc.
+func CreateDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 
@@ -325,29 +415,57 @@ func CreateDynamicTable(d *schema.ResourceData, meta interface{}) error {
 		request.WithOrReplace(true)
 	}
 	if v, ok := d.GetOk("refresh_mode"); ok {
-		request.WithRefreshMode(sdk.DynamicTableRefreshMode(v.(string)))
+		request.WithRefreshMode(sdk.DynamicTableRefreshMode(strings.ToUpper(v.(string))))
 	}
 	if v, ok := d.GetOk("initialize"); ok {
-		request.WithInitialize(sdk.DynamicTableInitialize(v.(string)))
+		request.WithInitialize(sdk.DynamicTableInitialize(strings.ToUpper(v.(string))))
 	}
-	if err := client.DynamicTables.Create(context.Background(), request); err != nil {
-		return err
+	if v, ok := d.GetOk("cluster_by"); ok {
+		request.WithClusterBy(expandStringList(v.([]any)))
+	}
+	if v := d.Get("data_retention_time_in_days").(int); v != -1 {
+		request.WithDataRetentionTimeInDays(sdk.Int(v))
+	}
+	if v := d.Get("max_data_extension_time_in_days").(int); v != -1 {
+		request.WithMaxDataExtensionTimeInDays(sdk.Int(v))
+	}
+	if err := client.DynamicTables.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
 	}
 	d.SetId(helpers.EncodeSnowflakeID(id))
 
-	return ReadDynamicTable(d, meta)
+	suspended := d.Get("suspended").(bool)
+	if suspended {
+		if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithSuspend(sdk.Bool(true))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// with initialize = ON_CREATE the dynamic table was already refreshed synchronously during creation
+	if d.Get("refresh_on_apply").(bool) && !suspended && strings.EqualFold(d.Get("initialize").(string), string(sdk.DynamicTableInitializeOnSchedule)) {
+		if err := refreshDynamicTableOnApply(ctx, client, d, id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadDynamicTable(ctx, d, meta)
+}
+
+func refreshDynamicTableOnApply(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.SchemaObjectIdentifier) error {
+	if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithRefresh(sdk.Bool(true))); err != nil {
+		return err
+	}
+	return d.Set("last_refresh_on_apply", time.Now().UTC().Format(time.RFC3339))
 }
 
-// UpdateDynamicTable implements schema.UpdateFunc.
-func UpdateDynamicTable(d *schema.ResourceData, meta interface{}) error {
+// UpdateDynamicTable implements schema.UpdateContextFunc.
+func UpdateDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
-	request := sdk.NewAlterDynamicTableRequest(id)
 
-	runSet := false
-	set := sdk.NewDynamicTableSetRequest()
+	runSet, runUnset := false, false
+	set, unset := sdk.NewDynamicTableSetRequest(), sdk.NewDynamicTableUnsetRequest()
 	if d.HasChange("target_lag") {
 		tl := parseTargetLag(d.Get("target_lag"))
 		set.WithTargetLag(tl)
@@ -360,34 +478,96 @@ func UpdateDynamicTable(d *schema.ResourceData, meta interface{}) error {
 		runSet = true
 	}
 
+	if d.HasChange("data_retention_time_in_days") {
+		if v := d.Get("data_retention_time_in_days").(int); v != -1 {
+			set.WithDataRetentionTimeInDays(sdk.Int(v))
+			runSet = true
+		} else {
+			unset.WithDataRetentionTimeInDays(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
+	if d.HasChange("max_data_extension_time_in_days") {
+		if v := d.Get("max_data_extension_time_in_days").(int); v != -1 {
+			set.WithMaxDataExtensionTimeInDays(sdk.Int(v))
+			runSet = true
+		} else {
+			unset.WithMaxDataExtensionTimeInDays(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
+	if d.HasChange("comment") {
+		if v := d.Get("comment").(string); v != "" {
+			set.WithComment(sdk.String(v))
+			runSet = true
+		} else {
+			unset.WithComment(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
 	if runSet {
-		request.WithSet(set)
+		if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithSet(set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if runUnset {
+		if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithUnset(unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("cluster_by") {
+		request := sdk.NewAlterDynamicTableRequest(id)
+		if clusterBy := expandStringList(d.Get("cluster_by").([]any)); len(clusterBy) > 0 {
+			request.WithClusterBy(clusterBy)
+		} else {
+			request.WithDropClusteringKey(sdk.Bool(true))
+		}
 		if err := client.DynamicTables.Alter(ctx, request); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	if d.HasChange("comment") {
-		err := client.Comments.Set(ctx, &sdk.SetCommentOptions{
-			ObjectType: sdk.ObjectTypeDynamicTable,
-			ObjectName: id,
-			Value:      sdk.String(d.Get("comment").(string)),
-		})
-		if err != nil {
-			return err
+	suspended := d.Get("suspended").(bool)
+	if d.HasChange("suspended") {
+		request := sdk.NewAlterDynamicTableRequest(id)
+		if suspended {
+			request.WithSuspend(sdk.Bool(true))
+		} else {
+			request.WithResume(sdk.Bool(true))
+		}
+		if err := client.DynamicTables.Alter(ctx, request); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("refresh_on_apply").(bool) {
+		if suspended {
+			// last_refresh_on_apply is planned as unknown, so the previous value has to be set explicitly
+			oldValue, _ := d.GetChange("last_refresh_on_apply")
+			if err := d.Set("last_refresh_on_apply", oldValue); err != nil {
+				return diag.FromErr(err)
+			}
+		} else if err := refreshDynamicTableOnApply(ctx, client, d, id); err != nil {
+			return diag.FromErr(err)
 		}
 	}
-	return ReadDynamicTable(d, meta)
+
+	return ReadDynamicTable(ctx, d, meta)
 }
 
-// DeleteDynamicTable implements schema.DeleteFunc.
-func DeleteDynamicTable(d *schema.ResourceData, meta interface{}) error {
+// DeleteDynamicTable implements schema.DeleteContextFunc.
+func DeleteDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
-	if err := client.DynamicTables.Drop(context.Background(), sdk.NewDropDynamicTableRequest(id)); err != nil {
-		return err
+	if err := client.DynamicTables.Drop(ctx, sdk.NewDropDynamicTableRequest(id)); err != nil {
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 
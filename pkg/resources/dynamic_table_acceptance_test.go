@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
@@ -426,6 +427,177 @@ func TestAcc_DynamicTable_issue2329_with_matching_comment(t *testing.T) {
 	})
 }
 
+func TestAcc_DynamicTable_complete(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_dynamic_table.dt"
+	tableName := name + "_table"
+	m := func() map[string]config.Variable {
+		return map[string]config.Variable{
+			"name":       config.StringVariable(name),
+			"database":   config.StringVariable(acc.TestDatabaseName),
+			"schema":     config.StringVariable(acc.TestSchemaName),
+			"warehouse":  config.StringVariable(acc.TestWarehouseName),
+			"query":      config.StringVariable(fmt.Sprintf(`select "id", "data" from "%v"."%v"."%v"`, acc.TestDatabaseName, acc.TestSchemaName, tableName)),
+			"table_name": config.StringVariable(tableName),
+		}
+	}
+
+	// used to check whether a dynamic table was replaced
+	var createdOn string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckDynamicTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.TestStepDirectory(),
+				ConfigVariables: m(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "target_lag.0.downstream", "true"),
+					resource.TestCheckResourceAttr(resourceName, "initialize", string(sdk.DynamicTableInitializeOnSchedule)),
+					resource.TestCheckResourceAttr(resourceName, "cluster_by.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_by.0", `"id"`),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_time_in_days", "2"),
+					resource.TestCheckResourceAttr(resourceName, "max_data_extension_time_in_days", "4"),
+					resource.TestCheckResourceAttr(resourceName, "suspended", "false"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling_state", string(sdk.DynamicTableSchedulingStateRunning)),
+					resource.TestCheckResourceAttr(resourceName, "refresh_on_apply", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_refresh_on_apply"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Terraform acceptance test"),
+
+					resource.TestCheckResourceAttrWith(resourceName, "created_on", func(value string) error {
+						createdOn = value
+						return nil
+					}),
+				),
+			},
+			// refresh_on_apply always produces a plan
+			{
+				ConfigDirectory: acc.ConfigurationSameAsStepN(1),
+				ConfigVariables: m(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("last_refresh_on_apply")),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "last_refresh_on_apply"),
+				),
+			},
+			// unset everything and suspend in place
+			{
+				ConfigDirectory: config.TestStepDirectory(),
+				ConfigVariables: m(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cluster_by.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_time_in_days", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "max_data_extension_time_in_days", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "suspended", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling_state", string(sdk.DynamicTableSchedulingStateSuspended)),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+
+					resource.TestCheckResourceAttrWith(resourceName, "created_on", func(value string) error {
+						if value != createdOn {
+							return fmt.Errorf("created_on changed from %v to %v", createdOn, value)
+						}
+						return nil
+					}),
+				),
+			},
+			// test import
+			{
+				ConfigDirectory:         acc.ConfigurationSameAsStepN(3),
+				ConfigVariables:         m(),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_refresh_on_apply"},
+			},
+		},
+	})
+}
+
+func TestAcc_DynamicTable_migrateFromVersion086(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_dynamic_table.dt"
+	tableName := name + "_table"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckDynamicTableDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"snowflake": {
+						VersionConstraint: "=0.86.0",
+						Source:            "Snowflake-Labs/snowflake",
+					},
+				},
+				Config: dynamicTableConfig(name, tableName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "cluster_by", ""),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+				Config:                   dynamicTableConfig(name, tableName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "cluster_by.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "suspended", "false"),
+				),
+			},
+		},
+	})
+}
+
+func dynamicTableConfig(name string, tableName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "t" {
+  database        = "%[1]s"
+  schema          = "%[2]s"
+  name            = "%[4]s"
+  change_tracking = true
+  column {
+    name = "id"
+    type = "NUMBER(38,0)"
+  }
+}
+
+resource "snowflake_dynamic_table" "dt" {
+  depends_on = [snowflake_table.t]
+  database   = "%[1]s"
+  schema     = "%[2]s"
+  name       = "%[3]s"
+  target_lag {
+    maximum_duration = "2 minutes"
+  }
+  warehouse = "%[5]s"
+  query     = "select \"id\" from \"%[1]s\".\"%[2]s\".\"%[4]s\""
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, tableName, acc.TestWarehouseName)
+}
+
 func testAccCheckDynamicTableDestroy(s *terraform.State) error {
 	db := acc.TestAccProvider.Meta().(*sql.DB)
 	client := sdk.NewClientFromDB(db)
@@ -0,0 +1,16 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDynamicTableSchema_CaseInsensitiveForceNewFields(t *testing.T) {
+	// the server returns the values in upper case, so lower case values in the configuration must not recreate the table
+	for _, key := range []string{"refresh_mode", "initialize"} {
+		suppress := dynamicTableSchema[key].DiffSuppressFunc
+		assert.True(t, suppress(key, "INCREMENTAL", "incremental", nil), key)
+		assert.False(t, suppress(key, "INCREMENTAL", "FULL", nil), key)
+	}
+}
@@ -0,0 +1,27 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// v086DynamicTableStateUpgrader converts cluster_by from the computed string returned by SHOW DYNAMIC TABLES
+// (e.g. "LINEAR(ID, NAME)") to the list of clustering keys. refresh_on_apply cannot be read from Snowflake, so it is set
+// to its default value to avoid a diff right after the upgrade.
+func v086DynamicTableStateUpgrader(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	clusterBy, _ := rawState["cluster_by"].(string)
+	keys := (&sdk.DynamicTable{ClusterBy: clusterBy}).GetClusterByKeys()
+	clusterByKeys := make([]interface{}, len(keys))
+	for i, key := range keys {
+		clusterByKeys[i] = key
+	}
+	rawState["cluster_by"] = clusterByKeys
+	rawState["refresh_on_apply"] = false
+
+	return rawState, nil
+}
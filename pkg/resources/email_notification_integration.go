@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -39,10 +40,10 @@ var emailNotificationIntegrationSchema = map[string]*schema.Schema{
 // EmailNotificationIntegration returns a pointer to the resource representing a notification integration.
 func EmailNotificationIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateEmailNotificationIntegration,
-		Read:   ReadEmailNotificationIntegration,
-		Update: UpdateEmailNotificationIntegration,
-		Delete: DeleteEmailNotificationIntegration,
+		CreateContext: CreateEmailNotificationIntegration,
+		ReadContext:   ReadEmailNotificationIntegration,
+		UpdateContext: UpdateEmailNotificationIntegration,
+		DeleteContext: DeleteEmailNotificationIntegration,
 
 		Schema: emailNotificationIntegrationSchema,
 		Importer: &schema.ResourceImporter{
@@ -59,10 +60,9 @@ func toAllowedRecipients(emails []string) []sdk.NotificationIntegrationAllowedRe
 	return allowedRecipients
 }
 
-// CreateEmailNotificationIntegration implements schema.CreateFunc.
-func CreateEmailNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
+// CreateEmailNotificationIntegration implements schema.CreateContextFunc.
+func CreateEmailNotificationIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	name := d.Get("name").(string)
@@ -83,18 +83,17 @@ func CreateEmailNotificationIntegration(d *schema.ResourceData, meta interface{}
 
 	err := client.NotificationIntegrations.Create(ctx, createRequest)
 	if err != nil {
-		return fmt.Errorf("error creating notification integration: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating notification integration: %w", err))
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(id))
 
-	return ReadEmailNotificationIntegration(d, meta)
+	return ReadEmailNotificationIntegration(ctx, d, meta)
 }
 
-// ReadEmailNotificationIntegration implements schema.ReadFunc.
-func ReadEmailNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
+// ReadEmailNotificationIntegration implements schema.ReadContextFunc.
+func ReadEmailNotificationIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
 
@@ -102,25 +101,25 @@ func ReadEmailNotificationIntegration(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		log.Printf("[DEBUG] notification integration (%s) not found", d.Id())
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("name", integration.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("enabled", integration.Enabled); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("comment", integration.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Some properties come from the DESCRIBE INTEGRATION call
 	integrationProperties, err := client.NotificationIntegrations.Describe(ctx, id)
 	if err != nil {
-		return fmt.Errorf("could not describe notification integration: %w", err)
+		return diag.FromErr(fmt.Errorf("could not describe notification integration: %w", err))
 	}
 	for _, property := range integrationProperties {
 		name := property.Name
@@ -130,11 +129,11 @@ func ReadEmailNotificationIntegration(d *schema.ResourceData, meta interface{})
 		case "ALLOWED_RECIPIENTS":
 			if value == "" {
 				if err := d.Set("allowed_recipients", make([]string, 0)); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			} else {
 				if err := d.Set("allowed_recipients", strings.Split(value, ",")); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		default:
@@ -142,13 +141,12 @@ func ReadEmailNotificationIntegration(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateEmailNotificationIntegration implements schema.UpdateFunc.
-func UpdateEmailNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
+// UpdateEmailNotificationIntegration implements schema.UpdateContextFunc.
+func UpdateEmailNotificationIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
 
@@ -186,30 +184,29 @@ func UpdateEmailNotificationIntegration(d *schema.ResourceData, meta interface{}
 	if runSetStatement {
 		err := client.NotificationIntegrations.Alter(ctx, sdk.NewAlterNotificationIntegrationRequest(id).WithSet(setRequest))
 		if err != nil {
-			return fmt.Errorf("error updating notification integration: %w", err)
+			return diag.FromErr(fmt.Errorf("error updating notification integration: %w", err))
 		}
 	}
 
 	if runUnsetStatement {
 		err := client.NotificationIntegrations.Alter(ctx, sdk.NewAlterNotificationIntegrationRequest(id).WithUnsetEmailParams(unsetRequest))
 		if err != nil {
-			return fmt.Errorf("error updating notification integration: %w", err)
+			return diag.FromErr(fmt.Errorf("error updating notification integration: %w", err))
 		}
 	}
 
-	return ReadEmailNotificationIntegration(d, meta)
+	return ReadEmailNotificationIntegration(ctx, d, meta)
 }
 
-// DeleteEmailNotificationIntegration implements schema.DeleteFunc.
-func DeleteEmailNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
+// DeleteEmailNotificationIntegration implements schema.DeleteContextFunc.
+func DeleteEmailNotificationIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
 
 	err := client.NotificationIntegrations.Drop(ctx, sdk.NewDropNotificationIntegrationRequest(id))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
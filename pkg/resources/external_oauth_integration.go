@@ -1,11 +1,13 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -142,11 +144,11 @@ var oauthExternalIntegrationSchema = map[string]*schema.Schema{
 // ExternalOauthIntegration returns a pointer to the resource representing a network policy.
 func ExternalOauthIntegration() *schema.Resource {
 	return &schema.Resource{
-		Description: "An External OAuth security integration allows a client to use a third-party authorization server to obtain the access tokens needed to interact with Snowflake.",
-		Create:      CreateExternalOauthIntegration,
-		Read:        ReadExternalOauthIntegration,
-		Update:      UpdateExternalOauthIntegration,
-		Delete:      DeleteExternalOauthIntegration,
+		Description:   "An External OAuth security integration allows a client to use a third-party authorization server to obtain the access tokens needed to interact with Snowflake.",
+		CreateContext: CreateExternalOauthIntegration,
+		ReadContext:   ReadExternalOauthIntegration,
+		UpdateContext: UpdateExternalOauthIntegration,
+		DeleteContext: DeleteExternalOauthIntegration,
 
 		Schema: oauthExternalIntegrationSchema,
 		Importer: &schema.ResourceImporter{
@@ -155,11 +157,11 @@ func ExternalOauthIntegration() *schema.Resource {
 	}
 }
 
-// CreateExternalOauthIntegration implements schema.CreateFunc.
-func CreateExternalOauthIntegration(d *schema.ResourceData, meta interface{}) error {
+// CreateExternalOauthIntegration implements schema.CreateContextFunc.
+func CreateExternalOauthIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	manager, err := snowflake.NewExternalOauthIntegration3Manager()
 	if err != nil {
-		return fmt.Errorf("couldn't create external oauth integration manager: %w", err)
+		return diag.FromErr(fmt.Errorf("couldn't create external oauth integration manager: %w", err))
 	}
 
 	input := &snowflake.ExternalOauthIntegration3CreateInput{
@@ -206,25 +208,25 @@ func CreateExternalOauthIntegration(d *schema.ResourceData, meta interface{}) er
 
 	stmt, err := manager.Create(input)
 	if err != nil {
-		return fmt.Errorf("couldn't generate create statement: %w", err)
+		return diag.FromErr(fmt.Errorf("couldn't generate create statement: %w", err))
 	}
 
 	db := meta.(*sql.DB)
-	err = snowflake.Exec(db, stmt)
+	err = snowflake.Exec(ctx, db, stmt)
 	if err != nil {
-		return fmt.Errorf("error executing create statement: %w", err)
+		return diag.FromErr(fmt.Errorf("error executing create statement: %w", err))
 	}
 
 	d.SetId(ExternalOauthIntegrationID(&input.ExternalOauthIntegration3))
 
-	return ReadExternalOauthIntegration(d, meta)
+	return ReadExternalOauthIntegration(ctx, d, meta)
 }
 
-// ReadExternalOauthIntegration implements schema.ReadFunc.
-func ReadExternalOauthIntegration(d *schema.ResourceData, meta interface{}) error {
+// ReadExternalOauthIntegration implements schema.ReadContextFunc.
+func ReadExternalOauthIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	manager, err := snowflake.NewExternalOauthIntegration3Manager()
 	if err != nil {
-		return fmt.Errorf("couldn't create external oauth integration builder: %w", err)
+		return diag.FromErr(fmt.Errorf("couldn't create external oauth integration builder: %w", err))
 	}
 
 	input := ExternalOauthIntegrationIdentifier(d.Id())
@@ -236,30 +238,30 @@ func ReadExternalOauthIntegration(d *schema.ResourceData, meta interface{}) erro
 	// SHOW
 	stmt, err := manager.ReadShow(input)
 	if err != nil {
-		return fmt.Errorf("couldn't generate show statement: %w", err)
+		return diag.FromErr(fmt.Errorf("couldn't generate show statement: %w", err))
 	}
 
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRow(ctx, db, stmt)
 	if err != nil {
-		return fmt.Errorf("error querying external oauth integration: %w", err)
+		return diag.FromErr(fmt.Errorf("error querying external oauth integration: %w", err))
 	}
 
 	showOutput, err := manager.ParseShow(row)
 	if err != nil {
-		return fmt.Errorf("error parsing show result: %w", err)
+		return diag.FromErr(fmt.Errorf("error parsing show result: %w", err))
 	}
 
 	if err := d.Set("type", strings.TrimPrefix(showOutput.Type, "EXTERNAL_OAUTH - ")); err != nil {
-		return fmt.Errorf("error setting type: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting type: %w", err))
 	}
 	if err := d.Set("name", showOutput.Name); err != nil {
-		return fmt.Errorf("error setting name: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting name: %w", err))
 	}
 	if err := d.Set("enabled", showOutput.Enabled); err != nil {
-		return fmt.Errorf("error setting enabled: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting enabled: %w", err))
 	}
 	if err := d.Set("comment", showOutput.Comment.String); err != nil {
-		return fmt.Errorf("error setting comment: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting comment: %w", err))
 	}
 	// if err := d.Set("created_on", showOutput.CreatedOn.String); err != nil {
 	// 	return fmt.Errorf("error setting created_on: %w", err)
@@ -268,34 +270,34 @@ func ReadExternalOauthIntegration(d *schema.ResourceData, meta interface{}) erro
 	// DESCRIBE
 	stmt, err = manager.ReadDescribe(input)
 	if err != nil {
-		return fmt.Errorf("couldn't generate describe statement: %w", err)
+		return diag.FromErr(fmt.Errorf("couldn't generate describe statement: %w", err))
 	}
 
-	rows, err := snowflake.Query(db, stmt)
+	rows, err := snowflake.Query(ctx, db, stmt)
 	if err != nil {
-		return fmt.Errorf("error querying external oauth integration: %w", err)
+		return diag.FromErr(fmt.Errorf("error querying external oauth integration: %w", err))
 	}
 
 	defer rows.Close()
 	describeOutput, err := manager.ParseDescribe(rows.Rows)
 	if err != nil {
-		return fmt.Errorf("failed to parse result of describe: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to parse result of describe: %w", err))
 	}
 
 	if err := d.Set("issuer", describeOutput.ExternalOauthIssuer); err != nil {
-		return fmt.Errorf("error setting issuer: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting issuer: %w", err))
 	}
 	if err := d.Set("jws_keys_urls", describeOutput.ExternalOauthJwsKeysURL); err != nil {
-		return fmt.Errorf("error setting jws_keys_urls: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting jws_keys_urls: %w", err))
 	}
 	if err := d.Set("any_role_mode", describeOutput.ExternalOauthAnyRoleMode); err != nil {
-		return fmt.Errorf("error setting any_role_mode: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting any_role_mode: %w", err))
 	}
 	if err := d.Set("rsa_public_key", describeOutput.ExternalOauthRsaPublicKey); err != nil {
-		return fmt.Errorf("error setting rsa_public_key: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting rsa_public_key: %w", err))
 	}
 	if err := d.Set("rsa_public_key_2", describeOutput.ExternalOauthRsaPublicKey2); err != nil {
-		return fmt.Errorf("error setting rsa_public_key_2: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting rsa_public_key_2: %w", err))
 	}
 	// Filter out default roles
 	blockedRoles := []string{}
@@ -306,32 +308,32 @@ func ReadExternalOauthIntegration(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 	if err := d.Set("blocked_roles", blockedRoles); err != nil {
-		return fmt.Errorf("error setting blocked_roles: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting blocked_roles: %w", err))
 	}
 	if err := d.Set("allowed_roles", describeOutput.ExternalOauthAllowedRolesList); err != nil {
-		return fmt.Errorf("error setting allowed_roles: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting allowed_roles: %w", err))
 	}
 	if err := d.Set("audience_urls", describeOutput.ExternalOauthAudienceList); err != nil {
-		return fmt.Errorf("error setting audience_urls: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting audience_urls: %w", err))
 	}
 	if err := d.Set("token_user_mapping_claims", describeOutput.ExternalOauthTokenUserMappingClaim); err != nil {
-		return fmt.Errorf("error setting token_user_mapping_claims: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting token_user_mapping_claims: %w", err))
 	}
 	if err := d.Set("snowflake_user_mapping_attribute", describeOutput.ExternalOauthSnowflakeUserMappingAttribute); err != nil {
-		return fmt.Errorf("error setting snowflake_user_mapping_attribute: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting snowflake_user_mapping_attribute: %w", err))
 	}
 	if err := d.Set("scope_mapping_attribute", describeOutput.ExternalOauthScopeMappingAttribute); err != nil {
-		return fmt.Errorf("error setting scope_mapping_attribute: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting scope_mapping_attribute: %w", err))
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateExternalOauthIntegration implements schema.UpdateFunc.
-func UpdateExternalOauthIntegration(d *schema.ResourceData, meta interface{}) error {
+// UpdateExternalOauthIntegration implements schema.UpdateContextFunc.
+func UpdateExternalOauthIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	manager, err := snowflake.NewExternalOauthIntegration3Manager()
 	if err != nil {
-		return fmt.Errorf("couldn't create external oauth integration builder: %w", err)
+		return diag.FromErr(fmt.Errorf("couldn't create external oauth integration builder: %w", err))
 	}
 
 	runAlter := false
@@ -522,35 +524,35 @@ func UpdateExternalOauthIntegration(d *schema.ResourceData, meta interface{}) er
 	if runAlter {
 		stmt, err := manager.Update(alterInput)
 		if err != nil {
-			return fmt.Errorf("couldn't generate alter statement for external oauth integration: %w", err)
+			return diag.FromErr(fmt.Errorf("couldn't generate alter statement for external oauth integration: %w", err))
 		}
 
-		err = snowflake.Exec(db, stmt)
+		err = snowflake.Exec(ctx, db, stmt)
 		if err != nil {
-			return fmt.Errorf("error executing alter statement: %w", err)
+			return diag.FromErr(fmt.Errorf("error executing alter statement: %w", err))
 		}
 	}
 
 	if runUnset {
 		stmt, err := manager.Unset(unsetInput)
 		if err != nil {
-			return fmt.Errorf("couldn't generate unset statement for external oauth integration: %w", err)
+			return diag.FromErr(fmt.Errorf("couldn't generate unset statement for external oauth integration: %w", err))
 		}
 
-		err = snowflake.Exec(db, stmt)
+		err = snowflake.Exec(ctx, db, stmt)
 		if err != nil {
-			return fmt.Errorf("error executing unset statement: %w", err)
+			return diag.FromErr(fmt.Errorf("error executing unset statement: %w", err))
 		}
 	}
 
-	return ReadExternalOauthIntegration(d, meta)
+	return ReadExternalOauthIntegration(ctx, d, meta)
 }
 
-// DeleteExternalOauthIntegration implements schema.DeleteFunc.
-func DeleteExternalOauthIntegration(d *schema.ResourceData, meta interface{}) error {
+// DeleteExternalOauthIntegration implements schema.DeleteContextFunc.
+func DeleteExternalOauthIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	manager, err := snowflake.NewExternalOauthIntegration3Manager()
 	if err != nil {
-		return fmt.Errorf("couldn't create external oauth integration builder: %w", err)
+		return diag.FromErr(fmt.Errorf("couldn't create external oauth integration builder: %w", err))
 	}
 
 	input := &snowflake.ExternalOauthIntegration3DeleteInput{
@@ -561,13 +563,13 @@ func DeleteExternalOauthIntegration(d *schema.ResourceData, meta interface{}) er
 
 	stmt, err := manager.Delete(input)
 	if err != nil {
-		return fmt.Errorf("couldn't generate drop statement: %w", err)
+		return diag.FromErr(fmt.Errorf("couldn't generate drop statement: %w", err))
 	}
 
 	db := meta.(*sql.DB)
-	err = snowflake.Exec(db, stmt)
+	err = snowflake.Exec(ctx, db, stmt)
 	if err != nil {
-		return fmt.Errorf("error executing drop statement: %w", err)
+		return diag.FromErr(fmt.Errorf("error executing drop statement: %w", err))
 	}
 
 	return nil
@@ -35,7 +35,9 @@ func externalStageConfig(n, databaseName, schemaName string) string {
 	return fmt.Sprintf(`
 resource "snowflake_stage" "test" {
 	name = "%v"
-	url = "s3://com.example.bucket/prefix"
+	s3 {
+		url = "s3://com.example.bucket/prefix"
+	}
 	database = "%s"
 	schema = "%s"
 	comment = "Terraform acceptance test"
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
@@ -137,10 +138,10 @@ var externalTableSchema = map[string]*schema.Schema{
 
 func ExternalTable() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateExternalTable,
-		Read:   ReadExternalTable,
-		Update: UpdateExternalTable,
-		Delete: DeleteExternalTable,
+		CreateContext: CreateExternalTable,
+		ReadContext:   ReadExternalTable,
+		UpdateContext: UpdateExternalTable,
+		DeleteContext: DeleteExternalTable,
 
 		Schema: externalTableSchema,
 		Importer: &schema.ResourceImporter{
@@ -149,10 +150,9 @@ func ExternalTable() *schema.Resource {
 	}
 }
 
-// CreateExternalTable implements schema.CreateFunc.
-func CreateExternalTable(d *schema.ResourceData, meta any) error {
+// CreateExternalTable implements schema.CreateContextFunc.
+func CreateExternalTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	database := d.Get("database").(string)
@@ -223,7 +223,7 @@ func CreateExternalTable(d *schema.ResourceData, meta any) error {
 				WithTag(tagAssociationRequests),
 		)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	default:
 		err := client.ExternalTables.Create(
@@ -241,19 +241,18 @@ func CreateExternalTable(d *schema.ResourceData, meta any) error {
 				WithTag(tagAssociationRequests),
 		)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(id))
 
-	return ReadExternalTable(d, meta)
+	return ReadExternalTable(ctx, d, meta)
 }
 
-// ReadExternalTable implements schema.ReadFunc.
-func ReadExternalTable(d *schema.ResourceData, meta any) error {
+// ReadExternalTable implements schema.ReadContextFunc.
+func ReadExternalTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
@@ -261,24 +260,23 @@ func ReadExternalTable(d *schema.ResourceData, meta any) error {
 	if err != nil {
 		log.Printf("[DEBUG] external table (%s) not found", d.Id())
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("name", externalTable.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("owner", externalTable.Owner); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-// UpdateExternalTable implements schema.UpdateFunc.
-func UpdateExternalTable(d *schema.ResourceData, meta any) error {
+// UpdateExternalTable implements schema.UpdateContextFunc.
+func UpdateExternalTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
@@ -288,7 +286,7 @@ func UpdateExternalTable(d *schema.ResourceData, meta any) error {
 		if len(unsetTags) > 0 {
 			err := client.ExternalTables.Alter(ctx, sdk.NewAlterExternalTableRequest(id).WithUnsetTag(unsetTags))
 			if err != nil {
-				return fmt.Errorf("error setting tags on %v, err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error setting tags on %v, err = %w", d.Id(), err))
 			}
 		}
 
@@ -299,24 +297,23 @@ func UpdateExternalTable(d *schema.ResourceData, meta any) error {
 			}
 			err := client.ExternalTables.Alter(ctx, sdk.NewAlterExternalTableRequest(id).WithSetTag(tagAssociationRequests))
 			if err != nil {
-				return fmt.Errorf("error setting tags on %v, err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error setting tags on %v, err = %w", d.Id(), err))
 			}
 		}
 	}
 
-	return ReadExternalTable(d, meta)
+	return ReadExternalTable(ctx, d, meta)
 }
 
-// DeleteExternalTable implements schema.DeleteFunc.
-func DeleteExternalTable(d *schema.ResourceData, meta any) error {
+// DeleteExternalTable implements schema.DeleteContextFunc.
+func DeleteExternalTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
 	err := client.ExternalTables.Drop(ctx, sdk.NewDropExternalTableRequest(id))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
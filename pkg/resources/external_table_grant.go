@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -103,10 +104,10 @@ var externalTableGrantSchema = map[string]*schema.Schema{
 func ExternalTableGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateExternalTableGrant,
-			Read:               ReadExternalTableGrant,
-			Delete:             DeleteExternalTableGrant,
-			Update:             UpdateExternalTableGrant,
+			CreateContext:      CreateExternalTableGrant,
+			ReadContext:        ReadExternalTableGrant,
+			DeleteContext:      DeleteExternalTableGrant,
+			UpdateContext:      UpdateExternalTableGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             externalTableGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -153,8 +154,8 @@ func ExternalTableGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateExternalTableGrant implements schema.CreateFunc.
-func CreateExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateExternalTableGrant implements schema.CreateContextFunc.
+func CreateExternalTableGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	externalTableName := d.Get("external_table_name").(string)
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -162,20 +163,20 @@ func CreateExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
 	onFuture := d.Get("on_future").(bool)
 	onAll := d.Get("on_all").(bool)
 	if onFuture && onAll {
-		return errors.New("on_future and on_all cannot both be true")
+		return diag.FromErr(errors.New("on_future and on_all cannot both be true"))
 	}
 	withGrantOption := d.Get("with_grant_option").(bool)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 	shares := expandStringList(d.Get("shares").(*schema.Set).List())
 
 	if (externalTableName == "") && !onFuture && !onAll {
-		return errors.New("external_table_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("external_table_name must be set unless on_future or on_all is true"))
 	}
 	if (externalTableName != "") && (onFuture || onAll) {
-		return errors.New("external_table_name must be empty if on_future or on_all is true")
+		return diag.FromErr(errors.New("external_table_name must be empty if on_future or on_all is true"))
 	}
 	if (schemaName == "") && !onFuture && !onAll {
-		return errors.New("schema_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future or on_all is true"))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -188,18 +189,18 @@ func CreateExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.ExternalTableGrant(databaseName, schemaName, externalTableName)
 	}
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, externalTableName, privilege, withGrantOption, onFuture, onAll, roles, shares)
 	d.SetId(grantID)
 
-	return ReadExternalTableGrant(d, meta)
+	return ReadExternalTableGrant(ctx, d, meta)
 }
 
-// ReadExternalTableGrant implements schema.ReadFunc.
-func ReadExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadExternalTableGrant implements schema.ReadContextFunc.
+func ReadExternalTableGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	externalTableName := d.Get("external_table_name").(string)
@@ -220,9 +221,9 @@ func ReadExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.ExternalTableGrant(databaseName, schemaName, externalTableName)
 	}
 
-	err := readGenericGrant(d, meta, externalTableGrantSchema, builder, onFuture, onAll, validExternalTablePrivileges)
+	err := readGenericGrant(ctx, d, meta, externalTableGrantSchema, builder, onFuture, onAll, validExternalTablePrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, externalTableName, privilege, withGrantOption, onFuture, onAll, roles, shares)
@@ -232,8 +233,8 @@ func ReadExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteExternalTableGrant implements schema.DeleteFunc.
-func DeleteExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteExternalTableGrant implements schema.DeleteContextFunc.
+func DeleteExternalTableGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	externalTableName := d.Get("external_table_name").(string)
@@ -249,11 +250,11 @@ func DeleteExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
 	default:
 		builder = snowflake.ExternalTableGrant(databaseName, schemaName, externalTableName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateExternalTableGrant implements schema.UpdateFunc.
-func UpdateExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateExternalTableGrant implements schema.UpdateContextFunc.
+func UpdateExternalTableGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles", "shares") {
@@ -290,18 +291,18 @@ func UpdateExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, privilege, reversionRole, rolesToRevoke, sharesToRevoke,
+		ctx, meta, builder, privilege, reversionRole, rolesToRevoke, sharesToRevoke,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then add
 
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, privilege, withGrantOption, rolesToAdd, sharesToAdd,
+		ctx, meta, builder, privilege, withGrantOption, rolesToAdd, sharesToAdd,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadExternalTableGrant(d, meta)
+	return ReadExternalTableGrant(ctx, d, meta)
 }
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -135,10 +136,10 @@ var failoverGroupSchema = map[string]*schema.Schema{
 // FailoverGroup returns a pointer to the resource representing a failover group.
 func FailoverGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateFailoverGroup,
-		Read:   ReadFailoverGroup,
-		Update: UpdateFailoverGroup,
-		Delete: DeleteFailoverGroup,
+		CreateContext: CreateFailoverGroup,
+		ReadContext:   ReadFailoverGroup,
+		UpdateContext: UpdateFailoverGroup,
+		DeleteContext: DeleteFailoverGroup,
 
 		Schema: failoverGroupSchema,
 		Importer: &schema.ResourceImporter{
@@ -147,11 +148,10 @@ func FailoverGroup() *schema.Resource {
 	}
 }
 
-// CreateFailoverGroup implements schema.CreateFunc.
-func CreateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
+// CreateFailoverGroup implements schema.CreateContextFunc.
+func CreateFailoverGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	// getting required attributes
 	name := d.Get("name").(string)
 	id := sdk.NewAccountObjectIdentifier(name)
@@ -166,15 +166,15 @@ func CreateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 		primaryFailoverGroupID := sdk.NewExternalObjectIdentifier(sdk.NewAccountIdentifier(organizationName, sourceAccountName), sdk.NewAccountObjectIdentifier(sourceFailoverGroupName))
 		err := client.FailoverGroups.CreateSecondaryReplicationGroup(ctx, id, primaryFailoverGroupID, nil)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(name)
-		return ReadFailoverGroup(d, meta)
+		return ReadFailoverGroup(ctx, d, meta)
 	}
 
 	// these two are required attributes if from_replica is not set
 	if _, ok := d.GetOk("object_types"); !ok {
-		return errors.New("object_types is required when not creating from a replica")
+		return diag.FromErr(errors.New("object_types is required when not creating from a replica"))
 	}
 	objectTypesList := expandStringList(d.Get("object_types").(*schema.Set).List())
 	objectTypes := make([]sdk.PluralObjectType, len(objectTypesList))
@@ -183,7 +183,7 @@ func CreateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if _, ok := d.GetOk("allowed_accounts"); !ok {
-		return errors.New("allowed_accounts is required when not creating from a replica")
+		return diag.FromErr(errors.New("allowed_accounts is required when not creating from a replica"))
 	}
 	aaList := expandStringList(d.Get("allowed_accounts").(*schema.Set).List())
 	allowedAccounts := make([]sdk.AccountIdentifier, len(aaList))
@@ -191,7 +191,7 @@ func CreateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 		// validation since we cannot do that in the ValidateFunc
 		parts := strings.Split(v, ".")
 		if len(parts) != 2 {
-			return fmt.Errorf("allowed_account %s cannot be an account locator and must be of the format <org_name>.<target_account_name>", allowedAccounts[i])
+			return diag.FromErr(fmt.Errorf("allowed_account %s cannot be an account locator and must be of the format <org_name>.<target_account_name>", allowedAccounts[i]))
 		}
 		organizationName := parts[0]
 		accountName := parts[1]
@@ -255,27 +255,26 @@ func CreateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 
 	err := client.FailoverGroups.Create(ctx, id, objectTypes, allowedAccounts, &opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(name)
 	return nil
 }
 
-// ReadFailoverGroup implements schema.ReadFunc.
-func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
+// ReadFailoverGroup implements schema.ReadContextFunc.
+func ReadFailoverGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	name := d.Id()
 	id := sdk.NewAccountObjectIdentifier(name)
 	failoverGroup, err := client.FailoverGroups.ShowByID(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("name", failoverGroup.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// if the failover group is created from a replica, then we do not want to get the other values
 	if _, ok := d.GetOk("from_replica"); ok {
@@ -287,7 +286,7 @@ func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 		if strings.Contains(replicationSchedule, "MINUTE") {
 			interval, err := strconv.Atoi(strings.TrimSuffix(replicationSchedule, " MINUTE"))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			err = d.Set("replication_schedule", []interface{}{
 				map[string]interface{}{
@@ -295,7 +294,7 @@ func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 				},
 			})
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		} else {
 			repScheduleParts := strings.Split(replicationSchedule, " ")
@@ -312,7 +311,7 @@ func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 				},
 			})
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -323,7 +322,7 @@ func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 	}
 	objectTypesSet := schema.NewSet(schema.HashString, objectTypes)
 	if err := d.Set("object_types", objectTypesSet); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// integration types
@@ -334,7 +333,7 @@ func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 
 	allowedIntegrationsTypesSet := schema.NewSet(schema.HashString, allowedIntegrationTypes)
 	if err := d.Set("allowed_integration_types", allowedIntegrationsTypesSet); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// allowed accounts
@@ -344,13 +343,13 @@ func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 	}
 	allowedAccountsSet := schema.NewSet(schema.HashString, allowedAccounts)
 	if err := d.Set("allowed_accounts", allowedAccountsSet); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// allowed databases
 	databases, err := client.FailoverGroups.ShowDatabases(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	allowedDatabases := make([]interface{}, len(databases))
 	for i, database := range databases {
@@ -359,18 +358,18 @@ func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 	allowedDatabasesSet := schema.NewSet(schema.HashString, allowedDatabases)
 	if len(allowedDatabases) > 0 {
 		if err := d.Set("allowed_databases", allowedDatabasesSet); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err := d.Set("allowed_databases", nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	// allowed shares
 	shares, err := client.FailoverGroups.ShowShares(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	allowedShares := make([]interface{}, len(shares))
 	for i, share := range shares {
@@ -379,22 +378,21 @@ func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 	allowedSharesSet := schema.NewSet(schema.HashString, allowedShares)
 	if len(allowedShares) > 0 {
 		if err := d.Set("allowed_shares", allowedSharesSet); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err := d.Set("allowed_shares", nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-// UpdateFailoverGroup implements schema.UpdateFunc.
-func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
+// UpdateFailoverGroup implements schema.UpdateContextFunc.
+func UpdateFailoverGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	name := d.Id()
 	id := sdk.NewAccountObjectIdentifier(name)
 
@@ -458,7 +456,7 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 	}
 	if runSet {
 		if err := client.FailoverGroups.AlterSource(ctx, id, opts); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -488,7 +486,7 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 				},
 			}
 			if err := client.FailoverGroups.AlterSource(ctx, id, opts); err != nil {
-				return fmt.Errorf("error removing allowed databases for failover group %v err = %w", name, err)
+				return diag.FromErr(fmt.Errorf("error removing allowed databases for failover group %v err = %w", name, err))
 			}
 		}
 
@@ -506,7 +504,7 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 				},
 			}
 			if err := client.FailoverGroups.AlterSource(ctx, id, opts); err != nil {
-				return fmt.Errorf("error removing allowed databases for failover group %v err = %w", name, err)
+				return diag.FromErr(fmt.Errorf("error removing allowed databases for failover group %v err = %w", name, err))
 			}
 		}
 	}
@@ -537,7 +535,7 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 				},
 			}
 			if err := client.FailoverGroups.AlterSource(ctx, id, opts); err != nil {
-				return fmt.Errorf("error removing allowed shares for failover group %v err = %w", name, err)
+				return diag.FromErr(fmt.Errorf("error removing allowed shares for failover group %v err = %w", name, err))
 			}
 		}
 
@@ -555,7 +553,7 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 				},
 			}
 			if err := client.FailoverGroups.AlterSource(ctx, id, opts); err != nil {
-				return fmt.Errorf("error removing allowed shares for failover group %v err = %w", name, err)
+				return diag.FromErr(fmt.Errorf("error removing allowed shares for failover group %v err = %w", name, err))
 			}
 		}
 	}
@@ -594,7 +592,7 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 				},
 			}
 			if err := client.FailoverGroups.AlterSource(ctx, id, opts); err != nil {
-				return fmt.Errorf("error removing allowed accounts for failover group %v err = %w", name, err)
+				return diag.FromErr(fmt.Errorf("error removing allowed accounts for failover group %v err = %w", name, err))
 			}
 		}
 
@@ -612,24 +610,23 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 				},
 			}
 			if err := client.FailoverGroups.AlterSource(ctx, id, opts); err != nil {
-				return fmt.Errorf("error removing allowed accounts for failover group %v err = %w", name, err)
+				return diag.FromErr(fmt.Errorf("error removing allowed accounts for failover group %v err = %w", name, err))
 			}
 		}
 	}
 
-	return ReadFailoverGroup(d, meta)
+	return ReadFailoverGroup(ctx, d, meta)
 }
 
-// DeleteFailoverGroup implements schema.DeleteFunc.
-func DeleteFailoverGroup(d *schema.ResourceData, meta interface{}) error {
+// DeleteFailoverGroup implements schema.DeleteContextFunc.
+func DeleteFailoverGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 	name := d.Id()
 	id := sdk.NewAccountObjectIdentifier(name)
-	ctx := context.Background()
 	err := client.FailoverGroups.Drop(ctx, id, &sdk.DropFailoverGroupOptions{IfExists: sdk.Bool(true)})
 	if err != nil {
-		return fmt.Errorf("error deleting failover group %v err = %w", name, err)
+		return diag.FromErr(fmt.Errorf("error deleting failover group %v err = %w", name, err))
 	}
 
 	d.SetId("")
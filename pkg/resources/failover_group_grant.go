@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -72,10 +73,10 @@ var failoverGroupGrantSchema = map[string]*schema.Schema{
 func FailoverGroupGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateFailoverGroupGrant,
-			Read:               ReadFailoverGroupGrant,
-			Delete:             DeleteFailoverGroupGrant,
-			Update:             UpdateFailoverGroupGrant,
+			CreateContext:      CreateFailoverGroupGrant,
+			ReadContext:        ReadFailoverGroupGrant,
+			DeleteContext:      DeleteFailoverGroupGrant,
+			UpdateContext:      UpdateFailoverGroupGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             failoverGroupGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -106,8 +107,8 @@ func FailoverGroupGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateFailoverGroupGrant implements schema.CreateFunc.
-func CreateFailoverGroupGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateFailoverGroupGrant implements schema.CreateContextFunc.
+func CreateFailoverGroupGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	failoverGroupName := d.Get("failover_group_name").(string)
 	privilege := d.Get("privilege").(string)
 	withGrantOption := d.Get("with_grant_option").(bool)
@@ -115,27 +116,27 @@ func CreateFailoverGroupGrant(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.FailoverGroupGrant(failoverGroupName)
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(failoverGroupName, privilege, withGrantOption, roles)
 	d.SetId(grantID)
 
-	return ReadFailoverGroupGrant(d, meta)
+	return ReadFailoverGroupGrant(ctx, d, meta)
 }
 
-// ReadFailoverGroupGrant implements schema.ReadFunc.
-func ReadFailoverGroupGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadFailoverGroupGrant implements schema.ReadContextFunc.
+func ReadFailoverGroupGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	failoverGroupName := d.Get("failover_group_name").(string)
 	privilege := d.Get("privilege").(string)
 	withGrantOption := d.Get("with_grant_option").(bool)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 	builder := snowflake.FailoverGroupGrant(failoverGroupName)
 
-	err := readGenericGrant(d, meta, failoverGroupGrantSchema, builder, false, false, validFailoverGroupPrivileges)
+	err := readGenericGrant(ctx, d, meta, failoverGroupGrantSchema, builder, false, false, validFailoverGroupPrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(failoverGroupName, privilege, withGrantOption, roles)
@@ -145,15 +146,15 @@ func ReadFailoverGroupGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteFailoverGroupGrant implements schema.DeleteFunc.
-func DeleteFailoverGroupGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteFailoverGroupGrant implements schema.DeleteContextFunc.
+func DeleteFailoverGroupGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	failoverGroupName := d.Get("failover_group_name").(string)
 	builder := snowflake.FailoverGroupGrant(failoverGroupName)
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateFailoverGroupGrant implements schema.UpdateFunc.
-func UpdateFailoverGroupGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateFailoverGroupGrant implements schema.UpdateContextFunc.
+func UpdateFailoverGroupGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles") {
@@ -174,17 +175,17 @@ func UpdateFailoverGroupGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
+		ctx, meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
+		ctx, meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadFileFormatGrant(d, meta)
+	return ReadFileFormatGrant(ctx, d, meta)
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -99,10 +100,10 @@ var fileFormatGrantSchema = map[string]*schema.Schema{
 func FileFormatGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateFileFormatGrant,
-			Read:               ReadFileFormatGrant,
-			Delete:             DeleteFileFormatGrant,
-			Update:             UpdateFileFormatGrant,
+			CreateContext:      CreateFileFormatGrant,
+			ReadContext:        ReadFileFormatGrant,
+			DeleteContext:      DeleteFileFormatGrant,
+			UpdateContext:      UpdateFileFormatGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             fileFormatGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -145,8 +146,8 @@ func FileFormatGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateFileFormatGrant implements schema.CreateFunc.
-func CreateFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateFileFormatGrant implements schema.CreateContextFunc.
+func CreateFileFormatGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	fileFormatName := d.Get("file_format_name").(string)
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -157,10 +158,10 @@ func CreateFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	if (fileFormatName == "") && !onFuture && !onAll {
-		return errors.New("file_format_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("file_format_name must be set unless on_future or on_all is true"))
 	}
 	if (schemaName == "") && !onFuture && !onAll {
-		return errors.New("schema_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future or on_all is true"))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -173,18 +174,18 @@ func CreateFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.FileFormatGrant(databaseName, schemaName, fileFormatName)
 	}
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, fileFormatName, privilege, withGrantOption, onFuture, onAll, roles)
 	d.SetId(grantID)
 
-	return ReadFileFormatGrant(d, meta)
+	return ReadFileFormatGrant(ctx, d, meta)
 }
 
-// ReadFileFormatGrant implements schema.ReadFunc.
-func ReadFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadFileFormatGrant implements schema.ReadContextFunc.
+func ReadFileFormatGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	fileFormatName := d.Get("file_format_name").(string)
@@ -204,9 +205,9 @@ func ReadFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.FileFormatGrant(databaseName, schemaName, fileFormatName)
 	}
 
-	err := readGenericGrant(d, meta, fileFormatGrantSchema, builder, onFuture, onAll, validFileFormatPrivileges)
+	err := readGenericGrant(ctx, d, meta, fileFormatGrantSchema, builder, onFuture, onAll, validFileFormatPrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, fileFormatName, privilege, withGrantOption, onFuture, onAll, roles)
@@ -216,8 +217,8 @@ func ReadFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteFileFormatGrant implements schema.DeleteFunc.
-func DeleteFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteFileFormatGrant implements schema.DeleteContextFunc.
+func DeleteFileFormatGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	fileFormatName := d.Get("file_format_name").(string)
@@ -233,11 +234,11 @@ func DeleteFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 	default:
 		builder = snowflake.FileFormatGrant(databaseName, schemaName, fileFormatName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateFileFormatGrant implements schema.UpdateFunc.
-func UpdateFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateFileFormatGrant implements schema.UpdateContextFunc.
+func UpdateFileFormatGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles") {
@@ -272,17 +273,17 @@ func UpdateFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
+		ctx, meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
+		ctx, meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadFileFormatGrant(d, meta)
+	return ReadFileFormatGrant(ctx, d, meta)
 }
@@ -0,0 +1,669 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// fileFormatTypedOption describes one format type option of the typed file format resources.
+type fileFormatTypedOption struct {
+	schema *schema.Schema
+	// serverDefault is the value shown by Snowflake when the option is not set, in its state representation.
+	serverDefault any
+	// reset is set with ALTER FILE FORMAT when the option is removed from the configuration. The options without it
+	// (i.e. the ones defaulting to NONE) cannot be set back to the default, so removing them recreates the file format.
+	reset any
+	// expand sets the option from its state representation.
+	expand func(opts *sdk.FileFormatTypeOptions, value any)
+	// flatten returns the state representation of the option, or nil when it is not shown.
+	flatten func(opts *sdk.FileFormatTypeOptions) any
+}
+
+// fileFormatStringOption returns an option holding a string; reset is nil for the options that cannot be reset.
+func fileFormatStringOption(key string, serverDefault string, reset *string, validateFunc schema.SchemaValidateFunc, expand func(*sdk.FileFormatTypeOptions, string), flatten func(*sdk.FileFormatTypeOptions) *string) fileFormatTypedOption {
+	description := fileFormatSchema[key].Description
+	if reset != nil {
+		description += fmt.Sprintf(" When left out, the Snowflake default (`%s`) is used.", strconv.Quote(serverDefault))
+	} else {
+		description += " When left out, the option is not set; removing it recreates the file format."
+	}
+	option := fileFormatTypedOption{
+		schema: &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  description,
+			ValidateFunc: validateFunc,
+		},
+		serverDefault: serverDefault,
+		expand: func(opts *sdk.FileFormatTypeOptions, value any) {
+			expand(opts, value.(string))
+		},
+		flatten: func(opts *sdk.FileFormatTypeOptions) any {
+			if v := flatten(opts); v != nil {
+				return *v
+			}
+			return nil
+		},
+	}
+	if reset != nil {
+		option.reset = *reset
+	}
+	return option
+}
+
+// fileFormatBoolOption returns an option holding a boolean. It is kept as a string in the schema, so that removing it
+// from the configuration can be told apart from setting it to false.
+func fileFormatBoolOption(key string, serverDefault bool, expand func(*sdk.FileFormatTypeOptions, bool), flatten func(*sdk.FileFormatTypeOptions) *bool) fileFormatTypedOption {
+	return fileFormatTypedOption{
+		schema: &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  fmt.Sprintf("%s Valid values are: true | false. When left out, the Snowflake default (%t) is used.", fileFormatSchema[key].Description, serverDefault),
+			ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+		},
+		serverDefault: strconv.FormatBool(serverDefault),
+		reset:         strconv.FormatBool(serverDefault),
+		expand: func(opts *sdk.FileFormatTypeOptions, value any) {
+			b, _ := strconv.ParseBool(value.(string))
+			expand(opts, b)
+		},
+		flatten: func(opts *sdk.FileFormatTypeOptions) any {
+			if v := flatten(opts); v != nil {
+				return strconv.FormatBool(*v)
+			}
+			return nil
+		},
+	}
+}
+
+// fileFormatNullIfOption returns the null_if option, which defaults to \N.
+func fileFormatNullIfOption(expand func(*sdk.FileFormatTypeOptions, []sdk.NullString), flatten func(*sdk.FileFormatTypeOptions) []sdk.NullString) fileFormatTypedOption {
+	return fileFormatTypedOption{
+		schema: &schema.Schema{
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: fileFormatSchema["null_if"].Description + ` When left out, the Snowflake default (\N) is used; an empty list is treated as left out.`,
+		},
+		serverDefault: []string{`\N`},
+		reset:         []string{`\\N`},
+		expand: func(opts *sdk.FileFormatTypeOptions, value any) {
+			nullIf := make([]sdk.NullString, 0)
+			for _, s := range expandStringList(toAnySlice(value)) {
+				nullIf = append(nullIf, sdk.NullString{S: s})
+			}
+			expand(opts, nullIf)
+		},
+		flatten: func(opts *sdk.FileFormatTypeOptions) any {
+			nullIf := flatten(opts)
+			if nullIf == nil {
+				return nil
+			}
+			values := make([]string, 0, len(nullIf))
+			for _, s := range nullIf {
+				values = append(values, s.S)
+			}
+			return values
+		},
+	}
+}
+
+func toAnySlice(value any) []any {
+	switch v := value.(type) {
+	case []any:
+		return v
+	case []string:
+		values := make([]any, 0, len(v))
+		for _, s := range v {
+			values = append(values, s)
+		}
+		return values
+	}
+	return nil
+}
+
+func validateFileFormatSingleCharacter(i any, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if utf8.RuneCountInString(v) != 1 {
+		return nil, []error{fmt.Errorf("%s has to be a single character, got %q", k, v)}
+	}
+	return nil, nil
+}
+
+var (
+	fileFormatBinaryFormats       = []string{string(sdk.BinaryFormatHex), string(sdk.BinaryFormatBase64), string(sdk.BinaryFormatUTF8)}
+	fileFormatCompressionsCSV     = []string{string(sdk.CSVCompressionAuto), string(sdk.CSVCompressionGzip), string(sdk.CSVCompressionBz2), string(sdk.CSVCompressionBrotli), string(sdk.CSVCompressionZstd), string(sdk.CSVCompressionDeflate), string(sdk.CSVCompressionRawDeflate), string(sdk.CSVCompressionNone)}
+	fileFormatCompressionsJSON    = []string{string(sdk.JSONCompressionAuto), string(sdk.JSONCompressionGzip), string(sdk.JSONCompressionBz2), string(sdk.JSONCompressionBrotli), string(sdk.JSONCompressionZstd), string(sdk.JSONCompressionDeflate), string(sdk.JSONCompressionRawDeflate), string(sdk.JSONCompressionNone)}
+	fileFormatCompressionsAvro    = []string{string(sdk.AvroCompressionAuto), string(sdk.AvroCompressionGzip), string(sdk.AvroCompressionBrotli), string(sdk.AvroCompressionZstd), string(sdk.AvroCompressionDeflate), string(sdk.AvroCompressionRawDeflate), string(sdk.AvroCompressionNone)}
+	fileFormatCompressionsParquet = []string{string(sdk.ParquetCompressionAuto), string(sdk.ParquetCompressionLzo), string(sdk.ParquetCompressionSnappy), string(sdk.ParquetCompressionNone)}
+	fileFormatCompressionsXML     = []string{string(sdk.XMLCompressionAuto), string(sdk.XMLCompressionGzip), string(sdk.XMLCompressionBz2), string(sdk.XMLCompressionBrotli), string(sdk.XMLCompressionZstd), string(sdk.XMLCompressionDeflate), string(sdk.XMLCompressionRawDeflate), string(sdk.XMLCompressionNone)}
+	fileFormatEncodings           = []string{
+		string(sdk.CSVEncodingBIG5), string(sdk.CSVEncodingEUCJP), string(sdk.CSVEncodingEUCKR), string(sdk.CSVEncodingGB18030),
+		string(sdk.CSVEncodingIBM420), string(sdk.CSVEncodingIBM424), string(sdk.CSVEncodingISO2022CN), string(sdk.CSVEncodingISO2022JP),
+		string(sdk.CSVEncodingISO2022KR), string(sdk.CSVEncodingISO88591), string(sdk.CSVEncodingISO88592), string(sdk.CSVEncodingISO88595),
+		string(sdk.CSVEncodingISO88596), string(sdk.CSVEncodingISO88597), string(sdk.CSVEncodingISO88598), string(sdk.CSVEncodingISO88599),
+		string(sdk.CSVEncodingISO885915), string(sdk.CSVEncodingKOI8R), string(sdk.CSVEncodingSHIFTJIS), string(sdk.CSVEncodingUTF8),
+		string(sdk.CSVEncodingUTF16), string(sdk.CSVEncodingUTF16BE), string(sdk.CSVEncodingUTF16LE), string(sdk.CSVEncodingUTF32),
+		string(sdk.CSVEncodingUTF32BE), string(sdk.CSVEncodingUTF32LE), string(sdk.CSVEncodingWINDOWS1250), string(sdk.CSVEncodingWINDOWS1251),
+		string(sdk.CSVEncodingWINDOWS1252), string(sdk.CSVEncodingWINDOWS1253), string(sdk.CSVEncodingWINDOWS1254), string(sdk.CSVEncodingWINDOWS1255),
+		string(sdk.CSVEncodingWINDOWS1256),
+	}
+)
+
+// fileFormatTypedOptions holds the options of every format type, based on
+// https://docs.snowflake.com/en/sql-reference/sql/create-file-format#format-type-options-formattypeoptions.
+var fileFormatTypedOptions = map[sdk.FileFormatType]map[string]fileFormatTypedOption{
+	sdk.FileFormatTypeCSV: {
+		"compression": fileFormatStringOption("compression", "AUTO", sdk.String("AUTO"), validation.StringInSlice(fileFormatCompressionsCSV, false),
+			func(o *sdk.FileFormatTypeOptions, v string) { o.CSVCompression = sdk.Pointer(sdk.CSVCompression(v)) },
+			func(o *sdk.FileFormatTypeOptions) *string { return (*string)(o.CSVCompression) }),
+		"record_delimiter": fileFormatStringOption("record_delimiter", "\n", sdk.String(`\n`), validation.StringIsNotEmpty,
+			func(o *sdk.FileFormatTypeOptions, v string) { o.CSVRecordDelimiter = sdk.String(v) },
+			func(o *sdk.FileFormatTypeOptions) *string { return o.CSVRecordDelimiter }),
+		"field_delimiter": fileFormatStringOption("field_delimiter", ",", sdk.String(","), validation.StringIsNotEmpty,
+			func(o *sdk.FileFormatTypeOptions, v string) { o.CSVFieldDelimiter = sdk.String(v) },
+			func(o *sdk.FileFormatTypeOptions) *string { return o.CSVFieldDelimiter }),
+		"file_extension": fileFormatStringOption("file_extension", "", nil, validation.StringIsNotEmpty,
+			func(o *sdk.FileFormatTypeOptions, v string) { o.CSVFileExtension = sdk.String(v) },
+			func(o *sdk.FileFormatTypeOptions) *string { return o.CSVFileExtension }),
+		"parse_header": fileFormatBoolOption("parse_header", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.CSVParseHeader = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.CSVParseHeader }),
+		"skip_header": {
+			schema: &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  fileFormatSchema["skip_header"].Description + " Cannot be used when `parse_header` is true. When left out, the Snowflake default (0) is used.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			serverDefault: 0,
+			reset:         0,
+			expand: func(o *sdk.FileFormatTypeOptions, v any) {
+				o.CSVSkipHeader = sdk.Int(v.(int))
+			},
+			flatten: func(o *sdk.FileFormatTypeOptions) any {
+				if o.CSVSkipHeader != nil {
+					return *o.CSVSkipHeader
+				}
+				return nil
+			},
+		},
+		"skip_blank_lines": fileFormatBoolOption("skip_blank_lines", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.CSVSkipBlankLines = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.CSVSkipBlankLines }),
+		"date_format": fileFormatStringOption("date_format", "AUTO", sdk.String("AUTO"), validation.StringIsNotEmpty,
+			func(o *sdk.FileFormatTypeOptions, v string) { o.CSVDateFormat = sdk.String(v) },
+			func(o *sdk.FileFormatTypeOptions) *string { return o.CSVDateFormat }),
+		"time_format": fileFormatStringOption("time_format", "AUTO", sdk.String("AUTO"), validation.StringIsNotEmpty,
+			func(o *sdk.FileFormatTypeOptions, v string) { o.CSVTimeFormat = sdk.String(v) },
+			func(o *sdk.FileFormatTypeOptions) *string { return o.CSVTimeFormat }),
+		"timestamp_format": fileFormatStringOption("timestamp_format", "AUTO", sdk.String("AUTO"), validation.StringIsNotEmpty,
+			func(o *sdk.FileFormatTypeOptions, v string) { o.CSVTimestampFormat = sdk.String(v) },
+			func(o *sdk.FileFormatTypeOptions) *string { return o.CSVTimestampFormat }),
+		"binary_format": fileFormatStringOption("binary_format", "HEX", sdk.String("HEX"), validation.StringInSlice(fileFormatBinaryFormats, false),
+			func(o *sdk.FileFormatTypeOptions, v string) { o.CSVBinaryFormat = sdk.Pointer(sdk.BinaryFormat(v)) },
+			func(o *sdk.FileFormatTypeOptions) *string { return (*string)(o.CSVBinaryFormat) }),
+		"escape": fileFormatStringOption("escape", "NONE", nil, validateFileFormatSingleCharacter,
+			func(o *sdk.FileFormatTypeOptions, v string) { o.CSVEscape = sdk.String(v) },
+			func(o *sdk.FileFormatTypeOptions) *string { return o.CSVEscape }),
+		"escape_unenclosed_field": fileFormatStringOption("escape_unenclosed_field", `\`, sdk.String(`\\`), validateFileFormatSingleCharacter,
+			func(o *sdk.FileFormatTypeOptions, v string) { o.CSVEscapeUnenclosedField = sdk.String(v) },
+			func(o *sdk.FileFormatTypeOptions) *string { return o.CSVEscapeUnenclosedField }),
+		"trim_space": fileFormatBoolOption("trim_space", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.CSVTrimSpace = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.CSVTrimSpace }),
+		"field_optionally_enclosed_by": fileFormatStringOption("field_optionally_enclosed_by", "NONE", nil, validation.StringInSlice([]string{`"`, "'"}, false),
+			func(o *sdk.FileFormatTypeOptions, v string) { o.CSVFieldOptionallyEnclosedBy = sdk.String(v) },
+			func(o *sdk.FileFormatTypeOptions) *string { return o.CSVFieldOptionallyEnclosedBy }),
+		"null_if": fileFormatNullIfOption(
+			func(o *sdk.FileFormatTypeOptions, v []sdk.NullString) { o.CSVNullIf = &v },
+			func(o *sdk.FileFormatTypeOptions) []sdk.NullString {
+				if o.CSVNullIf == nil {
+					return nil
+				}
+				return *o.CSVNullIf
+			}),
+		"error_on_column_count_mismatch": fileFormatBoolOption("error_on_column_count_mismatch", true,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.CSVErrorOnColumnCountMismatch = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.CSVErrorOnColumnCountMismatch }),
+		"replace_invalid_characters": fileFormatBoolOption("replace_invalid_characters", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.CSVReplaceInvalidCharacters = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.CSVReplaceInvalidCharacters }),
+		"empty_field_as_null": fileFormatBoolOption("empty_field_as_null", true,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.CSVEmptyFieldAsNull = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.CSVEmptyFieldAsNull }),
+		"skip_byte_order_mark": fileFormatBoolOption("skip_byte_order_mark", true,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.CSVSkipByteOrderMark = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.CSVSkipByteOrderMark }),
+		"encoding": fileFormatStringOption("encoding", "UTF8", sdk.String("UTF8"), validation.StringInSlice(fileFormatEncodings, false),
+			func(o *sdk.FileFormatTypeOptions, v string) { o.CSVEncoding = sdk.Pointer(sdk.CSVEncoding(v)) },
+			func(o *sdk.FileFormatTypeOptions) *string { return (*string)(o.CSVEncoding) }),
+	},
+	sdk.FileFormatTypeJSON: {
+		"compression": fileFormatStringOption("compression", "AUTO", sdk.String("AUTO"), validation.StringInSlice(fileFormatCompressionsJSON, false),
+			func(o *sdk.FileFormatTypeOptions, v string) { o.JSONCompression = sdk.Pointer(sdk.JSONCompression(v)) },
+			func(o *sdk.FileFormatTypeOptions) *string { return (*string)(o.JSONCompression) }),
+		"date_format": fileFormatStringOption("date_format", "AUTO", sdk.String("AUTO"), validation.StringIsNotEmpty,
+			func(o *sdk.FileFormatTypeOptions, v string) { o.JSONDateFormat = sdk.String(v) },
+			func(o *sdk.FileFormatTypeOptions) *string { return o.JSONDateFormat }),
+		"time_format": fileFormatStringOption("time_format", "AUTO", sdk.String("AUTO"), validation.StringIsNotEmpty,
+			func(o *sdk.FileFormatTypeOptions, v string) { o.JSONTimeFormat = sdk.String(v) },
+			func(o *sdk.FileFormatTypeOptions) *string { return o.JSONTimeFormat }),
+		"timestamp_format": fileFormatStringOption("timestamp_format", "AUTO", sdk.String("AUTO"), validation.StringIsNotEmpty,
+			func(o *sdk.FileFormatTypeOptions, v string) { o.JSONTimestampFormat = sdk.String(v) },
+			func(o *sdk.FileFormatTypeOptions) *string { return o.JSONTimestampFormat }),
+		"binary_format": fileFormatStringOption("binary_format", "HEX", sdk.String("HEX"), validation.StringInSlice(fileFormatBinaryFormats, false),
+			func(o *sdk.FileFormatTypeOptions, v string) { o.JSONBinaryFormat = sdk.Pointer(sdk.BinaryFormat(v)) },
+			func(o *sdk.FileFormatTypeOptions) *string { return (*string)(o.JSONBinaryFormat) }),
+		"trim_space": fileFormatBoolOption("trim_space", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.JSONTrimSpace = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.JSONTrimSpace }),
+		"null_if": fileFormatNullIfOption(
+			func(o *sdk.FileFormatTypeOptions, v []sdk.NullString) { o.JSONNullIf = v },
+			func(o *sdk.FileFormatTypeOptions) []sdk.NullString { return o.JSONNullIf }),
+		"file_extension": fileFormatStringOption("file_extension", "", nil, validation.StringIsNotEmpty,
+			func(o *sdk.FileFormatTypeOptions, v string) { o.JSONFileExtension = sdk.String(v) },
+			func(o *sdk.FileFormatTypeOptions) *string { return o.JSONFileExtension }),
+		"enable_octal": fileFormatBoolOption("enable_octal", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.JSONEnableOctal = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.JSONEnableOctal }),
+		"allow_duplicate": fileFormatBoolOption("allow_duplicate", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.JSONAllowDuplicate = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.JSONAllowDuplicate }),
+		"strip_outer_array": fileFormatBoolOption("strip_outer_array", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.JSONStripOuterArray = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.JSONStripOuterArray }),
+		"strip_null_values": fileFormatBoolOption("strip_null_values", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.JSONStripNullValues = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.JSONStripNullValues }),
+		"replace_invalid_characters": fileFormatBoolOption("replace_invalid_characters", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.JSONReplaceInvalidCharacters = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.JSONReplaceInvalidCharacters }),
+		"ignore_utf8_errors": fileFormatBoolOption("ignore_utf8_errors", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.JSONIgnoreUTF8Errors = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.JSONIgnoreUTF8Errors }),
+		"skip_byte_order_mark": fileFormatBoolOption("skip_byte_order_mark", true,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.JSONSkipByteOrderMark = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.JSONSkipByteOrderMark }),
+	},
+	sdk.FileFormatTypeAvro: {
+		"compression": fileFormatStringOption("compression", "AUTO", sdk.String("AUTO"), validation.StringInSlice(fileFormatCompressionsAvro, false),
+			func(o *sdk.FileFormatTypeOptions, v string) { o.AvroCompression = sdk.Pointer(sdk.AvroCompression(v)) },
+			func(o *sdk.FileFormatTypeOptions) *string { return (*string)(o.AvroCompression) }),
+		"trim_space": fileFormatBoolOption("trim_space", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.AvroTrimSpace = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.AvroTrimSpace }),
+		"replace_invalid_characters": fileFormatBoolOption("replace_invalid_characters", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.AvroReplaceInvalidCharacters = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.AvroReplaceInvalidCharacters }),
+		"null_if": fileFormatNullIfOption(
+			func(o *sdk.FileFormatTypeOptions, v []sdk.NullString) { o.AvroNullIf = &v },
+			func(o *sdk.FileFormatTypeOptions) []sdk.NullString {
+				if o.AvroNullIf == nil {
+					return nil
+				}
+				return *o.AvroNullIf
+			}),
+	},
+	sdk.FileFormatTypeORC: {
+		"trim_space": fileFormatBoolOption("trim_space", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.ORCTrimSpace = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.ORCTrimSpace }),
+		"replace_invalid_characters": fileFormatBoolOption("replace_invalid_characters", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.ORCReplaceInvalidCharacters = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.ORCReplaceInvalidCharacters }),
+		"null_if": fileFormatNullIfOption(
+			func(o *sdk.FileFormatTypeOptions, v []sdk.NullString) { o.ORCNullIf = &v },
+			func(o *sdk.FileFormatTypeOptions) []sdk.NullString {
+				if o.ORCNullIf == nil {
+					return nil
+				}
+				return *o.ORCNullIf
+			}),
+	},
+	sdk.FileFormatTypeParquet: {
+		"compression": fileFormatStringOption("compression", "AUTO", sdk.String("AUTO"), validation.StringInSlice(fileFormatCompressionsParquet, false),
+			func(o *sdk.FileFormatTypeOptions, v string) {
+				o.ParquetCompression = sdk.Pointer(sdk.ParquetCompression(v))
+			},
+			func(o *sdk.FileFormatTypeOptions) *string { return (*string)(o.ParquetCompression) }),
+		"binary_as_text": fileFormatBoolOption("binary_as_text", true,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.ParquetBinaryAsText = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.ParquetBinaryAsText }),
+		"trim_space": fileFormatBoolOption("trim_space", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.ParquetTrimSpace = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.ParquetTrimSpace }),
+		"replace_invalid_characters": fileFormatBoolOption("replace_invalid_characters", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.ParquetReplaceInvalidCharacters = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.ParquetReplaceInvalidCharacters }),
+		"null_if": fileFormatNullIfOption(
+			func(o *sdk.FileFormatTypeOptions, v []sdk.NullString) { o.ParquetNullIf = &v },
+			func(o *sdk.FileFormatTypeOptions) []sdk.NullString {
+				if o.ParquetNullIf == nil {
+					return nil
+				}
+				return *o.ParquetNullIf
+			}),
+	},
+	sdk.FileFormatTypeXML: {
+		"compression": fileFormatStringOption("compression", "AUTO", sdk.String("AUTO"), validation.StringInSlice(fileFormatCompressionsXML, false),
+			func(o *sdk.FileFormatTypeOptions, v string) { o.XMLCompression = sdk.Pointer(sdk.XMLCompression(v)) },
+			func(o *sdk.FileFormatTypeOptions) *string { return (*string)(o.XMLCompression) }),
+		"ignore_utf8_errors": fileFormatBoolOption("ignore_utf8_errors", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.XMLIgnoreUTF8Errors = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.XMLIgnoreUTF8Errors }),
+		"preserve_space": fileFormatBoolOption("preserve_space", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.XMLPreserveSpace = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.XMLPreserveSpace }),
+		"strip_outer_element": fileFormatBoolOption("strip_outer_element", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.XMLStripOuterElement = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.XMLStripOuterElement }),
+		"disable_snowflake_data": fileFormatBoolOption("disable_snowflake_data", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.XMLDisableSnowflakeData = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.XMLDisableSnowflakeData }),
+		"disable_auto_convert": fileFormatBoolOption("disable_auto_convert", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.XMLDisableAutoConvert = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.XMLDisableAutoConvert }),
+		"replace_invalid_characters": fileFormatBoolOption("replace_invalid_characters", false,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.XMLReplaceInvalidCharacters = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.XMLReplaceInvalidCharacters }),
+		"skip_byte_order_mark": fileFormatBoolOption("skip_byte_order_mark", true,
+			func(o *sdk.FileFormatTypeOptions, v bool) { o.XMLSkipByteOrderMark = sdk.Bool(v) },
+			func(o *sdk.FileFormatTypeOptions) *bool { return o.XMLSkipByteOrderMark }),
+	},
+}
+
+// fileFormatTypedResourceName returns the name of the typed file format resource, e.g. snowflake_file_format_csv.
+func fileFormatTypedResourceName(formatType sdk.FileFormatType) string {
+	return "snowflake_file_format_" + strings.ToLower(string(formatType))
+}
+
+// FileFormatCSV returns a pointer to the resource representing a CSV file format.
+func FileFormatCSV() *schema.Resource {
+	return fileFormatForType(sdk.FileFormatTypeCSV)
+}
+
+// FileFormatJSON returns a pointer to the resource representing a JSON file format.
+func FileFormatJSON() *schema.Resource {
+	return fileFormatForType(sdk.FileFormatTypeJSON)
+}
+
+// FileFormatAvro returns a pointer to the resource representing an AVRO file format.
+func FileFormatAvro() *schema.Resource {
+	return fileFormatForType(sdk.FileFormatTypeAvro)
+}
+
+// FileFormatORC returns a pointer to the resource representing an ORC file format.
+func FileFormatORC() *schema.Resource {
+	return fileFormatForType(sdk.FileFormatTypeORC)
+}
+
+// FileFormatParquet returns a pointer to the resource representing a PARQUET file format.
+func FileFormatParquet() *schema.Resource {
+	return fileFormatForType(sdk.FileFormatTypeParquet)
+}
+
+// FileFormatXML returns a pointer to the resource representing an XML file format.
+func FileFormatXML() *schema.Resource {
+	return fileFormatForType(sdk.FileFormatTypeXML)
+}
+
+func fileFormatForTypeSchema(formatType sdk.FileFormatType) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Specifies the identifier for the file format; must be unique for the database and schema in which the file format is created. Renaming is applied in place.",
+		},
+		"database": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The database in which to create the file format.",
+		},
+		"schema": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The schema in which to create the file format.",
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies a comment for the file format.",
+		},
+		"qualified_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Fully qualified name of the file format.",
+		},
+	}
+	for key, option := range fileFormatTypedOptions[formatType] {
+		s[key] = option.schema
+	}
+	return s
+}
+
+func fileFormatForType(formatType sdk.FileFormatType) *schema.Resource {
+	read := readFileFormatForType(formatType)
+	return &schema.Resource{
+		Description: fmt.Sprintf("Resource used to manage %s file formats. Only the options of the %s format type are accepted, and the options left out of the configuration fall back to the Snowflake defaults without a difference. For more information, check [file format documentation](https://docs.snowflake.com/en/sql-reference/sql/create-file-format).", formatType, formatType),
+
+		CreateContext: createFileFormatForType(formatType, read),
+		ReadContext:   read,
+		UpdateContext: updateFileFormatForType(formatType, read),
+		DeleteContext: DeleteFileFormatForType,
+		CustomizeDiff: customizeFileFormatForTypeDiff(formatType),
+
+		Schema: fileFormatForTypeSchema(formatType),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func createFileFormatForType(formatType sdk.FileFormatType, read schema.ReadContextFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := sdk.NewClientFromDB(meta.(*sql.DB))
+		id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+		opts := &sdk.CreateFileFormatOptions{Type: formatType}
+		for key, option := range fileFormatTypedOptions[formatType] {
+			if v, ok := d.GetOk(key); ok {
+				option.expand(&opts.FileFormatTypeOptions, v)
+			}
+		}
+		if v, ok := d.GetOk("comment"); ok {
+			opts.Comment = sdk.String(v.(string))
+		}
+
+		if err := client.FileFormats.Create(ctx, id, opts); err != nil {
+			return diag.FromErr(fmt.Errorf("error creating file format %s: %w", id.FullyQualifiedName(), err))
+		}
+		d.SetId(helpers.EncodeSnowflakeID(id))
+
+		return read(ctx, d, meta)
+	}
+}
+
+// fileFormatTypedStateValue returns the state representation of the value shown by Snowflake. The value equal to the
+// server default is only kept when it is already in the state, i.e. when it was set explicitly, so that the options
+// left out of the configuration do not diff.
+func fileFormatTypedStateValue(option fileFormatTypedOption, shown any, current any) any {
+	zero := option.schema.ZeroValue()
+	if shown == nil {
+		return zero
+	}
+	if reflect.DeepEqual(normalizeFileFormatTypedValue(shown), option.serverDefault) && isFileFormatTypedValueZero(current) {
+		return zero
+	}
+	return shown
+}
+
+func normalizeFileFormatTypedValue(v any) any {
+	if values, ok := v.([]any); ok {
+		return expandStringList(values)
+	}
+	return v
+}
+
+func isFileFormatTypedValueZero(v any) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case []any:
+		return len(value) == 0
+	case []string:
+		return len(value) == 0
+	default:
+		return reflect.ValueOf(v).IsZero()
+	}
+}
+
+func readFileFormatForType(formatType sdk.FileFormatType) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := sdk.NewClientFromDB(meta.(*sql.DB))
+		id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+		fileFormat, err := client.FileFormats.ShowByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+				log.Printf("[DEBUG] file format (%s) not found", d.Id())
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+		if fileFormat.Type != formatType {
+			return diag.Errorf("file format %s has type %s, which cannot be managed by the %s resource", id.FullyQualifiedName(), fileFormat.Type, fileFormatTypedResourceName(formatType))
+		}
+
+		values := map[string]any{
+			"name":           fileFormat.Name.Name(),
+			"database":       fileFormat.Name.DatabaseName(),
+			"schema":         fileFormat.Name.SchemaName(),
+			"comment":        fileFormat.Comment,
+			"qualified_name": id.FullyQualifiedName(),
+		}
+		for key, value := range values {
+			if err := d.Set(key, value); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		for key, option := range fileFormatTypedOptions[formatType] {
+			if err := d.Set(key, fileFormatTypedStateValue(option, option.flatten(&fileFormat.Options), d.Get(key))); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		return nil
+	}
+}
+
+func updateFileFormatForType(formatType sdk.FileFormatType, read schema.ReadContextFunc) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := sdk.NewClientFromDB(meta.(*sql.DB))
+		id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+		if d.HasChange("name") {
+			newId := sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), d.Get("name").(string))
+			err := client.FileFormats.Alter(ctx, id, &sdk.AlterFileFormatOptions{
+				Rename: &sdk.AlterFileFormatRenameOptions{NewName: newId},
+			})
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error renaming file format %s: %w", id.FullyQualifiedName(), err))
+			}
+			d.SetId(helpers.EncodeSnowflakeID(newId))
+			id = newId
+		}
+
+		// ALTER FILE FORMAT cannot unset the options, so the ones removed from the configuration are set to the defaults.
+		set := &sdk.FileFormatTypeOptions{}
+		runSet := false
+		for key, option := range fileFormatTypedOptions[formatType] {
+			if !d.HasChange(key) {
+				continue
+			}
+			v, ok := d.GetOk(key)
+			if !ok {
+				if option.reset == nil {
+					continue
+				}
+				v = option.reset
+			}
+			option.expand(set, v)
+			runSet = true
+		}
+		if d.HasChange("comment") {
+			set.Comment = sdk.String(d.Get("comment").(string))
+			runSet = true
+		}
+		if runSet {
+			if err := client.FileFormats.Alter(ctx, id, &sdk.AlterFileFormatOptions{Set: set}); err != nil {
+				return diag.FromErr(fmt.Errorf("error updating file format %s: %w", id.FullyQualifiedName(), err))
+			}
+		}
+
+		return read(ctx, d, meta)
+	}
+}
+
+// DeleteFileFormatForType implements schema.DeleteContextFunc.
+func DeleteFileFormatForType(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := sdk.NewClientFromDB(meta.(*sql.DB))
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.FileFormats.Drop(ctx, id, &sdk.DropFileFormatOptions{IfExists: sdk.Bool(true)}); err != nil {
+		return diag.FromErr(fmt.Errorf("error dropping file format %s: %w", id.FullyQualifiedName(), err))
+	}
+	d.SetId("")
+	return nil
+}
+
+// customizeFileFormatForTypeDiff rejects the conflicting options at plan time and recreates the file format when an
+// option which cannot be reset is removed from the configuration.
+func customizeFileFormatForTypeDiff(formatType sdk.FileFormatType) schema.CustomizeDiffFunc {
+	options := fileFormatTypedOptions[formatType]
+	funcs := make([]schema.CustomizeDiffFunc, 0)
+	for key, option := range options {
+		if option.reset != nil {
+			continue
+		}
+		funcs = append(funcs, customdiff.ForceNewIfChange(key, func(ctx context.Context, old, new, meta any) bool {
+			return !isFileFormatTypedValueZero(old) && isFileFormatTypedValueZero(new)
+		}))
+	}
+	if _, ok := options["parse_header"]; ok {
+		funcs = append(funcs, func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+			if d.Get("parse_header").(string) == "true" && d.Get("skip_header").(int) > 0 {
+				return fmt.Errorf("skip_header cannot be set when parse_header is true")
+			}
+			return nil
+		})
+	}
+	if _, ok := options["ignore_utf8_errors"]; ok {
+		funcs = append(funcs, func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+			if d.Get("ignore_utf8_errors").(string) == "true" && d.Get("replace_invalid_characters").(string) == "true" {
+				return fmt.Errorf("ignore_utf8_errors and replace_invalid_characters cannot be both true")
+			}
+			return nil
+		})
+	}
+	return customdiff.All(funcs...)
+}
@@ -0,0 +1,239 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_FileFormatTypedCSV(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	newName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_file_format_csv.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			// options left out fall back to the Snowflake defaults without a difference
+			{
+				Config: fileFormatTypedCSVConfig(name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "qualified_name", fmt.Sprintf(`"%s"."%s"."%s"`, acc.TestDatabaseName, acc.TestSchemaName, name)),
+					resource.TestCheckResourceAttr(resourceName, "compression", ""),
+					resource.TestCheckResourceAttr(resourceName, "skip_header", "0"),
+					resource.TestCheckResourceAttr(resourceName, "null_if.#", "0"),
+				),
+			},
+			{
+				Config: fileFormatTypedCSVConfig(name, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// options are set in place, including the values equal to the defaults
+			{
+				Config: fileFormatTypedCSVConfig(newName, `
+	compression = "GZIP"
+	field_delimiter = "|"
+	skip_header = 1
+	trim_space = "false"
+	field_optionally_enclosed_by = "\""
+	null_if = ["NULL", ""]
+	comment = "csv"
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", newName),
+					resource.TestCheckResourceAttr(resourceName, "compression", "GZIP"),
+					resource.TestCheckResourceAttr(resourceName, "field_delimiter", "|"),
+					resource.TestCheckResourceAttr(resourceName, "skip_header", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trim_space", "false"),
+					resource.TestCheckResourceAttr(resourceName, "field_optionally_enclosed_by", `"`),
+					resource.TestCheckResourceAttr(resourceName, "null_if.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "csv"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"trim_space"},
+			},
+			// removed options are set back to the defaults, except for the ones which cannot be reset
+			{
+				Config: fileFormatTypedCSVConfig(newName, `
+	field_optionally_enclosed_by = "\""
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "compression", ""),
+					resource.TestCheckResourceAttr(resourceName, "skip_header", "0"),
+					resource.TestCheckResourceAttr(resourceName, "null_if.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+				),
+			},
+			{
+				Config: fileFormatTypedCSVConfig(newName, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(resourceName, "field_optionally_enclosed_by", ""),
+			},
+			// conflicting options are rejected at plan time
+			{
+				Config: fileFormatTypedCSVConfig(newName, `
+	parse_header = "true"
+	skip_header = 1
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("skip_header cannot be set when parse_header is true"),
+			},
+		},
+	})
+}
+
+func TestAcc_FileFormatTypedParquet(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_file_format_parquet.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: fileFormatTypedParquetConfig(name, `
+	compression = "SNAPPY"
+	binary_as_text = "false"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "compression", "SNAPPY"),
+					resource.TestCheckResourceAttr(resourceName, "binary_as_text", "false"),
+					resource.TestCheckResourceAttr(resourceName, "trim_space", ""),
+				),
+			},
+			{
+				Config: fileFormatTypedParquetConfig(name, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "compression", ""),
+					resource.TestCheckResourceAttr(resourceName, "binary_as_text", ""),
+				),
+			},
+			// options of other format types are not accepted
+			{
+				Config: fileFormatTypedParquetConfig(name, `
+	field_delimiter = "|"
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`An argument named "field_delimiter" is not expected here`),
+			},
+		},
+	})
+}
+
+func TestAcc_FileFormatTypedCSV_MovedFromFileFormat(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_8_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "snowflake_file_format" "test" {
+	database = "%s"
+	schema = "%s"
+	name = "%s"
+	format_type = "CSV"
+	field_delimiter = "|"
+	skip_header = 1
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name),
+			},
+			{
+				Config: fmt.Sprintf(`
+moved {
+	from = snowflake_file_format.test
+	to = snowflake_file_format_csv.test
+}
+%s`, fileFormatTypedCSVConfig(name, `
+	field_delimiter = "|"
+	skip_header = 1
+`)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_file_format_csv.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_file_format_csv.test", "field_delimiter", "|"),
+					resource.TestCheckResourceAttr("snowflake_file_format_csv.test", "skip_header", "1"),
+					resource.TestCheckResourceAttr("snowflake_file_format_csv.test", "compression", ""),
+				),
+			},
+		},
+	})
+}
+
+func fileFormatTypedCSVConfig(name string, options string) string {
+	return fmt.Sprintf(`
+resource "snowflake_file_format_csv" "test" {
+	database = "%s"
+	schema = "%s"
+	name = "%s"
+%s}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, options)
+}
+
+func fileFormatTypedParquetConfig(name string, options string) string {
+	return fmt.Sprintf(`
+resource "snowflake_file_format_parquet" "test" {
+	database = "%s"
+	schema = "%s"
+	name = "%s"
+%s}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, options)
+}
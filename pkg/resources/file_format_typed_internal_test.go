@@ -0,0 +1,137 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileFormatForTypeSchema(t *testing.T) {
+	csv := fileFormatForTypeSchema(sdk.FileFormatTypeCSV)
+	assert.Contains(t, csv, "field_delimiter")
+	assert.Contains(t, csv, "skip_header")
+	assert.NotContains(t, csv, "format_type")
+	assert.NotContains(t, csv, "strip_outer_array")
+	assert.NotContains(t, csv, "binary_as_text")
+
+	parquet := fileFormatForTypeSchema(sdk.FileFormatTypeParquet)
+	assert.Contains(t, parquet, "binary_as_text")
+	assert.NotContains(t, parquet, "field_delimiter")
+	assert.NotContains(t, parquet, "preserve_space")
+
+	for formatType, options := range fileFormatTypedOptions {
+		for key := range options {
+			assert.Containsf(t, fileFormatSchema, key, "%s option of the %s format type is not described in the generic schema", key, formatType)
+		}
+	}
+}
+
+func TestFileFormatTypedStateValue(t *testing.T) {
+	options := fileFormatTypedOptions[sdk.FileFormatTypeCSV]
+
+	t.Run("server default left out of the configuration", func(t *testing.T) {
+		assert.Equal(t, "", fileFormatTypedStateValue(options["compression"], "AUTO", ""))
+		assert.Equal(t, "", fileFormatTypedStateValue(options["trim_space"], "false", ""))
+		assert.Equal(t, 0, fileFormatTypedStateValue(options["skip_header"], 0, 0))
+		assert.Equal(t, []any{}, fileFormatTypedStateValue(options["null_if"], []string{`\N`}, []any{}))
+	})
+
+	t.Run("server default set explicitly", func(t *testing.T) {
+		assert.Equal(t, "AUTO", fileFormatTypedStateValue(options["compression"], "AUTO", "AUTO"))
+		assert.Equal(t, "false", fileFormatTypedStateValue(options["trim_space"], "false", "false"))
+		assert.Equal(t, []string{`\N`}, fileFormatTypedStateValue(options["null_if"], []string{`\N`}, []any{`\N`}))
+	})
+
+	t.Run("value different from the server default", func(t *testing.T) {
+		assert.Equal(t, "GZIP", fileFormatTypedStateValue(options["compression"], "GZIP", ""))
+		assert.Equal(t, "true", fileFormatTypedStateValue(options["trim_space"], "true", ""))
+		assert.Equal(t, 1, fileFormatTypedStateValue(options["skip_header"], 1, 0))
+		assert.Equal(t, []string{"NULL"}, fileFormatTypedStateValue(options["null_if"], []string{"NULL"}, []any{}))
+	})
+
+	t.Run("value not shown", func(t *testing.T) {
+		assert.Equal(t, "", fileFormatTypedStateValue(options["escape"], nil, "|"))
+	})
+}
+
+func TestFileFormatForTypeStateUpgrader(t *testing.T) {
+	upgrader, ok := FileFormatMoveStateUpgrader("snowflake_file_format_csv")
+	require.True(t, ok)
+
+	rawState := map[string]interface{}{
+		"id":                             "db|schema|format",
+		"name":                           "format",
+		"database":                       "db",
+		"schema":                         "schema",
+		"comment":                        "comment",
+		"format_type":                    "CSV",
+		"compression":                    "AUTO",
+		"field_delimiter":                "|",
+		"skip_header":                    float64(1),
+		"trim_space":                     false,
+		"error_on_column_count_mismatch": false,
+		"escape":                         "NONE",
+		"null_if":                        []interface{}{`\N`},
+		"strip_outer_array":              false,
+		"binary_as_text":                 true,
+	}
+	upgraded, err := upgrader(context.Background(), rawState, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"id":                             "db|schema|format",
+		"name":                           "format",
+		"database":                       "db",
+		"schema":                         "schema",
+		"comment":                        "comment",
+		"qualified_name":                 `"db"."schema"."format"`,
+		"field_delimiter":                "|",
+		"skip_header":                    1,
+		"error_on_column_count_mismatch": "false",
+	}, upgraded)
+
+	t.Run("different format type", func(t *testing.T) {
+		upgrader, ok := FileFormatMoveStateUpgrader("snowflake_file_format_parquet")
+		require.True(t, ok)
+		_, err := upgrader(context.Background(), rawState, nil)
+		require.ErrorContains(t, err, `file format with format_type "CSV" cannot be moved to snowflake_file_format_parquet`)
+	})
+
+	t.Run("unknown resource", func(t *testing.T) {
+		_, ok := FileFormatMoveStateUpgrader("snowflake_file_format")
+		assert.False(t, ok)
+	})
+}
+
+func TestReadFileFormatForTypeErrors(t *testing.T) {
+	id := sdk.NewSchemaObjectIdentifier("db", "schema", "format")
+	query := `SHOW FILE FORMATS LIKE 'format' IN SCHEMA "db"."schema"`
+	read := readFileFormatForType(sdk.FileFormatTypeCSV)
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	// a file format missing from SHOW is removed from the state
+	d := schema.TestResourceDataRaw(t, fileFormatForTypeSchema(sdk.FileFormatTypeCSV), map[string]any{})
+	d.SetId(helpers.EncodeSnowflakeID(id))
+	mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"created_on", "name"}))
+	diags := read(context.Background(), d, db)
+	require.False(t, diags.HasError())
+	assert.Empty(t, d.Id())
+
+	// any other error is returned and the file format stays in the state
+	d = schema.TestResourceDataRaw(t, fileFormatForTypeSchema(sdk.FileFormatTypeCSV), map[string]any{})
+	d.SetId(helpers.EncodeSnowflakeID(id))
+	mock.ExpectQuery(query).WillReturnError(errors.New("warehouse is suspended"))
+	diags = read(context.Background(), d, db)
+	require.True(t, diags.HasError())
+	assert.Equal(t, helpers.EncodeSnowflakeID(id), d.Id())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fileFormatForTypeStateUpgrader converts the state of the generic snowflake_file_format resource into the state of the
+// typed resource of the given format type. The options of other format types are dropped, booleans become strings and
+// the values equal to the Snowflake defaults are left out, the same way Read leaves them out for a new resource.
+func fileFormatForTypeStateUpgrader(formatType sdk.FileFormatType) schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if rawState == nil {
+			return rawState, nil
+		}
+
+		if sourceType, _ := rawState["format_type"].(string); !strings.EqualFold(sourceType, string(formatType)) {
+			return nil, fmt.Errorf("file format with format_type %q cannot be moved to %s", sourceType, fileFormatTypedResourceName(formatType))
+		}
+
+		id, _ := rawState["id"].(string)
+		qualifiedName := ""
+		if objectId, ok := helpers.DecodeSnowflakeID(id).(sdk.SchemaObjectIdentifier); ok {
+			qualifiedName = objectId.FullyQualifiedName()
+		}
+		upgraded := map[string]interface{}{
+			"id":             id,
+			"name":           rawState["name"],
+			"database":       rawState["database"],
+			"schema":         rawState["schema"],
+			"comment":        rawState["comment"],
+			"qualified_name": qualifiedName,
+		}
+
+		for key, option := range fileFormatTypedOptions[formatType] {
+			var value any
+			switch v := rawState[key].(type) {
+			case bool:
+				value = strconv.FormatBool(v)
+			case float64:
+				value = int(v)
+			case []interface{}:
+				value = expandStringList(v)
+			default:
+				value = v
+			}
+			if value == "" || value == nil {
+				continue
+			}
+			if value = fileFormatTypedStateValue(option, value, nil); !isFileFormatTypedValueZero(value) {
+				upgraded[key] = value
+			}
+		}
+		return upgraded, nil
+	}
+}
+
+// FileFormatMoveStateUpgrader returns the function converting the state of the generic snowflake_file_format resource
+// into the state of the given typed file format resource, e.g. snowflake_file_format_csv. It is used when the resource
+// is moved with the moved block.
+func FileFormatMoveStateUpgrader(targetTypeName string) (schema.StateUpgradeFunc, bool) {
+	for formatType := range fileFormatTypedOptions {
+		if fileFormatTypedResourceName(formatType) == targetTypeName {
+			return fileFormatForTypeStateUpgrader(formatType), true
+		}
+	}
+	return nil, false
+}
@@ -155,6 +155,7 @@ var functionSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 		Description: "The target path for the Java / Python functions. For Java, it is the path of compiled jar files and for the Python it is the path of the Python files.",
 	},
+	"statement_comparison": statementComparisonSchema,
 }
 
 // Function returns a pointer to the resource representing a stored function.
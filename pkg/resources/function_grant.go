@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -111,10 +112,10 @@ var functionGrantSchema = map[string]*schema.Schema{
 func FunctionGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateFunctionGrant,
-			Read:               ReadFunctionGrant,
-			Delete:             DeleteFunctionGrant,
-			Update:             UpdateFunctionGrant,
+			CreateContext:      CreateFunctionGrant,
+			ReadContext:        ReadFunctionGrant,
+			DeleteContext:      DeleteFunctionGrant,
+			UpdateContext:      UpdateFunctionGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             functionGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -164,8 +165,8 @@ func FunctionGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateFunctionGrant implements schema.CreateFunc.
-func CreateFunctionGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateFunctionGrant implements schema.CreateContextFunc.
+func CreateFunctionGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionName := d.Get("function_name").(string)
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -190,10 +191,10 @@ func CreateFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 	shares := expandStringList(d.Get("shares").(*schema.Set).List())
 
 	if (functionName == "") && !onFuture && !onAll {
-		return errors.New("function_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("function_name must be set unless on_future or on_all is true"))
 	}
 	if (schemaName == "") && !onFuture && !onAll {
-		return errors.New("schema_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future or on_all is true"))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -206,16 +207,16 @@ func CreateFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.FunctionGrant(databaseName, schemaName, functionName, argumentDataTypes)
 	}
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, functionName, argumentDataTypes, privilege, withGrantOption, onFuture, onAll, roles, shares)
 	d.SetId(grantID)
-	return ReadFunctionGrant(d, meta)
+	return ReadFunctionGrant(ctx, d, meta)
 }
 
-// ReadFunctionGrant implements schema.ReadFunc.
-func ReadFunctionGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadFunctionGrant implements schema.ReadContextFunc.
+func ReadFunctionGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	functionName := d.Get("function_name").(string)
@@ -237,9 +238,9 @@ func ReadFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.FunctionGrant(databaseName, schemaName, functionName, argumentDataTypes)
 	}
 
-	err := readGenericGrant(d, meta, functionGrantSchema, builder, onFuture, onAll, validFunctionPrivileges)
+	err := readGenericGrant(ctx, d, meta, functionGrantSchema, builder, onFuture, onAll, validFunctionPrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, functionName, argumentDataTypes, privilege, withGrantOption, onFuture, onAll, roles, shares)
 	if grantID != d.Id() {
@@ -248,8 +249,8 @@ func ReadFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteFunctionGrant implements schema.DeleteFunc.
-func DeleteFunctionGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteFunctionGrant implements schema.DeleteContextFunc.
+func DeleteFunctionGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	functionName := d.Get("function_name").(string)
@@ -267,11 +268,11 @@ func DeleteFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.FunctionGrant(databaseName, schemaName, functionName, argumentDataTypes)
 	}
 
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateFunctionGrant implements schema.UpdateFunc.
-func UpdateFunctionGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateFunctionGrant implements schema.UpdateContextFunc.
+func UpdateFunctionGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles", "shares") {
@@ -311,17 +312,17 @@ func UpdateFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, privilege, reversionRole, rolesToRevoke, sharesToRevoke,
+		ctx, meta, builder, privilege, reversionRole, rolesToRevoke, sharesToRevoke,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, privilege, withGrantOption, rolesToAdd, sharesToAdd,
+		ctx, meta, builder, privilege, withGrantOption, rolesToAdd, sharesToAdd,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadFunctionGrant(d, meta)
+	return ReadFunctionGrant(ctx, d, meta)
 }
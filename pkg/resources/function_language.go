@@ -0,0 +1,451 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// defaultFunctionDescription is returned by SHOW FUNCTIONS for the functions without a comment.
+const defaultFunctionDescription = "user-defined function"
+
+// FunctionJava returns a pointer to the resource representing a function with a Java handler.
+func FunctionJava() *schema.Resource {
+	return functionForLanguage(routineLanguageJava)
+}
+
+// FunctionJavascript returns a pointer to the resource representing a function written in JavaScript.
+func FunctionJavascript() *schema.Resource {
+	return functionForLanguage(routineLanguageJavascript)
+}
+
+// FunctionPython returns a pointer to the resource representing a function with a Python handler.
+func FunctionPython() *schema.Resource {
+	return functionForLanguage(routineLanguagePython)
+}
+
+// FunctionScala returns a pointer to the resource representing a function with a Scala handler.
+func FunctionScala() *schema.Resource {
+	return functionForLanguage(routineLanguageScala)
+}
+
+// FunctionSql returns a pointer to the resource representing a function written in SQL.
+func FunctionSql() *schema.Resource {
+	return functionForLanguage(routineLanguageSQL)
+}
+
+func functionForLanguageSchema(language routineLanguage) map[string]*schema.Schema {
+	s := routineSchema("function", language, "function_definition")
+	if language != routineLanguageSQL {
+		s["null_input_behavior"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      routineNullInputBehaviors[0],
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(routineNullInputBehaviors, false),
+			Description:  fmt.Sprintf("Specifies the behavior of the function when called with null inputs. Valid values are: %s", strings.Join(routineNullInputBehaviors, " | ")),
+		}
+	}
+	s["return_results_behavior"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      string(sdk.ReturnResultsBehaviorVolatile),
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice([]string{string(sdk.ReturnResultsBehaviorVolatile), string(sdk.ReturnResultsBehaviorImmutable)}, false),
+		Description:  "Specifies the behavior of the function when returning results. Valid values are: VOLATILE | IMMUTABLE",
+	}
+	return s
+}
+
+func functionForLanguage(language routineLanguage) *schema.Resource {
+	functionSchema := functionForLanguageSchema(language)
+	read := readFunctionForLanguage(functionSchema)
+	return &schema.Resource{
+		Description: fmt.Sprintf("Resource used to manage functions written in %s. For more information, check [function documentation](https://docs.snowflake.com/en/sql-reference/sql/create-function).", language.displayName()),
+
+		CreateContext: createFunctionForLanguage(language, read),
+		ReadContext:   read,
+		UpdateContext: updateFunctionForLanguage(functionSchema, read),
+		DeleteContext: DeleteFunctionForLanguage,
+		CustomizeDiff: customizeRoutineDiff(language, "function_definition"),
+
+		Schema: functionSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func createFunctionForLanguage(language routineLanguage, read schema.ReadContextFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := sdk.NewClientFromDB(meta.(*sql.DB))
+		id, err := routineIdentifier(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := createFunction(ctx, client, d, language, id.WithoutArguments()); err != nil {
+			return diag.Errorf("error creating function %s, err = %s", id.FullyQualifiedName(), err)
+		}
+		d.SetId(id.FullyQualifiedName())
+
+		if err := alterFunctionLevels(ctx, client, d, id, true); err != nil {
+			return diag.FromErr(err)
+		}
+		return read(ctx, d, meta)
+	}
+}
+
+func createFunction(ctx context.Context, client *sdk.Client, d *schema.ResourceData, language routineLanguage, id sdk.SchemaObjectIdentifier) error {
+	returns, err := functionReturns(d.Get("return_type").(string))
+	if err != nil {
+		return err
+	}
+	arguments := functionArguments(d)
+	definition := d.Get("function_definition").(string)
+	secure := sdk.Bool(d.Get("is_secure").(bool))
+	returnResultsBehavior := sdk.Pointer(sdk.ReturnResultsBehavior(d.Get("return_results_behavior").(string)))
+	var comment *string
+	if v := d.Get("comment").(string); v != "" {
+		comment = sdk.String(v)
+	}
+	var nullInputBehavior *sdk.NullInputBehavior
+	if v, ok := d.GetOk("null_input_behavior"); ok {
+		nullInputBehavior = sdk.Pointer(sdk.NullInputBehavior(v.(string)))
+	}
+
+	switch language {
+	case routineLanguageJavascript:
+		request := sdk.NewCreateForJavascriptFunctionRequest(id, *returns, definition).
+			WithSecure(secure).
+			WithArguments(arguments).
+			WithNullInputBehavior(nullInputBehavior).
+			WithReturnResultsBehavior(returnResultsBehavior).
+			WithComment(comment)
+		return client.Functions.CreateForJavascript(ctx, request)
+	case routineLanguageSQL:
+		request := sdk.NewCreateForSQLFunctionRequest(id, *returns, definition).
+			WithSecure(secure).
+			WithArguments(arguments).
+			WithReturnResultsBehavior(returnResultsBehavior).
+			WithComment(comment)
+		return client.Functions.CreateForSQL(ctx, request)
+	}
+
+	secrets, err := getRoutineSecrets(d)
+	if err != nil {
+		return err
+	}
+	handler := d.Get("handler").(string)
+	runtimeVersion := d.Get("runtime_version").(string)
+	imports := functionImports(d)
+	packages := functionPackages(d)
+	integrations := getRoutineExternalAccessIntegrations(d)
+
+	switch language {
+	case routineLanguageJava:
+		request := sdk.NewCreateForJavaFunctionRequest(id, *returns, handler).
+			WithSecure(secure).
+			WithArguments(arguments).
+			WithNullInputBehavior(nullInputBehavior).
+			WithReturnResultsBehavior(returnResultsBehavior).
+			WithComment(comment).
+			WithImports(imports).
+			WithPackages(packages).
+			WithExternalAccessIntegrations(integrations).
+			WithSecrets(secrets)
+		if runtimeVersion != "" {
+			request.WithRuntimeVersion(sdk.String(runtimeVersion))
+		}
+		if v := d.Get("target_path").(string); v != "" {
+			request.WithTargetPath(sdk.String(v))
+		}
+		if definition != "" {
+			request.WithFunctionDefinition(sdk.String(definition))
+		}
+		return client.Functions.CreateForJava(ctx, request)
+	case routineLanguagePython:
+		request := sdk.NewCreateForPythonFunctionRequest(id, *returns, runtimeVersion, handler).
+			WithSecure(secure).
+			WithArguments(arguments).
+			WithNullInputBehavior(nullInputBehavior).
+			WithReturnResultsBehavior(returnResultsBehavior).
+			WithComment(comment).
+			WithImports(imports).
+			WithPackages(packages).
+			WithExternalAccessIntegrations(integrations).
+			WithSecrets(secrets)
+		if v := d.Get("artifact_repository").(string); v != "" {
+			request.WithArtifactRepository(sdk.String(v))
+		}
+		if definition != "" {
+			request.WithFunctionDefinition(sdk.String(definition))
+		}
+		return client.Functions.CreateForPython(ctx, request)
+	case routineLanguageScala:
+		if returns.ResultDataType == nil {
+			return errors.New("scala functions cannot return a table")
+		}
+		request := sdk.NewCreateForScalaFunctionRequest(id, returns.ResultDataType.ResultDataType, handler).
+			WithSecure(secure).
+			WithArguments(arguments).
+			WithNullInputBehavior(nullInputBehavior).
+			WithReturnResultsBehavior(returnResultsBehavior).
+			WithComment(comment).
+			WithImports(imports).
+			WithPackages(packages).
+			WithExternalAccessIntegrations(integrations).
+			WithSecrets(secrets)
+		if runtimeVersion != "" {
+			request.WithRuntimeVersion(sdk.String(runtimeVersion))
+		}
+		if v := d.Get("target_path").(string); v != "" {
+			request.WithTargetPath(sdk.String(v))
+		}
+		if definition != "" {
+			request.WithFunctionDefinition(sdk.String(definition))
+		}
+		return client.Functions.CreateForScala(ctx, request)
+	default:
+		return fmt.Errorf("unsupported function language %s", language)
+	}
+}
+
+func readFunctionForLanguage(functionSchema map[string]*schema.Schema) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := sdk.NewClientFromDB(meta.(*sql.DB))
+		id, err := routineIdentifierFromResourceId(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		details, err := client.Functions.Describe(ctx, sdk.NewDescribeFunctionRequest(id.WithoutArguments(), id.Arguments()))
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+				log.Printf("[DEBUG] function (%s) not found or we are not authorized. Err: %s", id.FullyQualifiedName(), err)
+				d.SetId("")
+				return nil
+			}
+			return diag.Errorf("error describing function %s, err = %s", id.FullyQualifiedName(), err)
+		}
+		properties := make(map[string]string, len(details))
+		for _, detail := range details {
+			properties[detail.Property] = detail.Value
+		}
+
+		if err := d.Set("database", id.DatabaseName()); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("schema", id.SchemaName()); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("name", id.Name()); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := setRoutineDetails(d, functionSchema, "function_definition", properties); err != nil {
+			return diag.FromErr(err)
+		}
+
+		request := sdk.NewShowFunctionRequest().
+			WithIn(&sdk.In{Schema: sdk.NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).
+			WithLike(&sdk.Like{Pattern: sdk.String(id.Name())})
+		functions, err := client.Functions.Show(ctx, request)
+		if err != nil {
+			return diag.Errorf("error listing functions in schema %s.%s, err = %s", id.DatabaseName(), id.SchemaName(), err)
+		}
+		for _, function := range functions {
+			if !routineSignatureMatches(function.Arguments, id) {
+				continue
+			}
+			if err := d.Set("is_secure", function.IsSecure); err != nil {
+				return diag.FromErr(err)
+			}
+			comment := function.Description
+			if comment == defaultFunctionDescription && d.Get("comment").(string) == "" {
+				comment = ""
+			}
+			if err := d.Set("comment", comment); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		return nil
+	}
+}
+
+func updateFunctionForLanguage(functionSchema map[string]*schema.Schema, read schema.ReadContextFunc) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := sdk.NewClientFromDB(meta.(*sql.DB))
+		id, err := routineIdentifierFromResourceId(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		alter := func(configure func(*sdk.AlterFunctionRequest) *sdk.AlterFunctionRequest) error {
+			return client.Functions.Alter(ctx, configure(sdk.NewAlterFunctionRequest(id.WithoutArguments(), id.Arguments())))
+		}
+
+		if d.HasChange("name") {
+			newId := sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), d.Get("name").(string), id.Arguments())
+			if err := alter(func(r *sdk.AlterFunctionRequest) *sdk.AlterFunctionRequest {
+				return r.WithRenameTo(sdk.Pointer(newId.WithoutArguments()))
+			}); err != nil {
+				return diag.Errorf("error renaming function %s to %s, err = %s", id.FullyQualifiedName(), newId.FullyQualifiedName(), err)
+			}
+			d.SetId(newId.FullyQualifiedName())
+			id = newId
+		}
+
+		if d.HasChange("is_secure") {
+			if err := alter(func(r *sdk.AlterFunctionRequest) *sdk.AlterFunctionRequest {
+				if d.Get("is_secure").(bool) {
+					return r.WithSetSecure(sdk.Bool(true))
+				}
+				return r.WithUnsetSecure(sdk.Bool(true))
+			}); err != nil {
+				return diag.Errorf("error updating secure of function %s, err = %s", id.FullyQualifiedName(), err)
+			}
+		}
+
+		if d.HasChange("comment") {
+			if err := alter(func(r *sdk.AlterFunctionRequest) *sdk.AlterFunctionRequest {
+				if v := d.Get("comment").(string); v != "" {
+					return r.WithSetComment(sdk.String(v))
+				}
+				return r.WithUnsetComment(sdk.Bool(true))
+			}); err != nil {
+				return diag.Errorf("error updating comment of function %s, err = %s", id.FullyQualifiedName(), err)
+			}
+		}
+
+		// removing all the integrations or secrets recreates the function (see customizeRoutineDiff)
+		if _, ok := functionSchema["external_access_integrations"]; ok && d.HasChange("external_access_integrations") {
+			if integrations := getRoutineExternalAccessIntegrations(d); len(integrations) > 0 {
+				if err := alter(func(r *sdk.AlterFunctionRequest) *sdk.AlterFunctionRequest {
+					return r.WithSetExternalAccessIntegrations(integrations)
+				}); err != nil {
+					return diag.Errorf("error updating external access integrations of function %s, err = %s", id.FullyQualifiedName(), err)
+				}
+			}
+		}
+		if _, ok := functionSchema["secrets"]; ok && d.HasChange("secrets") {
+			secrets, err := getRoutineSecrets(d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if len(secrets) > 0 {
+				if err := alter(func(r *sdk.AlterFunctionRequest) *sdk.AlterFunctionRequest {
+					return r.WithSetSecrets(secrets)
+				}); err != nil {
+					return diag.Errorf("error updating secrets of function %s, err = %s", id.FullyQualifiedName(), err)
+				}
+			}
+		}
+
+		if err := alterFunctionLevels(ctx, client, d, id, false); err != nil {
+			return diag.FromErr(err)
+		}
+		return read(ctx, d, meta)
+	}
+}
+
+// alterFunctionLevels sets log_level and trace_level, which cannot be specified in CREATE FUNCTION.
+func alterFunctionLevels(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.SchemaObjectIdentifier, create bool) error {
+	if create || d.HasChange("log_level") {
+		request := sdk.NewAlterFunctionRequest(id.WithoutArguments(), id.Arguments())
+		switch v := d.Get("log_level").(string); {
+		case v != "":
+			request.WithSetLogLevel(sdk.String(v))
+		case !create:
+			request.WithUnsetLogLevel(sdk.Bool(true))
+		default:
+			request = nil
+		}
+		if request != nil {
+			if err := client.Functions.Alter(ctx, request); err != nil {
+				return fmt.Errorf("error updating log level of function %s, err = %w", id.FullyQualifiedName(), err)
+			}
+		}
+	}
+	if create || d.HasChange("trace_level") {
+		request := sdk.NewAlterFunctionRequest(id.WithoutArguments(), id.Arguments())
+		switch v := d.Get("trace_level").(string); {
+		case v != "":
+			request.WithSetTraceLevel(sdk.String(v))
+		case !create:
+			request.WithUnsetTraceLevel(sdk.Bool(true))
+		default:
+			request = nil
+		}
+		if request != nil {
+			if err := client.Functions.Alter(ctx, request); err != nil {
+				return fmt.Errorf("error updating trace level of function %s, err = %w", id.FullyQualifiedName(), err)
+			}
+		}
+	}
+	return nil
+}
+
+func DeleteFunctionForLanguage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := sdk.NewClientFromDB(meta.(*sql.DB))
+	id, err := routineIdentifierFromResourceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.Functions.Drop(ctx, sdk.NewDropFunctionRequest(id.WithoutArguments(), id.Arguments()).WithIfExists(sdk.Bool(true))); err != nil {
+		return diag.Errorf("error dropping function %s, err = %s", id.FullyQualifiedName(), err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func functionArguments(d *schema.ResourceData) []sdk.FunctionArgumentRequest {
+	arguments := make([]sdk.FunctionArgumentRequest, 0)
+	for _, argument := range getRoutineArguments(d) {
+		arguments = append(arguments, sdk.FunctionArgumentRequest{
+			ArgName:      argument.Name,
+			ArgDataType:  sdk.DataType(argument.DataType),
+			DefaultValue: argument.DefaultValue,
+		})
+	}
+	return arguments
+}
+
+func functionReturns(returnType string) (*sdk.FunctionReturnsRequest, error) {
+	columns, isTable, err := parseRoutineTableColumns(returnType)
+	if err != nil {
+		return nil, err
+	}
+	returns := sdk.NewFunctionReturnsRequest()
+	if !isTable {
+		return returns.WithResultDataType(sdk.NewFunctionReturnsResultDataTypeRequest(sdk.DataType(normalizeRoutineDataType(returnType)))), nil
+	}
+	columnRequests := make([]sdk.FunctionColumnRequest, 0, len(columns))
+	for _, column := range columns {
+		columnRequests = append(columnRequests, *sdk.NewFunctionColumnRequest(column.Name, sdk.DataType(column.DataType)))
+	}
+	return returns.WithTable(sdk.NewFunctionReturnsTableRequest().WithColumns(columnRequests)), nil
+}
+
+func functionImports(d *schema.ResourceData) []sdk.FunctionImportRequest {
+	imports := make([]sdk.FunctionImportRequest, 0)
+	for _, item := range getRoutineStrings(d, "imports") {
+		imports = append(imports, *sdk.NewFunctionImportRequest().WithImport(item))
+	}
+	return imports
+}
+
+func functionPackages(d *schema.ResourceData) []sdk.FunctionPackageRequest {
+	packages := make([]sdk.FunctionPackageRequest, 0)
+	for _, item := range getRoutineStrings(d, "packages") {
+		packages = append(packages, *sdk.NewFunctionPackageRequest().WithPackage(item))
+	}
+	return packages
+}
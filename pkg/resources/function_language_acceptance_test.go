@@ -0,0 +1,152 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_FunctionSql_Overloads(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	oneArgument := "snowflake_function_sql.one_argument"
+	twoArguments := "snowflake_function_sql.two_arguments"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: functionSqlOverloadsConfig(name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(oneArgument, "id", fmt.Sprintf(`"%s"."%s"."%s"(NUMBER)`, acc.TestDatabaseName, acc.TestSchemaName, name)),
+					resource.TestCheckResourceAttr(twoArguments, "id", fmt.Sprintf(`"%s"."%s"."%s"(NUMBER, VARCHAR)`, acc.TestDatabaseName, acc.TestSchemaName, name)),
+					resource.TestCheckResourceAttr(oneArgument, "comment", "first"),
+					resource.TestCheckResourceAttr(twoArguments, "return_type", "TABLE (X NUMBER, Y VARCHAR)"),
+				),
+			},
+			// comment changes only alter the matching overload
+			{
+				Config: functionSqlOverloadsConfig(name, "second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(oneArgument, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(twoArguments, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(oneArgument, "comment", "second"),
+			},
+			{
+				ResourceName:            oneArgument,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"log_level", "trace_level", "statement_comparison"},
+			},
+		},
+	})
+}
+
+func TestAcc_FunctionPython(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	newName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_function_python.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: functionPythonConfig(name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "runtime_version", "3.11"),
+					resource.TestCheckResourceAttr(resourceName, "handler", "add_one"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "first"),
+					resource.TestCheckResourceAttr(resourceName, "is_secure", "false"),
+				),
+			},
+			// renaming and commenting are applied in place
+			{
+				Config: functionPythonConfig(newName, "second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", newName),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf(`"%s"."%s"."%s"(NUMBER)`, acc.TestDatabaseName, acc.TestSchemaName, newName)),
+					resource.TestCheckResourceAttr(resourceName, "comment", "second"),
+				),
+			},
+		},
+	})
+}
+
+func functionSqlOverloadsConfig(name string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_function_sql" "one_argument" {
+	database = "%[1]s"
+	schema = "%[2]s"
+	name = "%[3]s"
+	comment = "%[4]s"
+	arguments {
+		arg_name = "x"
+		arg_data_type = "NUMBER"
+	}
+	return_type = "NUMBER"
+	function_definition = "x + 1"
+}
+
+resource "snowflake_function_sql" "two_arguments" {
+	database = "%[1]s"
+	schema = "%[2]s"
+	name = "%[3]s"
+	arguments {
+		arg_name = "x"
+		arg_data_type = "NUMBER"
+	}
+	arguments {
+		arg_name = "y"
+		arg_data_type = "VARCHAR"
+	}
+	return_type = "TABLE (X NUMBER, Y VARCHAR)"
+	function_definition = "SELECT x, y"
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, comment)
+}
+
+func functionPythonConfig(name string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_function_python" "test" {
+	database = "%[1]s"
+	schema = "%[2]s"
+	name = "%[3]s"
+	comment = "%[4]s"
+	arguments {
+		arg_name = "x"
+		arg_data_type = "NUMBER"
+	}
+	return_type = "NUMBER"
+	runtime_version = "3.11"
+	handler = "add_one"
+	function_definition = <<EOT
+def add_one(x):
+    return x + 1
+EOT
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, comment)
+}
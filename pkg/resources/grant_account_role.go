@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -46,10 +47,10 @@ var grantAccountRoleSchema = map[string]*schema.Schema{
 
 func GrantAccountRole() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateGrantAccountRole,
-		Read:   ReadGrantAccountRole,
-		Delete: DeleteGrantAccountRole,
-		Schema: grantAccountRoleSchema,
+		CreateContext: CreateGrantAccountRole,
+		ReadContext:   ReadGrantAccountRole,
+		DeleteContext: DeleteGrantAccountRole,
+		Schema:        grantAccountRoleSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 				parts := strings.Split(d.Id(), helpers.IDDelimiter)
@@ -78,11 +79,10 @@ func GrantAccountRole() *schema.Resource {
 	}
 }
 
-// CreateGrantAccountRole implements schema.CreateFunc.
-func CreateGrantAccountRole(d *schema.ResourceData, meta interface{}) error {
+// CreateGrantAccountRole implements schema.CreateContextFunc.
+func CreateGrantAccountRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	roleName := d.Get("role_name").(string)
 	roleIdentifier := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(roleName)
 	// format of snowflakeResourceID is <role_identifier>|<object type>|<target_identifier>
@@ -94,7 +94,7 @@ func CreateGrantAccountRole(d *schema.ResourceData, meta interface{}) error {
 			Role: &parentRoleIdentifier,
 		})
 		if err := client.Roles.Grant(ctx, req); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if userName, ok := d.GetOk("user_name"); ok && userName.(string) != "" {
 		userIdentifier := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(userName.(string))
@@ -103,27 +103,26 @@ func CreateGrantAccountRole(d *schema.ResourceData, meta interface{}) error {
 			User: &userIdentifier,
 		})
 		if err := client.Roles.Grant(ctx, req); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		return fmt.Errorf("invalid role grant specified: %v", d)
+		return diag.FromErr(fmt.Errorf("invalid role grant specified: %v", d))
 	}
 	d.SetId(snowflakeResourceID)
-	return ReadGrantAccountRole(d, meta)
+	return ReadGrantAccountRole(ctx, d, meta)
 }
 
-func ReadGrantAccountRole(d *schema.ResourceData, meta interface{}) error {
+func ReadGrantAccountRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 	parts := strings.Split(d.Id(), helpers.IDDelimiter)
 	if len(parts) != 3 {
-		return fmt.Errorf("invalid ID specified: %v, expected <role_name>|<grantee_object_type>|<grantee_identifier>", d.Id())
+		return diag.FromErr(fmt.Errorf("invalid ID specified: %v, expected <role_name>|<grantee_object_type>|<grantee_identifier>", d.Id()))
 	}
 	roleName := parts[0]
 	roleIdentifier := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(roleName)
 	objectType := parts[1]
 	targetIdentifier := parts[2]
-	ctx := context.Background()
 	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
 		Of: &sdk.ShowGrantsOf{
 			Role: roleIdentifier,
@@ -152,29 +151,28 @@ func ReadGrantAccountRole(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func DeleteGrantAccountRole(d *schema.ResourceData, meta interface{}) error {
+func DeleteGrantAccountRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 	parts := strings.Split(d.Id(), helpers.IDDelimiter)
 	if len(parts) != 3 {
-		return fmt.Errorf("invalid ID specified: %v, expected <role_name>|<grantee_object_type>|<grantee_identifier>", d.Id())
+		return diag.FromErr(fmt.Errorf("invalid ID specified: %v, expected <role_name>|<grantee_object_type>|<grantee_identifier>", d.Id()))
 	}
 	id := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(parts[0])
 	objectType := parts[1]
 	granteeName := parts[2]
-	ctx := context.Background()
 	granteeIdentifier := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(granteeName)
 	switch objectType {
 	case "ROLE":
 		if err := client.Roles.Revoke(ctx, sdk.NewRevokeRoleRequest(id, sdk.RevokeRole{Role: &granteeIdentifier})); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	case "USER":
 		if err := client.Roles.Revoke(ctx, sdk.NewRevokeRoleRequest(id, sdk.RevokeRole{User: &granteeIdentifier})); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	default:
-		return fmt.Errorf("invalid object type specified: %v, expected ROLE or USER", objectType)
+		return diag.FromErr(fmt.Errorf("invalid object type specified: %v, expected ROLE or USER", objectType))
 	}
 	d.SetId("")
 	return nil
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -60,10 +61,10 @@ var grantDatabaseRoleSchema = map[string]*schema.Schema{
 
 func GrantDatabaseRole() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateGrantDatabaseRole,
-		Read:   ReadGrantDatabaseRole,
-		Delete: DeleteGrantDatabaseRole,
-		Schema: grantDatabaseRoleSchema,
+		CreateContext: CreateGrantDatabaseRole,
+		ReadContext:   ReadGrantDatabaseRole,
+		DeleteContext: DeleteGrantDatabaseRole,
+		Schema:        grantDatabaseRoleSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 				parts := strings.Split(d.Id(), helpers.IDDelimiter)
@@ -96,11 +97,10 @@ func GrantDatabaseRole() *schema.Resource {
 	}
 }
 
-// CreateGrantDatabaseRole implements schema.CreateFunc.
-func CreateGrantDatabaseRole(d *schema.ResourceData, meta interface{}) error {
+// CreateGrantDatabaseRole implements schema.CreateContextFunc.
+func CreateGrantDatabaseRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	databaseRoleName := d.Get("database_role_name").(string)
 	databaseRoleIdentifier := sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(databaseRoleName)
 	// format of snowflakeResourceID is <database_role_identifier>|<object type>|<parent_role_name>
@@ -110,29 +110,29 @@ func CreateGrantDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 		snowflakeResourceID = helpers.EncodeSnowflakeID(databaseRoleIdentifier.FullyQualifiedName(), sdk.ObjectTypeRole.String(), parentRoleIdentifier.FullyQualifiedName())
 		req := sdk.NewGrantDatabaseRoleRequest(databaseRoleIdentifier).WithAccountRole(parentRoleIdentifier)
 		if err := client.DatabaseRoles.Grant(ctx, req); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if parentDatabaseRoleName, ok := d.GetOk("parent_database_role_name"); ok && parentDatabaseRoleName.(string) != "" {
 		parentRoleIdentifier := sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(parentDatabaseRoleName.(string))
 		snowflakeResourceID = helpers.EncodeSnowflakeID(databaseRoleIdentifier.FullyQualifiedName(), sdk.ObjectTypeDatabaseRole.String(), parentRoleIdentifier.FullyQualifiedName())
 		req := sdk.NewGrantDatabaseRoleRequest(databaseRoleIdentifier).WithDatabaseRole(parentRoleIdentifier)
 		if err := client.DatabaseRoles.Grant(ctx, req); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if shareName, ok := d.GetOk("share_name"); ok && shareName.(string) != "" {
 		shareIdentifier := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(shareName.(string))
 		snowflakeResourceID = helpers.EncodeSnowflakeID(databaseRoleIdentifier.FullyQualifiedName(), sdk.ObjectTypeShare.String(), shareIdentifier.FullyQualifiedName())
 		req := sdk.NewGrantDatabaseRoleToShareRequest(databaseRoleIdentifier, shareIdentifier)
 		if err := client.DatabaseRoles.GrantToShare(ctx, req); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.SetId(snowflakeResourceID)
-	return ReadGrantDatabaseRole(d, meta)
+	return ReadGrantDatabaseRole(ctx, d, meta)
 }
 
-// ReadGrantDatabaseRole implements schema.ReadFunc.
-func ReadGrantDatabaseRole(d *schema.ResourceData, meta interface{}) error {
+// ReadGrantDatabaseRole implements schema.ReadContextFunc.
+func ReadGrantDatabaseRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 	parts := strings.Split(d.Id(), helpers.IDDelimiter)
@@ -140,7 +140,6 @@ func ReadGrantDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 	databaseRoleIdentifier := sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(databaseRoleName)
 	objectType := parts[1]
 	targetIdentifier := parts[2]
-	ctx := context.Background()
 	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
 		Of: &sdk.ShowGrantsOf{
 			DatabaseRole: databaseRoleIdentifier,
@@ -202,8 +201,8 @@ func ReadGrantDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteGrantDatabaseRole implements schema.DeleteFunc.
-func DeleteGrantDatabaseRole(d *schema.ResourceData, meta interface{}) error {
+// DeleteGrantDatabaseRole implements schema.DeleteContextFunc.
+func DeleteGrantDatabaseRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 
@@ -211,19 +210,18 @@ func DeleteGrantDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 	id := sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(parts[0])
 	objectType := parts[1]
 	granteeName := parts[2]
-	ctx := context.Background()
 	switch objectType {
 	case "ROLE":
 		if err := client.DatabaseRoles.Revoke(ctx, sdk.NewRevokeDatabaseRoleRequest(id).WithAccountRole(sdk.NewAccountObjectIdentifierFromFullyQualifiedName(granteeName))); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	case "DATABASE ROLE":
 		if err := client.DatabaseRoles.Revoke(ctx, sdk.NewRevokeDatabaseRoleRequest(id).WithDatabaseRole(sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(granteeName))); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	case "SHARE":
 		if err := client.DatabaseRoles.RevokeFromShare(ctx, sdk.NewRevokeDatabaseRoleFromShareRequest(id, sdk.NewAccountObjectIdentifierFromFullyQualifiedName(granteeName))); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.SetId("")
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"strings"
@@ -72,6 +73,7 @@ type grant struct {
 
 // createGenericGrantRolesAndShares will create generic grants for a set of roles and shares.
 func createGenericGrantRolesAndShares(
+	ctx context.Context,
 	meta interface{},
 	builder snowflake.GrantBuilder,
 	priv string,
@@ -81,25 +83,26 @@ func createGenericGrantRolesAndShares(
 ) error {
 	db := meta.(*sql.DB)
 	for _, role := range roles {
-		if err := snowflake.Exec(db, builder.Role(role).Grant(priv, grantOption)); err != nil {
+		if err := snowflake.Exec(ctx, db, builder.Role(role).Grant(priv, grantOption)); err != nil {
 			return err
 		}
 	}
 
 	for _, share := range shares {
-		if err := snowflake.Exec(db, builder.Share(share).Grant(priv, grantOption)); err != nil {
+		if err := snowflake.Exec(ctx, db, builder.Share(share).Grant(priv, grantOption)); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func createGenericGrant(d *schema.ResourceData, meta interface{}, builder snowflake.GrantBuilder) error {
+func createGenericGrant(ctx context.Context, d *schema.ResourceData, meta interface{}, builder snowflake.GrantBuilder) error {
 	priv := d.Get("privilege").(string)
 	grantOption := d.Get("with_grant_option").(bool)
 	roles, shares := expandRolesAndShares(d)
 
 	return createGenericGrantRolesAndShares(
+		ctx,
 		meta,
 		builder,
 		priv,
@@ -110,6 +113,7 @@ func createGenericGrant(d *schema.ResourceData, meta interface{}, builder snowfl
 }
 
 func readGenericGrant(
+	ctx context.Context,
 	d *schema.ResourceData,
 	meta interface{},
 	grantSchema map[string]*schema.Schema,
@@ -131,13 +135,13 @@ func readGenericGrant(
 	}
 	switch {
 	case futureObjects:
-		grants, err = readGenericFutureGrants(db, builder)
+		grants, err = readGenericFutureGrants(ctx, db, builder)
 	case allObjects:
 		// When running e.g. GRANT SELECT ON ALL TABLES IN ..., then Snowflake creates a grant for each individual existing table.
 		// There is no way to attribute existing table grants to a GRANT SELECT ON ALL TABLES grant. Thus they cannot be checked (or removed).
 		return nil
 	default:
-		grants, err = readGenericCurrentGrants(db, builder)
+		grants, err = readGenericCurrentGrants(ctx, db, builder)
 	}
 	if err != nil {
 		// HACK HACK: If the object doesn't exist or not authorized then we can assume someone deleted it
@@ -253,9 +257,9 @@ func readGenericGrant(
 	return nil
 }
 
-func readGenericCurrentGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*grant, error) {
+func readGenericCurrentGrants(ctx context.Context, db *sql.DB, builder snowflake.GrantBuilder) ([]*grant, error) {
 	stmt := builder.Show()
-	rows, err := snowflake.Query(db, stmt)
+	rows, err := snowflake.Query(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -289,11 +293,11 @@ func readGenericCurrentGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*gr
 	return grants, nil
 }
 
-func readGenericFutureGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*grant, error) {
+func readGenericFutureGrants(ctx context.Context, db *sql.DB, builder snowflake.GrantBuilder) ([]*grant, error) {
 	conn := sqlx.NewDb(db, "snowflake")
 
 	stmt := builder.Show()
-	rows, err := conn.Queryx(stmt)
+	rows, err := conn.QueryxContext(ctx, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -323,6 +327,7 @@ func readGenericFutureGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*gra
 // Deletes specific roles and shares from a grant
 // Does not modify TF remote state.
 func deleteGenericGrantRolesAndShares(
+	ctx context.Context,
 	meta interface{},
 	builder snowflake.GrantBuilder,
 	priv string,
@@ -337,7 +342,7 @@ func deleteGenericGrantRolesAndShares(
 		if priv == "OWNERSHIP" {
 			executable = builder.Role(role).RevokeOwnership(reversionRole)
 		}
-		if err := snowflake.ExecMulti(db, executable); err != nil {
+		if err := snowflake.ExecMulti(ctx, db, executable); err != nil {
 			return err
 		}
 	}
@@ -347,14 +352,14 @@ func deleteGenericGrantRolesAndShares(
 		if priv == "OWNERSHIP" {
 			executable = builder.Share(share).RevokeOwnership(reversionRole)
 		}
-		if err := snowflake.ExecMulti(db, executable); err != nil {
+		if err := snowflake.ExecMulti(ctx, db, executable); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func deleteGenericGrant(d *schema.ResourceData, meta interface{}, builder snowflake.GrantBuilder) error {
+func deleteGenericGrant(ctx context.Context, d *schema.ResourceData, meta interface{}, builder snowflake.GrantBuilder) error {
 	priv := d.Get("privilege").(string)
 	rr := d.Get("revert_ownership_to_role_name")
 	var reversionRole string
@@ -362,7 +367,7 @@ func deleteGenericGrant(d *schema.ResourceData, meta interface{}, builder snowfl
 		reversionRole = rr.(string)
 	}
 	roles, shares := expandRolesAndShares(d)
-	if err := deleteGenericGrantRolesAndShares(meta, builder, priv, reversionRole, roles, shares); err != nil {
+	if err := deleteGenericGrantRolesAndShares(ctx, meta, builder, priv, reversionRole, roles, shares); err != nil {
 		return err
 	}
 	d.SetId("")
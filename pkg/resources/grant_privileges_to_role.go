@@ -11,6 +11,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/logging"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -233,10 +234,10 @@ var grantPrivilegesToRoleSchema = map[string]*schema.Schema{
 
 func GrantPrivilegesToRole() *schema.Resource {
 	return &schema.Resource{
-		Create:             CreateGrantPrivilegesToRole,
-		Read:               ReadGrantPrivilegesToRole,
-		Delete:             DeleteGrantPrivilegesToRole,
-		Update:             UpdateGrantPrivilegesToRole,
+		CreateContext:      CreateGrantPrivilegesToRole,
+		ReadContext:        ReadGrantPrivilegesToRole,
+		DeleteContext:      DeleteGrantPrivilegesToRole,
+		UpdateContext:      UpdateGrantPrivilegesToRole,
 		DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 
 		Schema: grantPrivilegesToRoleSchema,
@@ -392,12 +393,11 @@ func (v GrantPrivilegesToRoleID) String() string {
 	return helpers.EncodeSnowflakeID(v.RoleName, v.Privileges, v.AllPrivileges, v.WithGrantOption, v.OnAccount, v.OnAccountObject, v.OnSchema, v.OnSchemaObject, v.All, v.Future, v.ObjectType, v.ObjectName, v.ObjectTypePlural, v.InSchema, v.SchemaName, v.InDatabase, v.DatabaseName)
 }
 
-func CreateGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error {
+func CreateGrantPrivilegesToRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	logging.DebugLogger.Printf("[DEBUG] Entering create grant privileges to role")
 	db := meta.(*sql.DB)
 	logging.DebugLogger.Printf("[DEBUG] Creating new client from db")
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	resourceID := &GrantPrivilegesToRoleID{}
 	var privileges []string
 	if p, ok := d.GetOk("privileges"); ok {
@@ -409,7 +409,7 @@ func CreateGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error
 	resourceID.AllPrivileges = allPrivileges
 	privilegesToGrant, on, err := configureRoleGrantPrivilegeOptions(d, privileges, allPrivileges, resourceID)
 	if err != nil {
-		return fmt.Errorf("error configuring account role grant privilege options: %w", err)
+		return diag.FromErr(fmt.Errorf("error configuring account role grant privilege options: %w", err))
 	}
 	withGrantOption := d.Get("with_grant_option").(bool)
 	resourceID.WithGrantOption = withGrantOption
@@ -423,20 +423,19 @@ func CreateGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error
 	err = client.Grants.GrantPrivilegesToAccountRole(ctx, privilegesToGrant, on, roleID, &opts)
 	logging.DebugLogger.Printf("[DEBUG] After granting privileges to account role: err = %v", err)
 	if err != nil {
-		return fmt.Errorf("error granting privileges to account role: %w", err)
+		return diag.FromErr(fmt.Errorf("error granting privileges to account role: %w", err))
 	}
 
 	logging.DebugLogger.Printf("[DEBUG] Setting ID to %s", resourceID.String())
 	d.SetId(resourceID.String())
-	return ReadGrantPrivilegesToRole(d, meta)
+	return ReadGrantPrivilegesToRole(ctx, d, meta)
 }
 
-func ReadGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error {
+func ReadGrantPrivilegesToRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	logging.DebugLogger.Printf("[DEBUG] Entering read grant privileges to role")
 	db := meta.(*sql.DB)
 	logging.DebugLogger.Printf("[DEBUG] Creating new client from db")
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	resourceID := NewGrantPrivilegesToRoleID(d.Id())
 	roleName := resourceID.RoleName
 	allPrivileges := resourceID.AllPrivileges
@@ -539,17 +538,16 @@ func ReadGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error {
 
 	err := readRoleGrantPrivileges(ctx, client, grantOn, resourceID, &opts, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func UpdateGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error {
+func UpdateGrantPrivilegesToRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	logging.DebugLogger.Printf("[DEBUG] Entering update grant privileges to role")
 	db := meta.(*sql.DB)
 	logging.DebugLogger.Printf("[DEBUG] Creating new client from db")
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	// the only thing that can change is "privileges"
 	roleName := d.Get("role_name").(string)
@@ -581,13 +579,13 @@ func UpdateGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error
 			logging.DebugLogger.Printf("[DEBUG] Adding new privileges")
 			privilegesToGrant, on, err := configureRoleGrantPrivilegeOptions(d, addPrivileges, false, &GrantPrivilegesToRoleID{})
 			if err != nil {
-				return fmt.Errorf("error configuring account role grant privilege options: %w", err)
+				return diag.FromErr(fmt.Errorf("error configuring account role grant privilege options: %w", err))
 			}
 			logging.DebugLogger.Printf("[DEBUG] About to grant privileges to account role")
 			err = client.Grants.GrantPrivilegesToAccountRole(ctx, privilegesToGrant, on, roleID, nil)
 			logging.DebugLogger.Printf("[DEBUG] After granting privileges to account role: err = %v", err)
 			if err != nil {
-				return fmt.Errorf("error granting privileges to account role: %w", err)
+				return diag.FromErr(fmt.Errorf("error granting privileges to account role: %w", err))
 			}
 		}
 
@@ -596,13 +594,13 @@ func UpdateGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error
 			logging.DebugLogger.Printf("[DEBUG] Removing old privileges")
 			privilegesToRevoke, on, err := configureRoleGrantPrivilegeOptions(d, removePrivileges, false, &GrantPrivilegesToRoleID{})
 			if err != nil {
-				return fmt.Errorf("error configuring account role grant privilege options: %w", err)
+				return diag.FromErr(fmt.Errorf("error configuring account role grant privilege options: %w", err))
 			}
 			logging.DebugLogger.Printf("[DEBUG] About to revoke privileges from account role")
 			err = client.Grants.RevokePrivilegesFromAccountRole(ctx, privilegesToRevoke, on, roleID, nil)
 			logging.DebugLogger.Printf("[DEBUG] After revoking privileges from account role: err = %v", err)
 			if err != nil {
-				return fmt.Errorf("error revoking privileges from account role: %w", err)
+				return diag.FromErr(fmt.Errorf("error revoking privileges from account role: %w", err))
 			}
 		}
 		logging.DebugLogger.Printf("[DEBUG] Setting new values")
@@ -610,15 +608,14 @@ func UpdateGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error
 		resourceID.Privileges = newPrivileges
 		d.SetId(resourceID.String())
 	}
-	return ReadGrantPrivilegesToRole(d, meta)
+	return ReadGrantPrivilegesToRole(ctx, d, meta)
 }
 
-func DeleteGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error {
+func DeleteGrantPrivilegesToRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	logging.DebugLogger.Printf("[DEBUG] Entering delete grant privileges to role")
 	db := meta.(*sql.DB)
 	logging.DebugLogger.Printf("[DEBUG] Creating new client from db")
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	roleName := d.Get("role_name").(string)
 	roleID := sdk.NewAccountObjectIdentifier(roleName)
@@ -630,13 +627,13 @@ func DeleteGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error
 	allPrivileges := d.Get("all_privileges").(bool)
 	privilegesToRevoke, on, err := configureRoleGrantPrivilegeOptions(d, privileges, allPrivileges, &GrantPrivilegesToRoleID{})
 	if err != nil {
-		return fmt.Errorf("error configuring account role grant privilege options: %w", err)
+		return diag.FromErr(fmt.Errorf("error configuring account role grant privilege options: %w", err))
 	}
 	logging.DebugLogger.Printf("[DEBUG] About to revoke privileges from account role")
 	err = client.Grants.RevokePrivilegesFromAccountRole(ctx, privilegesToRevoke, on, roleID, nil)
 	logging.DebugLogger.Printf("[DEBUG] After revoking privileges from account role: err = %v", err)
 	if err != nil {
-		return fmt.Errorf("error revoking privileges from account role: %w", err)
+		return diag.FromErr(fmt.Errorf("error revoking privileges from account role: %w", err))
 	}
 	logging.DebugLogger.Printf("[DEBUG] Cleaning resource id")
 	d.SetId("")
@@ -4,16 +4,6 @@ import "slices"
 
 // borrowed from https://github.com/terraform-providers/terraform-provider-aws/blob/master/aws/structure.go#L924:6
 
-func expandIntList(configured []interface{}) []int {
-	vs := make([]int, 0, len(configured))
-	for _, v := range configured {
-		if val, ok := v.(int); ok {
-			vs = append(vs, val)
-		}
-	}
-	return vs
-}
-
 func expandStringList(configured []interface{}) []string {
 	vs := make([]string, 0, len(configured))
 	for _, v := range configured {
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -62,6 +63,12 @@ func setBoolProperty(d *schema.ResourceData, key string, property *sdk.BoolPrope
 	return nil
 }
 
+// isPrivilegeError reports whether the statement failed for the lack of privileges. The optional properties read with
+// such statements are treated as unknown, so the values in the state are kept.
+func isPrivilegeError(err error) bool {
+	return errors.Is(err, sdk.ErrInsufficientPrivileges) || errors.Is(err, sdk.ErrObjectNotExistOrAuthorized)
+}
+
 func getTagObjectIdentifier(v map[string]any) sdk.ObjectIdentifier {
 	if _, ok := v["database"]; ok {
 		if _, ok := v["schema"]; ok {
@@ -194,15 +194,6 @@ func pipe(t *testing.T, id string, params map[string]interface{}) *schema.Resour
 	return d
 }
 
-func resourceMonitor(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
-	t.Helper()
-	r := require.New(t)
-	d := schema.TestResourceDataRaw(t, resources.ResourceMonitor().Schema, params)
-	r.NotNil(d)
-	d.SetId(id)
-	return d
-}
-
 func sequence(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
 	t.Helper()
 	r := require.New(t)
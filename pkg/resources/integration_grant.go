@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -70,10 +71,10 @@ var integrationGrantSchema = map[string]*schema.Schema{
 func IntegrationGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateIntegrationGrant,
-			Read:               ReadIntegrationGrant,
-			Delete:             DeleteIntegrationGrant,
-			Update:             UpdateIntegrationGrant,
+			CreateContext:      CreateIntegrationGrant,
+			ReadContext:        ReadIntegrationGrant,
+			DeleteContext:      DeleteIntegrationGrant,
+			UpdateContext:      UpdateIntegrationGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             integrationGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -102,25 +103,25 @@ func IntegrationGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateIntegrationGrant implements schema.CreateFunc.
-func CreateIntegrationGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateIntegrationGrant implements schema.CreateContextFunc.
+func CreateIntegrationGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	integrationName := d.Get("integration_name").(string)
 	privilege := d.Get("privilege").(string)
 	withGrantOption := d.Get("with_grant_option").(bool)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	builder := snowflake.IntegrationGrant(integrationName)
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 	grantID := helpers.EncodeSnowflakeID(integrationName, privilege, withGrantOption, roles)
 	d.SetId(grantID)
 
-	return ReadIntegrationGrant(d, meta)
+	return ReadIntegrationGrant(ctx, d, meta)
 }
 
-// ReadIntegrationGrant implements schema.ReadFunc.
-func ReadIntegrationGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadIntegrationGrant implements schema.ReadContextFunc.
+func ReadIntegrationGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	integrationName := d.Get("integration_name").(string)
 	privilege := d.Get("privilege").(string)
 	withGrantOption := d.Get("with_grant_option").(bool)
@@ -128,9 +129,9 @@ func ReadIntegrationGrant(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.IntegrationGrant(integrationName)
 
-	err := readGenericGrant(d, meta, integrationGrantSchema, builder, false, false, validIntegrationPrivileges)
+	err := readGenericGrant(ctx, d, meta, integrationGrantSchema, builder, false, false, validIntegrationPrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(integrationName, privilege, withGrantOption, roles)
@@ -140,16 +141,16 @@ func ReadIntegrationGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteIntegrationGrant implements schema.DeleteFunc.
-func DeleteIntegrationGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteIntegrationGrant implements schema.DeleteContextFunc.
+func DeleteIntegrationGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	integrationName := d.Get("integration_name").(string)
 	builder := snowflake.IntegrationGrant(integrationName)
 
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateIntegrationGrant implements schema.UpdateFunc.
-func UpdateIntegrationGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateIntegrationGrant implements schema.UpdateContextFunc.
+func UpdateIntegrationGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles") {
@@ -173,17 +174,17 @@ func UpdateIntegrationGrant(d *schema.ResourceData, meta interface{}) error {
 	// first revoke
 
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
+		ctx, meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
+		ctx, meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadIntegrationGrant(d, meta)
+	return ReadIntegrationGrant(ctx, d, meta)
 }
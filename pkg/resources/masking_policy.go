@@ -6,6 +6,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -118,10 +119,10 @@ var maskingPolicySchema = map[string]*schema.Schema{
 // MaskingPolicy returns a pointer to the resource representing a masking policy.
 func MaskingPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateMaskingPolicy,
-		Read:   ReadMaskingPolicy,
-		Update: UpdateMaskingPolicy,
-		Delete: DeleteMaskingPolicy,
+		CreateContext: CreateMaskingPolicy,
+		ReadContext:   ReadMaskingPolicy,
+		UpdateContext: UpdateMaskingPolicy,
+		DeleteContext: DeleteMaskingPolicy,
 
 		Schema: maskingPolicySchema,
 		Importer: &schema.ResourceImporter{
@@ -130,8 +131,8 @@ func MaskingPolicy() *schema.Resource {
 	}
 }
 
-// CreateMaskingPolicy implements schema.CreateFunc.
-func CreateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
+// CreateMaskingPolicy implements schema.CreateContextFunc.
+func CreateMaskingPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 
@@ -142,7 +143,6 @@ func CreateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 	expression := d.Get("masking_expression").(string)
 	returnDataType := d.Get("return_data_type").(string)
 
-	ctx := context.Background()
 	objectIdentifier := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
 	signatureList := d.Get("signature").([]interface{})
@@ -154,7 +154,7 @@ func CreateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 			cm := c.(map[string]interface{})
 			dt, err := sdk.ToDataType(cm["type"].(string))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			signature = append(signature, sdk.TableColumnSignature{
 				Name: cm["name"].(string),
@@ -165,7 +165,7 @@ func CreateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 
 	returns, err := sdk.ToDataType(returnDataType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	opts := &sdk.CreateMaskingPolicyOptions{}
 	if comment, ok := d.Get("comment").(string); ok {
@@ -177,55 +177,54 @@ func CreateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 
 	err = client.MaskingPolicies.Create(ctx, objectIdentifier, signature, returns, expression, opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
 
-	return ReadMaskingPolicy(d, meta)
+	return ReadMaskingPolicy(ctx, d, meta)
 }
 
-// ReadMaskingPolicy implements schema.ReadFunc.
-func ReadMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
+// ReadMaskingPolicy implements schema.ReadContextFunc.
+func ReadMaskingPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
-	ctx := context.Background()
 	maskingPolicy, err := client.MaskingPolicies.ShowByID(ctx, objectIdentifier)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("name", maskingPolicy.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("database", maskingPolicy.DatabaseName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("schema", maskingPolicy.SchemaName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("exempt_other_policies", maskingPolicy.ExemptOtherPolicies); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("comment", maskingPolicy.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	maskingPolicyDetails, err := client.MaskingPolicies.Describe(ctx, objectIdentifier)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("masking_expression", maskingPolicyDetails.Body); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("return_data_type", maskingPolicyDetails.ReturnType); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	signature := []map[string]interface{}{}
@@ -240,21 +239,20 @@ func ReadMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 		})
 	}
 	if err := d.Set("signature", signature); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("qualified_name", objectIdentifier.FullyQualifiedName()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateMaskingPolicy implements schema.UpdateFunc.
-func UpdateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
+// UpdateMaskingPolicy implements schema.UpdateContextFunc.
+func UpdateMaskingPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
-	ctx := context.Background()
 
 	if d.HasChange("masking_expression") {
 		alterOptions := &sdk.AlterMaskingPolicyOptions{}
@@ -264,7 +262,7 @@ func UpdateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.MaskingPolicies.Alter(ctx, objectIdentifier, alterOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -281,7 +279,7 @@ func UpdateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.MaskingPolicies.Alter(ctx, objectIdentifier, alterOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -294,24 +292,23 @@ func UpdateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.MaskingPolicies.Alter(ctx, objectIdentifier, alterOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(helpers.EncodeSnowflakeID(newID))
 	}
 
-	return ReadMaskingPolicy(d, meta)
+	return ReadMaskingPolicy(ctx, d, meta)
 }
 
-// DeleteMaskingPolicy implements schema.DeleteFunc.
-func DeleteMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
+// DeleteMaskingPolicy implements schema.DeleteContextFunc.
+func DeleteMaskingPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
 	err := client.MaskingPolicies.Drop(ctx, objectIdentifier)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
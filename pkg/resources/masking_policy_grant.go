@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -82,10 +83,10 @@ var maskingPolicyGrantSchema = map[string]*schema.Schema{
 func MaskingPolicyGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateMaskingPolicyGrant,
-			Read:               ReadMaskingPolicyGrant,
-			Delete:             DeleteMaskingPolicyGrant,
-			Update:             UpdateMaskingPolicyGrant,
+			CreateContext:      CreateMaskingPolicyGrant,
+			ReadContext:        ReadMaskingPolicyGrant,
+			DeleteContext:      DeleteMaskingPolicyGrant,
+			UpdateContext:      UpdateMaskingPolicyGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             maskingPolicyGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -122,8 +123,8 @@ func MaskingPolicyGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateMaskingPolicyGrant implements schema.CreateFunc.
-func CreateMaskingPolicyGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateMaskingPolicyGrant implements schema.CreateContextFunc.
+func CreateMaskingPolicyGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	maskingPolicyName := d.Get("masking_policy_name").(string)
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -132,18 +133,18 @@ func CreateMaskingPolicyGrant(d *schema.ResourceData, meta interface{}) error {
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	builder := snowflake.MaskingPolicyGrant(databaseName, schemaName, maskingPolicyName)
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, maskingPolicyName, privilege, withGrantOption, roles)
 	d.SetId(grantID)
 
-	return ReadMaskingPolicyGrant(d, meta)
+	return ReadMaskingPolicyGrant(ctx, d, meta)
 }
 
-// ReadMaskingPolicyGrant implements schema.ReadFunc.
-func ReadMaskingPolicyGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadMaskingPolicyGrant implements schema.ReadContextFunc.
+func ReadMaskingPolicyGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	maskingPolicyName := d.Get("masking_policy_name").(string)
@@ -153,9 +154,9 @@ func ReadMaskingPolicyGrant(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.MaskingPolicyGrant(databaseName, schemaName, maskingPolicyName)
 
-	err := readGenericGrant(d, meta, maskingPolicyGrantSchema, builder, false, false, validMaskingPoilcyPrivileges)
+	err := readGenericGrant(ctx, d, meta, maskingPolicyGrantSchema, builder, false, false, validMaskingPoilcyPrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, maskingPolicyName, privilege, withGrantOption, roles)
@@ -165,19 +166,19 @@ func ReadMaskingPolicyGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteMaskingPolicyGrant implements schema.DeleteFunc.
-func DeleteMaskingPolicyGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteMaskingPolicyGrant implements schema.DeleteContextFunc.
+func DeleteMaskingPolicyGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	maskingPolicyName := d.Get("masking_policy_name").(string)
 
 	builder := snowflake.MaskingPolicyGrant(databaseName, schemaName, maskingPolicyName)
 
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateMaskingPolicyGrant implements schema.UpdateFunc.
-func UpdateMaskingPolicyGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateMaskingPolicyGrant implements schema.UpdateContextFunc.
+func UpdateMaskingPolicyGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles") {
@@ -203,17 +204,17 @@ func UpdateMaskingPolicyGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
+		ctx, meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
+		ctx, meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadMaskingPolicyGrant(d, meta)
+	return ReadMaskingPolicyGrant(ctx, d, meta)
 }
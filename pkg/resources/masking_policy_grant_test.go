@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -36,8 +37,8 @@ func TestMaskingPolicyGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT APPLY ON MASKING POLICY "test-db"."PUBLIC"."test-masking-policy" TO ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT APPLY ON MASKING POLICY "test-db"."PUBLIC"."test-masking-policy" TO ROLE "test-role-2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadMaskingPolicyGrant(mock)
-		err := resources.CreateMaskingPolicyGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateMaskingPolicyGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -57,8 +58,8 @@ func TestMaskingPolicyGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadMaskingPolicyGrant(mock)
-		err := resources.ReadMaskingPolicyGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadMaskingPolicyGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	roles := d.Get("roles").(*schema.Set)
@@ -9,6 +9,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -60,16 +61,17 @@ var materializedViewSchema = map[string]*schema.Schema{
 		ForceNew:         true,
 		DiffSuppressFunc: DiffSuppressStatement,
 	},
-	"tag": tagReferenceSchema,
+	"tag":                  tagReferenceSchema,
+	"statement_comparison": statementComparisonSchema,
 }
 
 // MaterializedView returns a pointer to the resource representing a view.
 func MaterializedView() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateMaterializedView,
-		Read:   ReadMaterializedView,
-		Update: UpdateMaterializedView,
-		Delete: DeleteMaterializedView,
+		CreateContext: CreateMaterializedView,
+		ReadContext:   ReadMaterializedView,
+		UpdateContext: UpdateMaterializedView,
+		DeleteContext: DeleteMaterializedView,
 
 		Schema: materializedViewSchema,
 		Importer: &schema.ResourceImporter{
@@ -78,10 +80,9 @@ func MaterializedView() *schema.Resource {
 	}
 }
 
-// CreateMaterializedView implements schema.CreateFunc.
-func CreateMaterializedView(d *schema.ResourceData, meta interface{}) error {
+// CreateMaterializedView implements schema.CreateContextFunc.
+func CreateMaterializedView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	databaseName := d.Get("database").(string)
@@ -108,12 +109,12 @@ func CreateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 	// TODO [SNOW-867235]: this was the old implementation, it's left for now, we will address this with resources rework discussions
 	err := client.Sessions.UseWarehouse(ctx, sdk.NewAccountObjectIdentifier(warehouseName))
 	if err != nil {
-		return fmt.Errorf("error setting warehouse %s while creating materialized view %v err = %w", warehouseName, name, err)
+		return diag.FromErr(fmt.Errorf("error setting warehouse %s while creating materialized view %v err = %w", warehouseName, name, err))
 	}
 
 	err = client.MaterializedViews.Create(ctx, createRequest)
 	if err != nil {
-		return fmt.Errorf("error creating materialized view %v err = %w", name, err)
+		return diag.FromErr(fmt.Errorf("error creating materialized view %v err = %w", name, err))
 	}
 
 	// TODO [SNOW-867235]: we have to set tags after creation because existing materialized view extractor is not aware of TAG during CREATE
@@ -121,19 +122,18 @@ func CreateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 	if _, ok := d.GetOk("tag"); ok {
 		err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithSetTags(getPropertyTags(d, "tag")))
 		if err != nil {
-			return fmt.Errorf("error setting tags on materialized view %v, err = %w", id, err)
+			return diag.FromErr(fmt.Errorf("error setting tags on materialized view %v, err = %w", id, err))
 		}
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(id))
 
-	return ReadMaterializedView(d, meta)
+	return ReadMaterializedView(ctx, d, meta)
 }
 
-// ReadMaterializedView implements schema.ReadFunc.
-func ReadMaterializedView(d *schema.ResourceData, meta interface{}) error {
+// ReadMaterializedView implements schema.ReadContextFunc.
+func ReadMaterializedView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
@@ -145,23 +145,23 @@ func ReadMaterializedView(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("name", materializedView.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("is_secure", materializedView.IsSecure); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("comment", materializedView.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("schema", materializedView.SchemaName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("database", materializedView.DatabaseName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// TODO [SNOW-867235]: what do we do with these extractors (added as discussion topic)?
@@ -169,20 +169,19 @@ func ReadMaterializedView(d *schema.ResourceData, meta interface{}) error {
 	extractor := snowflake.NewViewSelectStatementExtractor(materializedView.Text)
 	substringOfQuery, err := extractor.ExtractMaterializedView()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("statement", substringOfQuery); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-// UpdateMaterializedView implements schema.UpdateFunc.
-func UpdateMaterializedView(d *schema.ResourceData, meta interface{}) error {
+// UpdateMaterializedView implements schema.UpdateContextFunc.
+func UpdateMaterializedView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
@@ -193,7 +192,7 @@ func UpdateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 
 		err := client.MaterializedViews.Alter(ctx, sdk.NewAlterMaterializedViewRequest(id).WithRenameTo(&newId))
 		if err != nil {
-			return fmt.Errorf("error renaming materialized view %v err = %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error renaming materialized view %v err = %w", d.Id(), err))
 		}
 
 		d.SetId(helpers.EncodeSnowflakeID(newId))
@@ -228,14 +227,14 @@ func UpdateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 	if runSetStatement {
 		err := client.MaterializedViews.Alter(ctx, sdk.NewAlterMaterializedViewRequest(id).WithSet(setRequest))
 		if err != nil {
-			return fmt.Errorf("error updating materialized view: %w", err)
+			return diag.FromErr(fmt.Errorf("error updating materialized view: %w", err))
 		}
 	}
 
 	if runUnsetStatement {
 		err := client.MaterializedViews.Alter(ctx, sdk.NewAlterMaterializedViewRequest(id).WithUnset(unsetRequest))
 		if err != nil {
-			return fmt.Errorf("error updating materialized view: %w", err)
+			return diag.FromErr(fmt.Errorf("error updating materialized view: %w", err))
 		}
 	}
 
@@ -246,7 +245,7 @@ func UpdateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 			// TODO [SNOW-1022645]: view is used on purpose here; change after we have an agreement on situations like this in the SDK
 			err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithUnsetTags(unsetTags))
 			if err != nil {
-				return fmt.Errorf("error unsetting tags on %v, err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error unsetting tags on %v, err = %w", d.Id(), err))
 			}
 		}
 
@@ -254,24 +253,23 @@ func UpdateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 			// TODO [SNOW-1022645]: view is used on purpose here; change after we have an agreement on situations like this in the SDK
 			err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithSetTags(setTags))
 			if err != nil {
-				return fmt.Errorf("error setting tags on %v, err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error setting tags on %v, err = %w", d.Id(), err))
 			}
 		}
 	}
 
-	return ReadMaterializedView(d, meta)
+	return ReadMaterializedView(ctx, d, meta)
 }
 
-// DeleteMaterializedView implements schema.DeleteFunc.
-func DeleteMaterializedView(d *schema.ResourceData, meta interface{}) error {
+// DeleteMaterializedView implements schema.DeleteContextFunc.
+func DeleteMaterializedView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
 	err := client.MaterializedViews.Drop(ctx, sdk.NewDropMaterializedViewRequest(id))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
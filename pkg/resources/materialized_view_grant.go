@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -109,10 +110,10 @@ var materializedViewGrantSchema = map[string]*schema.Schema{
 func MaterializedViewGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateMaterializedViewGrant,
-			Read:               ReadMaterializedViewGrant,
-			Delete:             DeleteMaterializedViewGrant,
-			Update:             UpdateMaterializedViewGrant,
+			CreateContext:      CreateMaterializedViewGrant,
+			ReadContext:        ReadMaterializedViewGrant,
+			DeleteContext:      DeleteMaterializedViewGrant,
+			UpdateContext:      UpdateMaterializedViewGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             materializedViewGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -159,8 +160,8 @@ func MaterializedViewGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateMaterializedViewGrant implements schema.CreateFunc.
-func CreateMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateMaterializedViewGrant implements schema.CreateContextFunc.
+func CreateMaterializedViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	materializedViewName := d.Get("materialized_view_name").(string)
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -172,14 +173,14 @@ func CreateMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error
 	shares := expandStringList(d.Get("shares").(*schema.Set).List())
 
 	if (schemaName == "") && !onFuture && !onAll {
-		return errors.New("schema_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future or on_all is true"))
 	}
 
 	if (materializedViewName == "") && !onFuture && !onAll {
-		return errors.New("materialized_view_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("materialized_view_name must be set unless on_future or on_all is true"))
 	}
 	if (materializedViewName != "") && onFuture && onAll {
-		return errors.New("materialized_view_name must be empty if on_future and on_all is true")
+		return diag.FromErr(errors.New("materialized_view_name must be empty if on_future and on_all is true"))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -192,18 +193,18 @@ func CreateMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error
 		builder = snowflake.MaterializedViewGrant(databaseName, schemaName, materializedViewName)
 	}
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, materializedViewName, privilege, withGrantOption, onFuture, onAll, roles, shares)
 	d.SetId(grantID)
 
-	return ReadMaterializedViewGrant(d, meta)
+	return ReadMaterializedViewGrant(ctx, d, meta)
 }
 
-// ReadMaterializedViewGrant implements schema.ReadFunc.
-func ReadMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadMaterializedViewGrant implements schema.ReadContextFunc.
+func ReadMaterializedViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	materializedViewName := d.Get("materialized_view_name").(string)
@@ -211,13 +212,13 @@ func ReadMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
 	onFuture := d.Get("on_future").(bool)
 	onAll := d.Get("on_all").(bool)
 	if materializedViewName == "" && !onFuture && !onAll {
-		return errors.New("materialized_view_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("materialized_view_name must be set unless on_future or on_all is true"))
 	}
 	if materializedViewName != "" && (onFuture || onAll) {
-		return errors.New("materialized_view_name must be empty if on_future or on_all is true")
+		return diag.FromErr(errors.New("materialized_view_name must be empty if on_future or on_all is true"))
 	}
 	if onAll && onFuture {
-		return errors.New("on_future and on_all cannot both be true")
+		return diag.FromErr(errors.New("on_future and on_all cannot both be true"))
 	}
 	withGrantOption := d.Get("with_grant_option").(bool)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
@@ -233,9 +234,9 @@ func ReadMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.MaterializedViewGrant(databaseName, schemaName, materializedViewName)
 	}
 
-	err := readGenericGrant(d, meta, materializedViewGrantSchema, builder, onFuture, onAll, validMaterializedViewPrivileges)
+	err := readGenericGrant(ctx, d, meta, materializedViewGrantSchema, builder, onFuture, onAll, validMaterializedViewPrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, materializedViewName, privilege, withGrantOption, onFuture, onAll, roles, shares)
@@ -246,8 +247,8 @@ func ReadMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteMaterializedViewGrant implements schema.DeleteFunc.
-func DeleteMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteMaterializedViewGrant implements schema.DeleteContextFunc.
+func DeleteMaterializedViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	materializedViewName := d.Get("materialized_view_name").(string)
@@ -263,11 +264,11 @@ func DeleteMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error
 	default:
 		builder = snowflake.MaterializedViewGrant(databaseName, schemaName, materializedViewName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateMaterializedViewGrant implements schema.UpdateFunc.
-func UpdateMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateMaterializedViewGrant implements schema.UpdateContextFunc.
+func UpdateMaterializedViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update, and we're done
 	if !d.HasChanges("roles", "shares") {
@@ -306,17 +307,17 @@ func UpdateMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, privilege, reversionRole, rolesToRevoke, sharesToRevoke,
+		ctx, meta, builder, privilege, reversionRole, rolesToRevoke, sharesToRevoke,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, privilege, withGrantOption, rolesToAdd, sharesToAdd,
+		ctx, meta, builder, privilege, withGrantOption, rolesToAdd, sharesToAdd,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadMaterializedViewGrant(d, meta)
+	return ReadMaterializedViewGrant(ctx, d, meta)
 }
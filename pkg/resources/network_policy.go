@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -43,10 +44,10 @@ var networkPolicySchema = map[string]*schema.Schema{
 // NetworkPolicy returns a pointer to the resource representing a network policy.
 func NetworkPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateNetworkPolicy,
-		Read:   ReadNetworkPolicy,
-		Update: UpdateNetworkPolicy,
-		Delete: DeleteNetworkPolicy,
+		CreateContext: CreateNetworkPolicy,
+		ReadContext:   ReadNetworkPolicy,
+		UpdateContext: UpdateNetworkPolicy,
+		DeleteContext: DeleteNetworkPolicy,
 
 		Schema: networkPolicySchema,
 		Importer: &schema.ResourceImporter{
@@ -55,8 +56,8 @@ func NetworkPolicy() *schema.Resource {
 	}
 }
 
-// CreateNetworkPolicy implements schema.CreateFunc.
-func CreateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
+// CreateNetworkPolicy implements schema.CreateContextFunc.
+func CreateNetworkPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 	req := sdk.NewCreateNetworkPolicyRequest(sdk.NewAccountObjectIdentifier(name))
 
@@ -84,22 +85,20 @@ func CreateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	err := client.NetworkPolicies.Create(ctx, req)
 	if err != nil {
-		return fmt.Errorf("error creating network policy %v err = %w", name, err)
+		return diag.FromErr(fmt.Errorf("error creating network policy %v err = %w", name, err))
 	}
 	d.SetId(name)
 
-	return ReadNetworkPolicy(d, meta)
+	return ReadNetworkPolicy(ctx, d, meta)
 }
 
-// ReadNetworkPolicy implements schema.ReadFunc.
-func ReadNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
+// ReadNetworkPolicy implements schema.ReadContextFunc.
+func ReadNetworkPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	policyName := d.Id()
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	networkPolicy, err := client.NetworkPolicies.ShowByID(ctx, sdk.NewAccountObjectIdentifier(policyName))
@@ -112,38 +111,37 @@ func ReadNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 
 	policyDescriptions, err := client.NetworkPolicies.Describe(ctx, sdk.NewAccountObjectIdentifier(policyName))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("name", networkPolicy.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("comment", networkPolicy.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, desc := range policyDescriptions {
 		switch desc.Name {
 		case "ALLOWED_IP_LIST":
 			if err = d.Set("allowed_ip_list", strings.Split(desc.Value, ",")); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "BLOCKED_IP_LIST":
 			if err = d.Set("blocked_ip_list", strings.Split(desc.Value, ",")); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateNetworkPolicy implements schema.UpdateFunc.
-func UpdateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
+// UpdateNetworkPolicy implements schema.UpdateContextFunc.
+func UpdateNetworkPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Id()
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	baseReq := sdk.NewAlterNetworkPolicyRequest(sdk.NewAccountObjectIdentifier(name))
 
@@ -153,13 +151,13 @@ func UpdateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 		if c := comment.(string); c == "" {
 			err := client.NetworkPolicies.Alter(ctx, baseReq.WithUnsetComment(sdk.Bool(true)))
 			if err != nil {
-				return fmt.Errorf("error unsetting comment for network policy %v err = %w", name, err)
+				return diag.FromErr(fmt.Errorf("error unsetting comment for network policy %v err = %w", name, err))
 			}
 		} else {
 			setReq := sdk.NewNetworkPolicySetRequest().WithComment(sdk.String(comment.(string)))
 			err := client.NetworkPolicies.Alter(ctx, baseReq.WithSet(setReq))
 			if err != nil {
-				return fmt.Errorf("error updating comment for network policy %v err = %w", name, err)
+				return diag.FromErr(fmt.Errorf("error updating comment for network policy %v err = %w", name, err))
 			}
 		}
 	}
@@ -173,7 +171,7 @@ func UpdateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 		setReq := sdk.NewNetworkPolicySetRequest().WithAllowedIpList(ipRequests)
 		err := client.NetworkPolicies.Alter(ctx, baseReq.WithSet(setReq))
 		if err != nil {
-			return fmt.Errorf("error updating ALLOWED_IP_LIST for network policy %v err = %w", name, err)
+			return diag.FromErr(fmt.Errorf("error updating ALLOWED_IP_LIST for network policy %v err = %w", name, err))
 		}
 	}
 
@@ -186,23 +184,22 @@ func UpdateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 		setReq := sdk.NewNetworkPolicySetRequest().WithBlockedIpList(ipRequests)
 		err := client.NetworkPolicies.Alter(ctx, baseReq.WithSet(setReq))
 		if err != nil {
-			return fmt.Errorf("error updating BLOCKED_IP_LIST for network policy %v err = %w", name, err)
+			return diag.FromErr(fmt.Errorf("error updating BLOCKED_IP_LIST for network policy %v err = %w", name, err))
 		}
 	}
 
-	return ReadNetworkPolicy(d, meta)
+	return ReadNetworkPolicy(ctx, d, meta)
 }
 
-// DeleteNetworkPolicy implements schema.DeleteFunc.
-func DeleteNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
+// DeleteNetworkPolicy implements schema.DeleteContextFunc.
+func DeleteNetworkPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Id()
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	err := client.NetworkPolicies.Drop(ctx, sdk.NewDropNetworkPolicyRequest(sdk.NewAccountObjectIdentifier(name)))
 	if err != nil {
-		return fmt.Errorf("error deleting network policy %v err = %w", name, err)
+		return diag.FromErr(fmt.Errorf("error deleting network policy %v err = %w", name, err))
 	}
 
 	d.SetId("")
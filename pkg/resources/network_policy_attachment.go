@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -35,10 +36,10 @@ var networkPolicyAttachmentSchema = map[string]*schema.Schema{
 // NetworkPolicyAttachment returns a pointer to the resource representing a network policy attachment.
 func NetworkPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateNetworkPolicyAttachment,
-		Read:   ReadNetworkPolicyAttachment,
-		Update: UpdateNetworkPolicyAttachment,
-		Delete: DeleteNetworkPolicyAttachment,
+		CreateContext: CreateNetworkPolicyAttachment,
+		ReadContext:   ReadNetworkPolicyAttachment,
+		UpdateContext: UpdateNetworkPolicyAttachment,
+		DeleteContext: DeleteNetworkPolicyAttachment,
 
 		Schema: networkPolicyAttachmentSchema,
 		Importer: &schema.ResourceImporter{
@@ -47,42 +48,41 @@ func NetworkPolicyAttachment() *schema.Resource {
 	}
 }
 
-// CreateNetworkPolicyAttachment implements schema.CreateFunc.
-func CreateNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+// CreateNetworkPolicyAttachment implements schema.CreateContextFunc.
+func CreateNetworkPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	policyName := d.Get("network_policy_name").(string)
 	d.SetId(policyName + "_attachment")
 
 	if d.Get("set_for_account").(bool) {
-		if err := setOnAccount(d, meta); err != nil {
-			return fmt.Errorf("error creating attachment for network policy %v err = %w", policyName, err)
+		if err := setOnAccount(ctx, d, meta); err != nil {
+			return diag.FromErr(fmt.Errorf("error creating attachment for network policy %v err = %w", policyName, err))
 		}
 	}
 
 	if u, ok := d.GetOk("users"); ok {
 		users := expandStringList(u.(*schema.Set).List())
 
-		if err := ensureUserAlterPrivileges(users, meta); err != nil {
-			return err
+		if err := ensureUserAlterPrivileges(ctx, users, meta); err != nil {
+			return diag.FromErr(err)
 		}
 
-		if err := setOnUsers(users, d, meta); err != nil {
-			return fmt.Errorf("error creating attachment for network policy %v err = %w", policyName, err)
+		if err := setOnUsers(ctx, users, d, meta); err != nil {
+			return diag.FromErr(fmt.Errorf("error creating attachment for network policy %v err = %w", policyName, err))
 		}
 	}
 
-	return ReadNetworkPolicyAttachment(d, meta)
+	return ReadNetworkPolicyAttachment(ctx, d, meta)
 }
 
-// ReadNetworkPolicyAttachment implements schema.ReadFunc.
-func ReadNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+// ReadNetworkPolicyAttachment implements schema.ReadContextFunc.
+func ReadNetworkPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	policyName := strings.Replace(d.Id(), "_attachment", "", 1)
 
 	var currentUsers []string
 	if err := d.Set("network_policy_name", policyName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if u, ok := d.GetOk("users"); ok {
@@ -100,7 +100,7 @@ func ReadNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) error
 		}
 
 		if err := d.Set("users", currentUsers); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -117,22 +117,22 @@ func ReadNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if err := d.Set("set_for_account", isSetOnAccount); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-// UpdateNetworkPolicyAttachment implements schema.UpdateFunc.
-func UpdateNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+// UpdateNetworkPolicyAttachment implements schema.UpdateContextFunc.
+func UpdateNetworkPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.HasChange("set_for_account") {
 		oldAcctFlag, newAcctFlag := d.GetChange("set_for_account")
 		if newAcctFlag.(bool) {
-			if err := setOnAccount(d, meta); err != nil {
-				return err
+			if err := setOnAccount(ctx, d, meta); err != nil {
+				return diag.FromErr(err)
 			}
 		} else if !newAcctFlag.(bool) && oldAcctFlag == true {
-			if err := unsetOnAccount(d, meta); err != nil {
-				return err
+			if err := unsetOnAccount(ctx, d, meta); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -145,50 +145,50 @@ func UpdateNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) err
 		removedUsers := expandStringList(oldUsersSet.Difference(newUsersSet).List())
 		addedUsers := expandStringList(newUsersSet.Difference(oldUsersSet).List())
 
-		if err := ensureUserAlterPrivileges(removedUsers, meta); err != nil {
-			return err
+		if err := ensureUserAlterPrivileges(ctx, removedUsers, meta); err != nil {
+			return diag.FromErr(err)
 		}
 
-		if err := ensureUserAlterPrivileges(addedUsers, meta); err != nil {
-			return err
+		if err := ensureUserAlterPrivileges(ctx, addedUsers, meta); err != nil {
+			return diag.FromErr(err)
 		}
 
 		for _, user := range removedUsers {
-			if err := unsetOnUser(user, d, meta); err != nil {
-				return err
+			if err := unsetOnUser(ctx, user, d, meta); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 
 		for _, user := range addedUsers {
-			if err := setOnUser(user, d, meta); err != nil {
-				return err
+			if err := setOnUser(ctx, user, d, meta); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return ReadNetworkPolicyAttachment(d, meta)
+	return ReadNetworkPolicyAttachment(ctx, d, meta)
 }
 
-// DeleteNetworkPolicyAttachment implements schema.DeleteFunc.
-func DeleteNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+// DeleteNetworkPolicyAttachment implements schema.DeleteContextFunc.
+func DeleteNetworkPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	policyName := d.Get("network_policy_name").(string)
 	d.SetId(policyName + "_attachment")
 
 	if d.Get("set_for_account").(bool) {
-		if err := unsetOnAccount(d, meta); err != nil {
-			return fmt.Errorf("error deleting attachment for network policy %v err = %w", policyName, err)
+		if err := unsetOnAccount(ctx, d, meta); err != nil {
+			return diag.FromErr(fmt.Errorf("error deleting attachment for network policy %v err = %w", policyName, err))
 		}
 	}
 
 	if u, ok := d.GetOk("users"); ok {
 		users := expandStringList(u.(*schema.Set).List())
 
-		if err := ensureUserAlterPrivileges(users, meta); err != nil {
-			return err
+		if err := ensureUserAlterPrivileges(ctx, users, meta); err != nil {
+			return diag.FromErr(err)
 		}
 
-		if err := unsetOnUsers(users, d, meta); err != nil {
-			return fmt.Errorf("error deleting attachment for network policy %v err = %w", policyName, err)
+		if err := unsetOnUsers(ctx, users, d, meta); err != nil {
+			return diag.FromErr(fmt.Errorf("error deleting attachment for network policy %v err = %w", policyName, err))
 		}
 	}
 
@@ -197,9 +197,8 @@ func DeleteNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) err
 
 // setOnAccount sets the network policy globally for the Snowflake account
 // Note: the ip address of the session executing this SQL must be allowed by the network policy being set.
-func setOnAccount(d *schema.ResourceData, meta interface{}) error {
+func setOnAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	policyName := d.Get("network_policy_name").(string)
 
@@ -212,9 +211,8 @@ func setOnAccount(d *schema.ResourceData, meta interface{}) error {
 }
 
 // setOnAccount unsets the network policy globally for the Snowflake account.
-func unsetOnAccount(d *schema.ResourceData, meta interface{}) error {
+func unsetOnAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	policyName := d.Get("network_policy_name").(string)
 
@@ -227,10 +225,10 @@ func unsetOnAccount(d *schema.ResourceData, meta interface{}) error {
 }
 
 // setOnUsers sets the network policy for list of users.
-func setOnUsers(users []string, data *schema.ResourceData, meta interface{}) error {
+func setOnUsers(ctx context.Context, users []string, data *schema.ResourceData, meta interface{}) error {
 	policyName := data.Get("network_policy_name").(string)
 	for _, user := range users {
-		if err := setOnUser(user, data, meta); err != nil {
+		if err := setOnUser(ctx, user, data, meta); err != nil {
 			return fmt.Errorf("error setting network policy %v on user %v err = %w", policyName, user, err)
 		}
 	}
@@ -239,9 +237,8 @@ func setOnUsers(users []string, data *schema.ResourceData, meta interface{}) err
 }
 
 // setOnUser sets the network policy for a given user.
-func setOnUser(user string, data *schema.ResourceData, meta interface{}) error {
+func setOnUser(ctx context.Context, user string, data *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	policyName := data.Get("network_policy_name").(string)
 
@@ -254,10 +251,10 @@ func setOnUser(user string, data *schema.ResourceData, meta interface{}) error {
 }
 
 // unsetOnUsers unsets the network policy for list of users.
-func unsetOnUsers(users []string, data *schema.ResourceData, meta interface{}) error {
+func unsetOnUsers(ctx context.Context, users []string, data *schema.ResourceData, meta interface{}) error {
 	policyName := data.Get("network_policy_name").(string)
 	for _, user := range users {
-		if err := unsetOnUser(user, data, meta); err != nil {
+		if err := unsetOnUser(ctx, user, data, meta); err != nil {
 			return fmt.Errorf("error unsetting network policy %v on user %v err = %w", policyName, user, err)
 		}
 	}
@@ -266,9 +263,8 @@ func unsetOnUsers(users []string, data *schema.ResourceData, meta interface{}) e
 }
 
 // unsetOnUser sets the network policy for a given user.
-func unsetOnUser(user string, data *schema.ResourceData, meta interface{}) error {
+func unsetOnUser(ctx context.Context, user string, data *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	policyName := data.Get("network_policy_name").(string)
 
@@ -281,9 +277,8 @@ func unsetOnUser(user string, data *schema.ResourceData, meta interface{}) error
 }
 
 // ensureUserAlterPrivileges ensures the executing Snowflake user can alter each user in the set of users.
-func ensureUserAlterPrivileges(users []string, meta interface{}) error {
+func ensureUserAlterPrivileges(ctx context.Context, users []string, meta interface{}) error {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	for _, user := range users {
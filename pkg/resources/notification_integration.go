@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -149,10 +150,10 @@ var notificationIntegrationSchema = map[string]*schema.Schema{
 // NotificationIntegration returns a pointer to the resource representing a notification integration.
 func NotificationIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateNotificationIntegration,
-		Read:   ReadNotificationIntegration,
-		Update: UpdateNotificationIntegration,
-		Delete: DeleteNotificationIntegration,
+		CreateContext: CreateNotificationIntegration,
+		ReadContext:   ReadNotificationIntegration,
+		UpdateContext: UpdateNotificationIntegration,
+		DeleteContext: DeleteNotificationIntegration,
 
 		Schema: notificationIntegrationSchema,
 		Importer: &schema.ResourceImporter{
@@ -161,10 +162,9 @@ func NotificationIntegration() *schema.Resource {
 	}
 }
 
-// CreateNotificationIntegration implements schema.CreateFunc.
-func CreateNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
+// CreateNotificationIntegration implements schema.CreateContextFunc.
+func CreateNotificationIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	name := d.Get("name").(string)
@@ -182,11 +182,11 @@ func CreateNotificationIntegration(d *schema.ResourceData, meta interface{}) err
 	case "AWS_SNS":
 		topic, ok := d.GetOk("aws_sns_topic_arn")
 		if !ok {
-			return fmt.Errorf("if you use AWS_SNS provider you must specify an aws_sns_topic_arn")
+			return diag.FromErr(fmt.Errorf("if you use AWS_SNS provider you must specify an aws_sns_topic_arn"))
 		}
 		role, ok := d.GetOk("aws_sns_role_arn")
 		if !ok {
-			return fmt.Errorf("if you use AWS_SNS provider you must specify an aws_sns_role_arn")
+			return diag.FromErr(fmt.Errorf("if you use AWS_SNS provider you must specify an aws_sns_role_arn"))
 		}
 		createRequest.WithPushNotificationParams(
 			sdk.NewPushNotificationParamsRequest().WithAmazonPushParams(sdk.NewAmazonPushParamsRequest(topic.(string), role.(string))),
@@ -205,33 +205,32 @@ func CreateNotificationIntegration(d *schema.ResourceData, meta interface{}) err
 	case "AZURE_STORAGE_QUEUE":
 		uri, ok := d.GetOk("azure_storage_queue_primary_uri")
 		if !ok {
-			return fmt.Errorf("if you use AZURE_STORAGE_QUEUE provider you must specify an azure_storage_queue_primary_uri")
+			return diag.FromErr(fmt.Errorf("if you use AZURE_STORAGE_QUEUE provider you must specify an azure_storage_queue_primary_uri"))
 		}
 		tenantId, ok := d.GetOk("azure_tenant_id")
 		if !ok {
-			return fmt.Errorf("if you use AZURE_STORAGE_QUEUE provider you must specify an azure_tenant_id")
+			return diag.FromErr(fmt.Errorf("if you use AZURE_STORAGE_QUEUE provider you must specify an azure_tenant_id"))
 		}
 		createRequest.WithAutomatedDataLoadsParams(
 			sdk.NewAutomatedDataLoadsParamsRequest().WithAzureAutoParams(sdk.NewAzureAutoParamsRequest(uri.(string), tenantId.(string))),
 		)
 	default:
-		return fmt.Errorf("unexpected provider %v", notificationProvider)
+		return diag.FromErr(fmt.Errorf("unexpected provider %v", notificationProvider))
 	}
 
 	err := client.NotificationIntegrations.Create(ctx, createRequest)
 	if err != nil {
-		return fmt.Errorf("error creating notification integration: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating notification integration: %w", err))
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(id))
 
-	return ReadNotificationIntegration(d, meta)
+	return ReadNotificationIntegration(ctx, d, meta)
 }
 
-// ReadNotificationIntegration implements schema.ReadFunc.
-func ReadNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
+// ReadNotificationIntegration implements schema.ReadContextFunc.
+func ReadNotificationIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
 
@@ -239,28 +238,28 @@ func ReadNotificationIntegration(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		log.Printf("[DEBUG] notification integration (%s) not found", d.Id())
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Note: category must be NOTIFICATION or something is broken
 	if c := integration.Category; c != "NOTIFICATION" {
-		return fmt.Errorf("expected %v to be a NOTIFICATION integration, got %v", id, c)
+		return diag.FromErr(fmt.Errorf("expected %v to be a NOTIFICATION integration, got %v", id, c))
 	}
 
 	if err := d.Set("name", integration.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("comment", integration.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("created_on", integration.CreatedOn.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("enabled", integration.Enabled); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Snowflake returns "QUEUE - AZURE_STORAGE_QUEUE" instead of simple "QUEUE" as a type
@@ -268,13 +267,13 @@ func ReadNotificationIntegration(d *schema.ResourceData, meta interface{}) error
 	typeParts := strings.Split(integration.NotificationType, "-")
 	parsedType := strings.TrimSpace(typeParts[0])
 	if err := d.Set("type", parsedType); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Some properties come from the DESCRIBE INTEGRATION call
 	integrationProperties, err := client.NotificationIntegrations.Describe(ctx, id)
 	if err != nil {
-		return fmt.Errorf("could not describe notification integration: %w", err)
+		return diag.FromErr(fmt.Errorf("could not describe notification integration: %w", err))
 	}
 	for _, property := range integrationProperties {
 		name := property.Name
@@ -284,72 +283,71 @@ func ReadNotificationIntegration(d *schema.ResourceData, meta interface{}) error
 			// We set this using the SHOW INTEGRATION call so let's ignore it here
 		case "DIRECTION":
 			if err := d.Set("direction", value); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "NOTIFICATION_PROVIDER":
 			if err := d.Set("notification_provider", value); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "AZURE_STORAGE_QUEUE_PRIMARY_URI":
 			if err := d.Set("azure_storage_queue_primary_uri", value); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			// NOTIFICATION_PROVIDER is not returned for azure automated data load, so we set it manually in such a case
 			if err := d.Set("notification_provider", "AZURE_STORAGE_QUEUE"); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "AZURE_TENANT_ID":
 			if err := d.Set("azure_tenant_id", value); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "AWS_SNS_TOPIC_ARN":
 			if err := d.Set("aws_sns_topic_arn", value); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "AWS_SNS_ROLE_ARN":
 			if err := d.Set("aws_sns_role_arn", value); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "SF_AWS_EXTERNAL_ID":
 			if err := d.Set("aws_sns_external_id", value); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "SF_AWS_IAM_USER_ARN":
 			if err := d.Set("aws_sns_iam_user_arn", value); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "GCP_PUBSUB_SUBSCRIPTION_NAME":
 			if err := d.Set("gcp_pubsub_subscription_name", value); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			// NOTIFICATION_PROVIDER is not returned for gcp, so we set it manually in such a case
 			if err := d.Set("notification_provider", "GCP_PUBSUB"); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "GCP_PUBSUB_TOPIC_NAME":
 			if err := d.Set("gcp_pubsub_topic_name", value); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			// NOTIFICATION_PROVIDER is not returned for gcp, so we set it manually in such a case
 			if err := d.Set("notification_provider", "GCP_PUBSUB"); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "GCP_PUBSUB_SERVICE_ACCOUNT":
 			if err := d.Set("gcp_pubsub_service_account", value); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		default:
 			log.Printf("[WARN] unexpected property %v returned from Snowflake", name)
 		}
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateNotificationIntegration implements schema.UpdateFunc.
-func UpdateNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
+// UpdateNotificationIntegration implements schema.UpdateContextFunc.
+func UpdateNotificationIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
 
@@ -378,29 +376,28 @@ func UpdateNotificationIntegration(d *schema.ResourceData, meta interface{}) err
 	case "AZURE_STORAGE_QUEUE":
 		log.Printf("[WARN] all AZURE_STORAGE_QUEUE properties should recreate the resource")
 	default:
-		return fmt.Errorf("unexpected provider %v", notificationProvider)
+		return diag.FromErr(fmt.Errorf("unexpected provider %v", notificationProvider))
 	}
 
 	if runSetStatement {
 		err := client.NotificationIntegrations.Alter(ctx, sdk.NewAlterNotificationIntegrationRequest(id).WithSet(setRequest))
 		if err != nil {
-			return fmt.Errorf("error updating notification integration: %w", err)
+			return diag.FromErr(fmt.Errorf("error updating notification integration: %w", err))
 		}
 	}
 
-	return ReadNotificationIntegration(d, meta)
+	return ReadNotificationIntegration(ctx, d, meta)
 }
 
-// DeleteNotificationIntegration implements schema.DeleteFunc.
-func DeleteNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
+// DeleteNotificationIntegration implements schema.DeleteContextFunc.
+func DeleteNotificationIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
 
 	err := client.NotificationIntegrations.Drop(ctx, sdk.NewDropNotificationIntegrationRequest(id))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -8,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -85,10 +87,10 @@ var oauthIntegrationSchema = map[string]*schema.Schema{
 // OAuthIntegration returns a pointer to the resource representing an OAuth integration.
 func OAuthIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateOAuthIntegration,
-		Read:   ReadOAuthIntegration,
-		Update: UpdateOAuthIntegration,
-		Delete: DeleteOAuthIntegration,
+		CreateContext: CreateOAuthIntegration,
+		ReadContext:   ReadOAuthIntegration,
+		UpdateContext: UpdateOAuthIntegration,
+		DeleteContext: DeleteOAuthIntegration,
 
 		Schema: oauthIntegrationSchema,
 		Importer: &schema.ResourceImporter{
@@ -97,8 +99,8 @@ func OAuthIntegration() *schema.Resource {
 	}
 }
 
-// CreateOAuthIntegration implements schema.CreateFunc.
-func CreateOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
+// CreateOAuthIntegration implements schema.CreateContextFunc.
+func CreateOAuthIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 
@@ -133,53 +135,53 @@ func CreateOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
 		stmt.SetString(`COMMENT`, d.Get("comment").(string))
 	}
 
-	if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-		return fmt.Errorf("error creating security integration err = %w", err)
+	if err := snowflake.Exec(ctx, db, stmt.Statement()); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating security integration err = %w", err))
 	}
 
 	d.SetId(name)
 
-	return ReadOAuthIntegration(d, meta)
+	return ReadOAuthIntegration(ctx, d, meta)
 }
 
-// ReadOAuthIntegration implements schema.ReadFunc.
-func ReadOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
+// ReadOAuthIntegration implements schema.ReadContextFunc.
+func ReadOAuthIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
 	stmt := snowflake.NewOAuthIntegrationBuilder(id).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRow(ctx, db, stmt)
 
 	// Some properties can come from the SHOW INTEGRATION call
 
 	s, err := snowflake.ScanOAuthIntegration(row)
 	if err != nil {
-		return fmt.Errorf("could not show security integration err = %w", err)
+		return diag.FromErr(fmt.Errorf("could not show security integration err = %w", err))
 	}
 
 	// Note: category must be Security or something is broken
 	if c := s.Category.String; c != "SECURITY" {
-		return fmt.Errorf("expected %v to be an Security integration, got %v err = %w", id, c, err)
+		return diag.FromErr(fmt.Errorf("expected %v to be an Security integration, got %v err = %w", id, c, err))
 	}
 
 	if err := d.Set("oauth_client", strings.TrimPrefix(s.IntegrationType.String, "OAUTH - ")); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("name", s.Name.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("enabled", s.Enabled.Bool); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("comment", s.Comment.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("created_on", s.CreatedOn.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Some properties come from the DESCRIBE INTEGRATION call
@@ -187,14 +189,14 @@ func ReadOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
 	var k, pType string
 	var v, unused interface{}
 	stmt = snowflake.NewOAuthIntegrationBuilder(id).Describe()
-	rows, err := db.Query(stmt)
+	rows, err := db.QueryContext(ctx, stmt)
 	if err != nil {
-		return fmt.Errorf("could not describe security integration err = %w", err)
+		return diag.FromErr(fmt.Errorf("could not describe security integration err = %w", err))
 	}
 	defer rows.Close()
 	for rows.Next() {
 		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
-			return fmt.Errorf("unable to parse security integration rows err = %w", err)
+			return diag.FromErr(fmt.Errorf("unable to parse security integration rows err = %w", err))
 		}
 		switch k {
 		case "ENABLED":
@@ -204,22 +206,22 @@ func ReadOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
 		case "OAUTH_ISSUE_REFRESH_TOKENS":
 			b, err := strconv.ParseBool(v.(string))
 			if err != nil {
-				return fmt.Errorf("returned OAuth issue refresh tokens that is not boolean err = %w", err)
+				return diag.FromErr(fmt.Errorf("returned OAuth issue refresh tokens that is not boolean err = %w", err))
 			}
 			if err := d.Set("oauth_issue_refresh_tokens", b); err != nil {
-				return fmt.Errorf("unable to set OAuth issue refresh tokens for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set OAuth issue refresh tokens for security integration err = %w", err))
 			}
 		case "OAUTH_REFRESH_TOKEN_VALIDITY":
 			i, err := strconv.Atoi(v.(string))
 			if err != nil {
-				return fmt.Errorf("returned OAuth refresh token validity that is not integer err = %w", err)
+				return diag.FromErr(fmt.Errorf("returned OAuth refresh token validity that is not integer err = %w", err))
 			}
 			if err := d.Set("oauth_refresh_token_validity", i); err != nil {
-				return fmt.Errorf("unable to set OAuth refresh token validity for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set OAuth refresh token validity for security integration err = %w", err))
 			}
 		case "OAUTH_USE_SECONDARY_ROLES":
 			if err := d.Set("oauth_use_secondary_roles", v.(string)); err != nil {
-				return fmt.Errorf("unable to set OAuth use secondary roles for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set OAuth use secondary roles for security integration err = %w", err))
 			}
 		case "BLOCKED_ROLES_LIST":
 			blockedRolesAll := strings.Split(v.(string), ",")
@@ -234,18 +236,18 @@ func ReadOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			if err := d.Set("blocked_roles_list", blockedRolesCustom); err != nil {
-				return fmt.Errorf("unable to set blocked roles list for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set blocked roles list for security integration err = %w", err))
 			}
 		case "OAUTH_REDIRECT_URI":
 			if err := d.Set("oauth_redirect_uri", v.(string)); err != nil {
-				return fmt.Errorf("unable to set OAuth redirect URI for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set OAuth redirect URI for security integration err = %w", err))
 			}
 		case "OAUTH_CLIENT_TYPE":
 			isTableau := strings.HasSuffix(s.IntegrationType.String, "TABLEAU_DESKTOP") ||
 				strings.HasSuffix(s.IntegrationType.String, "TABLEAU_SERVER")
 			if !isTableau {
 				if err = d.Set("oauth_client_type", v.(string)); err != nil {
-					return fmt.Errorf("unable to set OAuth client type for security integration err = %w", err)
+					return diag.FromErr(fmt.Errorf("unable to set OAuth client type for security integration err = %w", err))
 				}
 			}
 		case "OAUTH_ENFORCE_PKCE":
@@ -266,11 +268,11 @@ func ReadOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateOAuthIntegration implements schema.UpdateFunc.
-func UpdateOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
+// UpdateOAuthIntegration implements schema.UpdateContextFunc.
+func UpdateOAuthIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
@@ -324,15 +326,15 @@ func UpdateOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if runSetStatement {
-		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-			return fmt.Errorf("error updating security integration err = %w", err)
+		if err := snowflake.Exec(ctx, db, stmt.Statement()); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating security integration err = %w", err))
 		}
 	}
 
-	return ReadOAuthIntegration(d, meta)
+	return ReadOAuthIntegration(ctx, d, meta)
 }
 
-// DeleteOAuthIntegration implements schema.DeleteFunc.
-func DeleteOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("", snowflake.NewOAuthIntegrationBuilder)(d, meta)
+// DeleteOAuthIntegration implements schema.DeleteContextFunc.
+func DeleteOAuthIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diag.FromErr(DeleteResource(ctx, "", snowflake.NewOAuthIntegrationBuilder)(d, meta))
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -69,10 +70,10 @@ var objectParameterSchema = map[string]*schema.Schema{
 
 func ObjectParameter() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateObjectParameter,
-		Read:   ReadObjectParameter,
-		Update: UpdateObjectParameter,
-		Delete: DeleteObjectParameter,
+		CreateContext: CreateObjectParameter,
+		ReadContext:   ReadObjectParameter,
+		UpdateContext: UpdateObjectParameter,
+		DeleteContext: DeleteObjectParameter,
 
 		Schema: objectParameterSchema,
 		Importer: &schema.ResourceImporter{
@@ -81,13 +82,12 @@ func ObjectParameter() *schema.Resource {
 	}
 }
 
-// CreateObjectParameter implements schema.CreateFunc.
-func CreateObjectParameter(d *schema.ResourceData, meta interface{}) error {
+// CreateObjectParameter implements schema.CreateContextFunc.
+func CreateObjectParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	key := d.Get("key").(string)
 	value := d.Get("value").(string)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	parameter := sdk.ObjectParameter(key)
 
 	o := sdk.Object{}
@@ -103,12 +103,12 @@ func CreateObjectParameter(d *schema.ResourceData, meta interface{}) error {
 	if onAccount {
 		err := client.Parameters.SetObjectParameterOnAccount(ctx, parameter, value)
 		if err != nil {
-			return fmt.Errorf("error creating object parameter err = %w", err)
+			return diag.FromErr(fmt.Errorf("error creating object parameter err = %w", err))
 		}
 	} else {
 		err := client.Parameters.SetObjectParameterOnObject(ctx, o, parameter, value)
 		if err != nil {
-			return fmt.Errorf("error setting object parameter err = %w", err)
+			return diag.FromErr(fmt.Errorf("error setting object parameter err = %w", err))
 		}
 	}
 
@@ -128,19 +128,18 @@ func CreateObjectParameter(d *schema.ResourceData, meta interface{}) error {
 		p, err = client.Parameters.ShowObjectParameter(ctx, sdk.ObjectParameter(key), o)
 	}
 	if err != nil {
-		return fmt.Errorf("error reading object parameter err = %w", err)
+		return diag.FromErr(fmt.Errorf("error reading object parameter err = %w", err))
 	}
 	err = d.Set("value", p.Value)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-// ReadObjectParameter implements schema.ReadFunc.
-func ReadObjectParameter(d *schema.ResourceData, meta interface{}) error {
+// ReadObjectParameter implements schema.ReadContextFunc.
+func ReadObjectParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := d.Id()
 	parts := strings.Split(id, "|")
@@ -148,7 +147,7 @@ func ReadObjectParameter(d *schema.ResourceData, meta interface{}) error {
 		parts = strings.Split(id, "❄️") // for backwards compatibility
 	}
 	if len(parts) != 3 {
-		return fmt.Errorf("unexpected format of ID (%v), expected key|object_type|object_identifier", id)
+		return diag.FromErr(fmt.Errorf("unexpected format of ID (%v), expected key|object_type|object_identifier", id))
 	}
 	key := parts[0]
 	var p *sdk.Parameter
@@ -165,36 +164,35 @@ func ReadObjectParameter(d *schema.ResourceData, meta interface{}) error {
 		})
 	}
 	if err != nil {
-		return fmt.Errorf("error reading object parameter err = %w", err)
+		return diag.FromErr(fmt.Errorf("error reading object parameter err = %w", err))
 	}
 	if err := d.Set("value", p.Value); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-// UpdateObjectParameter implements schema.UpdateFunc.
-func UpdateObjectParameter(d *schema.ResourceData, meta interface{}) error {
-	return CreateObjectParameter(d, meta)
+// UpdateObjectParameter implements schema.UpdateContextFunc.
+func UpdateObjectParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return CreateObjectParameter(ctx, d, meta)
 }
 
-// DeleteObjectParameter implements schema.DeleteFunc.
-func DeleteObjectParameter(d *schema.ResourceData, meta interface{}) error {
+// DeleteObjectParameter implements schema.DeleteContextFunc.
+func DeleteObjectParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	key := d.Get("key").(string)
 
 	onAccount := d.Get("on_account").(bool)
 	if onAccount {
 		defaultParameter, err := client.Parameters.ShowAccountParameter(ctx, sdk.AccountParameter(key))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		defaultValue := defaultParameter.Default
 		err = client.Parameters.SetAccountParameter(ctx, sdk.AccountParameter(key), defaultValue)
 		if err != nil {
-			return fmt.Errorf("error resetting account parameter err = %w", err)
+			return diag.FromErr(fmt.Errorf("error resetting account parameter err = %w", err))
 		}
 	} else {
 		v := d.Get("object_identifier")
@@ -208,12 +206,12 @@ func DeleteObjectParameter(d *schema.ResourceData, meta interface{}) error {
 		objectParameter := sdk.ObjectParameter(key)
 		defaultParameter, err := client.Parameters.ShowObjectParameter(ctx, objectParameter, o)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		defaultValue := defaultParameter.Default
 		err = client.Parameters.SetObjectParameterOnObject(ctx, o, objectParameter, defaultValue)
 		if err != nil {
-			return fmt.Errorf("error resetting object parameter err = %w", err)
+			return diag.FromErr(fmt.Errorf("error resetting object parameter err = %w", err))
 		}
 	}
 	d.SetId("")
@@ -0,0 +1,306 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var parameterSetObjectTypes = []string{
+	string(sdk.ObjectTypeAccount),
+	string(sdk.ObjectTypeUser),
+	string(sdk.ObjectTypeWarehouse),
+	string(sdk.ObjectTypeDatabase),
+	string(sdk.ObjectTypeSchema),
+	string(sdk.ObjectTypeTable),
+	string(sdk.ObjectTypeTask),
+}
+
+var parameterSetSchema = map[string]*schema.Schema{
+	"object_type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  fmt.Sprintf("Type of the object on which the parameters are set. Valid values are: %s.", strings.Join(parameterSetObjectTypes, " | ")),
+		ValidateFunc: validation.StringInSlice(parameterSetObjectTypes, false),
+	},
+	"object_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Fully qualified name of the object on which the parameters are set (e.g. `database.schema.table`). Has to be omitted for ACCOUNT.",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			oldId, oldErr := helpers.DecodeSnowflakeParameterID(old)
+			newId, newErr := helpers.DecodeSnowflakeParameterID(new)
+			return oldErr == nil && newErr == nil && oldId.FullyQualifiedName() == newId.FullyQualifiedName()
+		},
+	},
+	"parameters": {
+		Type:        schema.TypeMap,
+		Required:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Map of parameter names (in upper case) to their values. The values are quoted according to the type of the parameter, e.g. a string parameter set to `1` is passed as `'1'`. Parameters removed from the map are unset on the object.",
+		ValidateDiagFunc: func(v any, path cty.Path) diag.Diagnostics {
+			var diags diag.Diagnostics
+			for k := range v.(map[string]any) {
+				if k != strings.ToUpper(k) {
+					diags = append(diags, diag.Diagnostic{
+						Severity:      diag.Error,
+						Summary:       "Invalid parameter name",
+						Detail:        fmt.Sprintf("parameter name %s has to be upper case", k),
+						AttributePath: path,
+					})
+				}
+			}
+			return diags
+		},
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
+	},
+	"reset_unmanaged": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "If true, the resource manages all parameters set directly on the object: parameters that are not present in the `parameters` map are reset to their inherited or default values.",
+	},
+}
+
+// ParameterSet returns a pointer to the resource authoritatively managing parameters of a single object.
+func ParameterSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateParameterSet,
+		ReadContext:   ReadParameterSet,
+		UpdateContext: UpdateParameterSet,
+		DeleteContext: DeleteParameterSet,
+
+		Schema: parameterSetSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportParameterSet,
+		},
+	}
+}
+
+func parameterSetObjectFromId(id string) (sdk.Object, error) {
+	parts := strings.SplitN(id, helpers.IDDelimiter, 2)
+	if len(parts) != 2 {
+		return sdk.Object{}, fmt.Errorf("unexpected format of ID (%v), expected object_type|object_name", id)
+	}
+	return helpers.DecodeParameterObject(sdk.ObjectType(parts[0]), parts[1])
+}
+
+func parameterSetId(object sdk.Object) string {
+	if object.ObjectType == sdk.ObjectTypeAccount {
+		return helpers.EncodeSnowflakeID(string(object.ObjectType), "")
+	}
+	return helpers.EncodeSnowflakeID(string(object.ObjectType), object.Name.FullyQualifiedName())
+}
+
+func expandParameterSet(v any) map[string]string {
+	parameters := make(map[string]string)
+	for k, value := range v.(map[string]any) {
+		parameters[k] = value.(string)
+	}
+	return parameters
+}
+
+// diffParameterSet compares the old and the new parameters maps and returns the sorted names of the parameters removed
+// from the map, which should be unset, and the parameters added or changed, which should be set.
+func diffParameterSet(o, n any) ([]string, map[string]string) {
+	oldParameters, newParameters := expandParameterSet(o), expandParameterSet(n)
+
+	toUnset := make([]string, 0)
+	for k := range oldParameters {
+		if _, ok := newParameters[k]; !ok {
+			toUnset = append(toUnset, k)
+		}
+	}
+	slices.Sort(toUnset)
+	toSet := make(map[string]string)
+	for k, v := range newParameters {
+		if oldValue, ok := oldParameters[k]; !ok || !strings.EqualFold(oldValue, v) {
+			toSet[k] = v
+		}
+	}
+	return toUnset, toSet
+}
+
+// unsetUnmanagedParameters unsets the parameters set directly on the object which are not in the managed ones.
+func unsetUnmanagedParameters(ctx context.Context, client *sdk.Client, object sdk.Object, managed map[string]string) error {
+	parameters, err := client.Parameters.ShowParametersForObject(ctx, object)
+	if err != nil {
+		return fmt.Errorf("error reading parameters of %s: %w", object.ObjectType, err)
+	}
+	toUnset := make([]string, 0)
+	for _, parameter := range parameters {
+		if string(parameter.Level) != string(object.ObjectType) {
+			continue
+		}
+		if _, ok := managed[parameter.Key]; !ok {
+			toUnset = append(toUnset, parameter.Key)
+		}
+	}
+	slices.Sort(toUnset)
+	if err := client.Parameters.UnsetParametersOnObject(ctx, object, toUnset); err != nil {
+		return fmt.Errorf("error unsetting unmanaged parameters on %s: %w", object.ObjectType, err)
+	}
+	return nil
+}
+
+func ImportParameterSet(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := sdk.NewClientFromDB(meta.(*sql.DB))
+
+	object, err := parameterSetObjectFromId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("object_type", string(object.ObjectType)); err != nil {
+		return nil, err
+	}
+	if object.ObjectType != sdk.ObjectTypeAccount {
+		if err := d.Set("object_name", object.Name.FullyQualifiedName()); err != nil {
+			return nil, err
+		}
+	}
+
+	parameters, err := client.Parameters.ShowParametersForObject(ctx, object)
+	if err != nil {
+		return nil, err
+	}
+	setOnObject := make(map[string]any)
+	for _, parameter := range parameters {
+		if string(parameter.Level) == string(object.ObjectType) {
+			setOnObject[parameter.Key] = parameter.Value
+		}
+	}
+	if err := d.Set("parameters", setOnObject); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateParameterSet(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := sdk.NewClientFromDB(meta.(*sql.DB))
+
+	object, err := helpers.DecodeParameterObject(sdk.ObjectType(d.Get("object_type").(string)), d.Get("object_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	managed := expandParameterSet(d.Get("parameters"))
+	if err := client.Parameters.SetParametersOnObject(ctx, object, managed); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting parameters on %s: %w", object.ObjectType, err))
+	}
+	if d.Get("reset_unmanaged").(bool) {
+		if err := unsetUnmanagedParameters(ctx, client, object, managed); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(parameterSetId(object))
+	return ReadParameterSet(ctx, d, meta)
+}
+
+// ReadParameterSet reads only parameters set directly on the object. Managed parameters which are no longer set on the
+// object are removed from the state. If reset_unmanaged is true, all other parameters set on the object are added to
+// the state, so that they are unset during the next apply.
+func ReadParameterSet(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := sdk.NewClientFromDB(meta.(*sql.DB))
+
+	object, err := parameterSetObjectFromId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parameters, err := client.Parameters.ShowParametersForObject(ctx, object)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			id := d.Id()
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Object: %s, Err: %s", id, err),
+				},
+			}
+		}
+		return diag.FromErr(fmt.Errorf("error reading parameters of %s: %w", d.Id(), err))
+	}
+
+	managed := expandParameterSet(d.Get("parameters"))
+	resetUnmanaged := d.Get("reset_unmanaged").(bool)
+	current := make(map[string]any)
+	for _, parameter := range parameters {
+		if string(parameter.Level) != string(object.ObjectType) {
+			continue
+		}
+		if _, ok := managed[parameter.Key]; ok || resetUnmanaged {
+			current[parameter.Key] = parameter.Value
+		}
+	}
+
+	if err := d.Set("parameters", current); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func UpdateParameterSet(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := sdk.NewClientFromDB(meta.(*sql.DB))
+
+	object, err := parameterSetObjectFromId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("parameters") {
+		toUnset, toSet := diffParameterSet(d.GetChange("parameters"))
+
+		if err := client.Parameters.UnsetParametersOnObject(ctx, object, toUnset); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting parameters on %s: %w", object.ObjectType, err))
+		}
+		if err := client.Parameters.SetParametersOnObject(ctx, object, toSet); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting parameters on %s: %w", object.ObjectType, err))
+		}
+	}
+	// also covers the parameters set outside of Terraform after the last refresh and reset_unmanaged switched to true
+	if d.Get("reset_unmanaged").(bool) {
+		if err := unsetUnmanagedParameters(ctx, client, object, expandParameterSet(d.Get("parameters"))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadParameterSet(ctx, d, meta)
+}
+
+func DeleteParameterSet(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := sdk.NewClientFromDB(meta.(*sql.DB))
+
+	object, err := parameterSetObjectFromId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keys := make([]string, 0)
+	for k := range expandParameterSet(d.Get("parameters")) {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	if err := client.Parameters.UnsetParametersOnObject(ctx, object, keys); err != nil {
+		return diag.FromErr(fmt.Errorf("error unsetting parameters on %s: %w", object.ObjectType, err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,113 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcc_ParameterSet(t *testing.T) {
+	databaseName := "TEST_DB_" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_parameter_set.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: parameterSetConfig(databaseName, false, map[string]string{
+					"DATA_RETENTION_TIME_IN_DAYS":     "3",
+					"SUSPEND_TASK_AFTER_NUM_FAILURES": "5",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf(`DATABASE|"%s"`, databaseName)),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.DATA_RETENTION_TIME_IN_DAYS", "3"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.SUSPEND_TASK_AFTER_NUM_FAILURES", "5"),
+				),
+			},
+			// remove one parameter and change the other one
+			{
+				Config: parameterSetConfig(databaseName, false, map[string]string{
+					"DATA_RETENTION_TIME_IN_DAYS": "4",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.DATA_RETENTION_TIME_IN_DAYS", "4"),
+				),
+			},
+			// parameters set outside of terraform are ignored without reset_unmanaged
+			{
+				PreConfig: func() {
+					setDatabaseParameterOutsideTerraform(t, databaseName, "MAX_DATA_EXTENSION_TIME_IN_DAYS", "10")
+				},
+				Config: parameterSetConfig(databaseName, false, map[string]string{
+					"DATA_RETENTION_TIME_IN_DAYS": "4",
+				}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()},
+				},
+			},
+			// parameters set outside of terraform are reset with reset_unmanaged
+			{
+				Config: parameterSetConfig(databaseName, true, map[string]string{
+					"DATA_RETENTION_TIME_IN_DAYS": "4",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"object_name", "reset_unmanaged"},
+			},
+		},
+	})
+}
+
+func parameterSetConfig(databaseName string, resetUnmanaged bool, parameters map[string]string) string {
+	var sb strings.Builder
+	for k, v := range parameters {
+		sb.WriteString(fmt.Sprintf("\t\t%s = \"%s\"\n", k, v))
+	}
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]s"
+}
+
+resource "snowflake_parameter_set" "test" {
+	object_type     = "DATABASE"
+	object_name     = snowflake_database.test.name
+	reset_unmanaged = %[2]t
+	parameters = {
+%[3]s	}
+}
+`, databaseName, resetUnmanaged, sb.String())
+}
+
+func setDatabaseParameterOutsideTerraform(t *testing.T, databaseName string, key string, value string) {
+	t.Helper()
+
+	client, err := sdk.NewDefaultClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	object := sdk.Object{ObjectType: sdk.ObjectTypeDatabase, Name: sdk.NewAccountObjectIdentifier(databaseName)}
+	err = client.Parameters.SetParametersOnObject(ctx, object, map[string]string{key: value})
+	require.NoError(t, err)
+}
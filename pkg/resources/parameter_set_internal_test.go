@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffParameterSet(t *testing.T) {
+	toUnset, toSet := diffParameterSet(
+		map[string]any{"QUERY_TAG": "etl", "TIMEZONE": "UTC", "WEEK_START": "1", "JSON_INDENT": "2"},
+		map[string]any{"QUERY_TAG": "etl", "TIMEZONE": "utc", "WEEK_START": "0", "STATEMENT_TIMEOUT_IN_SECONDS": "60"},
+	)
+
+	assert.Equal(t, []string{"JSON_INDENT"}, toUnset)
+	assert.Equal(t, map[string]string{"WEEK_START": "0", "STATEMENT_TIMEOUT_IN_SECONDS": "60"}, toSet)
+
+	toUnset, toSet = diffParameterSet(map[string]any{}, map[string]any{})
+	assert.Empty(t, toUnset)
+	assert.Empty(t, toSet)
+}
+
+func TestUnsetUnmanagedParameters(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	rows := sqlmock.NewRows([]string{"key", "value", "default", "level", "description"}).
+		AddRow("STATEMENT_TIMEOUT_IN_SECONDS", "60", "172800", "WAREHOUSE", "").
+		AddRow("MAX_CONCURRENCY_LEVEL", "4", "8", "WAREHOUSE", "").
+		AddRow("STATEMENT_QUEUED_TIMEOUT_IN_SECONDS", "10", "0", "ACCOUNT", "")
+	mock.ExpectQuery(`SHOW PARAMETERS IN WAREHOUSE "WH"`).WillReturnRows(rows)
+	mock.ExpectExec(`ALTER WAREHOUSE "WH" UNSET MAX_CONCURRENCY_LEVEL`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	object := sdk.Object{ObjectType: sdk.ObjectTypeWarehouse, Name: sdk.NewAccountObjectIdentifier("WH")}
+	err = unsetUnmanagedParameters(context.Background(), sdk.NewClientFromDB(db), object, map[string]string{"STATEMENT_TIMEOUT_IN_SECONDS": "60"})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
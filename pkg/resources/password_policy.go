@@ -6,6 +6,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -139,11 +140,11 @@ var passwordPolicySchema = map[string]*schema.Schema{
 
 func PasswordPolicy() *schema.Resource {
 	return &schema.Resource{
-		Description: "A password policy specifies the requirements that must be met to create and reset a password to authenticate to Snowflake.",
-		Create:      CreatePasswordPolicy,
-		Read:        ReadPasswordPolicy,
-		Update:      UpdatePasswordPolicy,
-		Delete:      DeletePasswordPolicy,
+		Description:   "A password policy specifies the requirements that must be met to create and reset a password to authenticate to Snowflake.",
+		CreateContext: CreatePasswordPolicy,
+		ReadContext:   ReadPasswordPolicy,
+		UpdateContext: UpdatePasswordPolicy,
+		DeleteContext: DeletePasswordPolicy,
 
 		Schema: passwordPolicySchema,
 		Importer: &schema.ResourceImporter{
@@ -152,11 +153,10 @@ func PasswordPolicy() *schema.Resource {
 	}
 }
 
-// CreatePasswordPolicy implements schema.CreateFunc.
-func CreatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
+// CreatePasswordPolicy implements schema.CreateContextFunc.
+func CreatePasswordPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
@@ -184,87 +184,85 @@ func CreatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 
 	err := client.PasswordPolicies.Create(ctx, objectIdentifier, createOptions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
-	return ReadPasswordPolicy(d, meta)
+	return ReadPasswordPolicy(ctx, d, meta)
 }
 
-// ReadPasswordPolicy implements schema.ReadFunc.
-func ReadPasswordPolicy(d *schema.ResourceData, meta interface{}) error {
+// ReadPasswordPolicy implements schema.ReadContextFunc.
+func ReadPasswordPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
 	if err := d.Set("qualified_name", objectIdentifier.FullyQualifiedName()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	passwordPolicy, err := client.PasswordPolicies.ShowByID(ctx, objectIdentifier)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("database", passwordPolicy.DatabaseName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("schema", passwordPolicy.SchemaName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("name", passwordPolicy.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("comment", passwordPolicy.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	passwordPolicyDetails, err := client.PasswordPolicies.Describe(ctx, objectIdentifier)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := setIntProperty(d, "min_length", passwordPolicyDetails.PasswordMinLength); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setIntProperty(d, "max_length", passwordPolicyDetails.PasswordMaxLength); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setIntProperty(d, "min_upper_case_chars", passwordPolicyDetails.PasswordMinUpperCaseChars); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setIntProperty(d, "min_lower_case_chars", passwordPolicyDetails.PasswordMinLowerCaseChars); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setIntProperty(d, "min_numeric_chars", passwordPolicyDetails.PasswordMinNumericChars); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setIntProperty(d, "min_special_chars", passwordPolicyDetails.PasswordMinSpecialChars); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setIntProperty(d, "min_age_days", passwordPolicyDetails.PasswordMinAgeDays); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setIntProperty(d, "max_age_days", passwordPolicyDetails.PasswordMaxAgeDays); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setIntProperty(d, "max_retries", passwordPolicyDetails.PasswordMaxRetries); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setIntProperty(d, "lockout_time_mins", passwordPolicyDetails.PasswordLockoutTimeMins); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setIntProperty(d, "history", passwordPolicyDetails.PasswordHistory); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-// UpdatePasswordPolicy implements schema.UpdateFunc.
-func UpdatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
+// UpdatePasswordPolicy implements schema.UpdateContextFunc.
+func UpdatePasswordPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
@@ -276,7 +274,7 @@ func UpdatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if d.HasChange("max_length") {
@@ -287,7 +285,7 @@ func UpdatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if d.HasChange("min_upper_case_chars") {
@@ -298,7 +296,7 @@ func UpdatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if d.HasChange("min_lower_case_chars") {
@@ -309,7 +307,7 @@ func UpdatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -321,7 +319,7 @@ func UpdatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -333,7 +331,7 @@ func UpdatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -345,7 +343,7 @@ func UpdatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -357,7 +355,7 @@ func UpdatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -369,7 +367,7 @@ func UpdatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -381,7 +379,7 @@ func UpdatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -393,7 +391,7 @@ func UpdatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -410,7 +408,7 @@ func UpdatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -423,23 +421,22 @@ func UpdatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(helpers.EncodeSnowflakeID(newID))
 	}
 
-	return ReadPasswordPolicy(d, meta)
+	return ReadPasswordPolicy(ctx, d, meta)
 }
 
-// DeletePasswordPolicy implements schema.DeleteFunc.
-func DeletePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
+// DeletePasswordPolicy implements schema.DeleteContextFunc.
+func DeletePasswordPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 	err := client.PasswordPolicies.Drop(ctx, objectIdentifier, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
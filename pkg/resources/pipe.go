@@ -9,11 +9,15 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
 	pipeIDDelimiter = '|'
+
+	pipeExecutionStatePaused = "PAUSED"
 )
 
 var pipeSchema = map[string]*schema.Schema{
@@ -80,16 +84,39 @@ var pipeSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies the name of the notification integration used for error notifications.",
 	},
+	"paused": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether the pipe is paused (PIPE_EXECUTION_PAUSED). A paused pipe does not load the new files; the notifications received in the meantime are processed when the pipe is resumed. It is read back with SYSTEM$PIPE_STATUS when the role has the OPERATE or MONITOR privilege on the pipe.",
+	},
+	"refresh_trigger": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Any value; setting or changing it queues the files staged within the last 7 days for loading (ALTER PIPE ... REFRESH). Removing it does not trigger a refresh. The refresh runs before pausing the pipe (or after resuming it); changing it while the pipe stays paused is rejected at plan time.",
+	},
+	"refresh_prefix": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Path (or prefix) appended to the stage reference in the pipe definition; only the files under it are queued by the refresh triggered by `refresh_trigger`.",
+	},
+	"refresh_modified_after": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsRFC3339Time,
+		Description:  "Timestamp (in RFC 3339 format); only the files modified after it are queued by the refresh triggered by `refresh_trigger`.",
+	},
 }
 
 func Pipe() *schema.Resource {
 	return &schema.Resource{
-		Create: CreatePipe,
-		Read:   ReadPipe,
-		Update: UpdatePipe,
-		Delete: DeletePipe,
+		CreateContext: CreatePipe,
+		ReadContext:   ReadPipe,
+		UpdateContext: UpdatePipe,
+		DeleteContext: DeletePipe,
 
-		Schema: pipeSchema,
+		Schema:        pipeSchema,
+		CustomizeDiff: customizePipeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -105,8 +132,8 @@ func pipeCopyStatementDiffSuppress(_, o, n string, _ *schema.ResourceData) bool
 	return strings.TrimRight(o, ";\r\n") == strings.TrimRight(n, ";\r\n")
 }
 
-// CreatePipe implements schema.CreateFunc.
-func CreatePipe(d *schema.ResourceData, meta interface{}) error {
+// CreatePipe implements schema.CreateContextFunc.
+func CreatePipe(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 
@@ -114,7 +141,6 @@ func CreatePipe(d *schema.ResourceData, meta interface{}) error {
 	schemaName := d.Get("schema").(string)
 	name := d.Get("name").(string)
 
-	ctx := context.Background()
 	objectIdentifier := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
 	opts := &sdk.CreatePipeOptions{}
@@ -144,21 +170,25 @@ func CreatePipe(d *schema.ResourceData, meta interface{}) error {
 
 	err := client.Pipes.Create(ctx, objectIdentifier, copyStatement, opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
 
-	return ReadPipe(d, meta)
+	_, refresh := d.GetOk("refresh_trigger")
+	if err := alterPipeExecution(ctx, client, objectIdentifier, d, false, refresh); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadPipe(ctx, d, meta)
 }
 
-// ReadPipe implements schema.ReadFunc.
-func ReadPipe(d *schema.ResourceData, meta interface{}) error {
+// ReadPipe implements schema.ReadContextFunc.
+func ReadPipe(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
-	ctx := context.Background()
 	pipe, err := client.Pipes.ShowByID(ctx, objectIdentifier)
 	if err != nil {
 		// If not found, mark resource to be removed from state file during apply or refresh
@@ -168,56 +198,80 @@ func ReadPipe(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("name", pipe.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("database", pipe.DatabaseName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("schema", pipe.SchemaName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("copy_statement", pipe.Definition); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("owner", pipe.Owner); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("comment", pipe.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("notification_channel", pipe.NotificationChannel); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("auto_ingest", pipe.NotificationChannel != ""); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if strings.Contains(pipe.NotificationChannel, "arn:aws:sns:") {
 		if err := d.Set("aws_sns_topic_arn", pipe.NotificationChannel); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if err := d.Set("error_integration", pipe.ErrorIntegration); err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+
+	paused, err := readPipePaused(ctx, client, objectIdentifier)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if paused != nil {
+		if err := d.Set("paused", *paused); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return nil
 }
 
-// UpdatePipe implements schema.UpdateFunc.
-func UpdatePipe(d *schema.ResourceData, meta interface{}) error {
+// readPipePaused returns whether the pipe is paused, as SYSTEM$PIPE_STATUS reports. It requires the OPERATE or MONITOR
+// privilege on the pipe; without them nil is returned, so the value in the state is kept.
+func readPipePaused(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier) (*bool, error) {
+	status, err := client.SystemFunctions.PipeStatus(ctx, id)
+	if isPrivilegeError(err) {
+		log.Printf("[WARN] unable to read the status of pipe %s, the paused value in the state is kept: %v", id.FullyQualifiedName(), err)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading the status of pipe %v err = %w", id.FullyQualifiedName(), err)
+	}
+	return sdk.Bool(status.ExecutionState == pipeExecutionStatePaused), nil
+}
+
+// UpdatePipe implements schema.UpdateContextFunc.
+func UpdatePipe(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
-	ctx := context.Background()
 
 	pipeSet := &sdk.PipeSet{}
 	pipeUnset := &sdk.PipeUnset{}
@@ -248,7 +302,7 @@ func UpdatePipe(d *schema.ResourceData, meta interface{}) error {
 		options := &sdk.AlterPipeOptions{Set: pipeSet}
 		err := client.Pipes.Alter(ctx, objectIdentifier, options)
 		if err != nil {
-			return fmt.Errorf("error updating pipe %v: %w", objectIdentifier.Name(), err)
+			return diag.FromErr(fmt.Errorf("error updating pipe %v: %w", objectIdentifier.Name(), err))
 		}
 	}
 
@@ -256,25 +310,81 @@ func UpdatePipe(d *schema.ResourceData, meta interface{}) error {
 		options := &sdk.AlterPipeOptions{Unset: pipeUnset}
 		err := client.Pipes.Alter(ctx, objectIdentifier, options)
 		if err != nil {
-			return fmt.Errorf("error updating pipe %v: %w", objectIdentifier.Name(), err)
+			return diag.FromErr(fmt.Errorf("error updating pipe %v: %w", objectIdentifier.Name(), err))
 		}
 	}
 
-	return ReadPipe(d, meta)
+	_, refresh := d.GetOk("refresh_trigger")
+	refresh = refresh && d.HasChange("refresh_trigger")
+	if refresh || d.HasChange("paused") {
+		wasPaused, _ := d.GetChange("paused")
+		if err := alterPipeExecution(ctx, client, objectIdentifier, d, wasPaused.(bool), refresh); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadPipe(ctx, d, meta)
 }
 
-// DeletePipe implements schema.DeleteFunc.
-func DeletePipe(d *schema.ResourceData, meta interface{}) error {
+// DeletePipe implements schema.DeleteContextFunc.
+func DeletePipe(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
 	err := client.Pipes.Drop(ctx, objectIdentifier)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
+
+// alterPipeExecution pauses or resumes the pipe and runs the requested refresh while the pipe is running:
+// before pausing it or after resuming it, as the REFRESH fails on a paused pipe.
+func alterPipeExecution(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData, wasPaused bool, refresh bool) error {
+	paused := d.Get("paused").(bool)
+	if refresh && !wasPaused {
+		if err := refreshPipe(ctx, client, id, d); err != nil {
+			return err
+		}
+	}
+	if paused != wasPaused {
+		if err := client.Pipes.Alter(ctx, id, &sdk.AlterPipeOptions{Set: &sdk.PipeSet{PipeExecutionPaused: sdk.Bool(paused)}}); err != nil {
+			return fmt.Errorf("error updating the execution state of pipe %v: %w", id.Name(), err)
+		}
+	}
+	if refresh && wasPaused {
+		if err := refreshPipe(ctx, client, id, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// customizePipeDiff rejects a refresh of a pipe which stays paused.
+func customizePipeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" || !d.HasChange("refresh_trigger") || d.Get("refresh_trigger").(string) == "" {
+		return nil
+	}
+	if wasPaused, paused := d.GetChange("paused"); wasPaused.(bool) && paused.(bool) {
+		return fmt.Errorf("pipe %v cannot be refreshed while it is paused; set paused to false to resume it", d.Get("name").(string))
+	}
+	return nil
+}
+
+// refreshPipe queues the staged files for loading, limited by the configured prefix and modification time.
+func refreshPipe(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData) error {
+	refresh := &sdk.PipeRefresh{}
+	if v, ok := d.GetOk("refresh_prefix"); ok {
+		refresh.Prefix = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("refresh_modified_after"); ok {
+		refresh.ModifiedAfter = sdk.String(v.(string))
+	}
+	if err := client.Pipes.Alter(ctx, id, &sdk.AlterPipeOptions{Refresh: refresh}); err != nil {
+		return fmt.Errorf("error refreshing pipe %v: %w", id.Name(), err)
+	}
+	return nil
+}
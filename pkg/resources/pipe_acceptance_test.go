@@ -9,6 +9,8 @@ import (
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAcc_Pipe(t *testing.T) {
@@ -31,6 +33,46 @@ func TestAcc_Pipe(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "comment", "Terraform acceptance test"),
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "auto_ingest", "false"),
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "notification_channel", ""),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "paused", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_Pipe_PausedAndRefresh(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_PIPE_TESTS"); ok {
+		t.Skip("Skipping TestAcc_Pipe_PausedAndRefresh")
+	}
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_pipe.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: pipeOperationalConfig(accName, true, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "paused", "true"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_trigger", "first"),
+				),
+			},
+			// resuming and refreshing are applied in place
+			{
+				Config: pipeOperationalConfig(accName, false, "second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "paused", "false"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_trigger", "second"),
 				),
 			},
 		},
@@ -78,3 +120,38 @@ CMD
 `
 	return fmt.Sprintf(s, databaseName, schemaName, name, name, databaseName, schemaName, databaseName, schemaName, name)
 }
+
+func pipeOperationalConfig(name string, paused bool, refreshTrigger string) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "test" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+	column {
+		name = "id"
+		type = "NUMBER(5,0)"
+	}
+}
+
+resource "snowflake_stage" "test" {
+	name     = "%[3]s"
+	database = "%[1]s"
+	schema   = "%[2]s"
+}
+
+resource "snowflake_pipe" "test" {
+	database               = "%[1]s"
+	schema                 = "%[2]s"
+	name                   = "%[3]s"
+	copy_statement         = <<CMD
+COPY INTO "${snowflake_table.test.database}"."${snowflake_table.test.schema}"."${snowflake_table.test.name}"
+  FROM @"${snowflake_stage.test.database}"."${snowflake_stage.test.schema}"."${snowflake_stage.test.name}"
+  FILE_FORMAT = (TYPE = CSV)
+CMD
+	paused                 = %[4]t
+	refresh_trigger        = "%[5]s"
+	refresh_prefix         = "/data"
+	refresh_modified_after = "2024-01-01T00:00:00Z"
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, paused, refreshTrigger)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -90,10 +91,10 @@ var pipeGrantSchema = map[string]*schema.Schema{
 func PipeGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreatePipeGrant,
-			Read:               ReadPipeGrant,
-			Delete:             DeletePipeGrant,
-			Update:             UpdatePipeGrant,
+			CreateContext:      CreatePipeGrant,
+			ReadContext:        ReadPipeGrant,
+			DeleteContext:      DeletePipeGrant,
+			UpdateContext:      UpdatePipeGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             pipeGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -133,8 +134,8 @@ func PipeGrant() *TerraformGrantResource {
 	}
 }
 
-// CreatePipeGrant implements schema.CreateFunc.
-func CreatePipeGrant(d *schema.ResourceData, meta interface{}) error {
+// CreatePipeGrant implements schema.CreateContextFunc.
+func CreatePipeGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	pipeName := d.Get("pipe_name").(string)
 	var schemaName string
 	if name, ok := d.GetOk("schema_name"); ok {
@@ -148,10 +149,10 @@ func CreatePipeGrant(d *schema.ResourceData, meta interface{}) error {
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	if (schemaName == "") && !onFuture {
-		return errors.New("schema_name must be set unless on_future is true")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future is true"))
 	}
 	if (pipeName == "") && !onFuture {
-		return errors.New("pipe_name must be set unless on_future is true")
+		return diag.FromErr(errors.New("pipe_name must be set unless on_future is true"))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -161,18 +162,18 @@ func CreatePipeGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.PipeGrant(databaseName, schemaName, pipeName)
 	}
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, pipeName, privilege, withGrantOption, onFuture, roles)
 	d.SetId(grantID)
 
-	return ReadPipeGrant(d, meta)
+	return ReadPipeGrant(ctx, d, meta)
 }
 
-// ReadPipeGrant implements schema.ReadFunc.
-func ReadPipeGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadPipeGrant implements schema.ReadContextFunc.
+func ReadPipeGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	pipeName := d.Get("pipe_name").(string)
@@ -181,10 +182,10 @@ func ReadPipeGrant(d *schema.ResourceData, meta interface{}) error {
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 	onFuture := d.Get("on_future").(bool)
 	if pipeName == "" && !onFuture {
-		return errors.New("pipe_name must be set unless on_future is true")
+		return diag.FromErr(errors.New("pipe_name must be set unless on_future is true"))
 	}
 	if pipeName != "" && onFuture {
-		return errors.New("pipe_name must not be set when on_future is true")
+		return diag.FromErr(errors.New("pipe_name must not be set when on_future is true"))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -196,9 +197,9 @@ func ReadPipeGrant(d *schema.ResourceData, meta interface{}) error {
 	// TODO
 	onAll := false
 
-	err := readGenericGrant(d, meta, pipeGrantSchema, builder, onFuture, onAll, validPipePrivileges)
+	err := readGenericGrant(ctx, d, meta, pipeGrantSchema, builder, onFuture, onAll, validPipePrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, pipeName, privilege, withGrantOption, onFuture, roles)
@@ -208,8 +209,8 @@ func ReadPipeGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeletePipeGrant implements schema.DeleteFunc.
-func DeletePipeGrant(d *schema.ResourceData, meta interface{}) error {
+// DeletePipeGrant implements schema.DeleteContextFunc.
+func DeletePipeGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	pipeName := d.Get("pipe_name").(string)
@@ -221,11 +222,11 @@ func DeletePipeGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		builder = snowflake.PipeGrant(databaseName, schemaName, pipeName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdatePipeGrant implements schema.UpdateFunc.
-func UpdatePipeGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdatePipeGrant implements schema.UpdateContextFunc.
+func UpdatePipeGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles") {
@@ -256,17 +257,17 @@ func UpdatePipeGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
+		ctx, meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
+		ctx, meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadPipeGrant(d, meta)
+	return ReadPipeGrant(ctx, d, meta)
 }
@@ -1,9 +1,15 @@
 package resources
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -103,3 +109,68 @@ func TestPipeCopyStatementDiffSuppress(t *testing.T) {
 		})
 	}
 }
+
+func TestReadPipePaused(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	client := sdk.NewClientFromDB(db)
+	id := sdk.NewSchemaObjectIdentifier("db", "schema", "pipe")
+	query := `SELECT SYSTEM$PIPE_STATUS('"db"."schema"."pipe"') AS "STATUS"`
+
+	mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"STATUS"}).AddRow(`{"executionState":"PAUSED","pendingFileCount":0}`))
+	paused, err := readPipePaused(context.Background(), client, id)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.Bool(true), paused)
+
+	// without the OPERATE or MONITOR privilege the value is unknown
+	mock.ExpectQuery(query).WillReturnError(errors.New("003001 (42501): SQL access control error: Insufficient privileges to operate on pipe 'PIPE'"))
+	paused, err = readPipePaused(context.Background(), client, id)
+	require.NoError(t, err)
+	assert.Nil(t, paused)
+
+	mock.ExpectQuery(query).WillReturnError(errors.New("warehouse is suspended"))
+	_, err = readPipePaused(context.Background(), client, id)
+	require.ErrorContains(t, err, "error reading the status of pipe")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAlterPipeExecution(t *testing.T) {
+	id := sdk.NewSchemaObjectIdentifier("db", "schema", "pipe")
+	refresh := `ALTER PIPE "db"."schema"."pipe" REFRESH PREFIX = 'd1/'`
+	pause := `ALTER PIPE "db"."schema"."pipe" SET PIPE_EXECUTION_PAUSED = true`
+	resume := `ALTER PIPE "db"."schema"."pipe" SET PIPE_EXECUTION_PAUSED = false`
+
+	testCases := []struct {
+		name      string
+		wasPaused bool
+		paused    bool
+		refresh   bool
+		queries   []string
+	}{
+		{name: "refresh before pausing", paused: true, refresh: true, queries: []string{refresh, pause}},
+		{name: "refresh after resuming", wasPaused: true, refresh: true, queries: []string{resume, refresh}},
+		{name: "refresh only", refresh: true, queries: []string{refresh}},
+		{name: "pause only", paused: true, queries: []string{pause}},
+		{name: "nothing to do", wasPaused: true, paused: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+			t.Cleanup(func() { db.Close() })
+			d := schema.TestResourceDataRaw(t, pipeSchema, map[string]any{
+				"paused":          tc.paused,
+				"refresh_trigger": "1",
+				"refresh_prefix":  "d1/",
+			})
+			for _, query := range tc.queries {
+				mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+			}
+
+			err = alterPipeExecution(context.Background(), sdk.NewClientFromDB(db), id, d, tc.wasPaused, tc.refresh)
+			require.NoError(t, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -169,6 +169,7 @@ var procedureSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 		Description: "The handler method for Java / Python procedures.",
 	},
+	"statement_comparison": statementComparisonSchema,
 }
 
 // Procedure returns a pointer to the resource representing a stored procedure.
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -111,10 +112,10 @@ var procedureGrantSchema = map[string]*schema.Schema{
 func ProcedureGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateProcedureGrant,
-			Read:               ReadProcedureGrant,
-			Delete:             DeleteProcedureGrant,
-			Update:             UpdateProcedureGrant,
+			CreateContext:      CreateProcedureGrant,
+			ReadContext:        ReadProcedureGrant,
+			DeleteContext:      DeleteProcedureGrant,
+			UpdateContext:      UpdateProcedureGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             procedureGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -163,8 +164,8 @@ func ProcedureGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateProcedureGrant implements schema.CreateFunc.
-func CreateProcedureGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateProcedureGrant implements schema.CreateContextFunc.
+func CreateProcedureGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	procedureName := d.Get("procedure_name").(string)
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -190,10 +191,10 @@ func CreateProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 	shares := expandStringList(d.Get("shares").(*schema.Set).List())
 
 	if (procedureName == "") && !onFuture && !onAll {
-		return errors.New("procedure_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("procedure_name must be set unless on_future or on_all is true"))
 	}
 	if (schemaName == "") && !onFuture && !onAll {
-		return errors.New("schema_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future or on_all is true"))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -206,17 +207,17 @@ func CreateProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.ProcedureGrant(databaseName, schemaName, procedureName, argumentDataTypes)
 	}
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, procedureName, argumentDataTypes, privilege, withGrantOption, onFuture, onAll, roles, shares)
 	d.SetId(grantID)
-	return ReadProcedureGrant(d, meta)
+	return ReadProcedureGrant(ctx, d, meta)
 }
 
-// ReadProcedureGrant implements schema.ReadFunc.
-func ReadProcedureGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadProcedureGrant implements schema.ReadContextFunc.
+func ReadProcedureGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	procedureName := d.Get("procedure_name").(string)
@@ -238,9 +239,9 @@ func ReadProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.ProcedureGrant(databaseName, schemaName, procedureName, argumentDataTypes)
 	}
 
-	err := readGenericGrant(d, meta, procedureGrantSchema, builder, onFuture, onAll, validProcedurePrivileges)
+	err := readGenericGrant(ctx, d, meta, procedureGrantSchema, builder, onFuture, onAll, validProcedurePrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, procedureName, argumentDataTypes, privilege, withGrantOption, onFuture, onAll, roles, shares)
@@ -250,8 +251,8 @@ func ReadProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteProcedureGrant implements schema.DeleteFunc.
-func DeleteProcedureGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteProcedureGrant implements schema.DeleteContextFunc.
+func DeleteProcedureGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	procedureName := d.Get("procedure_name").(string)
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -268,11 +269,11 @@ func DeleteProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 	default:
 		builder = snowflake.ProcedureGrant(databaseName, schemaName, procedureName, argumentDataTypes)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateProcedureGrant implements schema.UpdateFunc.
-func UpdateProcedureGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateProcedureGrant implements schema.UpdateContextFunc.
+func UpdateProcedureGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles", "shares") {
@@ -313,17 +314,17 @@ func UpdateProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, privilege, reversionRole, rolesToRevoke, sharesToRevoke,
+		ctx, meta, builder, privilege, reversionRole, rolesToRevoke, sharesToRevoke,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, privilege, withGrantOption, rolesToAdd, sharesToAdd,
+		ctx, meta, builder, privilege, withGrantOption, rolesToAdd, sharesToAdd,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadProcedureGrant(d, meta)
+	return ReadProcedureGrant(ctx, d, meta)
 }
@@ -0,0 +1,478 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// defaultProcedureDescription is returned by SHOW PROCEDURES for the procedures without a comment.
+const defaultProcedureDescription = "user-defined procedure"
+
+var (
+	procedureExecuteAs = []string{"CALLER", "OWNER"}
+	// procedureSnowparkPackages are the package prefixes of the Snowpark libraries required by the procedures.
+	procedureSnowparkPackages = map[routineLanguage]string{
+		routineLanguageJava:   "com.snowflake:snowpark:",
+		routineLanguagePython: "snowflake-snowpark-python",
+		routineLanguageScala:  "com.snowflake:snowpark:",
+	}
+)
+
+// ProcedureJava returns a pointer to the resource representing a procedure with a Java handler.
+func ProcedureJava() *schema.Resource {
+	return procedureForLanguage(routineLanguageJava)
+}
+
+// ProcedureJavascript returns a pointer to the resource representing a procedure written in JavaScript.
+func ProcedureJavascript() *schema.Resource {
+	return procedureForLanguage(routineLanguageJavascript)
+}
+
+// ProcedurePython returns a pointer to the resource representing a procedure with a Python handler.
+func ProcedurePython() *schema.Resource {
+	return procedureForLanguage(routineLanguagePython)
+}
+
+// ProcedureScala returns a pointer to the resource representing a procedure with a Scala handler.
+func ProcedureScala() *schema.Resource {
+	return procedureForLanguage(routineLanguageScala)
+}
+
+// ProcedureSql returns a pointer to the resource representing a procedure written in Snowflake Scripting.
+func ProcedureSql() *schema.Resource {
+	return procedureForLanguage(routineLanguageSQL)
+}
+
+func procedureForLanguageSchema(language routineLanguage) map[string]*schema.Schema {
+	s := routineSchema("procedure", language, "procedure_definition")
+	// ALTER PROCEDURE does not support SET SECURE
+	s["is_secure"].ForceNew = true
+	if language.hasHandler() {
+		s["packages"].Optional = false
+		s["packages"].Required = true
+		s["packages"].Description += fmt.Sprintf(" The Snowpark package (%s) is required.", strings.TrimSuffix(procedureSnowparkPackages[language], ":"))
+	}
+	s["null_input_behavior"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      routineNullInputBehaviors[0],
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(routineNullInputBehaviors, false),
+		Description:  fmt.Sprintf("Specifies the behavior of the procedure when called with null inputs. Valid values are: %s", strings.Join(routineNullInputBehaviors, " | ")),
+	}
+	s["execute_as"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "OWNER",
+		ValidateFunc: validation.StringInSlice(procedureExecuteAs, false),
+		Description:  fmt.Sprintf("Specifies whether the procedure executes with the privileges of the owner or with the privileges of the caller. Valid values are: %s", strings.Join(procedureExecuteAs, " | ")),
+	}
+	return s
+}
+
+func procedureForLanguage(language routineLanguage) *schema.Resource {
+	procedureSchema := procedureForLanguageSchema(language)
+	read := readProcedureForLanguage(procedureSchema)
+	return &schema.Resource{
+		Description: fmt.Sprintf("Resource used to manage procedures written in %s. For more information, check [procedure documentation](https://docs.snowflake.com/en/sql-reference/sql/create-procedure).", language.displayName()),
+
+		CreateContext: createProcedureForLanguage(language, read),
+		ReadContext:   read,
+		UpdateContext: updateProcedureForLanguage(procedureSchema, read),
+		DeleteContext: DeleteProcedureForLanguage,
+		CustomizeDiff: customizeProcedureDiff(language),
+
+		Schema: procedureSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func customizeProcedureDiff(language routineLanguage) schema.CustomizeDiffFunc {
+	if !language.hasHandler() {
+		return nil
+	}
+	return customdiff.All(
+		customizeRoutineDiff(language, "procedure_definition"),
+		func(_ context.Context, d *schema.ResourceDiff, _ any) error {
+			if !d.NewValueKnown("packages") {
+				return nil
+			}
+			for _, item := range d.Get("packages").(*schema.Set).List() {
+				if strings.HasPrefix(strings.ToLower(item.(string)), procedureSnowparkPackages[language]) {
+					return nil
+				}
+			}
+			return fmt.Errorf("packages must include the Snowpark package %s", strings.TrimSuffix(procedureSnowparkPackages[language], ":"))
+		},
+	)
+}
+
+func createProcedureForLanguage(language routineLanguage, read schema.ReadContextFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := sdk.NewClientFromDB(meta.(*sql.DB))
+		id, err := routineIdentifier(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := createProcedure(ctx, client, d, language, id.WithoutArguments()); err != nil {
+			return diag.Errorf("error creating procedure %s, err = %s", id.FullyQualifiedName(), err)
+		}
+		d.SetId(id.FullyQualifiedName())
+
+		if err := alterProcedureLevels(ctx, client, d, id, true); err != nil {
+			return diag.FromErr(err)
+		}
+		return read(ctx, d, meta)
+	}
+}
+
+func createProcedure(ctx context.Context, client *sdk.Client, d *schema.ResourceData, language routineLanguage, id sdk.SchemaObjectIdentifier) error {
+	returns, err := procedureReturns(d.Get("return_type").(string))
+	if err != nil {
+		return err
+	}
+	arguments := procedureArguments(d)
+	definition := d.Get("procedure_definition").(string)
+	secure := sdk.Bool(d.Get("is_secure").(bool))
+	nullInputBehavior := sdk.Pointer(sdk.NullInputBehavior(d.Get("null_input_behavior").(string)))
+	executeAs := sdk.Pointer(sdk.ExecuteAs("EXECUTE AS " + d.Get("execute_as").(string)))
+	var comment *string
+	if v := d.Get("comment").(string); v != "" {
+		comment = sdk.String(v)
+	}
+
+	switch language {
+	case routineLanguageJavascript:
+		if returns.ResultDataType == nil {
+			return errors.New("javascript procedures cannot return a table")
+		}
+		request := sdk.NewCreateForJavaScriptProcedureRequest(id, returns.ResultDataType.ResultDataType, definition).
+			WithSecure(secure).
+			WithArguments(arguments).
+			WithNullInputBehavior(nullInputBehavior).
+			WithComment(comment).
+			WithExecuteAs(executeAs)
+		return client.Procedures.CreateForJavaScript(ctx, request)
+	case routineLanguageSQL:
+		sqlReturns := sdk.NewProcedureSQLReturnsRequest()
+		if returns.Table != nil {
+			sqlReturns.WithTable(returns.Table)
+		} else {
+			sqlReturns.WithResultDataType(returns.ResultDataType)
+		}
+		request := sdk.NewCreateForSQLProcedureRequest(id, *sqlReturns, definition).
+			WithSecure(secure).
+			WithArguments(arguments).
+			WithNullInputBehavior(nullInputBehavior).
+			WithComment(comment).
+			WithExecuteAs(executeAs)
+		return client.Procedures.CreateForSQL(ctx, request)
+	}
+
+	secrets, err := getRoutineSecrets(d)
+	if err != nil {
+		return err
+	}
+	handler := d.Get("handler").(string)
+	runtimeVersion := d.Get("runtime_version").(string)
+	imports := procedureImports(d)
+	packages := procedurePackages(d)
+	integrations := getRoutineExternalAccessIntegrations(d)
+
+	switch language {
+	case routineLanguageJava:
+		request := sdk.NewCreateForJavaProcedureRequest(id, *returns, runtimeVersion, packages, handler).
+			WithSecure(secure).
+			WithArguments(arguments).
+			WithImports(imports).
+			WithExternalAccessIntegrations(integrations).
+			WithSecrets(secrets).
+			WithNullInputBehavior(nullInputBehavior).
+			WithComment(comment).
+			WithExecuteAs(executeAs)
+		if v := d.Get("target_path").(string); v != "" {
+			request.WithTargetPath(sdk.String(v))
+		}
+		if definition != "" {
+			request.WithProcedureDefinition(sdk.String(definition))
+		}
+		return client.Procedures.CreateForJava(ctx, request)
+	case routineLanguagePython:
+		request := sdk.NewCreateForPythonProcedureRequest(id, *returns, runtimeVersion, packages, handler).
+			WithSecure(secure).
+			WithArguments(arguments).
+			WithImports(imports).
+			WithExternalAccessIntegrations(integrations).
+			WithSecrets(secrets).
+			WithNullInputBehavior(nullInputBehavior).
+			WithComment(comment).
+			WithExecuteAs(executeAs)
+		if v := d.Get("artifact_repository").(string); v != "" {
+			request.WithArtifactRepository(sdk.String(v))
+		}
+		if definition != "" {
+			request.WithProcedureDefinition(sdk.String(definition))
+		}
+		return client.Procedures.CreateForPython(ctx, request)
+	case routineLanguageScala:
+		request := sdk.NewCreateForScalaProcedureRequest(id, *returns, runtimeVersion, packages, handler).
+			WithSecure(secure).
+			WithArguments(arguments).
+			WithImports(imports).
+			WithExternalAccessIntegrations(integrations).
+			WithSecrets(secrets).
+			WithNullInputBehavior(nullInputBehavior).
+			WithComment(comment).
+			WithExecuteAs(executeAs)
+		if v := d.Get("target_path").(string); v != "" {
+			request.WithTargetPath(sdk.String(v))
+		}
+		if definition != "" {
+			request.WithProcedureDefinition(sdk.String(definition))
+		}
+		return client.Procedures.CreateForScala(ctx, request)
+	default:
+		return fmt.Errorf("unsupported procedure language %s", language)
+	}
+}
+
+func readProcedureForLanguage(procedureSchema map[string]*schema.Schema) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := sdk.NewClientFromDB(meta.(*sql.DB))
+		id, err := routineIdentifierFromResourceId(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		details, err := client.Procedures.Describe(ctx, sdk.NewDescribeProcedureRequest(id.WithoutArguments(), id.Arguments()))
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+				log.Printf("[DEBUG] procedure (%s) not found or we are not authorized. Err: %s", id.FullyQualifiedName(), err)
+				d.SetId("")
+				return nil
+			}
+			return diag.Errorf("error describing procedure %s, err = %s", id.FullyQualifiedName(), err)
+		}
+		properties := make(map[string]string, len(details))
+		for _, detail := range details {
+			properties[detail.Property] = detail.Value
+		}
+
+		if err := d.Set("database", id.DatabaseName()); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("schema", id.SchemaName()); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("name", id.Name()); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := setRoutineDetails(d, procedureSchema, "procedure_definition", properties); err != nil {
+			return diag.FromErr(err)
+		}
+
+		request := sdk.NewShowProcedureRequest().
+			WithIn(&sdk.In{Schema: sdk.NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).
+			WithLike(&sdk.Like{Pattern: sdk.String(id.Name())})
+		procedures, err := client.Procedures.Show(ctx, request)
+		if err != nil {
+			return diag.Errorf("error listing procedures in schema %s.%s, err = %s", id.DatabaseName(), id.SchemaName(), err)
+		}
+		for _, procedure := range procedures {
+			if !routineSignatureMatches(procedure.Arguments, id) {
+				continue
+			}
+			if err := d.Set("is_secure", procedure.IsSecure); err != nil {
+				return diag.FromErr(err)
+			}
+			comment := procedure.Description
+			if comment == defaultProcedureDescription && d.Get("comment").(string) == "" {
+				comment = ""
+			}
+			if err := d.Set("comment", comment); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		return nil
+	}
+}
+
+func updateProcedureForLanguage(procedureSchema map[string]*schema.Schema, read schema.ReadContextFunc) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := sdk.NewClientFromDB(meta.(*sql.DB))
+		id, err := routineIdentifierFromResourceId(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		alter := func(configure func(*sdk.AlterProcedureRequest) *sdk.AlterProcedureRequest) error {
+			return client.Procedures.Alter(ctx, configure(sdk.NewAlterProcedureRequest(id.WithoutArguments(), id.Arguments())))
+		}
+
+		if d.HasChange("name") {
+			newId := sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), d.Get("name").(string), id.Arguments())
+			if err := alter(func(r *sdk.AlterProcedureRequest) *sdk.AlterProcedureRequest {
+				return r.WithRenameTo(sdk.Pointer(newId.WithoutArguments()))
+			}); err != nil {
+				return diag.Errorf("error renaming procedure %s to %s, err = %s", id.FullyQualifiedName(), newId.FullyQualifiedName(), err)
+			}
+			d.SetId(newId.FullyQualifiedName())
+			id = newId
+		}
+
+		if d.HasChange("comment") {
+			if err := alter(func(r *sdk.AlterProcedureRequest) *sdk.AlterProcedureRequest {
+				if v := d.Get("comment").(string); v != "" {
+					return r.WithSetComment(sdk.String(v))
+				}
+				return r.WithUnsetComment(sdk.Bool(true))
+			}); err != nil {
+				return diag.Errorf("error updating comment of procedure %s, err = %s", id.FullyQualifiedName(), err)
+			}
+		}
+
+		if d.HasChange("execute_as") {
+			if err := alter(func(r *sdk.AlterProcedureRequest) *sdk.AlterProcedureRequest {
+				return r.WithExecuteAs(sdk.Pointer(sdk.ExecuteAs("EXECUTE AS " + d.Get("execute_as").(string))))
+			}); err != nil {
+				return diag.Errorf("error updating execute as of procedure %s, err = %s", id.FullyQualifiedName(), err)
+			}
+		}
+
+		// removing all the integrations or secrets recreates the procedure (see customizeRoutineDiff)
+		if _, ok := procedureSchema["external_access_integrations"]; ok && d.HasChange("external_access_integrations") {
+			if integrations := getRoutineExternalAccessIntegrations(d); len(integrations) > 0 {
+				if err := alter(func(r *sdk.AlterProcedureRequest) *sdk.AlterProcedureRequest {
+					return r.WithSetExternalAccessIntegrations(integrations)
+				}); err != nil {
+					return diag.Errorf("error updating external access integrations of procedure %s, err = %s", id.FullyQualifiedName(), err)
+				}
+			}
+		}
+		if _, ok := procedureSchema["secrets"]; ok && d.HasChange("secrets") {
+			secrets, err := getRoutineSecrets(d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if len(secrets) > 0 {
+				if err := alter(func(r *sdk.AlterProcedureRequest) *sdk.AlterProcedureRequest {
+					return r.WithSetSecrets(secrets)
+				}); err != nil {
+					return diag.Errorf("error updating secrets of procedure %s, err = %s", id.FullyQualifiedName(), err)
+				}
+			}
+		}
+
+		if err := alterProcedureLevels(ctx, client, d, id, false); err != nil {
+			return diag.FromErr(err)
+		}
+		return read(ctx, d, meta)
+	}
+}
+
+// alterProcedureLevels sets log_level and trace_level, which cannot be specified in CREATE PROCEDURE.
+func alterProcedureLevels(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.SchemaObjectIdentifier, create bool) error {
+	if create || d.HasChange("log_level") {
+		request := sdk.NewAlterProcedureRequest(id.WithoutArguments(), id.Arguments())
+		switch v := d.Get("log_level").(string); {
+		case v != "":
+			request.WithSetLogLevel(sdk.String(v))
+		case !create:
+			request.WithUnsetLogLevel(sdk.Bool(true))
+		default:
+			request = nil
+		}
+		if request != nil {
+			if err := client.Procedures.Alter(ctx, request); err != nil {
+				return fmt.Errorf("error updating log level of procedure %s, err = %w", id.FullyQualifiedName(), err)
+			}
+		}
+	}
+	if create || d.HasChange("trace_level") {
+		request := sdk.NewAlterProcedureRequest(id.WithoutArguments(), id.Arguments())
+		switch v := d.Get("trace_level").(string); {
+		case v != "":
+			request.WithSetTraceLevel(sdk.String(v))
+		case !create:
+			request.WithUnsetTraceLevel(sdk.Bool(true))
+		default:
+			request = nil
+		}
+		if request != nil {
+			if err := client.Procedures.Alter(ctx, request); err != nil {
+				return fmt.Errorf("error updating trace level of procedure %s, err = %w", id.FullyQualifiedName(), err)
+			}
+		}
+	}
+	return nil
+}
+
+func DeleteProcedureForLanguage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := sdk.NewClientFromDB(meta.(*sql.DB))
+	id, err := routineIdentifierFromResourceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.Procedures.Drop(ctx, sdk.NewDropProcedureRequest(id.WithoutArguments(), id.Arguments()).WithIfExists(sdk.Bool(true))); err != nil {
+		return diag.Errorf("error dropping procedure %s, err = %s", id.FullyQualifiedName(), err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func procedureArguments(d *schema.ResourceData) []sdk.ProcedureArgumentRequest {
+	arguments := make([]sdk.ProcedureArgumentRequest, 0)
+	for _, argument := range getRoutineArguments(d) {
+		arguments = append(arguments, sdk.ProcedureArgumentRequest{
+			ArgName:      argument.Name,
+			ArgDataType:  sdk.DataType(argument.DataType),
+			DefaultValue: argument.DefaultValue,
+		})
+	}
+	return arguments
+}
+
+func procedureReturns(returnType string) (*sdk.ProcedureReturnsRequest, error) {
+	columns, isTable, err := parseRoutineTableColumns(returnType)
+	if err != nil {
+		return nil, err
+	}
+	returns := sdk.NewProcedureReturnsRequest()
+	if !isTable {
+		return returns.WithResultDataType(sdk.NewProcedureReturnsResultDataTypeRequest(sdk.DataType(normalizeRoutineDataType(returnType)))), nil
+	}
+	columnRequests := make([]sdk.ProcedureColumnRequest, 0, len(columns))
+	for _, column := range columns {
+		columnRequests = append(columnRequests, *sdk.NewProcedureColumnRequest(column.Name, sdk.DataType(column.DataType)))
+	}
+	return returns.WithTable(sdk.NewProcedureReturnsTableRequest().WithColumns(columnRequests)), nil
+}
+
+func procedureImports(d *schema.ResourceData) []sdk.ProcedureImportRequest {
+	imports := make([]sdk.ProcedureImportRequest, 0)
+	for _, item := range getRoutineStrings(d, "imports") {
+		imports = append(imports, *sdk.NewProcedureImportRequest(item))
+	}
+	return imports
+}
+
+func procedurePackages(d *schema.ResourceData) []sdk.ProcedurePackageRequest {
+	packages := make([]sdk.ProcedurePackageRequest, 0)
+	for _, item := range getRoutineStrings(d, "packages") {
+		packages = append(packages, *sdk.NewProcedurePackageRequest(item))
+	}
+	return packages
+}
@@ -0,0 +1,125 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ProcedureSql(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	newName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_procedure_sql.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: procedureSqlConfig(name, "first", "OWNER"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf(`"%s"."%s"."%s"(VARCHAR)`, acc.TestDatabaseName, acc.TestSchemaName, name)),
+					resource.TestCheckResourceAttr(resourceName, "comment", "first"),
+					resource.TestCheckResourceAttr(resourceName, "execute_as", "OWNER"),
+				),
+			},
+			// renaming, commenting and changing the caller rights are applied in place
+			{
+				Config: procedureSqlConfig(newName, "second", "CALLER"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf(`"%s"."%s"."%s"(VARCHAR)`, acc.TestDatabaseName, acc.TestSchemaName, newName)),
+					resource.TestCheckResourceAttr(resourceName, "comment", "second"),
+					resource.TestCheckResourceAttr(resourceName, "execute_as", "CALLER"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"log_level", "trace_level", "statement_comparison"},
+			},
+		},
+	})
+}
+
+func TestAcc_ProcedureJavascript(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_procedure_javascript.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: procedureJavascriptConfig(name, "return 1;"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "return_type", "FLOAT"),
+				),
+			},
+			// a new body recreates the procedure
+			{
+				Config: procedureJavascriptConfig(name, "return 2;"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(resourceName, "procedure_definition", "return 2;"),
+			},
+		},
+	})
+}
+
+func procedureSqlConfig(name string, comment string, executeAs string) string {
+	return fmt.Sprintf(`
+resource "snowflake_procedure_sql" "test" {
+	database = "%[1]s"
+	schema = "%[2]s"
+	name = "%[3]s"
+	comment = "%[4]s"
+	execute_as = "%[5]s"
+	arguments {
+		arg_name = "x"
+		arg_data_type = "VARCHAR"
+	}
+	return_type = "VARCHAR"
+	procedure_definition = <<EOT
+BEGIN
+  RETURN x;
+END;
+EOT
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, comment, executeAs)
+}
+
+func procedureJavascriptConfig(name string, definition string) string {
+	return fmt.Sprintf(`
+resource "snowflake_procedure_javascript" "test" {
+	database = "%[1]s"
+	schema = "%[2]s"
+	name = "%[3]s"
+	return_type = "FLOAT"
+	procedure_definition = "%[4]s"
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, definition)
+}
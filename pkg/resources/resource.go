@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
@@ -8,13 +9,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func DeleteResource(t string, builder func(string) *snowflake.Builder) func(*schema.ResourceData, interface{}) error {
+func DeleteResource(ctx context.Context, t string, builder func(string) *snowflake.Builder) func(*schema.ResourceData, interface{}) error {
 	return func(d *schema.ResourceData, meta interface{}) error {
 		db := meta.(*sql.DB)
 		name := d.Get("name").(string)
 
 		stmt := builder(name).Drop()
-		if err := snowflake.Exec(db, stmt); err != nil {
+		if err := snowflake.Exec(ctx, db, stmt); err != nil {
 			return fmt.Errorf("error dropping %s %s err = %w", t, name, err)
 		}
 
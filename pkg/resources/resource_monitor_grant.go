@@ -7,6 +7,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -58,10 +59,10 @@ var resourceMonitorGrantSchema = map[string]*schema.Schema{
 func ResourceMonitorGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateResourceMonitorGrant,
-			Read:               ReadResourceMonitorGrant,
-			Delete:             DeleteResourceMonitorGrant,
-			Update:             UpdateResourceMonitorGrant,
+			CreateContext:      CreateResourceMonitorGrant,
+			ReadContext:        ReadResourceMonitorGrant,
+			DeleteContext:      DeleteResourceMonitorGrant,
+			UpdateContext:      UpdateResourceMonitorGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Importer: &schema.ResourceImporter{
 				StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -90,35 +91,35 @@ func ResourceMonitorGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateResourceMonitorGrant implements schema.CreateFunc.
-func CreateResourceMonitorGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateResourceMonitorGrant implements schema.CreateContextFunc.
+func CreateResourceMonitorGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	monitorName := d.Get("monitor_name").(string)
 	privilege := d.Get("privilege").(string)
 	withGrantOption := d.Get("with_grant_option").(bool)
 	builder := snowflake.ResourceMonitorGrant(monitorName)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(monitorName, privilege, withGrantOption, roles)
 	d.SetId(grantID)
 
-	return ReadResourceMonitorGrant(d, meta)
+	return ReadResourceMonitorGrant(ctx, d, meta)
 }
 
-// ReadResourceMonitorGrant implements schema.ReadFunc.
-func ReadResourceMonitorGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadResourceMonitorGrant implements schema.ReadContextFunc.
+func ReadResourceMonitorGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	monitorName := d.Get("monitor_name").(string)
 	privilege := d.Get("privilege").(string)
 	withGrantOption := d.Get("with_grant_option").(bool)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	builder := snowflake.ResourceMonitorGrant(monitorName)
-	err := readGenericGrant(d, meta, resourceMonitorGrantSchema, builder, false, false, validResourceMonitorPrivileges)
+	err := readGenericGrant(ctx, d, meta, resourceMonitorGrantSchema, builder, false, false, validResourceMonitorPrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(monitorName, privilege, withGrantOption, roles)
@@ -128,17 +129,17 @@ func ReadResourceMonitorGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteResourceMonitorGrant implements schema.DeleteFunc.
-func DeleteResourceMonitorGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteResourceMonitorGrant implements schema.DeleteContextFunc.
+func DeleteResourceMonitorGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	monitorName := d.Get("monitor_name").(string)
 
 	builder := snowflake.ResourceMonitorGrant(monitorName)
 
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateResourceMonitorGrant implements schema.UpdateFunc.
-func UpdateResourceMonitorGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateResourceMonitorGrant implements schema.UpdateContextFunc.
+func UpdateResourceMonitorGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles") {
@@ -160,17 +161,17 @@ func UpdateResourceMonitorGrant(d *schema.ResourceData, meta interface{}) error
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, privilege, "", rolesToRevoke, []string{},
+		ctx, meta, builder, privilege, "", rolesToRevoke, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
+		ctx, meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadResourceMonitorGrant(d, meta)
+	return ReadResourceMonitorGrant(ctx, d, meta)
 }
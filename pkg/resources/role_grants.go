@@ -12,6 +12,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jmoiron/sqlx"
 	"github.com/snowflakedb/gosnowflake"
@@ -19,10 +20,10 @@ import (
 
 func RoleGrants() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateRoleGrants,
-		Read:   ReadRoleGrants,
-		Delete: DeleteRoleGrants,
-		Update: UpdateRoleGrants,
+		CreateContext: CreateRoleGrants,
+		ReadContext:   ReadRoleGrants,
+		DeleteContext: DeleteRoleGrants,
+		UpdateContext: UpdateRoleGrants,
 
 		Schema: map[string]*schema.Schema{
 			"role_name": {
@@ -76,43 +77,43 @@ func RoleGrants() *schema.Resource {
 	}
 }
 
-func CreateRoleGrants(d *schema.ResourceData, meta interface{}) error {
+func CreateRoleGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	roleName := d.Get("role_name").(string)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 	users := expandStringList(d.Get("users").(*schema.Set).List())
 
 	if len(roles) == 0 && len(users) == 0 {
-		return fmt.Errorf("no users or roles specified for role grants")
+		return diag.FromErr(fmt.Errorf("no users or roles specified for role grants"))
 	}
 
 	grantID := helpers.EncodeSnowflakeID(roleName, roles, users)
 	d.SetId(grantID)
 
 	for _, role := range roles {
-		if err := grantRoleToRole(db, roleName, role); err != nil {
-			return err
+		if err := grantRoleToRole(ctx, db, roleName, role); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	for _, user := range users {
-		if err := grantRoleToUser(db, roleName, user); err != nil {
-			return err
+		if err := grantRoleToUser(ctx, db, roleName, user); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	return ReadRoleGrants(d, meta)
+	return ReadRoleGrants(ctx, d, meta)
 }
 
-func grantRoleToRole(db *sql.DB, role1, role2 string) error {
+func grantRoleToRole(ctx context.Context, db *sql.DB, role1, role2 string) error {
 	g := snowflake.RoleGrant(role1)
-	err := snowflake.Exec(db, g.Role(role2).Grant())
+	err := snowflake.Exec(ctx, db, g.Role(role2).Grant())
 	return err
 }
 
-func grantRoleToUser(db *sql.DB, role1, user string) error {
+func grantRoleToUser(ctx context.Context, db *sql.DB, role1, user string) error {
 	g := snowflake.RoleGrant(role1)
-	err := snowflake.Exec(db, g.User(user).Grant())
+	err := snowflake.Exec(ctx, db, g.User(user).Grant())
 	return err
 }
 
@@ -124,7 +125,7 @@ type roleGrant struct {
 	Grantedby   sql.NullString `db:"granted_by"`
 }
 
-func ReadRoleGrants(d *schema.ResourceData, meta interface{}) error {
+func ReadRoleGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	roleName := d.Get("role_name").(string)
 
@@ -132,7 +133,7 @@ func ReadRoleGrants(d *schema.ResourceData, meta interface{}) error {
 	users := make([]string, 0)
 
 	builder := snowflake.NewRoleBuilder(db, roleName)
-	_, err := builder.Show()
+	_, err := builder.Show(ctx)
 	if errors.Is(err, sql.ErrNoRows) {
 		// If not found, mark resource to be removed from state file during apply or refresh
 		log.Printf("[DEBUG] role (%s) not found", roleName)
@@ -140,9 +141,9 @@ func ReadRoleGrants(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	grants, err := readGrants(db, roleName)
+	grants, err := readGrants(ctx, db, roleName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, grant := range grants {
@@ -165,10 +166,10 @@ func ReadRoleGrants(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("roles", roles); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("users", users); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(roleName, roles, users)
@@ -178,11 +179,11 @@ func ReadRoleGrants(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func readGrants(db *sql.DB, roleName string) ([]*roleGrant, error) {
+func readGrants(ctx context.Context, db *sql.DB, roleName string) ([]*roleGrant, error) {
 	sdb := sqlx.NewDb(db, "snowflake")
 
 	stmt := fmt.Sprintf(`SHOW GRANTS OF ROLE "%s"`, roleName)
-	rows, err := sdb.Queryx(stmt)
+	rows, err := sdb.QueryxContext(ctx, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -209,7 +210,7 @@ func readGrants(db *sql.DB, roleName string) ([]*roleGrant, error) {
 	return grants, nil
 }
 
-func DeleteRoleGrants(d *schema.ResourceData, meta interface{}) error {
+func DeleteRoleGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	roleName := d.Get("role_name").(string)
 
@@ -217,14 +218,14 @@ func DeleteRoleGrants(d *schema.ResourceData, meta interface{}) error {
 	users := expandStringList(d.Get("users").(*schema.Set).List())
 
 	for _, role := range roles {
-		if err := revokeRoleFromRole(db, roleName, role); err != nil {
-			return err
+		if err := revokeRoleFromRole(ctx, db, roleName, role); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	for _, user := range users {
-		if err := revokeRoleFromUser(db, roleName, user); err != nil {
-			return err
+		if err := revokeRoleFromUser(ctx, db, roleName, user); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -232,16 +233,16 @@ func DeleteRoleGrants(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func revokeRoleFromRole(db *sql.DB, role1, role2 string) error {
+func revokeRoleFromRole(ctx context.Context, db *sql.DB, role1, role2 string) error {
 	rg := snowflake.RoleGrant(role1).Role(role2)
-	err := snowflake.Exec(db, rg.Revoke())
+	err := snowflake.Exec(ctx, db, rg.Revoke())
 	log.Printf("revokeRoleFromRole %v", err)
 	if driverErr, ok := err.(*gosnowflake.SnowflakeError); ok { //nolint:errorlint // todo: should be fixed
 		if driverErr.Number == 2003 {
 			// handling error if a role has been deleted prior to revoking a role
 			// 002003 (02000): SQL compilation error:
 			// User 'XXX' does not exist or not authorized.
-			roles, _ := snowflake.ListRoles(db, role2)
+			roles, _ := snowflake.ListRoles(ctx, db, role2)
 			roleNames := make([]string, len(roles))
 			for i, r := range roles {
 				roleNames[i] = r.Name.String
@@ -255,12 +256,11 @@ func revokeRoleFromRole(db *sql.DB, role1, role2 string) error {
 	return err
 }
 
-func revokeRoleFromUser(db *sql.DB, role1, user string) error {
-	ctx := context.Background()
+func revokeRoleFromUser(ctx context.Context, db *sql.DB, role1, user string) error {
 	client := sdk.NewClientFromDB(db)
 
 	rg := snowflake.RoleGrant(role1).User(user)
-	err := snowflake.Exec(db, rg.Revoke())
+	err := snowflake.Exec(ctx, db, rg.Revoke())
 	if driverErr, ok := err.(*gosnowflake.SnowflakeError); ok { //nolint:errorlint // todo: should be fixed
 		// handling error if a user has been deleted prior to revoking a role
 		// 002003 (02000): SQL compilation error:
@@ -282,11 +282,11 @@ func revokeRoleFromUser(db *sql.DB, role1, user string) error {
 	return err
 }
 
-func UpdateRoleGrants(d *schema.ResourceData, meta interface{}) error {
+func UpdateRoleGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	roleName := d.Get("role_name").(string)
 
-	x := func(resource string, grant func(db *sql.DB, role string, target string) error, revoke func(db *sql.DB, role string, target string) error) error {
+	x := func(resource string, grant func(ctx context.Context, db *sql.DB, role string, target string) error, revoke func(ctx context.Context, db *sql.DB, role string, target string) error) error {
 		o, n := d.GetChange(resource)
 
 		if o == nil {
@@ -302,12 +302,12 @@ func UpdateRoleGrants(d *schema.ResourceData, meta interface{}) error {
 		add := expandStringList(ns.Difference(os).List())
 
 		for _, user := range remove {
-			if err := revoke(db, roleName, user); err != nil {
+			if err := revoke(ctx, db, roleName, user); err != nil {
 				return err
 			}
 		}
 		for _, user := range add {
-			if err := grant(db, roleName, user); err != nil {
+			if err := grant(ctx, db, roleName, user); err != nil {
 				return err
 			}
 		}
@@ -315,12 +315,12 @@ func UpdateRoleGrants(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := x("users", grantRoleToUser, revokeRoleFromUser); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := x("roles", grantRoleToRole, revokeRoleFromRole); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return ReadRoleGrants(d, meta)
+	return ReadRoleGrants(ctx, d, meta)
 }
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -57,40 +59,40 @@ var roleOwnershipGrantSchema = map[string]*schema.Schema{
 
 func RoleOwnershipGrant() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateRoleOwnershipGrant,
-		Read:   ReadRoleOwnershipGrant,
-		Delete: DeleteRoleOwnershipGrant,
-		Update: UpdateRoleOwnershipGrant,
-		Schema: roleOwnershipGrantSchema,
+		CreateContext: CreateRoleOwnershipGrant,
+		ReadContext:   ReadRoleOwnershipGrant,
+		DeleteContext: DeleteRoleOwnershipGrant,
+		UpdateContext: UpdateRoleOwnershipGrant,
+		Schema:        roleOwnershipGrantSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 
-func CreateRoleOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
+func CreateRoleOwnershipGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	onRoleName := d.Get("on_role_name").(string)
 	toRoleName := d.Get("to_role_name").(string)
 	currentGrants := d.Get("current_grants").(string)
 
 	g := snowflake.NewRoleOwnershipGrantBuilder(onRoleName, currentGrants)
-	if err := snowflake.Exec(db, g.Role(toRoleName).Grant()); err != nil {
-		return err
+	if err := snowflake.Exec(ctx, db, g.Role(toRoleName).Grant()); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf(`%s|%s|%s`, onRoleName, toRoleName, currentGrants))
 
-	return ReadRoleOwnershipGrant(d, meta)
+	return ReadRoleOwnershipGrant(ctx, d, meta)
 }
 
-func ReadRoleOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
+func ReadRoleOwnershipGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	onRoleName := strings.Split(d.Id(), "|")[0]
 	currentGrants := strings.Split(d.Id(), "|")[2]
 
 	stmt := fmt.Sprintf("SHOW ROLES LIKE '%s'", onRoleName)
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRow(ctx, db, stmt)
 
 	grant, err := snowflake.ScanRoleOwnershipGrant(row)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -100,33 +102,33 @@ func ReadRoleOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if onRoleName != grant.Name.String {
-		return fmt.Errorf("no role found like '%s'", onRoleName)
+		return diag.FromErr(fmt.Errorf("no role found like '%s'", onRoleName))
 	}
 
 	grant.Name.String = strings.TrimPrefix(grant.Name.String, `"`)
 	grant.Name.String = strings.TrimSuffix(grant.Name.String, `"`)
 	if err := d.Set("on_role_name", grant.Name.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant.Owner.String = strings.TrimPrefix(grant.Owner.String, `"`)
 	grant.Owner.String = strings.TrimSuffix(grant.Owner.String, `"`)
 	if err := d.Set("to_role_name", grant.Owner.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("current_grants", currentGrants); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func UpdateRoleOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
+func UpdateRoleOwnershipGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	onRoleName := d.Get("on_role_name").(string)
 	toRoleName := d.Get("to_role_name").(string)
@@ -135,22 +137,22 @@ func UpdateRoleOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(fmt.Sprintf(`%s|%s|%s`, onRoleName, toRoleName, currentGrants))
 
 	g := snowflake.NewRoleOwnershipGrantBuilder(onRoleName, currentGrants)
-	if err := snowflake.Exec(db, g.Role(toRoleName).Grant()); err != nil {
-		return err
+	if err := snowflake.Exec(ctx, db, g.Role(toRoleName).Grant()); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return ReadRoleOwnershipGrant(d, meta)
+	return ReadRoleOwnershipGrant(ctx, d, meta)
 }
 
-func DeleteRoleOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
+func DeleteRoleOwnershipGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	onRoleName := d.Get("on_role_name").(string)
 	currentGrants := d.Get("current_grants").(string)
 	reversionRole := d.Get("revert_ownership_to_role_name").(string)
 
 	g := snowflake.NewRoleOwnershipGrantBuilder(onRoleName, currentGrants)
-	if err := snowflake.Exec(db, g.Role(reversionRole).Revoke()); err != nil {
-		return err
+	if err := snowflake.Exec(ctx, db, g.Role(reversionRole).Revoke()); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
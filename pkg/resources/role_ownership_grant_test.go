@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -29,8 +30,8 @@ func TestRoleOwnershipGrantCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`GRANT OWNERSHIP ON ROLE "good_name" TO ROLE "other_good_name" COPY CURRENT GRANTS`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadRoleOwnershipGrant(mock)
-		err := resources.CreateRoleOwnershipGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateRoleOwnershipGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -45,8 +46,8 @@ func TestRoleOwnershipGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadRoleOwnershipGrant(mock)
-		err := resources.ReadRoleOwnershipGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadRoleOwnershipGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -77,7 +78,7 @@ func TestRoleOwnershipGrantDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`GRANT OWNERSHIP ON ROLE "good_name" TO ROLE "ACCOUNTADMIN" COPY CURRENT GRANTS`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteRoleOwnershipGrant(d, db)
-		r.NoError(err)
+		diags := resources.DeleteRoleOwnershipGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
@@ -0,0 +1,704 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Functions and procedures (routines) share the way their arguments, return types, identifiers and handler options are
+// described. The helpers below are used by the language-specific snowflake_function_* and snowflake_procedure_*
+// resources.
+
+type routineLanguage string
+
+const (
+	routineLanguageJava       routineLanguage = "JAVA"
+	routineLanguageJavascript routineLanguage = "JAVASCRIPT"
+	routineLanguagePython     routineLanguage = "PYTHON"
+	routineLanguageScala      routineLanguage = "SCALA"
+	routineLanguageSQL        routineLanguage = "SQL"
+)
+
+// displayName is the name of the language used in the resource descriptions.
+func (l routineLanguage) displayName() string {
+	switch l {
+	case routineLanguageJava:
+		return "Java"
+	case routineLanguageJavascript:
+		return "JavaScript"
+	case routineLanguagePython:
+		return "Python"
+	case routineLanguageScala:
+		return "Scala"
+	default:
+		return "SQL"
+	}
+}
+
+// hasHandler reports whether the code of the routine is run by a handler, either written inline or imported from a
+// stage. Only such routines support runtime_version, packages, imports, handler, external_access_integrations and
+// secrets.
+func (l routineLanguage) hasHandler() bool {
+	return l == routineLanguageJava || l == routineLanguagePython || l == routineLanguageScala
+}
+
+// hasTargetPath reports whether the inline code of the routine is compiled to a jar that can be stored in a stage.
+func (l routineLanguage) hasTargetPath() bool {
+	return l == routineLanguageJava || l == routineLanguageScala
+}
+
+var (
+	routineRuntimeVersionValidations = map[routineLanguage]schema.SchemaValidateFunc{ //nolint:staticcheck
+		routineLanguageJava:   validation.StringInSlice([]string{"11", "17"}, false),
+		routineLanguagePython: validation.StringMatch(regexp.MustCompile(`^3\.\d+$`), "must be a Python 3 version, e.g. 3.11"),
+		routineLanguageScala:  validation.StringInSlice([]string{"2.12"}, false),
+	}
+	routineHandlerValidations = map[routineLanguage]schema.SchemaValidateFunc{ //nolint:staticcheck
+		routineLanguageJava:   validation.StringMatch(regexp.MustCompile(`^[A-Za-z_$][\w$]*(\.[A-Za-z_$][\w$]*)+$`), "must be in the form of class.method, e.g. com.example.Handler.run"),
+		routineLanguagePython: validation.StringMatch(regexp.MustCompile(`^[A-Za-z_]\w*(\.[A-Za-z_]\w*)?$`), "must be a function name or module.function, e.g. run or handler.run"),
+		routineLanguageScala:  validation.StringMatch(regexp.MustCompile(`^[A-Za-z_$][\w$]*(\.[A-Za-z_$][\w$]*)+$`), "must be in the form of object.method, e.g. Handler.run"),
+	}
+	routinePackageValidations = map[routineLanguage]schema.SchemaValidateFunc{ //nolint:staticcheck
+		routineLanguageJava:   validation.StringMatch(regexp.MustCompile(`^[\w.-]+:[\w.-]+:[\w.-]+$`), "must be in the form of domain:package:version, e.g. com.snowflake:snowpark:1.14.0"),
+		routineLanguagePython: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][\w.-]*((==|>=|<=|>|<)[\w.*-]+)?$`), "must be a package name with an optional version specifier, e.g. numpy or numpy==1.26.4"),
+		routineLanguageScala:  validation.StringMatch(regexp.MustCompile(`^[\w.-]+:[\w.-]+:[\w.-]+$`), "must be in the form of domain:package:version, e.g. com.snowflake:snowpark:1.14.0"),
+	}
+	// STRICT is not used, because Snowflake then in the Read phase returns RETURNS NULL ON NULL INPUT
+	routineNullInputBehaviors = []string{"CALLED ON NULL INPUT", "RETURNS NULL ON NULL INPUT"}
+	routineLogLevels          = []string{
+		string(sdk.LogLevelTrace),
+		string(sdk.LogLevelDebug),
+		string(sdk.LogLevelInfo),
+		string(sdk.LogLevelWarn),
+		string(sdk.LogLevelError),
+		string(sdk.LogLevelFatal),
+		string(sdk.LogLevelOff),
+	}
+	routineTraceLevels = []string{
+		string(sdk.TraceLevelAlways),
+		string(sdk.TraceLevelOnEvent),
+		string(sdk.TraceLevelOff),
+	}
+)
+
+// routineSchema returns the attributes shared by all the function and procedure resources of the given language.
+// objectType is either "function" or "procedure" and definitionKey is the name of the attribute holding the code.
+func routineSchema(objectType string, language routineLanguage, definitionKey string) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"database": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("The database in which to create the %s.", objectType),
+		},
+		"schema": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("The schema in which to create the %s.", objectType),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: fmt.Sprintf("Specifies the identifier for the %[1]s; does not have to be unique for the schema in which the %[1]s is created, because %[1]ss are identified by their names and argument types.", objectType),
+		},
+		"arguments": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("List of the arguments for the %[1]s. Argument types are a part of the %[1]s identifier, so overloaded %[1]ss with the same name can be managed by separate resources.", objectType),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"arg_name": {
+						Type:     schema.TypeString,
+						Required: true,
+						ForceNew: true,
+						DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+							return strings.EqualFold(old, new)
+						},
+						Description: "The argument name.",
+					},
+					"arg_data_type": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ForceNew:         true,
+						ValidateFunc:     IsDataType(),
+						DiffSuppressFunc: suppressRoutineDataTypeDiff,
+						Description:      "The argument type.",
+					},
+					"arg_default_value": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						ForceNew:    true,
+						Description: "Optional default value for the argument. Text values have to be enclosed in single quotes, e.g. `'text'`.",
+					},
+				},
+			},
+		},
+		"return_type": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validateRoutineReturnType(language != routineLanguageJavascript && !(objectType == "function" && language == routineLanguageScala)),
+			DiffSuppressFunc: suppressRoutineDataTypeDiff,
+			Description:      fmt.Sprintf("Specifies the results returned by the %s, either a data type or a table in the form of `TABLE (column_name column_type, ...)`.", objectType),
+		},
+		"is_secure": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: fmt.Sprintf("Specifies that the %s is secure.", objectType),
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fmt.Sprintf("Specifies a comment for the %s.", objectType),
+		},
+		"log_level": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(routineLogLevels, false),
+			Description:  fmt.Sprintf("Specifies the severity level of messages that should be ingested from the %[1]s and made available in the active event table. Valid values are: %[2]s. The value is not read from Snowflake, so changes made outside of Terraform are not detected.", objectType, strings.Join(routineLogLevels, " | ")),
+		},
+		"trace_level": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(routineTraceLevels, false),
+			Description:  fmt.Sprintf("Specifies how trace events of the %[1]s are ingested into the event table. Valid values are: %[2]s. The value is not read from Snowflake, so changes made outside of Terraform are not detected.", objectType, strings.Join(routineTraceLevels, " | ")),
+		},
+		definitionKey: {
+			Type:             schema.TypeString,
+			ForceNew:         true,
+			DiffSuppressFunc: diffSuppressStatementForLanguage(string(language)),
+			Description:      fmt.Sprintf("Specifies the %s code of the %s.", language.displayName(), objectType),
+		},
+		"statement_comparison": statementComparisonSchema,
+	}
+	if language.hasHandler() {
+		s[definitionKey].Optional = true
+		s[definitionKey].Description += " Can be omitted when the handler is imported from a stage with `imports`."
+	} else {
+		s[definitionKey].Required = true
+	}
+
+	if !language.hasHandler() {
+		return s
+	}
+	s["runtime_version"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     objectType == "function" && language != routineLanguagePython,
+		Required:     objectType == "procedure" || language == routineLanguagePython,
+		ForceNew:     true,
+		ValidateFunc: routineRuntimeVersionValidations[language],
+		Description:  fmt.Sprintf("Specifies the %s runtime version to use.", language.displayName()),
+	}
+	s["packages"] = &schema.Schema{
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: routinePackageValidations[language],
+		},
+		Optional:    true,
+		ForceNew:    true,
+		Description: fmt.Sprintf("The %s packages to make available for the %s.", language.displayName(), objectType),
+	}
+	s["imports"] = &schema.Schema{
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^@`), "must be a stage location starting with @"),
+		},
+		Optional:    true,
+		ForceNew:    true,
+		Description: fmt.Sprintf("The stage locations of the files to import for the %s, e.g. `@stage/handler.jar`.", objectType),
+	}
+	s["handler"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: routineHandlerValidations[language],
+		Description:  fmt.Sprintf("The name of the handler method or function of the %s.", objectType),
+	}
+	s["external_access_integrations"] = &schema.Schema{
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		},
+		Optional:    true,
+		Description: fmt.Sprintf("The names of the external access integrations the %s is allowed to use.", objectType),
+	}
+	s["secrets"] = &schema.Schema{
+		Type: schema.TypeSet,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"secret_variable_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the variable used in the handler code to retrieve the secret.",
+				},
+				"secret_id": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					Description:      "The fully qualified name of the secret, e.g. `\"db\".\"schema\".\"secret\"`.",
+				},
+			},
+		},
+		Optional:    true,
+		Description: fmt.Sprintf("The secrets the %s is allowed to use. Secrets can only be used together with external access integrations.", objectType),
+	}
+	if language.hasTargetPath() {
+		s["target_path"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^@`), "must be a stage location starting with @"),
+			Description:  fmt.Sprintf("The stage location where the jar compiled from the inline code of the %s is stored.", objectType),
+		}
+	}
+	if language == routineLanguagePython {
+		s["artifact_repository"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The name of the artifact repository to install the `packages` from, e.g. `snowflake.snowpark.pypi_shared_repository`.",
+		}
+	}
+	return s
+}
+
+// customizeRoutineDiff validates the handler related attributes that depend on each other and forces the recreation
+// when external access integrations or secrets are removed, because they cannot be unset with ALTER.
+func customizeRoutineDiff(language routineLanguage, definitionKey string) schema.CustomizeDiffFunc {
+	if !language.hasHandler() {
+		return nil
+	}
+	becameEmpty := func(_ context.Context, old, new, _ any) bool {
+		return old.(*schema.Set).Len() > 0 && new.(*schema.Set).Len() == 0
+	}
+	return customdiff.All(
+		customdiff.ForceNewIfChange("external_access_integrations", becameEmpty),
+		customdiff.ForceNewIfChange("secrets", becameEmpty),
+		func(_ context.Context, d *schema.ResourceDiff, _ any) error {
+			if !d.NewValueKnown(definitionKey) || !d.NewValueKnown("imports") {
+				return nil
+			}
+			if d.Get(definitionKey).(string) != "" {
+				return nil
+			}
+			if d.Get("imports").(*schema.Set).Len() == 0 {
+				return fmt.Errorf("imports must be set when %s is not set", definitionKey)
+			}
+			if language.hasTargetPath() && d.Get("target_path").(string) != "" {
+				return fmt.Errorf("target_path can only be set together with %s", definitionKey)
+			}
+			return nil
+		},
+	)
+}
+
+type routineArgument struct {
+	Name         string
+	DataType     string
+	DefaultValue *string
+}
+
+func getRoutineArguments(d *schema.ResourceData) []routineArgument {
+	arguments := make([]routineArgument, 0)
+	for _, item := range d.Get("arguments").([]any) {
+		argument := item.(map[string]any)
+		routineArgument := routineArgument{
+			Name:     argument["arg_name"].(string),
+			DataType: strings.ToUpper(argument["arg_data_type"].(string)),
+		}
+		if v := argument["arg_default_value"].(string); v != "" {
+			routineArgument.DefaultValue = sdk.String(v)
+		}
+		arguments = append(arguments, routineArgument)
+	}
+	return arguments
+}
+
+// routineIdentifier builds the identifier of the routine from the configuration. The argument types are normalized with
+// sdk.ToDataType, because Snowflake identifies routines by the argument types without precision or length.
+func routineIdentifier(d *schema.ResourceData) (sdk.SchemaObjectIdentifier, error) {
+	arguments := getRoutineArguments(d)
+	dataTypes := make([]sdk.DataType, 0, len(arguments))
+	for _, argument := range arguments {
+		dataType, err := sdk.ToDataType(argument.DataType)
+		if err != nil {
+			return sdk.SchemaObjectIdentifier{}, err
+		}
+		dataTypes = append(dataTypes, dataType)
+	}
+	return sdk.NewSchemaObjectIdentifierWithArguments(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string), dataTypes), nil
+}
+
+// routineIdentifierFromResourceId parses the resource id in the form of "database"."schema"."name"(TYPE, ...).
+func routineIdentifierFromResourceId(id string) (sdk.SchemaObjectIdentifier, error) {
+	objectId, err := helpers.DecodeSnowflakeParameterIDWithArguments(id)
+	if err != nil {
+		return sdk.SchemaObjectIdentifier{}, err
+	}
+	schemaObjectId, ok := objectId.(sdk.SchemaObjectIdentifier)
+	if !ok {
+		return sdk.SchemaObjectIdentifier{}, fmt.Errorf(`expected "database"."schema"."name"(argument types) identifier, got: %s`, id)
+	}
+	return schemaObjectId, nil
+}
+
+// routineSignatureMatches reports whether the arguments column returned by SHOW FUNCTIONS or SHOW PROCEDURES, e.g.
+// NAME(NUMBER [, VARCHAR]) RETURN VARCHAR, describes the routine with the given identifier. Optional arguments are
+// enclosed in square brackets.
+func routineSignatureMatches(arguments string, id sdk.SchemaObjectIdentifier) bool {
+	signature, _, _ := strings.Cut(arguments, " RETURN ")
+	signature = strings.NewReplacer(" ", "", "[", "", "]", "").Replace(signature)
+	return signature == strings.ReplaceAll(id.ArgumentsSignature(), " ", "")
+}
+
+type routineColumn struct {
+	Name     string
+	DataType string
+}
+
+// parseRoutineTableColumns parses the return type in the form of TABLE (column_name column_type, ...). It returns false
+// when the return type is not a table.
+func parseRoutineTableColumns(returnType string) ([]routineColumn, bool, error) {
+	trimmed := strings.TrimSpace(returnType)
+	if len(trimmed) < len("TABLE") || !strings.EqualFold(trimmed[:len("TABLE")], "TABLE") {
+		return nil, false, nil
+	}
+	rest := strings.TrimSpace(trimmed[len("TABLE"):])
+	if !strings.HasPrefix(rest, "(") || !strings.HasSuffix(rest, ")") {
+		return nil, false, nil
+	}
+	columns := make([]routineColumn, 0)
+	for _, column := range splitRoutineTopLevel(rest[1 : len(rest)-1]) {
+		fields := strings.Fields(column)
+		if len(fields) < 2 {
+			return nil, true, fmt.Errorf("expected column in the form of column_name column_type, got: %s", column)
+		}
+		columns = append(columns, routineColumn{Name: fields[0], DataType: strings.ToUpper(strings.Join(fields[1:], " "))})
+	}
+	return columns, true, nil
+}
+
+// splitRoutineTopLevel splits the comma separated list, ignoring the commas nested in parentheses, e.g. in NUMBER(38, 0).
+func splitRoutineTopLevel(s string) []string {
+	parts := make([]string, 0)
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if last := strings.TrimSpace(s[start:]); last != "" || len(parts) > 0 {
+		parts = append(parts, last)
+	}
+	return parts
+}
+
+func validateRoutineReturnType(tableAllowed bool) schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(value any, key string) ([]string, []error) {
+		columns, isTable, err := parseRoutineTableColumns(value.(string))
+		if err != nil {
+			return nil, []error{fmt.Errorf("invalid %s: %w", key, err)}
+		}
+		if !isTable {
+			return nil, nil
+		}
+		if !tableAllowed {
+			return nil, []error{fmt.Errorf("%s cannot be a table for this language", key)}
+		}
+		for _, column := range columns {
+			if _, err := sdk.ToDataType(column.DataType); err != nil {
+				return nil, []error{fmt.Errorf("invalid %s: %w", key, err)}
+			}
+		}
+		return nil, nil
+	})
+}
+
+func normalizeRoutineDataType(dataType string) string {
+	normalized := strings.ToUpper(strings.Join(strings.Fields(dataType), " "))
+	return strings.NewReplacer(" (", "(", "( ", "(", " )", ")", " ,", ",", ", ", ",").Replace(normalized)
+}
+
+// routineDataTypesEqual reports whether both data types describe the same type. Snowflake returns the types with their
+// default precision or length, e.g. NUMBER(38,0) for INT, so a type without parameters is equal to any type with the
+// same base type.
+func routineDataTypesEqual(a, b string) bool {
+	a, b = normalizeRoutineDataType(a), normalizeRoutineDataType(b)
+	if a == b {
+		return true
+	}
+	aColumns, aIsTable, aErr := parseRoutineTableColumns(a)
+	bColumns, bIsTable, bErr := parseRoutineTableColumns(b)
+	if aIsTable || bIsTable {
+		if !aIsTable || !bIsTable || aErr != nil || bErr != nil || len(aColumns) != len(bColumns) {
+			return false
+		}
+		for i := range aColumns {
+			if !strings.EqualFold(aColumns[i].Name, bColumns[i].Name) || !routineDataTypesEqual(aColumns[i].DataType, bColumns[i].DataType) {
+				return false
+			}
+		}
+		return true
+	}
+	if strings.Contains(a, "(") && strings.Contains(b, "(") {
+		return false
+	}
+	aDataType, aErr := sdk.ToDataType(a)
+	bDataType, bErr := sdk.ToDataType(b)
+	return aErr == nil && bErr == nil && aDataType == bDataType
+}
+
+func suppressRoutineDataTypeDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return old != "" && new != "" && routineDataTypesEqual(old, new)
+}
+
+// parseRoutineArgumentsSignature parses the signature property returned by DESCRIBE, e.g. (A NUMBER, B VARCHAR).
+func parseRoutineArgumentsSignature(signature string) []any {
+	signature = strings.TrimSpace(signature)
+	signature = strings.TrimSuffix(strings.TrimPrefix(signature, "("), ")")
+	arguments := make([]any, 0)
+	for _, argument := range splitRoutineTopLevel(signature) {
+		name, dataType, _ := strings.Cut(strings.TrimSpace(argument), " ")
+		arguments = append(arguments, map[string]any{
+			"arg_name":          name,
+			"arg_data_type":     strings.TrimSpace(dataType),
+			"arg_default_value": "",
+		})
+	}
+	return arguments
+}
+
+// parseRoutineList parses the list properties returned by DESCRIBE, e.g. ['numpy','pandas'] or [INTEGRATION].
+func parseRoutineList(value string) []string {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "null" {
+		return []string{}
+	}
+	items := make([]string, 0)
+	for _, item := range strings.Split(strings.TrimSuffix(strings.TrimPrefix(value, "["), "]"), ",") {
+		item = strings.Trim(strings.TrimSpace(item), `'"`)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseRoutineSecrets parses the secrets property returned by DESCRIBE, which is a JSON object mapping the variable
+// names to the fully qualified names of the secrets.
+func parseRoutineSecrets(value string) ([]any, error) {
+	secrets := make([]any, 0)
+	if strings.TrimSpace(value) == "" || value == "null" {
+		return secrets, nil
+	}
+	parsed := make(map[string]string)
+	if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+		return nil, fmt.Errorf("unable to parse secrets %s: %w", value, err)
+	}
+	variables := make([]string, 0, len(parsed))
+	for variable := range parsed {
+		variables = append(variables, variable)
+	}
+	slices.Sort(variables)
+	for _, variable := range variables {
+		secrets = append(secrets, map[string]any{
+			"secret_variable_name": variable,
+			"secret_id":            parsed[variable],
+		})
+	}
+	return secrets, nil
+}
+
+func normalizeRoutineSecretId(secretId string) string {
+	id, err := helpers.DecodeSnowflakeParameterID(secretId)
+	if err != nil {
+		return secretId
+	}
+	return strings.ToUpper(id.FullyQualifiedName())
+}
+
+// routineSecretsEqual compares the secrets case-insensitively, because the secrets returned by DESCRIBE have the
+// identifiers resolved to their stored form.
+func routineSecretsEqual(a, b []any) bool {
+	normalize := func(secrets []any) []string {
+		normalized := make([]string, 0, len(secrets))
+		for _, item := range secrets {
+			secret := item.(map[string]any)
+			normalized = append(normalized, strings.ToUpper(secret["secret_variable_name"].(string))+"="+normalizeRoutineSecretId(secret["secret_id"].(string)))
+		}
+		slices.Sort(normalized)
+		return normalized
+	}
+	return slices.Equal(normalize(a), normalize(b))
+}
+
+func routineStringsEqualFold(a []any, b []string) bool {
+	normalize := func(items []string) []string {
+		normalized := make([]string, 0, len(items))
+		for _, item := range items {
+			normalized = append(normalized, strings.ToUpper(item))
+		}
+		slices.Sort(normalized)
+		return normalized
+	}
+	aItems := make([]string, 0, len(a))
+	for _, item := range a {
+		aItems = append(aItems, item.(string))
+	}
+	return slices.Equal(normalize(aItems), normalize(b))
+}
+
+func getRoutineExternalAccessIntegrations(d *schema.ResourceData) []sdk.AccountObjectIdentifier {
+	integrations := make([]sdk.AccountObjectIdentifier, 0)
+	for _, item := range d.Get("external_access_integrations").(*schema.Set).List() {
+		integrations = append(integrations, sdk.NewAccountObjectIdentifier(item.(string)))
+	}
+	return integrations
+}
+
+func getRoutineSecrets(d *schema.ResourceData) ([]sdk.Secret, error) {
+	secrets := make([]sdk.Secret, 0)
+	for _, item := range d.Get("secrets").(*schema.Set).List() {
+		secret := item.(map[string]any)
+		secretId, err := helpers.DecodeSnowflakeParameterID(secret["secret_id"].(string))
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, sdk.Secret{
+			VariableName: secret["secret_variable_name"].(string),
+			Name:         secretId.FullyQualifiedName(),
+		})
+	}
+	return secrets, nil
+}
+
+func getRoutineStrings(d *schema.ResourceData, key string) []string {
+	items := make([]string, 0)
+	if v, ok := d.GetOk(key); ok {
+		for _, item := range v.(*schema.Set).List() {
+			items = append(items, item.(string))
+		}
+	}
+	slices.Sort(items)
+	return items
+}
+
+// setRoutineDetails sets the attributes described by the DESCRIBE FUNCTION / DESCRIBE PROCEDURE output. Only the
+// attributes present in the resource schema are set. Imports, target path and arguments are set only when they are
+// not known yet (e.g. after an import), because Snowflake returns them in a resolved form that differs from the
+// configuration.
+func setRoutineDetails(d *schema.ResourceData, resourceSchema map[string]*schema.Schema, definitionKey string, properties map[string]string) error {
+	has := func(key string) bool {
+		_, ok := resourceSchema[key]
+		return ok
+	}
+	set := func(key string, value any) error {
+		if !has(key) {
+			return nil
+		}
+		return d.Set(key, value)
+	}
+
+	if v, ok := properties["signature"]; ok && len(d.Get("arguments").([]any)) == 0 {
+		if err := d.Set("arguments", parseRoutineArgumentsSignature(v)); err != nil {
+			return err
+		}
+	}
+	if v, ok := properties["returns"]; ok && !routineDataTypesEqual(v, d.Get("return_type").(string)) {
+		if err := d.Set("return_type", v); err != nil {
+			return err
+		}
+	}
+	if v, ok := properties["body"]; ok {
+		if err := d.Set(definitionKey, v); err != nil {
+			return err
+		}
+	}
+	if v, ok := properties["null handling"]; ok {
+		if err := set("null_input_behavior", v); err != nil {
+			return err
+		}
+	}
+	if v, ok := properties["volatility"]; ok {
+		if err := set("return_results_behavior", v); err != nil {
+			return err
+		}
+	}
+	if v, ok := properties["execute as"]; ok {
+		if err := set("execute_as", v); err != nil {
+			return err
+		}
+	}
+	if v, ok := properties["handler"]; ok {
+		if err := set("handler", v); err != nil {
+			return err
+		}
+	}
+	if v, ok := properties["runtime_version"]; ok {
+		if err := set("runtime_version", v); err != nil {
+			return err
+		}
+	}
+	if v, ok := properties["packages"]; ok && has("packages") {
+		packages := parseRoutineList(v)
+		if !routineStringsEqualFold(d.Get("packages").(*schema.Set).List(), packages) {
+			if err := d.Set("packages", packages); err != nil {
+				return err
+			}
+		}
+	}
+	if v, ok := properties["imports"]; ok && has("imports") && d.Get("imports").(*schema.Set).Len() == 0 {
+		if err := d.Set("imports", parseRoutineList(v)); err != nil {
+			return err
+		}
+	}
+	if v, ok := properties["target_path"]; ok && has("target_path") && d.Get("target_path").(string) == "" {
+		if err := d.Set("target_path", v); err != nil {
+			return err
+		}
+	}
+	if v, ok := properties["external_access_integrations"]; ok && has("external_access_integrations") {
+		integrations := parseRoutineList(v)
+		if !routineStringsEqualFold(d.Get("external_access_integrations").(*schema.Set).List(), integrations) {
+			if err := d.Set("external_access_integrations", integrations); err != nil {
+				return err
+			}
+		}
+	}
+	if v, ok := properties["secrets"]; ok && has("secrets") {
+		secrets, err := parseRoutineSecrets(v)
+		if err != nil {
+			return err
+		}
+		if !routineSecretsEqual(d.Get("secrets").(*schema.Set).List(), secrets) {
+			if err := d.Set("secrets", secrets); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutineDataTypesEqual(t *testing.T) {
+	testCases := []struct {
+		a, b  string
+		equal bool
+	}{
+		{a: "NUMBER", b: "number", equal: true},
+		{a: "INT", b: "NUMBER(38,0)", equal: true},
+		{a: "NUMBER(10, 2)", b: "NUMBER(10,2)", equal: true},
+		{a: "NUMBER(10,2)", b: "NUMBER(38,0)", equal: false},
+		{a: "VARCHAR", b: "VARCHAR(16777216)", equal: true},
+		{a: "VARCHAR", b: "NUMBER", equal: false},
+		{a: "TABLE (id INT, name VARCHAR)", b: "TABLE (ID NUMBER(38,0), NAME VARCHAR(16777216))", equal: true},
+		{a: "TABLE (id INT)", b: "TABLE (id INT, name VARCHAR)", equal: false},
+		{a: "TABLE (id INT)", b: "NUMBER", equal: false},
+		{a: "TABLE ()", b: "table()", equal: true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.a+" "+tc.b, func(t *testing.T) {
+			assert.Equal(t, tc.equal, routineDataTypesEqual(tc.a, tc.b))
+			assert.Equal(t, tc.equal, routineDataTypesEqual(tc.b, tc.a))
+		})
+	}
+}
+
+func TestParseRoutineTableColumns(t *testing.T) {
+	columns, isTable, err := parseRoutineTableColumns("TABLE (id NUMBER(38, 0), name VARCHAR, created_at TIMESTAMP_NTZ(9))")
+	require.NoError(t, err)
+	assert.True(t, isTable)
+	assert.Equal(t, []routineColumn{
+		{Name: "id", DataType: "NUMBER(38, 0)"},
+		{Name: "name", DataType: "VARCHAR"},
+		{Name: "created_at", DataType: "TIMESTAMP_NTZ(9)"},
+	}, columns)
+
+	columns, isTable, err = parseRoutineTableColumns("table()")
+	require.NoError(t, err)
+	assert.True(t, isTable)
+	assert.Empty(t, columns)
+
+	_, isTable, err = parseRoutineTableColumns("NUMBER(38,0)")
+	require.NoError(t, err)
+	assert.False(t, isTable)
+
+	_, _, err = parseRoutineTableColumns("TABLE (id)")
+	require.ErrorContains(t, err, "column_name column_type")
+}
+
+func TestRoutineIdentifier(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, functionForLanguageSchema(routineLanguageSQL), map[string]any{
+		"database": "db",
+		"schema":   "schema",
+		"name":     "fn",
+		"arguments": []any{
+			map[string]any{"arg_name": "a", "arg_data_type": "NUMBER(10, 2)"},
+			map[string]any{"arg_name": "b", "arg_data_type": "text"},
+		},
+	})
+	id, err := routineIdentifier(d)
+	require.NoError(t, err)
+	assert.Equal(t, `"db"."schema"."fn"(NUMBER, VARCHAR)`, id.FullyQualifiedName())
+
+	parsed, err := routineIdentifierFromResourceId(id.FullyQualifiedName())
+	require.NoError(t, err)
+	assert.Equal(t, id, parsed)
+
+	parsed, err = routineIdentifierFromResourceId(`"db"."schema"."fn"()`)
+	require.NoError(t, err)
+	assert.Equal(t, `"db"."schema"."fn"`, parsed.FullyQualifiedName())
+	assert.Empty(t, parsed.Arguments())
+
+	_, err = routineIdentifierFromResourceId(`"db"."schema"`)
+	require.Error(t, err)
+}
+
+func TestRoutineSignatureMatches(t *testing.T) {
+	id := sdk.NewSchemaObjectIdentifierWithArguments("db", "schema", "FN", []sdk.DataType{sdk.DataTypeNumber, sdk.DataTypeVARCHAR})
+
+	assert.True(t, routineSignatureMatches("FN(NUMBER, VARCHAR) RETURN VARCHAR", id))
+	assert.True(t, routineSignatureMatches("FN(NUMBER [, VARCHAR]) RETURN VARCHAR", id))
+	assert.False(t, routineSignatureMatches("FN(NUMBER) RETURN VARCHAR", id))
+	assert.False(t, routineSignatureMatches("FN(VARCHAR, NUMBER) RETURN VARCHAR", id))
+	assert.True(t, routineSignatureMatches("FN() RETURN VARCHAR", sdk.NewSchemaObjectIdentifierWithArguments("db", "schema", "FN", nil)))
+}
+
+func TestParseRoutineDescribeProperties(t *testing.T) {
+	assert.Equal(t, []any{
+		map[string]any{"arg_name": "A", "arg_data_type": "NUMBER", "arg_default_value": ""},
+		map[string]any{"arg_name": "B", "arg_data_type": "NUMBER(10,2)", "arg_default_value": ""},
+	}, parseRoutineArgumentsSignature("(A NUMBER, B NUMBER(10,2))"))
+	assert.Empty(t, parseRoutineArgumentsSignature("()"))
+
+	assert.Equal(t, []string{"numpy", "pandas==2.1.0"}, parseRoutineList("['numpy','pandas==2.1.0']"))
+	assert.Equal(t, []string{"INTEGRATION"}, parseRoutineList("[INTEGRATION]"))
+	assert.Empty(t, parseRoutineList("[]"))
+	assert.Empty(t, parseRoutineList("null"))
+
+	secrets, err := parseRoutineSecrets(`{"cred":"\"DB\".\"SCHEMA\".\"SECRET\""}`)
+	require.NoError(t, err)
+	assert.Equal(t, []any{map[string]any{"secret_variable_name": "cred", "secret_id": `"DB"."SCHEMA"."SECRET"`}}, secrets)
+	assert.True(t, routineSecretsEqual(secrets, []any{map[string]any{"secret_variable_name": "cred", "secret_id": "db.schema.secret"}}))
+	assert.False(t, routineSecretsEqual(secrets, []any{map[string]any{"secret_variable_name": "other", "secret_id": "db.schema.secret"}}))
+}
+
+func TestRoutineSchemaValidations(t *testing.T) {
+	validate := func(s *schema.Schema, value string) bool {
+		_, errs := s.ValidateFunc(value, "key")
+		return len(errs) == 0
+	}
+
+	python := functionForLanguageSchema(routineLanguagePython)
+	assert.True(t, validate(python["handler"], "run"))
+	assert.True(t, validate(python["handler"], "module.run"))
+	assert.False(t, validate(python["handler"], "com.example.Handler.run"))
+	assert.True(t, validate(python["runtime_version"], "3.11"))
+	assert.False(t, validate(python["runtime_version"], "2.7"))
+	assert.True(t, validate(python["packages"].Elem.(*schema.Schema), "numpy==1.26.4"))
+	assert.True(t, validate(python["imports"].Elem.(*schema.Schema), "@stage/handler.py"))
+	assert.False(t, validate(python["imports"].Elem.(*schema.Schema), "stage/handler.py"))
+	assert.True(t, python["runtime_version"].Required)
+	assert.NotContains(t, python, "target_path")
+
+	java := functionForLanguageSchema(routineLanguageJava)
+	assert.True(t, validate(java["handler"], "com.example.Handler.run"))
+	assert.False(t, validate(java["handler"], "run"))
+	assert.True(t, validate(java["packages"].Elem.(*schema.Schema), "com.snowflake:snowpark:1.14.0"))
+	assert.False(t, validate(java["packages"].Elem.(*schema.Schema), "snowpark"))
+	assert.False(t, validate(java["runtime_version"], "8"))
+	assert.True(t, java["runtime_version"].Optional)
+	assert.NotContains(t, java, "artifact_repository")
+
+	sql := functionForLanguageSchema(routineLanguageSQL)
+	assert.True(t, sql["function_definition"].Required)
+	assert.NotContains(t, sql, "handler")
+	assert.NotContains(t, sql, "null_input_behavior")
+
+	procedure := procedureForLanguageSchema(routineLanguageScala)
+	assert.True(t, procedure["packages"].Required)
+	assert.True(t, procedure["is_secure"].ForceNew)
+	assert.Contains(t, procedure, "execute_as")
+	assert.NotContains(t, procedure, "return_results_behavior")
+
+}
+
+func TestRoutineReturnTypeValidation(t *testing.T) {
+	assert.False(t, functionForLanguageSchema(routineLanguagePython)["return_type"].ValidateDiagFunc("TABLE (a NUMBER)", nil).HasError())
+	assert.True(t, functionForLanguageSchema(routineLanguageScala)["return_type"].ValidateDiagFunc("TABLE (a NUMBER)", nil).HasError())
+	assert.True(t, procedureForLanguageSchema(routineLanguageJavascript)["return_type"].ValidateDiagFunc("TABLE (a NUMBER)", nil).HasError())
+	assert.True(t, functionForLanguageSchema(routineLanguageSQL)["return_type"].ValidateDiagFunc("TABLE (a UNKNOWN)", nil).HasError())
+	assert.False(t, functionForLanguageSchema(routineLanguageSQL)["return_type"].ValidateDiagFunc("NUMBER(10, 2)", nil).HasError())
+}
+
+func TestRoutineCustomizeDiff(t *testing.T) {
+	diff := func(t *testing.T, resource *schema.Resource, state map[string]string, config map[string]any) (*terraform.InstanceDiff, error) {
+		t.Helper()
+		var instanceState *terraform.InstanceState
+		if state != nil {
+			instanceState = &terraform.InstanceState{ID: "id", Attributes: state}
+		}
+		return resource.Diff(context.Background(), instanceState, terraform.NewResourceConfigRaw(config), nil)
+	}
+	pythonConfig := func() map[string]any {
+		return map[string]any{
+			"database":        "db",
+			"schema":          "schema",
+			"name":            "fn",
+			"return_type":     "VARCHAR",
+			"runtime_version": "3.11",
+			"handler":         "run",
+		}
+	}
+
+	t.Run("handler imported from a stage", func(t *testing.T) {
+		config := pythonConfig()
+		_, err := diff(t, FunctionPython(), nil, config)
+		require.ErrorContains(t, err, "imports must be set when function_definition is not set")
+
+		config["imports"] = []any{"@stage/handler.py"}
+		_, err = diff(t, FunctionPython(), nil, config)
+		require.NoError(t, err)
+	})
+
+	t.Run("target path without inline code", func(t *testing.T) {
+		config := pythonConfig()
+		config["runtime_version"] = "17"
+		config["handler"] = "Handler.run"
+		config["imports"] = []any{"@stage/handler.jar"}
+		config["target_path"] = "@stage/compiled.jar"
+		_, err := diff(t, FunctionJava(), nil, config)
+		require.ErrorContains(t, err, "target_path can only be set together with function_definition")
+	})
+
+	t.Run("procedure without snowpark", func(t *testing.T) {
+		config := pythonConfig()
+		config["procedure_definition"] = "def run(session): return 'ok'"
+		config["packages"] = []any{"numpy"}
+		_, err := diff(t, ProcedurePython(), nil, config)
+		require.ErrorContains(t, err, "packages must include the Snowpark package snowflake-snowpark-python")
+
+		config["packages"] = []any{"numpy", "snowflake-snowpark-python==1.14.0"}
+		_, err = diff(t, ProcedurePython(), nil, config)
+		require.NoError(t, err)
+	})
+
+	t.Run("removing external access integrations recreates the function", func(t *testing.T) {
+		config := pythonConfig()
+		config["function_definition"] = "def run(): return 'ok'"
+		state := map[string]string{
+			"database":                       "db",
+			"schema":                         "schema",
+			"name":                           "fn",
+			"return_type":                    "VARCHAR",
+			"runtime_version":                "3.11",
+			"handler":                        "run",
+			"function_definition":            "def run(): return 'ok'",
+			"null_input_behavior":            "CALLED ON NULL INPUT",
+			"return_results_behavior":        "VOLATILE",
+			"is_secure":                      "false",
+			"external_access_integrations.#": "1",
+		}
+		state[fmt.Sprintf("external_access_integrations.%d", schema.HashSchema(&schema.Schema{Type: schema.TypeString})("INTEGRATION"))] = "INTEGRATION"
+
+		instanceDiff, err := diff(t, FunctionPython(), state, config)
+		require.NoError(t, err)
+		require.NotNil(t, instanceDiff)
+		assert.True(t, instanceDiff.RequiresNew())
+
+		config["external_access_integrations"] = []any{"OTHER_INTEGRATION"}
+		instanceDiff, err = diff(t, FunctionPython(), state, config)
+		require.NoError(t, err)
+		require.NotNil(t, instanceDiff)
+		assert.False(t, instanceDiff.RequiresNew())
+	})
+}
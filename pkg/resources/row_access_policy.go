@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -54,10 +55,10 @@ var rowAccessPolicySchema = map[string]*schema.Schema{
 // RowAccessPolicy returns a pointer to the resource representing a row access policy.
 func RowAccessPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateRowAccessPolicy,
-		Read:   ReadRowAccessPolicy,
-		Update: UpdateRowAccessPolicy,
-		Delete: DeleteRowAccessPolicy,
+		CreateContext: CreateRowAccessPolicy,
+		ReadContext:   ReadRowAccessPolicy,
+		UpdateContext: UpdateRowAccessPolicy,
+		DeleteContext: DeleteRowAccessPolicy,
 
 		Schema: rowAccessPolicySchema,
 		Importer: &schema.ResourceImporter{
@@ -66,10 +67,9 @@ func RowAccessPolicy() *schema.Resource {
 	}
 }
 
-// CreateRowAccessPolicy implements schema.CreateFunc.
-func CreateRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
+// CreateRowAccessPolicy implements schema.CreateContextFunc.
+func CreateRowAccessPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	databaseName := d.Get("database").(string)
@@ -95,18 +95,17 @@ func CreateRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
 
 	err := client.RowAccessPolicies.Create(ctx, createRequest)
 	if err != nil {
-		return fmt.Errorf("error creating row access policy %v err = %w", name, err)
+		return diag.FromErr(fmt.Errorf("error creating row access policy %v err = %w", name, err))
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(id))
 
-	return ReadRowAccessPolicy(d, meta)
+	return ReadRowAccessPolicy(ctx, d, meta)
 }
 
-// ReadRowAccessPolicy implements schema.ReadFunc.
-func ReadRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
+// ReadRowAccessPolicy implements schema.ReadContextFunc.
+func ReadRowAccessPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
@@ -118,41 +117,40 @@ func ReadRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("name", rowAccessPolicy.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("database", rowAccessPolicy.DatabaseName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("schema", rowAccessPolicy.SchemaName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("comment", rowAccessPolicy.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	rowAccessPolicyDescription, err := client.RowAccessPolicies.Describe(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("row_access_expression", rowAccessPolicyDescription.Body); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("signature", parseSignature(rowAccessPolicyDescription.Signature)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateRowAccessPolicy implements schema.UpdateFunc.
-func UpdateRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
+// UpdateRowAccessPolicy implements schema.UpdateContextFunc.
+func UpdateRowAccessPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
@@ -161,12 +159,12 @@ func UpdateRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
 		if c := comment.(string); c == "" {
 			err := client.RowAccessPolicies.Alter(ctx, sdk.NewAlterRowAccessPolicyRequest(id).WithUnsetComment(sdk.Bool(true)))
 			if err != nil {
-				return fmt.Errorf("error unsetting comment for row access policy on %v err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error unsetting comment for row access policy on %v err = %w", d.Id(), err))
 			}
 		} else {
 			err := client.RowAccessPolicies.Alter(ctx, sdk.NewAlterRowAccessPolicyRequest(id).WithSetComment(sdk.String(c)))
 			if err != nil {
-				return fmt.Errorf("error updating comment for row access policy on %v err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error updating comment for row access policy on %v err = %w", d.Id(), err))
 			}
 		}
 	}
@@ -175,23 +173,22 @@ func UpdateRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
 		rowAccessExpression := d.Get("row_access_expression").(string)
 		err := client.RowAccessPolicies.Alter(ctx, sdk.NewAlterRowAccessPolicyRequest(id).WithSetBody(sdk.String(rowAccessExpression)))
 		if err != nil {
-			return fmt.Errorf("error updating row access policy expression on %v err = %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating row access policy expression on %v err = %w", d.Id(), err))
 		}
 	}
 
-	return ReadRowAccessPolicy(d, meta)
+	return ReadRowAccessPolicy(ctx, d, meta)
 }
 
-// DeleteRowAccessPolicy implements schema.DeleteFunc.
-func DeleteRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
+// DeleteRowAccessPolicy implements schema.DeleteContextFunc.
+func DeleteRowAccessPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
 	err := client.RowAccessPolicies.Drop(ctx, sdk.NewDropRowAccessPolicyRequest(id))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
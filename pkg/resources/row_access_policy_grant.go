@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -82,10 +83,10 @@ var rowAccessPolicyGrantSchema = map[string]*schema.Schema{
 func RowAccessPolicyGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateRowAccessPolicyGrant,
-			Read:               ReadRowAccessPolicyGrant,
-			Delete:             DeleteRowAccessPolicyGrant,
-			Update:             UpdateRowAccessPolicyGrant,
+			CreateContext:      CreateRowAccessPolicyGrant,
+			ReadContext:        ReadRowAccessPolicyGrant,
+			DeleteContext:      DeleteRowAccessPolicyGrant,
+			UpdateContext:      UpdateRowAccessPolicyGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             rowAccessPolicyGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -120,14 +121,14 @@ func RowAccessPolicyGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateRowAccessPolicyGrant implements schema.CreateFunc.
-func CreateRowAccessPolicyGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateRowAccessPolicyGrant implements schema.CreateContextFunc.
+func CreateRowAccessPolicyGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var rowAccessPolicyName string
 	if name, ok := d.GetOk("row_access_policy_name"); ok {
 		rowAccessPolicyName = name.(string)
 	}
 	if err := d.Set("row_access_policy_name", rowAccessPolicyName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -137,18 +138,18 @@ func CreateRowAccessPolicyGrant(d *schema.ResourceData, meta interface{}) error
 
 	builder := snowflake.RowAccessPolicyGrant(databaseName, schemaName, rowAccessPolicyName)
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, rowAccessPolicyName, privilege, withGrantOption, roles)
 	d.SetId(grantID)
 
-	return ReadRowAccessPolicyGrant(d, meta)
+	return ReadRowAccessPolicyGrant(ctx, d, meta)
 }
 
-// ReadRowAccessPolicyGrant implements schema.ReadFunc.
-func ReadRowAccessPolicyGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadRowAccessPolicyGrant implements schema.ReadContextFunc.
+func ReadRowAccessPolicyGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	rowAccessPolicyName := d.Get("row_access_policy_name").(string)
@@ -158,9 +159,9 @@ func ReadRowAccessPolicyGrant(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.RowAccessPolicyGrant(databaseName, schemaName, rowAccessPolicyName)
 
-	err := readGenericGrant(d, meta, rowAccessPolicyGrantSchema, builder, false, false, validRowAccessPoilcyPrivileges)
+	err := readGenericGrant(ctx, d, meta, rowAccessPolicyGrantSchema, builder, false, false, validRowAccessPoilcyPrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, rowAccessPolicyName, privilege, withGrantOption, roles)
@@ -170,19 +171,19 @@ func ReadRowAccessPolicyGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteRowAccessPolicyGrant implements schema.DeleteFunc.
-func DeleteRowAccessPolicyGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteRowAccessPolicyGrant implements schema.DeleteContextFunc.
+func DeleteRowAccessPolicyGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	rowAccessPolicyName := d.Get("row_access_policy_name").(string)
 
 	builder := snowflake.RowAccessPolicyGrant(databaseName, schemaName, rowAccessPolicyName)
 
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateRowAccessPolicyGrant implements schema.UpdateFunc.
-func UpdateRowAccessPolicyGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateRowAccessPolicyGrant implements schema.UpdateContextFunc.
+func UpdateRowAccessPolicyGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles") {
@@ -208,17 +209,17 @@ func UpdateRowAccessPolicyGrant(d *schema.ResourceData, meta interface{}) error
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
+		ctx, meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
+		ctx, meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadRowAccessPolicyGrant(d, meta)
+	return ReadRowAccessPolicyGrant(ctx, d, meta)
 }
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -36,8 +37,8 @@ func TestRowAccessPolicyGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT APPLY ON ROW ACCESS POLICY "test-db"."PUBLIC"."test-row-access-policy" TO ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT APPLY ON ROW ACCESS POLICY "test-db"."PUBLIC"."test-row-access-policy" TO ROLE "test-role-2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadRowAccessPolicyGrant(mock)
-		err := resources.CreateRowAccessPolicyGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateRowAccessPolicyGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -57,8 +58,8 @@ func TestRowAccessPolicyGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadRowAccessPolicyGrant(mock)
-		err := resources.ReadRowAccessPolicyGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadRowAccessPolicyGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	roles := d.Get("roles").(*schema.Set)
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -8,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -140,10 +142,10 @@ var samlIntegrationSchema = map[string]*schema.Schema{
 // SAMLIntegration returns a pointer to the resource representing a SAML2 security integration.
 func SAMLIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateSAMLIntegration,
-		Read:   ReadSAMLIntegration,
-		Update: UpdateSAMLIntegration,
-		Delete: DeleteSAMLIntegration,
+		CreateContext: CreateSAMLIntegration,
+		ReadContext:   ReadSAMLIntegration,
+		UpdateContext: UpdateSAMLIntegration,
+		DeleteContext: DeleteSAMLIntegration,
 
 		Schema: samlIntegrationSchema,
 		Importer: &schema.ResourceImporter{
@@ -152,8 +154,8 @@ func SAMLIntegration() *schema.Resource {
 	}
 }
 
-// CreateSAMLIntegration implements schema.CreateFunc.
-func CreateSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
+// CreateSAMLIntegration implements schema.CreateContextFunc.
+func CreateSAMLIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 
@@ -207,51 +209,51 @@ func CreateSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
 		stmt.SetString(`SAML2_SNOWFLAKE_ACS_URL`, d.Get("saml2_snowflake_acs_url").(string))
 	}
 
-	err := snowflake.Exec(db, stmt.Statement())
+	err := snowflake.Exec(ctx, db, stmt.Statement())
 	if err != nil {
-		return fmt.Errorf("error creating security integration err = %w", err)
+		return diag.FromErr(fmt.Errorf("error creating security integration err = %w", err))
 	}
 
 	d.SetId(name)
 
-	return ReadSAMLIntegration(d, meta)
+	return ReadSAMLIntegration(ctx, d, meta)
 }
 
-// ReadSAMLIntegration implements schema.ReadFunc.
-func ReadSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
+// ReadSAMLIntegration implements schema.ReadContextFunc.
+func ReadSAMLIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
 	stmt := snowflake.NewSamlIntegrationBuilder(id).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRow(ctx, db, stmt)
 
 	// Some properties can come from the SHOW INTEGRATION call
 
 	s, err := snowflake.ScanSamlIntegration(row)
 	if err != nil {
-		return fmt.Errorf("could not show security integration err = %w", err)
+		return diag.FromErr(fmt.Errorf("could not show security integration err = %w", err))
 	}
 
 	// Note: category must be Security or something is broken
 	if c := s.Category.String; c != "SECURITY" {
-		return fmt.Errorf("expected %v to be an Security integration, got %v", id, c)
+		return diag.FromErr(fmt.Errorf("expected %v to be an Security integration, got %v", id, c))
 	}
 
 	// Note: type must be SAML2 or something is broken
 	if c := s.IntegrationType.String; c != "SAML2" {
-		return fmt.Errorf("expected %v to be a SAML2 integration type, got %v", id, c)
+		return diag.FromErr(fmt.Errorf("expected %v to be a SAML2 integration type, got %v", id, c))
 	}
 
 	if err := d.Set("name", s.Name.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("created_on", s.CreatedOn.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("enabled", s.Enabled.Bool); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Some properties come from the DESCRIBE INTEGRATION call
@@ -259,37 +261,37 @@ func ReadSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
 	var k, pType string
 	var v, unused interface{}
 	stmt = snowflake.NewSamlIntegrationBuilder(id).Describe()
-	rows, err := db.Query(stmt)
+	rows, err := db.QueryContext(ctx, stmt)
 	if err != nil {
-		return fmt.Errorf("could not describe security integration err = %w", err)
+		return diag.FromErr(fmt.Errorf("could not describe security integration err = %w", err))
 	}
 	defer rows.Close()
 	for rows.Next() {
 		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
-			return fmt.Errorf("unable to parse security integration rows err = %w", err)
+			return diag.FromErr(fmt.Errorf("unable to parse security integration rows err = %w", err))
 		}
 		switch k {
 		case "ENABLED":
 			// set using the SHOW INTEGRATION, ignoring here
 		case "SAML2_ISSUER":
 			if err := d.Set("saml2_issuer", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_issuer for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set saml2_issuer for security integration err = %w", err))
 			}
 		case "SAML2_SSO_URL":
 			if err := d.Set("saml2_sso_url", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_sso_url for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set saml2_sso_url for security integration err = %w", err))
 			}
 		case "SAML2_PROVIDER":
 			if err := d.Set("saml2_provider", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_provider for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set saml2_provider for security integration err = %w", err))
 			}
 		case "SAML2_X509_CERT":
 			if err := d.Set("saml2_x509_cert", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_x509_cert for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set saml2_x509_cert for security integration err = %w", err))
 			}
 		case "SAML2_SP_INITIATED_LOGIN_PAGE_LABEL":
 			if err := d.Set("saml2_sp_initiated_login_page_label", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_sp_initiated_login_page_label for security integration")
+				return diag.FromErr(fmt.Errorf("unable to set saml2_sp_initiated_login_page_label for security integration"))
 			}
 		case "SAML2_ENABLE_SP_INITIATED":
 			var b bool
@@ -299,17 +301,17 @@ func ReadSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
 			case string:
 				b, err = strconv.ParseBool(v.(string))
 				if err != nil {
-					return fmt.Errorf("returned saml2_force_authn that is not boolean err = %w", err)
+					return diag.FromErr(fmt.Errorf("returned saml2_force_authn that is not boolean err = %w", err))
 				}
 			default:
-				return fmt.Errorf("returned saml2_force_authn that is not boolean")
+				return diag.FromErr(fmt.Errorf("returned saml2_force_authn that is not boolean"))
 			}
 			if err := d.Set("saml2_enable_sp_initiated", b); err != nil {
-				return fmt.Errorf("unable to set saml2_enable_sp_initiated for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set saml2_enable_sp_initiated for security integration err = %w", err))
 			}
 		case "SAML2_SNOWFLAKE_X509_CERT":
 			if err := d.Set("saml2_snowflake_x509_cert", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_snowflake_x509_cert for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set saml2_snowflake_x509_cert for security integration err = %w", err))
 			}
 		case "SAML2_SIGN_REQUEST":
 			var b bool
@@ -319,21 +321,21 @@ func ReadSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
 			case string:
 				b, err = strconv.ParseBool(v.(string))
 				if err != nil {
-					return fmt.Errorf("returned saml2_force_authn that is not boolean err = %w", err)
+					return diag.FromErr(fmt.Errorf("returned saml2_force_authn that is not boolean err = %w", err))
 				}
 			default:
-				return fmt.Errorf("returned saml2_force_authn that is not boolean err = %w", err)
+				return diag.FromErr(fmt.Errorf("returned saml2_force_authn that is not boolean err = %w", err))
 			}
 			if err := d.Set("saml2_sign_request", b); err != nil {
-				return fmt.Errorf("unable to set saml2_sign_request for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set saml2_sign_request for security integration err = %w", err))
 			}
 		case "SAML2_REQUESTED_NAMEID_FORMAT":
 			if err := d.Set("saml2_requested_nameid_format", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_requested_nameid_format for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set saml2_requested_nameid_format for security integration err = %w", err))
 			}
 		case "SAML2_POST_LOGOUT_REDIRECT_URL":
 			if err := d.Set("saml2_post_logout_redirect_url", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_post_logout_redirect_url for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set saml2_post_logout_redirect_url for security integration err = %w", err))
 			}
 		case "SAML2_FORCE_AUTHN":
 			var b bool
@@ -343,33 +345,33 @@ func ReadSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
 			case string:
 				b, err = strconv.ParseBool(v.(string))
 				if err != nil {
-					return fmt.Errorf("returned saml2_force_authn that is not boolean err = %w", err)
+					return diag.FromErr(fmt.Errorf("returned saml2_force_authn that is not boolean err = %w", err))
 				}
 			default:
-				return fmt.Errorf("returned saml2_force_authn that is not boolean err = %w", err)
+				return diag.FromErr(fmt.Errorf("returned saml2_force_authn that is not boolean err = %w", err))
 			}
 			if err := d.Set("saml2_force_authn", b); err != nil {
-				return fmt.Errorf("unable to set saml2_force_authn for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set saml2_force_authn for security integration err = %w", err))
 			}
 		case "SAML2_SNOWFLAKE_ISSUER_URL":
 			if err := d.Set("saml2_snowflake_issuer_url", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_snowflake_issuer_url for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set saml2_snowflake_issuer_url for security integration err = %w", err))
 			}
 		case "SAML2_SNOWFLAKE_ACS_URL":
 			if err := d.Set("saml2_snowflake_acs_url", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_snowflake_acs_url for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set saml2_snowflake_acs_url for security integration err = %w", err))
 			}
 		case "SAML2_SNOWFLAKE_METADATA":
 			if err := d.Set("saml2_snowflake_metadata", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_snowflake_metadata for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set saml2_snowflake_metadata for security integration err = %w", err))
 			}
 		case "SAML2_DIGEST_METHODS_USED":
 			if err := d.Set("saml2_digest_methods_used", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_digest_methods_used for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set saml2_digest_methods_used for security integration err = %w", err))
 			}
 		case "SAML2_SIGNATURE_METHODS_USED":
 			if err := d.Set("saml2_signature_methods_used", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_signature_methods_used for security integration err = %w", err)
+				return diag.FromErr(fmt.Errorf("unable to set saml2_signature_methods_used for security integration err = %w", err))
 			}
 		case "COMMENT":
 			// COMMENT cannot be set according to snowflake docs, so ignoring
@@ -378,11 +380,11 @@ func ReadSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateSAMLIntegration implements schema.UpdateFunc.
-func UpdateSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
+// UpdateSAMLIntegration implements schema.UpdateContextFunc.
+func UpdateSAMLIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
@@ -461,15 +463,15 @@ func UpdateSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if runSetStatement {
-		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-			return fmt.Errorf("error updating security integration err = %w", err)
+		if err := snowflake.Exec(ctx, db, stmt.Statement()); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating security integration err = %w", err))
 		}
 	}
 
-	return ReadSAMLIntegration(d, meta)
+	return ReadSAMLIntegration(ctx, d, meta)
 }
 
-// DeleteSAMLIntegration implements schema.DeleteFunc.
-func DeleteSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("", snowflake.NewSamlIntegrationBuilder)(d, meta)
+// DeleteSAMLIntegration implements schema.DeleteContextFunc.
+func DeleteSAMLIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diag.FromErr(DeleteResource(ctx, "", snowflake.NewSamlIntegrationBuilder)(d, meta))
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -57,16 +58,17 @@ var schemaSchema = map[string]*schema.Schema{
 		Description:  "Specifies the number of days for which Time Travel actions (CLONE and UNDROP) can be performed on the schema, as well as specifying the default Time Travel retention time for all tables created in the schema.",
 		ValidateFunc: validation.IntBetween(0, 90),
 	},
-	"tag": tagReferenceSchema,
+	"tag":   tagReferenceSchema,
+	"clone": cloneSchema("schema", "Name of the schema to clone. Either a schema name in the same database or a fully qualified name (`database.schema`); names containing dots have to be double-quoted."),
 }
 
 // Schema returns a pointer to the resource representing a schema.
 func Schema() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateSchema,
-		Read:   ReadSchema,
-		Update: UpdateSchema,
-		Delete: DeleteSchema,
+		CreateContext: CreateSchema,
+		ReadContext:   ReadSchema,
+		UpdateContext: UpdateSchema,
+		DeleteContext: DeleteSchema,
 
 		Schema: schemaSchema,
 		Importer: &schema.ResourceImporter{
@@ -75,36 +77,51 @@ func Schema() *schema.Resource {
 	}
 }
 
-// CreateSchema implements schema.CreateFunc.
-func CreateSchema(d *schema.ResourceData, meta interface{}) error {
+// CreateSchema implements schema.CreateContextFunc.
+func CreateSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
 
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
-	err := client.Schemas.Create(ctx, sdk.NewDatabaseObjectIdentifier(database, name), &sdk.CreateSchemaOptions{
+	opts := &sdk.CreateSchemaOptions{
 		Transient:               GetPropertyAsPointer[bool](d, "is_transient"),
 		WithManagedAccess:       GetPropertyAsPointer[bool](d, "is_managed"),
 		DataRetentionTimeInDays: GetPropertyAsPointer[int](d, "data_retention_days"),
 		Tag:                     getPropertyTags(d, "tag"),
 		Comment:                 GetPropertyAsPointer[string](d, "comment"),
-	})
+	}
+	clone, err := getCloneSettings(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if clone != nil {
+		parts, err := clone.sourceParts(2)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		sourceId := sdk.NewDatabaseObjectIdentifier(database, parts[0])
+		if len(parts) == 2 {
+			sourceId = sdk.NewDatabaseObjectIdentifier(parts[0], parts[1])
+		}
+		opts.Clone = clone.toSdkClone(sourceId)
+	}
+
+	err = client.Schemas.Create(ctx, sdk.NewDatabaseObjectIdentifier(database, name), opts)
 	if err != nil {
-		return fmt.Errorf("error creating schema %v err = %w", name, err)
+		return diag.FromErr(fmt.Errorf("error creating schema %v err = %w", name, err))
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(database, name))
 
-	return ReadSchema(d, meta)
+	return ReadSchema(ctx, d, meta)
 }
 
-// ReadSchema implements schema.ReadFunc.
-func ReadSchema(d *schema.ResourceData, meta interface{}) error {
+// ReadSchema implements schema.ReadContextFunc.
+func ReadSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.DatabaseObjectIdentifier)
 
 	_, err := client.Databases.ShowByID(ctx, sdk.NewAccountObjectIdentifier(id.DatabaseName()))
@@ -129,7 +146,7 @@ func ReadSchema(d *schema.ResourceData, meta interface{}) error {
 
 		retentionTime, err = strconv.ParseInt(rt, 10, 64)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -147,7 +164,7 @@ func ReadSchema(d *schema.ResourceData, meta interface{}) error {
 
 	for k, v := range values {
 		if err := d.Set(k, v); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -156,11 +173,11 @@ func ReadSchema(d *schema.ResourceData, meta interface{}) error {
 			switch opt {
 			case "TRANSIENT":
 				if err := d.Set("is_transient", true); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			case "MANAGED ACCESS":
 				if err := d.Set("is_managed", true); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -169,12 +186,11 @@ func ReadSchema(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// UpdateSchema implements schema.UpdateFunc.
-func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
+// UpdateSchema implements schema.UpdateContextFunc.
+func UpdateSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.DatabaseObjectIdentifier)
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	if d.HasChange("name") {
 		newName := d.Get("name")
@@ -182,7 +198,7 @@ func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
 			NewName: sdk.NewDatabaseObjectIdentifier(id.DatabaseName(), newName.(string)),
 		})
 		if err != nil {
-			return fmt.Errorf("error updating schema name on %v err = %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating schema name on %v err = %w", d.Id(), err))
 		}
 		d.SetId(helpers.EncodeSnowflakeID(id.DatabaseName(), newName))
 	}
@@ -195,7 +211,7 @@ func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
 			},
 		})
 		if err != nil {
-			return fmt.Errorf("error updating schema comment on %v err = %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating schema comment on %v err = %w", d.Id(), err))
 		}
 	}
 
@@ -212,7 +228,7 @@ func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
 			})
 		}
 		if err != nil {
-			return fmt.Errorf("error changing management state on %v err = %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error changing management state on %v err = %w", d.Id(), err))
 		}
 	}
 
@@ -224,7 +240,7 @@ func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
 			},
 		})
 		if err != nil {
-			return fmt.Errorf("error updating data retention days on %v err = %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating data retention days on %v err = %w", d.Id(), err))
 		}
 	}
 
@@ -236,7 +252,7 @@ func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
 				UnsetTag: unsetTags,
 			})
 			if err != nil {
-				return fmt.Errorf("error occurred when dropping tags on %v, err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error occurred when dropping tags on %v, err = %w", d.Id(), err))
 			}
 		}
 
@@ -245,24 +261,23 @@ func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
 				SetTag: setTags,
 			})
 			if err != nil {
-				return fmt.Errorf("error occurred when setting tags on %v, err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error occurred when setting tags on %v, err = %w", d.Id(), err))
 			}
 		}
 	}
 
-	return ReadSchema(d, meta)
+	return ReadSchema(ctx, d, meta)
 }
 
-// DeleteSchema implements schema.DeleteFunc.
-func DeleteSchema(d *schema.ResourceData, meta interface{}) error {
+// DeleteSchema implements schema.DeleteContextFunc.
+func DeleteSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.DatabaseObjectIdentifier)
 
 	err := client.Schemas.Drop(ctx, id, new(sdk.DropSchemaOptions))
 	if err != nil {
-		return fmt.Errorf("error deleting schema %v err = %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting schema %v err = %w", d.Id(), err))
 	}
 
 	d.SetId("")
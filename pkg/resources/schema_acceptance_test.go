@@ -137,6 +137,47 @@ func TestAcc_Schema_TwoSchemasWithTheSameNameOnDifferentDatabases(t *testing.T)
 	})
 }
 
+func TestAcc_Schema_clone(t *testing.T) {
+	sourceName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	cloneName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckSchemaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: schemaCloneConfig(acc.TestDatabaseName, sourceName, cloneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_schema.clone", "name", cloneName),
+					resource.TestCheckResourceAttr("snowflake_schema.clone", "clone.0.source", fmt.Sprintf("%s.%s", acc.TestDatabaseName, sourceName)),
+					resource.TestCheckResourceAttr("snowflake_schema.clone", "clone.0.before.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func schemaCloneConfig(databaseName string, sourceName string, cloneName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_schema" "source" {
+	database = "%[1]s"
+	name     = "%[2]s"
+}
+
+resource "snowflake_schema" "clone" {
+	database = "%[1]s"
+	name     = "%[3]s"
+	clone {
+		source = "${snowflake_schema.source.database}.${snowflake_schema.source.name}"
+	}
+}
+`, databaseName, sourceName, cloneName)
+}
+
 func testAccCheckSchemaDestroy(s *terraform.State) error {
 	db := acc.TestAccProvider.Meta().(*sql.DB)
 	client := sdk.NewClientFromDB(db)
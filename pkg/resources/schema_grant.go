@@ -10,6 +10,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -120,10 +121,10 @@ var schemaGrantSchema = map[string]*schema.Schema{
 func SchemaGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateSchemaGrant,
-			Read:               ReadSchemaGrant,
-			Delete:             DeleteSchemaGrant,
-			Update:             UpdateSchemaGrant,
+			CreateContext:      CreateSchemaGrant,
+			ReadContext:        ReadSchemaGrant,
+			DeleteContext:      DeleteSchemaGrant,
+			UpdateContext:      UpdateSchemaGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             schemaGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -167,22 +168,22 @@ func SchemaGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateSchemaGrant implements schema.CreateFunc.
-func CreateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateSchemaGrant implements schema.CreateContextFunc.
+func CreateSchemaGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	schemaName := d.Get("schema_name").(string)
 	databaseName := d.Get("database_name").(string)
 	privilege := d.Get("privilege").(string)
 	onFuture := d.Get("on_future").(bool)
 	onAll := d.Get("on_all").(bool)
 	if onFuture && onAll {
-		return errors.New("on_future and on_all cannot both be true")
+		return diag.FromErr(errors.New("on_future and on_all cannot both be true"))
 	}
 	withGrantOption := d.Get("with_grant_option").(bool)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 	shares := expandStringList(d.Get("shares").(*schema.Set).List())
 
 	if (schemaName == "") && !onFuture && !onAll {
-		return errors.New("schema_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future or on_all is true"))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -195,18 +196,18 @@ func CreateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.SchemaGrant(databaseName, schemaName)
 	}
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, privilege, withGrantOption, onFuture, onAll, roles, shares)
 	d.SetId(grantID)
 
-	return ReadSchemaGrant(d, meta)
+	return ReadSchemaGrant(ctx, d, meta)
 }
 
-// UpdateSchemaGrant implements schema.UpdateFunc.
-func UpdateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateSchemaGrant implements schema.UpdateContextFunc.
+func UpdateSchemaGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update, and we're done
 	if !d.HasChanges("roles", "shares") {
@@ -245,6 +246,7 @@ func UpdateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
+		ctx,
 		meta,
 		builder,
 		privilege,
@@ -252,11 +254,12 @@ func UpdateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 		rolesToRevoke,
 		sharesToRevoke,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// then add
 	if err := createGenericGrantRolesAndShares(
+		ctx,
 		meta,
 		builder,
 		privilege,
@@ -264,15 +267,15 @@ func UpdateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 		rolesToAdd,
 		sharesToAdd,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadSchemaGrant(d, meta)
+	return ReadSchemaGrant(ctx, d, meta)
 }
 
-// ReadSchemaGrant implements schema.ReadFunc.
-func ReadSchemaGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadSchemaGrant implements schema.ReadContextFunc.
+func ReadSchemaGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	privilege := d.Get("privilege").(string)
@@ -292,9 +295,9 @@ func ReadSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.SchemaGrant(databaseName, schemaName)
 	}
 
-	err := readGenericGrant(d, meta, schemaGrantSchema, builder, onFuture, onAll, validSchemaPrivileges)
+	err := readGenericGrant(ctx, d, meta, schemaGrantSchema, builder, onFuture, onAll, validSchemaPrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, privilege, withGrantOption, onFuture, onAll, roles, shares)
@@ -304,8 +307,8 @@ func ReadSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteSchemaGrant implements schema.DeleteFunc.
-func DeleteSchemaGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteSchemaGrant implements schema.DeleteContextFunc.
+func DeleteSchemaGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	onFuture := d.Get("on_future").(bool)
@@ -319,5 +322,5 @@ func DeleteSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 	default:
 		builder = snowflake.SchemaGrant(databaseName, schemaName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
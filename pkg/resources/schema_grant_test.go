@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -50,8 +51,8 @@ func TestSchemaGrantCreate(t *testing.T) {
 				fmt.Sprintf(`^GRANT %s ON SCHEMA "test-db"."test-schema" TO SHARE "test-share-2" WITH GRANT OPTION$`, testPriv),
 			).WillReturnResult(sqlmock.NewResult(1, 1))
 			expectReadSchemaGrant(mock, testPriv)
-			err := resources.CreateSchemaGrant(d, db)
-			r.NoError(err)
+			diags := resources.CreateSchemaGrant(context.Background(), d, db)
+			r.Empty(diags)
 		})
 	}
 }
@@ -72,8 +73,8 @@ func TestSchemaGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadSchemaGrant(mock, "USAGE")
-		err := resources.ReadSchemaGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadSchemaGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 	roles := d.Get("roles").(*schema.Set)
 	r.True(roles.Contains("test-role-1"))
@@ -122,8 +123,8 @@ func TestFutureSchemaGrantCreate(t *testing.T) {
 			`^GRANT USAGE ON FUTURE SCHEMAS IN DATABASE "test-db" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureSchemaGrant(mock)
-		err := resources.CreateSchemaGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateSchemaGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -1,12 +1,14 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -61,10 +63,10 @@ var scimIntegrationSchema = map[string]*schema.Schema{
 // SCIMIntegration returns a pointer to the resource representing a network policy.
 func SCIMIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateSCIMIntegration,
-		Read:   ReadSCIMIntegration,
-		Update: UpdateSCIMIntegration,
-		Delete: DeleteSCIMIntegration,
+		CreateContext: CreateSCIMIntegration,
+		ReadContext:   ReadSCIMIntegration,
+		UpdateContext: UpdateSCIMIntegration,
+		DeleteContext: DeleteSCIMIntegration,
 
 		Schema: scimIntegrationSchema,
 		Importer: &schema.ResourceImporter{
@@ -73,8 +75,8 @@ func SCIMIntegration() *schema.Resource {
 	}
 }
 
-// CreateSCIMIntegration implements schema.CreateFunc.
-func CreateSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
+// CreateSCIMIntegration implements schema.CreateContextFunc.
+func CreateSCIMIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 
@@ -90,45 +92,45 @@ func CreateSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
 		stmt.SetString(`NETWORK_POLICY`, d.Get("network_policy").(string))
 	}
 
-	if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-		return fmt.Errorf("error creating security integration")
+	if err := snowflake.Exec(ctx, db, stmt.Statement()); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating security integration"))
 	}
 
 	d.SetId(name)
 
-	return ReadSCIMIntegration(d, meta)
+	return ReadSCIMIntegration(ctx, d, meta)
 }
 
-// ReadSCIMIntegration implements schema.ReadFunc.
-func ReadSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
+// ReadSCIMIntegration implements schema.ReadContextFunc.
+func ReadSCIMIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
 	stmt := snowflake.NewSCIMIntegrationBuilder(id).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRow(ctx, db, stmt)
 
 	// Some properties can come from the SHOW INTEGRATION call
 
 	s, err := snowflake.ScanScimIntegration(row)
 	if err != nil {
-		return fmt.Errorf("could not show security integration")
+		return diag.FromErr(fmt.Errorf("could not show security integration"))
 	}
 
 	// Note: category must be Security or something is broken
 	if c := s.Category.String; c != "SECURITY" {
-		return fmt.Errorf("expected %v to be an Security integration, got %v", id, c)
+		return diag.FromErr(fmt.Errorf("expected %v to be an Security integration, got %v", id, c))
 	}
 
 	if err := d.Set("scim_client", strings.TrimPrefix(s.IntegrationType.String, "SCIM - ")); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("name", s.Name.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("created_on", s.CreatedOn.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Some properties come from the DESCRIBE INTEGRATION call
@@ -136,34 +138,34 @@ func ReadSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
 	var k, pType string
 	var v, unused interface{}
 	stmt = snowflake.NewSCIMIntegrationBuilder(id).Describe()
-	rows, err := db.Query(stmt)
+	rows, err := db.QueryContext(ctx, stmt)
 	if err != nil {
-		return fmt.Errorf("could not describe security integration")
+		return diag.FromErr(fmt.Errorf("could not describe security integration"))
 	}
 	defer rows.Close()
 	for rows.Next() {
 		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
-			return fmt.Errorf("unable to parse security integration rows")
+			return diag.FromErr(fmt.Errorf("unable to parse security integration rows"))
 		}
 		switch k {
 		case "NETWORK_POLICY":
 			if err := d.Set("network_policy", v.(string)); err != nil {
-				return fmt.Errorf("unable to set network policy for security integration")
+				return diag.FromErr(fmt.Errorf("unable to set network policy for security integration"))
 			}
 		case "RUN_AS_ROLE":
 			if err := d.Set("provisioner_role", v.(string)); err != nil {
-				return fmt.Errorf("unable to set provisioner role for security integration")
+				return diag.FromErr(fmt.Errorf("unable to set provisioner role for security integration"))
 			}
 		default:
 			log.Printf("[WARN] unexpected security integration property %v returned from Snowflake", k)
 		}
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateSCIMIntegration implements schema.UpdateFunc.
-func UpdateSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
+// UpdateSCIMIntegration implements schema.UpdateContextFunc.
+func UpdateSCIMIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
@@ -185,8 +187,8 @@ func UpdateSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("network_policy") {
 		v := d.Get("network_policy").(string)
 		if len(v) == 0 {
-			if err := snowflake.Exec(db, fmt.Sprintf(`ALTER SECURITY INTEGRATION %v UNSET NETWORK_POLICY`, id)); err != nil {
-				return fmt.Errorf("error unsetting network_policy")
+			if err := snowflake.Exec(ctx, db, fmt.Sprintf(`ALTER SECURITY INTEGRATION %v UNSET NETWORK_POLICY`, id)); err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting network_policy"))
 			}
 		} else {
 			runSetStatement = true
@@ -195,15 +197,15 @@ func UpdateSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if runSetStatement {
-		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-			return fmt.Errorf("error updating security integration")
+		if err := snowflake.Exec(ctx, db, stmt.Statement()); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating security integration"))
 		}
 	}
 
-	return ReadSCIMIntegration(d, meta)
+	return ReadSCIMIntegration(ctx, d, meta)
 }
 
-// DeleteSCIMIntegration implements schema.DeleteFunc.
-func DeleteSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("", snowflake.NewSCIMIntegrationBuilder)(d, meta)
+// DeleteSCIMIntegration implements schema.DeleteContextFunc.
+func DeleteSCIMIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diag.FromErr(DeleteResource(ctx, "", snowflake.NewSCIMIntegrationBuilder)(d, meta))
 }
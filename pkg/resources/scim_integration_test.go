@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -36,8 +37,8 @@ func TestSCIMIntegrationCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadSCIMIntegration(mock)
 
-		err := resources.CreateSCIMIntegration(d, db)
-		r.NoError(err)
+		diags := resources.CreateSCIMIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -49,8 +50,8 @@ func TestSCIMIntegrationRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadSCIMIntegration(mock)
 
-		err := resources.ReadSCIMIntegration(d, db)
-		r.NoError(err)
+		diags := resources.ReadSCIMIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -61,8 +62,8 @@ func TestSCIMIntegrationDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP SECURITY INTEGRATION "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteSCIMIntegration(d, db)
-		r.NoError(err)
+		diags := resources.DeleteSCIMIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
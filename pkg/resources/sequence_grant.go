@@ -10,6 +10,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -99,10 +100,10 @@ var sequenceGrantSchema = map[string]*schema.Schema{
 func SequenceGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateSequenceGrant,
-			Read:               ReadSequenceGrant,
-			Delete:             DeleteSequenceGrant,
-			Update:             UpdateSequenceGrant,
+			CreateContext:      CreateSequenceGrant,
+			ReadContext:        ReadSequenceGrant,
+			DeleteContext:      DeleteSequenceGrant,
+			UpdateContext:      UpdateSequenceGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             sequenceGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -145,8 +146,8 @@ func SequenceGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateSequenceGrant implements schema.CreateFunc.
-func CreateSequenceGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateSequenceGrant implements schema.CreateContextFunc.
+func CreateSequenceGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sequenceName := d.Get("sequence_name").(string)
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -157,10 +158,10 @@ func CreateSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	if (sequenceName == "") && !onFuture && !onAll {
-		return errors.New("sequence_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("sequence_name must be set unless on_future or on_all is true"))
 	}
 	if (schemaName == "") && !onFuture && !onAll {
-		return errors.New("schema_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future or on_all is true"))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -173,18 +174,18 @@ func CreateSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.SequenceGrant(databaseName, schemaName, sequenceName)
 	}
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, sequenceName, privilege, withGrantOption, onFuture, onAll, roles)
 	d.SetId(grantID)
 
-	return ReadSequenceGrant(d, meta)
+	return ReadSequenceGrant(ctx, d, meta)
 }
 
-// ReadSequenceGrant implements schema.ReadFunc.
-func ReadSequenceGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadSequenceGrant implements schema.ReadContextFunc.
+func ReadSequenceGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	sequenceName := d.Get("sequence_name").(string)
@@ -204,20 +205,20 @@ func ReadSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.SequenceGrant(databaseName, schemaName, sequenceName)
 	}
 
-	err := readGenericGrant(d, meta, sequenceGrantSchema, builder, onFuture, onAll, validSequencePrivileges)
+	err := readGenericGrant(ctx, d, meta, sequenceGrantSchema, builder, onFuture, onAll, validSequencePrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, sequenceName, privilege, withGrantOption, onFuture, onAll, roles)
 	if grantID != d.Id() {
 		d.SetId(grantID)
 	}
-	return err
+	return diag.FromErr(err)
 }
 
-// DeleteSequenceGrant implements schema.DeleteFunc.
-func DeleteSequenceGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteSequenceGrant implements schema.DeleteContextFunc.
+func DeleteSequenceGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	sequenceName := d.Get("sequence_name").(string)
@@ -233,11 +234,11 @@ func DeleteSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 	default:
 		builder = snowflake.SequenceGrant(databaseName, schemaName, sequenceName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateSequenceGrant implements schema.UpdateFunc.
-func UpdateSequenceGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateSequenceGrant implements schema.UpdateContextFunc.
+func UpdateSequenceGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles") {
@@ -272,17 +273,17 @@ func UpdateSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
+		ctx, meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
+		ctx, meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadSequenceGrant(d, meta)
+	return ReadSequenceGrant(ctx, d, meta)
 }
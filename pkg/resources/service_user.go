@@ -0,0 +1,408 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// serviceUserSchema returns the schema shared by the service and the legacy service users. Both types cannot have
+// a first, middle or last name, and cannot use MFA. Only the legacy service users can log in with a password.
+func serviceUserSchema(userType sdk.UserType) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the user. Renaming the user is applied in place.",
+		},
+		"login_name": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			Description:      "The name used to log in. If not supplied, Snowflake uses the name of the user.",
+			DiffSuppressFunc: diffCaseInsensitive,
+		},
+		"display_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Name displayed for the user in the Snowflake web interface. If not supplied, Snowflake uses the name of the user.",
+		},
+		"email": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Email address of the user.",
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies a comment for the user.",
+		},
+		"disabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Specifies whether the user is disabled, which prevents logging in and aborts all the currently-running queries of the user.",
+		},
+		"days_to_expiry": userDaysToExpirySchema(),
+		"mins_to_unlock": userMinsToUnlockSchema(),
+		"default_warehouse": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies the virtual warehouse that is active by default for the user’s session upon login.",
+		},
+		"default_namespace": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: diffCaseInsensitive,
+			Description:      "Specifies the namespace (database only or database and schema) that is active by default for the user’s session upon login.",
+		},
+		"default_role": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: diffCaseInsensitive,
+			Description:      "Specifies the role that is active by default for the user’s session upon login.",
+		},
+		"default_secondary_roles": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "Specifies the set of secondary roles that are active for the user’s session upon login. Currently only [\"ALL\"] value is supported.",
+		},
+		"rsa_public_key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies the user’s RSA public key; used for key-pair authentication. Must be on 1 line without header and trailer.",
+		},
+		"rsa_public_key_2": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies the user’s second RSA public key; used to rotate the keys for key-pair authentication. Must be on 1 line without header and trailer.",
+		},
+		"has_rsa_public_key": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Will be true if the user has an RSA public key set.",
+		},
+		"parameters": userParametersSchema(),
+		"user_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Type of the user, always %s for this resource. A type changed outside of Terraform is set back during the next apply.", userType),
+		},
+	}
+	if userType == sdk.UserTypeLegacyService {
+		s["password"] = &schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			Description:   "**WARNING:** this will put the password in the terraform state file. Use carefully. Use `password_wo` instead with Terraform 1.11 or newer.",
+			ConflictsWith: []string{"password_wo"},
+		}
+		s["password_wo"] = &schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			WriteOnly:     true,
+			Description:   "Write-only version of `password`; it is never stored in the plan or the state (requires Terraform 1.11 or newer). The password is updated only when `password_wo_version` changes.",
+			ConflictsWith: []string{"password"},
+			RequiredWith:  []string{"password_wo_version"},
+		}
+		s["password_wo_version"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "Version of `password_wo`. Change it to set the current value of `password_wo` on the user.",
+			RequiredWith: []string{"password_wo"},
+		}
+		s["must_change_password"] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Specifies whether the user is forced to change their password on the next login.",
+		}
+	}
+	return s
+}
+
+// ServiceUser returns a pointer to the resource managing users of type SERVICE, which authenticate without a password.
+func ServiceUser() *schema.Resource {
+	return serviceUserForType(sdk.UserTypeService, "Resource used to manage users of type SERVICE, used by applications and integrations. Service users cannot log in with a password and cannot use MFA.")
+}
+
+// LegacyServiceUser returns a pointer to the resource managing users of type LEGACY_SERVICE, which may log in with a password.
+func LegacyServiceUser() *schema.Resource {
+	return serviceUserForType(sdk.UserTypeLegacyService, "Resource used to manage users of type LEGACY_SERVICE, used by the applications which still log in with a password. Legacy service users cannot use MFA.")
+}
+
+func serviceUserForType(userType sdk.UserType, description string) *schema.Resource {
+	return &schema.Resource{
+		Description:   description,
+		CreateContext: createServiceUser(userType),
+		ReadContext:   readServiceUser(userType),
+		UpdateContext: updateServiceUser(userType),
+		DeleteContext: DeleteServiceUser,
+		CustomizeDiff: customizeServiceUserDiff(userType),
+
+		Schema: serviceUserSchema(userType),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// customizeServiceUserDiff plans setting the type back when it was changed outside of Terraform.
+func customizeServiceUserDiff(userType sdk.UserType) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ any) error {
+		if current := d.Get("user_type").(string); current != "" && current != string(userType) {
+			return d.SetNew("user_type", string(userType))
+		}
+		return nil
+	}
+}
+
+func createServiceUser(userType sdk.UserType) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := sdk.NewClientFromDB(meta.(*sql.DB))
+
+		id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+		properties := &sdk.UserObjectProperties{
+			Type: sdk.Pointer(userType),
+		}
+		for key, property := range map[string]**string{
+			"login_name":        &properties.LoginName,
+			"display_name":      &properties.DisplayName,
+			"email":             &properties.Email,
+			"comment":           &properties.Comment,
+			"default_warehouse": &properties.DefaultWarehosue,
+			"default_namespace": &properties.DefaultNamespace,
+			"default_role":      &properties.DefaultRole,
+			"rsa_public_key":    &properties.RSAPublicKey,
+			"rsa_public_key_2":  &properties.RSAPublicKey2,
+		} {
+			if v, ok := d.GetOk(key); ok {
+				*property = sdk.String(v.(string))
+			}
+		}
+		if d.Get("disabled").(bool) {
+			properties.Disable = sdk.Bool(true)
+		}
+		if v, ok := d.GetOk("days_to_expiry"); ok {
+			properties.DaysToExpiry = sdk.Int(v.(int))
+		}
+		if v, ok := d.GetOk("mins_to_unlock"); ok {
+			properties.MinsToUnlock = sdk.Int(v.(int))
+		}
+		if v, ok := d.GetOk("default_secondary_roles"); ok {
+			properties.DefaultSeconaryRoles = expandUserSecondaryRoles(v)
+		}
+		if userType == sdk.UserTypeLegacyService {
+			if v, ok := d.GetOk("password"); ok {
+				properties.Password = sdk.String(v.(string))
+			}
+			if password, ok, err := getWriteOnlyString(d, "password_wo"); err != nil {
+				return diag.FromErr(err)
+			} else if ok {
+				properties.Password = sdk.String(password)
+			}
+			if d.Get("must_change_password").(bool) {
+				properties.MustChangePassword = sdk.Bool(true)
+			}
+		}
+
+		if err := client.Users.Create(ctx, id, &sdk.CreateUserOptions{ObjectProperties: properties}); err != nil {
+			return diag.FromErr(fmt.Errorf("error creating user %s: %w", id.Name(), err))
+		}
+		d.SetId(helpers.EncodeSnowflakeID(id))
+
+		if v, ok := d.GetOk("parameters"); ok {
+			object := sdk.Object{ObjectType: sdk.ObjectTypeUser, Name: id}
+			if err := client.Parameters.SetParametersOnObject(ctx, object, expandParameterSet(v)); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting parameters on user %s: %w", id.Name(), err))
+			}
+		}
+
+		return readServiceUser(userType)(ctx, d, meta)
+	}
+}
+
+// readServiceUser reads the user from SHOW USERS and SHOW PARAMETERS IN USER. The keys, the password, and the
+// counters (days_to_expiry and mins_to_unlock) cannot be read back, so they are left as configured.
+func readServiceUser(userType sdk.UserType) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := sdk.NewClientFromDB(meta.(*sql.DB))
+		id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+		user, err := client.Users.ShowByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+				log.Printf("[DEBUG] user (%s) not found or we are not authorized. Err: %s", d.Id(), err)
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+
+		values := map[string]any{
+			"name":                    user.Name,
+			"login_name":              user.LoginName,
+			"display_name":            user.DisplayName,
+			"email":                   user.Email,
+			"comment":                 user.Comment,
+			"disabled":                user.Disabled,
+			"default_warehouse":       user.DefaultWarehouse,
+			"default_namespace":       user.DefaultNamespace,
+			"default_role":            user.DefaultRole,
+			"default_secondary_roles": parseUserSecondaryRoles(user.DefaultSecondaryRoles),
+			"has_rsa_public_key":      user.HasRsaPublicKey,
+			"user_type":               user.Type,
+		}
+		if userType == sdk.UserTypeLegacyService {
+			values["must_change_password"] = user.MustChangePassword
+		}
+		for key, value := range values {
+			if err := d.Set(key, value); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if err := readUserParameters(ctx, client, id, d); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+}
+
+func updateServiceUser(userType sdk.UserType) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := sdk.NewClientFromDB(meta.(*sql.DB))
+		id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+		if d.HasChange("name") {
+			newId := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+			if err := client.Users.Alter(ctx, id, &sdk.AlterUserOptions{NewName: newId}); err != nil {
+				return diag.FromErr(fmt.Errorf("error renaming user %s: %w", id.Name(), err))
+			}
+			d.SetId(helpers.EncodeSnowflakeID(newId))
+			id = newId
+		}
+
+		set := &sdk.UserObjectProperties{}
+		unset := &sdk.UserObjectPropertiesUnset{}
+		runSet, runUnset := false, false
+
+		stringProperties := []struct {
+			key   string
+			set   **string
+			unset **bool
+		}{
+			{"login_name", &set.LoginName, &unset.LoginName},
+			{"display_name", &set.DisplayName, &unset.DisplayName},
+			{"email", &set.Email, &unset.Email},
+			{"comment", &set.Comment, &unset.Comment},
+			{"default_warehouse", &set.DefaultWarehosue, &unset.DefaultWarehosue},
+			{"default_namespace", &set.DefaultNamespace, &unset.DefaultNamespace},
+			{"default_role", &set.DefaultRole, &unset.DefaultRole},
+			{"rsa_public_key", &set.RSAPublicKey, &unset.RSAPublicKey},
+			{"rsa_public_key_2", &set.RSAPublicKey2, &unset.RSAPublicKey2},
+		}
+		if userType == sdk.UserTypeLegacyService {
+			stringProperties = append(stringProperties, struct {
+				key   string
+				set   **string
+				unset **bool
+			}{"password", &set.Password, &unset.Password})
+		}
+		for _, property := range stringProperties {
+			if !d.HasChange(property.key) {
+				continue
+			}
+			if v, ok := d.GetOk(property.key); ok {
+				runSet = true
+				*property.set = sdk.String(v.(string))
+			} else {
+				runUnset = true
+				*property.unset = sdk.Bool(true)
+			}
+		}
+		for _, property := range []struct {
+			key   string
+			set   **int
+			unset **bool
+		}{
+			{"days_to_expiry", &set.DaysToExpiry, &unset.DaysToExpiry},
+			{"mins_to_unlock", &set.MinsToUnlock, &unset.MinsToUnlock},
+		} {
+			propertySet, propertyUnset := updateUserIntProperty(d, property.key, property.set, property.unset)
+			runSet = runSet || propertySet
+			runUnset = runUnset || propertyUnset
+		}
+		if d.HasChange("disabled") {
+			runSet = true
+			set.Disable = sdk.Bool(d.Get("disabled").(bool))
+		}
+		if d.HasChange("default_secondary_roles") {
+			if v, ok := d.GetOk("default_secondary_roles"); ok {
+				runSet = true
+				set.DefaultSeconaryRoles = expandUserSecondaryRoles(v)
+			} else {
+				runUnset = true
+				unset.DefaultSeconaryRoles = sdk.Bool(true)
+			}
+		}
+		if d.HasChange("user_type") {
+			runSet = true
+			set.Type = sdk.Pointer(userType)
+		}
+		if userType == sdk.UserTypeLegacyService {
+			if d.HasChange("password_wo_version") {
+				password, ok, err := getWriteOnlyString(d, "password_wo")
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				if ok {
+					runSet = true
+					set.Password = sdk.String(password)
+				}
+			}
+			if d.HasChange("must_change_password") {
+				runSet = true
+				set.MustChangePassword = sdk.Bool(d.Get("must_change_password").(bool))
+			}
+		}
+
+		if runSet {
+			if err := client.Users.Alter(ctx, id, &sdk.AlterUserOptions{Set: &sdk.UserSet{ObjectProperties: set}}); err != nil {
+				return diag.FromErr(fmt.Errorf("error updating user %s: %w", id.Name(), err))
+			}
+		}
+		if runUnset {
+			if err := client.Users.Alter(ctx, id, &sdk.AlterUserOptions{Unset: &sdk.UserUnset{ObjectProperties: unset}}); err != nil {
+				return diag.FromErr(fmt.Errorf("error updating user %s: %w", id.Name(), err))
+			}
+		}
+		if err := updateUserParameters(ctx, client, id, d); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return readServiceUser(userType)(ctx, d, meta)
+	}
+}
+
+func DeleteServiceUser(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := sdk.NewClientFromDB(meta.(*sql.DB))
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if err := client.Users.Drop(ctx, id); err != nil {
+		return diag.FromErr(fmt.Errorf("error dropping user %s: %w", id.Name(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,117 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ServiceUser(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	newName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_service_user.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: serviceUserConfig(name, `comment = "first"`, "60"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "user_type", string(sdk.UserTypeService)),
+					resource.TestCheckResourceAttr(resourceName, "comment", "first"),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.STATEMENT_TIMEOUT_IN_SECONDS", "60"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.QUERY_TAG", "terraform"),
+				),
+			},
+			// renaming, unsetting the comment and changing the parameters are applied in place
+			{
+				Config: serviceUserConfig(newName, "", "120"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", newName),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttr(resourceName, "parameters.STATEMENT_TIMEOUT_IN_SECONDS", "120"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"days_to_expiry"},
+			},
+		},
+	})
+}
+
+func TestAcc_LegacyServiceUser(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_legacy_service_user.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: legacyServiceUserConfig(name, "Passw0rd-first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "user_type", string(sdk.UserTypeLegacyService)),
+					resource.TestCheckResourceAttr(resourceName, "must_change_password", "false"),
+				),
+			},
+			{
+				Config: legacyServiceUserConfig(name, "Passw0rd-second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func serviceUserConfig(name string, comment string, statementTimeout string) string {
+	return fmt.Sprintf(`
+resource "snowflake_service_user" "test" {
+	name           = "%[1]s"
+	%[2]s
+	days_to_expiry = 30
+	parameters = {
+		STATEMENT_TIMEOUT_IN_SECONDS = "%[3]s"
+		QUERY_TAG                    = "terraform"
+	}
+}
+`, name, comment, statementTimeout)
+}
+
+func legacyServiceUserConfig(name string, password string) string {
+	return fmt.Sprintf(`
+resource "snowflake_legacy_service_user" "test" {
+	name     = "%[1]s"
+	password = "%[2]s"
+}
+`, name, password)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -36,10 +37,10 @@ var sessionParameterSchema = map[string]*schema.Schema{
 
 func SessionParameter() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateSessionParameter,
-		Read:   ReadSessionParameter,
-		Update: UpdateSessionParameter,
-		Delete: DeleteSessionParameter,
+		CreateContext: CreateSessionParameter,
+		ReadContext:   ReadSessionParameter,
+		UpdateContext: UpdateSessionParameter,
+		DeleteContext: DeleteSessionParameter,
 
 		Schema: sessionParameterSchema,
 		Importer: &schema.ResourceImporter{
@@ -48,13 +49,12 @@ func SessionParameter() *schema.Resource {
 	}
 }
 
-// CreateSessionParameter implements schema.CreateFunc.
-func CreateSessionParameter(d *schema.ResourceData, meta interface{}) error {
+// CreateSessionParameter implements schema.CreateContextFunc.
+func CreateSessionParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	key := d.Get("key").(string)
 	value := d.Get("value").(string)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	onAccount := d.Get("on_account").(bool)
 	user := d.Get("user").(string)
 	parameter := sdk.SessionParameter(key)
@@ -63,29 +63,28 @@ func CreateSessionParameter(d *schema.ResourceData, meta interface{}) error {
 	if onAccount {
 		err := client.Parameters.SetSessionParameterOnAccount(ctx, parameter, value)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if user == "" {
-			return fmt.Errorf("user is required if on_account is false")
+			return diag.FromErr(fmt.Errorf("user is required if on_account is false"))
 		}
 		userId := sdk.NewAccountObjectIdentifier(user)
 		err = client.Parameters.SetSessionParameterOnUser(ctx, userId, parameter, value)
 		if err != nil {
-			return fmt.Errorf("error creating session parameter err = %w", err)
+			return diag.FromErr(fmt.Errorf("error creating session parameter err = %w", err))
 		}
 	}
 
 	d.SetId(key)
 
-	return ReadSessionParameter(d, meta)
+	return ReadSessionParameter(ctx, d, meta)
 }
 
-// ReadSessionParameter implements schema.ReadFunc.
-func ReadSessionParameter(d *schema.ResourceData, meta interface{}) error {
+// ReadSessionParameter implements schema.ReadContextFunc.
+func ReadSessionParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	parameter := d.Id()
 
 	onAccount := d.Get("on_account").(bool)
@@ -99,26 +98,25 @@ func ReadSessionParameter(d *schema.ResourceData, meta interface{}) error {
 		p, err = client.Parameters.ShowUserParameter(ctx, sdk.UserParameter(parameter), userId)
 	}
 	if err != nil {
-		return fmt.Errorf("error reading session parameter err = %w", err)
+		return diag.FromErr(fmt.Errorf("error reading session parameter err = %w", err))
 	}
 	err = d.Set("value", p.Value)
 	if err != nil {
-		return fmt.Errorf("error setting session parameter err = %w", err)
+		return diag.FromErr(fmt.Errorf("error setting session parameter err = %w", err))
 	}
 	return nil
 }
 
-// UpdateSessionParameter implements schema.UpdateFunc.
-func UpdateSessionParameter(d *schema.ResourceData, meta interface{}) error {
-	return CreateSessionParameter(d, meta)
+// UpdateSessionParameter implements schema.UpdateContextFunc.
+func UpdateSessionParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return CreateSessionParameter(ctx, d, meta)
 }
 
-// DeleteSessionParameter implements schema.DeleteFunc.
-func DeleteSessionParameter(d *schema.ResourceData, meta interface{}) error {
+// DeleteSessionParameter implements schema.DeleteContextFunc.
+func DeleteSessionParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	key := d.Get("key").(string)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	onAccount := d.Get("on_account").(bool)
 	parameter := sdk.SessionParameter(key)
@@ -126,27 +124,27 @@ func DeleteSessionParameter(d *schema.ResourceData, meta interface{}) error {
 	if onAccount {
 		defaultParameter, err := client.Parameters.ShowAccountParameter(ctx, sdk.AccountParameter(key))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		defaultValue := defaultParameter.Default
 		err = client.Parameters.SetSessionParameterOnAccount(ctx, parameter, defaultValue)
 		if err != nil {
-			return fmt.Errorf("error creating session parameter err = %w", err)
+			return diag.FromErr(fmt.Errorf("error creating session parameter err = %w", err))
 		}
 	} else {
 		user := d.Get("user").(string)
 		if user == "" {
-			return fmt.Errorf("user is required if on_account is false")
+			return diag.FromErr(fmt.Errorf("user is required if on_account is false"))
 		}
 		userId := sdk.NewAccountObjectIdentifier(user)
 		defaultParameter, err := client.Parameters.ShowSessionParameter(ctx, sdk.SessionParameter(key))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		defaultValue := defaultParameter.Default
 		err = client.Parameters.SetSessionParameterOnUser(ctx, userId, parameter, defaultValue)
 		if err != nil {
-			return fmt.Errorf("error deleting session parameter err = %w", err)
+			return diag.FromErr(fmt.Errorf("error deleting session parameter err = %w", err))
 		}
 	}
 
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -43,10 +44,10 @@ var shareSchema = map[string]*schema.Schema{
 // Share returns a pointer to the resource representing a share.
 func Share() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateShare,
-		Read:   ReadShare,
-		Update: UpdateShare,
-		Delete: DeleteShare,
+		CreateContext: CreateShare,
+		ReadContext:   ReadShare,
+		UpdateContext: UpdateShare,
+		DeleteContext: DeleteShare,
 
 		Schema: shareSchema,
 		Importer: &schema.ResourceImporter{
@@ -55,11 +56,10 @@ func Share() *schema.Resource {
 	}
 }
 
-// CreateShare implements schema.CreateFunc.
-func CreateShare(d *schema.ResourceData, meta interface{}) error {
+// CreateShare implements schema.CreateContextFunc.
+func CreateShare(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	comment := d.Get("comment").(string)
 	id := sdk.NewAccountObjectIdentifier(name)
@@ -70,7 +70,7 @@ func CreateShare(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 	if err := client.Shares.Create(ctx, id, &opts); err != nil {
-		return fmt.Errorf("error creating share (%v) err = %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error creating share (%v) err = %w", d.Id(), err))
 	}
 	d.SetId(name)
 
@@ -86,10 +86,10 @@ func CreateShare(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := setShareAccounts(ctx, client, shareID, accountIdentifiers)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return ReadShare(d, meta)
+	return ReadShare(ctx, d, meta)
 }
 
 func setShareAccounts(ctx context.Context, client *sdk.Client, shareID sdk.AccountObjectIdentifier, accounts []sdk.AccountIdentifier) error {
@@ -154,22 +154,21 @@ func setShareAccounts(ctx context.Context, client *sdk.Client, shareID sdk.Accou
 	return err
 }
 
-// ReadShare implements schema.ReadFunc.
-func ReadShare(d *schema.ResourceData, meta interface{}) error {
+// ReadShare implements schema.ReadContextFunc.
+func ReadShare(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := sdk.NewAccountObjectIdentifier(d.Id())
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	share, err := client.Shares.ShowByID(ctx, id)
 	if err != nil {
-		return fmt.Errorf("error reading share (%v) err = %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading share (%v) err = %w", d.Id(), err))
 	}
 	if err := d.Set("name", share.Name.Name()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("comment", share.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	accounts := make([]string, len(share.To))
 	for i, accountIdentifier := range share.To {
@@ -184,10 +183,10 @@ func ReadShare(d *schema.ResourceData, meta interface{}) error {
 		accounts = reorderStringList(currentAccounts, accounts)
 	}
 	if err := d.Set("accounts", accounts); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
 func accountIdentifiersFromSlice(accounts []string) []sdk.AccountIdentifier {
@@ -201,11 +200,10 @@ func accountIdentifiersFromSlice(accounts []string) []sdk.AccountIdentifier {
 	return accountIdentifiers
 }
 
-// UpdateShare implements schema.UpdateFunc.
-func UpdateShare(d *schema.ResourceData, meta interface{}) error {
+// UpdateShare implements schema.UpdateContextFunc.
+func UpdateShare(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	if d.HasChange("accounts") {
 		o, n := d.GetChange("accounts")
 		oldAccounts := expandStringList(o.([]interface{}))
@@ -218,13 +216,13 @@ func UpdateShare(d *schema.ResourceData, meta interface{}) error {
 				},
 			})
 			if err != nil {
-				return fmt.Errorf("error removing accounts from share (%v) err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error removing accounts from share (%v) err = %w", d.Id(), err))
 			}
 		} else {
 			accountIdentifiers := accountIdentifiersFromSlice(newAccounts)
 			err := setShareAccounts(ctx, client, sdk.NewAccountObjectIdentifier(d.Id()), accountIdentifiers)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -236,21 +234,20 @@ func UpdateShare(d *schema.ResourceData, meta interface{}) error {
 			},
 		})
 		if err != nil {
-			return fmt.Errorf("error updating share (%v) comment err = %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating share (%v) comment err = %w", d.Id(), err))
 		}
 	}
 
-	return ReadShare(d, meta)
+	return ReadShare(ctx, d, meta)
 }
 
-// DeleteShare implements schema.DeleteFunc.
-func DeleteShare(d *schema.ResourceData, meta interface{}) error {
+// DeleteShare implements schema.DeleteContextFunc.
+func DeleteShare(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	err := client.Shares.Drop(ctx, sdk.NewAccountObjectIdentifier(d.Id()))
 	if err != nil {
-		return fmt.Errorf("error deleting share (%v) err = %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting share (%v) err = %w", d.Id(), err))
 	}
 	return nil
 }
@@ -4,21 +4,48 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// stageStorageBlocks are the storage provider blocks of an external stage; a stage without any of them is internal.
+var stageStorageBlocks = []string{"s3", "gcs", "azure", "s3_compatible"}
+
+func stageStorageConflicts(block string) []string {
+	conflicts := []string{"internal_encryption"}
+	for _, other := range stageStorageBlocks {
+		if other != block {
+			conflicts = append(conflicts, other)
+		}
+	}
+	return conflicts
+}
+
+func stageStorageIntegrationSchema(block string) *schema.Schema {
+	s := &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the name of the storage integration used to delegate authentication responsibility for external cloud storage to a Snowflake identity and access management (IAM) entity.",
+	}
+	if block != "gcs" {
+		s.ConflictsWith = []string{fmt.Sprintf("%s.0.credentials", block)}
+	}
+	return s
+}
+
 var stageSchema = map[string]*schema.Schema{
 	"name": {
 		Type:        schema.TypeString,
 		Required:    true,
 		Description: "Specifies the identifier for the stage; must be unique for the database and schema in which the stage is created.",
-		ForceNew:    true,
 	},
 	"database": {
 		Type:        schema.TypeString,
@@ -32,62 +59,308 @@ var stageSchema = map[string]*schema.Schema{
 		Description: "The schema in which to create the stage.",
 		ForceNew:    true,
 	},
-	"url": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "Specifies the URL for the stage.",
+	"internal_encryption": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ValidateFunc:  validation.StringInSlice([]string{string(sdk.InternalStageEncryptionFull), string(sdk.InternalStageEncryptionSSE)}, false),
+		ConflictsWith: stageStorageBlocks,
+		Description:   "Specifies the type of encryption supported for all files stored on an internal stage (SNOWFLAKE_FULL | SNOWFLAKE_SSE). Cannot be changed after the stage is created.",
 	},
-	"credentials": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "Specifies the credentials for the stage.",
-		Sensitive:   true,
+	"s3": {
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: stageStorageConflicts("s3"),
+		Description:   "Creates an external stage on Amazon S3. Adding or removing the block recreates the stage.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"url": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "URL of the S3 bucket and path (e.g. `s3://bucket/path/`).",
+				},
+				"storage_integration": stageStorageIntegrationSchema("s3"),
+				"credentials": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Specifies the security credentials for connecting to AWS. Conflicts with `storage_integration`.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"aws_key_id": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								ConflictsWith: []string{"s3.0.credentials.0.aws_role"},
+								Description:   "AWS access key id.",
+							},
+							"aws_secret_key": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Sensitive:   true,
+								Description: "AWS secret access key.",
+							},
+							"aws_token": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Sensitive:   true,
+								Description: "AWS session token for temporary credentials.",
+							},
+							"aws_role": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "ARN of the AWS role used to access the bucket.",
+							},
+						},
+					},
+				},
+				"encryption": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Specifies the encryption of the files in the bucket.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice([]string{string(sdk.ExternalStageS3EncryptionCSE), string(sdk.ExternalStageS3EncryptionSSES3), string(sdk.ExternalStageS3EncryptionSSEKMS), string(sdk.ExternalStageS3EncryptionNone)}, false),
+								Description:  "Encryption type (AWS_CSE | AWS_SSE_S3 | AWS_SSE_KMS | NONE).",
+							},
+							"master_key": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Sensitive:   true,
+								Description: "Client-side master key used to decrypt the files; valid for AWS_CSE.",
+							},
+							"kms_key_id": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "ID of the AWS KMS-managed key used to encrypt unloaded files; valid for AWS_SSE_KMS.",
+							},
+						},
+					},
+				},
+			},
+		},
 	},
-	"storage_integration": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "Specifies the name of the storage integration used to delegate authentication responsibility for external cloud storage to a Snowflake identity and access management (IAM) entity.",
+	"gcs": {
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: stageStorageConflicts("gcs"),
+		Description:   "Creates an external stage on Google Cloud Storage. Adding or removing the block recreates the stage.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"url": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "URL of the GCS bucket and path (e.g. `gcs://bucket/path/`).",
+				},
+				"storage_integration": stageStorageIntegrationSchema("gcs"),
+				"encryption": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Specifies the encryption of the files in the bucket.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice([]string{string(sdk.ExternalStageGCSEncryptionSSEKMS), string(sdk.ExternalStageGCSEncryptionNone)}, false),
+								Description:  "Encryption type (GCS_SSE_KMS | NONE).",
+							},
+							"kms_key_id": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "ID of the Cloud KMS-managed key used to encrypt unloaded files; valid for GCS_SSE_KMS.",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	"azure": {
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: stageStorageConflicts("azure"),
+		Description:   "Creates an external stage on Microsoft Azure. Adding or removing the block recreates the stage.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"url": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "URL of the Azure container and path (e.g. `azure://account.blob.core.windows.net/container/path/`).",
+				},
+				"storage_integration": stageStorageIntegrationSchema("azure"),
+				"credentials": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Specifies the SAS token for connecting to Azure. Conflicts with `storage_integration`.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"azure_sas_token": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Sensitive:   true,
+								Description: "Shared access signature token.",
+							},
+						},
+					},
+				},
+				"encryption": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Specifies the encryption of the files in the container.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice([]string{string(sdk.ExternalStageAzureEncryptionCSE), string(sdk.ExternalStageAzureEncryptionNone)}, false),
+								Description:  "Encryption type (AZURE_CSE | NONE).",
+							},
+							"master_key": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Sensitive:   true,
+								Description: "Client-side master key used to decrypt the files; valid for AZURE_CSE.",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	"s3_compatible": {
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: stageStorageConflicts("s3_compatible"),
+		Description:   "Creates an external stage on an S3-compatible storage. Snowflake cannot alter these settings, so any change recreates the stage.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"url": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "URL of the bucket and path (e.g. `s3compat://bucket/path/`).",
+				},
+				"endpoint": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Endpoint of the S3-compatible storage.",
+				},
+				"credentials": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					ForceNew:    true,
+					MaxItems:    1,
+					Description: "Specifies the security credentials for connecting to the storage.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"aws_key_id": {
+								Type:        schema.TypeString,
+								Required:    true,
+								ForceNew:    true,
+								Description: "Access key id.",
+							},
+							"aws_secret_key": {
+								Type:        schema.TypeString,
+								Required:    true,
+								ForceNew:    true,
+								Sensitive:   true,
+								Description: "Secret access key.",
+							},
+						},
+					},
+				},
+			},
+		},
 	},
 	"file_format": {
-		Type:        schema.TypeString,
+		Type:        schema.TypeList,
 		Optional:    true,
-		Description: "Specifies the file format for the stage.",
+		MaxItems:    1,
+		Description: "Specifies the file format for the stage. The options left out of the configuration keep the Snowflake defaults of the format type; the options removed from it (or the whole block) are set back to the defaults.",
+		Elem: &schema.Resource{
+			Schema: stageFileFormatSchema,
+		},
 	},
 	"copy_options": {
-		Type:        schema.TypeString,
+		Type:        schema.TypeList,
 		Optional:    true,
-		Description: "Specifies the copy options for the stage.",
+		MaxItems:    1,
+		Description: "Specifies the copy options for the stage. The options left out of the configuration keep the Snowflake defaults; the options removed from it (or the whole block) are set back to the defaults.",
+		Elem: &schema.Resource{
+			Schema: stageCopyOptionsSchema,
+		},
 	},
-	"encryption": {
-		Type:        schema.TypeString,
+	"directory": {
+		Type:        schema.TypeList,
 		Optional:    true,
-		Description: "Specifies the encryption settings for the stage.",
+		MaxItems:    1,
+		Description: "Specifies the directory table settings for the stage.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enable": {
+					Type:        schema.TypeBool,
+					Required:    true,
+					Description: "Specifies whether to add a directory table to the stage. Changed in place.",
+				},
+				"refresh_on_create": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Specifies whether to automatically refresh the directory table metadata once, immediately after the stage is created. Used only on creation.",
+				},
+				"auto_refresh": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Specifies whether Snowflake should enable triggering automatic refreshes of the directory table metadata when new or updated data files are available in the external stage. Changing it recreates the stage.",
+				},
+				"notification_integration": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name of the notification integration used to automatically refresh the directory table metadata of a GCS or Azure stage. Changing it recreates the stage.",
+				},
+				"refresh_trigger": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Any change to this value refreshes the directory table metadata (ALTER STAGE ... REFRESH) after the stage is created. The directory table must be enabled.",
+				},
+			},
+		},
 	},
 	"comment": {
 		Type:        schema.TypeString,
 		Optional:    true,
 		Description: "Specifies a comment for the stage.",
 	},
-	"directory": {
-		Type:        schema.TypeString,
-		ForceNew:    true,
-		Optional:    true,
-		Description: "Specifies the directory settings for the stage.",
-	},
 	"aws_external_id": {
-		Type:     schema.TypeString,
-		Optional: true,
-		Computed: true,
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "External id used by Snowflake to assume the AWS role of the stage.",
 	},
 	"snowflake_iam_user": {
-		Type:     schema.TypeString,
-		Optional: true,
-		Computed: true,
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "AWS IAM user used by Snowflake to access the bucket of the stage.",
 	},
 	"tag": tagReferenceSchema,
 }
 
-// TODO (SNOW-1019005): Remove snowflake package that is used in Create and Update operations
 func Stage() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: CreateStage,
@@ -99,61 +372,139 @@ func Stage() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIfChange("directory.0.auto_refresh", func(ctx context.Context, old, new, meta any) bool {
+				return old.(bool) != new.(bool)
+			}),
+			customdiff.ForceNewIfChange("directory.0.notification_integration", func(ctx context.Context, old, new, meta any) bool {
+				return old.(string) != new.(string)
+			}),
+			customizeStageOptionsDiff(),
+		),
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				// setting type to cty.EmptyObject is a bit hacky here but following https://developer.hashicorp.com/terraform/plugin/framework/migrating/resources/state-upgrade#sdkv2-1 would require lots of repetitive code; this should work with cty.EmptyObject
+				Type:    cty.EmptyObject,
+				Upgrade: v087StageStateUpgrader,
+			},
+		},
 	}
 }
 
 func CreateStage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	name := d.Get("name").(string)
-	database := d.Get("database").(string)
-	schema := d.Get("schema").(string)
-
-	builder := snowflake.NewStageBuilder(name, database, schema)
-
-	if v, ok := d.GetOk("url"); ok {
-		builder.WithURL(v.(string))
-	}
-
-	if v, ok := d.GetOk("credentials"); ok {
-		builder.WithCredentials(v.(string))
-	}
-
-	if v, ok := d.GetOk("storage_integration"); ok {
-		builder.WithStorageIntegration(v.(string))
-	}
-
-	if v, ok := d.GetOk("file_format"); ok {
-		builder.WithFileFormat(v.(string))
-	}
-
-	if v, ok := d.GetOk("copy_options"); ok {
-		builder.WithCopyOptions(v.(string))
-	}
+	client := sdk.NewClientFromDB(db)
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
 
-	if v, ok := d.GetOk("directory"); ok {
-		builder.WithDirectory(v.(string))
+	fileFormat, err := getStageFileFormat(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-
-	if v, ok := d.GetOk("encryption"); ok {
-		builder.WithEncryption(v.(string))
+	copyOptions, err := getStageCopyOptions(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-
+	var comment *string
 	if v, ok := d.GetOk("comment"); ok {
-		builder.WithComment(v.(string))
+		comment = sdk.String(v.(string))
 	}
+	tags := getPropertyTags(d, "tag")
 
-	if v, ok := d.GetOk("tag"); ok {
-		tags := getTags(v)
-		builder.WithTags(tags.toSnowflakeTagValues())
+	kind, storage := getStageStorage(d)
+	directory, err := getStageDirectory(d, kind)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	q := builder.Create()
-
-	if err := snowflake.Exec(db, q); err != nil {
-		return diag.Errorf("error creating stage %v, err: %v", name, err)
+	switch kind {
+	case "s3":
+		request := sdk.NewCreateOnS3StageRequest(id).
+			WithExternalStageParams(stageS3Params(storage)).
+			WithFileFormat(fileFormat).
+			WithCopyOptions(copyOptions).
+			WithComment(comment).
+			WithTag(tags)
+		if directory != nil {
+			request.WithDirectoryTableOptions(sdk.NewExternalS3DirectoryTableOptionsRequest().
+				WithEnable(directory.enable).
+				WithRefreshOnCreate(directory.refreshOnCreate).
+				WithAutoRefresh(directory.autoRefresh))
+		}
+		err = client.Stages.CreateOnS3(ctx, request)
+	case "gcs":
+		request := sdk.NewCreateOnGCSStageRequest(id).
+			WithExternalStageParams(stageGCSParams(storage)).
+			WithFileFormat(fileFormat).
+			WithCopyOptions(copyOptions).
+			WithComment(comment).
+			WithTag(tags)
+		if directory != nil {
+			request.WithDirectoryTableOptions(sdk.NewExternalGCSDirectoryTableOptionsRequest().
+				WithEnable(directory.enable).
+				WithRefreshOnCreate(directory.refreshOnCreate).
+				WithAutoRefresh(directory.autoRefresh).
+				WithNotificationIntegration(directory.notificationIntegration))
+		}
+		err = client.Stages.CreateOnGCS(ctx, request)
+	case "azure":
+		request := sdk.NewCreateOnAzureStageRequest(id).
+			WithExternalStageParams(stageAzureParams(storage)).
+			WithFileFormat(fileFormat).
+			WithCopyOptions(copyOptions).
+			WithComment(comment).
+			WithTag(tags)
+		if directory != nil {
+			request.WithDirectoryTableOptions(sdk.NewExternalAzureDirectoryTableOptionsRequest().
+				WithEnable(directory.enable).
+				WithRefreshOnCreate(directory.refreshOnCreate).
+				WithAutoRefresh(directory.autoRefresh).
+				WithNotificationIntegration(directory.notificationIntegration))
+		}
+		err = client.Stages.CreateOnAzure(ctx, request)
+	case "s3_compatible":
+		request := sdk.NewCreateOnS3CompatibleStageRequest(id, storage["url"].(string), storage["endpoint"].(string)).
+			WithFileFormat(fileFormat).
+			WithCopyOptions(copyOptions).
+			WithComment(comment).
+			WithTag(tags)
+		if credentials := firstStageBlock(storage["credentials"]); credentials != nil {
+			request.WithCredentials(sdk.NewExternalStageS3CompatibleCredentialsRequest(
+				sdk.String(credentials["aws_key_id"].(string)),
+				sdk.String(credentials["aws_secret_key"].(string)),
+			))
+		}
+		if directory != nil {
+			request.WithDirectoryTableOptions(sdk.NewExternalS3DirectoryTableOptionsRequest().
+				WithEnable(directory.enable).
+				WithRefreshOnCreate(directory.refreshOnCreate).
+				WithAutoRefresh(directory.autoRefresh))
+		}
+		err = client.Stages.CreateOnS3Compatible(ctx, request)
+	default:
+		request := sdk.NewCreateInternalStageRequest(id).
+			WithFileFormat(fileFormat).
+			WithCopyOptions(copyOptions).
+			WithComment(comment).
+			WithTag(tags)
+		if v, ok := d.GetOk("internal_encryption"); ok {
+			request.WithEncryption(sdk.NewInternalStageEncryptionRequest(sdk.Pointer(sdk.InternalStageEncryptionOption(v.(string)))))
+		}
+		if directory != nil {
+			request.WithDirectoryTableOptions(sdk.NewInternalDirectoryTableOptionsRequest().
+				WithEnable(directory.enable).
+				WithRefreshOnCreate(directory.refreshOnCreate))
+		}
+		err = client.Stages.CreateInternal(ctx, request)
+	}
+	if err != nil {
+		return diag.Errorf("error creating stage %v, err: %v", id.Name(), err)
 	}
 
-	d.SetId(helpers.EncodeSnowflakeID(database, schema, name))
+	d.SetId(helpers.EncodeSnowflakeID(id))
 
 	return ReadStage(ctx, d, meta)
 }
@@ -199,42 +550,65 @@ func ReadStage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagn
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("url", strings.Trim(findStagePropertyValueByName(properties, "URL"), "[\"]")); err != nil {
-		return diag.FromErr(err)
-	}
-
-	fileFormat := make([]string, 0)
-	for _, property := range properties {
-		if property.Parent == "STAGE_FILE_FORMAT" && property.Value != property.Default {
-			fileFormat = append(fileFormat, fmt.Sprintf("%s = %s", property.Name, property.Value))
+	// Credentials and encryption keys are never returned by Snowflake, so they are kept from the state.
+	kind := stageStorageKindOf(stage)
+	for _, block := range stageStorageBlocks {
+		if block == kind {
+			continue
+		}
+		if err := d.Set(block, nil); err != nil {
+			return diag.FromErr(err)
 		}
 	}
-	if err := d.Set("file_format", strings.Join(fileFormat, " ")); err != nil {
-		return diag.FromErr(err)
+	if kind != "" {
+		storage := firstStageBlock(d.Get(kind))
+		if storage == nil {
+			storage = map[string]any{}
+		}
+		storage["url"] = stage.Url
+		if kind == "s3_compatible" {
+			if stage.Endpoint != nil {
+				storage["endpoint"] = *stage.Endpoint
+			}
+		} else {
+			storage["storage_integration"] = ""
+			if stage.StorageIntegration != nil {
+				storage["storage_integration"] = *stage.StorageIntegration
+			}
+		}
+		if err := d.Set(kind, []any{storage}); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	copyOptions := make([]string, 0)
-	for _, property := range properties {
-		if property.Parent == "STAGE_COPY_OPTIONS" && property.Value != property.Default {
-			copyOptions = append(copyOptions, fmt.Sprintf("%s = %s", property.Name, property.Value))
-		}
+	fileFormat, err := readStageFileFormat(properties, firstStageBlock(d.Get("file_format")))
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	if err := d.Set("copy_options", strings.Join(copyOptions, " ")); err != nil {
+	if err := d.Set("file_format", fileFormat); err != nil {
 		return diag.FromErr(err)
 	}
 
-	directory := make([]string, 0)
-	for _, property := range properties {
-		if property.Parent == "DIRECTORY" && property.Value != property.Default && property.Name != "LAST_REFRESHED_ON" {
-			directory = append(directory, fmt.Sprintf("%s = %s", property.Name, property.Value))
-		}
+	copyOptions, err := readStageCopyOptions(properties, firstStageBlock(d.Get("copy_options")))
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	if err := d.Set("directory", strings.Join(directory, " ")); err != nil {
+	if err := d.Set("copy_options", copyOptions); err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("storage_integration", stage.StorageIntegration); err != nil {
-		return diag.FromErr(err)
+	// refresh_on_create, notification_integration and refresh_trigger cannot be read, so they are kept from the state.
+	if directory := firstStageBlock(d.Get("directory")); directory != nil || stage.DirectoryEnabled {
+		if directory == nil {
+			directory = map[string]any{}
+		}
+		directory["enable"] = stage.DirectoryEnabled
+		if autoRefresh := findStageProperty(properties, "DIRECTORY", "AUTO_REFRESH"); autoRefresh != nil {
+			directory["auto_refresh"], _ = strconv.ParseBool(strings.ToLower(autoRefresh.Value))
+		}
+		if err := d.Set("directory", []any{directory}); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	if err := d.Set("comment", stage.Comment); err != nil {
@@ -255,73 +629,81 @@ func ReadStage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagn
 func UpdateStage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
-	builder := snowflake.NewStageBuilder(id.Name(), id.DatabaseName(), id.SchemaName())
-
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 
-	if d.HasChange("credentials") {
-		credentials := d.Get("credentials")
-		q := builder.ChangeCredentials(credentials.(string))
-		if err := snowflake.Exec(db, q); err != nil {
-			return diag.Errorf("error updating stage credentials on %v", d.Id())
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), d.Get("name").(string))
+		if err := client.Stages.Alter(ctx, sdk.NewAlterStageRequest(id).WithRenameTo(&newId)); err != nil {
+			return diag.Errorf("error renaming stage %v to %v, err = %s", d.Id(), newId.FullyQualifiedName(), err)
 		}
+		d.SetId(helpers.EncodeSnowflakeID(newId))
+		id = newId
 	}
 
-	if d.HasChange("storage_integration") && d.HasChange("url") {
-		si := d.Get("storage_integration")
-		url := d.Get("url")
-		q := builder.ChangeStorageIntegrationAndUrl(si.(string), url.(string))
-		if err := snowflake.Exec(db, q); err != nil {
-			return diag.Errorf("error updating stage storage integration and url on %v", d.Id())
+	var fileFormat *sdk.StageFileFormatRequest
+	var copyOptions *sdk.StageCopyOptionsRequest
+	var comment *string
+	var err error
+	if d.HasChange("file_format") {
+		if fileFormat, err = getStageFileFormat(d); err != nil {
+			return diag.FromErr(err)
 		}
-	} else {
-		if d.HasChange("storage_integration") {
-			si := d.Get("storage_integration")
-			q := builder.ChangeStorageIntegration(si.(string))
-			if err := snowflake.Exec(db, q); err != nil {
-				return diag.Errorf("error updating stage storage integration on %v", d.Id())
-			}
+	}
+	if d.HasChange("copy_options") {
+		if copyOptions, err = getStageCopyOptions(d); err != nil {
+			return diag.FromErr(err)
 		}
+	}
+	if d.HasChange("comment") {
+		comment = sdk.String(d.Get("comment").(string))
+	}
 
-		if d.HasChange("url") {
-			url := d.Get("url")
-			q := builder.ChangeURL(url.(string))
-			if err := snowflake.Exec(db, q); err != nil {
-				return diag.Errorf("error updating stage url on %v", d.Id())
+	kind, storage := getStageStorage(d)
+	storageChanged := kind != "" && kind != "s3_compatible" && d.HasChange(kind)
+	if fileFormat != nil || copyOptions != nil || comment != nil || storageChanged {
+		switch kind {
+		case "s3":
+			request := sdk.NewAlterExternalS3StageStageRequest(id).WithFileFormat(fileFormat).WithCopyOptions(copyOptions).WithComment(comment)
+			if storageChanged {
+				request.WithExternalStageParams(stageS3Params(storage))
+			}
+			err = client.Stages.AlterExternalS3Stage(ctx, request)
+		case "gcs":
+			request := sdk.NewAlterExternalGCSStageStageRequest(id).WithFileFormat(fileFormat).WithCopyOptions(copyOptions).WithComment(comment)
+			if storageChanged {
+				request.WithExternalStageParams(stageGCSParams(storage))
 			}
+			err = client.Stages.AlterExternalGCSStage(ctx, request)
+		case "azure":
+			request := sdk.NewAlterExternalAzureStageStageRequest(id).WithFileFormat(fileFormat).WithCopyOptions(copyOptions).WithComment(comment)
+			if storageChanged {
+				request.WithExternalStageParams(stageAzureParams(storage))
+			}
+			err = client.Stages.AlterExternalAzureStage(ctx, request)
+		default:
+			err = client.Stages.AlterInternalStage(ctx, sdk.NewAlterInternalStageStageRequest(id).WithFileFormat(fileFormat).WithCopyOptions(copyOptions).WithComment(comment))
 		}
-	}
-
-	if d.HasChange("encryption") {
-		encryption := d.Get("encryption")
-		q := builder.ChangeEncryption(encryption.(string))
-		if err := snowflake.Exec(db, q); err != nil {
-			return diag.Errorf("error updating stage encryption on %v", d.Id())
+		if err != nil {
+			return diag.Errorf("error updating stage %v, err = %s", d.Id(), err)
 		}
 	}
 
-	if d.HasChange("file_format") {
-		fileFormat := d.Get("file_format")
-		q := builder.ChangeFileFormat(fileFormat.(string))
-		if err := snowflake.Exec(db, q); err != nil {
-			return diag.Errorf("error updating stage file format on %v", d.Id())
+	if d.HasChange("directory.0.enable") {
+		enable := d.Get("directory.0.enable").(bool)
+		err := client.Stages.AlterDirectoryTable(ctx, sdk.NewAlterDirectoryTableStageRequest(id).WithSetDirectory(sdk.NewDirectoryTableSetRequest(enable)))
+		if err != nil {
+			return diag.Errorf("error setting directory table of stage %v, err = %s", d.Id(), err)
 		}
 	}
 
-	if d.HasChange("copy_options") {
-		copyOptions := d.Get("copy_options")
-		q := builder.ChangeCopyOptions(copyOptions.(string))
-		if err := snowflake.Exec(db, q); err != nil {
-			return diag.Errorf("error updating stage copy options on %v", d.Id())
+	if d.HasChange("directory.0.refresh_trigger") && d.Get("directory.0.refresh_trigger").(string) != "" {
+		if !d.Get("directory.0.enable").(bool) {
+			return diag.Errorf("directory table of stage %v must be enabled to be refreshed", d.Id())
 		}
-	}
-
-	if d.HasChange("comment") {
-		comment := d.Get("comment")
-		q := builder.ChangeComment(comment.(string))
-		if err := snowflake.Exec(db, q); err != nil {
-			return diag.Errorf("error updating stage comment on %v", d.Id())
+		err := client.Stages.AlterDirectoryTable(ctx, sdk.NewAlterDirectoryTableStageRequest(id).WithRefresh(sdk.NewDirectoryTableRefreshRequest()))
+		if err != nil {
+			return diag.Errorf("error refreshing directory table of stage %v, err = %s", d.Id(), err)
 		}
 	}
 
@@ -375,3 +757,138 @@ func findStagePropertyValueByName(properties []sdk.StageProperty, name string) s
 	}
 	return ""
 }
+
+func firstStageBlock(v any) map[string]any {
+	if list, ok := v.([]any); ok && len(list) > 0 && list[0] != nil {
+		return list[0].(map[string]any)
+	}
+	return nil
+}
+
+// getStageStorage returns the configured storage provider block of the stage; the kind is empty for internal stages.
+func getStageStorage(d *schema.ResourceData) (string, map[string]any) {
+	for _, block := range stageStorageBlocks {
+		if storage := firstStageBlock(d.Get(block)); storage != nil {
+			return block, storage
+		}
+	}
+	return "", nil
+}
+
+// stageStorageKindOf returns the storage provider block matching the stage returned by SHOW STAGES.
+func stageStorageKindOf(stage *sdk.Stage) string {
+	if stage.Endpoint != nil && *stage.Endpoint != "" {
+		return "s3_compatible"
+	}
+	if stage.Cloud != nil {
+		switch strings.ToUpper(*stage.Cloud) {
+		case "AWS":
+			return "s3"
+		case "GCP":
+			return "gcs"
+		case "AZURE":
+			return "azure"
+		}
+	}
+	return stageStorageKindOfUrl(stage.Url)
+}
+
+func stageStorageKindOfUrl(url string) string {
+	switch {
+	case strings.HasPrefix(url, "s3://"), strings.HasPrefix(url, "s3gov://"), strings.HasPrefix(url, "s3china://"):
+		return "s3"
+	case strings.HasPrefix(url, "gcs://"):
+		return "gcs"
+	case strings.HasPrefix(url, "azure://"):
+		return "azure"
+	case strings.HasPrefix(url, "s3compat://"):
+		return "s3_compatible"
+	}
+	return ""
+}
+
+func stageStorageIntegration(storage map[string]any) *sdk.AccountObjectIdentifier {
+	if v, ok := storage["storage_integration"].(string); ok && v != "" {
+		return sdk.Pointer(sdk.NewAccountObjectIdentifier(v))
+	}
+	return nil
+}
+
+func optionalStageString(block map[string]any, key string) *string {
+	if v, ok := block[key].(string); ok && v != "" {
+		return sdk.String(v)
+	}
+	return nil
+}
+
+func stageS3Params(storage map[string]any) *sdk.ExternalS3StageParamsRequest {
+	params := sdk.NewExternalS3StageParamsRequest(storage["url"].(string)).WithStorageIntegration(stageStorageIntegration(storage))
+	if credentials := firstStageBlock(storage["credentials"]); credentials != nil {
+		params.WithCredentials(sdk.NewExternalStageS3CredentialsRequest().
+			WithAwsKeyId(optionalStageString(credentials, "aws_key_id")).
+			WithAwsSecretKey(optionalStageString(credentials, "aws_secret_key")).
+			WithAwsToken(optionalStageString(credentials, "aws_token")).
+			WithAwsRole(optionalStageString(credentials, "aws_role")))
+	}
+	if encryption := firstStageBlock(storage["encryption"]); encryption != nil {
+		params.WithEncryption(sdk.NewExternalStageS3EncryptionRequest(sdk.Pointer(sdk.ExternalStageS3EncryptionOption(encryption["type"].(string)))).
+			WithMasterKey(optionalStageString(encryption, "master_key")).
+			WithKmsKeyId(optionalStageString(encryption, "kms_key_id")))
+	}
+	return params
+}
+
+func stageGCSParams(storage map[string]any) *sdk.ExternalGCSStageParamsRequest {
+	params := sdk.NewExternalGCSStageParamsRequest(storage["url"].(string)).WithStorageIntegration(stageStorageIntegration(storage))
+	if encryption := firstStageBlock(storage["encryption"]); encryption != nil {
+		params.WithEncryption(sdk.NewExternalStageGCSEncryptionRequest(sdk.Pointer(sdk.ExternalStageGCSEncryptionOption(encryption["type"].(string)))).
+			WithKmsKeyId(optionalStageString(encryption, "kms_key_id")))
+	}
+	return params
+}
+
+func stageAzureParams(storage map[string]any) *sdk.ExternalAzureStageParamsRequest {
+	params := sdk.NewExternalAzureStageParamsRequest(storage["url"].(string)).WithStorageIntegration(stageStorageIntegration(storage))
+	if credentials := firstStageBlock(storage["credentials"]); credentials != nil {
+		params.WithCredentials(sdk.NewExternalStageAzureCredentialsRequest(credentials["azure_sas_token"].(string)))
+	}
+	if encryption := firstStageBlock(storage["encryption"]); encryption != nil {
+		params.WithEncryption(sdk.NewExternalStageAzureEncryptionRequest(sdk.Pointer(sdk.ExternalStageAzureEncryptionOption(encryption["type"].(string)))).
+			WithMasterKey(optionalStageString(encryption, "master_key")))
+	}
+	return params
+}
+
+type stageDirectory struct {
+	enable                  *bool
+	refreshOnCreate         *bool
+	autoRefresh             *bool
+	notificationIntegration *string
+}
+
+// getStageDirectory returns the directory table options of the stage, checking that the storage of the stage supports them.
+func getStageDirectory(d *schema.ResourceData, kind string) (*stageDirectory, error) {
+	directory := firstStageBlock(d.Get("directory"))
+	if directory == nil {
+		return nil, nil
+	}
+	options := &stageDirectory{
+		enable: sdk.Bool(directory["enable"].(bool)),
+	}
+	if directory["refresh_on_create"].(bool) {
+		options.refreshOnCreate = sdk.Bool(true)
+	}
+	if directory["auto_refresh"].(bool) {
+		if kind == "" {
+			return nil, fmt.Errorf("directory.auto_refresh is supported only for external stages")
+		}
+		options.autoRefresh = sdk.Bool(true)
+	}
+	if v := directory["notification_integration"].(string); v != "" {
+		if kind != "gcs" && kind != "azure" {
+			return nil, fmt.Errorf("directory.notification_integration is supported only for GCS and Azure stages")
+		}
+		options.notificationIntegration = sdk.String(v)
+	}
+	return options, nil
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
@@ -25,14 +26,14 @@ func TestAcc_StageAlterWhenBothURLAndStorageIntegrationChange(t *testing.T) {
 				Config: stageIntegrationConfig(name, "si1", "s3://foo/", acc.TestDatabaseName, acc.TestSchemaName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("snowflake_stage.test", "name", name),
-					resource.TestCheckResourceAttr("snowflake_stage.test", "url", "s3://foo/"),
+					resource.TestCheckResourceAttr("snowflake_stage.test", "s3.0.url", "s3://foo/"),
 				),
 			},
 			{
 				Config: stageIntegrationConfig(name, "changed", "s3://changed/", acc.TestDatabaseName, acc.TestSchemaName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("snowflake_stage.test", "name", name),
-					resource.TestCheckResourceAttr("snowflake_stage.test", "url", "s3://changed/"),
+					resource.TestCheckResourceAttr("snowflake_stage.test", "s3.0.url", "s3://changed/"),
 				),
 			},
 		},
@@ -49,26 +50,25 @@ func TestAcc_Stage_CreateAndAlter(t *testing.T) {
 	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	url := "s3://foo/"
 	comment := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
-	storageIntegration := ""
-	credentials := fmt.Sprintf("AWS_KEY_ID = '%s' AWS_SECRET_KEY = '%s'", awsKeyId, awsSecretKey)
-	encryption := "TYPE = 'NONE'"
+	encryption := "NONE"
 
 	changedUrl := awsBucketUrl + "/some-path"
 	changedStorageIntegration := "S3_STORAGE_INTEGRATION"
-	changedEncryption := "TYPE = 'AWS_SSE_S3'"
-	changedFileFormat := "TYPE = JSON NULL_IF = []"
+	changedEncryption := "AWS_SSE_S3"
+	changedFileFormatType := "JSON"
 	changedComment := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
-	configVariables := func(url string, storageIntegration string, credentials string, encryption string, fileFormat string, comment string) config.Variables {
+	configVariables := func(url string, storageIntegration string, awsKeyId string, awsSecretKey string, encryption string, fileFormatType string, comment string) config.Variables {
 		return config.Variables{
 			"database":            config.StringVariable(databaseName),
 			"schema":              config.StringVariable(schemaName),
 			"name":                config.StringVariable(name),
 			"url":                 config.StringVariable(url),
 			"storage_integration": config.StringVariable(storageIntegration),
-			"credentials":         config.StringVariable(credentials),
+			"aws_key_id":          config.StringVariable(awsKeyId),
+			"aws_secret_key":      config.StringVariable(awsSecretKey),
 			"encryption":          config.StringVariable(encryption),
-			"file_format":         config.StringVariable(fileFormat),
+			"file_format_type":    config.StringVariable(fileFormatType),
 			"comment":             config.StringVariable(comment),
 		}
 	}
@@ -83,31 +83,39 @@ func TestAcc_Stage_CreateAndAlter(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				ConfigDirectory: config.TestNameDirectory(),
-				ConfigVariables: configVariables(url, storageIntegration, credentials, encryption, "", comment),
+				ConfigVariables: configVariables(url, "", awsKeyId, awsSecretKey, encryption, "CSV", comment),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "database", databaseName),
 					resource.TestCheckResourceAttr(resourceName, "schema", schemaName),
 					resource.TestCheckResourceAttr(resourceName, "name", name),
-					resource.TestCheckResourceAttr(resourceName, "storage_integration", storageIntegration),
-					resource.TestCheckResourceAttr(resourceName, "credentials", credentials),
-					resource.TestCheckResourceAttr(resourceName, "encryption", encryption),
-					resource.TestCheckResourceAttr(resourceName, "file_format", ""),
-					resource.TestCheckResourceAttr(resourceName, "url", url),
+					resource.TestCheckResourceAttr(resourceName, "s3.0.url", url),
+					resource.TestCheckResourceAttr(resourceName, "s3.0.storage_integration", ""),
+					resource.TestCheckResourceAttr(resourceName, "s3.0.credentials.0.aws_key_id", awsKeyId),
+					resource.TestCheckResourceAttr(resourceName, "s3.0.encryption.0.type", encryption),
+					resource.TestCheckResourceAttr(resourceName, "file_format.0.type", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "file_format.0.skip_header", "1"),
+					resource.TestCheckResourceAttr(resourceName, "copy_options.0.on_error", "CONTINUE"),
 					resource.TestCheckResourceAttr(resourceName, "comment", comment),
 				),
 			},
 			{
 				ConfigDirectory: config.TestNameDirectory(),
-				ConfigVariables: configVariables(changedUrl, changedStorageIntegration, credentials, changedEncryption, changedFileFormat, changedComment),
+				ConfigVariables: configVariables(changedUrl, changedStorageIntegration, "", "", changedEncryption, changedFileFormatType, changedComment),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "database", databaseName),
 					resource.TestCheckResourceAttr(resourceName, "schema", schemaName),
 					resource.TestCheckResourceAttr(resourceName, "name", name),
-					resource.TestCheckResourceAttr(resourceName, "storage_integration", changedStorageIntegration),
-					resource.TestCheckResourceAttr(resourceName, "credentials", credentials),
-					resource.TestCheckResourceAttr(resourceName, "encryption", changedEncryption),
-					resource.TestCheckResourceAttr(resourceName, "file_format", changedFileFormat),
-					resource.TestCheckResourceAttr(resourceName, "url", changedUrl),
+					resource.TestCheckResourceAttr(resourceName, "s3.0.url", changedUrl),
+					resource.TestCheckResourceAttr(resourceName, "s3.0.storage_integration", changedStorageIntegration),
+					resource.TestCheckResourceAttr(resourceName, "s3.0.credentials.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "s3.0.encryption.0.type", changedEncryption),
+					resource.TestCheckResourceAttr(resourceName, "file_format.0.type", changedFileFormatType),
+					resource.TestCheckResourceAttr(resourceName, "file_format.0.null_if.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "comment", changedComment),
 				),
 			},
@@ -115,6 +123,94 @@ func TestAcc_Stage_CreateAndAlter(t *testing.T) {
 	})
 }
 
+func TestAcc_Stage_InternalInPlaceChanges(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	newName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_stage.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: internalStageInPlaceConfig(name, false, "", "CSV", "ABORT_STATEMENT"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "directory.0.enable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "file_format.0.type", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "file_format.0.field_delimiter", "|"),
+					resource.TestCheckResourceAttr(resourceName, "copy_options.0.on_error", "ABORT_STATEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "copy_options.0.purge", ""),
+				),
+			},
+			{
+				Config: internalStageInPlaceConfig(newName, true, "1", "JSON", "SKIP_FILE"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", newName),
+					resource.TestCheckResourceAttr(resourceName, "directory.0.enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "directory.0.refresh_trigger", "1"),
+					resource.TestCheckResourceAttr(resourceName, "file_format.0.type", "JSON"),
+					resource.TestCheckResourceAttr(resourceName, "copy_options.0.on_error", "SKIP_FILE"),
+				),
+			},
+			{
+				Config: internalStageInPlaceConfig(newName, true, "2", "JSON", "SKIP_FILE"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "directory.0.refresh_trigger", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"directory.0.refresh_trigger", "directory.0.refresh_on_create"},
+			},
+		},
+	})
+}
+
+func internalStageInPlaceConfig(name string, directoryEnabled bool, refreshTrigger string, fileFormatType string, onError string) string {
+	fieldDelimiter := ""
+	if fileFormatType == "CSV" {
+		fieldDelimiter = `field_delimiter = "|"`
+	}
+	return fmt.Sprintf(`
+resource "snowflake_stage" "test" {
+	name = "%[1]s"
+	database = "%[2]s"
+	schema = "%[3]s"
+
+	file_format {
+		type = "%[4]s"
+		%[5]s
+	}
+
+	copy_options {
+		on_error = "%[6]s"
+	}
+
+	directory {
+		enable = %[7]t
+		refresh_trigger = "%[8]s"
+	}
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName, fileFormatType, fieldDelimiter, onError, directoryEnabled, refreshTrigger)
+}
+
 func stageIntegrationConfig(name string, siNameSuffix string, url string, databaseName string, schemaName string) string {
 	resources := `
 resource "snowflake_storage_integration" "test" {
@@ -127,12 +223,14 @@ resource "snowflake_storage_integration" "test" {
 
 resource "snowflake_stage" "test" {
 	name = "%s"
-	url = "%s"
-	storage_integration = snowflake_storage_integration.test.name
 	database = "%s"
 	schema = "%s"
+	s3 {
+		url = "%s"
+		storage_integration = snowflake_storage_integration.test.name
+	}
 }
 `
 
-	return fmt.Sprintf(resources, name, siNameSuffix, url, name, url, databaseName, schemaName)
+	return fmt.Sprintf(resources, name, siNameSuffix, url, name, databaseName, schemaName, url)
 }
@@ -0,0 +1,419 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var stageFileSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database of the stage.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema of the stage.",
+	},
+	"stage": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the stage the files are uploaded to.",
+	},
+	"path": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     "",
+		Description: "Path within the stage the files are uploaded to (e.g. `imports/python`). By default, the files are uploaded to the root of the stage.",
+	},
+	"source": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Path of the local file or directory to upload. The files of a directory are uploaded recursively, keeping their relative paths.",
+	},
+	"auto_compress": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     true,
+		Description: "Specifies whether the files are compressed with gzip during the upload (the `.gz` extension is added to their names).",
+	},
+	"overwrite": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether the first upload may overwrite files that already exist in the stage. The uploads of changed content always overwrite the files managed by the resource.",
+	},
+	"parallel": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntBetween(1, 99),
+		Description:  "Number of threads used to upload the files.",
+	},
+	"content_sha256": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "SHA-256 checksum of the local content. A change of the local content, or of the files in the stage, uploads the files again.",
+	},
+	"files": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The uploaded files.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"source": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Path of the file relative to `source` (the file name when `source` is a file).",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Path of the file in the stage.",
+				},
+				"size": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Size of the file in the stage.",
+				},
+				"md5": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "MD5 checksum of the file in the stage, as returned by LIST.",
+				},
+			},
+		},
+	},
+}
+
+// StageFile uploads local files to a stage with PUT.
+func StageFile() *schema.Resource {
+	return stageFileResource(func(meta any) sdk.StageFiles {
+		return sdk.NewClientFromDB(meta.(*sql.DB)).StageFiles
+	})
+}
+
+// stageFileResource builds the resource on top of the given stage files, so that the uploads can be tested without Snowflake.
+func stageFileResource(stageFilesOf func(meta any) sdk.StageFiles) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return CreateStageFile(ctx, d, stageFilesOf(meta))
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return ReadStageFile(ctx, d, stageFilesOf(meta))
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return UpdateStageFile(ctx, d, stageFilesOf(meta))
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return DeleteStageFile(ctx, d, stageFilesOf(meta))
+		},
+		CustomizeDiff: customizeStageFileDiff,
+
+		Schema: stageFileSchema,
+	}
+}
+
+func CreateStageFile(ctx context.Context, d *schema.ResourceData, stageFiles sdk.StageFiles) diag.Diagnostics {
+	stageId := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("stage").(string))
+	stagePath := d.Get("path").(string)
+	source := d.Get("source").(string)
+
+	checksum, err := uploadStageFiles(ctx, d, stageFiles, stageId, d.Get("overwrite").(bool))
+	if err != nil {
+		return diag.Errorf("error uploading %s to %s, err = %s", source, sdk.NewStageLocation(stageId, stagePath), err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(stageId.DatabaseName(), stageId.SchemaName(), stageId.Name(), stagePath, source))
+	if err := d.Set("content_sha256", checksum); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadStageFile(ctx, d, stageFiles)
+}
+
+func ReadStageFile(ctx context.Context, d *schema.ResourceData, stageFiles sdk.StageFiles) diag.Diagnostics {
+	stageId := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("stage").(string))
+
+	listed, err := stageFiles.List(ctx, sdk.NewStageLocation(stageId, d.Get("path").(string)), nil)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[DEBUG] stage (%s) not found or we are not authorized. Err: %s", stageId.FullyQualifiedName(), err)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error listing files of stage %s, err = %s", stageId.FullyQualifiedName(), err)
+	}
+
+	files := d.Get("files").([]any)
+	found := make([]any, 0, len(files))
+	drifted := false
+	for _, f := range files {
+		file := f.(map[string]any)
+		stageFile := findStageFile(listed, file["name"].(string))
+		if stageFile == nil {
+			drifted = true
+			continue
+		}
+		if stageFile.MD5 != file["md5"].(string) {
+			drifted = true
+		}
+		file["size"] = int(stageFile.Size)
+		file["md5"] = stageFile.MD5
+		found = append(found, file)
+	}
+
+	if len(files) > 0 && len(found) == 0 {
+		// all the files were removed from the stage (or the stage itself was dropped)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("files", found); err != nil {
+		return diag.FromErr(err)
+	}
+	if drifted {
+		// the content of the stage no longer matches the uploaded one; an empty checksum makes the plan upload the files again
+		if err := d.Set("content_sha256", ""); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateStageFile(ctx context.Context, d *schema.ResourceData, stageFiles sdk.StageFiles) diag.Diagnostics {
+	if d.HasChange("content_sha256") {
+		stageId := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("stage").(string))
+		oldFiles := d.Get("files").([]any)
+
+		checksum, err := uploadStageFiles(ctx, d, stageFiles, stageId, true)
+		if err != nil {
+			return diag.Errorf("error uploading %s to %s, err = %s", d.Get("source").(string), sdk.NewStageLocation(stageId, d.Get("path").(string)), err)
+		}
+
+		// remove the files that are no longer in the source directory
+		uploaded := make([]string, 0)
+		for _, f := range d.Get("files").([]any) {
+			uploaded = append(uploaded, f.(map[string]any)["name"].(string))
+		}
+		for _, f := range oldFiles {
+			name := f.(map[string]any)["name"].(string)
+			if !slices.Contains(uploaded, name) {
+				if err := removeStageFile(ctx, stageFiles, stageId, name); err != nil {
+					return diag.Errorf("error removing %s from stage %s, err = %s", name, stageId.FullyQualifiedName(), err)
+				}
+			}
+		}
+
+		if err := d.Set("content_sha256", checksum); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadStageFile(ctx, d, stageFiles)
+}
+
+func DeleteStageFile(ctx context.Context, d *schema.ResourceData, stageFiles sdk.StageFiles) diag.Diagnostics {
+	stageId := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("stage").(string))
+
+	for _, f := range d.Get("files").([]any) {
+		name := f.(map[string]any)["name"].(string)
+		if err := removeStageFile(ctx, stageFiles, stageId, name); err != nil && !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			return diag.Errorf("error removing %s from stage %s, err = %s", name, stageId.FullyQualifiedName(), err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// customizeStageFileDiff computes the checksum of the local content, so that the plan shows the upload of a changed content.
+func customizeStageFileDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	checksum, _, err := stageFileSourceChecksum(d.Get("source").(string))
+	if err != nil {
+		return err
+	}
+	if d.Get("content_sha256").(string) != checksum {
+		return d.SetNew("content_sha256", checksum)
+	}
+	return nil
+}
+
+// uploadStageFiles uploads the source file, or the files of the source directory, and sets the files attribute with
+// the uploaded files. It returns the checksum of the uploaded content.
+func uploadStageFiles(ctx context.Context, d *schema.ResourceData, stageFiles sdk.StageFiles, stageId sdk.SchemaObjectIdentifier, overwrite bool) (string, error) {
+	source := d.Get("source").(string)
+	stagePath := strings.Trim(d.Get("path").(string), "/")
+	checksum, sourceFiles, err := stageFileSourceChecksum(source)
+	if err != nil {
+		return "", err
+	}
+
+	opts := &sdk.PutStageFileOptions{
+		AutoCompress: sdk.Bool(d.Get("auto_compress").(bool)),
+		Overwrite:    sdk.Bool(overwrite),
+	}
+	if v, ok := d.GetOk("parallel"); ok {
+		opts.Parallel = sdk.Int(v.(int))
+	}
+
+	files := make([]any, 0, len(sourceFiles))
+	for _, sourceFile := range sourceFiles {
+		localPath := source
+		if sourceFile.relative != "" {
+			localPath = filepath.Join(source, filepath.FromSlash(sourceFile.relative))
+		}
+		targetDirectory := path.Join(stagePath, path.Dir(sourceFile.name()))
+		if targetDirectory == "." {
+			targetDirectory = ""
+		}
+
+		results, err := stageFiles.Put(ctx, localPath, sdk.NewStageLocation(stageId, targetDirectory), opts)
+		if err != nil {
+			return "", err
+		}
+		if len(results) != 1 {
+			return "", fmt.Errorf("expected one uploaded file for %s, got %d", localPath, len(results))
+		}
+		result := results[0]
+		if !strings.EqualFold(result.Status, "UPLOADED") {
+			return "", fmt.Errorf("file %s was not uploaded, status: %s %s (set overwrite to replace an existing file)", localPath, result.Status, result.Message)
+		}
+		files = append(files, map[string]any{
+			"source": sourceFile.name(),
+			"name":   path.Join(targetDirectory, result.Target),
+			"size":   int(result.TargetSize),
+			"md5":    "",
+		})
+	}
+
+	listed, err := stageFiles.List(ctx, sdk.NewStageLocation(stageId, stagePath), nil)
+	if err != nil {
+		return "", err
+	}
+	for _, f := range files {
+		file := f.(map[string]any)
+		if stageFile := findStageFile(listed, file["name"].(string)); stageFile != nil {
+			file["md5"] = stageFile.MD5
+		}
+	}
+
+	return checksum, d.Set("files", files)
+}
+
+func removeStageFile(ctx context.Context, stageFiles sdk.StageFiles, stageId sdk.SchemaObjectIdentifier, name string) error {
+	// REMOVE matches the location as a prefix (e.g. file.csv.bak for file.csv), so the pattern limits it to the file
+	// itself; the names matched by the pattern are prefixed with the stage name or the url
+	return stageFiles.Remove(ctx, sdk.NewStageLocation(stageId, name), &sdk.RemoveStageFilesOptions{
+		Pattern: sdk.String("^(.*/)?" + regexp.QuoteMeta(name) + "$"),
+	})
+}
+
+// findStageFile finds the listed file with the given path in the stage. LIST prefixes the names with the stage name
+// (internal stages) or the url (external stages), so the shortest name ending with the path is the file.
+func findStageFile(listed []sdk.StageFile, name string) *sdk.StageFile {
+	var found *sdk.StageFile
+	for i, stageFile := range listed {
+		if strings.HasSuffix(stageFile.Name, "/"+name) && (found == nil || len(stageFile.Name) < len(found.Name)) {
+			found = &listed[i]
+		}
+	}
+	return found
+}
+
+type stageSourceFile struct {
+	// relative is the slash-separated path relative to the source directory; empty when the source is a file
+	relative string
+	base     string
+}
+
+func (f stageSourceFile) name() string {
+	if f.relative == "" {
+		return f.base
+	}
+	return f.relative
+}
+
+// stageFileSourceChecksum returns the SHA-256 checksum of the source file, or of the files of the source directory
+// together with their relative paths, and the files to upload.
+func stageFileSourceChecksum(source string) (string, []stageSourceFile, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return "", nil, fmt.Errorf("cannot read source %s: %w", source, err)
+	}
+
+	if !info.IsDir() {
+		checksum, err := fileSHA256(source)
+		if err != nil {
+			return "", nil, err
+		}
+		return checksum, []stageSourceFile{{base: filepath.Base(source)}}, nil
+	}
+
+	files := make([]stageSourceFile, 0)
+	hash := sha256.New()
+	err = filepath.WalkDir(source, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.Type().IsRegular() {
+			return err
+		}
+		relative, err := filepath.Rel(source, p)
+		if err != nil {
+			return err
+		}
+		checksum, err := fileSHA256(p)
+		if err != nil {
+			return err
+		}
+		relative = filepath.ToSlash(relative)
+		files = append(files, stageSourceFile{relative: relative, base: path.Base(relative)})
+		_, err = fmt.Fprintf(hash, "%s\x00%s\n", relative, checksum)
+		return err
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	if len(files) == 0 {
+		return "", nil, fmt.Errorf("source directory %s has no files", source)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), files, nil
+}
+
+func fileSHA256(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,78 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcc_StageFile(t *testing.T) {
+	stageName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	source := filepath.Join(t.TempDir(), "handler.py")
+	require.NoError(t, os.WriteFile(source, []byte("def run(session):\n    return 'first'\n"), 0o600))
+	resourceName := "snowflake_stage_file.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: stageFileConfig(stageName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "content_sha256"),
+					resource.TestCheckResourceAttr(resourceName, "files.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "files.0.source", "handler.py"),
+					resource.TestCheckResourceAttr(resourceName, "files.0.name", "imports/handler.py.gz"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.0.md5"),
+				),
+			},
+			// the changed local content is uploaded again in place
+			{
+				PreConfig: func() {
+					require.NoError(t, os.WriteFile(source, []byte("def run(session):\n    return 'second'\n"), 0o600))
+				},
+				Config: stageFileConfig(stageName, source),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "files.0.name", "imports/handler.py.gz"),
+				),
+			},
+		},
+	})
+}
+
+func stageFileConfig(stageName string, source string) string {
+	return fmt.Sprintf(`
+resource "snowflake_stage" "test" {
+	name = "%[1]s"
+	database = "%[2]s"
+	schema = "%[3]s"
+}
+
+resource "snowflake_stage_file" "test" {
+	database = snowflake_stage.test.database
+	schema = snowflake_stage.test.schema
+	stage = snowflake_stage.test.name
+	path = "imports"
+	source = "%[4]s"
+}
+`, stageName, acc.TestDatabaseName, acc.TestSchemaName, filepath.ToSlash(source))
+}
@@ -0,0 +1,214 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeStageFileSource(t *testing.T, files map[string]string) string {
+	t.Helper()
+	directory := t.TempDir()
+	for name, content := range files {
+		p := filepath.Join(directory, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0o600))
+	}
+	return directory
+}
+
+func testStageFileResource(t *testing.T) (*schema.Resource, sdk.StageFiles, string) {
+	t.Helper()
+	root := t.TempDir()
+	stageFiles := sdk.NewLocalStageFiles(root)
+	return stageFileResource(func(any) sdk.StageFiles { return stageFiles }), stageFiles, root
+}
+
+func TestStageFile_CreateFile(t *testing.T) {
+	resource, stageFiles, _ := testStageFileResource(t)
+	source := filepath.Join(writeStageFileSource(t, map[string]string{"handler.py": "def run(): pass"}), "handler.py")
+
+	d := schema.TestResourceDataRaw(t, stageFileSchema, map[string]any{
+		"database": "db",
+		"schema":   "schema",
+		"stage":    "stage",
+		"path":     "imports/python",
+		"source":   source,
+	})
+	require.Empty(t, resource.CreateContext(context.Background(), d, nil))
+
+	checksum := sha256.Sum256([]byte("def run(): pass"))
+	assert.Equal(t, hex.EncodeToString(checksum[:]), d.Get("content_sha256"))
+	assert.NotEmpty(t, d.Id())
+
+	listed, err := stageFiles.List(context.Background(), sdk.NewStageLocation(sdk.NewSchemaObjectIdentifier("db", "schema", "stage"), ""), nil)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, []any{map[string]any{
+		"source": "handler.py",
+		"name":   "imports/python/handler.py.gz",
+		"size":   int(listed[0].Size),
+		"md5":    listed[0].MD5,
+	}}, d.Get("files"))
+}
+
+func TestStageFile_CreateDirectory(t *testing.T) {
+	resource, _, _ := testStageFileResource(t)
+	source := writeStageFileSource(t, map[string]string{
+		"manifest.yml":          "manifest_version: 1",
+		"scripts/setup.sql":     "CREATE APPLICATION ROLE app_public;",
+		"streamlit/app/main.py": "import streamlit",
+	})
+
+	d := schema.TestResourceDataRaw(t, stageFileSchema, map[string]any{
+		"database":      "db",
+		"schema":        "schema",
+		"stage":         "stage",
+		"source":        source,
+		"auto_compress": false,
+	})
+	require.Empty(t, resource.CreateContext(context.Background(), d, nil))
+
+	names := make([]string, 0)
+	for _, f := range d.Get("files").([]any) {
+		file := f.(map[string]any)
+		assert.NotEmpty(t, file["md5"])
+		names = append(names, file["name"].(string))
+	}
+	assert.Equal(t, []string{"manifest.yml", "scripts/setup.sql", "streamlit/app/main.py"}, names)
+}
+
+func TestStageFile_CreateExistingFileWithoutOverwrite(t *testing.T) {
+	resource, _, _ := testStageFileResource(t)
+	source := filepath.Join(writeStageFileSource(t, map[string]string{"file.csv": "a,b"}), "file.csv")
+	config := map[string]any{
+		"database": "db",
+		"schema":   "schema",
+		"stage":    "stage",
+		"source":   source,
+	}
+
+	require.Empty(t, resource.CreateContext(context.Background(), schema.TestResourceDataRaw(t, stageFileSchema, config), nil))
+
+	diags := resource.CreateContext(context.Background(), schema.TestResourceDataRaw(t, stageFileSchema, config), nil)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "status: SKIPPED")
+
+	config["overwrite"] = true
+	require.Empty(t, resource.CreateContext(context.Background(), schema.TestResourceDataRaw(t, stageFileSchema, config), nil))
+}
+
+func TestStageFile_ReadDrift(t *testing.T) {
+	resource, _, root := testStageFileResource(t)
+	source := writeStageFileSource(t, map[string]string{"a.txt": "a", "b.txt": "b"})
+	d := schema.TestResourceDataRaw(t, stageFileSchema, map[string]any{
+		"database": "db",
+		"schema":   "schema",
+		"stage":    "stage",
+		"path":     "files",
+		"source":   source,
+	})
+	require.Empty(t, resource.CreateContext(context.Background(), d, nil))
+	checksum := d.Get("content_sha256").(string)
+
+	require.Empty(t, resource.ReadContext(context.Background(), d, nil))
+	assert.Equal(t, checksum, d.Get("content_sha256"))
+
+	// a file changed in the stage
+	stored := filepath.Join(root, "stage", "files", "a.txt.gz")
+	require.NoError(t, os.WriteFile(stored, []byte("changed"), 0o600))
+	require.Empty(t, resource.ReadContext(context.Background(), d, nil))
+	assert.Equal(t, "", d.Get("content_sha256"))
+	assert.Len(t, d.Get("files"), 2)
+
+	// a file removed from the stage
+	require.NoError(t, os.Remove(stored))
+	require.Empty(t, resource.ReadContext(context.Background(), d, nil))
+	assert.Len(t, d.Get("files"), 1)
+
+	// all the files removed from the stage
+	require.NoError(t, os.Remove(filepath.Join(root, "stage", "files", "b.txt.gz")))
+	require.Empty(t, resource.ReadContext(context.Background(), d, nil))
+	assert.Empty(t, d.Id())
+}
+
+func TestStageFile_Delete(t *testing.T) {
+	resource, stageFiles, _ := testStageFileResource(t)
+	directory := writeStageFileSource(t, map[string]string{"file.csv": "a,b", "file.csv.bak": "a"})
+	stageId := sdk.NewSchemaObjectIdentifier("db", "schema", "stage")
+
+	// a file sharing the prefix of the uploaded one, not managed by the resource
+	_, err := stageFiles.Put(context.Background(), filepath.Join(directory, "file.csv.bak"), sdk.NewStageLocation(stageId, ""), &sdk.PutStageFileOptions{AutoCompress: sdk.Bool(false)})
+	require.NoError(t, err)
+
+	d := schema.TestResourceDataRaw(t, stageFileSchema, map[string]any{
+		"database":      "db",
+		"schema":        "schema",
+		"stage":         "stage",
+		"source":        filepath.Join(directory, "file.csv"),
+		"auto_compress": false,
+	})
+	require.Empty(t, resource.CreateContext(context.Background(), d, nil))
+	require.Empty(t, resource.DeleteContext(context.Background(), d, nil))
+
+	listed, err := stageFiles.List(context.Background(), sdk.NewStageLocation(stageId, ""), nil)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, "stage/file.csv.bak", listed[0].Name)
+}
+
+func TestRemoveStageFile(t *testing.T) {
+	root := t.TempDir()
+	stageFiles := sdk.NewLocalStageFiles(root)
+	stageId := sdk.NewSchemaObjectIdentifier("db", "schema", "stage")
+	// the files of the same name in the other directories and in the other stage are kept
+	for _, name := range []string{"stage/dir/file.csv", "stage/dir/file.csv.bak", "stage/dir2/file.csv", "stage/dir/sub/file.csv", "stage/file.csv", "other/dir/file.csv"} {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, []byte("a,b"), 0o600))
+	}
+	listNames := func(stage string) []string {
+		listed, err := stageFiles.List(context.Background(), sdk.NewStageLocation(sdk.NewSchemaObjectIdentifier("db", "schema", stage), ""), nil)
+		require.NoError(t, err)
+		names := make([]string, len(listed))
+		for i, f := range listed {
+			names[i] = f.Name
+		}
+		return names
+	}
+
+	require.NoError(t, removeStageFile(context.Background(), stageFiles, stageId, "dir/file.csv"))
+	assert.ElementsMatch(t, []string{"stage/dir/file.csv.bak", "stage/dir2/file.csv", "stage/dir/sub/file.csv", "stage/file.csv"}, listNames("stage"))
+	assert.Equal(t, []string{"other/dir/file.csv"}, listNames("other"))
+
+	require.NoError(t, removeStageFile(context.Background(), stageFiles, stageId, "file.csv"))
+	assert.ElementsMatch(t, []string{"stage/dir/file.csv.bak", "stage/dir2/file.csv", "stage/dir/sub/file.csv"}, listNames("stage"))
+}
+
+func TestStageFileSourceChecksum(t *testing.T) {
+	source := writeStageFileSource(t, map[string]string{"a/file.txt": "content", "b.txt": "other"})
+	checksum, files, err := stageFileSourceChecksum(source)
+	require.NoError(t, err)
+	assert.Equal(t, []stageSourceFile{{relative: "a/file.txt", base: "file.txt"}, {relative: "b.txt", base: "b.txt"}}, files)
+
+	renamed := writeStageFileSource(t, map[string]string{"c/file.txt": "content", "b.txt": "other"})
+	renamedChecksum, _, err := stageFileSourceChecksum(renamed)
+	require.NoError(t, err)
+	assert.NotEqual(t, checksum, renamedChecksum)
+
+	same := writeStageFileSource(t, map[string]string{"a/file.txt": "content", "b.txt": "other"})
+	sameChecksum, _, err := stageFileSourceChecksum(same)
+	require.NoError(t, err)
+	assert.Equal(t, checksum, sameChecksum)
+
+	_, _, err = stageFileSourceChecksum(t.TempDir())
+	require.ErrorContains(t, err, "has no files")
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -102,10 +103,10 @@ var stageGrantSchema = map[string]*schema.Schema{
 func StageGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateStageGrant,
-			Read:               ReadStageGrant,
-			Delete:             DeleteStageGrant,
-			Update:             UpdateStageGrant,
+			CreateContext:      CreateStageGrant,
+			ReadContext:        ReadStageGrant,
+			DeleteContext:      DeleteStageGrant,
+			UpdateContext:      UpdateStageGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             stageGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -148,13 +149,13 @@ func StageGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateStageGrant implements schema.CreateFunc.
-func CreateStageGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateStageGrant implements schema.CreateContextFunc.
+func CreateStageGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	onFuture := d.Get("on_future").(bool)
 	onAll := d.Get("on_all").(bool)
 	if onFuture && onAll {
-		return errors.New("on_future and on_all cannot both be true")
+		return diag.FromErr(errors.New("on_future and on_all cannot both be true"))
 	}
 	privilege := d.Get("privilege").(string)
 	withGrantOption := d.Get("with_grant_option").(bool)
@@ -165,10 +166,10 @@ func CreateStageGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	stageName := d.Get("stage_name").(string)
 	if (schemaName == "") && !onFuture && !onAll {
-		return errors.New("schema_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future or on_all is true"))
 	}
 	if (stageName == "") && !onFuture && !onAll {
-		return errors.New("stage_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("stage_name must be set unless on_future or on_all is true"))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -181,19 +182,19 @@ func CreateStageGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.StageGrant(databaseName, schemaName, stageName)
 	}
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, stageName, privilege, withGrantOption, onFuture, onAll, roles)
 	d.SetId(grantID)
 
-	return ReadStageGrant(d, meta)
+	return ReadStageGrant(ctx, d, meta)
 }
 
-// ReadStageGrant implements schema.ReadFunc.
-func ReadStageGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadStageGrant implements schema.ReadContextFunc.
+func ReadStageGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	stageName := d.Get("stage_name").(string)
@@ -213,9 +214,9 @@ func ReadStageGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.StageGrant(databaseName, schemaName, stageName)
 	}
 
-	err := readGenericGrant(d, meta, stageGrantSchema, builder, onFuture, onAll, validStagePrivileges)
+	err := readGenericGrant(ctx, d, meta, stageGrantSchema, builder, onFuture, onAll, validStagePrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, stageName, privilege, withGrantOption, onFuture, onAll, roles)
@@ -225,8 +226,8 @@ func ReadStageGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// UpdateStageGrant implements schema.UpdateFunc.
-func UpdateStageGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateStageGrant implements schema.UpdateContextFunc.
+func UpdateStageGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update, and we're done
 	if !d.HasChanges("roles") {
@@ -260,23 +261,23 @@ func UpdateStageGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
+		ctx, meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
+		ctx, meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadStageGrant(d, meta)
+	return ReadStageGrant(ctx, d, meta)
 }
 
-// DeleteStageGrant implements schema.DeleteFunc.
-func DeleteStageGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteStageGrant implements schema.DeleteContextFunc.
+func DeleteStageGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var builder snowflake.GrantBuilder
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -292,5 +293,5 @@ func DeleteStageGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.StageGrant(databaseName, schemaName, stageName)
 	}
 
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -40,8 +41,8 @@ func TestStageGrantCreate(t *testing.T) {
 			mock.ExpectExec(fmt.Sprintf(`^GRANT %s ON STAGE "test-db"."test-schema"."test-stage" TO ROLE "test-role-1" WITH GRANT OPTION$`, testPriv)).WillReturnResult(sqlmock.NewResult(1, 1))
 			mock.ExpectExec(fmt.Sprintf(`^GRANT %s ON STAGE "test-db"."test-schema"."test-stage" TO ROLE "test-role-2" WITH GRANT OPTION$`, testPriv)).WillReturnResult(sqlmock.NewResult(1, 1))
 			expectReadStageGrant(mock, testPriv)
-			err := resources.CreateStageGrant(d, db)
-			r.NoError(err)
+			diags := resources.CreateStageGrant(context.Background(), d, db)
+			r.Empty(diags)
 		})
 	}
 }
@@ -62,8 +63,8 @@ func TestStageGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadStageGrant(mock, "USAGE")
-		err := resources.ReadStageGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadStageGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	roles := d.Get("roles").(*schema.Set)
@@ -105,8 +106,8 @@ func TestFutureStageGrantCreate(t *testing.T) {
 			`^GRANT USAGE ON FUTURE STAGES IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureStageGrant(mock)
-		err := resources.CreateStageGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateStageGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	b := require.New(t)
@@ -129,8 +130,8 @@ func TestFutureStageGrantCreate(t *testing.T) {
 			`^GRANT USAGE ON FUTURE STAGES IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureStageDatabaseGrant(mock)
-		err := resources.CreateStageGrant(d, db)
-		b.NoError(err)
+		diags := resources.CreateStageGrant(context.Background(), d, db)
+		b.Empty(diags)
 	})
 }
 
@@ -0,0 +1,280 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStageOnS3Request(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, stageSchema, map[string]any{
+		"name":     "stage",
+		"database": "db",
+		"schema":   "schema",
+		"s3": []any{map[string]any{
+			"url":         "s3://bucket/path/",
+			"credentials": []any{map[string]any{"aws_key_id": "key", "aws_secret_key": "secret"}},
+			"encryption":  []any{map[string]any{"type": "AWS_SSE_KMS", "kms_key_id": "kms"}},
+		}},
+		"file_format": []any{map[string]any{
+			"type":        "csv",
+			"skip_header": 1,
+			"null_if":     []any{"NULL"},
+		}},
+		"copy_options": []any{map[string]any{
+			"on_error": "skip_file_10%",
+			"purge":    "true",
+		}},
+	})
+
+	kind, storage := getStageStorage(d)
+	require.Equal(t, "s3", kind)
+	fileFormat, err := getStageFileFormat(d)
+	require.NoError(t, err)
+	copyOptions, err := getStageCopyOptions(d)
+	require.NoError(t, err)
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	mock.ExpectExec(`CREATE STAGE "db"."schema"."stage" URL = 's3://bucket/path/' CREDENTIALS = (AWS_KEY_ID = 'key' AWS_SECRET_KEY = 'secret') ENCRYPTION = (TYPE = 'AWS_SSE_KMS' KMS_KEY_ID = 'kms') FILE_FORMAT = (TYPE = CSV, SKIP_HEADER = 1 NULL_IF = ('NULL')) COPY_OPTIONS = (ON_ERROR = 'SKIP_FILE_10%' PURGE = true)`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	request := sdk.NewCreateOnS3StageRequest(sdk.NewSchemaObjectIdentifier("db", "schema", "stage")).
+		WithExternalStageParams(stageS3Params(storage)).
+		WithFileFormat(fileFormat).
+		WithCopyOptions(copyOptions)
+	require.NoError(t, sdk.NewClientFromDB(db).Stages.CreateOnS3(context.Background(), request))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetStageFileFormat(t *testing.T) {
+	t.Run("format name", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, stageSchema, map[string]any{
+			"file_format": []any{map[string]any{"format_name": `"db"."schema"."format"`}},
+		})
+		fileFormat, err := getStageFileFormat(d)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.NewStageFileFormatRequest().WithFormatName(sdk.String(`"db"."schema"."format"`)), fileFormat)
+	})
+
+	t.Run("option of another type", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, stageSchema, map[string]any{
+			"file_format": []any{map[string]any{"type": "PARQUET", "strip_outer_array": "true"}},
+		})
+		_, err := getStageFileFormat(d)
+		require.ErrorContains(t, err, "strip_outer_array is an invalid format type option for format type PARQUET")
+	})
+
+	t.Run("format name with options", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, stageSchema, map[string]any{
+			"file_format": []any{map[string]any{"format_name": "format", "trim_space": "true"}},
+		})
+		_, err := getStageFileFormat(d)
+		require.ErrorContains(t, err, "format type options cannot be set together with format_name")
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, stageSchema, map[string]any{})
+		fileFormat, err := getStageFileFormat(d)
+		require.NoError(t, err)
+		assert.Nil(t, fileFormat)
+	})
+}
+
+func TestParseStageCopyOnError(t *testing.T) {
+	for value, expected := range map[string]*sdk.StageCopyOnErrorOptionsRequest{
+		"CONTINUE":        sdk.NewStageCopyOnErrorOptionsRequest().WithContinue(sdk.Bool(true)),
+		"abort_statement": sdk.NewStageCopyOnErrorOptionsRequest().WithAbortStatement(sdk.Bool(true)),
+		"SKIP_FILE":       sdk.NewStageCopyOnErrorOptionsRequest().WithSkipFile(),
+		"SKIP_FILE_3":     sdk.NewStageCopyOnErrorOptionsRequest().WithSkipFileX(3),
+		"'SKIP_FILE_10%'": sdk.NewStageCopyOnErrorOptionsRequest().WithSkipFileXPercent(10),
+	} {
+		t.Run(value, func(t *testing.T) {
+			onError, err := parseStageCopyOnError(value)
+			require.NoError(t, err)
+			assert.Equal(t, expected, onError)
+		})
+	}
+
+	_, err := parseStageCopyOnError("SKIP")
+	require.ErrorContains(t, err, "invalid on_error copy option")
+}
+
+func TestStageOptionsRequest(t *testing.T) {
+	t.Run("removed file format options are set back to the defaults", func(t *testing.T) {
+		fileFormat, err := stageFileFormatRequest(
+			map[string]any{"type": "CSV", "skip_header": 1, "trim_space": "true", "field_delimiter": ";", "null_if": []any{"NULL"}},
+			map[string]any{"type": "CSV", "field_delimiter": "|"},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, &sdk.FileFormatTypeOptionsRequest{
+			CSVFieldDelimiter: sdk.String("|"),
+			CSVSkipHeader:     sdk.Int(0),
+			CSVTrimSpace:      sdk.Bool(false),
+			CSVNullIf:         &[]sdk.NullString{{S: `\\N`}},
+		}, fileFormat.Options)
+	})
+
+	t.Run("removed file format block", func(t *testing.T) {
+		fileFormat, err := stageFileFormatRequest(map[string]any{"skip_blank_lines": "true"}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.NewStageFileFormatRequest().WithType(sdk.Pointer(sdk.FileFormatTypeCSV)).WithOptions(&sdk.FileFormatTypeOptionsRequest{
+			CSVSkipBlankLines: sdk.Bool(false),
+		}), fileFormat)
+	})
+
+	t.Run("options of the previous format type are not reset", func(t *testing.T) {
+		fileFormat, err := stageFileFormatRequest(map[string]any{"type": "JSON", "strip_outer_array": "true"}, map[string]any{"type": "PARQUET"})
+		require.NoError(t, err)
+		assert.Equal(t, sdk.NewStageFileFormatRequest().WithType(sdk.Pointer(sdk.FileFormatTypeParquet)), fileFormat)
+	})
+
+	t.Run("removed copy options are set back to the defaults", func(t *testing.T) {
+		copyOptions, err := stageCopyOptionsRequest(map[string]any{"on_error": "CONTINUE", "enforce_length": "false", "purge": "true"}, map[string]any{"purge": "true"})
+		require.NoError(t, err)
+		assert.Equal(t, sdk.NewStageCopyOptionsRequest().
+			WithOnError(sdk.NewStageCopyOnErrorOptionsRequest().WithAbortStatement(sdk.Bool(true))).
+			WithEnforceLength(sdk.Bool(true)).
+			WithPurge(sdk.Bool(true)), copyOptions)
+	})
+
+	t.Run("nothing to set", func(t *testing.T) {
+		copyOptions, err := stageCopyOptionsRequest(nil, nil)
+		require.NoError(t, err)
+		assert.Nil(t, copyOptions)
+	})
+}
+
+func TestReadStageOptions(t *testing.T) {
+	properties := []sdk.StageProperty{
+		{Parent: "STAGE_FILE_FORMAT", Name: "TYPE", Type: "String", Value: "JSON", Default: "CSV"},
+		{Parent: "STAGE_FILE_FORMAT", Name: "COMPRESSION", Type: "String", Value: "AUTO", Default: "AUTO"},
+		{Parent: "STAGE_FILE_FORMAT", Name: "STRIP_OUTER_ARRAY", Type: "Boolean", Value: "true", Default: "false"},
+		{Parent: "STAGE_FILE_FORMAT", Name: "TRIM_SPACE", Type: "Boolean", Value: "false", Default: "false"},
+		{Parent: "STAGE_FILE_FORMAT", Name: "NULL_IF", Type: "List", Value: "[NULL, ]", Default: "[]"},
+		{Parent: "STAGE_COPY_OPTIONS", Name: "ON_ERROR", Type: "String", Value: "ABORT_STATEMENT", Default: "ABORT_STATEMENT"},
+		{Parent: "STAGE_COPY_OPTIONS", Name: "SIZE_LIMIT", Type: "Long", Value: "", Default: ""},
+		{Parent: "STAGE_COPY_OPTIONS", Name: "PURGE", Type: "Boolean", Value: "false", Default: "false"},
+		{Parent: "STAGE_COPY_OPTIONS", Name: "ENFORCE_LENGTH", Type: "Boolean", Value: "FALSE", Default: "true"},
+	}
+
+	// the options equal to their defaults are only read back when they are in the state
+	fileFormat, err := readStageFileFormat(properties, map[string]any{"trim_space": "false"})
+	require.NoError(t, err)
+	assert.Equal(t, []any{map[string]any{
+		"type":              "JSON",
+		"strip_outer_array": "true",
+		"trim_space":        "false",
+		"null_if":           []any{"NULL"},
+	}}, fileFormat)
+
+	copyOptions, err := readStageCopyOptions(properties, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []any{map[string]any{
+		"enforce_length": "false",
+	}}, copyOptions)
+
+	// the blocks left at the defaults are only read back when they are in the state
+	defaults := []sdk.StageProperty{
+		{Parent: "STAGE_FILE_FORMAT", Name: "TYPE", Type: "String", Value: "CSV", Default: "CSV"},
+		{Parent: "STAGE_COPY_OPTIONS", Name: "PURGE", Type: "Boolean", Value: "false", Default: "false"},
+	}
+	fileFormat, err = readStageFileFormat(defaults, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []any{}, fileFormat)
+	fileFormat, err = readStageFileFormat(defaults, map[string]any{"type": "CSV"})
+	require.NoError(t, err)
+	assert.Equal(t, []any{map[string]any{"type": "CSV"}}, fileFormat)
+	copyOptions, err = readStageCopyOptions(defaults, map[string]any{})
+	require.NoError(t, err)
+	assert.Equal(t, []any{map[string]any{}}, copyOptions)
+
+	fileFormat, err = readStageFileFormat([]sdk.StageProperty{{Parent: "STAGE_FILE_FORMAT", Name: "FORMAT_NAME", Value: `"db"."schema"."format"`}}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []any{map[string]any{"format_name": `"db"."schema"."format"`}}, fileFormat)
+}
+
+func TestStageStorageKindOf(t *testing.T) {
+	assert.Equal(t, "", stageStorageKindOf(&sdk.Stage{Type: "INTERNAL"}))
+	assert.Equal(t, "s3", stageStorageKindOf(&sdk.Stage{Url: "s3://bucket/", Cloud: sdk.String("AWS")}))
+	assert.Equal(t, "gcs", stageStorageKindOf(&sdk.Stage{Url: "gcs://bucket/"}))
+	assert.Equal(t, "azure", stageStorageKindOf(&sdk.Stage{Url: "azure://account.blob.core.windows.net/container/", Cloud: sdk.String("AZURE")}))
+	assert.Equal(t, "s3_compatible", stageStorageKindOf(&sdk.Stage{Url: "s3compat://bucket/", Endpoint: sdk.String("example.com")}))
+}
+
+func TestV087StageStateUpgrader(t *testing.T) {
+	t.Run("s3 stage", func(t *testing.T) {
+		state, err := v087StageStateUpgrader(context.Background(), map[string]any{
+			"name":                "stage",
+			"url":                 "s3://bucket/path/",
+			"storage_integration": "",
+			"credentials":         "AWS_KEY_ID = 'key' AWS_SECRET_KEY = 'secret'",
+			"encryption":          "TYPE = 'AWS_SSE_KMS' KMS_KEY_ID = 'kms'",
+			"file_format":         "TYPE = JSON NULL_IF = []",
+			"copy_options":        "ON_ERROR = CONTINUE",
+			"directory":           "ENABLE = true",
+		}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"name": "stage",
+			"s3": []any{map[string]any{
+				"url": "s3://bucket/path/",
+				"credentials": []any{map[string]any{
+					"aws_key_id":     "key",
+					"aws_secret_key": "secret",
+					"aws_token":      "",
+					"aws_role":       "",
+				}},
+				"encryption": []any{map[string]any{
+					"type":       "AWS_SSE_KMS",
+					"master_key": "",
+					"kms_key_id": "kms",
+				}},
+			}},
+		}, state)
+	})
+
+	t.Run("gcs stage with storage integration", func(t *testing.T) {
+		state, err := v087StageStateUpgrader(context.Background(), map[string]any{
+			"url":                 "gcs://bucket/",
+			"storage_integration": "INTEGRATION",
+		}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"gcs": []any{map[string]any{"url": "gcs://bucket/", "storage_integration": "INTEGRATION"}},
+		}, state)
+	})
+
+	t.Run("s3 compatible stage", func(t *testing.T) {
+		state, err := v087StageStateUpgrader(context.Background(), map[string]any{
+			"url":         "s3compat://bucket/path/",
+			"credentials": "AWS_KEY_ID = 'key' AWS_SECRET_KEY = 'secret'",
+		}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"s3_compatible": []any{map[string]any{
+				"url":      "s3compat://bucket/path/",
+				"endpoint": "",
+				"credentials": []any{map[string]any{
+					"aws_key_id":     "key",
+					"aws_secret_key": "secret",
+				}},
+			}},
+		}, state)
+	})
+
+	t.Run("internal stage", func(t *testing.T) {
+		state, err := v087StageStateUpgrader(context.Background(), map[string]any{
+			"name":        "stage",
+			"url":         "",
+			"file_format": "",
+		}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"name": "stage"}, state)
+	})
+}
@@ -0,0 +1,471 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// stageFileFormatTypes are the format types of the stage file format.
+var stageFileFormatTypes = []sdk.FileFormatType{sdk.FileFormatTypeCSV, sdk.FileFormatTypeJSON, sdk.FileFormatTypeAvro, sdk.FileFormatTypeORC, sdk.FileFormatTypeParquet, sdk.FileFormatTypeXML}
+
+// stageFileFormatSchema describes the FILE_FORMAT of a stage. The format type options reuse the definitions of the typed
+// file format resources, so the options left out of the configuration keep the Snowflake defaults without a difference.
+var stageFileFormatSchema = func() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"format_name": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ConflictsWith:    []string{"file_format.0.type"},
+			DiffSuppressFunc: suppressSchemaObjectIdentifierQuoting,
+			Description:      "Fully qualified name of an existing named file format to use for the stage. Conflicts with `type` and the format type options.",
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"CSV", "JSON", "AVRO", "ORC", "PARQUET", "XML"}, true),
+			StateFunc: func(v any) string {
+				return strings.ToUpper(v.(string))
+			},
+			Description: "Specifies the format of the files in the stage (CSV | JSON | AVRO | ORC | PARQUET | XML). When left out, the Snowflake default (CSV) is used.",
+		},
+	}
+	for _, formatType := range stageFileFormatTypes {
+		for option, typedOption := range fileFormatTypedOptions[formatType] {
+			if _, ok := s[option]; ok {
+				continue
+			}
+			optionSchema := *typedOption.schema
+			optionSchema.Description = fmt.Sprintf("%s Valid for the %s format types.", strings.Replace(optionSchema.Description, "recreates the file format", "recreates the stage", 1), strings.Join(stageFormatTypesWithOption(option), ", "))
+			s[option] = &optionSchema
+		}
+	}
+	// the compressions differ between the format types
+	compressions := slices.Concat(fileFormatCompressionsCSV, fileFormatCompressionsJSON, fileFormatCompressionsAvro, fileFormatCompressionsParquet, fileFormatCompressionsXML)
+	slices.Sort(compressions)
+	s["compression"].ValidateFunc = validation.StringInSlice(slices.Compact(compressions), false)
+	return s
+}()
+
+var stageCopyOptionsSchema = map[string]*schema.Schema{
+	"on_error": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(stageCopyOnErrorRegexp, "must be one of CONTINUE, SKIP_FILE, SKIP_FILE_<num>, SKIP_FILE_<num>% or ABORT_STATEMENT"),
+		Description:  "Specifies the error handling for the load operation (CONTINUE | SKIP_FILE | SKIP_FILE_<num> | SKIP_FILE_<num>% | ABORT_STATEMENT). When left out, the Snowflake default (ABORT_STATEMENT) is used.",
+	},
+	"size_limit": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "Maximum size (in bytes) of data to be loaded for a given COPY statement. When left out, the size is not limited; removing it recreates the stage.",
+	},
+	"purge":              stageCopyBoolOptionSchema("Boolean that specifies whether to remove the data files from the stage automatically after the data is loaded successfully.", false),
+	"return_failed_only": stageCopyBoolOptionSchema("Boolean that specifies whether to return only files that have failed to load in the statement result.", false),
+	"match_by_column_name": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{string(sdk.StageCopyColumnMapCaseSensitive), string(sdk.StageCopyColumnMapCaseInsensitive), string(sdk.StageCopyColumnMapCaseNone)}, true),
+		Description:  "Specifies whether to load semi-structured data into columns in the target table that match corresponding columns represented in the data (CASE_SENSITIVE | CASE_INSENSITIVE | NONE). When left out, the Snowflake default (NONE) is used.",
+	},
+	"enforce_length":  stageCopyBoolOptionSchema("Boolean that specifies whether to truncate text strings that exceed the target column length.", true),
+	"truncatecolumns": stageCopyBoolOptionSchema("Boolean that specifies whether to truncate text strings that exceed the target column length (the inverse of `enforce_length`).", false),
+	"force":           stageCopyBoolOptionSchema("Boolean that specifies to load all files, regardless of whether they've been loaded previously and have not changed since they were loaded.", false),
+}
+
+// stageCopyBoolOptionSchema returns the schema of a boolean copy option. It is kept as a string, so that removing it
+// from the configuration can be told apart from setting it to false.
+func stageCopyBoolOptionSchema(description string, serverDefault bool) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+		Description:  fmt.Sprintf("%s Valid values are: true | false. When left out, the Snowflake default (%t) is used.", description, serverDefault),
+	}
+}
+
+// stageCopyOptionResets holds the values set with ALTER STAGE when the copy options are removed from the configuration.
+// size_limit cannot be set back to no limit, so removing it recreates the stage.
+var stageCopyOptionResets = map[string]any{
+	"on_error":             "ABORT_STATEMENT",
+	"purge":                "false",
+	"return_failed_only":   "false",
+	"match_by_column_name": string(sdk.StageCopyColumnMapCaseNone),
+	"enforce_length":       "true",
+	"truncatecolumns":      "false",
+	"force":                "false",
+}
+
+var stageCopyOnErrorRegexp = regexp.MustCompile(`(?i)^(CONTINUE|ABORT_STATEMENT|SKIP_FILE|SKIP_FILE_(\d+)(%?))$`)
+
+func stageFormatTypesWithOption(option string) []string {
+	formatTypes := make([]string, 0)
+	for _, formatType := range stageFileFormatTypes {
+		if _, ok := fileFormatTypedOptions[formatType][option]; ok {
+			formatTypes = append(formatTypes, string(formatType))
+		}
+	}
+	return formatTypes
+}
+
+// customizeStageOptionsDiff recreates the stage when an option which cannot be set back to its default is removed from
+// the configuration.
+func customizeStageOptionsDiff() schema.CustomizeDiffFunc {
+	keys := []string{"copy_options.0.size_limit"}
+	for _, formatType := range stageFileFormatTypes {
+		for option, typedOption := range fileFormatTypedOptions[formatType] {
+			if key := "file_format.0." + option; typedOption.reset == nil && !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	slices.Sort(keys)
+	funcs := make([]schema.CustomizeDiffFunc, 0, len(keys))
+	for _, key := range keys {
+		funcs = append(funcs, customdiff.ForceNewIfChange(key, func(ctx context.Context, old, new, meta any) bool {
+			return !isFileFormatTypedValueZero(old) && isFileFormatTypedValueZero(new)
+		}))
+	}
+	return customdiff.All(funcs...)
+}
+
+// getStageFileFormat builds the FILE_FORMAT of the stage from the configuration. It returns nil when the file_format
+// block is neither configured nor in the state.
+func getStageFileFormat(d *schema.ResourceData) (*sdk.StageFileFormatRequest, error) {
+	o, n := d.GetChange("file_format")
+	return stageFileFormatRequest(firstStageBlock(o), firstStageBlock(n))
+}
+
+// stageFileFormatRequest builds the FILE_FORMAT of the stage from the options set in the file_format block. ALTER STAGE
+// cannot unset the options, so the ones set in the previous block of the same format type and removed from the current
+// one are set back to their defaults; removing the whole block sets the stage back to the default CSV format.
+func stageFileFormatRequest(previous map[string]any, current map[string]any) (*sdk.StageFileFormatRequest, error) {
+	if previous == nil && current == nil {
+		return nil, nil
+	}
+	if current == nil {
+		current = map[string]any{}
+	}
+
+	options := make(map[string]any)
+	for option := range stageFileFormatSchema {
+		if option == "format_name" || option == "type" {
+			continue
+		}
+		if v := current[option]; !isFileFormatTypedValueZero(v) {
+			options[option] = v
+		}
+	}
+
+	if formatName, _ := current["format_name"].(string); formatName != "" {
+		if len(options) > 0 {
+			return nil, fmt.Errorf("format type options cannot be set together with format_name, got: %v", slices.Sorted(maps.Keys(options)))
+		}
+		return sdk.NewStageFileFormatRequest().WithFormatName(&formatName), nil
+	}
+
+	formatType := stageFileFormatType(current)
+	typedOptions := fileFormatTypedOptions[formatType]
+	for _, option := range slices.Sorted(maps.Keys(options)) {
+		if err := validateFormatTypeOptions(string(formatType), option, slices.Collect(maps.Keys(typedOptions))); err != nil {
+			return nil, err
+		}
+	}
+	if previousFormatName, _ := previous["format_name"].(string); previous != nil && previousFormatName == "" && stageFileFormatType(previous) == formatType {
+		for option, typedOption := range typedOptions {
+			if _, ok := options[option]; !ok && typedOption.reset != nil && !isFileFormatTypedValueZero(previous[option]) {
+				options[option] = typedOption.reset
+			}
+		}
+	}
+
+	typeOptions, err := stageFileFormatTypeOptions(formatType, options)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.NewStageFileFormatRequest().WithType(&formatType).WithOptions(typeOptions), nil
+}
+
+func stageFileFormatType(fileFormat map[string]any) sdk.FileFormatType {
+	if formatType, _ := fileFormat["type"].(string); formatType != "" {
+		return sdk.FileFormatType(strings.ToUpper(formatType))
+	}
+	return sdk.FileFormatTypeCSV
+}
+
+// stageFileFormatTypeOptions maps the flat format type options onto the type-specific fields of the SDK request.
+func stageFileFormatTypeOptions(formatType sdk.FileFormatType, options map[string]any) (*sdk.FileFormatTypeOptionsRequest, error) {
+	if len(options) == 0 {
+		return nil, nil
+	}
+
+	str := func(name string) *string {
+		if v, ok := options[name]; ok {
+			return sdk.String(v.(string))
+		}
+		return nil
+	}
+	boolean := func(name string) *bool {
+		if v, ok := options[name]; ok {
+			b, _ := strconv.ParseBool(v.(string))
+			return sdk.Bool(b)
+		}
+		return nil
+	}
+	integer := func(name string) *int {
+		if v, ok := options[name]; ok {
+			return sdk.Int(v.(int))
+		}
+		return nil
+	}
+	nullIf := func() *[]sdk.NullString {
+		v, ok := options["null_if"]
+		if !ok {
+			return nil
+		}
+		values := make([]sdk.NullString, 0)
+		for _, s := range expandStringList(toAnySlice(v)) {
+			values = append(values, sdk.NullString{S: s})
+		}
+		return &values
+	}
+	upper := func(name string) *string {
+		if v := str(name); v != nil {
+			return sdk.String(strings.ToUpper(*v))
+		}
+		return nil
+	}
+
+	request := &sdk.FileFormatTypeOptionsRequest{}
+	switch formatType {
+	case sdk.FileFormatTypeCSV:
+		request.CSVCompression = (*sdk.CSVCompression)(upper("compression"))
+		request.CSVRecordDelimiter = str("record_delimiter")
+		request.CSVFieldDelimiter = str("field_delimiter")
+		request.CSVFileExtension = str("file_extension")
+		request.CSVParseHeader = boolean("parse_header")
+		request.CSVSkipHeader = integer("skip_header")
+		request.CSVSkipBlankLines = boolean("skip_blank_lines")
+		request.CSVDateFormat = str("date_format")
+		request.CSVTimeFormat = str("time_format")
+		request.CSVTimestampFormat = str("timestamp_format")
+		request.CSVBinaryFormat = (*sdk.BinaryFormat)(upper("binary_format"))
+		request.CSVEscape = str("escape")
+		request.CSVEscapeUnenclosedField = str("escape_unenclosed_field")
+		request.CSVTrimSpace = boolean("trim_space")
+		request.CSVFieldOptionallyEnclosedBy = str("field_optionally_enclosed_by")
+		request.CSVNullIf = nullIf()
+		request.CSVErrorOnColumnCountMismatch = boolean("error_on_column_count_mismatch")
+		request.CSVReplaceInvalidCharacters = boolean("replace_invalid_characters")
+		request.CSVEmptyFieldAsNull = boolean("empty_field_as_null")
+		request.CSVSkipByteOrderMark = boolean("skip_byte_order_mark")
+		request.CSVEncoding = (*sdk.CSVEncoding)(upper("encoding"))
+	case sdk.FileFormatTypeJSON:
+		request.JSONCompression = (*sdk.JSONCompression)(upper("compression"))
+		request.JSONDateFormat = str("date_format")
+		request.JSONTimeFormat = str("time_format")
+		request.JSONTimestampFormat = str("timestamp_format")
+		request.JSONBinaryFormat = (*sdk.BinaryFormat)(upper("binary_format"))
+		request.JSONTrimSpace = boolean("trim_space")
+		if values := nullIf(); values != nil {
+			request.JSONNullIf = *values
+		}
+		request.JSONFileExtension = str("file_extension")
+		request.JSONEnableOctal = boolean("enable_octal")
+		request.JSONAllowDuplicate = boolean("allow_duplicate")
+		request.JSONStripOuterArray = boolean("strip_outer_array")
+		request.JSONStripNullValues = boolean("strip_null_values")
+		request.JSONReplaceInvalidCharacters = boolean("replace_invalid_characters")
+		request.JSONIgnoreUTF8Errors = boolean("ignore_utf8_errors")
+		request.JSONSkipByteOrderMark = boolean("skip_byte_order_mark")
+	case sdk.FileFormatTypeAvro:
+		request.AvroCompression = (*sdk.AvroCompression)(upper("compression"))
+		request.AvroTrimSpace = boolean("trim_space")
+		request.AvroReplaceInvalidCharacters = boolean("replace_invalid_characters")
+		request.AvroNullIf = nullIf()
+	case sdk.FileFormatTypeORC:
+		request.ORCTrimSpace = boolean("trim_space")
+		request.ORCReplaceInvalidCharacters = boolean("replace_invalid_characters")
+		request.ORCNullIf = nullIf()
+	case sdk.FileFormatTypeParquet:
+		request.ParquetCompression = (*sdk.ParquetCompression)(upper("compression"))
+		request.ParquetBinaryAsText = boolean("binary_as_text")
+		request.ParquetTrimSpace = boolean("trim_space")
+		request.ParquetReplaceInvalidCharacters = boolean("replace_invalid_characters")
+		request.ParquetNullIf = nullIf()
+	case sdk.FileFormatTypeXML:
+		request.XMLCompression = (*sdk.XMLCompression)(upper("compression"))
+		request.XMLIgnoreUTF8Errors = boolean("ignore_utf8_errors")
+		request.XMLPreserveSpace = boolean("preserve_space")
+		request.XMLStripOuterElement = boolean("strip_outer_element")
+		request.XMLDisableSnowflakeData = boolean("disable_snowflake_data")
+		request.XMLDisableAutoConvert = boolean("disable_auto_convert")
+		request.XMLSkipByteOrderMark = boolean("skip_byte_order_mark")
+		request.XMLReplaceInvalidCharacters = boolean("replace_invalid_characters")
+	default:
+		return nil, fmt.Errorf("unsupported file format type: %s", formatType)
+	}
+	return request, nil
+}
+
+// getStageCopyOptions builds the COPY_OPTIONS of the stage from the configuration. It returns nil when there is nothing
+// to set.
+func getStageCopyOptions(d *schema.ResourceData) (*sdk.StageCopyOptionsRequest, error) {
+	o, n := d.GetChange("copy_options")
+	return stageCopyOptionsRequest(firstStageBlock(o), firstStageBlock(n))
+}
+
+// stageCopyOptionsRequest builds the COPY_OPTIONS of the stage from the options set in the copy_options block. ALTER
+// STAGE cannot unset the options, so the ones set in the previous block and removed from the current one (or with the
+// whole block) are set back to their defaults.
+func stageCopyOptionsRequest(previous map[string]any, current map[string]any) (*sdk.StageCopyOptionsRequest, error) {
+	options := make(map[string]any)
+	for option := range stageCopyOptionsSchema {
+		if v := current[option]; !isFileFormatTypedValueZero(v) {
+			options[option] = v
+		} else if reset, ok := stageCopyOptionResets[option]; ok && !isFileFormatTypedValueZero(previous[option]) {
+			options[option] = reset
+		}
+	}
+	if len(options) == 0 {
+		return nil, nil
+	}
+
+	request := sdk.NewStageCopyOptionsRequest()
+	if v, ok := options["on_error"]; ok {
+		onError, err := parseStageCopyOnError(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		request.WithOnError(onError)
+	}
+	if v, ok := options["size_limit"]; ok {
+		request.WithSizeLimit(sdk.Int(v.(int)))
+	}
+	if v, ok := options["match_by_column_name"]; ok {
+		request.WithMatchByColumnName(sdk.Pointer(sdk.StageCopyColumnMapOption(strings.ToUpper(v.(string)))))
+	}
+	for option, with := range map[string]func(*bool) *sdk.StageCopyOptionsRequest{
+		"purge":              request.WithPurge,
+		"return_failed_only": request.WithReturnFailedOnly,
+		"enforce_length":     request.WithEnforceLength,
+		"truncatecolumns":    request.WithTruncatecolumns,
+		"force":              request.WithForce,
+	} {
+		if v, ok := options[option]; ok {
+			b, _ := strconv.ParseBool(v.(string))
+			with(sdk.Bool(b))
+		}
+	}
+	return request, nil
+}
+
+func parseStageCopyOnError(value string) (*sdk.StageCopyOnErrorOptionsRequest, error) {
+	matches := stageCopyOnErrorRegexp.FindStringSubmatch(strings.Trim(value, "'"))
+	if matches == nil {
+		return nil, fmt.Errorf("invalid on_error copy option: %s", value)
+	}
+	request := sdk.NewStageCopyOnErrorOptionsRequest()
+	switch strings.ToUpper(matches[1]) {
+	case "CONTINUE":
+		return request.WithContinue(sdk.Bool(true)), nil
+	case "ABORT_STATEMENT":
+		return request.WithAbortStatement(sdk.Bool(true)), nil
+	case "SKIP_FILE":
+		return request.WithSkipFile(), nil
+	}
+	x, err := strconv.Atoi(matches[2])
+	if err != nil {
+		return nil, err
+	}
+	if matches[3] == "%" {
+		return request.WithSkipFileXPercent(x), nil
+	}
+	return request.WithSkipFileX(x), nil
+}
+
+// readStageFileFormat converts the STAGE_FILE_FORMAT properties returned by DESCRIBE STAGE into the file_format block.
+// The options equal to their defaults are only read back when they are in the current block.
+func readStageFileFormat(properties []sdk.StageProperty, current map[string]any) ([]any, error) {
+	if formatName := findStageProperty(properties, "STAGE_FILE_FORMAT", "FORMAT_NAME"); formatName != nil && formatName.Value != "" {
+		return []any{map[string]any{"format_name": formatName.Value}}, nil
+	}
+	formatType := findStageProperty(properties, "STAGE_FILE_FORMAT", "TYPE")
+	if formatType == nil {
+		return []any{}, nil
+	}
+	options := []string{"type"}
+	for option := range fileFormatTypedOptions[sdk.FileFormatType(formatType.Value)] {
+		options = append(options, option)
+	}
+	return readStageBlock(properties, "STAGE_FILE_FORMAT", stageFileFormatSchema, options, current)
+}
+
+// readStageCopyOptions converts the STAGE_COPY_OPTIONS properties returned by DESCRIBE STAGE into the copy_options block.
+// The options equal to their defaults are only read back when they are in the current block.
+func readStageCopyOptions(properties []sdk.StageProperty, current map[string]any) ([]any, error) {
+	return readStageBlock(properties, "STAGE_COPY_OPTIONS", stageCopyOptionsSchema, slices.Collect(maps.Keys(stageCopyOptionsSchema)), current)
+}
+
+// readStageBlock reads the options of the block from the properties of the parent. The block is left out when it is not
+// in the state and all its options are equal to their defaults, so that the stages without it do not diff.
+func readStageBlock(properties []sdk.StageProperty, parent string, blockSchema map[string]*schema.Schema, options []string, current map[string]any) ([]any, error) {
+	block := make(map[string]any)
+	for _, option := range options {
+		property := findStageProperty(properties, parent, strings.ToUpper(option))
+		if property == nil || property.Value == property.Default && isFileFormatTypedValueZero(current[option]) {
+			continue
+		}
+		value, err := parseStagePropertyValue(blockSchema[option], property)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of %s option %s: %w", strings.ToLower(parent), option, err)
+		}
+		block[option] = value
+	}
+	if len(block) == 0 && current == nil {
+		return []any{}, nil
+	}
+	return []any{block}, nil
+}
+
+func parseStagePropertyValue(s *schema.Schema, property *sdk.StageProperty) (any, error) {
+	value := property.Value
+	switch s.Type {
+	case schema.TypeInt:
+		if value == "" {
+			return 0, nil
+		}
+		return strconv.Atoi(value)
+	case schema.TypeList:
+		values := make([]any, 0)
+		for _, v := range strings.Split(strings.TrimSuffix(strings.TrimPrefix(value, "["), "]"), ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+		return values, nil
+	default:
+		if strings.EqualFold(property.Type, "Boolean") {
+			return strings.ToLower(value), nil
+		}
+		return value, nil
+	}
+}
+
+func findStageProperty(properties []sdk.StageProperty, parent string, name string) *sdk.StageProperty {
+	for i, property := range properties {
+		if property.Parent == parent && property.Name == name {
+			return &properties[i]
+		}
+	}
+	return nil
+}
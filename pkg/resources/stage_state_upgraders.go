@@ -0,0 +1,85 @@
+package resources
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+var stageLegacyParameterRegexp = regexp.MustCompile(`(?i)(\w+)\s*=\s*'([^']*)'`)
+
+// parseStageLegacyParameters parses the opaque credentials and encryption strings of the previous schema version
+// (e.g. "AWS_KEY_ID='...' AWS_SECRET_KEY='...'") into lower-cased keys and their values.
+func parseStageLegacyParameters(s string) map[string]string {
+	parameters := make(map[string]string)
+	for _, match := range stageLegacyParameterRegexp.FindAllStringSubmatch(s, -1) {
+		parameters[strings.ToLower(match[1])] = match[2]
+	}
+	return parameters
+}
+
+// v087StageStateUpgrader moves url, storage_integration, credentials and encryption of an external stage into the storage
+// provider block matching the url. The endpoint of an S3-compatible stage was not in the state; it is read back on the
+// next refresh. file_format, copy_options and directory were opaque strings; they are dropped and read back as blocks on
+// the next refresh.
+func v087StageStateUpgrader(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	url, _ := rawState["url"].(string)
+	if kind := stageStorageKindOfUrl(url); kind != "" {
+		storage := map[string]interface{}{
+			"url": url,
+		}
+		if storageIntegration, _ := rawState["storage_integration"].(string); storageIntegration != "" && kind != "s3_compatible" {
+			storage["storage_integration"] = storageIntegration
+		}
+		if kind == "s3_compatible" {
+			storage["endpoint"] = ""
+		}
+
+		rawCredentials, _ := rawState["credentials"].(string)
+		credentials := parseStageLegacyParameters(rawCredentials)
+		switch {
+		case kind == "s3" && len(credentials) > 0:
+			storage["credentials"] = []interface{}{map[string]interface{}{
+				"aws_key_id":     credentials["aws_key_id"],
+				"aws_secret_key": credentials["aws_secret_key"],
+				"aws_token":      credentials["aws_token"],
+				"aws_role":       credentials["aws_role"],
+			}}
+		case kind == "s3_compatible" && len(credentials) > 0:
+			storage["credentials"] = []interface{}{map[string]interface{}{
+				"aws_key_id":     credentials["aws_key_id"],
+				"aws_secret_key": credentials["aws_secret_key"],
+			}}
+		case kind == "azure" && credentials["azure_sas_token"] != "":
+			storage["credentials"] = []interface{}{map[string]interface{}{
+				"azure_sas_token": credentials["azure_sas_token"],
+			}}
+		}
+
+		rawEncryption, _ := rawState["encryption"].(string)
+		if encryption := parseStageLegacyParameters(rawEncryption); encryption["type"] != "" && kind != "s3_compatible" {
+			upgraded := map[string]interface{}{
+				"type": strings.ToUpper(encryption["type"]),
+			}
+			if kind == "s3" || kind == "azure" {
+				upgraded["master_key"] = encryption["master_key"]
+			}
+			if kind == "s3" || kind == "gcs" {
+				upgraded["kms_key_id"] = encryption["kms_key_id"]
+			}
+			storage["encryption"] = []interface{}{upgraded}
+		}
+
+		rawState[kind] = []interface{}{storage}
+	}
+
+	for _, key := range []string{"url", "storage_integration", "credentials", "encryption", "file_format", "copy_options", "directory"} {
+		delete(rawState, key)
+	}
+
+	return rawState, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -91,10 +92,10 @@ var streamSchema = map[string]*schema.Schema{
 
 func Stream() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateStream,
-		Read:   ReadStream,
-		Update: UpdateStream,
-		Delete: DeleteStream,
+		CreateContext: CreateStream,
+		ReadContext:   ReadStream,
+		UpdateContext: UpdateStream,
+		DeleteContext: DeleteStream,
 
 		Schema: streamSchema,
 		Importer: &schema.ResourceImporter{
@@ -103,8 +104,8 @@ func Stream() *schema.Resource {
 	}
 }
 
-// CreateStream implements schema.CreateFunc.
-func CreateStream(d *schema.ResourceData, meta interface{}) error {
+// CreateStream implements schema.CreateContextFunc.
+func CreateStream(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
@@ -115,7 +116,6 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	onTable, onTableSet := d.GetOk("on_table")
 	onView, onViewSet := d.GetOk("on_view")
@@ -125,13 +125,13 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 	case onTableSet:
 		tableObjectIdentifier, err := helpers.DecodeSnowflakeParameterID(onTable.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		tableId := tableObjectIdentifier.(sdk.SchemaObjectIdentifier)
 
 		table, err := client.Tables.ShowByID(ctx, tableId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if table.IsExternal {
@@ -144,7 +144,7 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 			}
 			err := client.Streams.CreateOnExternalTable(ctx, req)
 			if err != nil {
-				return fmt.Errorf("error creating stream %v err = %w", name, err)
+				return diag.FromErr(fmt.Errorf("error creating stream %v err = %w", name, err))
 			}
 		} else {
 			req := sdk.NewCreateStreamOnTableRequest(id, tableId)
@@ -159,19 +159,19 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 			}
 			err := client.Streams.CreateOnTable(ctx, req)
 			if err != nil {
-				return fmt.Errorf("error creating stream %v err = %w", name, err)
+				return diag.FromErr(fmt.Errorf("error creating stream %v err = %w", name, err))
 			}
 		}
 	case onViewSet:
 		viewObjectIdentifier, err := helpers.DecodeSnowflakeParameterID(onView.(string))
 		viewId := viewObjectIdentifier.(sdk.SchemaObjectIdentifier)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		_, err = client.Views.ShowByID(ctx, viewId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req := sdk.NewCreateStreamOnViewRequest(id, viewId)
@@ -186,20 +186,20 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 		}
 		err = client.Streams.CreateOnView(ctx, req)
 		if err != nil {
-			return fmt.Errorf("error creating stream %v err = %w", name, err)
+			return diag.FromErr(fmt.Errorf("error creating stream %v err = %w", name, err))
 		}
 	case onStageSet:
 		stageObjectIdentifier, err := helpers.DecodeSnowflakeParameterID(onStage.(string))
 		stageId := stageObjectIdentifier.(sdk.SchemaObjectIdentifier)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		stageProperties, err := client.Stages.Describe(ctx, stageId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if findStagePropertyValueByName(stageProperties, "ENABLE") != "true" {
-			return fmt.Errorf("directory must be enabled on stage")
+			return diag.FromErr(fmt.Errorf("directory must be enabled on stage"))
 		}
 		req := sdk.NewCreateStreamOnDirectoryTableRequest(id, stageId)
 		if v, ok := d.GetOk("comment"); ok {
@@ -207,20 +207,19 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 		}
 		err = client.Streams.CreateOnDirectoryTable(ctx, req)
 		if err != nil {
-			return fmt.Errorf("error creating stream %v err = %w", name, err)
+			return diag.FromErr(fmt.Errorf("error creating stream %v err = %w", name, err))
 		}
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(id))
 
-	return ReadStream(d, meta)
+	return ReadStream(ctx, d, meta)
 }
 
-// ReadStream implements schema.ReadFunc.
-func ReadStream(d *schema.ResourceData, meta interface{}) error {
+// ReadStream implements schema.ReadContextFunc.
+func ReadStream(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 	stream, err := client.Streams.ShowByID(ctx, sdk.NewShowByIdStreamRequest(id))
 	if err != nil {
@@ -229,54 +228,53 @@ func ReadStream(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err := d.Set("name", stream.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("database", stream.DatabaseName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("schema", stream.SchemaName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	switch *stream.SourceType {
 	case "Stage":
 		if err := d.Set("on_stage", *stream.TableName); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	case "View":
 		if err := d.Set("on_view", *stream.TableName); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	default:
 		if err := d.Set("on_table", *stream.TableName); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if err := d.Set("append_only", *stream.Mode == "APPEND_ONLY"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("insert_only", *stream.Mode == "INSERT_ONLY"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// TODO: SHOW STREAMS doesn't return that value right now (I'm not sure if it ever did), but probably we can assume
 	// 	the customers got 'false' every time and hardcode it (it's only on create thing, so it's not necessary
 	//	to track its value after creation).
 	if err := d.Set("show_initial_rows", false); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("comment", *stream.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("owner", *stream.Owner); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-// UpdateStream implements schema.UpdateFunc.
-func UpdateStream(d *schema.ResourceData, meta interface{}) error {
+// UpdateStream implements schema.UpdateContextFunc.
+func UpdateStream(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
 	if d.HasChange("comment") {
@@ -284,29 +282,28 @@ func UpdateStream(d *schema.ResourceData, meta interface{}) error {
 		if comment == "" {
 			err := client.Streams.Alter(ctx, sdk.NewAlterStreamRequest(id).WithUnsetComment(sdk.Bool(true)))
 			if err != nil {
-				return fmt.Errorf("error unsetting stream comment on %v", d.Id())
+				return diag.FromErr(fmt.Errorf("error unsetting stream comment on %v", d.Id()))
 			}
 		} else {
 			err := client.Streams.Alter(ctx, sdk.NewAlterStreamRequest(id).WithSetComment(sdk.String(comment)))
 			if err != nil {
-				return fmt.Errorf("error setting stream comment on %v", d.Id())
+				return diag.FromErr(fmt.Errorf("error setting stream comment on %v", d.Id()))
 			}
 		}
 	}
 
-	return ReadStream(d, meta)
+	return ReadStream(ctx, d, meta)
 }
 
-// DeleteStream implements schema.DeleteFunc.
-func DeleteStream(d *schema.ResourceData, meta interface{}) error {
+// DeleteStream implements schema.DeleteContextFunc.
+func DeleteStream(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	streamId := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
 	err := client.Streams.Drop(ctx, sdk.NewDropStreamRequest(streamId))
 	if err != nil {
-		return fmt.Errorf("error deleting stream %v err = %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting stream %v err = %w", d.Id(), err))
 	}
 
 	d.SetId("")
@@ -222,11 +222,13 @@ resource "snowflake_schema" "test" {
 }
 resource "snowflake_stage" "test" {
 	name = "%v"
-	url = "%s"
 	database = snowflake_database.test.name
 	schema = snowflake_schema.test.name
 	comment = "Terraform acceptance test"
-	storage_integration = snowflake_storage_integration.external_table_stream_integration.name
+	s3 {
+		url = "%s"
+		storage_integration = snowflake_storage_integration.external_table_stream_integration.name
+	}
 }
 resource "snowflake_storage_integration" "external_table_stream_integration" {
 	name = "%v"
@@ -337,7 +339,9 @@ resource "snowflake_stage" "test_stage" {
 	name	 = "%s"
 	database = snowflake_database.test_database.name
 	schema	 = snowflake_schema.test_schema.name
-	directory = "ENABLE = %t"
+	directory {
+		enable = %t
+	}
 }
 
 resource "snowflake_stream" "test_stream" {
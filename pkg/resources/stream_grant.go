@@ -10,6 +10,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -99,10 +100,10 @@ var streamGrantSchema = map[string]*schema.Schema{
 func StreamGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateStreamGrant,
-			Read:               ReadStreamGrant,
-			Delete:             DeleteStreamGrant,
-			Update:             UpdateStreamGrant,
+			CreateContext:      CreateStreamGrant,
+			ReadContext:        ReadStreamGrant,
+			DeleteContext:      DeleteStreamGrant,
+			UpdateContext:      UpdateStreamGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             streamGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -145,8 +146,8 @@ func StreamGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateStreamGrant implements schema.CreateFunc.
-func CreateStreamGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateStreamGrant implements schema.CreateContextFunc.
+func CreateStreamGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	streamName := d.Get("stream_name").(string)
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -157,10 +158,10 @@ func CreateStreamGrant(d *schema.ResourceData, meta interface{}) error {
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	if (streamName == "") && !onFuture && !onAll {
-		return errors.New("stream_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("stream_name must be set unless on_future or on_all is true"))
 	}
 	if (schemaName == "") && !onFuture && !onAll {
-		return errors.New("schema_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future or on_all is true"))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -173,18 +174,18 @@ func CreateStreamGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.StreamGrant(databaseName, schemaName, streamName)
 	}
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, streamName, privilege, withGrantOption, onFuture, onAll, roles)
 	d.SetId(grantID)
 
-	return ReadStreamGrant(d, meta)
+	return ReadStreamGrant(ctx, d, meta)
 }
 
-// ReadStreamGrant implements schema.ReadFunc.
-func ReadStreamGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadStreamGrant implements schema.ReadContextFunc.
+func ReadStreamGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	streamName := d.Get("stream_name").(string)
@@ -204,9 +205,9 @@ func ReadStreamGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.StreamGrant(databaseName, schemaName, streamName)
 	}
 
-	err := readGenericGrant(d, meta, streamGrantSchema, builder, onFuture, onAll, validStreamPrivileges)
+	err := readGenericGrant(ctx, d, meta, streamGrantSchema, builder, onFuture, onAll, validStreamPrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, streamName, privilege, withGrantOption, onFuture, onAll, roles)
@@ -216,8 +217,8 @@ func ReadStreamGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteStreamGrant implements schema.DeleteFunc.
-func DeleteStreamGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteStreamGrant implements schema.DeleteContextFunc.
+func DeleteStreamGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	streamName := d.Get("stream_name").(string)
@@ -233,11 +234,11 @@ func DeleteStreamGrant(d *schema.ResourceData, meta interface{}) error {
 	default:
 		builder = snowflake.StreamGrant(databaseName, schemaName, streamName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateStreamGrant implements schema.UpdateFunc.
-func UpdateStreamGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateStreamGrant implements schema.UpdateContextFunc.
+func UpdateStreamGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles") {
@@ -272,17 +273,17 @@ func UpdateStreamGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
+		ctx, meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
+		ctx, meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadStreamGrant(d, meta)
+	return ReadStreamGrant(ctx, d, meta)
 }
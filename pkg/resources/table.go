@@ -3,8 +3,10 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log"
+	"maps"
 	"slices"
 	"strconv"
 	"strings"
@@ -12,6 +14,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -55,15 +58,17 @@ var tableSchema = map[string]*schema.Schema{
 					Required:    true,
 					Description: "Column name",
 				},
+				"rename_from": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Previous name of the column. When the table has a column of this name that is no longer configured, the column is renamed in place; otherwise changing the name drops the column with its data and adds a new one.",
+				},
 				"type": {
 					Type:        schema.TypeString,
 					Required:    true,
 					Description: "Column type, e.g. VARIANT",
-					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-						// these are all equivalent as per https://docs.snowflake.com/en/sql-reference/data-types-text.html
-						varcharType := []string{"VARCHAR(16777216)", "VARCHAR", "text", "string", "NVARCHAR", "NVARCHAR2", "CHAR VARYING", "NCHAR VARYING"}
-						return slices.Contains(varcharType, new) && slices.Contains(varcharType, old)
-					},
+					// the synonyms are equivalent as per https://docs.snowflake.com/en/sql-reference/data-types-text.html and https://docs.snowflake.com/en/sql-reference/data-types-numeric
+					DiffSuppressFunc: suppressEquivalentColumnType,
 				},
 				"nullable": {
 					Type:        schema.TypeBool,
@@ -74,7 +79,7 @@ var tableSchema = map[string]*schema.Schema{
 				"default": {
 					Type:        schema.TypeList,
 					Optional:    true,
-					Description: "Defines the column default value; note due to limitations of Snowflake's ALTER TABLE ADD/MODIFY COLUMN updates to default will not be applied",
+					Description: "Defines the column default value; note due to limitations of Snowflake's ALTER TABLE ADD/MODIFY COLUMN only the default sequence can be changed and the default dropped in place, other changes of the default fail the plan",
 					MinItems:    1,
 					MaxItems:    1,
 					Elem: &schema.Resource{
@@ -139,6 +144,7 @@ var tableSchema = map[string]*schema.Schema{
 					Default:     "",
 					Description: "Masking policy to apply on column. It has to be a fully qualified name.",
 				},
+				"tag": tableTagSchema("Definitions of a tag to associate with the column. The tags without the database and schema are looked up in the schema of the table. The tags of the columns are only read back when some column has a tag block."),
 			},
 		},
 	},
@@ -197,25 +203,78 @@ var tableSchema = map[string]*schema.Schema{
 		Default:     false,
 		Description: "Specifies whether to enable change tracking on the table. Default false.",
 	},
+	"row_access_policy": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Row access policy attached to the table. Changing the policy or its columns replaces it with a single statement, so the table is never left unprotected. The policy is only read back when the block is set.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "Fully qualified name of the row access policy.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					DiffSuppressFunc: suppressSchemaObjectIdentifierQuoting,
+				},
+				"on": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Required:    true,
+					MinItems:    1,
+					Description: "Columns of the table passed to the policy, in the order of its arguments.",
+				},
+			},
+		},
+	},
+	"search_optimization": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Search access paths of the search optimization service, one per method and column. They are only read back when some are configured or in the state. Requires Enterprise Edition (or higher).",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"method": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "Search method. Valid values are: EQUALITY | SUBSTRING | GEO",
+					ValidateDiagFunc: StringInSlice([]string{string(sdk.SearchOptimizationMethodEquality), string(sdk.SearchOptimizationMethodSubstring), string(sdk.SearchOptimizationMethodGeo)}, false),
+				},
+				"column": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Column of the table to optimize the searches on.",
+				},
+			},
+		},
+	},
+	"allow_dropping_populated_columns": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Allows dropping the columns holding data. By default, the plan dropping a column fails if any row of the table has a value in it (checking it needs a warehouse).",
+	},
 	"qualified_name": {
 		Type:        schema.TypeString,
 		Computed:    true,
 		Description: "Qualified name of the table.",
 	},
-	"tag": tagReferenceSchema,
+	"tag":   tableTagSchema("Definitions of a tag to associate with the table. The tags without the database and schema are looked up in the schema of the table. The tags are only read back when some tag block is set."),
+	"clone": cloneSchema("table", "Name of the table to clone. Either a table name in the same schema or a fully qualified name (`database.schema.table`); names containing dots have to be double-quoted. The configured columns have to match the columns of the source table."),
 }
 
 func Table() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateTable,
-		Read:   ReadTable,
-		Update: UpdateTable,
-		Delete: DeleteTable,
+		CreateContext: CreateTable,
+		ReadContext:   ReadTable,
+		UpdateContext: UpdateTable,
+		DeleteContext: DeleteTable,
 
 		Schema: tableSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: customizeTableColumnsDiff,
 	}
 }
 
@@ -248,12 +307,14 @@ type columnIdentity struct {
 
 type column struct {
 	name          string
+	renameFrom    string
 	dataType      string
 	nullable      bool
 	_default      *columnDefault
 	identity      *columnIdentity
 	comment       string
 	maskingPolicy string
+	tags          tags
 }
 
 type columns []column
@@ -265,16 +326,19 @@ type changedColumn struct {
 	changedDataType       bool
 	changedNullConstraint bool
 	dropedDefault         bool
+	changedSequence       bool
 	changedComment        bool
 	changedMaskingPolicy  bool
+	changedTags           bool
+	oldTags               tags
 }
 
 func (c columns) getChangedColumnProperties(new columns) (changed changedColumns) {
 	changed = changedColumns{}
 	for _, cO := range c {
 		for _, cN := range new {
-			changeColumn := changedColumn{cN, false, false, false, false, false}
-			if cO.name == cN.name && cO.dataType != cN.dataType {
+			changeColumn := changedColumn{newColumn: cN, oldTags: cO.tags}
+			if kind, _ := classifyColumnTypeChange(cO.dataType, cN.dataType); cO.name == cN.name && kind == columnChangeInPlace {
 				changeColumn.changedDataType = true
 			}
 			if cO.name == cN.name && cO.nullable != cN.nullable {
@@ -283,6 +347,9 @@ func (c columns) getChangedColumnProperties(new columns) (changed changedColumns
 			if cO.name == cN.name && cO._default != nil && cN._default == nil {
 				changeColumn.dropedDefault = true
 			}
+			if cO.name == cN.name && cO._default != nil && cO._default.sequence != nil && cN._default != nil && cN._default.sequence != nil && *cO._default.sequence != *cN._default.sequence {
+				changeColumn.changedSequence = true
+			}
 
 			if cO.name == cN.name && cO.comment != cN.comment {
 				changeColumn.changedComment = true
@@ -292,6 +359,10 @@ func (c columns) getChangedColumnProperties(new columns) (changed changedColumns
 				changeColumn.changedMaskingPolicy = true
 			}
 
+			if cO.name == cN.name && !slices.Equal(cO.tags, cN.tags) {
+				changeColumn.changedTags = true
+			}
+
 			changed = append(changed, changeColumn)
 		}
 	}
@@ -355,14 +426,24 @@ func getColumn(from interface{}) (to column) {
 		id = getColumnIdentity(identity[0].(map[string]interface{}))
 	}
 
+	var columnTags tags
+	if t, ok := c["tag"].([]interface{}); ok {
+		columnTags = getTags(t)
+	}
+
+	// the columns read back from Snowflake have no rename_from
+	renameFrom, _ := c["rename_from"].(string)
+
 	return column{
 		name:          c["name"].(string),
+		renameFrom:    renameFrom,
 		dataType:      c["type"].(string),
 		nullable:      c["nullable"].(bool),
 		_default:      cd,
 		identity:      id,
 		comment:       c["comment"].(string),
 		maskingPolicy: c["masking_policy"].(string),
+		tags:          columnTags,
 	}
 }
 
@@ -375,7 +456,7 @@ func getColumns(from interface{}) (to columns) {
 	return to
 }
 
-func getTableColumnRequest(from interface{}) *sdk.TableColumnRequest {
+func getTableColumnRequest(tableID sdk.SchemaObjectIdentifier, from interface{}) *sdk.TableColumnRequest {
 	c := from.(map[string]interface{})
 	_type := c["type"].(string)
 
@@ -422,16 +503,20 @@ func getTableColumnRequest(from interface{}) *sdk.TableColumnRequest {
 		request.WithMaskingPolicy(sdk.NewColumnMaskingPolicyRequest(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(maskingPolicy)))
 	}
 
+	if columnTags := getTags(c["tag"]); len(columnTags) > 0 {
+		request.WithTags(columnTags.tableTagAssociations(tableID))
+	}
+
 	return request.
 		WithNotNull(sdk.Bool(!c["nullable"].(bool))).
 		WithComment(sdk.String(c["comment"].(string)))
 }
 
-func getTableColumnRequests(from interface{}) []sdk.TableColumnRequest {
+func getTableColumnRequests(tableID sdk.SchemaObjectIdentifier, from interface{}) []sdk.TableColumnRequest {
 	cols := from.([]interface{})
 	to := make([]sdk.TableColumnRequest, len(cols))
 	for i, c := range cols {
-		to[i] = *getTableColumnRequest(c)
+		to[i] = *getTableColumnRequest(tableID, c)
 	}
 	return to
 }
@@ -543,10 +628,9 @@ func toColumnIdentityConfig(td sdk.TableColumnDetails) map[string]any {
 	return nil
 }
 
-// CreateTable implements schema.CreateFunc.
-func CreateTable(d *schema.ResourceData, meta interface{}) error {
+// CreateTable implements schema.CreateContextFunc.
+func CreateTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	databaseName := d.Get("database").(string)
@@ -554,7 +638,18 @@ func CreateTable(d *schema.ResourceData, meta interface{}) error {
 	name := d.Get("name").(string)
 	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
-	tableColumnRequests := getTableColumnRequests(d.Get("column").([]interface{}))
+	clone, err := getCloneSettings(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if clone != nil {
+		if err := createTableClone(ctx, d, client, id, clone); err != nil {
+			return diag.FromErr(err)
+		}
+		return ReadTable(ctx, d, meta)
+	}
+
+	tableColumnRequests := getTableColumnRequests(id, d.Get("column").([]interface{}))
 
 	createRequest := sdk.NewCreateTableRequest(id, tableColumnRequests)
 
@@ -589,30 +684,130 @@ func CreateTable(d *schema.ResourceData, meta interface{}) error {
 		createRequest.WithChangeTracking(sdk.Bool(v.(bool)))
 	}
 
-	var tagAssociationRequests []sdk.TagAssociationRequest
-	if _, ok := d.GetOk("tag"); ok {
-		tagAssociations := getPropertyTags(d, "tag")
-		tagAssociationRequests = make([]sdk.TagAssociationRequest, len(tagAssociations))
+	if tableTags := getTags(d.Get("tag")); len(tableTags) > 0 {
+		tagAssociations := tableTags.tableTagAssociations(id)
+		tagAssociationRequests := make([]sdk.TagAssociationRequest, len(tagAssociations))
 		for i, t := range tagAssociations {
 			tagAssociationRequests[i] = *sdk.NewTagAssociationRequest(t.Name, t.Value)
 		}
 		createRequest.WithTags(tagAssociationRequests)
 	}
 
-	err := client.Tables.Create(ctx, createRequest)
+	if policy := getTableRowAccessPolicy(d.Get("row_access_policy")); policy != nil {
+		createRequest.WithRowAccessPolicy(&sdk.RowAccessPolicyRequest{Name: policy.policy, On: snowflake.QuoteStringList(policy.on)})
+	}
+
+	err = client.Tables.Create(ctx, createRequest)
 	if err != nil {
-		return fmt.Errorf("error creating table %v err = %w", name, err)
+		return diag.FromErr(fmt.Errorf("error creating table %v err = %w", name, err))
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(id))
 
-	return ReadTable(d, meta)
+	if err := updateTableSearchOptimization(ctx, client, id, nil, getSearchOptimizationPaths(d.Get("search_optimization"))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadTable(ctx, d, meta)
 }
 
-// ReadTable implements schema.ReadFunc.
-func ReadTable(d *schema.ResourceData, meta interface{}) error {
+// createTableClone creates the table as a clone and aligns the properties inherited from the source table with the configuration.
+func createTableClone(ctx context.Context, d *schema.ResourceData, client *sdk.Client, id sdk.SchemaObjectIdentifier, clone *cloneSettings) error {
+	parts, err := clone.sourceParts(3)
+	if err != nil {
+		return err
+	}
+	var sourceId sdk.SchemaObjectIdentifier
+	switch len(parts) {
+	case 1:
+		sourceId = sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), parts[0])
+	case 2:
+		sourceId = sdk.NewSchemaObjectIdentifier(id.DatabaseName(), parts[0], parts[1])
+	default:
+		sourceId = sdk.NewSchemaObjectIdentifier(parts[0], parts[1], parts[2])
+	}
+
+	cloneRequest := sdk.NewCreateTableCloneRequest(id, sourceId)
+	if clone.Moment != "" {
+		cloneRequest.WithClonePoint(sdk.NewClonePointRequest().
+			WithMoment(clone.Moment).
+			WithAt(*sdk.NewTimeTravelRequest().
+				WithTimestamp(clone.TimeTravel.Timestamp).
+				WithOffset(clone.TimeTravel.Offset).
+				WithStatement(clone.TimeTravel.Statement)))
+	}
+	if err := client.Tables.CreateClone(ctx, cloneRequest); err != nil {
+		return fmt.Errorf("error creating table %v as a clone of %v err = %w", id.Name(), sourceId.FullyQualifiedName(), err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	setRequest := sdk.NewTableSetRequest().WithChangeTracking(sdk.Bool(d.Get("change_tracking").(bool)))
+	if v, ok := d.GetOk("comment"); ok {
+		setRequest.WithComment(sdk.String(v.(string)))
+	} else if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithUnset(sdk.NewTableUnsetRequest().WithComment(true))); err != nil {
+		return fmt.Errorf("error updating table: %w", err)
+	}
+	if v, ok := d.GetOk("data_retention_days"); ok {
+		setRequest.WithDataRetentionTimeInDays(sdk.Int(v.(int)))
+	} else if v, ok := d.GetOk("data_retention_time_in_days"); ok {
+		setRequest.WithDataRetentionTimeInDays(sdk.Int(v.(int)))
+	}
+	if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSet(setRequest)); err != nil {
+		return fmt.Errorf("error updating table: %w", err)
+	}
+
+	clusteringAction := sdk.NewTableClusteringActionRequest().WithDropClusteringKey(sdk.Bool(true))
+	if v, ok := d.GetOk("cluster_by"); ok {
+		clusteringAction = sdk.NewTableClusteringActionRequest().WithClusterBy(expandStringList(v.([]interface{})))
+	}
+	if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithClusteringAction(clusteringAction)); err != nil {
+		return fmt.Errorf("error updating table: %w", err)
+	}
+
+	if tableTags := getTags(d.Get("tag")); len(tableTags) > 0 {
+		tagAssociations := tableTags.tableTagAssociations(id)
+		tagAssociationRequests := make([]sdk.TagAssociationRequest, len(tagAssociations))
+		for i, t := range tagAssociations {
+			tagAssociationRequests[i] = *sdk.NewTagAssociationRequest(t.Name, t.Value)
+		}
+		if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSetTags(tagAssociationRequests)); err != nil {
+			return fmt.Errorf("error setting tags on table: %w", err)
+		}
+	}
+	for _, c := range getColumns(d.Get("column")) {
+		if err := updateTableColumnTags(ctx, client, id, c.name, nil, c.tags); err != nil {
+			return err
+		}
+	}
+
+	// the clone inherits the row access policy and the search optimization of the source table
+	clonedPolicy, err := readTableRowAccessPolicy(ctx, client, id)
+	switch {
+	case isPrivilegeError(err):
+		log.Printf("[WARN] %v, the row access policy of the clone is assumed to be unset", err)
+	case err != nil:
+		return err
+	}
+	if err := updateTableRowAccessPolicy(ctx, client, id, clonedPolicy, getTableRowAccessPolicy(d.Get("row_access_policy"))); err != nil {
+		return err
+	}
+	table, err := client.Tables.ShowByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	clonedPaths, err := readTableSearchOptimization(ctx, client, table)
+	if err != nil {
+		return err
+	}
+	if err := updateTableSearchOptimization(ctx, client, id, clonedPaths, getSearchOptimizationPaths(d.Get("search_optimization"))); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReadTable implements schema.ReadContextFunc.
+func ReadTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
@@ -625,9 +820,26 @@ func ReadTable(d *schema.ResourceData, meta interface{}) error {
 
 	tableDescription, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(id))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+
+	// the tags, the row access policy and the search optimization are only read when they are configured or in the state, and the values in the
+	// state are kept when the role cannot read them
+	knownColumns := getColumns(d.Get("column"))
+	columnConfig := toColumnConfig(tableDescription)
+	if slices.ContainsFunc(knownColumns, func(c column) bool { return len(c.tags) > 0 }) {
+		columnConfig, err = readTableColumnTags(ctx, client, id, columnConfig, knownColumns)
+		switch {
+		case isPrivilegeError(err):
+			log.Printf("[WARN] %v, the column tags in the state are kept", err)
+			columnConfig = keepTableColumnTags(toColumnConfig(tableDescription), knownColumns)
+		case err != nil:
+			return diag.FromErr(err)
+		}
 	}
 
+	columnConfig = keepTableColumnRenames(columnConfig, knownColumns)
+
 	// Set the relevant data in the state
 	toSet := map[string]interface{}{
 		"name":            table.Name,
@@ -635,11 +847,44 @@ func ReadTable(d *schema.ResourceData, meta interface{}) error {
 		"database":        table.DatabaseName,
 		"schema":          table.SchemaName,
 		"comment":         table.Comment,
-		"column":          toColumnConfig(tableDescription),
+		"column":          columnConfig,
 		"cluster_by":      table.GetClusterByKeys(),
 		"change_tracking": table.ChangeTracking,
 		"qualified_name":  id.FullyQualifiedName(),
 	}
+
+	if len(getSearchOptimizationPaths(d.Get("search_optimization"))) > 0 {
+		searchOptimizationPaths, err := readTableSearchOptimization(ctx, client, table)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		toSet["search_optimization"] = searchOptimizationPathsToConfig(searchOptimizationPaths)
+	}
+
+	if knownTags := getTags(d.Get("tag")); len(knownTags) > 0 {
+		tableTags, err := readTableTags(ctx, client, id, knownTags)
+		switch {
+		case isPrivilegeError(err):
+			log.Printf("[WARN] %v, the tags in the state are kept", err)
+		case err != nil:
+			return diag.FromErr(err)
+		default:
+			toSet["tag"] = tableTags
+		}
+	}
+
+	if getTableRowAccessPolicy(d.Get("row_access_policy")) != nil {
+		rowAccessPolicy, err := readTableRowAccessPolicy(ctx, client, id)
+		switch {
+		case isPrivilegeError(err):
+			log.Printf("[WARN] %v, the row access policy in the state is kept", err)
+		case err != nil:
+			return diag.FromErr(err)
+		default:
+			toSet["row_access_policy"] = rowAccessPolicy.toConfig()
+		}
+	}
+
 	var dataRetentionKey string
 	if _, ok := d.GetOk("data_retention_time_in_days"); ok {
 		dataRetentionKey = "data_retention_time_in_days"
@@ -652,16 +897,15 @@ func ReadTable(d *schema.ResourceData, meta interface{}) error {
 
 	for key, val := range toSet {
 		if err := d.Set(key, val); err != nil { // lintignore:R001
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
 }
 
-// UpdateTable implements schema.UpdateFunc.
-func UpdateTable(d *schema.ResourceData, meta interface{}) error {
+// UpdateTable implements schema.UpdateContextFunc.
+func UpdateTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
@@ -672,7 +916,7 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 
 		err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithNewName(&newId))
 		if err != nil {
-			return fmt.Errorf("error renaming table %v err = %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error renaming table %v err = %w", d.Id(), err))
 		}
 
 		d.SetId(helpers.EncodeSnowflakeID(newId))
@@ -714,14 +958,14 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 	if runSetStatement {
 		err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSet(setRequest))
 		if err != nil {
-			return fmt.Errorf("error updating table: %w", err)
+			return diag.FromErr(fmt.Errorf("error updating table: %w", err))
 		}
 	}
 
 	if runUnsetStatement {
 		err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithUnset(unsetRequest))
 		if err != nil {
-			return fmt.Errorf("error updating table: %w", err)
+			return diag.FromErr(fmt.Errorf("error updating table: %w", err))
 		}
 	}
 
@@ -731,19 +975,39 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 		if len(cb) != 0 {
 			err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithClusteringAction(sdk.NewTableClusteringActionRequest().WithClusterBy(cb)))
 			if err != nil {
-				return fmt.Errorf("error updating table: %w", err)
+				return diag.FromErr(fmt.Errorf("error updating table: %w", err))
 			}
 		} else {
 			err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithClusteringAction(sdk.NewTableClusteringActionRequest().WithDropClusteringKey(sdk.Bool(true))))
 			if err != nil {
-				return fmt.Errorf("error updating table: %w", err)
+				return diag.FromErr(fmt.Errorf("error updating table: %w", err))
 			}
 		}
 	}
 
+	// the search access paths are dropped before the columns they are defined on, and added after the new columns
+	var removedPaths, addedPaths []searchOptimizationPath
+	if d.HasChange("search_optimization") {
+		o, n := d.GetChange("search_optimization")
+		removedPaths, addedPaths = searchOptimizationPathsDiff(getSearchOptimizationPaths(o), getSearchOptimizationPaths(n))
+	}
+	if err := dropTableSearchOptimization(ctx, client, id, removedPaths); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("column") {
 		t, n := d.GetChange("column")
-		removed, added, changed := getColumns(t).diffs(getColumns(n))
+		oldColumns, newColumns := getColumns(t), getColumns(n)
+
+		// the columns are renamed first, so the other changes find them by the new names
+		renamed := planColumnChanges(oldColumns, newColumns).renamed
+		for _, oldName := range slices.Sorted(maps.Keys(renamed)) {
+			err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(sdk.NewTableColumnActionRequest().WithRename(sdk.NewTableColumnRenameActionRequest(fmt.Sprintf("\"%s\"", oldName), fmt.Sprintf("\"%s\"", renamed[oldName])))))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error renaming column %v: err %w", oldName, err))
+			}
+		}
+		removed, added, changed := oldColumns.withRenames(renamed).diffs(newColumns)
 
 		if len(removed) > 0 {
 			removedColumnNames := make([]string, len(removed))
@@ -752,7 +1016,7 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 			}
 			err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(sdk.NewTableColumnActionRequest().WithDropColumns(snowflake.QuoteStringList(removedColumnNames))))
 			if err != nil {
-				return fmt.Errorf("error updating table: %w", err)
+				return diag.FromErr(fmt.Errorf("error updating table: %w", err))
 			}
 		}
 
@@ -762,7 +1026,7 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 
 			if cA._default != nil {
 				if cA._default._type() != "constant" {
-					return fmt.Errorf("failed to add column %v => Only adding a column as a constant is supported by Snowflake", cA.name)
+					return diag.FromErr(fmt.Errorf("failed to add column %v => Only adding a column as a constant is supported by Snowflake", cA.name))
 				}
 				var expression string
 				if strings.Contains(cA.dataType, "CHAR") || cA.dataType == "STRING" || cA.dataType == "TEXT" {
@@ -785,16 +1049,20 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 				addRequest.WithComment(sdk.String(cA.comment))
 			}
 
+			if len(cA.tags) > 0 {
+				addRequest.WithTags(cA.tags.tableTagAssociations(id))
+			}
+
 			err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(sdk.NewTableColumnActionRequest().WithAdd(addRequest)))
 			if err != nil {
-				return fmt.Errorf("error adding column: %w", err)
+				return diag.FromErr(fmt.Errorf("error adding column: %w", err))
 			}
 		}
 		for _, cA := range changed {
 			if cA.changedDataType {
 				err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(sdk.NewTableColumnActionRequest().WithAlter([]sdk.TableColumnAlterActionRequest{*sdk.NewTableColumnAlterActionRequest(fmt.Sprintf("\"%s\"", cA.newColumn.name)).WithType(sdk.Pointer(sdk.DataType(cA.newColumn.dataType)))})))
 				if err != nil {
-					return fmt.Errorf("error changing property on %v: err %w", d.Id(), err)
+					return diag.FromErr(fmt.Errorf("error changing property on %v: err %w", d.Id(), err))
 				}
 			}
 			if cA.changedNullConstraint {
@@ -806,13 +1074,19 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 				}
 				err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(sdk.NewTableColumnActionRequest().WithAlter([]sdk.TableColumnAlterActionRequest{*sdk.NewTableColumnAlterActionRequest(fmt.Sprintf("\"%s\"", cA.newColumn.name)).WithNotNullConstraint(nullabilityRequest)})))
 				if err != nil {
-					return fmt.Errorf("error changing property on %v: err %w", d.Id(), err)
+					return diag.FromErr(fmt.Errorf("error changing property on %v: err %w", d.Id(), err))
 				}
 			}
 			if cA.dropedDefault {
 				err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(sdk.NewTableColumnActionRequest().WithAlter([]sdk.TableColumnAlterActionRequest{*sdk.NewTableColumnAlterActionRequest(fmt.Sprintf("\"%s\"", cA.newColumn.name)).WithDropDefault(sdk.Bool(true))})))
 				if err != nil {
-					return fmt.Errorf("error changing property on %v: err %w", d.Id(), err)
+					return diag.FromErr(fmt.Errorf("error changing property on %v: err %w", d.Id(), err))
+				}
+			}
+			if cA.changedSequence {
+				err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(sdk.NewTableColumnActionRequest().WithAlter([]sdk.TableColumnAlterActionRequest{*sdk.NewTableColumnAlterActionRequest(fmt.Sprintf("\"%s\"", cA.newColumn.name)).WithSetDefault(sdk.Pointer(sdk.SequenceName(*cA.newColumn._default.sequence)))})))
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("error changing property on %v: err %w", d.Id(), err))
 				}
 			}
 			if cA.changedComment {
@@ -825,7 +1099,7 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 
 				err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(sdk.NewTableColumnActionRequest().WithAlter([]sdk.TableColumnAlterActionRequest{*columnAlterActionRequest})))
 				if err != nil {
-					return fmt.Errorf("error changing property on %v: err %w", d.Id(), err)
+					return diag.FromErr(fmt.Errorf("error changing property on %v: err %w", d.Id(), err))
 				}
 			}
 			if cA.changedMaskingPolicy {
@@ -837,7 +1111,12 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 				}
 				err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(columnAction))
 				if err != nil {
-					return fmt.Errorf("error changing property on %v: err %w", d.Id(), err)
+					return diag.FromErr(fmt.Errorf("error changing property on %v: err %w", d.Id(), err))
+				}
+			}
+			if cA.changedTags {
+				if err := updateTableColumnTags(ctx, client, id, cA.newColumn.name, cA.oldTags, cA.newColumn.tags); err != nil {
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -856,7 +1135,7 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 					WithDrop(sdk.NewTableConstraintDropActionRequest().WithPrimaryKey(sdk.Bool(true))),
 			))
 			if err != nil {
-				return fmt.Errorf("error updating table: %w", err)
+				return diag.FromErr(fmt.Errorf("error updating table: %w", err))
 			}
 		}
 
@@ -869,18 +1148,30 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 				sdk.NewTableConstraintActionRequest().WithAdd(constraint),
 			))
 			if err != nil {
-				return fmt.Errorf("error updating table: %w", err)
+				return diag.FromErr(fmt.Errorf("error updating table: %w", err))
 			}
 		}
 	}
 
+	if d.HasChange("row_access_policy") {
+		o, n := d.GetChange("row_access_policy")
+		if err := updateTableRowAccessPolicy(ctx, client, id, getTableRowAccessPolicy(o), getTableRowAccessPolicy(n)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := addTableSearchOptimization(ctx, client, id, addedPaths); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("tag") {
-		unsetTags, setTags := GetTagsDiff(d, "tag")
+		o, n := d.GetChange("tag")
+		unsetTags, setTags := tableTagsDiff(id, getTags(o), getTags(n))
 
 		if len(unsetTags) > 0 {
 			err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithUnsetTags(unsetTags))
 			if err != nil {
-				return fmt.Errorf("error setting tags on %v, err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error setting tags on %v, err = %w", d.Id(), err))
 			}
 		}
 
@@ -891,27 +1182,394 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 			}
 			err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSetTags(tagAssociationRequests))
 			if err != nil {
-				return fmt.Errorf("error setting tags on %v, err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error setting tags on %v, err = %w", d.Id(), err))
 			}
 		}
 	}
 
-	return ReadTable(d, meta)
+	return ReadTable(ctx, d, meta)
 }
 
-// DeleteTable implements schema.DeleteFunc.
-func DeleteTable(d *schema.ResourceData, meta interface{}) error {
+// DeleteTable implements schema.DeleteContextFunc.
+func DeleteTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
 	err := client.Tables.Drop(ctx, sdk.NewDropTableRequest(id))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
 
 	return nil
 }
+
+// tableTagSchema is the tag block of the table and its columns. Unlike the deprecated tag blocks of the other resources,
+// the tag values are read back, so the changes made outside of Terraform are detected.
+func tableTagSchema(description string) *schema.Schema {
+	tagSchema := *tagReferenceSchema
+	tagSchema.Description = description
+	tagSchema.Deprecated = ""
+	return &tagSchema
+}
+
+func suppressSchemaObjectIdentifierQuoting(_, old, new string, _ *schema.ResourceData) bool {
+	oldID, err := helpers.DecodeSnowflakeParameterID(old)
+	if err != nil {
+		return false
+	}
+	newID, err := helpers.DecodeSnowflakeParameterID(new)
+	if err != nil {
+		return false
+	}
+	return oldID.FullyQualifiedName() == newID.FullyQualifiedName()
+}
+
+// tableTagID returns the identifier of the tag. The tag is looked up in the schema of the table when the block does not
+// specify its database or schema.
+func (t tag) tableTagID(tableID sdk.SchemaObjectIdentifier) sdk.SchemaObjectIdentifier {
+	database, schemaName := t.database, t.schema
+	if database == "" {
+		database = tableID.DatabaseName()
+	}
+	if schemaName == "" {
+		schemaName = tableID.SchemaName()
+	}
+	return sdk.NewSchemaObjectIdentifier(database, schemaName, t.name)
+}
+
+func (t tags) tableTagAssociations(tableID sdk.SchemaObjectIdentifier) []sdk.TagAssociation {
+	associations := make([]sdk.TagAssociation, len(t))
+	for i, tag := range t {
+		associations[i] = sdk.TagAssociation{Name: tag.tableTagID(tableID), Value: tag.value}
+	}
+	return associations
+}
+
+func tableTagsDiff(tableID sdk.SchemaObjectIdentifier, old tags, new tags) (unsetTags []sdk.ObjectIdentifier, setTags []sdk.TagAssociation) {
+	removed, added, changed := old.diffs(new)
+	unsetTags = make([]sdk.ObjectIdentifier, len(removed))
+	for i, t := range removed {
+		unsetTags[i] = t.tableTagID(tableID)
+	}
+	return unsetTags, append(added.tableTagAssociations(tableID), changed.tableTagAssociations(tableID)...)
+}
+
+func updateTableColumnTags(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, columnName string, old tags, new tags) error {
+	unsetTags, setTags := tableTagsDiff(id, old, new)
+	quotedName := fmt.Sprintf("\"%s\"", columnName)
+	if len(unsetTags) > 0 {
+		err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(sdk.NewTableColumnActionRequest().WithUnsetTags(sdk.NewTableColumnAlterUnsetTagsActionRequest(quotedName, unsetTags))))
+		if err != nil {
+			return fmt.Errorf("error unsetting tags on column %v of %v, err = %w", columnName, id.FullyQualifiedName(), err)
+		}
+	}
+	if len(setTags) > 0 {
+		err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(sdk.NewTableColumnActionRequest().WithSetTags(sdk.NewTableColumnAlterSetTagsActionRequest(quotedName, setTags))))
+		if err != nil {
+			return fmt.Errorf("error setting tags on column %v of %v, err = %w", columnName, id.FullyQualifiedName(), err)
+		}
+	}
+	return nil
+}
+
+// readTableTags returns the tag blocks of the tags set on the table, read from its tag references; the tags inherited
+// from the schema or the database are left out.
+func readTableTags(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, known tags) ([]any, error) {
+	references, err := client.TagReferences.GetForEntity(ctx, id, sdk.TagReferenceDomainTable)
+	if err != nil {
+		return nil, fmt.Errorf("error reading tag references of %v err = %w", id.FullyQualifiedName(), err)
+	}
+	references = slices.DeleteFunc(references, func(r sdk.TagReference) bool { return r.Level != sdk.TagReferenceDomainTable })
+	return tableTagsFromReferences(id, references, known), nil
+}
+
+// readTableColumnTags sets the tags of the described columns, read from the tag references of the table columns.
+func readTableColumnTags(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, described []any, known columns) ([]any, error) {
+	references, err := client.TagReferences.GetForTableColumns(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error reading column tag references of %v err = %w", id.FullyQualifiedName(), err)
+	}
+	for _, c := range described {
+		flat := c.(map[string]any)
+		name := flat["name"].(string)
+		var knownTags tags
+		if i := slices.IndexFunc(known, func(k column) bool { return k.name == name }); i >= 0 {
+			knownTags = known[i].tags
+		}
+		columnReferences := slices.DeleteFunc(slices.Clone(references), func(r sdk.TagReference) bool {
+			return r.Level != sdk.TagReferenceDomainColumn || r.ColumnName == nil || *r.ColumnName != name
+		})
+		flat["tag"] = tableTagsFromReferences(id, columnReferences, knownTags)
+	}
+	return described, nil
+}
+
+// keepTableColumnTags sets the known tags of the columns of the same name on the described columns.
+func keepTableColumnTags(described []any, known columns) []any {
+	for _, c := range described {
+		flat := c.(map[string]any)
+		if i := slices.IndexFunc(known, func(k column) bool { return k.name == flat["name"].(string) }); i >= 0 && len(known[i].tags) > 0 {
+			flat["tag"] = known[i].tags.toTableTagsConfig()
+		}
+	}
+	return described
+}
+
+// keepTableColumnRenames sets the known rename_from of the columns of the same name on the described columns, as it is
+// not stored in Snowflake.
+func keepTableColumnRenames(described []any, known columns) []any {
+	for _, c := range described {
+		flat := c.(map[string]any)
+		if i := slices.IndexFunc(known, func(k column) bool { return k.name == flat["name"].(string) }); i >= 0 && known[i].renameFrom != "" {
+			flat["rename_from"] = known[i].renameFrom
+		}
+	}
+	return described
+}
+
+// tableTagsFromReferences returns the tag blocks of the referenced tags. The tags known from the configuration or the
+// state come first and keep their form, e.g. without the database and the schema; the others follow in the order of
+// their identifiers.
+func tableTagsFromReferences(tableID sdk.SchemaObjectIdentifier, references []sdk.TagReference, known tags) []any {
+	references = slices.Clone(references)
+	read := make([]any, 0, len(references))
+	for _, t := range known {
+		tagID := t.tableTagID(tableID).FullyQualifiedName()
+		i := slices.IndexFunc(references, func(r sdk.TagReference) bool { return r.TagID().FullyQualifiedName() == tagID })
+		if i < 0 {
+			continue
+		}
+		read = append(read, tableTagConfig(t.name, references[i].TagValue, t.database, t.schema))
+		references = slices.Delete(references, i, i+1)
+	}
+	slices.SortFunc(references, func(a, b sdk.TagReference) int {
+		return strings.Compare(a.TagID().FullyQualifiedName(), b.TagID().FullyQualifiedName())
+	})
+	for _, r := range references {
+		read = append(read, tableTagConfig(r.TagName, r.TagValue, r.TagDatabase, r.TagSchema))
+	}
+	return read
+}
+
+func (t tags) toTableTagsConfig() []any {
+	config := make([]any, len(t))
+	for i, tag := range t {
+		config[i] = tableTagConfig(tag.name, tag.value, tag.database, tag.schema)
+	}
+	return config
+}
+
+func tableTagConfig(name string, value string, database string, schemaName string) map[string]any {
+	return map[string]any{
+		"name":     name,
+		"value":    value,
+		"database": database,
+		"schema":   schemaName,
+	}
+}
+
+type tableRowAccessPolicy struct {
+	policy sdk.SchemaObjectIdentifier
+	on     []string
+}
+
+func getTableRowAccessPolicy(from any) *tableRowAccessPolicy {
+	list, ok := from.([]any)
+	if !ok || len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	v := list[0].(map[string]any)
+	return &tableRowAccessPolicy{
+		policy: sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(v["policy_name"].(string)),
+		on:     expandStringList(v["on"].([]any)),
+	}
+}
+
+func (p *tableRowAccessPolicy) equals(other *tableRowAccessPolicy) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	return p.policy.FullyQualifiedName() == other.policy.FullyQualifiedName() && slices.Equal(p.on, other.on)
+}
+
+func (p *tableRowAccessPolicy) toConfig() []any {
+	if p == nil {
+		return nil
+	}
+	return []any{map[string]any{
+		"policy_name": p.policy.FullyQualifiedName(),
+		"on":          p.on,
+	}}
+}
+
+// readTableRowAccessPolicy returns the row access policy attached to the table, found in its policy references.
+func readTableRowAccessPolicy(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier) (*tableRowAccessPolicy, error) {
+	references, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(id, sdk.PolicyEntityDomainTable))
+	if err != nil {
+		return nil, fmt.Errorf("error reading policy references of %v err = %w", id.FullyQualifiedName(), err)
+	}
+	for _, reference := range references {
+		if reference.PolicyKind != "ROW_ACCESS_POLICY" || reference.PolicyDb == nil || reference.PolicySchema == nil {
+			continue
+		}
+		return &tableRowAccessPolicy{
+			policy: sdk.NewSchemaObjectIdentifier(*reference.PolicyDb, *reference.PolicySchema, reference.PolicyName),
+			on:     parseRefArgColumnNames(reference.RefArgColumnNames),
+		}, nil
+	}
+	return nil, nil
+}
+
+// parseRefArgColumnNames parses the REF_ARG_COLUMN_NAMES of a policy reference, e.g. [ "ID", "REGION" ].
+func parseRefArgColumnNames(raw *string) []string {
+	if raw == nil {
+		return nil
+	}
+	var names []string
+	if err := json.Unmarshal([]byte(*raw), &names); err == nil {
+		return names
+	}
+	for _, name := range strings.Split(strings.Trim(*raw, "[] "), ",") {
+		if name = strings.Trim(strings.TrimSpace(name), `"`); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// updateTableRowAccessPolicy attaches, detaches or replaces the row access policy. The replacement is done with a
+// single DROP ... , ADD ... statement, so the rows are never accessible without a policy.
+func updateTableRowAccessPolicy(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, old *tableRowAccessPolicy, new *tableRowAccessPolicy) error {
+	if old.equals(new) {
+		return nil
+	}
+	request := sdk.NewAlterTableRequest(id)
+	switch {
+	case old == nil:
+		request.WithAddRowAccessPolicy(sdk.NewTableAddRowAccessPolicyRequest(new.policy, snowflake.QuoteStringList(new.on)))
+	case new == nil:
+		request.WithDropRowAccessPolicy(sdk.NewTableDropRowAccessPolicyRequest(old.policy))
+	default:
+		request.WithDropAndAddRowAccessPolicy(sdk.NewTableDropAndAddRowAccessPolicyRequest(
+			*sdk.NewTableDropRowAccessPolicyRequest(old.policy),
+			*sdk.NewTableAddRowAccessPolicyRequest(new.policy, snowflake.QuoteStringList(new.on)),
+		))
+	}
+	if err := client.Tables.Alter(ctx, request); err != nil {
+		return fmt.Errorf("error updating row access policy of %v err = %w", id.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
+// searchOptimizationPath is a search access path of the search optimization service, e.g. EQUALITY on one column.
+type searchOptimizationPath struct {
+	method sdk.SearchOptimizationMethod
+	column string
+}
+
+func (p searchOptimizationPath) expression() string {
+	return fmt.Sprintf(`%s("%s")`, p.method, strings.ReplaceAll(p.column, `"`, `""`))
+}
+
+func getSearchOptimizationPaths(from any) []searchOptimizationPath {
+	set, ok := from.(*schema.Set)
+	if !ok {
+		return nil
+	}
+	paths := make([]searchOptimizationPath, 0, set.Len())
+	for _, p := range set.List() {
+		v := p.(map[string]any)
+		paths = append(paths, searchOptimizationPath{
+			method: sdk.SearchOptimizationMethod(v["method"].(string)),
+			column: v["column"].(string),
+		})
+	}
+	return paths
+}
+
+func searchOptimizationPathsToConfig(paths []searchOptimizationPath) []any {
+	config := make([]any, len(paths))
+	for i, p := range paths {
+		config[i] = map[string]any{
+			"method": string(p.method),
+			"column": p.column,
+		}
+	}
+	return config
+}
+
+func searchOptimizationPathsDiff(old []searchOptimizationPath, new []searchOptimizationPath) (removed []searchOptimizationPath, added []searchOptimizationPath) {
+	for _, p := range old {
+		if !slices.Contains(new, p) {
+			removed = append(removed, p)
+		}
+	}
+	for _, p := range new {
+		if !slices.Contains(old, p) {
+			added = append(added, p)
+		}
+	}
+	return removed, added
+}
+
+// readTableSearchOptimization returns the search access paths of the table. DESCRIBE SEARCH OPTIMIZATION is only
+// run for the tables with the search optimization turned on, as SHOW TABLES reports.
+func readTableSearchOptimization(ctx context.Context, client *sdk.Client, table *sdk.Table) ([]searchOptimizationPath, error) {
+	if !table.SearchOptimization {
+		return nil, nil
+	}
+	details, err := client.Tables.DescribeSearchOptimization(ctx, sdk.NewDescribeTableSearchOptimizationRequest(table.ID()))
+	if err != nil {
+		return nil, fmt.Errorf("error describing search optimization of %v err = %w", table.ID().FullyQualifiedName(), err)
+	}
+	paths := make([]searchOptimizationPath, 0, len(details))
+	for _, detail := range details {
+		target := detail.Target
+		if strings.HasPrefix(target, `"`) && strings.HasSuffix(target, `"`) && len(target) > 1 {
+			target = strings.ReplaceAll(target[1:len(target)-1], `""`, `"`)
+		}
+		paths = append(paths, searchOptimizationPath{method: detail.Method, column: target})
+	}
+	return paths, nil
+}
+
+func updateTableSearchOptimization(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, old []searchOptimizationPath, new []searchOptimizationPath) error {
+	removed, added := searchOptimizationPathsDiff(old, new)
+	if err := dropTableSearchOptimization(ctx, client, id, removed); err != nil {
+		return err
+	}
+	return addTableSearchOptimization(ctx, client, id, added)
+}
+
+func dropTableSearchOptimization(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, paths []searchOptimizationPath) error {
+	if len(paths) == 0 {
+		return nil
+	}
+	err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSearchOptimizationAction(sdk.NewTableSearchOptimizationActionRequest().WithDropSearchOptimizationOn(searchOptimizationExpressions(paths))))
+	if err != nil {
+		return fmt.Errorf("error dropping search optimization of %v err = %w", id.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
+func addTableSearchOptimization(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, paths []searchOptimizationPath) error {
+	if len(paths) == 0 {
+		return nil
+	}
+	err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSearchOptimizationAction(sdk.NewTableSearchOptimizationActionRequest().WithAddSearchOptimizationOn(searchOptimizationExpressions(paths))))
+	if err != nil {
+		return fmt.Errorf("error adding search optimization of %v err = %w", id.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
+func searchOptimizationExpressions(paths []searchOptimizationPath) []string {
+	expressions := make([]string, len(paths))
+	for i, p := range paths {
+		expressions[i] = p.expression()
+	}
+	return expressions
+}
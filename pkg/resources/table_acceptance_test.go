@@ -12,6 +12,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
@@ -1285,6 +1286,83 @@ resource "snowflake_table" "test_table" {
 	return fmt.Sprintf(s, tableName, databaseName, schemaName)
 }
 
+func TestAcc_Table_clone(t *testing.T) {
+	sourceName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	cloneName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tableCloneConfig(sourceName, cloneName, acc.TestDatabaseName, acc.TestSchemaName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.clone", "name", cloneName),
+					resource.TestCheckResourceAttr("snowflake_table.clone", "clone.0.source", sourceName),
+					resource.TestCheckResourceAttr("snowflake_table.clone", "comment", "cloned table"),
+					resource.TestCheckResourceAttr("snowflake_table.clone", "change_tracking", "true"),
+					resource.TestCheckResourceAttr("snowflake_table.clone", "column.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.clone", "column.0.name", "column1"),
+				),
+			},
+			// adding a column to the source does not affect the clone
+			{
+				Config: tableCloneConfig(sourceName, cloneName, acc.TestDatabaseName, acc.TestSchemaName, `
+	column {
+		name = "column2"
+		type = "VARIANT"
+	}`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_table.source", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("snowflake_table.clone", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.clone", "column.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func tableCloneConfig(sourceName string, cloneName string, databaseName string, schemaName string, additionalSourceColumns string) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "source" {
+	database = "%[3]s"
+	schema   = "%[4]s"
+	name     = "%[1]s"
+	comment  = "source table"
+	column {
+		name = "column1"
+		type = "VARIANT"
+	}%[5]s
+}
+
+resource "snowflake_table" "clone" {
+	database        = "%[3]s"
+	schema          = "%[4]s"
+	name            = "%[2]s"
+	comment         = "cloned table"
+	change_tracking = true
+	column {
+		name = "column1"
+		type = "VARIANT"
+	}
+	clone {
+		source = snowflake_table.source.name
+		at {
+			offset = 0
+		}
+	}
+}
+`, sourceName, cloneName, databaseName, schemaName, additionalSourceColumns)
+}
+
 func TestAcc_Table_MaskingPolicy(t *testing.T) {
 	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
@@ -0,0 +1,341 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// columnChangeKind tells how a change of the table columns can be applied.
+type columnChangeKind string
+
+const (
+	// columnChangeInPlace changes are applied with ALTER TABLE.
+	columnChangeInPlace columnChangeKind = "in-place"
+	// columnChangeRequiresRecreate changes can only be set when the table is created, so the table is recreated.
+	columnChangeRequiresRecreate columnChangeKind = "requires-recreate"
+	// columnChangeDisallowed changes are rejected at plan time: Snowflake cannot apply them in place and they are not
+	// worth silently recreating the table with all its data (e.g. narrowing a column or changing its default value), so
+	// they have to be reverted or the table replaced explicitly.
+	columnChangeDisallowed columnChangeKind = "disallowed"
+)
+
+type columnChange struct {
+	kind   columnChangeKind
+	column string
+	// attribute is the attribute of the column block the change is about.
+	attribute   string
+	description string
+}
+
+func (c columnChange) String() string {
+	return fmt.Sprintf("column %q: %s (%s)", c.column, c.description, c.kind)
+}
+
+// columnChangePlan is the classification of the differences between the old and the new column lists.
+type columnChangePlan struct {
+	changes []columnChange
+	// renamed maps the old names of the renamed columns to the new ones.
+	renamed map[string]string
+	// dropped are the names of the columns dropped in place.
+	dropped []string
+}
+
+func (p columnChangePlan) ofKind(kind columnChangeKind) []columnChange {
+	var changes []columnChange
+	for _, c := range p.changes {
+		if c.kind == kind {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+// planColumnChanges compares the old and the new columns of the table. The columns are matched by name. A new column
+// whose rename_from names an old column is treated as renamed, as long as the old name is gone from the new list and
+// the new name was not used before. Otherwise, the old column is dropped and the new one added.
+func planColumnChanges(old columns, new columns) columnChangePlan {
+	plan := columnChangePlan{renamed: make(map[string]string)}
+	oldNames := columnNames(old)
+	newNames := columnNames(new)
+	for _, n := range new {
+		if _, ok := plan.renamed[n.renameFrom]; ok || !slices.Contains(oldNames, n.renameFrom) ||
+			slices.Contains(newNames, n.renameFrom) || slices.Contains(oldNames, n.name) {
+			continue
+		}
+		plan.renamed[n.renameFrom] = n.name
+		plan.changes = append(plan.changes, columnChange{columnChangeInPlace, n.name, "name", fmt.Sprintf("rename from %q", n.renameFrom)})
+	}
+
+	var keptOldOrder, keptNewOrder []string
+	for _, o := range old {
+		name := o.name
+		if newName, ok := plan.renamed[name]; ok {
+			name = newName
+		}
+		i := slices.IndexFunc(new, func(c column) bool { return c.name == name })
+		if i < 0 {
+			plan.dropped = append(plan.dropped, o.name)
+			plan.changes = append(plan.changes, columnChange{columnChangeInPlace, o.name, "name", "drop"})
+			continue
+		}
+		keptOldOrder = append(keptOldOrder, name)
+		plan.changes = append(plan.changes, planColumnChange(o, new[i])...)
+	}
+	for _, n := range new {
+		if slices.Contains(keptOldOrder, n.name) {
+			keptNewOrder = append(keptNewOrder, n.name)
+			continue
+		}
+		if n._default != nil && n._default._type() != "constant" {
+			plan.changes = append(plan.changes, columnChange{columnChangeRequiresRecreate, n.name, "default", fmt.Sprintf("add with a default %s, only constant defaults can be added to an existing table", n._default._type())})
+			continue
+		}
+		plan.changes = append(plan.changes, columnChange{columnChangeInPlace, n.name, "name", "add"})
+	}
+	for i := range keptNewOrder {
+		if keptOldOrder[i] != keptNewOrder[i] {
+			plan.changes = append(plan.changes, columnChange{columnChangeRequiresRecreate, keptNewOrder[i], "name", fmt.Sprintf("move (the order of the kept columns: %s), the columns of an existing table cannot be reordered", strings.Join(keptNewOrder, ", "))})
+			break
+		}
+	}
+	return plan
+}
+
+func planColumnChange(old column, new column) []columnChange {
+	var changes []columnChange
+	change := func(kind columnChangeKind, attribute string, format string, args ...any) {
+		changes = append(changes, columnChange{kind, new.name, attribute, fmt.Sprintf(format, args...)})
+	}
+
+	if kind, reason := classifyColumnTypeChange(old.dataType, new.dataType); kind != "" {
+		change(kind, "type", "type %s -> %s%s", old.dataType, new.dataType, reason)
+	}
+	if old.nullable != new.nullable {
+		change(columnChangeInPlace, "nullable", "nullable %t -> %t", old.nullable, new.nullable)
+	}
+	if old.comment != new.comment {
+		change(columnChangeInPlace, "comment", "comment")
+	}
+	if old.maskingPolicy != new.maskingPolicy {
+		change(columnChangeInPlace, "masking_policy", "masking policy")
+	}
+	if !slices.Equal(old.tags, new.tags) {
+		change(columnChangeInPlace, "tag", "tags")
+	}
+	switch {
+	case columnDefaultsEqual(old._default, new._default):
+	case new._default == nil:
+		change(columnChangeInPlace, "default", "drop default")
+	case old._default != nil && old._default.sequence != nil && new._default.sequence != nil:
+		change(columnChangeInPlace, "default", "default sequence %s -> %s", *old._default.sequence, *new._default.sequence)
+	default:
+		change(columnChangeDisallowed, "default", "default, only the default sequence of a column can be changed or the default dropped in place")
+	}
+	if !columnIdentitiesEqual(old.identity, new.identity) {
+		change(columnChangeDisallowed, "identity", "identity, it can only be set when the column is created")
+	}
+	return changes
+}
+
+// classifyColumnTypeChange returns an empty kind for the equivalent types. Only the length of the text columns and the
+// precision of the number columns can be increased in place.
+func classifyColumnTypeChange(old string, new string) (columnChangeKind, string) {
+	oldType, newType := parseColumnType(old), parseColumnType(new)
+	switch {
+	case oldType == newType:
+		return "", ""
+	case oldType.base != newType.base:
+		return columnChangeRequiresRecreate, ", the type of a column can only be widened in place"
+	case oldType.base == sdk.DataTypeVARCHAR && newType.length > oldType.length:
+		return columnChangeInPlace, ""
+	case oldType.base == sdk.DataTypeVARCHAR:
+		return columnChangeDisallowed, ", the length of a text column cannot be decreased"
+	case oldType.base == sdk.DataTypeNumber && newType.scale != oldType.scale:
+		return columnChangeDisallowed, ", the scale of a number column cannot be changed"
+	case oldType.base == sdk.DataTypeNumber && newType.precision > oldType.precision:
+		return columnChangeInPlace, ""
+	case oldType.base == sdk.DataTypeNumber:
+		return columnChangeDisallowed, ", the precision of a number column cannot be decreased"
+	default:
+		return columnChangeRequiresRecreate, ", the type of a column can only be widened in place"
+	}
+}
+
+// columnType is the data type of a column with the defaults of its parameters filled in, e.g. INT is NUMBER(38,0).
+type columnType struct {
+	base      sdk.DataType
+	raw       string
+	length    int
+	precision int
+	scale     int
+}
+
+var columnTypeParameters = regexp.MustCompile(`^([A-Z_0-9 ]+?)\s*(?:\(\s*(\d+)\s*(?:,\s*(\d+)\s*)?\))?$`)
+
+func parseColumnType(s string) columnType {
+	normalized := strings.ToUpper(strings.TrimSpace(s))
+	matches := columnTypeParameters.FindStringSubmatch(normalized)
+	if matches == nil {
+		return columnType{raw: normalized}
+	}
+	name := matches[1]
+	first, hasFirst := atoiOrZero(matches[2])
+	second, _ := atoiOrZero(matches[3])
+
+	// the national variants of the text types (NCHAR, NVARCHAR, ...) are not known to the SDK
+	base, err := sdk.ToDataType(name)
+	if err != nil {
+		base, err = sdk.ToDataType(strings.TrimPrefix(name, "N"))
+	}
+	if err != nil {
+		return columnType{raw: normalized}
+	}
+	switch base {
+	case sdk.DataTypeVARCHAR:
+		t := columnType{base: base, length: 16777216}
+		if hasFirst {
+			t.length = first
+		} else if slices.Contains([]string{"CHAR", "CHARACTER", "NCHAR"}, name) {
+			t.length = 1
+		}
+		return t
+	case sdk.DataTypeNumber:
+		t := columnType{base: base, precision: 38}
+		if hasFirst && slices.Contains([]string{"NUMBER", "DECIMAL", "NUMERIC"}, name) {
+			t.precision, t.scale = first, second
+		}
+		return t
+	case sdk.DataTypeTimestampNTZ, sdk.DataTypeTimestampLTZ, sdk.DataTypeTimestampTZ, sdk.DataTypeTime:
+		t := columnType{base: base, precision: 9}
+		if hasFirst {
+			t.precision = first
+		}
+		return t
+	case sdk.DataTypeBinary:
+		t := columnType{base: base, length: 8388608}
+		if hasFirst {
+			t.length = first
+		}
+		return t
+	default:
+		return columnType{base: base}
+	}
+}
+
+func atoiOrZero(s string) (int, bool) {
+	if s == "" {
+		return 0, false
+	}
+	v, err := strconv.Atoi(s)
+	return v, err == nil
+}
+
+// suppressEquivalentColumnType suppresses the differences between the synonyms of a type, e.g. INT and NUMBER(38,0).
+func suppressEquivalentColumnType(_, old, new string, _ *schema.ResourceData) bool {
+	return old != "" && new != "" && parseColumnType(old) == parseColumnType(new)
+}
+
+func columnDefaultsEqual(a *columnDefault, b *columnDefault) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a._type() == b._type() && ((a.constant == nil || *a.constant == *b.constant) &&
+		(a.expression == nil || *a.expression == *b.expression) &&
+		(a.sequence == nil || *a.sequence == *b.sequence))
+}
+
+func columnIdentitiesEqual(a *columnIdentity, b *columnIdentity) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// withRenames returns the columns with the names of the renamed columns replaced by the new ones.
+func (c columns) withRenames(renamed map[string]string) columns {
+	to := slices.Clone(c)
+	for i := range to {
+		if newName, ok := renamed[to[i].name]; ok {
+			to[i].name = newName
+		}
+	}
+	return to
+}
+
+func columnNames(c columns) []string {
+	names := make([]string, len(c))
+	for i, column := range c {
+		names[i] = column.name
+	}
+	return names
+}
+
+// customizeTableColumnsDiff classifies the column changes at plan time: the changes requiring a recreation force a
+// new table, the disallowed ones fail the plan, and so does dropping a column with data, unless
+// allow_dropping_populated_columns is set.
+func customizeTableColumnsDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" || !d.HasChange("column") || !d.NewValueKnown("column") {
+		return nil
+	}
+	o, n := d.GetChange("column")
+	plan := planColumnChanges(getColumns(o), getColumns(n))
+	for _, c := range plan.changes {
+		log.Printf("[DEBUG] table %s: %s\n", d.Id(), c)
+	}
+
+	if disallowed := plan.ofKind(columnChangeDisallowed); len(disallowed) > 0 {
+		errs := make([]error, len(disallowed))
+		for i, c := range disallowed {
+			errs[i] = errors.New(c.String())
+		}
+		return fmt.Errorf("the column changes of table %s cannot be applied, revert them or replace the table explicitly (e.g. with terraform apply -replace): %w", d.Id(), errors.Join(errs...))
+	}
+	if recreate := plan.ofKind(columnChangeRequiresRecreate); len(recreate) > 0 {
+		newNames := columnNames(getColumns(n))
+		for _, c := range recreate {
+			log.Printf("[INFO] table %s is recreated because of %s\n", d.Id(), c)
+			// ForceNew of the whole list only looks at its length, so the changed attributes of the columns are marked
+			i := slices.Index(newNames, c.column)
+			for _, attribute := range []string{c.attribute, "name", "type", "default", "identity"} {
+				if key := fmt.Sprintf("column.%d.%s", i, attribute); d.HasChange(key) {
+					if err := d.ForceNew(key); err != nil {
+						return err
+					}
+					break
+				}
+			}
+		}
+		return nil
+	}
+
+	if len(plan.dropped) == 0 || d.Get("allow_dropping_populated_columns").(bool) {
+		return nil
+	}
+	db, ok := meta.(*sql.DB)
+	if !ok {
+		return nil
+	}
+	client := sdk.NewClientFromDB(db)
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	for _, name := range plan.dropped {
+		populated, err := client.Tables.ColumnIsPopulated(ctx, id, name)
+		if err != nil {
+			return fmt.Errorf("unable to check if column %q of table %s has data, set allow_dropping_populated_columns to drop it without the check: %w", name, id.FullyQualifiedName(), err)
+		}
+		if populated {
+			return fmt.Errorf("column %q of table %s has data, set allow_dropping_populated_columns to drop it", name, id.FullyQualifiedName())
+		}
+	}
+	return nil
+}
@@ -1,10 +1,12 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -31,10 +33,10 @@ var tableColumnMaskingPolicyApplicationSchema = map[string]*schema.Schema{
 
 func TableColumnMaskingPolicyApplication() *schema.Resource {
 	return &schema.Resource{
-		Description: "Applies a masking policy to a table column.",
-		Create:      CreateTableColumnMaskingPolicyApplication,
-		Read:        ReadTableColumnMaskingPolicyApplication,
-		Delete:      DeleteTableColumnMaskingPolicyApplication,
+		Description:   "Applies a masking policy to a table column.",
+		CreateContext: CreateTableColumnMaskingPolicyApplication,
+		ReadContext:   ReadTableColumnMaskingPolicyApplication,
+		DeleteContext: DeleteTableColumnMaskingPolicyApplication,
 
 		Schema: tableColumnMaskingPolicyApplicationSchema,
 		Importer: &schema.ResourceImporter{
@@ -43,8 +45,8 @@ func TableColumnMaskingPolicyApplication() *schema.Resource {
 	}
 }
 
-// CreateTableColumnMaskingPolicyApplication implements schema.CreateFunc.
-func CreateTableColumnMaskingPolicyApplication(d *schema.ResourceData, meta interface{}) error {
+// CreateTableColumnMaskingPolicyApplication implements schema.CreateContextFunc.
+func CreateTableColumnMaskingPolicyApplication(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	manager := snowflake.NewTableColumnMaskingPolicyApplicationManager()
 
 	input := &snowflake.TableColumnMaskingPolicyApplicationCreateInput{
@@ -58,9 +60,9 @@ func CreateTableColumnMaskingPolicyApplication(d *schema.ResourceData, meta inte
 	stmt := manager.Create(input)
 
 	db := meta.(*sql.DB)
-	_, err := db.Exec(stmt)
+	_, err := db.ExecContext(ctx, stmt)
 	if err != nil {
-		return fmt.Errorf("error applying masking policy: %w", err)
+		return diag.FromErr(fmt.Errorf("error applying masking policy: %w", err))
 	}
 
 	d.SetId(TableColumnMaskingPolicyApplicationID(&input.TableColumnMaskingPolicyApplication))
@@ -68,17 +70,17 @@ func CreateTableColumnMaskingPolicyApplication(d *schema.ResourceData, meta inte
 	return nil
 }
 
-// ReadTableColumnMaskingPolicyApplication implements schema.ReadFunc.
-func ReadTableColumnMaskingPolicyApplication(d *schema.ResourceData, meta interface{}) error {
+// ReadTableColumnMaskingPolicyApplication implements schema.ReadContextFunc.
+func ReadTableColumnMaskingPolicyApplication(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	manager := snowflake.NewTableColumnMaskingPolicyApplicationManager()
 
 	table, column := TableColumnMaskingPolicyApplicationIdentifier(d.Id())
 
 	if err := d.Set("table", table.QualifiedName()); err != nil {
-		return fmt.Errorf("error setting table: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting table: %w", err))
 	}
 	if err := d.Set("column", column); err != nil {
-		return fmt.Errorf("error setting column: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting column: %w", err))
 	}
 
 	input := &snowflake.TableColumnMaskingPolicyApplicationReadInput{
@@ -89,26 +91,26 @@ func ReadTableColumnMaskingPolicyApplication(d *schema.ResourceData, meta interf
 	stmt := manager.Read(input)
 
 	db := meta.(*sql.DB)
-	rows, err := db.Query(stmt)
+	rows, err := db.QueryContext(ctx, stmt)
 	if err != nil {
-		return fmt.Errorf("error querying password policy: %w", err)
+		return diag.FromErr(fmt.Errorf("error querying password policy: %w", err))
 	}
 
 	defer rows.Close()
 	maskingPolicy, err := manager.Parse(rows, column)
 	if err != nil {
-		return fmt.Errorf("failed to parse result of describe: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to parse result of describe: %w", err))
 	}
 
 	if err = d.Set("masking_policy", maskingPolicy); err != nil {
-		return fmt.Errorf("error setting masking_policy: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting masking_policy: %w", err))
 	}
 
 	return nil
 }
 
-// DeleteTableColumnMaskingPolicyApplication implements schema.DeleteFunc.
-func DeleteTableColumnMaskingPolicyApplication(d *schema.ResourceData, meta interface{}) error {
+// DeleteTableColumnMaskingPolicyApplication implements schema.DeleteContextFunc.
+func DeleteTableColumnMaskingPolicyApplication(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	manager := snowflake.NewTableColumnMaskingPolicyApplicationManager()
 
 	input := &snowflake.TableColumnMaskingPolicyApplicationDeleteInput{
@@ -121,9 +123,9 @@ func DeleteTableColumnMaskingPolicyApplication(d *schema.ResourceData, meta inte
 	stmt := manager.Delete(input)
 
 	db := meta.(*sql.DB)
-	_, err := db.Exec(stmt)
+	_, err := db.ExecContext(ctx, stmt)
 	if err != nil {
-		return fmt.Errorf("error executing drop statement: %w", err)
+		return diag.FromErr(fmt.Errorf("error executing drop statement: %w", err))
 	}
 
 	return nil
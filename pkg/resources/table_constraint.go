@@ -9,6 +9,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -178,10 +179,10 @@ var tableConstraintSchema = map[string]*schema.Schema{
 
 func TableConstraint() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateTableConstraint,
-		Read:   ReadTableConstraint,
-		Update: UpdateTableConstraint,
-		Delete: DeleteTableConstraint,
+		CreateContext: CreateTableConstraint,
+		ReadContext:   ReadTableConstraint,
+		UpdateContext: UpdateTableConstraint,
+		DeleteContext: DeleteTableConstraint,
 
 		Schema: tableConstraintSchema,
 		Importer: &schema.ResourceImporter{
@@ -219,10 +220,9 @@ func getTableIdentifier(s string) (*sdk.SchemaObjectIdentifier, error) {
 	return &tableIdentifier, nil
 }
 
-// CreateTableConstraint implements schema.CreateFunc.
-func CreateTableConstraint(d *schema.ResourceData, meta interface{}) error {
+// CreateTableConstraint implements schema.CreateContextFunc.
+func CreateTableConstraint(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	name := d.Get("name").(string)
@@ -231,12 +231,12 @@ func CreateTableConstraint(d *schema.ResourceData, meta interface{}) error {
 
 	tableIdentifier, err := getTableIdentifier(tableID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	constraintType, err := sdk.ToColumnConstraintType(cType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	constraintRequest := sdk.NewOutOfLineConstraintRequest(constraintType).WithName(&name)
 
@@ -282,11 +282,11 @@ func CreateTableConstraint(d *schema.ResourceData, meta interface{}) error {
 		fkTableID := references["table_id"].(string)
 		fkId, err := helpers.DecodeSnowflakeParameterID(fkTableID)
 		if err != nil {
-			return fmt.Errorf("table id is incorrect: %s, err: %w", fkTableID, err)
+			return diag.FromErr(fmt.Errorf("table id is incorrect: %s, err: %w", fkTableID, err))
 		}
 		referencedTableIdentifier, ok := fkId.(sdk.SchemaObjectIdentifier)
 		if !ok {
-			return fmt.Errorf("table id is incorrect: %s", fkId)
+			return diag.FromErr(fmt.Errorf("table id is incorrect: %s", fkId))
 		}
 
 		cols := references["columns"].([]interface{})
@@ -298,17 +298,17 @@ func CreateTableConstraint(d *schema.ResourceData, meta interface{}) error {
 
 		matchType, err := sdk.ToMatchType(foreignKeyProperties["match"].(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		foreignKeyRequest.WithMatch(&matchType)
 
 		onUpdate, err := sdk.ToForeignKeyAction(foreignKeyProperties["on_update"].(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		onDelete, err := sdk.ToForeignKeyAction(foreignKeyProperties["on_delete"].(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		foreignKeyRequest.WithOn(sdk.NewForeignKeyOnAction().
 			WithOnDelete(&onDelete).
@@ -320,7 +320,7 @@ func CreateTableConstraint(d *schema.ResourceData, meta interface{}) error {
 	alterStatement := sdk.NewAlterTableRequest(*tableIdentifier).WithConstraintAction(sdk.NewTableConstraintActionRequest().WithAdd(constraintRequest))
 	err = client.Tables.Alter(ctx, alterStatement)
 	if err != nil {
-		return fmt.Errorf("error creating table constraint %v err = %w", name, err)
+		return diag.FromErr(fmt.Errorf("error creating table constraint %v err = %w", name, err))
 	}
 
 	tc := tableConstraintID{
@@ -330,11 +330,11 @@ func CreateTableConstraint(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(tc.String())
 
-	return ReadTableConstraint(d, meta)
+	return ReadTableConstraint(ctx, d, meta)
 }
 
-// ReadTableConstraint implements schema.ReadFunc.
-func ReadTableConstraint(_ *schema.ResourceData, _ interface{}) error {
+// ReadTableConstraint implements schema.ReadContextFunc.
+func ReadTableConstraint(ctx context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
 	// commenting this out since it requires an active warehouse to be set which may not be intuitive.
 	// also it takes a while for the database to reflect changes. Would likely need to add a validation
 	// step like in tag association. People don't like waiting 40 minutes for Terraform to run.
@@ -353,10 +353,9 @@ func ReadTableConstraint(_ *schema.ResourceData, _ interface{}) error {
 	return nil
 }
 
-// UpdateTableConstraint implements schema.UpdateFunc.
-func UpdateTableConstraint(d *schema.ResourceData, meta interface{}) error {
+// UpdateTableConstraint implements schema.UpdateContextFunc.
+func UpdateTableConstraint(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	tc := tableConstraintID{}
@@ -364,7 +363,7 @@ func UpdateTableConstraint(d *schema.ResourceData, meta interface{}) error {
 
 	tableIdentifier, err := getTableIdentifier(tc.tableID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("name") {
@@ -373,17 +372,16 @@ func UpdateTableConstraint(d *schema.ResourceData, meta interface{}) error {
 		alterStatement := sdk.NewAlterTableRequest(*tableIdentifier).WithConstraintAction(sdk.NewTableConstraintActionRequest().WithRename(constraintRequest))
 		err = client.Tables.Alter(ctx, alterStatement)
 		if err != nil {
-			return fmt.Errorf("error renaming table constraint %s err = %w", tc.name, err)
+			return diag.FromErr(fmt.Errorf("error renaming table constraint %s err = %w", tc.name, err))
 		}
 	}
 
-	return ReadTableConstraint(d, meta)
+	return ReadTableConstraint(ctx, d, meta)
 }
 
-// DeleteTableConstraint implements schema.DeleteFunc.
-func DeleteTableConstraint(d *schema.ResourceData, meta interface{}) error {
+// DeleteTableConstraint implements schema.DeleteContextFunc.
+func DeleteTableConstraint(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	tc := tableConstraintID{}
@@ -391,7 +389,7 @@ func DeleteTableConstraint(d *schema.ResourceData, meta interface{}) error {
 
 	tableIdentifier, err := getTableIdentifier(tc.tableID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dropRequest := sdk.NewTableConstraintDropActionRequest().WithConstraintName(&tc.name)
@@ -403,7 +401,7 @@ func DeleteTableConstraint(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error dropping table constraint %v err = %w", tc.name, err)
+		return diag.FromErr(fmt.Errorf("error dropping table constraint %v err = %w", tc.name, err))
 	}
 
 	d.SetId("")
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -109,10 +110,10 @@ var tableGrantSchema = map[string]*schema.Schema{
 func TableGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateTableGrant,
-			Read:               ReadTableGrant,
-			Delete:             DeleteTableGrant,
-			Update:             UpdateTableGrant,
+			CreateContext:      CreateTableGrant,
+			ReadContext:        ReadTableGrant,
+			DeleteContext:      DeleteTableGrant,
+			UpdateContext:      UpdateTableGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             tableGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -158,8 +159,8 @@ func TableGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateTableGrant implements schema.CreateFunc.
-func CreateTableGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateTableGrant implements schema.CreateContextFunc.
+func CreateTableGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tableName := d.Get("table_name").(string)
 	var schemaName string
 	if _, ok := d.GetOk("schema_name"); ok {
@@ -170,17 +171,17 @@ func CreateTableGrant(d *schema.ResourceData, meta interface{}) error {
 	onFuture := d.Get("on_future").(bool)
 	onAll := d.Get("on_all").(bool)
 	if onFuture && onAll {
-		return errors.New("on_future and on_all cannot both be true")
+		return diag.FromErr(errors.New("on_future and on_all cannot both be true"))
 	}
 	withGrantOption := d.Get("with_grant_option").(bool)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 	shares := expandStringList(d.Get("shares").(*schema.Set).List())
 	if (schemaName == "") && !onFuture && !onAll {
-		return errors.New("schema_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future or on_all is true"))
 	}
 
 	if (tableName == "") && !onFuture && !onAll {
-		return errors.New("table_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("table_name must be set unless on_future or on_all is true"))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -193,17 +194,17 @@ func CreateTableGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.TableGrant(databaseName, schemaName, tableName)
 	}
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, tableName, privilege, withGrantOption, onFuture, onAll, roles, shares)
 	d.SetId(grantID)
-	return ReadTableGrant(d, meta)
+	return ReadTableGrant(ctx, d, meta)
 }
 
-// ReadTableGrant implements schema.ReadFunc.
-func ReadTableGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadTableGrant implements schema.ReadContextFunc.
+func ReadTableGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	tableName := d.Get("table_name").(string)
@@ -223,9 +224,9 @@ func ReadTableGrant(d *schema.ResourceData, meta interface{}) error {
 	default:
 		builder = snowflake.TableGrant(databaseName, schemaName, tableName)
 	}
-	err := readGenericGrant(d, meta, tableGrantSchema, builder, onFuture, onAll, validTablePrivileges)
+	err := readGenericGrant(ctx, d, meta, tableGrantSchema, builder, onFuture, onAll, validTablePrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, tableName, privilege, withGrantOption, onFuture, onAll, roles, shares)
@@ -235,8 +236,8 @@ func ReadTableGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteTableGrant implements schema.DeleteFunc.
-func DeleteTableGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteTableGrant implements schema.DeleteContextFunc.
+func DeleteTableGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var builder snowflake.GrantBuilder
 	onFuture := d.Get("on_future").(bool)
 	onAll := d.Get("on_all").(bool)
@@ -251,11 +252,11 @@ func DeleteTableGrant(d *schema.ResourceData, meta interface{}) error {
 	default:
 		builder = snowflake.TableGrant(databaseName, schemaName, tableName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateTableGrant implements schema.UpdateFunc.
-func UpdateTableGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateTableGrant implements schema.UpdateContextFunc.
+func UpdateTableGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update, and we're done
 	if !d.HasChanges("roles", "shares") {
@@ -305,6 +306,7 @@ func UpdateTableGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
+		ctx,
 		meta,
 		builder,
 		privilege,
@@ -312,11 +314,12 @@ func UpdateTableGrant(d *schema.ResourceData, meta interface{}) error {
 		rolesToRevoke,
 		sharesToRevoke,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// then add
 	if err := createGenericGrantRolesAndShares(
+		ctx,
 		meta,
 		builder,
 		privilege,
@@ -324,9 +327,9 @@ func UpdateTableGrant(d *schema.ResourceData, meta interface{}) error {
 		rolesToAdd,
 		sharesToAdd,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadTableGrant(d, meta)
+	return ReadTableGrant(ctx, d, meta)
 }
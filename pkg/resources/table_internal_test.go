@@ -0,0 +1,305 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRefArgColumnNames(t *testing.T) {
+	assert.Nil(t, parseRefArgColumnNames(nil))
+	assert.Equal(t, []string{"ID", "region"}, parseRefArgColumnNames(sdk.String(`[ "ID", "region" ]`)))
+	assert.Equal(t, []string{"ID", "REGION"}, parseRefArgColumnNames(sdk.String(`[ID, REGION]`)))
+}
+
+func TestTableTagsDiff(t *testing.T) {
+	tableID := sdk.NewSchemaObjectIdentifier("db", "schema", "table")
+	old := tags{
+		{name: "owner", value: "data", database: "governance", schema: "tags"},
+		{name: "pii", value: "false"},
+		{name: "removed", value: "x"},
+	}
+	new := tags{
+		{name: "owner", value: "data", database: "governance", schema: "tags"},
+		{name: "pii", value: "true"},
+		{name: "added", value: "y", database: "governance", schema: "tags"},
+	}
+
+	unsetTags, setTags := tableTagsDiff(tableID, old, new)
+	assert.Equal(t, []sdk.ObjectIdentifier{sdk.NewSchemaObjectIdentifier("db", "schema", "removed")}, unsetTags)
+	assert.Equal(t, []sdk.TagAssociation{
+		{Name: sdk.NewSchemaObjectIdentifier("governance", "tags", "added"), Value: "y"},
+		{Name: sdk.NewSchemaObjectIdentifier("db", "schema", "pii"), Value: "true"},
+	}, setTags)
+}
+
+func TestSearchOptimizationPathsDiff(t *testing.T) {
+	old := []searchOptimizationPath{
+		{method: sdk.SearchOptimizationMethodEquality, column: "ID"},
+		{method: sdk.SearchOptimizationMethodSubstring, column: "NAME"},
+	}
+	new := []searchOptimizationPath{
+		{method: sdk.SearchOptimizationMethodEquality, column: "ID"},
+		{method: sdk.SearchOptimizationMethodEquality, column: "NAME"},
+	}
+
+	removed, added := searchOptimizationPathsDiff(old, new)
+	assert.Equal(t, []searchOptimizationPath{{method: sdk.SearchOptimizationMethodSubstring, column: "NAME"}}, removed)
+	assert.Equal(t, []searchOptimizationPath{{method: sdk.SearchOptimizationMethodEquality, column: "NAME"}}, added)
+	assert.Equal(t, []string{`EQUALITY("ID")`, `SUBSTRING("NAME")`}, searchOptimizationExpressions(old))
+	assert.Equal(t, `EQUALITY("say ""hi""")`, searchOptimizationPath{method: sdk.SearchOptimizationMethodEquality, column: `say "hi"`}.expression())
+}
+
+func TestUpdateTableRowAccessPolicy(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := sdk.NewSchemaObjectIdentifier("db", "schema", "table")
+	regionPolicy := &tableRowAccessPolicy{policy: sdk.NewSchemaObjectIdentifier("db", "policies", "by_region"), on: []string{"REGION"}}
+	tenantPolicy := &tableRowAccessPolicy{policy: sdk.NewSchemaObjectIdentifier("db", "policies", "by_tenant"), on: []string{"TENANT", "REGION"}}
+
+	mock.ExpectExec(`ALTER TABLE "db"."schema"."table" ADD ROW ACCESS POLICY "db"."policies"."by_region" ON ("REGION")`).WillReturnResult(sqlmock.NewResult(0, 0))
+	require.NoError(t, updateTableRowAccessPolicy(ctx, client, id, nil, regionPolicy))
+
+	mock.ExpectExec(`ALTER TABLE "db"."schema"."table" DROP ROW ACCESS POLICY "db"."policies"."by_region", ADD ROW ACCESS POLICY "db"."policies"."by_tenant" ON ("TENANT", "REGION")`).WillReturnResult(sqlmock.NewResult(0, 0))
+	require.NoError(t, updateTableRowAccessPolicy(ctx, client, id, regionPolicy, tenantPolicy))
+
+	// no statement for an unchanged policy
+	require.NoError(t, updateTableRowAccessPolicy(ctx, client, id, tenantPolicy, &tableRowAccessPolicy{policy: tenantPolicy.policy, on: []string{"TENANT", "REGION"}}))
+
+	mock.ExpectExec(`ALTER TABLE "db"."schema"."table" DROP ROW ACCESS POLICY "db"."policies"."by_tenant"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	require.NoError(t, updateTableRowAccessPolicy(ctx, client, id, tenantPolicy, nil))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestParseColumnType(t *testing.T) {
+	assert.Equal(t, parseColumnType("NUMBER(38,0)"), parseColumnType("int"))
+	assert.Equal(t, parseColumnType("NUMBER"), parseColumnType("DECIMAL(38, 0)"))
+	assert.Equal(t, parseColumnType("VARCHAR(16777216)"), parseColumnType("NVARCHAR"))
+	assert.Equal(t, parseColumnType("VARCHAR(16777216)"), parseColumnType("string"))
+	assert.Equal(t, parseColumnType("VARCHAR(1)"), parseColumnType("NCHAR"))
+	assert.Equal(t, parseColumnType("TIMESTAMP_NTZ(9)"), parseColumnType("DATETIME"))
+	assert.Equal(t, parseColumnType("FLOAT"), parseColumnType("DOUBLE"))
+	assert.NotEqual(t, parseColumnType("NUMBER(10,2)"), parseColumnType("NUMBER(10,0)"))
+	assert.NotEqual(t, parseColumnType("VARIANT"), parseColumnType("OBJECT"))
+	assert.NotEqual(t, parseColumnType("VECTOR(INT, 3)"), parseColumnType("VECTOR(INT, 4)"))
+}
+
+func TestPlanColumnChanges(t *testing.T) {
+	sequence := func(name string) *columnDefault { return &columnDefault{sequence: sdk.String(name)} }
+	constant := func(value string) *columnDefault { return &columnDefault{constant: sdk.String(value)} }
+
+	testCases := []struct {
+		name     string
+		old, new columns
+		expected []columnChange
+	}{
+		{
+			name: "no changes for the equivalent types",
+			old:  columns{{name: "ID", dataType: "NUMBER(38,0)"}, {name: "NAME", dataType: "VARCHAR(16777216)"}},
+			new:  columns{{name: "ID", dataType: "INT"}, {name: "NAME", dataType: "STRING"}},
+		},
+		{
+			name: "in-place changes",
+			old:  columns{{name: "ID", dataType: "NUMBER(10,0)", _default: sequence("S1")}, {name: "NAME", dataType: "VARCHAR(10)", nullable: true, _default: constant("x")}},
+			new:  columns{{name: "ID", dataType: "NUMBER(20,0)", _default: sequence("S2")}, {name: "NAME", dataType: "VARCHAR(20)", comment: "name"}},
+			expected: []columnChange{
+				{columnChangeInPlace, "ID", "type", "type NUMBER(10,0) -> NUMBER(20,0)"},
+				{columnChangeInPlace, "ID", "default", "default sequence S1 -> S2"},
+				{columnChangeInPlace, "NAME", "type", "type VARCHAR(10) -> VARCHAR(20)"},
+				{columnChangeInPlace, "NAME", "nullable", "nullable true -> false"},
+				{columnChangeInPlace, "NAME", "comment", "comment"},
+				{columnChangeInPlace, "NAME", "default", "drop default"},
+			},
+		},
+		{
+			name: "rename, drop and add",
+			old:  columns{{name: "ID", dataType: "INT"}, {name: "NAME", dataType: "VARCHAR(50)"}, {name: "OLD", dataType: "TEXT"}},
+			new:  columns{{name: "ID", dataType: "INT"}, {name: "FULL_NAME", renameFrom: "NAME", dataType: "VARCHAR(100)"}, {name: "CREATED", dataType: "DATE", _default: constant("2024-01-01")}},
+			expected: []columnChange{
+				{columnChangeInPlace, "FULL_NAME", "name", `rename from "NAME"`},
+				{columnChangeInPlace, "FULL_NAME", "type", "type VARCHAR(50) -> VARCHAR(100)"},
+				{columnChangeInPlace, "OLD", "name", "drop"},
+				{columnChangeInPlace, "CREATED", "name", "add"},
+			},
+		},
+		{
+			name: "name change without rename_from",
+			old:  columns{{name: "ID", dataType: "INT"}, {name: "NAME", dataType: "TEXT"}},
+			new:  columns{{name: "ID", dataType: "INT"}, {name: "FULL_NAME", dataType: "TEXT"}},
+			expected: []columnChange{
+				{columnChangeInPlace, "NAME", "name", "drop"},
+				{columnChangeInPlace, "FULL_NAME", "name", "add"},
+			},
+		},
+		{
+			name: "rename_from of a kept column",
+			old:  columns{{name: "ID", dataType: "INT"}, {name: "NAME", dataType: "TEXT"}},
+			new:  columns{{name: "ID", dataType: "INT"}, {name: "NAME", dataType: "TEXT"}, {name: "FULL_NAME", renameFrom: "NAME", dataType: "TEXT"}},
+			expected: []columnChange{
+				{columnChangeInPlace, "FULL_NAME", "name", "add"},
+			},
+		},
+		{
+			name: "changes requiring the recreation",
+			old:  columns{{name: "ID", dataType: "INT"}, {name: "NAME", dataType: "TEXT"}, {name: "PAYLOAD", dataType: "VARIANT"}},
+			new:  columns{{name: "NAME", dataType: "TEXT"}, {name: "ID", dataType: "INT"}, {name: "PAYLOAD", dataType: "OBJECT"}, {name: "CREATED", dataType: "TIMESTAMP_NTZ", _default: &columnDefault{expression: sdk.String("CURRENT_TIMESTAMP()")}}},
+			expected: []columnChange{
+				{columnChangeRequiresRecreate, "PAYLOAD", "type", "type VARIANT -> OBJECT, the type of a column can only be widened in place"},
+				{columnChangeRequiresRecreate, "CREATED", "default", "add with a default expression, only constant defaults can be added to an existing table"},
+				{columnChangeRequiresRecreate, "NAME", "name", "move (the order of the kept columns: NAME, ID, PAYLOAD), the columns of an existing table cannot be reordered"},
+			},
+		},
+		{
+			name: "disallowed changes",
+			old:  columns{{name: "NAME", dataType: "VARCHAR(20)"}, {name: "PRICE", dataType: "NUMBER(10,2)"}, {name: "QUANTITY", dataType: "NUMBER(10,0)", _default: constant("0")}},
+			new:  columns{{name: "NAME", dataType: "VARCHAR(10)"}, {name: "PRICE", dataType: "NUMBER(12,4)"}, {name: "QUANTITY", dataType: "NUMBER(5,0)", _default: constant("1"), identity: &columnIdentity{1, 1}}},
+			expected: []columnChange{
+				{columnChangeDisallowed, "NAME", "type", "type VARCHAR(20) -> VARCHAR(10), the length of a text column cannot be decreased"},
+				{columnChangeDisallowed, "PRICE", "type", "type NUMBER(10,2) -> NUMBER(12,4), the scale of a number column cannot be changed"},
+				{columnChangeDisallowed, "QUANTITY", "type", "type NUMBER(10,0) -> NUMBER(5,0), the precision of a number column cannot be decreased"},
+				{columnChangeDisallowed, "QUANTITY", "default", "default, only the default sequence of a column can be changed or the default dropped in place"},
+				{columnChangeDisallowed, "QUANTITY", "identity", "identity, it can only be set when the column is created"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, planColumnChanges(tc.old, tc.new).changes)
+		})
+	}
+
+	t.Run("renamed and dropped columns", func(t *testing.T) {
+		old := columns{{name: "ID", dataType: "INT"}, {name: "NAME", dataType: "TEXT"}, {name: "OLD", dataType: "TEXT"}}
+		new := columns{{name: "ID", dataType: "INT"}, {name: "FULL_NAME", renameFrom: "NAME", dataType: "TEXT"}}
+
+		plan := planColumnChanges(old, new)
+		assert.Equal(t, map[string]string{"NAME": "FULL_NAME"}, plan.renamed)
+		assert.Equal(t, []string{"OLD"}, plan.dropped)
+		assert.Equal(t, []string{"ID", "FULL_NAME", "OLD"}, columnNames(old.withRenames(plan.renamed)))
+	})
+}
+
+func TestCustomizeTableColumnsDiff(t *testing.T) {
+	config := func(columns ...any) map[string]any {
+		return map[string]any{"database": "db", "schema": "schema", "name": "table", "column": columns}
+	}
+	idColumn := map[string]any{"name": "ID", "type": "NUMBER(38,0)"}
+	state := schema.TestResourceDataRaw(t, tableSchema, config(idColumn, map[string]any{"name": "NOTES", "type": "VARCHAR(100)"}))
+	state.SetId(helpers.EncodeSnowflakeID(sdk.NewSchemaObjectIdentifier("db", "schema", "table")))
+
+	diff := func(t *testing.T, db *sql.DB, config map[string]any) (*terraform.InstanceDiff, error) {
+		t.Helper()
+		return Table().Diff(context.Background(), state.State(), terraform.NewResourceConfigRaw(config), db)
+	}
+
+	t.Run("in-place change", func(t *testing.T) {
+		d, err := diff(t, nil, config(idColumn, map[string]any{"name": "NOTES", "type": "VARCHAR(200)"}))
+		require.NoError(t, err)
+		assert.False(t, d.RequiresNew())
+	})
+
+	t.Run("change requiring the recreation", func(t *testing.T) {
+		d, err := diff(t, nil, config(idColumn, map[string]any{"name": "NOTES", "type": "VARIANT"}))
+		require.NoError(t, err)
+		assert.True(t, d.RequiresNew())
+	})
+
+	t.Run("reordered columns", func(t *testing.T) {
+		d, err := diff(t, nil, config(map[string]any{"name": "NOTES", "type": "VARCHAR(100)"}, idColumn))
+		require.NoError(t, err)
+		assert.True(t, d.RequiresNew())
+	})
+
+	t.Run("disallowed change", func(t *testing.T) {
+		_, err := diff(t, nil, config(idColumn, map[string]any{"name": "NOTES", "type": "VARCHAR(10)"}))
+		require.ErrorContains(t, err, `column "NOTES": type VARCHAR(100) -> VARCHAR(10), the length of a text column cannot be decreased (disallowed)`)
+	})
+
+	t.Run("dropping populated column", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+		query := `SELECT EXISTS (SELECT 1 FROM "db"."schema"."table" WHERE "NOTES" IS NOT NULL) AS "POPULATED"`
+
+		mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"POPULATED"}).AddRow(false))
+		_, err = diff(t, db, config(idColumn))
+		require.NoError(t, err)
+
+		mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"POPULATED"}).AddRow(true))
+		_, err = diff(t, db, config(idColumn))
+		require.ErrorContains(t, err, `column "NOTES" of table "db"."schema"."table" has data, set allow_dropping_populated_columns to drop it`)
+
+		allowed := config(idColumn)
+		allowed["allow_dropping_populated_columns"] = true
+		_, err = diff(t, db, allowed)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestReadTableTags(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := sdk.NewSchemaObjectIdentifier("db", "schema", "table")
+	referenceColumns := []string{"TAG_DATABASE", "TAG_SCHEMA", "TAG_NAME", "TAG_VALUE", "LEVEL", "COLUMN_NAME"}
+
+	t.Run("table", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT TAG_DATABASE, TAG_SCHEMA, TAG_NAME, TAG_VALUE, LEVEL, COLUMN_NAME FROM TABLE("db".INFORMATION_SCHEMA.TAG_REFERENCES('"db"."schema"."table"', 'TABLE'))`).
+			WillReturnRows(sqlmock.NewRows(referenceColumns).
+				AddRow("db", "tags", "owner", "team", "TABLE", nil).
+				AddRow("db", "schema", "department", "sales", "TABLE", nil).
+				AddRow("db", "tags", "cost_center", "1", "SCHEMA", nil))
+		read, err := readTableTags(ctx, client, id, tags{{name: "department", value: "finance"}, {name: "removed", value: "x"}})
+		require.NoError(t, err)
+		// the known tag keeps its form and comes first, the tag set outside of Terraform is added and the inherited one is left out
+		assert.Equal(t, []any{
+			tableTagConfig("department", "sales", "", ""),
+			tableTagConfig("owner", "team", "db", "tags"),
+		}, read)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("columns", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT TAG_DATABASE, TAG_SCHEMA, TAG_NAME, TAG_VALUE, LEVEL, COLUMN_NAME FROM TABLE("db".INFORMATION_SCHEMA.TAG_REFERENCES_ALL_COLUMNS('"db"."schema"."table"', 'TABLE'))`).
+			WillReturnRows(sqlmock.NewRows(referenceColumns).
+				AddRow("db", "tags", "pii", "email", "COLUMN", "EMAIL").
+				AddRow("db", "tags", "owner", "team", "TABLE", "ID"))
+		described := []any{map[string]any{"name": "ID"}, map[string]any{"name": "EMAIL"}}
+		read, err := readTableColumnTags(ctx, client, id, described, columns{{name: "ID", tags: tags{{name: "pii", value: "id", database: "db", schema: "tags"}}}})
+		require.NoError(t, err)
+		assert.Equal(t, []any{
+			map[string]any{"name": "ID", "tag": []any{}},
+			map[string]any{"name": "EMAIL", "tag": []any{tableTagConfig("pii", "email", "db", "tags")}},
+		}, read)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("insufficient privileges", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT TAG_DATABASE, TAG_SCHEMA, TAG_NAME, TAG_VALUE, LEVEL, COLUMN_NAME FROM TABLE("db".INFORMATION_SCHEMA.TAG_REFERENCES('"db"."schema"."table"', 'TABLE'))`).
+			WillReturnError(errors.New("003001 (42501): SQL access control error: Insufficient privileges to operate on table 'TABLE'"))
+		_, err := readTableTags(ctx, client, id, tags{{name: "department", value: "finance"}})
+		assert.True(t, isPrivilegeError(err))
+		require.NoError(t, mock.ExpectationsWereMet())
+
+		known := columns{{name: "ID", tags: tags{{name: "pii", value: "id"}}}}
+		assert.Equal(t, []any{
+			map[string]any{"name": "ID", "tag": []any{tableTagConfig("pii", "id", "", "")}},
+			map[string]any{"name": "EMAIL"},
+		}, keepTableColumnTags([]any{map[string]any{"name": "ID"}, map[string]any{"name": "EMAIL"}}, known))
+	})
+}
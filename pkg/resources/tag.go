@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"errors"
@@ -9,6 +10,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
@@ -138,10 +140,10 @@ func tagIDFromString(stringID string) (*TagID, error) {
 // Schema returns a pointer to the resource representing a schema.
 func Tag() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateTag,
-		Read:   ReadTag,
-		Update: UpdateTag,
-		Delete: DeleteTag,
+		CreateContext: CreateTag,
+		ReadContext:   ReadTag,
+		UpdateContext: UpdateTag,
+		DeleteContext: DeleteTag,
 
 		Schema: tagSchema,
 		Importer: &schema.ResourceImporter{
@@ -150,8 +152,8 @@ func Tag() *schema.Resource {
 	}
 }
 
-// CreateSchema implements schema.CreateFunc.
-func CreateTag(d *schema.ResourceData, meta interface{}) error {
+// CreateSchema implements schema.CreateContextFunc.
+func CreateTag(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
@@ -170,8 +172,8 @@ func CreateTag(d *schema.ResourceData, meta interface{}) error {
 
 	q := builder.Create()
 
-	if err := snowflake.Exec(db, q); err != nil {
-		return fmt.Errorf("error creating tag %v", name)
+	if err := snowflake.Exec(ctx, db, q); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating tag %v", name))
 	}
 
 	tagID := &TagID{
@@ -181,19 +183,19 @@ func CreateTag(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := tagID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadTag(d, meta)
+	return ReadTag(ctx, d, meta)
 }
 
-// ReadSchema implements schema.ReadFunc.
-func ReadTag(d *schema.ResourceData, meta interface{}) error {
+// ReadSchema implements schema.ReadContextFunc.
+func ReadTag(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	tagID, err := tagIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := tagID.DatabaseName
@@ -201,7 +203,7 @@ func ReadTag(d *schema.ResourceData, meta interface{}) error {
 	tag := tagID.TagName
 
 	q := snowflake.NewTagBuilder(tag).WithDB(dbName).WithSchema(schemaName).Show()
-	row := snowflake.QueryRow(db, q)
+	row := snowflake.QueryRow(ctx, db, q)
 
 	t, err := snowflake.ScanTag(row)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -211,37 +213,37 @@ func ReadTag(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("name", t.Name.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("database", t.DatabaseName.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("schema", t.SchemaName.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("comment", t.Comment.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	av := strings.ReplaceAll(t.AllowedValues.String, "\"", "")
 	av = strings.TrimPrefix(av, "[")
 	av = strings.TrimSuffix(av, "]")
 	err = d.Set("allowed_values", helpers.StringListToList(av))
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateTag implements schema.UpdateFunc.
-func UpdateTag(d *schema.ResourceData, meta interface{}) error {
+// UpdateTag implements schema.UpdateContextFunc.
+func UpdateTag(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tagID, err := tagIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := tagID.DatabaseName
@@ -259,8 +261,8 @@ func UpdateTag(d *schema.ResourceData, meta interface{}) error {
 		} else {
 			q = builder.RemoveComment()
 		}
-		if err := snowflake.Exec(db, q); err != nil {
-			return fmt.Errorf("error updating tag comment on %v", d.Id())
+		if err := snowflake.Exec(ctx, db, q); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating tag comment on %v", d.Id()))
 		}
 	}
 
@@ -273,23 +275,23 @@ func UpdateTag(d *schema.ResourceData, meta interface{}) error {
 
 			q := builder.RemoveAllowedValues()
 
-			if err := snowflake.Exec(db, q); err != nil {
-				return fmt.Errorf("error removing ALLOWED_VALUES for tag %v", tag)
+			if err := snowflake.Exec(ctx, db, q); err != nil {
+				return diag.FromErr(fmt.Errorf("error removing ALLOWED_VALUES for tag %v", tag))
 			}
 
 			addQuery := builder.AddAllowedValues(ns)
-			if err := snowflake.Exec(db, addQuery); err != nil {
-				return fmt.Errorf("error adding ALLOWED_VALUES for tag %v", tag)
+			if err := snowflake.Exec(ctx, db, addQuery); err != nil {
+				return diag.FromErr(fmt.Errorf("error adding ALLOWED_VALUES for tag %v", tag))
 			}
 		} else {
 			q := builder.RemoveAllowedValues()
-			if err := snowflake.Exec(db, q); err != nil {
-				return fmt.Errorf("error removing ALLOWED_VALUES for tag %v", tag)
+			if err := snowflake.Exec(ctx, db, q); err != nil {
+				return diag.FromErr(fmt.Errorf("error removing ALLOWED_VALUES for tag %v", tag))
 			}
 		}
 	}
 
-	return ReadTag(d, meta)
+	return ReadTag(ctx, d, meta)
 }
 
 // Returns the slice of strings for inputed allowed values.
@@ -303,12 +305,12 @@ func expandAllowedValues(avChangeSet interface{}) []string {
 	return newAvs
 }
 
-// DeleteTag implements schema.DeleteFunc.
-func DeleteTag(d *schema.ResourceData, meta interface{}) error {
+// DeleteTag implements schema.DeleteContextFunc.
+func DeleteTag(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	tagID, err := tagIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := tagID.DatabaseName
@@ -317,8 +319,8 @@ func DeleteTag(d *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.NewTagBuilder(tag).WithDB(dbName).WithSchema(schemaName).Drop()
 
-	if err := snowflake.Exec(db, q); err != nil {
-		return fmt.Errorf("error deleting tag %v err = %w", d.Id(), err)
+	if err := snowflake.Exec(ctx, db, q); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting tag %v err = %w", d.Id(), err))
 	}
 
 	d.SetId("")
@@ -8,6 +8,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -86,10 +87,10 @@ var tagAssociationSchema = map[string]*schema.Schema{
 // TagAssociation returns a pointer to the resource representing a schema.
 func TagAssociation() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateTagAssociation,
-		Read:   ReadTagAssociation,
-		Update: UpdateTagAssociation,
-		Delete: DeleteTagAssociation,
+		CreateContext: CreateTagAssociation,
+		ReadContext:   ReadTagAssociation,
+		UpdateContext: UpdateTagAssociation,
+		DeleteContext: DeleteTagAssociation,
 
 		Schema: tagAssociationSchema,
 		Importer: &schema.ResourceImporter{
@@ -101,8 +102,8 @@ func TagAssociation() *schema.Resource {
 	}
 }
 
-// CreateTagAssociation implements schema.CreateFunc.
-func CreateTagAssociation(d *schema.ResourceData, meta interface{}) error {
+// CreateTagAssociation implements schema.CreateContextFunc.
+func CreateTagAssociation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	tagID := d.Get("tag_id").(string)
 	objectType := d.Get("object_type").(string)
@@ -113,16 +114,16 @@ func CreateTagAssociation(d *schema.ResourceData, meta interface{}) error {
 	builder := snowflake.NewTagAssociationBuilder(tagID).WithObjectIdentifier(fullyQualifierObjectIdentifier).WithObjectType(objectType).WithTagValue(tagValue)
 
 	q := builder.Create()
-	if err := snowflake.Exec(db, q); err != nil {
-		return fmt.Errorf("error associating tag to object: [%v] with command: [%v], tag_id [%v]", fullyQualifierObjectIdentifier, q, tagID)
+	if err := snowflake.Exec(ctx, db, q); err != nil {
+		return diag.FromErr(fmt.Errorf("error associating tag to object: [%v] with command: [%v], tag_id [%v]", fullyQualifierObjectIdentifier, q, tagID))
 	}
 
 	skipValidate := d.Get("skip_validation").(bool)
 	if !skipValidate {
 		log.Println("[DEBUG] validating tag creation")
 
-		if err := retry.RetryContext(context.Background(), d.Timeout(schema.TimeoutCreate)-time.Minute, func() *retry.RetryError {
-			resp, err := snowflake.ListTagAssociations(builder, db)
+		if err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate)-time.Minute, func() *retry.RetryError {
+			resp, err := snowflake.ListTagAssociations(ctx, builder, db)
 			if err != nil {
 				return retry.NonRetryableError(fmt.Errorf("error: %w", err))
 			}
@@ -133,7 +134,7 @@ func CreateTagAssociation(d *schema.ResourceData, meta interface{}) error {
 			}
 			return nil
 		}); err != nil {
-			return fmt.Errorf("error validating tag association")
+			return diag.FromErr(fmt.Errorf("error validating tag association"))
 		}
 	}
 
@@ -144,14 +145,14 @@ func CreateTagAssociation(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := t.String()
 	if err != nil {
-		return fmt.Errorf("error creating tag id")
+		return diag.FromErr(fmt.Errorf("error creating tag id"))
 	}
 	d.SetId(dataIDInput)
-	return ReadTagAssociation(d, meta)
+	return ReadTagAssociation(ctx, d, meta)
 }
 
-// ReadTagAssociation implements schema.ReadFunc.
-func ReadTagAssociation(d *schema.ResourceData, meta interface{}) error {
+// ReadTagAssociation implements schema.ReadContextFunc.
+func ReadTagAssociation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 
 	tagID := d.Get("tag_id").(string)
@@ -160,7 +161,7 @@ func ReadTagAssociation(d *schema.ResourceData, meta interface{}) error {
 	fullyQualifierObjectIdentifier := snowflakeValidation.FormatFullyQualifiedObjectID(objectDatabase, objectSchema, objectName)
 
 	q := snowflake.NewTagAssociationBuilder(tagID).WithObjectIdentifier(fullyQualifierObjectIdentifier).WithObjectType(objectType).Show()
-	row := snowflake.QueryRow(db, q)
+	row := snowflake.QueryRow(ctx, db, q)
 
 	ta, err := snowflake.ScanTagAssociation(row)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -171,16 +172,16 @@ func ReadTagAssociation(d *schema.ResourceData, meta interface{}) error {
 	}
 	if err != nil {
 		// return err
-		return fmt.Errorf("error listing tag associations")
+		return diag.FromErr(fmt.Errorf("error listing tag associations"))
 	}
 
 	if err := d.Set("tag_value", ta.TagValue.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func UpdateTagAssociation(d *schema.ResourceData, meta interface{}) error {
+func UpdateTagAssociation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 
 	tagID := d.Get("tag_id").(string)
@@ -203,17 +204,17 @@ func UpdateTagAssociation(d *schema.ResourceData, meta interface{}) error {
 		} else {
 			q = builder.WithTagValue("").Create()
 		}
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return fmt.Errorf("error updating tag association value for object [%v]", fullyQualifierObjectIdentifier)
+			return diag.FromErr(fmt.Errorf("error updating tag association value for object [%v]", fullyQualifierObjectIdentifier))
 		}
 	}
 
-	return ReadTagAssociation(d, meta)
+	return ReadTagAssociation(ctx, d, meta)
 }
 
-// DeleteTagAssociation implements schema.DeleteFunc.
-func DeleteTagAssociation(d *schema.ResourceData, meta interface{}) error {
+// DeleteTagAssociation implements schema.DeleteContextFunc.
+func DeleteTagAssociation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 
 	tagID := d.Get("tag_id").(string)
@@ -222,8 +223,8 @@ func DeleteTagAssociation(d *schema.ResourceData, meta interface{}) error {
 	fullyQualifierObjectIdentifier := snowflakeValidation.FormatFullyQualifiedObjectID(objectDatabase, objectSchema, objectName)
 	q := snowflake.NewTagAssociationBuilder(tagID).WithObjectIdentifier(fullyQualifierObjectIdentifier).WithObjectType(objectType).Drop()
 
-	if err := snowflake.Exec(db, q); err != nil {
-		return fmt.Errorf("error deleting tag association for object id [%s]: %w", tagID, err)
+	if err := snowflake.Exec(ctx, db, q); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting tag association for object id [%s]: %w", tagID, err))
 	}
 
 	d.SetId("")
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -82,10 +83,10 @@ var tagGrantSchema = map[string]*schema.Schema{
 func TagGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateTagGrant,
-			Read:               ReadTagGrant,
-			Update:             UpdateTagGrant,
-			Delete:             DeleteTagGrant,
+			CreateContext:      CreateTagGrant,
+			ReadContext:        ReadTagGrant,
+			UpdateContext:      UpdateTagGrant,
+			DeleteContext:      DeleteTagGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             tagGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -120,8 +121,8 @@ func TagGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateTagGrant implements schema.CreateFunc.
-func CreateTagGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateTagGrant implements schema.CreateContextFunc.
+func CreateTagGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tagName := d.Get("tag_name").(string)
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -131,17 +132,17 @@ func CreateTagGrant(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.TagGrant(databaseName, schemaName, tagName)
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, tagName, privilege, withGrantOption, roles)
 	d.SetId(grantID)
 
-	return ReadTagGrant(d, meta)
+	return ReadTagGrant(ctx, d, meta)
 }
 
-// ReadTagGrant implements schema.ReadFunc.
-func ReadTagGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadTagGrant implements schema.ReadContextFunc.
+func ReadTagGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	tagName := d.Get("tag_name").(string)
@@ -151,9 +152,9 @@ func ReadTagGrant(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.TagGrant(databaseName, schemaName, tagName)
 
-	err := readGenericGrant(d, meta, tagGrantSchema, builder, false, false, validTagPrivileges)
+	err := readGenericGrant(ctx, d, meta, tagGrantSchema, builder, false, false, validTagPrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, tagName, privilege, withGrantOption, roles)
@@ -163,8 +164,8 @@ func ReadTagGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// UpdateTagGrant implements schema.UpdateFunc.
-func UpdateTagGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateTagGrant implements schema.UpdateContextFunc.
+func UpdateTagGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update is roles. if nothing changed,
 	// nothing to update and we're done.
 	if !d.HasChanges("roles") {
@@ -185,6 +186,7 @@ func UpdateTagGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
+		ctx,
 		meta,
 		builder,
 		privilege,
@@ -192,11 +194,12 @@ func UpdateTagGrant(d *schema.ResourceData, meta interface{}) error {
 		rolesToRevoke,
 		nil,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// then add
 	if err := createGenericGrantRolesAndShares(
+		ctx,
 		meta,
 		builder,
 		privilege,
@@ -204,19 +207,19 @@ func UpdateTagGrant(d *schema.ResourceData, meta interface{}) error {
 		rolesToAdd,
 		nil,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return ReadTagGrant(d, meta)
+	return ReadTagGrant(ctx, d, meta)
 }
 
-// DeleteTagGrant implements schema.DeleteFunc.
-func DeleteTagGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteTagGrant implements schema.DeleteContextFunc.
+func DeleteTagGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	tagName := d.Get("tag_name").(string)
 
 	builder := snowflake.TagGrant(databaseName, schemaName, tagName)
 
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
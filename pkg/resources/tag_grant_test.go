@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -36,8 +37,8 @@ func TestTagGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT APPLY ON TAG "test-db"."PUBLIC"."test_tag" TO ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT APPLY ON TAG "test-db"."PUBLIC"."test_tag" TO ROLE "test-role-2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadTagGrant(mock)
-		err := resources.CreateTagGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateTagGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -57,8 +58,8 @@ func TestTagGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadTagGrant(mock)
-		err := resources.ReadTagGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadTagGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	roles := d.Get("roles").(*schema.Set)
@@ -10,6 +10,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
@@ -90,9 +91,9 @@ func attachedPolicyIDFromString(stringID string) (*attachmentID, error) {
 // Schema returns a pointer to the resource representing a schema.
 func TagMaskingPolicyAssociation() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateTagMaskingPolicyAssociation,
-		Read:   ReadTagMaskingPolicyAssociation,
-		Delete: DeleteTagMaskingPolicyAssociation,
+		CreateContext: CreateTagMaskingPolicyAssociation,
+		ReadContext:   ReadTagMaskingPolicyAssociation,
+		DeleteContext: DeleteTagMaskingPolicyAssociation,
 
 		Schema: mpAttachmentPolicySchema,
 		Importer: &schema.ResourceImporter{
@@ -102,13 +103,13 @@ func TagMaskingPolicyAssociation() *schema.Resource {
 	}
 }
 
-// CreateTagMaskingPolicyAssociation implements schema.CreateFunc.
-func CreateTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) error {
+// CreateTagMaskingPolicyAssociation implements schema.CreateContextFunc.
+func CreateTagMaskingPolicyAssociation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	tagID := d.Get("tag_id").(string)
 	tagIDStruct, idErr := tagIDFromString(tagID)
 	if idErr != nil {
-		return idErr
+		return diag.FromErr(idErr)
 	}
 	tagDB := tagIDStruct.DatabaseName
 	tagSchema := tagIDStruct.SchemaName
@@ -125,8 +126,8 @@ func CreateTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{})
 
 	q := builder.AddMaskingPolicy()
 
-	if err := snowflake.Exec(db, q); err != nil {
-		return fmt.Errorf("error attaching masking policy %v to tag %v", mpName, tagName)
+	if err := snowflake.Exec(ctx, db, q); err != nil {
+		return diag.FromErr(fmt.Errorf("error attaching masking policy %v to tag %v", mpName, tagName))
 	}
 
 	mpAttachmentID := &attachmentID{
@@ -139,19 +140,19 @@ func CreateTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{})
 	}
 	dataIDInput, err := mpAttachmentID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadTagMaskingPolicyAssociation(d, meta)
+	return ReadTagMaskingPolicyAssociation(ctx, d, meta)
 }
 
-// ReadTagTagMaskingPolicyAssociation implements schema.ReadFunc.
-func ReadTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) error {
+// ReadTagTagMaskingPolicyAssociation implements schema.ReadContextFunc.
+func ReadTagMaskingPolicyAssociation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	attachementID, err := attachedPolicyIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tagDBName := attachementID.TagDatabaseName
@@ -166,10 +167,9 @@ func ReadTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) e
 
 	// create temp warehouse to query the tag, and make sure to clean it up
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	originalWarehouse, err := client.ContextFunctions.CurrentWarehouse(ctx)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if originalWarehouse == "" {
 		log.Printf("[DEBUG] no current warehouse set, creating a temporary warehouse")
@@ -177,7 +177,7 @@ func ReadTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) e
 		tempWarehouseID := sdk.NewAccountObjectIdentifier(randomWarehouseName)
 		err = client.Warehouses.Create(ctx, tempWarehouseID, nil)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		defer func() {
 			err := client.Warehouses.Drop(ctx, tempWarehouseID, nil)
@@ -191,10 +191,10 @@ func ReadTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) e
 		}()
 		err = client.Sessions.UseWarehouse(ctx, tempWarehouseID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	row := snowflake.QueryRow(db, builder.ShowAttachedPolicy())
+	row := snowflake.QueryRow(ctx, db, builder.ShowAttachedPolicy())
 	t, err := snowflake.ScanTagPolicy(row)
 	if errors.Is(err, sql.ErrNoRows) {
 		// If not found, mark resource to be removed from state file during apply or refresh
@@ -204,23 +204,23 @@ func ReadTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	mpIDString := helpers.EncodeSnowflakeID(t.PolicyDB.String, t.PolicySchema.String, t.PolicyName.String)
 
 	if err := d.Set("masking_policy_id", mpIDString); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-// DeleteTagMaskingPolicyAssociation implements schema.DeleteFunc.
-func DeleteTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) error {
+// DeleteTagMaskingPolicyAssociation implements schema.DeleteContextFunc.
+func DeleteTagMaskingPolicyAssociation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	attachmentID, err := attachedPolicyIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tagDBName := attachmentID.TagDatabaseName
@@ -234,8 +234,8 @@ func DeleteTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{})
 
 	builder := snowflake.NewTagBuilder(tagName).WithDB(tagDBName).WithSchema(tagSchemaName).WithMaskingPolicy(mP)
 
-	if err := snowflake.Exec(db, builder.RemoveMaskingPolicy()); err != nil {
-		return fmt.Errorf("error unattaching masking policy for %v err = %w", d.Id(), err)
+	if err := snowflake.Exec(ctx, db, builder.RemoveMaskingPolicy()); err != nil {
+		return diag.FromErr(fmt.Errorf("error unattaching masking policy for %v err = %w", d.Id(), err))
 	}
 
 	d.SetId("")
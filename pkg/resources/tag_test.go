@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -38,8 +39,8 @@ func TestTagCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadTag(mock)
-		err := resources.CreateTag(d, db)
-		r.NoError(err)
+		diags := resources.CreateTag(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -63,8 +64,8 @@ func TestTagUpdate(t *testing.T) {
 		mock.ExpectExec(`^ALTER TAG "test_db"."test_schema"."good_name" ADD ALLOWED_VALUES 'marketing', 'finance'$`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadTag(mock)
-		err := resources.UpdateTag(d, db)
-		r.NoError(err)
+		diags := resources.UpdateTag(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -86,8 +87,8 @@ func TestTagDelete(t *testing.T) {
 			`^DROP TAG "test_db"."test_schema"."good_name"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := resources.DeleteTag(d, db)
-		r.NoError(err)
+		diags := resources.DeleteTag(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -108,9 +109,9 @@ func TestTagRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.NewTagBuilder("good_name").WithDB("test_db").WithSchema("test_schema").Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadTag(d, db)
+		diags := resources.ReadTag(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err)
+		r.Empty(diags)
 	})
 }
 
@@ -11,6 +11,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -117,6 +118,7 @@ var taskSchema = map[string]*schema.Schema{
 		Default:     false,
 		Description: "By default, Snowflake ensures that only one instance of a particular DAG is allowed to run at a time, setting the parameter value to TRUE permits DAG runs to overlap.",
 	},
+	"statement_comparison": statementComparisonSchema,
 }
 
 // difference find keys in 'a' but not in 'b'.
@@ -146,10 +148,10 @@ func differentValue(a, b map[string]any) map[string]any {
 // Task returns a pointer to the resource representing a task.
 func Task() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateTask,
-		Read:   ReadTask,
-		Update: UpdateTask,
-		Delete: DeleteTask,
+		CreateContext: CreateTask,
+		ReadContext:   ReadTask,
+		UpdateContext: UpdateTask,
+		DeleteContext: DeleteTask,
 		CustomizeDiff: customdiff.ForceNewIfChange("when", func(ctx context.Context, old, new, meta any) bool {
 			return old.(string) != "" && new.(string) == ""
 		}),
@@ -161,11 +163,10 @@ func Task() *schema.Resource {
 	}
 }
 
-// ReadTask implements schema.ReadFunc.
-func ReadTask(d *schema.ResourceData, meta interface{}) error {
+// ReadTask implements schema.ReadContextFunc.
+func ReadTask(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	taskId := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
@@ -178,39 +179,39 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("enabled", task.IsStarted()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("name", task.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("database", task.DatabaseName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("schema", task.SchemaName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("warehouse", task.Warehouse); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("schedule", task.Schedule); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("comment", task.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("allow_overlapping_execution", task.AllowOverlappingExecution); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("error_integration", task.ErrorIntegration); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	predecessors := make([]string, len(task.Predecessors))
@@ -218,21 +219,21 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 		predecessors[i] = p.Name()
 	}
 	if err := d.Set("after", predecessors); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("when", task.Condition); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("sql_statement", task.Definition); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	opts := &sdk.ShowParametersOptions{In: &sdk.ParametersIn{Task: taskId}}
 	params, err := client.Parameters.ShowParameters(ctx, opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(params) > 0 {
@@ -251,14 +252,14 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 			case "USER_TASK_TIMEOUT_MS":
 				timeout, err := strconv.ParseInt(param.Value, 10, 64)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 
 				fieldParameters["user_task_timeout_ms"] = timeout
 			case "SUSPEND_TASK_AFTER_NUM_FAILURES":
 				num, err := strconv.ParseInt(param.Value, 10, 64)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 
 				fieldParameters["suspend_task_after_num_failures"] = num
@@ -268,14 +269,14 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if err := d.Set("session_parameters", sessionParameters); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		for key, value := range fieldParameters {
 			// lintignore:R001
 			err = d.Set(key, value)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -283,11 +284,10 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// CreateTask implements schema.CreateFunc.
-func CreateTask(d *schema.ResourceData, meta interface{}) error {
+// CreateTask implements schema.CreateContextFunc.
+func CreateTask(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
@@ -307,7 +307,7 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("user_task_managed_initial_warehouse_size"); ok {
 		size, err := sdk.ToWarehouseSize(v.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		createRequest.WithWarehouse(sdk.NewCreateTaskWarehouseRequest().WithUserTaskManagedInitialWarehouseSize(&size))
 	}
@@ -319,7 +319,7 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("session_parameters"); ok {
 		sessionParameters, err := sdk.GetSessionParametersFrom(v.(map[string]any))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		createRequest.WithSessionParameters(sessionParameters)
 	}
@@ -351,14 +351,14 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 			precedingTaskId := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, dep)
 			rootTasks, err := sdk.GetRootTasks(client.Tasks, ctx, precedingTaskId)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			for _, rootTask := range rootTasks {
 				// if a root task is started, then it needs to be suspended before the child tasks can be created
 				if rootTask.IsStarted() {
 					err := suspendTask(ctx, client, rootTask.ID())
 					if err != nil {
-						return err
+						return diag.FromErr(err)
 					}
 
 					// resume the task after modifications are complete as long as it is not a standalone task
@@ -377,7 +377,7 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := client.Tasks.Create(ctx, createRequest); err != nil {
-		return fmt.Errorf("error creating task %s err = %w", taskId.FullyQualifiedName(), err)
+		return diag.FromErr(fmt.Errorf("error creating task %s err = %w", taskId.FullyQualifiedName(), err))
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(taskId))
@@ -389,7 +389,7 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return ReadTask(d, meta)
+	return ReadTask(ctx, d, meta)
 }
 
 func waitForTaskStart(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier) error {
@@ -425,24 +425,23 @@ func resumeTask(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIden
 	return err
 }
 
-// UpdateTask implements schema.UpdateFunc.
-func UpdateTask(d *schema.ResourceData, meta interface{}) error {
+// UpdateTask implements schema.UpdateContextFunc.
+func UpdateTask(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	taskId := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
 	rootTasks, err := sdk.GetRootTasks(client.Tasks, ctx, taskId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, rootTask := range rootTasks {
 		// if a root task is started, then it needs to be suspended before the child tasks can be created
 		if rootTask.IsStarted() {
 			err := suspendTask(ctx, client, rootTask.ID())
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			// resume the task after modifications are complete as long as it is not a standalone task
@@ -462,7 +461,7 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.Tasks.Alter(ctx, alterRequest)
 		if err != nil {
-			return fmt.Errorf("error updating warehouse on task %s err = %w", taskId.FullyQualifiedName(), err)
+			return diag.FromErr(fmt.Errorf("error updating warehouse on task %s err = %w", taskId.FullyQualifiedName(), err))
 		}
 	}
 
@@ -473,12 +472,12 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		if warehouse == "" && newSize != "" {
 			size, err := sdk.ToWarehouseSize(newSize.(string))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			alterRequest := sdk.NewAlterTaskRequest(taskId).WithSet(sdk.NewTaskSetRequest().WithUserTaskManagedInitialWarehouseSize(&size))
 			err = client.Tasks.Alter(ctx, alterRequest)
 			if err != nil {
-				return fmt.Errorf("error updating user_task_managed_initial_warehouse_size on task %s", taskId.FullyQualifiedName())
+				return diag.FromErr(fmt.Errorf("error updating user_task_managed_initial_warehouse_size on task %s", taskId.FullyQualifiedName()))
 			}
 		}
 	}
@@ -493,14 +492,14 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.Tasks.Alter(ctx, alterRequest)
 		if err != nil {
-			return fmt.Errorf("error updating error integration on task %s", taskId.FullyQualifiedName())
+			return diag.FromErr(fmt.Errorf("error updating error integration on task %s", taskId.FullyQualifiedName()))
 		}
 	}
 
 	if d.HasChange("after") {
 		// making changes to after require suspending the current task
 		if err := suspendTask(ctx, client, taskId); err != nil {
-			return fmt.Errorf("error suspending task %s, err: %w", taskId.FullyQualifiedName(), err)
+			return diag.FromErr(fmt.Errorf("error suspending task %s, err: %w", taskId.FullyQualifiedName(), err))
 		}
 
 		o, n := d.GetChange("after")
@@ -510,7 +509,7 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		if len(newAfter) > 0 {
 			// preemptively removing schedule because a task cannot have both after and schedule
 			if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(taskId).WithUnset(sdk.NewTaskUnsetRequest().WithSchedule(sdk.Bool(true)))); err != nil {
-				return fmt.Errorf("error updating schedule on task %s", taskId.FullyQualifiedName())
+				return diag.FromErr(fmt.Errorf("error updating schedule on task %s", taskId.FullyQualifiedName()))
 			}
 		}
 
@@ -523,7 +522,7 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		}
 		if len(toRemove) > 0 {
 			if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(taskId).WithRemoveAfter(toRemove)); err != nil {
-				return fmt.Errorf("error removing after dependencies from task %s", taskId.FullyQualifiedName())
+				return diag.FromErr(fmt.Errorf("error removing after dependencies from task %s", taskId.FullyQualifiedName()))
 			}
 		}
 
@@ -540,14 +539,14 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 			for _, dep := range toAdd {
 				rootTasks, err := sdk.GetRootTasks(client.Tasks, ctx, dep)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				for _, rootTask := range rootTasks {
 					// if a root task is started, then it needs to be suspended before the child tasks can be created
 					if rootTask.IsStarted() {
 						err := suspendTask(ctx, client, rootTask.ID())
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 
 						// resume the task after modifications are complete as long as it is not a standalone task
@@ -558,7 +557,7 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 				}
 			}
 			if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(taskId).WithAddAfter(toAdd)); err != nil {
-				return fmt.Errorf("error adding after dependencies from task %s", taskId.FullyQualifiedName())
+				return diag.FromErr(fmt.Errorf("error adding after dependencies from task %s", taskId.FullyQualifiedName()))
 			}
 		}
 	}
@@ -573,7 +572,7 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.Tasks.Alter(ctx, alterRequest)
 		if err != nil {
-			return fmt.Errorf("error updating schedule on task %s", taskId.FullyQualifiedName())
+			return diag.FromErr(fmt.Errorf("error updating schedule on task %s", taskId.FullyQualifiedName()))
 		}
 	}
 
@@ -587,7 +586,7 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.Tasks.Alter(ctx, alterRequest)
 		if err != nil {
-			return fmt.Errorf("error updating user task timeout on task %s", taskId.FullyQualifiedName())
+			return diag.FromErr(fmt.Errorf("error updating user task timeout on task %s", taskId.FullyQualifiedName()))
 		}
 	}
 
@@ -601,7 +600,7 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.Tasks.Alter(ctx, alterRequest)
 		if err != nil {
-			return fmt.Errorf("error updating suspend task after num failures on task %s", taskId.FullyQualifiedName())
+			return diag.FromErr(fmt.Errorf("error updating suspend task after num failures on task %s", taskId.FullyQualifiedName()))
 		}
 	}
 
@@ -615,7 +614,7 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.Tasks.Alter(ctx, alterRequest)
 		if err != nil {
-			return fmt.Errorf("error updating comment on task %s", taskId.FullyQualifiedName())
+			return diag.FromErr(fmt.Errorf("error updating comment on task %s", taskId.FullyQualifiedName()))
 		}
 	}
 
@@ -629,7 +628,7 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.Tasks.Alter(ctx, alterRequest)
 		if err != nil {
-			return fmt.Errorf("error updating allow overlapping execution on task %s", taskId.FullyQualifiedName())
+			return diag.FromErr(fmt.Errorf("error updating allow overlapping execution on task %s", taskId.FullyQualifiedName()))
 		}
 	}
 
@@ -652,30 +651,30 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		if len(remove) > 0 {
 			sessionParametersUnset, err := sdk.GetSessionParametersUnsetFrom(remove)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(taskId).WithUnset(sdk.NewTaskUnsetRequest().WithSessionParametersUnset(sessionParametersUnset))); err != nil {
-				return fmt.Errorf("error removing session_parameters on task %v err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error removing session_parameters on task %v err = %w", d.Id(), err))
 			}
 		}
 
 		if len(add) > 0 {
 			sessionParameters, err := sdk.GetSessionParametersFrom(add)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(taskId).WithSet(sdk.NewTaskSetRequest().WithSessionParameters(sessionParameters))); err != nil {
-				return fmt.Errorf("error adding session_parameters to task %v err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error adding session_parameters to task %v err = %w", d.Id(), err))
 			}
 		}
 
 		if len(change) > 0 {
 			sessionParameters, err := sdk.GetSessionParametersFrom(change)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(taskId).WithSet(sdk.NewTaskSetRequest().WithSessionParameters(sessionParameters))); err != nil {
-				return fmt.Errorf("error updating session_parameters in task %v err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error updating session_parameters in task %v err = %w", d.Id(), err))
 			}
 		}
 	}
@@ -685,7 +684,7 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		alterRequest := sdk.NewAlterTaskRequest(taskId).WithModifyWhen(sdk.String(n.(string)))
 		err := client.Tasks.Alter(ctx, alterRequest)
 		if err != nil {
-			return fmt.Errorf("error updating when condition on task %s", taskId.FullyQualifiedName())
+			return diag.FromErr(fmt.Errorf("error updating when condition on task %s", taskId.FullyQualifiedName()))
 		}
 	}
 
@@ -694,7 +693,7 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		alterRequest := sdk.NewAlterTaskRequest(taskId).WithModifyAs(sdk.String(n.(string)))
 		err := client.Tasks.Alter(ctx, alterRequest)
 		if err != nil {
-			return fmt.Errorf("error updating sql statement on task %s", taskId.FullyQualifiedName())
+			return diag.FromErr(fmt.Errorf("error updating sql statement on task %s", taskId.FullyQualifiedName()))
 		}
 	}
 
@@ -705,30 +704,29 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		}
 	} else {
 		if suspendTask(ctx, client, taskId) != nil {
-			return fmt.Errorf("[WARN] failed to suspend task %s", taskId.FullyQualifiedName())
+			return diag.FromErr(fmt.Errorf("[WARN] failed to suspend task %s", taskId.FullyQualifiedName()))
 		}
 	}
-	return ReadTask(d, meta)
+	return ReadTask(ctx, d, meta)
 }
 
-// DeleteTask implements schema.DeleteFunc.
-func DeleteTask(d *schema.ResourceData, meta interface{}) error {
+// DeleteTask implements schema.DeleteContextFunc.
+func DeleteTask(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	taskId := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
 	rootTasks, err := sdk.GetRootTasks(client.Tasks, ctx, taskId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, rootTask := range rootTasks {
 		// if a root task is started, then it needs to be suspended before the child tasks can be created
 		if rootTask.IsStarted() {
 			err := suspendTask(ctx, client, rootTask.ID())
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			// resume the task after modifications are complete as long as it is not a standalone task
@@ -741,7 +739,7 @@ func DeleteTask(d *schema.ResourceData, meta interface{}) error {
 	dropRequest := sdk.NewDropTaskRequest(taskId)
 	err = client.Tasks.Drop(ctx, dropRequest)
 	if err != nil {
-		return fmt.Errorf("error deleting task %s err = %w", taskId.FullyQualifiedName(), err)
+		return diag.FromErr(fmt.Errorf("error deleting task %s err = %w", taskId.FullyQualifiedName(), err))
 	}
 
 	d.SetId("")
@@ -10,6 +10,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -100,10 +101,10 @@ var taskGrantSchema = map[string]*schema.Schema{
 func TaskGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateTaskGrant,
-			Read:               ReadTaskGrant,
-			Delete:             DeleteTaskGrant,
-			Update:             UpdateTaskGrant,
+			CreateContext:      CreateTaskGrant,
+			ReadContext:        ReadTaskGrant,
+			DeleteContext:      DeleteTaskGrant,
+			UpdateContext:      UpdateTaskGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             taskGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -146,8 +147,8 @@ func TaskGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateTaskGrant implements schema.CreateFunc.
-func CreateTaskGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateTaskGrant implements schema.CreateContextFunc.
+func CreateTaskGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	taskName := d.Get("task_name").(string)
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -158,10 +159,10 @@ func CreateTaskGrant(d *schema.ResourceData, meta interface{}) error {
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	if (taskName == "") && !onFuture && !onAll {
-		return errors.New("task_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("task_name must be set unless on_future or on_all is true"))
 	}
 	if (schemaName == "") && !onFuture && !onAll {
-		return errors.New("schema_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future or on_all is true"))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -174,17 +175,17 @@ func CreateTaskGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.TaskGrant(databaseName, schemaName, taskName)
 	}
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, taskName, privilege, withGrantOption, onFuture, onAll, roles)
 	d.SetId(grantID)
 
-	return ReadTaskGrant(d, meta)
+	return ReadTaskGrant(ctx, d, meta)
 }
 
-// ReadTaskGrant implements schema.ReadFunc.
-func ReadTaskGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadTaskGrant implements schema.ReadContextFunc.
+func ReadTaskGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	taskName := d.Get("task_name").(string)
@@ -204,9 +205,9 @@ func ReadTaskGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.TaskGrant(databaseName, schemaName, taskName)
 	}
 
-	err := readGenericGrant(d, meta, taskGrantSchema, builder, onFuture, onAll, validTaskPrivileges)
+	err := readGenericGrant(ctx, d, meta, taskGrantSchema, builder, onFuture, onAll, validTaskPrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, taskName, privilege, withGrantOption, onFuture, onAll, roles)
@@ -216,8 +217,8 @@ func ReadTaskGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteTaskGrant implements schema.DeleteFunc.
-func DeleteTaskGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteTaskGrant implements schema.DeleteContextFunc.
+func DeleteTaskGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	taskName := d.Get("task_name").(string)
@@ -233,11 +234,11 @@ func DeleteTaskGrant(d *schema.ResourceData, meta interface{}) error {
 	default:
 		builder = snowflake.TaskGrant(databaseName, schemaName, taskName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateTaskGrant implements schema.UpdateFunc.
-func UpdateTaskGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateTaskGrant implements schema.UpdateContextFunc.
+func UpdateTaskGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles") {
@@ -271,17 +272,17 @@ func UpdateTaskGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
-		meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
+		ctx, meta, builder, privilege, reversionRole, rolesToRevoke, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then add
 	if err := createGenericGrantRolesAndShares(
-		meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
+		ctx, meta, builder, privilege, withGrantOption, rolesToAdd, []string{},
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadTaskGrant(d, meta)
+	return ReadTaskGrant(ctx, d, meta)
 }
@@ -0,0 +1,32 @@
+resource "snowflake_table" "t" {
+  database        = var.database
+  schema          = var.schema
+  name            = var.table_name
+  change_tracking = true
+  column {
+    name = "id"
+    type = "NUMBER(38,0)"
+  }
+  column {
+    name = "data"
+    type = "VARCHAR"
+  }
+}
+
+resource "snowflake_dynamic_table" "dt" {
+  depends_on = [snowflake_table.t]
+  name       = var.name
+  database   = var.database
+  schema     = var.schema
+  target_lag {
+    downstream = true
+  }
+  warehouse                       = var.warehouse
+  query                           = var.query
+  initialize                      = "ON_SCHEDULE"
+  cluster_by                      = ["\"id\""]
+  data_retention_time_in_days     = 2
+  max_data_extension_time_in_days = 4
+  refresh_on_apply                = true
+  comment                         = "Terraform acceptance test"
+}
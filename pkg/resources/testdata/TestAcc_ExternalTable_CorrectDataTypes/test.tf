@@ -1,10 +1,20 @@
 resource "snowflake_stage" "test" {
-  name        = var.name
-  url         = var.location
-  database    = var.database
-  schema      = var.schema
-  credentials = "aws_key_id = '${var.aws_key_id}' aws_secret_key = '${var.aws_secret_key}'"
-  file_format = "TYPE = JSON NULL_IF = []"
+  name     = var.name
+  database = var.database
+  schema   = var.schema
+
+  s3 {
+    url = var.location
+    credentials {
+      aws_key_id     = var.aws_key_id
+      aws_secret_key = var.aws_secret_key
+    }
+  }
+
+  file_format {
+    type    = "JSON"
+    null_if = []
+  }
 }
 
 resource "snowflake_external_table" "test_table" {
@@ -8,13 +8,35 @@ resource "snowflake_schema" "test" {
 }
 
 resource "snowflake_stage" "test" {
-  name                = var.name
-  schema              = snowflake_schema.test.name
-  database            = snowflake_database.test.name
-  comment             = var.comment
-  url                 = var.url
-  storage_integration = var.storage_integration
-  credentials         = var.credentials
-  encryption          = var.encryption
-  file_format         = var.file_format
+  name     = var.name
+  schema   = snowflake_schema.test.name
+  database = snowflake_database.test.name
+  comment  = var.comment
+
+  s3 {
+    url                 = var.url
+    storage_integration = var.storage_integration == "" ? null : var.storage_integration
+
+    dynamic "credentials" {
+      for_each = var.aws_key_id == "" ? [] : [1]
+      content {
+        aws_key_id     = var.aws_key_id
+        aws_secret_key = var.aws_secret_key
+      }
+    }
+
+    encryption {
+      type = var.encryption
+    }
+  }
+
+  file_format {
+    type        = var.file_format_type
+    skip_header = var.file_format_type == "CSV" ? 1 : null
+    null_if     = []
+  }
+
+  copy_options {
+    on_error = "CONTINUE"
+  }
 }
@@ -22,14 +22,20 @@ variable "storage_integration" {
   type = string
 }
 
-variable "credentials" {
-  type = string
+variable "aws_key_id" {
+  type      = string
+  sensitive = true
+}
+
+variable "aws_secret_key" {
+  type      = string
+  sensitive = true
 }
 
 variable "encryption" {
   type = string
 }
 
-variable "file_format" {
+variable "file_format_type" {
   type = string
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -44,10 +45,10 @@ var unsafeExecuteSchema = map[string]*schema.Schema{
 
 func UnsafeExecute() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateUnsafeExecute,
-		Read:   ReadUnsafeExecute,
-		Delete: DeleteUnsafeExecute,
-		Update: UpdateUnsafeExecute,
+		CreateContext: CreateUnsafeExecute,
+		ReadContext:   ReadUnsafeExecute,
+		DeleteContext: DeleteUnsafeExecute,
+		UpdateContext: UpdateUnsafeExecute,
 
 		Schema: unsafeExecuteSchema,
 
@@ -66,9 +67,8 @@ func UnsafeExecute() *schema.Resource {
 	}
 }
 
-func ReadUnsafeExecute(d *schema.ResourceData, meta interface{}) error {
+func ReadUnsafeExecute(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	readStatement := d.Get("query").(string)
@@ -83,12 +83,12 @@ func ReadUnsafeExecute(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if readStatement == "" {
-		return setNilResults()
+		return diag.FromErr(setNilResults())
 	} else {
 		rows, err := client.QueryUnsafe(ctx, readStatement)
 		if err != nil {
 			log.Printf(`[WARN] SQL query "%s" failed with err %v`, readStatement, err)
-			return setNilResults()
+			return diag.FromErr(setNilResults())
 		}
 		log.Printf(`[INFO] SQL query "%s" executed successfully, returned rows count: %d`, readStatement, len(rows))
 		rowsTransformed := make([]map[string]any, len(rows))
@@ -104,7 +104,7 @@ func ReadUnsafeExecute(d *schema.ResourceData, meta interface{}) error {
 					case string:
 						t[k] = *v
 					default:
-						return fmt.Errorf("currently only objects convertible to String are supported by query; got %v", *v)
+						return diag.FromErr(fmt.Errorf("currently only objects convertible to String are supported by query; got %v", *v))
 					}
 				}
 			}
@@ -112,44 +112,42 @@ func ReadUnsafeExecute(d *schema.ResourceData, meta interface{}) error {
 		}
 		err = d.Set("query_results", rowsTransformed)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func CreateUnsafeExecute(d *schema.ResourceData, meta interface{}) error {
+func CreateUnsafeExecute(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	id, err := uuid.GenerateUUID()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	executeStatement := d.Get("execute").(string)
 	_, err = client.ExecUnsafe(ctx, executeStatement)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
 	log.Printf(`[INFO] SQL "%s" applied successfully\n`, executeStatement)
 
-	return ReadUnsafeExecute(d, meta)
+	return ReadUnsafeExecute(ctx, d, meta)
 }
 
-func DeleteUnsafeExecute(d *schema.ResourceData, meta interface{}) error {
+func DeleteUnsafeExecute(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	revertStatement := d.Get("revert").(string)
 	_, err := client.ExecUnsafe(ctx, revertStatement)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -158,9 +156,9 @@ func DeleteUnsafeExecute(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func UpdateUnsafeExecute(d *schema.ResourceData, meta interface{}) error {
+func UpdateUnsafeExecute(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.HasChange("query") {
-		return ReadUnsafeExecute(d, meta)
+		return ReadUnsafeExecute(ctx, d, meta)
 	}
 	return nil
 }
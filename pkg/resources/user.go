@@ -4,10 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -57,12 +61,28 @@ var userSchema = map[string]*schema.Schema{
 		// TODO validation
 	},
 	"password": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Sensitive:   true,
-		Description: "**WARNING:** this will put the password in the terraform state file. Use carefully.",
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		Description:   "**WARNING:** this will put the password in the terraform state file. Use carefully. Use `password_wo` instead with Terraform 1.11 or newer.",
+		ConflictsWith: []string{"password_wo"},
 		// TODO validation https://docs.snowflake.net/manuals/sql-reference/sql/create-user.html#optional-parameters
 	},
+	"password_wo": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		Description:   "Write-only version of `password`; it is never stored in the plan or the state (requires Terraform 1.11 or newer). Terraform cannot detect its changes, so the password is updated only when `password_wo_version` changes.",
+		ConflictsWith: []string{"password"},
+		RequiredWith:  []string{"password_wo_version"},
+	},
+	"password_wo_version": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  "Version of `password_wo`. Change it to set the current value of `password_wo` on the user.",
+		RequiredWith: []string{"password_wo"},
+	},
 	"disabled": {
 		Type:     schema.TypeBool,
 		Optional: true,
@@ -93,9 +113,24 @@ var userSchema = map[string]*schema.Schema{
 		Description: "Specifies the set of secondary roles that are active for the user’s session upon login. Currently only [\"ALL\"] value is supported - more information can be found in [doc](https://docs.snowflake.com/en/sql-reference/sql/create-user#optional-object-properties-objectproperties)",
 	},
 	"rsa_public_key": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "Specifies the user’s RSA public key; used for key-pair authentication. Must be on 1 line without header and trailer.",
+		Type:          schema.TypeString,
+		Optional:      true,
+		Description:   "Specifies the user’s RSA public key; used for key-pair authentication. Must be on 1 line without header and trailer.",
+		ConflictsWith: []string{"rsa_public_key_wo"},
+	},
+	"rsa_public_key_wo": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		WriteOnly:     true,
+		Description:   "Write-only version of `rsa_public_key`; it is never stored in the plan or the state (requires Terraform 1.11 or newer). Terraform cannot detect its changes, so the key is updated only when `rsa_public_key_wo_version` changes.",
+		ConflictsWith: []string{"rsa_public_key"},
+		RequiredWith:  []string{"rsa_public_key_wo_version"},
+	},
+	"rsa_public_key_wo_version": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  "Version of `rsa_public_key_wo`. Change it to set the current value of `rsa_public_key_wo` on the user.",
+		RequiredWith: []string{"rsa_public_key_wo"},
 	},
 	"rsa_public_key_2": {
 		Type:        schema.TypeString,
@@ -137,24 +172,30 @@ var userSchema = map[string]*schema.Schema{
 		Sensitive:   true,
 		Description: "Last name of the user.",
 	},
+	"days_to_expiry": userDaysToExpirySchema(),
+	"mins_to_unlock": userMinsToUnlockSchema(),
+	"mins_to_bypass_mfa": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Specifies the number of minutes to temporarily bypass MFA for the user. The value counts down in Snowflake, so it is not read back; changing it sets the new value, and removing it unsets the bypass.",
+	},
+	"parameters": userParametersSchema(),
 	//    MIDDLE_NAME = <string>
 	//    SNOWFLAKE_LOCK = TRUE | FALSE
 	//    SNOWFLAKE_SUPPORT = TRUE | FALSE
-	//    DAYS_TO_EXPIRY = <integer>
-	//    MINS_TO_UNLOCK = <integer>
 	//    EXT_AUTHN_DUO = TRUE | FALSE
 	//    EXT_AUTHN_UID = <string>
-	//    MINS_TO_BYPASS_MFA = <integer>
 	//    DISABLE_MFA = TRUE | FALSE
 	//    MINS_TO_BYPASS_NETWORK POLICY = <integer>
 }
 
 func User() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateUser,
-		Read:   ReadUser,
-		Update: UpdateUser,
-		Delete: DeleteUser,
+		CreateContext: CreateUser,
+		ReadContext:   ReadUser,
+		UpdateContext: UpdateUser,
+		DeleteContext: DeleteUser,
 
 		Schema: userSchema,
 		Importer: &schema.ResourceImporter{
@@ -163,7 +204,7 @@ func User() *schema.Resource {
 	}
 }
 
-func CreateUser(d *schema.ResourceData, meta interface{}) error {
+func CreateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 
@@ -173,7 +214,6 @@ func CreateUser(d *schema.ResourceData, meta interface{}) error {
 		SessionParameters: &sdk.SessionParameters{},
 	}
 	name := d.Get("name").(string)
-	ctx := context.Background()
 	objectIdentifier := sdk.NewAccountObjectIdentifier(name)
 
 	if loginName, ok := d.GetOk("login_name"); ok {
@@ -186,6 +226,11 @@ func CreateUser(d *schema.ResourceData, meta interface{}) error {
 	if password, ok := d.GetOk("password"); ok {
 		opts.ObjectProperties.Password = sdk.String(password.(string))
 	}
+	if password, ok, err := getWriteOnlyString(d, "password_wo"); err != nil {
+		return diag.FromErr(err)
+	} else if ok {
+		opts.ObjectProperties.Password = sdk.String(password)
+	}
 	if v, ok := d.GetOk("disabled"); ok {
 		disabled := v.(bool)
 		opts.ObjectProperties.Disable = &disabled
@@ -203,16 +248,16 @@ func CreateUser(d *schema.ResourceData, meta interface{}) error {
 		opts.ObjectProperties.DefaultRole = sdk.String(defaultRole.(string))
 	}
 	if v, ok := d.GetOk("default_secondary_roles"); ok {
-		roles := expandStringList(v.(*schema.Set).List())
-		secondaryRoles := []sdk.SecondaryRole{}
-		for _, role := range roles {
-			secondaryRoles = append(secondaryRoles, sdk.SecondaryRole{Value: role})
-		}
-		opts.ObjectProperties.DefaultSeconaryRoles = &sdk.SecondaryRoles{Roles: secondaryRoles}
+		opts.ObjectProperties.DefaultSeconaryRoles = expandUserSecondaryRoles(v)
 	}
 	if rsaPublicKey, ok := d.GetOk("rsa_public_key"); ok {
 		opts.ObjectProperties.RSAPublicKey = sdk.String(rsaPublicKey.(string))
 	}
+	if rsaPublicKey, ok, err := getWriteOnlyString(d, "rsa_public_key_wo"); err != nil {
+		return diag.FromErr(err)
+	} else if ok {
+		opts.ObjectProperties.RSAPublicKey = sdk.String(rsaPublicKey)
+	}
 	if rsaPublicKey2, ok := d.GetOk("rsa_public_key_2"); ok {
 		opts.ObjectProperties.RSAPublicKey2 = sdk.String(rsaPublicKey2.(string))
 	}
@@ -229,21 +274,36 @@ func CreateUser(d *schema.ResourceData, meta interface{}) error {
 	if lastName, ok := d.GetOk("last_name"); ok {
 		opts.ObjectProperties.LastName = sdk.String(lastName.(string))
 	}
+	if v, ok := d.GetOk("days_to_expiry"); ok {
+		opts.ObjectProperties.DaysToExpiry = sdk.Int(v.(int))
+	}
+	if v, ok := d.GetOk("mins_to_unlock"); ok {
+		opts.ObjectProperties.MinsToUnlock = sdk.Int(v.(int))
+	}
+	if v, ok := d.GetOk("mins_to_bypass_mfa"); ok {
+		opts.ObjectProperties.MinsToBypassMFA = sdk.Int(v.(int))
+	}
 	err := client.Users.Create(ctx, objectIdentifier, opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
-	return ReadUser(d, meta)
+
+	if v, ok := d.GetOk("parameters"); ok {
+		object := sdk.Object{ObjectType: sdk.ObjectTypeUser, Name: objectIdentifier}
+		if err := client.Parameters.SetParametersOnObject(ctx, object, expandParameterSet(v)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting parameters on user %s: %w", name, err))
+		}
+	}
+	return ReadUser(ctx, d, meta)
 }
 
-func ReadUser(d *schema.ResourceData, meta interface{}) error {
+func ReadUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	// We use User.Describe instead of User.Show because the "SHOW USERS ..." command
 	// requires the "MANAGE GRANTS" global privilege
 	client := sdk.NewClientFromDB(db)
 	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
-	ctx := context.Background()
 	user, err := client.Users.Describe(ctx, objectIdentifier)
 	if err != nil {
 		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
@@ -251,65 +311,62 @@ func ReadUser(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := setStringProperty(d, "name", user.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setStringProperty(d, "comment", user.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setStringProperty(d, "login_name", user.LoginName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setBoolProperty(d, "disabled", user.Disabled); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setStringProperty(d, "default_role", user.DefaultRole); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var defaultSecondaryRoles []string
-	if user.DefaultSecondaryRoles != nil && len(user.DefaultSecondaryRoles.Value) > 0 {
-		defaultRoles, _ := strings.CutPrefix(user.DefaultSecondaryRoles.Value, "[\"")
-		defaultRoles, _ = strings.CutSuffix(defaultRoles, "\"]")
-		defaultSecondaryRoles = strings.Split(defaultRoles, ",")
+	if user.DefaultSecondaryRoles != nil {
+		defaultSecondaryRoles = parseUserSecondaryRoles(user.DefaultSecondaryRoles.Value)
 	}
 	if err = d.Set("default_secondary_roles", defaultSecondaryRoles); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setStringProperty(d, "default_namespace", user.DefaultNamespace); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setStringProperty(d, "default_warehouse", user.DefaultWarehouse); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if user.RsaPublicKeyFp != nil {
 		if err = d.Set("has_rsa_public_key", user.RsaPublicKeyFp.Value != ""); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if err := setStringProperty(d, "email", user.Email); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setStringProperty(d, "display_name", user.DisplayName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setStringProperty(d, "first_name", user.FirstName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := setStringProperty(d, "last_name", user.LastName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return nil
+	return diag.FromErr(readUserParameters(ctx, client, objectIdentifier, d))
 }
 
-func UpdateUser(d *schema.ResourceData, meta interface{}) error {
+func UpdateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 
-	ctx := context.Background()
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
 
 	if d.HasChange("name") {
@@ -321,7 +378,7 @@ func UpdateUser(d *schema.ResourceData, meta interface{}) error {
 		}
 		err := client.Users.Alter(ctx, id, alterOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(helpers.EncodeSnowflakeID(newID))
 		id = newID
@@ -347,6 +404,16 @@ func UpdateUser(d *schema.ResourceData, meta interface{}) error {
 		_, n := d.GetChange("password")
 		alterOptions.Set.ObjectProperties.Password = sdk.String(n.(string))
 	}
+	if d.HasChange("password_wo_version") {
+		password, ok, err := getWriteOnlyString(d, "password_wo")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if ok {
+			runSet = true
+			alterOptions.Set.ObjectProperties.Password = sdk.String(password)
+		}
+	}
 
 	if d.HasChange("disabled") {
 		runSet = true
@@ -372,18 +439,23 @@ func UpdateUser(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("default_secondary_roles") {
 		runSet = true
 		_, n := d.GetChange("default_secondary_roles")
-		roles := expandStringList(n.(*schema.Set).List())
-		secondaryRoles := []sdk.SecondaryRole{}
-		for _, role := range roles {
-			secondaryRoles = append(secondaryRoles, sdk.SecondaryRole{Value: role})
-		}
-		alterOptions.Set.ObjectProperties.DefaultSeconaryRoles = &sdk.SecondaryRoles{Roles: secondaryRoles}
+		alterOptions.Set.ObjectProperties.DefaultSeconaryRoles = expandUserSecondaryRoles(n)
 	}
 	if d.HasChange("rsa_public_key") {
 		runSet = true
 		_, n := d.GetChange("rsa_public_key")
 		alterOptions.Set.ObjectProperties.RSAPublicKey = sdk.String(n.(string))
 	}
+	if d.HasChange("rsa_public_key_wo_version") {
+		rsaPublicKey, ok, err := getWriteOnlyString(d, "rsa_public_key_wo")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if ok {
+			runSet = true
+			alterOptions.Set.ObjectProperties.RSAPublicKey = sdk.String(rsaPublicKey)
+		}
+	}
 	if d.HasChange("rsa_public_key_2") {
 		runSet = true
 		_, n := d.GetChange("rsa_public_key_2")
@@ -415,27 +487,63 @@ func UpdateUser(d *schema.ResourceData, meta interface{}) error {
 		_, n := d.GetChange("last_name")
 		alterOptions.Set.ObjectProperties.LastName = sdk.String(n.(string))
 	}
+	unsetProperties := &sdk.UserObjectPropertiesUnset{}
+	runUnset := false
+	for _, property := range []struct {
+		key   string
+		set   **int
+		unset **bool
+	}{
+		{"days_to_expiry", &alterOptions.Set.ObjectProperties.DaysToExpiry, &unsetProperties.DaysToExpiry},
+		{"mins_to_unlock", &alterOptions.Set.ObjectProperties.MinsToUnlock, &unsetProperties.MinsToUnlock},
+		{"mins_to_bypass_mfa", &alterOptions.Set.ObjectProperties.MinsToBypassMFA, &unsetProperties.MinsToBypassMFA},
+	} {
+		set, unset := updateUserIntProperty(d, property.key, property.set, property.unset)
+		runSet = runSet || set
+		runUnset = runUnset || unset
+	}
 	if runSet {
 		err := client.Users.Alter(ctx, id, alterOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
+	if runUnset {
+		err := client.Users.Alter(ctx, id, &sdk.AlterUserOptions{Unset: &sdk.UserUnset{ObjectProperties: unsetProperties}})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := updateUserParameters(ctx, client, id, d); err != nil {
+		return diag.FromErr(err)
+	}
 
-	return ReadUser(d, meta)
+	return ReadUser(ctx, d, meta)
 }
 
-func DeleteUser(d *schema.ResourceData, meta interface{}) error {
+func DeleteUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
 
 	err := client.Users.Drop(ctx, objectIdentifier)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
+
+// getWriteOnlyString returns the value of a write-only attribute. Write-only attributes are not a part of the plan
+// and the state, so they can be read only from the raw config.
+func getWriteOnlyString(d *schema.ResourceData, key string) (string, bool, error) {
+	value, diags := d.GetRawConfigAt(cty.GetAttrPath(key))
+	if diags.HasError() {
+		return "", false, fmt.Errorf("unable to read write-only attribute %s: %v", key, diags)
+	}
+	if !value.Type().Equals(cty.String) || value.IsNull() || !value.IsKnown() {
+		return "", false, nil
+	}
+	return value.AsString(), true, nil
+}
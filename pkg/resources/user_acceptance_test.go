@@ -257,3 +257,64 @@ func TestAcc_User_issue2058(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_User_WriteOnlyCredentials(t *testing.T) {
+	userName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: userWriteOnlyCredentialsConfig(userName, "First password 123", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_user.test", "name", userName),
+					resource.TestCheckResourceAttr("snowflake_user.test", "password_wo_version", "1"),
+					resource.TestCheckNoResourceAttr("snowflake_user.test", "password_wo"),
+					resource.TestCheckNoResourceAttr("snowflake_user.test", "password"),
+					resource.TestCheckNoResourceAttr("snowflake_user.test", "rsa_public_key_wo"),
+					checkBool("snowflake_user.test", "has_rsa_public_key", true),
+				),
+			},
+			// a new ephemeral key pair is generated on every run, but only the version change updates the user
+			{
+				Config: userWriteOnlyCredentialsConfig(userName, "First password 123", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: userWriteOnlyCredentialsConfig(userName, "Second password 456", 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_user.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_user.test", "password_wo_version", "2"),
+					resource.TestCheckNoResourceAttr("snowflake_user.test", "password_wo"),
+				),
+			},
+		},
+	})
+}
+
+func userWriteOnlyCredentialsConfig(name string, password string, version int) string {
+	return fmt.Sprintf(`
+ephemeral "snowflake_rsa_key_pair" "test" {}
+
+resource "snowflake_user" "test" {
+	name                      = "%[1]s"
+	password_wo               = "%[2]s"
+	password_wo_version       = %[3]d
+	rsa_public_key_wo         = ephemeral.snowflake_rsa_key_pair.test.public_key
+	rsa_public_key_wo_version = %[3]d
+}
+`, name, password, version)
+}
@@ -0,0 +1,133 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var userAuthenticationPolicyAttachmentSchema = map[string]*schema.Schema{
+	"user_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "User name of the user you want to attach the authentication policy to",
+	},
+	"authentication_policy_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Fully qualified name of the authentication policy",
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+}
+
+func UserAuthenticationPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Specifies the authentication policy to use for a certain user.",
+		CreateContext: CreateUserAuthenticationPolicyAttachment,
+		ReadContext:   ReadUserAuthenticationPolicyAttachment,
+		DeleteContext: DeleteUserAuthenticationPolicyAttachment,
+		Schema:        userAuthenticationPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateUserAuthenticationPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+
+	userName := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(d.Get("user_name").(string))
+	authenticationPolicy := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Get("authentication_policy_name").(string))
+
+	err := client.Users.Alter(ctx, userName, &sdk.AlterUserOptions{
+		Set: &sdk.UserSet{
+			AuthenticationPolicy: &authenticationPolicy,
+		},
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(userName.FullyQualifiedName(), authenticationPolicy.FullyQualifiedName()))
+
+	return ReadUserAuthenticationPolicyAttachment(ctx, d, meta)
+}
+
+func ReadUserAuthenticationPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+
+	parts := strings.Split(d.Id(), helpers.IDDelimiter)
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("required id format 'user_name|authentication_policy_name', but got: '%s'", d.Id()))
+	}
+
+	// Note: there is no alphanumeric id for an attachment, so we retrieve the authentication policies attached to a certain user.
+	userName := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(parts[0])
+	policyReferences, err := getUserPolicyReferences(ctx, client, userName, sdk.PolicyKindAuthenticationPolicy)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[DEBUG] user (%s) not found", userName.FullyQualifiedName())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// Note: this should never happen, but just in case: so far, Snowflake only allows one Authentication Policy per user.
+	if len(policyReferences) > 1 {
+		return diag.FromErr(fmt.Errorf("internal error: multiple policy references attached to a user. This should never happen"))
+	}
+
+	// Note: this means the resource has been deleted outside of Terraform.
+	if len(policyReferences) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("user_name", userName.Name()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(
+		"authentication_policy_name",
+		sdk.NewSchemaObjectIdentifier(
+			*policyReferences[0].PolicyDb,
+			*policyReferences[0].PolicySchema,
+			policyReferences[0].PolicyName,
+		).FullyQualifiedName()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(err)
+}
+
+func DeleteUserAuthenticationPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+
+	userName := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(d.Get("user_name").(string))
+
+	err := client.Users.Alter(ctx, userName, &sdk.AlterUserOptions{
+		Unset: &sdk.UserUnset{
+			AuthenticationPolicy: sdk.Bool(true),
+		},
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
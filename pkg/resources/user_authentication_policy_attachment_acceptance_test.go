@@ -0,0 +1,100 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_UserAuthenticationPolicyAttachment(t *testing.T) {
+	userName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	policyName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	newPolicyName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_user_authentication_policy_attachment.apa"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckUserAuthenticationPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: userAuthenticationPolicyAttachmentConfig(userName, acc.TestDatabaseName, acc.TestSchemaName, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "user_name", userName),
+					resource.TestCheckResourceAttr(resourceName, "authentication_policy_name", sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, policyName).FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s|%s", sdk.NewAccountObjectIdentifier(userName).FullyQualifiedName(), sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, policyName).FullyQualifiedName())),
+				),
+			},
+			{
+				Config: userAuthenticationPolicyAttachmentConfig(userName, acc.TestDatabaseName, acc.TestSchemaName, newPolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "authentication_policy_name", sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, newPolicyName).FullyQualifiedName()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckUserAuthenticationPolicyAttachmentDestroy(s *terraform.State) error {
+	db := acc.TestAccProvider.Meta().(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "snowflake_user_authentication_policy_attachment" {
+			continue
+		}
+		policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(
+			sdk.NewAccountObjectIdentifierFromFullyQualifiedName(rs.Primary.Attributes["user_name"]),
+			sdk.PolicyEntityDomainUser,
+		))
+		if err != nil {
+			if strings.Contains(err.Error(), "does not exist or not authorized") {
+				// Note: this can happen if the Policy Reference or the User has been deleted as well; in this case, ignore the error
+				continue
+			}
+			return err
+		}
+		for _, policyReference := range policyReferences {
+			if policyReference.PolicyKind == string(sdk.PolicyKindAuthenticationPolicy) {
+				return fmt.Errorf("user authentication policy attachment %v still exists", policyReference.PolicyName)
+			}
+		}
+	}
+	return nil
+}
+
+func userAuthenticationPolicyAttachmentConfig(userName, databaseName, schemaName, policyName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_user" "user" {
+	name = "%s"
+}
+
+resource "snowflake_authentication_policy" "ap" {
+	database = "%s"
+	schema   = "%s"
+	name     = "%s"
+}
+
+resource "snowflake_user_authentication_policy_attachment" "apa" {
+	authentication_policy_name = snowflake_authentication_policy.ap.qualified_name
+	user_name                  = snowflake_user.user.name
+}
+`, userName, databaseName, schemaName, policyName)
+}
@@ -0,0 +1,191 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// userParameters lists the parameters which can be set on a user with the parameters map. NETWORK_POLICY is left out,
+// because it is managed by the snowflake_network_policy_attachment resource.
+var userParameters = []sdk.UserParameter{
+	sdk.UserParameterEnableUnredactedQuerySyntaxError,
+	sdk.UserParameterAbortDetachedQuery,
+	sdk.UserParameterAutocommit,
+	sdk.UserParameterBinaryInputFormat,
+	sdk.UserParameterBinaryOutputFormat,
+	sdk.UserParameterClientMetadataRequestUseConnectionCtx,
+	sdk.UserParameterClientMetadataUseSessionDatabase,
+	sdk.UserParameterClientResultColumnCaseInsensitive,
+	sdk.UserParameterDateInputFormat,
+	sdk.UserParameterDateOutputFormat,
+	sdk.UserParameterErrorOnNondeterministicMerge,
+	sdk.UserParameterErrorOnNondeterministicUpdate,
+	sdk.UserParameterGeographyOutputFormat,
+	sdk.UserParameterJsonIndent,
+	sdk.UserParameterLockTimeout,
+	sdk.UserParameterMultiStatementCount,
+	sdk.UserParameterQueryTag,
+	sdk.UserParameterQuotedIdentifiersIgnoreCase,
+	sdk.UserParameterRowsPerResultset,
+	sdk.UserParameterSimulatedDataSharingConsumer,
+	sdk.UserParameterStatementTimeoutInSeconds,
+	sdk.UserParameterStrictJsonOutput,
+	sdk.UserParameterTimeInputFormat,
+	sdk.UserParameterTimeOutputFormat,
+	sdk.UserParameterTimestampDayIsAlways24h,
+	sdk.UserParameterTimestampInputFormat,
+	sdk.UserParameterTimestampLtzOutputFormat,
+	sdk.UserParameterTimestampNtzOutputFormat,
+	sdk.UserParameterTimestampOutputFormat,
+	sdk.UserParameterTimestampTypeMapping,
+	sdk.UserParameterTimestampTzOutputFormat,
+	sdk.UserParameterTimezone,
+	sdk.UserParameterTransactionDefaultIsolationLevel,
+	sdk.UserParameterTwoDigitCenturyStart,
+	sdk.UserParameterUnsupportedDdlAction,
+	sdk.UserParameterUseCachedResult,
+	sdk.UserParameterWeekOfYearPolicy,
+	sdk.UserParameterWeekStart,
+}
+
+func isUserParameter(key string) bool {
+	return slices.Contains(userParameters, sdk.UserParameter(key))
+}
+
+func userParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Map of user-level parameter names (in upper case, e.g. `STATEMENT_TIMEOUT_IN_SECONDS`) to their values. Only the parameters in the map are read back, so a parameter of the map changed or unset outside of Terraform shows up as a difference, and the parameters removed from the map are unset. Do not manage the same parameter of the user with the snowflake_object_parameter or snowflake_parameter_set resources, as they would overwrite each other. NETWORK_POLICY is not supported; use the snowflake_network_policy_attachment resource instead.",
+		ValidateDiagFunc: func(v any, path cty.Path) diag.Diagnostics {
+			var diags diag.Diagnostics
+			for k := range v.(map[string]any) {
+				if !isUserParameter(k) {
+					diags = append(diags, diag.Diagnostic{
+						Severity:      diag.Error,
+						Summary:       "Invalid user parameter",
+						Detail:        fmt.Sprintf("%s is not a user parameter; the names have to be upper case", k),
+						AttributePath: path,
+					})
+				}
+			}
+			return diags
+		},
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
+	}
+}
+
+// readUserParameters sets the parameters map to the parameters of the map (in the configuration or in the state) which
+// are set directly on the user. The other parameters set on the user are left out, as they may be managed by the
+// snowflake_object_parameter or snowflake_parameter_set resources.
+func readUserParameters(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, d *schema.ResourceData) error {
+	known := d.Get("parameters").(map[string]any)
+	if len(known) == 0 {
+		return nil
+	}
+	parameters, err := client.Parameters.ShowParametersForObject(ctx, sdk.Object{ObjectType: sdk.ObjectTypeUser, Name: id})
+	if err != nil {
+		return err
+	}
+	setOnUser := make(map[string]any)
+	for _, parameter := range parameters {
+		if _, ok := known[parameter.Key]; ok && parameter.Level == sdk.ParameterTypeUser {
+			setOnUser[parameter.Key] = parameter.Value
+		}
+	}
+	return d.Set("parameters", setOnUser)
+}
+
+// updateUserParameters unsets the parameters removed from the parameters map and sets the added or changed ones.
+func updateUserParameters(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, d *schema.ResourceData) error {
+	if !d.HasChange("parameters") {
+		return nil
+	}
+	object := sdk.Object{ObjectType: sdk.ObjectTypeUser, Name: id}
+	toUnset, toSet := diffParameterSet(d.GetChange("parameters"))
+
+	if err := client.Parameters.UnsetParametersOnObject(ctx, object, toUnset); err != nil {
+		return fmt.Errorf("error unsetting parameters on user %s: %w", id.Name(), err)
+	}
+	if err := client.Parameters.SetParametersOnObject(ctx, object, toSet); err != nil {
+		return fmt.Errorf("error setting parameters on user %s: %w", id.Name(), err)
+	}
+	return nil
+}
+
+// parseUserSecondaryRoles parses the default secondary roles, returned as e.g. ["ALL"].
+func parseUserSecondaryRoles(value string) []string {
+	if value == "" {
+		return nil
+	}
+	roles, _ := strings.CutPrefix(value, "[\"")
+	roles, _ = strings.CutSuffix(roles, "\"]")
+	if roles == "" || roles == "[]" {
+		return nil
+	}
+	return strings.Split(roles, ",")
+}
+
+func expandUserSecondaryRoles(v any) *sdk.SecondaryRoles {
+	roles := expandStringList(v.(*schema.Set).List())
+	secondaryRoles := []sdk.SecondaryRole{}
+	for _, role := range roles {
+		secondaryRoles = append(secondaryRoles, sdk.SecondaryRole{Value: role})
+	}
+	return &sdk.SecondaryRoles{Roles: secondaryRoles}
+}
+
+func userDaysToExpirySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Specifies the number of days after which the user is disabled. The value counts down in Snowflake, so it is not read back; changing it sets the new value, and removing it unsets the expiration.",
+	}
+}
+
+func userMinsToUnlockSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Specifies the number of minutes until the temporary lock on the user login is cleared. The value counts down in Snowflake, so it is not read back; changing it sets the new value, and removing it unsets the lock.",
+	}
+}
+
+// updateUserIntProperty fills the SET or the UNSET option of a changed integer property. It returns which of the two
+// statements has to be run.
+func updateUserIntProperty(d *schema.ResourceData, key string, set **int, unset **bool) (bool, bool) {
+	if !d.HasChange(key) {
+		return false, false
+	}
+	if v, ok := d.GetOk(key); ok {
+		*set = sdk.Int(v.(int))
+		return true, false
+	}
+	*unset = sdk.Bool(true)
+	return false, true
+}
+
+// getUserPolicyReferences returns the policies of the given kind attached to the user. Filtering by the kind is needed,
+// because a user can have e.g. both password and authentication policies attached at the same time.
+func getUserPolicyReferences(ctx context.Context, client *sdk.Client, userName sdk.AccountObjectIdentifier, kind sdk.PolicyKind) ([]sdk.PolicyReference, error) {
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(userName, sdk.PolicyEntityDomainUser))
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(policyReferences, func(policyReference sdk.PolicyReference) bool {
+		return policyReference.PolicyKind != string(kind)
+	}), nil
+}
@@ -0,0 +1,96 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUserSecondaryRoles(t *testing.T) {
+	assert.Nil(t, parseUserSecondaryRoles(""))
+	assert.Nil(t, parseUserSecondaryRoles("[]"))
+	assert.Equal(t, []string{"ALL"}, parseUserSecondaryRoles(`["ALL"]`))
+}
+
+func TestUserParametersValidation(t *testing.T) {
+	validate := userParametersSchema().ValidateDiagFunc
+
+	assert.False(t, validate(map[string]any{"STATEMENT_TIMEOUT_IN_SECONDS": "60", "QUERY_TAG": "etl"}, nil).HasError())
+	assert.True(t, validate(map[string]any{"statement_timeout_in_seconds": "60"}, nil).HasError())
+	assert.True(t, validate(map[string]any{"NETWORK_POLICY": "policy"}, nil).HasError())
+	assert.True(t, validate(map[string]any{"MAX_CONCURRENCY_LEVEL": "8"}, nil).HasError())
+}
+
+func TestReadUserParameters(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	client := sdk.NewClientFromDB(db)
+	id := sdk.NewAccountObjectIdentifier("user")
+	userSchema := map[string]*schema.Schema{"parameters": userParametersSchema()}
+
+	t.Run("only the parameters of the map are read", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, userSchema, map[string]any{
+			"parameters": map[string]any{"STATEMENT_TIMEOUT_IN_SECONDS": "60", "QUERY_TAG": "etl"},
+		})
+		mock.ExpectQuery(`SHOW PARAMETERS IN USER "user"`).WillReturnRows(sqlmock.NewRows([]string{"key", "value", "default", "level", "description"}).
+			AddRow("STATEMENT_TIMEOUT_IN_SECONDS", "120", "172800", "USER", "").
+			AddRow("QUERY_TAG", "", "", "", "").
+			AddRow("TIMEZONE", "UTC", "America/Los_Angeles", "USER", ""))
+		require.NoError(t, readUserParameters(context.Background(), client, id, d))
+		assert.Equal(t, map[string]any{"STATEMENT_TIMEOUT_IN_SECONDS": "120"}, d.Get("parameters"))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("no parameters are read without the map", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, userSchema, map[string]any{})
+		require.NoError(t, readUserParameters(context.Background(), client, id, d))
+		assert.Empty(t, d.Get("parameters"))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestServiceUserSchema(t *testing.T) {
+	serviceUser := ServiceUser().Schema
+	legacyServiceUser := LegacyServiceUser().Schema
+
+	for _, key := range []string{"password", "password_wo", "must_change_password", "first_name", "last_name", "mins_to_bypass_mfa"} {
+		assert.NotContains(t, serviceUser, key)
+	}
+	for _, key := range []string{"first_name", "last_name", "mins_to_bypass_mfa"} {
+		assert.NotContains(t, legacyServiceUser, key)
+	}
+	assert.Contains(t, legacyServiceUser, "password")
+	assert.Contains(t, legacyServiceUser, "must_change_password")
+}
+
+func TestServiceUserCustomizeDiff(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]any{"name": "user"})
+	state := func(userType string) *terraform.InstanceState {
+		return &terraform.InstanceState{ID: `"user"`, Attributes: map[string]string{
+			"name":      "user",
+			"disabled":  "false",
+			"user_type": userType,
+		}}
+	}
+
+	t.Run("matching type", func(t *testing.T) {
+		instanceDiff, err := ServiceUser().Diff(context.Background(), state(string(sdk.UserTypeService)), config, nil)
+		require.NoError(t, err)
+		assert.Nil(t, instanceDiff)
+	})
+
+	t.Run("type changed outside of terraform", func(t *testing.T) {
+		instanceDiff, err := ServiceUser().Diff(context.Background(), state(string(sdk.UserTypePerson)), config, nil)
+		require.NoError(t, err)
+		require.NotNil(t, instanceDiff)
+		assert.Equal(t, string(sdk.UserTypeService), instanceDiff.Attributes["user_type"].New)
+		assert.False(t, instanceDiff.RequiresNew())
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -55,10 +56,10 @@ var userGrantSchema = map[string]*schema.Schema{
 func UserGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateUserGrant,
-			Read:               ReadUserGrant,
-			Delete:             DeleteUserGrant,
-			Update:             UpdateUserGrant,
+			CreateContext:      CreateUserGrant,
+			ReadContext:        ReadUserGrant,
+			DeleteContext:      DeleteUserGrant,
+			UpdateContext:      UpdateUserGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             userGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -87,25 +88,25 @@ func UserGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateUserGrant implements schema.CreateFunc.
-func CreateUserGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateUserGrant implements schema.CreateContextFunc.
+func CreateUserGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userName := d.Get("user_name").(string)
 	privilege := d.Get("privilege").(string)
 	withGrantOption := d.Get("with_grant_option").(bool)
 	builder := snowflake.UserGrant(userName)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
-	if err := createGenericGrant(d, meta, builder); err != nil {
-		return err
+	if err := createGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
 	}
 	grantID := helpers.EncodeSnowflakeID(userName, privilege, withGrantOption, roles)
 	d.SetId(grantID)
 
-	return ReadUserGrant(d, meta)
+	return ReadUserGrant(ctx, d, meta)
 }
 
-// ReadUserGrant implements schema.ReadFunc.
-func ReadUserGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadUserGrant implements schema.ReadContextFunc.
+func ReadUserGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userName := d.Get("user_name").(string)
 	privilege := d.Get("privilege").(string)
 	withGrantOption := d.Get("with_grant_option").(bool)
@@ -113,9 +114,9 @@ func ReadUserGrant(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.UserGrant(userName)
 
-	err := readGenericGrant(d, meta, userGrantSchema, builder, false, false, validUserPrivileges)
+	err := readGenericGrant(ctx, d, meta, userGrantSchema, builder, false, false, validUserPrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(userName, privilege, withGrantOption, roles)
@@ -125,17 +126,17 @@ func ReadUserGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteUserGrant implements schema.DeleteFunc.
-func DeleteUserGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteUserGrant implements schema.DeleteContextFunc.
+func DeleteUserGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userName := d.Get("user_name").(string)
 
 	builder := snowflake.UserGrant(userName)
 
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateUserGrant implements schema.UpdateFunc.
-func UpdateUserGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateUserGrant implements schema.UpdateContextFunc.
+func UpdateUserGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update is roles. if nothing changed,
 	// nothing to update and we're done.
 	if !d.HasChanges("roles") {
@@ -153,6 +154,7 @@ func UpdateUserGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
+		ctx,
 		meta,
 		builder,
 		privilege,
@@ -160,11 +162,12 @@ func UpdateUserGrant(d *schema.ResourceData, meta interface{}) error {
 		rolesToRevoke,
 		nil,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// then add
 	if err := createGenericGrantRolesAndShares(
+		ctx,
 		meta,
 		builder,
 		privilege,
@@ -172,9 +175,9 @@ func UpdateUserGrant(d *schema.ResourceData, meta interface{}) error {
 		rolesToAdd,
 		nil,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadUserGrant(d, meta)
+	return ReadUserGrant(ctx, d, meta)
 }
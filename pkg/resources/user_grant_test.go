@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -34,8 +35,8 @@ func TestUserGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT MONITOR ON USER "test-user" TO ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT MONITOR ON USER "test-user" TO ROLE "test-role-2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadUserGrant(mock)
-		err := resources.CreateUserGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateUserGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -53,42 +55,42 @@ var userOwnershipGrantSchema = map[string]*schema.Schema{
 
 func UserOwnershipGrant() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateUserOwnershipGrant,
-		Read:   ReadUserOwnershipGrant,
-		Delete: DeleteUserOwnershipGrant,
-		Update: UpdateUserOwnershipGrant,
-		Schema: userOwnershipGrantSchema,
+		CreateContext: CreateUserOwnershipGrant,
+		ReadContext:   ReadUserOwnershipGrant,
+		DeleteContext: DeleteUserOwnershipGrant,
+		UpdateContext: UpdateUserOwnershipGrant,
+		Schema:        userOwnershipGrantSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 
-func CreateUserOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
+func CreateUserOwnershipGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	user := d.Get("on_user_name").(string)
 	role := d.Get("to_role_name").(string)
 	currentGrants := d.Get("current_grants").(string)
 
 	g := snowflake.NewUserOwnershipGrantBuilder(user, currentGrants)
-	err := snowflake.Exec(db, g.Role(role).Grant())
+	err := snowflake.Exec(ctx, db, g.Role(role).Grant())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf(`%s|%s|%s`, user, role, currentGrants))
 
-	return ReadUserOwnershipGrant(d, meta)
+	return ReadUserOwnershipGrant(ctx, d, meta)
 }
 
-func ReadUserOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
+func ReadUserOwnershipGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	log.Println(d.Id())
 	user := strings.Split(d.Id(), "|")[0]
 	currentGrants := strings.Split(d.Id(), "|")[2]
 
 	stmt := fmt.Sprintf("SHOW USERS LIKE '%s'", user)
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRow(ctx, db, stmt)
 
 	grant, err := snowflake.ScanUserOwnershipGrant(row)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -98,36 +100,36 @@ func ReadUserOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if user != grant.Name.String {
-		return fmt.Errorf("no user found like '%s'", user)
+		return diag.FromErr(fmt.Errorf("no user found like '%s'", user))
 	}
 
 	grant.Name.String = strings.TrimPrefix(grant.Name.String, `"`)
 	grant.Name.String = strings.TrimSuffix(grant.Name.String, `"`)
 	err = d.Set("on_user_name", grant.Name.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant.Owner.String = strings.TrimPrefix(grant.Owner.String, `"`)
 	grant.Owner.String = strings.TrimSuffix(grant.Owner.String, `"`)
 	err = d.Set("to_role_name", grant.Owner.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("current_grants", currentGrants)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func UpdateUserOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
+func UpdateUserOwnershipGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	user := d.Get("on_user_name").(string)
 	role := d.Get("to_role_name").(string)
@@ -136,24 +138,24 @@ func UpdateUserOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(fmt.Sprintf(`%s|%s|%s`, user, role, currentGrants))
 
 	g := snowflake.NewUserOwnershipGrantBuilder(user, currentGrants)
-	err := snowflake.Exec(db, g.Role(role).Grant())
+	err := snowflake.Exec(ctx, db, g.Role(role).Grant())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return ReadUserOwnershipGrant(d, meta)
+	return ReadUserOwnershipGrant(ctx, d, meta)
 }
 
-func DeleteUserOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
+func DeleteUserOwnershipGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	user := d.Get("on_user_name").(string)
 	currentGrants := d.Get("current_grants").(string)
 	reversionRole := d.Get("revert_ownership_to_role_name").(string)
 
 	g := snowflake.NewUserOwnershipGrantBuilder(user, currentGrants)
-	err := snowflake.Exec(db, g.Role(reversionRole).Revoke())
+	err := snowflake.Exec(ctx, db, g.Role(reversionRole).Revoke())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -29,8 +30,8 @@ func TestUserOwnershipGrantCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`GRANT OWNERSHIP ON USER "user1" TO ROLE "role1" COPY CURRENT GRANTS`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadUserOwnershipGrant(mock)
-		err := resources.CreateUserOwnershipGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateUserOwnershipGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -45,8 +46,8 @@ func TestUserOwnershipGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadUserOwnershipGrant(mock)
-		err := resources.ReadUserOwnershipGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadUserOwnershipGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -93,7 +94,7 @@ func TestUserOwnershipGrantDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`GRANT OWNERSHIP ON USER "user1" TO ROLE "ACCOUNTADMIN" COPY CURRENT GRANTS`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteUserOwnershipGrant(d, db)
-		r.NoError(err)
+		diags := resources.DeleteUserOwnershipGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
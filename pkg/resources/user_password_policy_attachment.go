@@ -8,6 +8,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -29,21 +30,20 @@ var userPasswordPolicyAttachmentSchema = map[string]*schema.Schema{
 
 func UserPasswordPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Description: "Specifies the password policy to use for a certain user.",
-		Create:      CreateUserPasswordPolicyAttachment,
-		Read:        ReadUserPasswordPolicyAttachment,
-		Delete:      DeleteUserPasswordPolicyAttachment,
-		Schema:      userPasswordPolicyAttachmentSchema,
+		Description:   "Specifies the password policy to use for a certain user.",
+		CreateContext: CreateUserPasswordPolicyAttachment,
+		ReadContext:   ReadUserPasswordPolicyAttachment,
+		DeleteContext: DeleteUserPasswordPolicyAttachment,
+		Schema:        userPasswordPolicyAttachmentSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 
-func CreateUserPasswordPolicyAttachment(d *schema.ResourceData, meta any) error {
+func CreateUserPasswordPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	userName := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(d.Get("user_name").(string))
 	passwordPolicy := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Get("password_policy_name").(string))
@@ -54,34 +54,33 @@ func CreateUserPasswordPolicyAttachment(d *schema.ResourceData, meta any) error
 		},
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(userName.FullyQualifiedName(), passwordPolicy.FullyQualifiedName()))
 
-	return ReadUserPasswordPolicyAttachment(d, meta)
+	return ReadUserPasswordPolicyAttachment(ctx, d, meta)
 }
 
-func ReadUserPasswordPolicyAttachment(d *schema.ResourceData, meta any) error {
+func ReadUserPasswordPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	parts := strings.Split(d.Id(), helpers.IDDelimiter)
 	if len(parts) != 2 {
-		return fmt.Errorf("required id format 'user_name|password_policy_name', but got: '%s'", d.Id())
+		return diag.FromErr(fmt.Errorf("required id format 'user_name|password_policy_name', but got: '%s'", d.Id()))
 	}
 
 	// Note: there is no alphanumeric id for an attachment, so we retrieve the password policies attached to a certain user.
 	userName := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(parts[0])
-	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(userName, sdk.PolicyEntityDomainUser))
+	policyReferences, err := getUserPolicyReferences(ctx, client, userName, sdk.PolicyKindPasswordPolicy)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Note: this should never happen, but just in case: so far, Snowflake only allows one Password Policy per user.
 	if len(policyReferences) > 1 {
-		return fmt.Errorf("internal error: multiple policy references attached to a user. This should never happen")
+		return diag.FromErr(fmt.Errorf("internal error: multiple policy references attached to a user. This should never happen"))
 	}
 
 	// Note: this means the resource has been deleted outside of Terraform.
@@ -91,7 +90,7 @@ func ReadUserPasswordPolicyAttachment(d *schema.ResourceData, meta any) error {
 	}
 
 	if err := d.Set("user_name", userName.Name()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set(
 		"password_policy_name",
@@ -100,16 +99,15 @@ func ReadUserPasswordPolicyAttachment(d *schema.ResourceData, meta any) error {
 			*policyReferences[0].PolicySchema,
 			policyReferences[0].PolicyName,
 		).FullyQualifiedName()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-func DeleteUserPasswordPolicyAttachment(d *schema.ResourceData, meta any) error {
+func DeleteUserPasswordPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	userName := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(d.Get("user_name").(string))
 
@@ -119,7 +117,7 @@ func DeleteUserPasswordPolicyAttachment(d *schema.ResourceData, meta any) error
 		},
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -10,6 +10,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -49,10 +50,10 @@ var userPublicKeysSchema = map[string]*schema.Schema{
 
 func UserPublicKeys() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateUserPublicKeys,
-		Read:   ReadUserPublicKeys,
-		Update: UpdateUserPublicKeys,
-		Delete: DeleteUserPublicKeys,
+		CreateContext: CreateUserPublicKeys,
+		ReadContext:   ReadUserPublicKeys,
+		UpdateContext: UpdateUserPublicKeys,
+		DeleteContext: DeleteUserPublicKeys,
 
 		Schema: userPublicKeysSchema,
 		Importer: &schema.ResourceImporter{
@@ -61,8 +62,7 @@ func UserPublicKeys() *schema.Resource {
 	}
 }
 
-func checkUserExists(db *sql.DB, name string) (bool, error) {
-	ctx := context.Background()
+func checkUserExists(ctx context.Context, db *sql.DB, name string) (bool, error) {
 	client := sdk.NewClientFromDB(db)
 
 	// First check if user exists
@@ -78,13 +78,13 @@ func checkUserExists(db *sql.DB, name string) (bool, error) {
 	return true, nil
 }
 
-func ReadUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
+func ReadUserPublicKeys(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
-	exists, err := checkUserExists(db, id)
+	exists, err := checkUserExists(ctx, db, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// If not found, mark resource to be removed from state file during apply or refresh
 	if !exists {
@@ -95,7 +95,7 @@ func ReadUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func CreateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
+func CreateUserPublicKeys(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 
@@ -104,17 +104,17 @@ func CreateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 		if !publicKeyOK {
 			continue
 		}
-		err := updateUserPublicKeys(db, name, prop, publicKey.(string))
+		err := updateUserPublicKeys(ctx, db, name, prop, publicKey.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(name)
-	return ReadUserPublicKeys(d, meta)
+	return ReadUserPublicKeys(ctx, d, meta)
 }
 
-func UpdateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
+func UpdateUserPublicKeys(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Id()
 
@@ -137,31 +137,31 @@ func UpdateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 
 	// set the keys we decided should be set
 	for prop, value := range propsToSet {
-		err := updateUserPublicKeys(db, name, prop, value)
+		err := updateUserPublicKeys(ctx, db, name, prop, value)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	// unset the keys we decided should be unset
 	for k := range propsToUnset {
-		err := unsetUserPublicKeys(db, name, k)
+		err := unsetUserPublicKeys(ctx, db, name, k)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	// re-sync
-	return ReadUserPublicKeys(d, meta)
+	return ReadUserPublicKeys(ctx, d, meta)
 }
 
-func DeleteUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
+func DeleteUserPublicKeys(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Id()
 
 	for _, prop := range userPublicKeyProperties {
-		err := unsetUserPublicKeys(db, name, prop)
+		err := unsetUserPublicKeys(ctx, db, name, prop)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -169,12 +169,12 @@ func DeleteUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func updateUserPublicKeys(db *sql.DB, name string, prop string, value string) error {
+func updateUserPublicKeys(ctx context.Context, db *sql.DB, name string, prop string, value string) error {
 	stmt := fmt.Sprintf(`ALTER USER "%s" SET %s = '%s'`, name, prop, value)
-	return snowflake.Exec(db, stmt)
+	return snowflake.Exec(ctx, db, stmt)
 }
 
-func unsetUserPublicKeys(db *sql.DB, name string, prop string) error {
+func unsetUserPublicKeys(ctx context.Context, db *sql.DB, name string, prop string) error {
 	stmt := fmt.Sprintf(`ALTER USER "%s" UNSET %s`, name, prop)
-	return snowflake.Exec(db, stmt)
+	return snowflake.Exec(ctx, db, stmt)
 }
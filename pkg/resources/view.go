@@ -5,16 +5,14 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
-	"regexp"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-var space = regexp.MustCompile(`\s+`)
-
 var viewSchema = map[string]*schema.Schema{
 	"name": {
 		Type:        schema.TypeString,
@@ -71,16 +69,17 @@ var viewSchema = map[string]*schema.Schema{
 		Computed:    true,
 		Description: "The timestamp at which the view was created.",
 	},
-	"tag": tagReferenceSchema,
+	"tag":                  tagReferenceSchema,
+	"statement_comparison": statementComparisonSchema,
 }
 
 // View returns a pointer to the resource representing a view.
 func View() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateView,
-		Read:   ReadView,
-		Update: UpdateView,
-		Delete: DeleteView,
+		CreateContext: CreateView,
+		ReadContext:   ReadView,
+		UpdateContext: UpdateView,
+		DeleteContext: DeleteView,
 
 		Schema: viewSchema,
 		Importer: &schema.ResourceImporter{
@@ -89,10 +88,9 @@ func View() *schema.Resource {
 	}
 }
 
-// CreateView implements schema.CreateFunc.
-func CreateView(d *schema.ResourceData, meta interface{}) error {
+// CreateView implements schema.CreateContextFunc.
+func CreateView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 
 	databaseName := d.Get("database").(string)
@@ -121,7 +119,7 @@ func CreateView(d *schema.ResourceData, meta interface{}) error {
 
 	err := client.Views.Create(ctx, createRequest)
 	if err != nil {
-		return fmt.Errorf("error creating view %v err = %w", name, err)
+		return diag.FromErr(fmt.Errorf("error creating view %v err = %w", name, err))
 	}
 
 	// TODO [SNOW-867235]: we have to set tags after creation because existing view extractor is not aware of TAG during CREATE
@@ -129,19 +127,18 @@ func CreateView(d *schema.ResourceData, meta interface{}) error {
 	if _, ok := d.GetOk("tag"); ok {
 		err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithSetTags(getPropertyTags(d, "tag")))
 		if err != nil {
-			return fmt.Errorf("error setting tags on view %v, err = %w", id, err)
+			return diag.FromErr(fmt.Errorf("error setting tags on view %v, err = %w", id, err))
 		}
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(id))
 
-	return ReadView(d, meta)
+	return ReadView(ctx, d, meta)
 }
 
-// ReadView implements schema.ReadFunc.
-func ReadView(d *schema.ResourceData, meta interface{}) error {
+// ReadView implements schema.ReadContextFunc.
+func ReadView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
@@ -153,25 +150,25 @@ func ReadView(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err = d.Set("name", view.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("is_secure", view.IsSecure); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("copy_grants", view.HasCopyGrants()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("comment", view.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("schema", view.SchemaName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("database", view.DatabaseName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("created_on", view.CreatedOn); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// TODO [SNOW-867235]: what do we do with these extractors (added as discussion topic)?
@@ -179,20 +176,19 @@ func ReadView(d *schema.ResourceData, meta interface{}) error {
 	extractor := snowflake.NewViewSelectStatementExtractor(view.Text)
 	substringOfQuery, err := extractor.Extract()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("statement", substringOfQuery); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-// UpdateView implements schema.UpdateFunc.
-func UpdateView(d *schema.ResourceData, meta interface{}) error {
+// UpdateView implements schema.UpdateContextFunc.
+func UpdateView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
@@ -216,7 +212,7 @@ func UpdateView(d *schema.ResourceData, meta interface{}) error {
 
 		err := client.Views.Create(ctx, createRequest)
 		if err != nil {
-			return fmt.Errorf("error when changing property on %v and performing create or replace to update view statements, err = %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error when changing property on %v and performing create or replace to update view statements, err = %w", d.Id(), err))
 		}
 	}
 
@@ -227,7 +223,7 @@ func UpdateView(d *schema.ResourceData, meta interface{}) error {
 
 		err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithRenameTo(&newId))
 		if err != nil {
-			return fmt.Errorf("error renaming view %v err = %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error renaming view %v err = %w", d.Id(), err))
 		}
 
 		d.SetId(helpers.EncodeSnowflakeID(newId))
@@ -238,12 +234,12 @@ func UpdateView(d *schema.ResourceData, meta interface{}) error {
 		if comment := d.Get("comment").(string); comment == "" {
 			err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithUnsetComment(sdk.Bool(true)))
 			if err != nil {
-				return fmt.Errorf("error unsetting comment for view %v", d.Id())
+				return diag.FromErr(fmt.Errorf("error unsetting comment for view %v", d.Id()))
 			}
 		} else {
 			err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithSetComment(sdk.String(comment)))
 			if err != nil {
-				return fmt.Errorf("error updating comment for view %v", d.Id())
+				return diag.FromErr(fmt.Errorf("error updating comment for view %v", d.Id()))
 			}
 		}
 	}
@@ -252,12 +248,12 @@ func UpdateView(d *schema.ResourceData, meta interface{}) error {
 		if d.Get("is_secure").(bool) {
 			err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithSetSecure(sdk.Bool(true)))
 			if err != nil {
-				return fmt.Errorf("error setting secure for view %v", d.Id())
+				return diag.FromErr(fmt.Errorf("error setting secure for view %v", d.Id()))
 			}
 		} else {
 			err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithUnsetSecure(sdk.Bool(true)))
 			if err != nil {
-				return fmt.Errorf("error unsetting secure for view %v", d.Id())
+				return diag.FromErr(fmt.Errorf("error unsetting secure for view %v", d.Id()))
 			}
 		}
 	}
@@ -268,31 +264,30 @@ func UpdateView(d *schema.ResourceData, meta interface{}) error {
 		if len(unsetTags) > 0 {
 			err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithUnsetTags(unsetTags))
 			if err != nil {
-				return fmt.Errorf("error unsetting tags on %v, err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error unsetting tags on %v, err = %w", d.Id(), err))
 			}
 		}
 
 		if len(setTags) > 0 {
 			err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(id).WithSetTags(setTags))
 			if err != nil {
-				return fmt.Errorf("error setting tags on %v, err = %w", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("error setting tags on %v, err = %w", d.Id(), err))
 			}
 		}
 	}
 
-	return ReadView(d, meta)
+	return ReadView(ctx, d, meta)
 }
 
-// DeleteView implements schema.DeleteFunc.
-func DeleteView(d *schema.ResourceData, meta interface{}) error {
+// DeleteView implements schema.DeleteContextFunc.
+func DeleteView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
 	err := client.Views.Drop(ctx, sdk.NewDropViewRequest(id))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -104,10 +105,10 @@ var viewGrantSchema = map[string]*schema.Schema{
 func ViewGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateViewGrant,
-			Read:               ReadViewGrant,
-			Delete:             DeleteViewGrant,
-			Update:             UpdateViewGrant,
+			CreateContext:      CreateViewGrant,
+			ReadContext:        ReadViewGrant,
+			DeleteContext:      DeleteViewGrant,
+			UpdateContext:      UpdateViewGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             viewGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -153,8 +154,8 @@ func ViewGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateViewGrant implements schema.CreateFunc.
-func CreateViewGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateViewGrant implements schema.CreateContextFunc.
+func CreateViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	viewName := d.Get("view_name").(string)
 	var schemaName string
 	if _, ok := d.GetOk("schema_name"); ok {
@@ -165,19 +166,19 @@ func CreateViewGrant(d *schema.ResourceData, meta interface{}) error {
 	onFuture := d.Get("on_future").(bool)
 	onAll := d.Get("on_all").(bool)
 	if onFuture && onAll {
-		return errors.New("on_future and on_all cannot both be true")
+		return diag.FromErr(errors.New("on_future and on_all cannot both be true"))
 	}
 	withGrantOption := d.Get("with_grant_option").(bool)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 	shares := expandStringList(d.Get("shares").(*schema.Set).List())
 	if (schemaName == "") && !onFuture && !onAll {
-		return errors.New("schema_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future or on_all is true"))
 	}
 	if (viewName == "") && !onFuture && !onAll {
-		return errors.New("view_name must be set unless on_future or on_all is true")
+		return diag.FromErr(errors.New("view_name must be set unless on_future or on_all is true"))
 	}
 	if (viewName != "") && onFuture && onAll {
-		return errors.New("view_name must be empty if on_future and on_all are true")
+		return diag.FromErr(errors.New("view_name must be empty if on_future and on_all are true"))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -190,17 +191,17 @@ func CreateViewGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.ViewGrant(databaseName, schemaName, viewName)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, viewName, privilege, withGrantOption, onFuture, onAll, roles, shares)
 	d.SetId(grantID)
-	return ReadViewGrant(d, meta)
+	return ReadViewGrant(ctx, d, meta)
 }
 
-// ReadViewGrant implements schema.ReadFunc.
-func ReadViewGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadViewGrant implements schema.ReadContextFunc.
+func ReadViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	viewName := d.Get("view_name").(string)
@@ -221,9 +222,9 @@ func ReadViewGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.ViewGrant(databaseName, schemaName, viewName)
 	}
 
-	err := readGenericGrant(d, meta, viewGrantSchema, builder, onFuture, onAll, validViewPrivileges)
+	err := readGenericGrant(ctx, d, meta, viewGrantSchema, builder, onFuture, onAll, validViewPrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	grantID := helpers.EncodeSnowflakeID(databaseName, schemaName, viewName, privilege, withGrantOption, onFuture, onAll, roles, shares)
 	if grantID != d.Id() {
@@ -232,8 +233,8 @@ func ReadViewGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteViewGrant implements schema.DeleteFunc.
-func DeleteViewGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteViewGrant implements schema.DeleteContextFunc.
+func DeleteViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	databaseName := d.Get("database_name").(string)
 	schemaName := d.Get("schema_name").(string)
 	viewName := d.Get("view_name").(string)
@@ -250,11 +251,11 @@ func DeleteViewGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.ViewGrant(databaseName, schemaName, viewName)
 	}
 
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateViewGrant implements schema.UpdateFunc.
-func UpdateViewGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateViewGrant implements schema.UpdateContextFunc.
+func UpdateViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update, and we're done
 	if !d.HasChanges("roles", "shares") {
@@ -293,17 +294,17 @@ func UpdateViewGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	err := deleteGenericGrantRolesAndShares(
-		meta, builder, privilege, reversionRole, rolesToRevoke, sharesToRevoke)
+		ctx, meta, builder, privilege, reversionRole, rolesToRevoke, sharesToRevoke)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then add
 	err = createGenericGrantRolesAndShares(
-		meta, builder, privilege, withGrantOption, rolesToAdd, sharesToAdd)
+		ctx, meta, builder, privilege, withGrantOption, rolesToAdd, sharesToAdd)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadViewGrant(d, meta)
+	return ReadViewGrant(ctx, d, meta)
 }
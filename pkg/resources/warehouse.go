@@ -8,6 +8,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/logging"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	snowflakevalidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -143,10 +144,10 @@ var warehouseSchema = map[string]*schema.Schema{
 // Warehouse returns a pointer to the resource representing a warehouse.
 func Warehouse() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateWarehouse,
-		Read:   ReadWarehouse,
-		Delete: DeleteWarehouse,
-		Update: UpdateWarehouse,
+		CreateContext: CreateWarehouse,
+		ReadContext:   ReadWarehouse,
+		DeleteContext: DeleteWarehouse,
+		UpdateContext: UpdateWarehouse,
 
 		Schema: warehouseSchema,
 		Importer: &schema.ResourceImporter{
@@ -155,11 +156,10 @@ func Warehouse() *schema.Resource {
 	}
 }
 
-// CreateWarehouse implements schema.CreateFunc.
-func CreateWarehouse(d *schema.ResourceData, meta interface{}) error {
+// CreateWarehouse implements schema.CreateContextFunc.
+func CreateWarehouse(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	name := d.Get("name").(string)
 	objectIdentifier := sdk.NewAccountObjectIdentifier(name)
@@ -183,7 +183,7 @@ func CreateWarehouse(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("warehouse_size"); ok {
 		size, err := sdk.ToWarehouseSize(v.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		createOptions.WarehouseSize = &size
 	}
@@ -212,68 +212,67 @@ func CreateWarehouse(d *schema.ResourceData, meta interface{}) error {
 
 	err := client.Warehouses.Create(ctx, objectIdentifier, createOptions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
 
-	return ReadWarehouse(d, meta)
+	return ReadWarehouse(ctx, d, meta)
 }
 
-// ReadWarehouse implements schema.ReadFunc.
-func ReadWarehouse(d *schema.ResourceData, meta interface{}) error {
+// ReadWarehouse implements schema.ReadContextFunc.
+func ReadWarehouse(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
 
 	w, err := client.Warehouses.ShowByID(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("name", w.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("comment", w.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("warehouse_type", w.Type); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("warehouse_size", w.Size); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("max_cluster_count", w.MaxClusterCount); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("min_cluster_count", w.MinClusterCount); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("scaling_policy", w.ScalingPolicy); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("auto_suspend", w.AutoSuspend); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("auto_resume", w.AutoResume); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("resource_monitor", w.ResourceMonitor); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("enable_query_acceleration", w.EnableQueryAcceleration); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = readWarehouseObjectProperties(d, id, client, ctx)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if w.EnableQueryAcceleration {
 		if err = d.Set("query_acceleration_max_scale_factor", w.QueryAccelerationMaxScaleFactor); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -311,11 +310,10 @@ func readWarehouseObjectProperties(d *schema.ResourceData, warehouseId sdk.Accou
 	return nil
 }
 
-// UpdateWarehouse implements schema.UpdateFunc.
-func UpdateWarehouse(d *schema.ResourceData, meta interface{}) error {
+// UpdateWarehouse implements schema.UpdateContextFunc.
+func UpdateWarehouse(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
 
@@ -327,7 +325,7 @@ func UpdateWarehouse(d *schema.ResourceData, meta interface{}) error {
 				NewName: &newName,
 			})
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			d.SetId(helpers.EncodeSnowflakeID(newName))
 		} else {
@@ -349,7 +347,7 @@ func UpdateWarehouse(d *schema.ResourceData, meta interface{}) error {
 		v := d.Get("warehouse_size")
 		size, err := sdk.ToWarehouseSize(v.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		set.WarehouseSize = &size
 	}
@@ -445,7 +443,7 @@ func UpdateWarehouse(d *schema.ResourceData, meta interface{}) error {
 			Set: &set,
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if runUnset {
@@ -453,24 +451,23 @@ func UpdateWarehouse(d *schema.ResourceData, meta interface{}) error {
 			Unset: &unset,
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return ReadWarehouse(d, meta)
+	return ReadWarehouse(ctx, d, meta)
 }
 
-// DeleteWarehouse implements schema.DeleteFunc.
-func DeleteWarehouse(d *schema.ResourceData, meta interface{}) error {
+// DeleteWarehouse implements schema.DeleteContextFunc.
+func DeleteWarehouse(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
 
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
 
 	err := client.Warehouses.Drop(ctx, id, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
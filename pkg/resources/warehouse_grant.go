@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -72,10 +73,10 @@ var warehouseGrantSchema = map[string]*schema.Schema{
 func WarehouseGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create:             CreateWarehouseGrant,
-			Read:               ReadWarehouseGrant,
-			Delete:             DeleteWarehouseGrant,
-			Update:             UpdateWarehouseGrant,
+			CreateContext:      CreateWarehouseGrant,
+			ReadContext:        ReadWarehouseGrant,
+			DeleteContext:      DeleteWarehouseGrant,
+			UpdateContext:      UpdateWarehouseGrant,
 			DeprecationMessage: "This resource is deprecated and will be removed in a future major version release. Please use snowflake_grant_privileges_to_account_role instead.",
 			Schema:             warehouseGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -104,27 +105,27 @@ func WarehouseGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateWarehouseGrant implements schema.CreateFunc.
-func CreateWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateWarehouseGrant implements schema.CreateContextFunc.
+func CreateWarehouseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	warehouseName := d.Get("warehouse_name").(string)
 	privilege := d.Get("privilege").(string)
 	withGrantOption := d.Get("with_grant_option").(bool)
 	builder := snowflake.WarehouseGrant(warehouseName)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(warehouseName, privilege, withGrantOption, roles)
 	d.SetId(grantID)
 
-	return ReadWarehouseGrant(d, meta)
+	return ReadWarehouseGrant(ctx, d, meta)
 }
 
-// ReadWarehouseGrant implements schema.ReadFunc.
-func ReadWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadWarehouseGrant implements schema.ReadContextFunc.
+func ReadWarehouseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	warehouseName := d.Get("warehouse_name").(string)
 	privilege := d.Get("privilege").(string)
 	withGrantOption := d.Get("with_grant_option").(bool)
@@ -132,9 +133,9 @@ func ReadWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.WarehouseGrant(warehouseName)
 
-	err := readGenericGrant(d, meta, warehouseGrantSchema, builder, false, false, validWarehousePrivileges)
+	err := readGenericGrant(ctx, d, meta, warehouseGrantSchema, builder, false, false, validWarehousePrivileges)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := helpers.EncodeSnowflakeID(warehouseName, privilege, withGrantOption, roles)
@@ -144,16 +145,16 @@ func ReadWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteWarehouseGrant implements schema.DeleteFunc.
-func DeleteWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteWarehouseGrant implements schema.DeleteContextFunc.
+func DeleteWarehouseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	warehouseName := d.Get("warehouse_name").(string)
 	builder := snowflake.WarehouseGrant(warehouseName)
 
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateWarehouseGrant implements schema.UpdateFunc.
-func UpdateWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateWarehouseGrant implements schema.UpdateContextFunc.
+func UpdateWarehouseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update is roles. if nothing changed,
 	// nothing to update and we're done.
 	if !d.HasChanges("roles") {
@@ -172,6 +173,7 @@ func UpdateWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	if err := deleteGenericGrantRolesAndShares(
+		ctx,
 		meta,
 		builder,
 		privilege,
@@ -179,11 +181,12 @@ func UpdateWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
 		rolesToRevoke,
 		nil,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// then add
 	if err := createGenericGrantRolesAndShares(
+		ctx,
 		meta,
 		builder,
 		privilege,
@@ -191,9 +194,9 @@ func UpdateWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
 		rolesToAdd,
 		nil,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadWarehouseGrant(d, meta)
+	return ReadWarehouseGrant(ctx, d, meta)
 }
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	input := flag.String("input", "-", `path to the state file or the "terraform show -json" output; "-" reads from the standard input`)
+	output := flag.String("output", "migrated_grants.tf", `path to the generated configuration; "-" writes to the standard output`)
+	report := flag.String("report", "", "path to the migration report; by default the report is written to the standard error")
+	flag.Parse()
+
+	if err := run(*input, *output, *report); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(input string, output string, report string) error {
+	var content []byte
+	var err error
+	if input == "-" {
+		content, err = io.ReadAll(os.Stdin)
+	} else {
+		content, err = os.ReadFile(input)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to read input: %w", err)
+	}
+
+	legacyGrants, err := LoadLegacyGrants(content)
+	if err != nil {
+		return err
+	}
+	migration := Translate(legacyGrants)
+
+	configuration := RenderConfiguration(migration)
+	if output == "-" {
+		_, err = fmt.Fprint(os.Stdout, configuration)
+	} else {
+		err = os.WriteFile(output, []byte(configuration), 0o600)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to write configuration: %w", err)
+	}
+
+	summary := RenderReport(migration)
+	if report == "" {
+		_, err = fmt.Fprint(os.Stderr, summary)
+	} else {
+		err = os.WriteFile(report, []byte(summary), 0o600)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to write report: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// hclItem is a minimal HCL syntax tree node, sufficient for the generated configuration.
+type hclItem interface {
+	write(b *strings.Builder, indent int)
+}
+
+type hclAttribute struct {
+	name  string
+	value string
+}
+
+type hclBlock struct {
+	name   string
+	labels []string
+	items  []hclItem
+}
+
+func (a hclAttribute) write(b *strings.Builder, indent int) {
+	a.writeAligned(b, indent, len(a.name))
+}
+
+func (a hclAttribute) writeAligned(b *strings.Builder, indent int, width int) {
+	fmt.Fprintf(b, "%s%-*s = %s\n", strings.Repeat("  ", indent), width, a.name, a.value)
+}
+
+// write renders the block the same way terraform fmt does: equal signs of consecutive attributes are aligned and
+// nested blocks are separated from attributes with an empty line.
+func (h hclBlock) write(b *strings.Builder, indent int) {
+	b.WriteString(strings.Repeat("  ", indent))
+	b.WriteString(h.name)
+	for _, label := range h.labels {
+		b.WriteString(" ")
+		b.WriteString(hclString(label))
+	}
+	b.WriteString(" {\n")
+	for i := 0; i < len(h.items); {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		if _, ok := h.items[i].(hclAttribute); !ok {
+			h.items[i].write(b, indent+1)
+			i++
+			continue
+		}
+		end, width := i, 0
+		for ; end < len(h.items); end++ {
+			attribute, ok := h.items[end].(hclAttribute)
+			if !ok {
+				break
+			}
+			width = max(width, len(attribute.name))
+		}
+		for ; i < end; i++ {
+			h.items[i].(hclAttribute).writeAligned(b, indent+1, width)
+		}
+	}
+	b.WriteString(strings.Repeat("  ", indent))
+	b.WriteString("}\n")
+}
+
+// hclString returns a quoted HCL string literal. Template sequences are escaped, so that the value is used verbatim.
+func hclString(s string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+		"${", "$${",
+		"%{", "%%{",
+	)
+	return `"` + replacer.Replace(s) + `"`
+}
+
+func hclStringList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = hclString(v)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// RenderConfiguration returns the configuration with the new resources, their import blocks and removed blocks for the
+// legacy resources. Import blocks require Terraform 1.5 and removed blocks require Terraform 1.7.
+func RenderConfiguration(migration Migration) string {
+	var b strings.Builder
+	for i, grant := range migration.Grants {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "# migrated from %s\n", grant.Source.Address)
+		hclBlock{name: "resource", labels: []string{grant.ResourceType, grant.Name}, items: grant.Body}.write(&b, 0)
+		b.WriteString("\n")
+		b.WriteString("import {\n")
+		fmt.Fprintf(&b, "  to = %s.%s\n", grant.ResourceType, grant.Name)
+		fmt.Fprintf(&b, "  id = %s\n", hclString(grant.ImportId))
+		b.WriteString("}\n")
+	}
+	for _, address := range migration.Removed {
+		b.WriteString("\n")
+		hclBlock{name: "removed", items: []hclItem{
+			hclAttribute{"from", address},
+			hclBlock{name: "lifecycle", items: []hclItem{hclAttribute{"destroy", "false"}}},
+		}}.write(&b, 0)
+	}
+	return b.String()
+}
+
+// RenderReport returns a human-readable summary of the migration, listing everything that could not be migrated.
+func RenderReport(migration Migration) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Generated %d resources and %d removed blocks.\n", len(migration.Grants), len(migration.Removed))
+	if len(migration.Unmapped) == 0 {
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "\nCould not migrate %d grants:\n", len(migration.Unmapped))
+	kept := make([]string, 0)
+	for _, unmapped := range migration.Unmapped {
+		if unmapped.Grantee != "" {
+			fmt.Fprintf(&b, "  - %s (%s): %s\n", unmapped.Address, unmapped.Grantee, unmapped.Reason)
+		} else {
+			fmt.Fprintf(&b, "  - %s: %s\n", unmapped.Address, unmapped.Reason)
+		}
+		if !slices.Contains(kept, unmapped.ResourceAddress) {
+			kept = append(kept, unmapped.ResourceAddress)
+		}
+	}
+
+	b.WriteString("\nNo removed blocks were generated for the following resources, their remaining grants have to be migrated manually:\n")
+	for _, address := range kept {
+		fmt.Fprintf(&b, "  - %s\n", address)
+	}
+	return b.String()
+}
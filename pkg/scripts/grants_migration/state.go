@@ -0,0 +1,182 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+)
+
+// LegacyGrant is a single instance of one of the deprecated *_grant resources found in the state.
+type LegacyGrant struct {
+	// Address is the full instance address, e.g. module.a.snowflake_table_grant.b["c"].
+	Address string
+	// ResourceAddress is the address without the instance key, as required by removed blocks.
+	ResourceAddress string
+	Type            string
+	Name            string
+	Attributes      map[string]any
+}
+
+// stateFile covers the parts of the raw state file (format version 4) used by this script.
+type stateFile struct {
+	Version   int `json:"version"`
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey   any            `json:"index_key"`
+			Attributes map[string]any `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// showOutput covers the parts of the `terraform show -json` output used by this script.
+type showOutput struct {
+	FormatVersion string `json:"format_version"`
+	Values        *struct {
+		RootModule showModule `json:"root_module"`
+	} `json:"values"`
+}
+
+type showModule struct {
+	Address   string `json:"address"`
+	Resources []struct {
+		Address string         `json:"address"`
+		Mode    string         `json:"mode"`
+		Type    string         `json:"type"`
+		Name    string         `json:"name"`
+		Index   any            `json:"index"`
+		Values  map[string]any `json:"values"`
+	} `json:"resources"`
+	ChildModules []showModule `json:"child_modules"`
+}
+
+// LoadLegacyGrants reads either a raw state file or the `terraform show -json` output and returns all instances of the
+// legacy grant resources (as returned by provider.GetGrantResources) in the order they appear in the input.
+func LoadLegacyGrants(input []byte) ([]LegacyGrant, error) {
+	var probe map[string]json.RawMessage
+	if err := json.Unmarshal(input, &probe); err != nil {
+		return nil, fmt.Errorf("input is neither a state file nor a terraform show -json output: %w", err)
+	}
+
+	switch {
+	case probe["format_version"] != nil:
+		var show showOutput
+		if err := json.Unmarshal(input, &show); err != nil {
+			return nil, err
+		}
+		if show.Values == nil {
+			return []LegacyGrant{}, nil
+		}
+		return legacyGrantsFromShowModule(show.Values.RootModule), nil
+	case probe["resources"] != nil:
+		var state stateFile
+		if err := json.Unmarshal(input, &state); err != nil {
+			return nil, err
+		}
+		if state.Version != 4 {
+			return nil, fmt.Errorf("unsupported state file version %d, only version 4 is supported", state.Version)
+		}
+		return legacyGrantsFromState(state), nil
+	default:
+		return nil, fmt.Errorf("input is neither a state file nor a terraform show -json output")
+	}
+}
+
+func legacyGrantsFromState(state stateFile) []LegacyGrant {
+	grants := make([]LegacyGrant, 0)
+	for _, r := range state.Resources {
+		if r.Mode != "managed" || !isLegacyGrantType(r.Type) {
+			continue
+		}
+		resourceAddress := fmt.Sprintf("%s.%s", r.Type, r.Name)
+		if r.Module != "" {
+			resourceAddress = fmt.Sprintf("%s.%s", r.Module, resourceAddress)
+		}
+		for _, instance := range r.Instances {
+			grants = append(grants, LegacyGrant{
+				Address:         resourceAddress + indexKeySuffix(instance.IndexKey),
+				ResourceAddress: resourceAddress,
+				Type:            r.Type,
+				Name:            r.Name,
+				Attributes:      instance.Attributes,
+			})
+		}
+	}
+	return grants
+}
+
+func legacyGrantsFromShowModule(module showModule) []LegacyGrant {
+	grants := make([]LegacyGrant, 0)
+	for _, r := range module.Resources {
+		if r.Mode != "managed" || !isLegacyGrantType(r.Type) {
+			continue
+		}
+		resourceAddress := fmt.Sprintf("%s.%s", r.Type, r.Name)
+		if module.Address != "" {
+			resourceAddress = fmt.Sprintf("%s.%s", module.Address, resourceAddress)
+		}
+		grants = append(grants, LegacyGrant{
+			Address:         r.Address,
+			ResourceAddress: resourceAddress,
+			Type:            r.Type,
+			Name:            r.Name,
+			Attributes:      r.Values,
+		})
+	}
+	for _, child := range module.ChildModules {
+		grants = append(grants, legacyGrantsFromShowModule(child)...)
+	}
+	return grants
+}
+
+func isLegacyGrantType(resourceType string) bool {
+	_, ok := provider.GetGrantResources()[resourceType]
+	return ok
+}
+
+func indexKeySuffix(indexKey any) string {
+	switch key := indexKey.(type) {
+	case nil:
+		return ""
+	case string:
+		return fmt.Sprintf("[%q]", key)
+	case float64:
+		return fmt.Sprintf("[%d]", int(key))
+	default:
+		return fmt.Sprintf("[%v]", key)
+	}
+}
+
+func (g LegacyGrant) stringAttribute(name string) string {
+	if v, ok := g.Attributes[name].(string); ok {
+		return v
+	}
+	return ""
+}
+
+func (g LegacyGrant) boolAttribute(name string) bool {
+	if v, ok := g.Attributes[name].(bool); ok {
+		return v
+	}
+	return false
+}
+
+// stringSetAttribute returns sorted values of a set attribute, because sets have no stable order in the state.
+func (g LegacyGrant) stringSetAttribute(name string) []string {
+	values := make([]string, 0)
+	if list, ok := g.Attributes[name].([]any); ok {
+		for _, v := range list {
+			if s, ok := v.(string); ok && strings.TrimSpace(s) != "" {
+				values = append(values, s)
+			}
+		}
+	}
+	slices.Sort(values)
+	return values
+}
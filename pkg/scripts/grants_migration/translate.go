@@ -0,0 +1,447 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+const (
+	accountRoleResourceType  = "snowflake_grant_privileges_to_account_role"
+	databaseRoleResourceType = "snowflake_grant_privileges_to_database_role"
+	shareResourceType        = "snowflake_grant_privileges_to_share"
+)
+
+// MigratedGrant is a single grant_privileges_to_* resource generated from a legacy grant instance.
+type MigratedGrant struct {
+	Source       LegacyGrant
+	ResourceType string
+	Name         string
+	ImportId     string
+	Body         []hclItem
+}
+
+// UnmappedGrant describes a part of a legacy grant instance which could not be translated.
+type UnmappedGrant struct {
+	Address         string
+	ResourceAddress string
+	Grantee         string
+	Reason          string
+}
+
+// Migration is the result of translating all legacy grant instances.
+type Migration struct {
+	Grants   []MigratedGrant
+	Unmapped []UnmappedGrant
+	// Removed contains addresses of the legacy resources whose every instance was translated. Resources with at least
+	// one unmapped grant are left in the state, because removed blocks cannot target a single instance.
+	Removed []string
+}
+
+type legacyObject struct {
+	nameAttribute string
+	objectType    sdk.ObjectType
+}
+
+var accountObjectGrants = map[string]legacyObject{
+	"snowflake_database_grant":         {"database_name", sdk.ObjectTypeDatabase},
+	"snowflake_failover_group_grant":   {"failover_group_name", sdk.ObjectTypeFailoverGroup},
+	"snowflake_integration_grant":      {"integration_name", sdk.ObjectTypeIntegration},
+	"snowflake_resource_monitor_grant": {"monitor_name", sdk.ObjectTypeResourceMonitor},
+	"snowflake_user_grant":             {"user_name", sdk.ObjectTypeUser},
+	"snowflake_warehouse_grant":        {"warehouse_name", sdk.ObjectTypeWarehouse},
+}
+
+var schemaObjectGrants = map[string]legacyObject{
+	"snowflake_external_table_grant":    {"external_table_name", sdk.ObjectTypeExternalTable},
+	"snowflake_file_format_grant":       {"file_format_name", sdk.ObjectTypeFileFormat},
+	"snowflake_function_grant":          {"function_name", sdk.ObjectTypeFunction},
+	"snowflake_masking_policy_grant":    {"masking_policy_name", sdk.ObjectTypeMaskingPolicy},
+	"snowflake_materialized_view_grant": {"materialized_view_name", sdk.ObjectTypeMaterializedView},
+	"snowflake_pipe_grant":              {"pipe_name", sdk.ObjectTypePipe},
+	"snowflake_procedure_grant":         {"procedure_name", sdk.ObjectTypeProcedure},
+	"snowflake_row_access_policy_grant": {"row_access_policy_name", sdk.ObjectTypeRowAccessPolicy},
+	"snowflake_sequence_grant":          {"sequence_name", sdk.ObjectTypeSequence},
+	"snowflake_stage_grant":             {"stage_name", sdk.ObjectTypeStage},
+	"snowflake_stream_grant":            {"stream_name", sdk.ObjectTypeStream},
+	"snowflake_table_grant":             {"table_name", sdk.ObjectTypeTable},
+	"snowflake_tag_grant":               {"tag_name", sdk.ObjectTypeTag},
+	"snowflake_task_grant":              {"task_name", sdk.ObjectTypeTask},
+	"snowflake_view_grant":              {"view_name", sdk.ObjectTypeView},
+}
+
+// grantTarget describes the object of a legacy grant in terms of the grant_privileges_to_* resources.
+type grantTarget struct {
+	accountRoleKind resources.AccountRoleGrantKind
+	accountRoleData fmt.Stringer
+	accountRoleOn   []hclItem
+
+	// databaseRoleKind is empty if the object cannot be granted to a database role.
+	databaseRoleKind resources.DatabaseRoleGrantKind
+	databaseRoleData fmt.Stringer
+	databaseRoleOn   []hclItem
+
+	// share is nil if the object cannot be granted to a share; shareReason explains why.
+	share       *resources.GrantPrivilegesToShareId
+	shareOn     []hclItem
+	shareReason string
+}
+
+// Translate converts legacy grant instances into grant_privileges_to_* resources. Every legacy instance grants a single
+// privilege to a set of roles and shares, so it is split into one resource per grantee.
+func Translate(legacyGrants []LegacyGrant) Migration {
+	migration := Migration{
+		Grants:   make([]MigratedGrant, 0),
+		Unmapped: make([]UnmappedGrant, 0),
+		Removed:  make([]string, 0),
+	}
+	names := newNameRegistry()
+	resourceAddresses := make([]string, 0)
+	incomplete := make(map[string]bool)
+
+	for _, legacy := range legacyGrants {
+		if _, seen := incomplete[legacy.ResourceAddress]; !seen {
+			resourceAddresses = append(resourceAddresses, legacy.ResourceAddress)
+			incomplete[legacy.ResourceAddress] = false
+		}
+		unmapped := func(grantee string, reason string) {
+			migration.Unmapped = append(migration.Unmapped, UnmappedGrant{
+				Address:         legacy.Address,
+				ResourceAddress: legacy.ResourceAddress,
+				Grantee:         grantee,
+				Reason:          reason,
+			})
+			incomplete[legacy.ResourceAddress] = true
+		}
+
+		privilege := strings.ToUpper(strings.TrimSpace(legacy.stringAttribute("privilege")))
+		if privilege == "OWNERSHIP" {
+			unmapped("", "OWNERSHIP is not supported by the grant_privileges_to_* resources")
+			continue
+		}
+		target, err := targetOf(legacy)
+		if err != nil {
+			unmapped("", err.Error())
+			continue
+		}
+		privileges, allPrivileges := []string{privilege}, privilege == "ALL" || privilege == "ALL PRIVILEGES"
+		if allPrivileges {
+			privileges = nil
+		}
+		withGrantOption := legacy.boolAttribute("with_grant_option")
+
+		for _, role := range legacy.stringSetAttribute("roles") {
+			roleId, isDatabaseRole := parseRole(role)
+			if !isDatabaseRole {
+				id := resources.GrantPrivilegesToAccountRoleId{
+					RoleName:        sdk.NewAccountObjectIdentifier(roleId.Name()),
+					WithGrantOption: withGrantOption,
+					AllPrivileges:   allPrivileges,
+					Privileges:      privileges,
+					Kind:            target.accountRoleKind,
+					Data:            target.accountRoleData,
+				}
+				body := []hclItem{hclAttribute{"account_role_name", hclString(id.RoleName.FullyQualifiedName())}}
+				body = append(body, privilegesAttributes(privileges, allPrivileges, withGrantOption)...)
+				migration.Grants = append(migration.Grants, MigratedGrant{
+					Source:       legacy,
+					ResourceType: accountRoleResourceType,
+					Name:         names.next(accountRoleResourceType, legacy, role),
+					ImportId:     id.String(),
+					Body:         append(body, target.accountRoleOn...),
+				})
+				continue
+			}
+			if target.databaseRoleKind == "" {
+				unmapped(role, fmt.Sprintf("%s cannot be granted to a database role", legacy.Type))
+				continue
+			}
+			id := resources.GrantPrivilegesToDatabaseRoleId{
+				DatabaseRoleName: roleId.(sdk.DatabaseObjectIdentifier),
+				WithGrantOption:  withGrantOption,
+				AllPrivileges:    allPrivileges,
+				Privileges:       privileges,
+				Kind:             target.databaseRoleKind,
+				Data:             target.databaseRoleData,
+			}
+			body := []hclItem{hclAttribute{"database_role_name", hclString(id.DatabaseRoleName.FullyQualifiedName())}}
+			body = append(body, privilegesAttributes(privileges, allPrivileges, withGrantOption)...)
+			migration.Grants = append(migration.Grants, MigratedGrant{
+				Source:       legacy,
+				ResourceType: databaseRoleResourceType,
+				Name:         names.next(databaseRoleResourceType, legacy, role),
+				ImportId:     id.String(),
+				Body:         append(body, target.databaseRoleOn...),
+			})
+		}
+
+		for _, share := range legacy.stringSetAttribute("shares") {
+			if target.share == nil {
+				unmapped("share "+share, target.shareReason)
+				continue
+			}
+			if allPrivileges {
+				unmapped("share "+share, "ALL PRIVILEGES cannot be granted to a share")
+				continue
+			}
+			id := *target.share
+			id.ShareName = sdk.NewAccountObjectIdentifier(share)
+			id.Privileges = privileges
+			body := []hclItem{
+				hclAttribute{"to_share", hclString(id.ShareName.Name())},
+				hclAttribute{"privileges", hclStringList(privileges)},
+			}
+			migration.Grants = append(migration.Grants, MigratedGrant{
+				Source:       legacy,
+				ResourceType: shareResourceType,
+				Name:         names.next(shareResourceType, legacy, "share_"+share),
+				ImportId:     id.String(),
+				Body:         append(body, target.shareOn...),
+			})
+		}
+	}
+
+	for _, address := range resourceAddresses {
+		if !incomplete[address] {
+			migration.Removed = append(migration.Removed, address)
+		}
+	}
+	return migration
+}
+
+// parseRole returns the identifier of a role from the legacy roles attribute. Roles written as qualified names
+// (database.role) are treated as database roles.
+func parseRole(role string) (sdk.ObjectIdentifier, bool) {
+	if strings.Contains(role, ".") {
+		if id, err := helpers.DecodeSnowflakeParameterID(role); err == nil {
+			if databaseRoleId, ok := id.(sdk.DatabaseObjectIdentifier); ok {
+				return databaseRoleId, true
+			}
+		}
+	}
+	return sdk.NewAccountObjectIdentifier(role), false
+}
+
+func privilegesAttributes(privileges []string, allPrivileges bool, withGrantOption bool) []hclItem {
+	items := make([]hclItem, 0)
+	if allPrivileges {
+		items = append(items, hclAttribute{"all_privileges", "true"})
+	} else {
+		items = append(items, hclAttribute{"privileges", hclStringList(privileges)})
+	}
+	if withGrantOption {
+		items = append(items, hclAttribute{"with_grant_option", "true"})
+	}
+	return items
+}
+
+func targetOf(legacy LegacyGrant) (grantTarget, error) {
+	switch {
+	case legacy.Type == "snowflake_account_grant":
+		return grantTarget{
+			accountRoleKind: resources.OnAccountAccountRoleGrantKind,
+			accountRoleData: new(resources.OnAccountGrantData),
+			accountRoleOn:   []hclItem{hclAttribute{"on_account", "true"}},
+			shareReason:     "account privileges cannot be granted to a share",
+		}, nil
+	case legacy.Type == "snowflake_schema_grant":
+		return schemaTarget(legacy)
+	}
+	if object, ok := accountObjectGrants[legacy.Type]; ok {
+		return accountObjectTarget(legacy, object)
+	}
+	if object, ok := schemaObjectGrants[legacy.Type]; ok {
+		return schemaObjectTarget(legacy, object)
+	}
+	return grantTarget{}, fmt.Errorf("resource type %s is not supported", legacy.Type)
+}
+
+func accountObjectTarget(legacy LegacyGrant, object legacyObject) (grantTarget, error) {
+	name := legacy.stringAttribute(object.nameAttribute)
+	if name == "" {
+		return grantTarget{}, fmt.Errorf("attribute %s is empty", object.nameAttribute)
+	}
+	id := sdk.NewAccountObjectIdentifier(name)
+	target := grantTarget{
+		accountRoleKind: resources.OnAccountObjectAccountRoleGrantKind,
+		accountRoleData: &resources.OnAccountObjectGrantData{ObjectType: object.objectType, ObjectName: id},
+		accountRoleOn: []hclItem{hclBlock{name: "on_account_object", items: []hclItem{
+			hclAttribute{"object_type", hclString(object.objectType.String())},
+			hclAttribute{"object_name", hclString(id.FullyQualifiedName())},
+		}}},
+		shareReason: fmt.Sprintf("%s cannot be granted to a share", object.objectType),
+	}
+	if object.objectType == sdk.ObjectTypeDatabase {
+		target.databaseRoleKind = resources.OnDatabaseDatabaseRoleGrantKind
+		target.databaseRoleData = &resources.OnDatabaseGrantData{DatabaseName: id}
+		target.databaseRoleOn = []hclItem{hclAttribute{"on_database", hclString(id.FullyQualifiedName())}}
+		target.share = &resources.GrantPrivilegesToShareId{Kind: resources.OnDatabaseShareGrantKind, Identifier: id}
+		target.shareOn = []hclItem{hclAttribute{"on_database", hclString(id.Name())}}
+	}
+	return target, nil
+}
+
+func schemaTarget(legacy LegacyGrant) (grantTarget, error) {
+	databaseName, schemaName := legacy.stringAttribute("database_name"), legacy.stringAttribute("schema_name")
+	if databaseName == "" {
+		return grantTarget{}, fmt.Errorf("attribute database_name is empty")
+	}
+	databaseId := sdk.NewAccountObjectIdentifier(databaseName)
+
+	var data *resources.OnSchemaGrantData
+	var on hclAttribute
+	switch {
+	case legacy.boolAttribute("on_future"):
+		data = &resources.OnSchemaGrantData{Kind: resources.OnFutureSchemasInDatabaseSchemaGrantKind, DatabaseName: &databaseId}
+		on = hclAttribute{"future_schemas_in_database", hclString(databaseId.FullyQualifiedName())}
+	case legacy.boolAttribute("on_all"):
+		data = &resources.OnSchemaGrantData{Kind: resources.OnAllSchemasInDatabaseSchemaGrantKind, DatabaseName: &databaseId}
+		on = hclAttribute{"all_schemas_in_database", hclString(databaseId.FullyQualifiedName())}
+	case schemaName != "":
+		schemaId := sdk.NewDatabaseObjectIdentifier(databaseName, schemaName)
+		data = &resources.OnSchemaGrantData{Kind: resources.OnSchemaSchemaGrantKind, SchemaName: &schemaId}
+		on = hclAttribute{"schema_name", hclString(schemaId.FullyQualifiedName())}
+	default:
+		return grantTarget{}, fmt.Errorf("attribute schema_name is empty and neither on_future nor on_all is set")
+	}
+
+	onSchema := []hclItem{hclBlock{name: "on_schema", items: []hclItem{on}}}
+	target := grantTarget{
+		accountRoleKind:  resources.OnSchemaAccountRoleGrantKind,
+		accountRoleData:  data,
+		accountRoleOn:    onSchema,
+		databaseRoleKind: resources.OnSchemaDatabaseRoleGrantKind,
+		databaseRoleData: data,
+		databaseRoleOn:   onSchema,
+		shareReason:      "future and all schemas cannot be granted to a share",
+	}
+	if data.Kind == resources.OnSchemaSchemaGrantKind {
+		target.share = &resources.GrantPrivilegesToShareId{Kind: resources.OnSchemaShareGrantKind, Identifier: *data.SchemaName}
+		target.shareOn = []hclItem{hclAttribute{"on_schema", hclString(data.SchemaName.FullyQualifiedName())}}
+	}
+	return target, nil
+}
+
+func schemaObjectTarget(legacy LegacyGrant, object legacyObject) (grantTarget, error) {
+	databaseName, schemaName := legacy.stringAttribute("database_name"), legacy.stringAttribute("schema_name")
+	if databaseName == "" {
+		return grantTarget{}, fmt.Errorf("attribute database_name is empty")
+	}
+
+	var data *resources.OnSchemaObjectGrantData
+	var on hclBlock
+	if onFuture, onAll := legacy.boolAttribute("on_future"), legacy.boolAttribute("on_all"); onFuture || onAll {
+		bulk := &resources.BulkOperationGrantData{ObjectNamePlural: object.objectType.Plural()}
+		bulkItems := []hclItem{hclAttribute{"object_type_plural", hclString(bulk.ObjectNamePlural.String())}}
+		if schemaName == "" {
+			databaseId := sdk.NewAccountObjectIdentifier(databaseName)
+			bulk.Kind, bulk.Database = resources.InDatabaseBulkOperationGrantKind, &databaseId
+			bulkItems = append(bulkItems, hclAttribute{"in_database", hclString(databaseId.FullyQualifiedName())})
+		} else {
+			schemaId := sdk.NewDatabaseObjectIdentifier(databaseName, schemaName)
+			bulk.Kind, bulk.Schema = resources.InSchemaBulkOperationGrantKind, &schemaId
+			bulkItems = append(bulkItems, hclAttribute{"in_schema", hclString(schemaId.FullyQualifiedName())})
+		}
+		if onFuture {
+			data = &resources.OnSchemaObjectGrantData{Kind: resources.OnFutureSchemaObjectGrantKind, OnAllOrFuture: bulk}
+			on = hclBlock{name: "future", items: bulkItems}
+		} else {
+			data = &resources.OnSchemaObjectGrantData{Kind: resources.OnAllSchemaObjectGrantKind, OnAllOrFuture: bulk}
+			on = hclBlock{name: "all", items: bulkItems}
+		}
+	} else {
+		name := legacy.stringAttribute(object.nameAttribute)
+		switch {
+		case name == "":
+			return grantTarget{}, fmt.Errorf("attribute %s is empty and neither on_future nor on_all is set", object.nameAttribute)
+		case schemaName == "":
+			return grantTarget{}, fmt.Errorf("attribute schema_name is empty")
+		case object.objectType == sdk.ObjectTypeFunction || object.objectType == sdk.ObjectTypeProcedure:
+			return grantTarget{}, fmt.Errorf("grants on a single %s are not supported yet, because its identifier contains argument types", strings.ToLower(object.objectType.String()))
+		}
+		objectId := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+		data = &resources.OnSchemaObjectGrantData{
+			Kind:   resources.OnObjectSchemaObjectGrantKind,
+			Object: &sdk.Object{ObjectType: object.objectType, Name: objectId},
+		}
+		on = hclBlock{items: []hclItem{
+			hclAttribute{"object_type", hclString(object.objectType.String())},
+			hclAttribute{"object_name", hclString(objectId.FullyQualifiedName())},
+		}}
+	}
+
+	var onSchemaObject hclBlock
+	if on.name == "" {
+		onSchemaObject = hclBlock{name: "on_schema_object", items: on.items}
+	} else {
+		onSchemaObject = hclBlock{name: "on_schema_object", items: []hclItem{on}}
+	}
+	target := grantTarget{
+		accountRoleKind:  resources.OnSchemaObjectAccountRoleGrantKind,
+		accountRoleData:  data,
+		accountRoleOn:    []hclItem{onSchemaObject},
+		databaseRoleKind: resources.OnSchemaObjectDatabaseRoleGrantKind,
+		databaseRoleData: data,
+		databaseRoleOn:   []hclItem{onSchemaObject},
+		shareReason:      fmt.Sprintf("%s grants of this kind cannot be granted to a share", strings.ToLower(object.objectType.String())),
+	}
+	shareTarget(&target, object.objectType, data)
+	return target, nil
+}
+
+// shareTarget fills share information for the schema objects supported by grant_privileges_to_share.
+func shareTarget(target *grantTarget, objectType sdk.ObjectType, data *resources.OnSchemaObjectGrantData) {
+	switch {
+	case data.Kind == resources.OnObjectSchemaObjectGrantKind:
+		attributes := map[sdk.ObjectType]struct {
+			kind      resources.ShareGrantKind
+			attribute string
+		}{
+			sdk.ObjectTypeTable: {resources.OnTableShareGrantKind, "on_table"},
+			sdk.ObjectTypeView:  {resources.OnViewShareGrantKind, "on_view"},
+			sdk.ObjectTypeTag:   {resources.OnTagShareGrantKind, "on_tag"},
+		}
+		if share, ok := attributes[objectType]; ok {
+			target.share = &resources.GrantPrivilegesToShareId{Kind: share.kind, Identifier: data.Object.Name}
+			target.shareOn = []hclItem{hclAttribute{share.attribute, hclString(data.Object.Name.FullyQualifiedName())}}
+		}
+	case data.Kind == resources.OnAllSchemaObjectGrantKind && objectType == sdk.ObjectTypeTable && data.OnAllOrFuture.Kind == resources.InSchemaBulkOperationGrantKind:
+		target.share = &resources.GrantPrivilegesToShareId{Kind: resources.OnAllTablesInSchemaShareGrantKind, Identifier: *data.OnAllOrFuture.Schema}
+		target.shareOn = []hclItem{hclAttribute{"on_all_tables_in_schema", hclString(data.OnAllOrFuture.Schema.FullyQualifiedName())}}
+	}
+}
+
+var invalidNameCharacters = regexp.MustCompile(`[^a-z0-9]+`)
+
+// nameRegistry generates unique, valid resource names derived from the legacy resource names.
+type nameRegistry struct {
+	used map[string]int
+}
+
+func newNameRegistry() *nameRegistry {
+	return &nameRegistry{used: make(map[string]int)}
+}
+
+func (r *nameRegistry) next(resourceType string, legacy LegacyGrant, grantee string) string {
+	parts := []string{legacy.Name}
+	if key := strings.TrimPrefix(legacy.Address, legacy.ResourceAddress); key != "" {
+		parts = append(parts, key)
+	}
+	parts = append(parts, grantee)
+
+	name := strings.Trim(invalidNameCharacters.ReplaceAllString(strings.ToLower(strings.Join(parts, "_")), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "grant_" + name
+	}
+
+	key := resourceType + "." + name
+	r.used[key]++
+	if count := r.used[key]; count > 1 {
+		return fmt.Sprintf("%s_%d", name, count)
+	}
+	return name
+}
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const stateFileInput = `{
+  "version": 4,
+  "terraform_version": "1.7.0",
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "snowflake_database_grant",
+      "name": "usage",
+      "instances": [
+        {
+          "attributes": {
+            "database_name": "DB",
+            "privilege": "USAGE",
+            "roles": ["ROLE_B", "DB.DB_ROLE"],
+            "shares": ["SHARE"],
+            "with_grant_option": false
+          }
+        }
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "snowflake_table_grant",
+      "name": "select",
+      "instances": [
+        {
+          "index_key": "future",
+          "attributes": {
+            "database_name": "DB",
+            "schema_name": "SCHEMA",
+            "table_name": "",
+            "privilege": "SELECT",
+            "roles": ["ROLE"],
+            "on_future": true,
+            "on_all": false,
+            "with_grant_option": true
+          }
+        },
+        {
+          "index_key": "single",
+          "attributes": {
+            "database_name": "DB",
+            "schema_name": "SCHEMA",
+            "table_name": "TABLE",
+            "privilege": "SELECT",
+            "roles": ["ROLE"],
+            "shares": ["SHARE"],
+            "on_future": false,
+            "on_all": false
+          }
+        }
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "snowflake_function_grant",
+      "name": "usage",
+      "instances": [
+        {
+          "index_key": 0,
+          "attributes": {
+            "database_name": "DB",
+            "schema_name": "SCHEMA",
+            "function_name": "FUNC",
+            "argument_data_types": ["NUMBER"],
+            "privilege": "USAGE",
+            "roles": ["ROLE"]
+          }
+        }
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "snowflake_database",
+      "name": "db",
+      "instances": [{"attributes": {"name": "DB"}}]
+    }
+  ]
+}`
+
+const showJsonInput = `{
+  "format_version": "1.0",
+  "values": {
+    "root_module": {
+      "child_modules": [
+        {
+          "address": "module.grants",
+          "resources": [
+            {
+              "address": "module.grants.snowflake_account_grant.create_database",
+              "mode": "managed",
+              "type": "snowflake_account_grant",
+              "name": "create_database",
+              "values": {
+                "privilege": "CREATE DATABASE",
+                "roles": ["ROLE"],
+                "with_grant_option": false
+              }
+            },
+            {
+              "address": "module.grants.snowflake_schema_grant.all",
+              "mode": "managed",
+              "type": "snowflake_schema_grant",
+              "name": "all",
+              "values": {
+                "database_name": "DB",
+                "schema_name": "",
+                "privilege": "ALL PRIVILEGES",
+                "roles": ["ROLE"],
+                "on_all": true,
+                "on_future": false
+              }
+            },
+            {
+              "address": "module.grants.snowflake_warehouse_grant.ownership",
+              "mode": "managed",
+              "type": "snowflake_warehouse_grant",
+              "name": "ownership",
+              "values": {
+                "warehouse_name": "WH",
+                "privilege": "OWNERSHIP",
+                "roles": ["ROLE"]
+              }
+            }
+          ]
+        }
+      ]
+    }
+  }
+}`
+
+func TestLoadLegacyGrants(t *testing.T) {
+	t.Run("state file", func(t *testing.T) {
+		grants, err := LoadLegacyGrants([]byte(stateFileInput))
+		require.NoError(t, err)
+
+		addresses := make([]string, len(grants))
+		for i, grant := range grants {
+			addresses[i] = grant.Address
+		}
+		assert.Equal(t, []string{
+			`snowflake_database_grant.usage`,
+			`snowflake_table_grant.select["future"]`,
+			`snowflake_table_grant.select["single"]`,
+			`snowflake_function_grant.usage[0]`,
+		}, addresses)
+		assert.Equal(t, "snowflake_table_grant.select", grants[1].ResourceAddress)
+	})
+
+	t.Run("terraform show json", func(t *testing.T) {
+		grants, err := LoadLegacyGrants([]byte(showJsonInput))
+		require.NoError(t, err)
+
+		require.Len(t, grants, 3)
+		assert.Equal(t, "module.grants.snowflake_account_grant.create_database", grants[0].Address)
+		assert.Equal(t, "module.grants.snowflake_account_grant.create_database", grants[0].ResourceAddress)
+		assert.Equal(t, "snowflake_account_grant", grants[0].Type)
+	})
+
+	t.Run("unsupported input", func(t *testing.T) {
+		_, err := LoadLegacyGrants([]byte(`{"version": 3, "resources": []}`))
+		require.ErrorContains(t, err, "unsupported state file version 3")
+
+		_, err = LoadLegacyGrants([]byte(`{}`))
+		require.ErrorContains(t, err, "neither a state file nor a terraform show -json output")
+	})
+}
+
+func TestTranslate(t *testing.T) {
+	t.Run("state file", func(t *testing.T) {
+		grants, err := LoadLegacyGrants([]byte(stateFileInput))
+		require.NoError(t, err)
+
+		migration := Translate(grants)
+
+		type result struct {
+			resource string
+			importId string
+		}
+		results := make([]result, len(migration.Grants))
+		for i, grant := range migration.Grants {
+			results[i] = result{grant.ResourceType + "." + grant.Name, grant.ImportId}
+		}
+		assert.Equal(t, []result{
+			{"snowflake_grant_privileges_to_database_role.usage_db_db_role", `"DB"."DB_ROLE"|false|false|USAGE|OnDatabase|"DB"`},
+			{"snowflake_grant_privileges_to_account_role.usage_role_b", `"ROLE_B"|false|false|USAGE|OnAccountObject|DATABASE|"DB"`},
+			{"snowflake_grant_privileges_to_share.usage_share_share", `"SHARE"|USAGE|OnDatabase|"DB"`},
+			{"snowflake_grant_privileges_to_account_role.select_future_role", `"ROLE"|true|false|SELECT|OnSchemaObject|OnFuture|TABLES|InSchema|"DB"."SCHEMA"`},
+			{"snowflake_grant_privileges_to_account_role.select_single_role", `"ROLE"|false|false|SELECT|OnSchemaObject|OnObject|TABLE|"DB"."SCHEMA"."TABLE"`},
+			{"snowflake_grant_privileges_to_share.select_single_share_share", `"SHARE"|SELECT|OnTable|"DB"."SCHEMA"."TABLE"`},
+		}, results)
+
+		require.Len(t, migration.Unmapped, 1)
+		assert.Equal(t, "snowflake_function_grant.usage[0]", migration.Unmapped[0].Address)
+		assert.Contains(t, migration.Unmapped[0].Reason, "grants on a single function are not supported yet")
+		assert.Equal(t, []string{"snowflake_database_grant.usage", "snowflake_table_grant.select"}, migration.Removed)
+	})
+
+	t.Run("terraform show json", func(t *testing.T) {
+		grants, err := LoadLegacyGrants([]byte(showJsonInput))
+		require.NoError(t, err)
+
+		migration := Translate(grants)
+
+		require.Len(t, migration.Grants, 2)
+		assert.Equal(t, `"ROLE"|false|false|CREATE DATABASE|OnAccount`, migration.Grants[0].ImportId)
+		assert.Equal(t, `"ROLE"|false|false|ALL|OnSchema|OnAllSchemasInDatabase|"DB"`, migration.Grants[1].ImportId)
+
+		require.Len(t, migration.Unmapped, 1)
+		assert.Equal(t, "module.grants.snowflake_warehouse_grant.ownership", migration.Unmapped[0].Address)
+		assert.Equal(t, []string{
+			"module.grants.snowflake_account_grant.create_database",
+			"module.grants.snowflake_schema_grant.all",
+		}, migration.Removed)
+	})
+
+	t.Run("database role on account object", func(t *testing.T) {
+		migration := Translate([]LegacyGrant{{
+			Address:         "snowflake_warehouse_grant.usage",
+			ResourceAddress: "snowflake_warehouse_grant.usage",
+			Type:            "snowflake_warehouse_grant",
+			Name:            "usage",
+			Attributes: map[string]any{
+				"warehouse_name": "WH",
+				"privilege":      "USAGE",
+				"roles":          []any{"DB.DB_ROLE"},
+			},
+		}})
+
+		assert.Empty(t, migration.Grants)
+		require.Len(t, migration.Unmapped, 1)
+		assert.Equal(t, "DB.DB_ROLE", migration.Unmapped[0].Grantee)
+		assert.Empty(t, migration.Removed)
+	})
+}
+
+func TestRenderConfiguration(t *testing.T) {
+	grants, err := LoadLegacyGrants([]byte(stateFileInput))
+	require.NoError(t, err)
+
+	configuration := RenderConfiguration(Translate(grants))
+
+	assert.Contains(t, configuration, `# migrated from snowflake_table_grant.select["future"]
+resource "snowflake_grant_privileges_to_account_role" "select_future_role" {
+  account_role_name = "\"ROLE\""
+  privileges        = ["SELECT"]
+  with_grant_option = true
+
+  on_schema_object {
+    future {
+      object_type_plural = "TABLES"
+      in_schema          = "\"DB\".\"SCHEMA\""
+    }
+  }
+}
+
+import {
+  to = snowflake_grant_privileges_to_account_role.select_future_role
+  id = "\"ROLE\"|true|false|SELECT|OnSchemaObject|OnFuture|TABLES|InSchema|\"DB\".\"SCHEMA\""
+}
+`)
+	assert.Contains(t, configuration, `resource "snowflake_grant_privileges_to_share" "usage_share_share" {
+  to_share    = "SHARE"
+  privileges  = ["USAGE"]
+  on_database = "DB"
+}
+`)
+	assert.Contains(t, configuration, `removed {
+  from = snowflake_table_grant.select
+
+  lifecycle {
+    destroy = false
+  }
+}
+`)
+	assert.NotContains(t, configuration, "snowflake_function_grant.usage\n")
+}
+
+func TestHclString(t *testing.T) {
+	assert.Equal(t, `"a\"b\\c$${d}%%{e}"`, hclString(`a"b\c${d}%{e}`))
+}
@@ -45,7 +45,7 @@ type CreateAccountOptions struct {
 
 	// Object properties
 	AdminName          string         `ddl:"parameter,single_quotes" sql:"ADMIN_NAME"`
-	AdminPassword      *string        `ddl:"parameter,single_quotes" sql:"ADMIN_PASSWORD"`
+	AdminPassword      *string        `ddl:"parameter,single_quotes,secret" sql:"ADMIN_PASSWORD"`
 	AdminRSAPublicKey  *string        `ddl:"parameter,single_quotes" sql:"ADMIN_RSA_PUBLIC_KEY"`
 	FirstName          *string        `ddl:"parameter,single_quotes" sql:"FIRST_NAME"`
 	LastName           *string        `ddl:"parameter,single_quotes" sql:"LAST_NAME"`
@@ -89,6 +89,9 @@ func (c *accounts) Create(ctx context.Context, id AccountObjectIdentifier, opts
 type AlterAccountOptions struct {
 	alter   bool `ddl:"static" sql:"ALTER"`
 	account bool `ddl:"static" sql:"ACCOUNT"`
+	// Name of the account to alter. When left empty, the current account is altered. It cannot be used with Rename
+	// and Drop, which name the account themselves.
+	Name *AccountObjectIdentifier `ddl:"identifier"`
 
 	Set      *AccountSet        `ddl:"keyword" sql:"SET"`
 	Unset    *AccountUnset      `ddl:"list,no_parentheses" sql:"UNSET"`
@@ -106,6 +109,14 @@ func (opts *AlterAccountOptions) validate() error {
 	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.SetTag, opts.UnsetTag, opts.Drop, opts.Rename) {
 		errs = append(errs, errExactlyOneOf("CreateAccountOptions", "Set", "Unset", "SetTag", "UnsetTag", "Drop", "Rename"))
 	}
+	if valueSet(opts.Name) {
+		if !ValidObjectIdentifier(opts.Name) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+		if anyValueSet(opts.Drop, opts.Rename) {
+			errs = append(errs, errOneOf("AlterAccountOptions", "Name", "Drop", "Rename"))
+		}
+	}
 	if valueSet(opts.Set) {
 		if err := opts.Set.validate(); err != nil {
 			errs = append(errs, err)
@@ -162,16 +173,17 @@ func (opts *AccountLevelParameters) validate() error {
 }
 
 type AccountSet struct {
-	Parameters      *AccountLevelParameters `ddl:"list,no_parentheses"`
-	ResourceMonitor AccountObjectIdentifier `ddl:"identifier,equals" sql:"RESOURCE_MONITOR"`
-	PasswordPolicy  SchemaObjectIdentifier  `ddl:"identifier" sql:"PASSWORD POLICY"`
-	SessionPolicy   SchemaObjectIdentifier  `ddl:"identifier" sql:"SESSION POLICY"`
+	Parameters           *AccountLevelParameters `ddl:"list,no_parentheses"`
+	ResourceMonitor      AccountObjectIdentifier `ddl:"identifier,equals" sql:"RESOURCE_MONITOR"`
+	PasswordPolicy       SchemaObjectIdentifier  `ddl:"identifier" sql:"PASSWORD POLICY"`
+	SessionPolicy        SchemaObjectIdentifier  `ddl:"identifier" sql:"SESSION POLICY"`
+	AuthenticationPolicy SchemaObjectIdentifier  `ddl:"identifier" sql:"AUTHENTICATION POLICY"`
 }
 
 func (opts *AccountSet) validate() error {
 	var errs []error
-	if !exactlyOneValueSet(opts.Parameters, opts.ResourceMonitor, opts.PasswordPolicy, opts.SessionPolicy) {
-		errs = append(errs, errExactlyOneOf("AccountSet", "Parameters", "ResourceMonitor", "PasswordPolicy", "SessionPolicy"))
+	if !exactlyOneValueSet(opts.Parameters, opts.ResourceMonitor, opts.PasswordPolicy, opts.SessionPolicy, opts.AuthenticationPolicy) {
+		errs = append(errs, errExactlyOneOf("AccountSet", "Parameters", "ResourceMonitor", "PasswordPolicy", "SessionPolicy", "AuthenticationPolicy"))
 	}
 	if valueSet(opts.Parameters) {
 		if err := opts.Parameters.validate(); err != nil {
@@ -196,15 +208,17 @@ func (opts *AccountLevelParametersUnset) validate() error {
 }
 
 type AccountUnset struct {
-	Parameters     *AccountLevelParametersUnset `ddl:"list,no_parentheses"`
-	PasswordPolicy *bool                        `ddl:"keyword" sql:"PASSWORD POLICY"`
-	SessionPolicy  *bool                        `ddl:"keyword" sql:"SESSION POLICY"`
+	Parameters           *AccountLevelParametersUnset `ddl:"list,no_parentheses"`
+	ResourceMonitor      *bool                        `ddl:"keyword" sql:"RESOURCE_MONITOR"`
+	PasswordPolicy       *bool                        `ddl:"keyword" sql:"PASSWORD POLICY"`
+	SessionPolicy        *bool                        `ddl:"keyword" sql:"SESSION POLICY"`
+	AuthenticationPolicy *bool                        `ddl:"keyword" sql:"AUTHENTICATION POLICY"`
 }
 
 func (opts *AccountUnset) validate() error {
 	var errs []error
-	if !exactlyOneValueSet(opts.Parameters, opts.PasswordPolicy, opts.SessionPolicy) {
-		errs = append(errs, errExactlyOneOf("AccountUnset", "Parameters", "PasswordPolicy", "SessionPolicy"))
+	if !exactlyOneValueSet(opts.Parameters, opts.ResourceMonitor, opts.PasswordPolicy, opts.SessionPolicy, opts.AuthenticationPolicy) {
+		errs = append(errs, errExactlyOneOf("AccountUnset", "Parameters", "ResourceMonitor", "PasswordPolicy", "SessionPolicy", "AuthenticationPolicy"))
 	}
 	if valueSet(opts.Parameters) {
 		if err := opts.Parameters.validate(); err != nil {
@@ -263,6 +277,7 @@ func (c *accounts) Alter(ctx context.Context, opts *AlterAccountOptions) error {
 type ShowAccountOptions struct {
 	show     bool  `ddl:"static" sql:"SHOW"`
 	accounts bool  `ddl:"static" sql:"ORGANIZATION ACCOUNTS"`
+	History  *bool `ddl:"keyword" sql:"HISTORY"`
 	Like     *Like `ddl:"keyword" sql:"LIKE"`
 }
 
@@ -290,6 +305,18 @@ type Account struct {
 	MarketplaceProviderBillingEntityName string
 	OldAccountURL                        string
 	IsOrgAdmin                           bool
+	DroppedOn                            *time.Time
+	ScheduledDeletionTime                *time.Time
+	RestoredOn                           *time.Time
+}
+
+// IsDropped returns true for the accounts which were dropped and can still be undropped, i.e. the ones not restored
+// yet and still within their grace period. The drop details are only returned by SHOW ORGANIZATION ACCOUNTS HISTORY.
+func (v *Account) IsDropped() bool {
+	if v.DroppedOn == nil || (v.RestoredOn != nil && v.RestoredOn.After(*v.DroppedOn)) {
+		return false
+	}
+	return v.ScheduledDeletionTime == nil || v.ScheduledDeletionTime.After(time.Now())
 }
 
 func (v *Account) ID() AccountObjectIdentifier {
@@ -316,8 +343,11 @@ type accountDBRow struct {
 	ConsumptionBillingEntityName         string         `db:"consumption_billing_entity_name"`
 	MarketplaceConsumerBillingEntityName sql.NullString `db:"marketplace_consumer_billing_entity_name"`
 	MarketplaceProviderBillingEntityName sql.NullString `db:"marketplace_provider_billing_entity_name"`
-	OldAccountURL                        string         `db:"old_account_url"`
+	OldAccountURL                        sql.NullString `db:"old_account_url"`
 	IsOrgAdmin                           bool           `db:"is_org_admin"`
+	DroppedOn                            sql.NullTime   `db:"dropped_on"`
+	ScheduledDeletionTime                sql.NullTime   `db:"scheduled_deletion_time"`
+	RestoredOn                           sql.NullTime   `db:"restored_on"`
 }
 
 func (row accountDBRow) convert() *Account {
@@ -336,7 +366,7 @@ func (row accountDBRow) convert() *Account {
 		ConsumptionBillingEntityName:         row.ConsumptionBillingEntityName,
 		MarketplaceConsumerBillingEntityName: "",
 		MarketplaceProviderBillingEntityName: "",
-		OldAccountURL:                        row.OldAccountURL,
+		OldAccountURL:                        row.OldAccountURL.String,
 		IsOrgAdmin:                           row.IsOrgAdmin,
 	}
 	if row.MarketplaceConsumerBillingEntityName.Valid {
@@ -346,7 +376,16 @@ func (row accountDBRow) convert() *Account {
 		acc.MarketplaceProviderBillingEntityName = row.MarketplaceProviderBillingEntityName.String
 	}
 	if row.RegionGroup.Valid {
-		acc.SnowflakeRegion = row.RegionGroup.String
+		acc.RegionGroup = row.RegionGroup.String
+	}
+	if row.DroppedOn.Valid {
+		acc.DroppedOn = &row.DroppedOn.Time
+	}
+	if row.ScheduledDeletionTime.Valid {
+		acc.ScheduledDeletionTime = &row.ScheduledDeletionTime.Time
+	}
+	if row.RestoredOn.Valid {
+		acc.RestoredOn = &row.RestoredOn.Time
 	}
 	return acc
 }
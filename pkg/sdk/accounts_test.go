@@ -2,6 +2,9 @@ package sdk
 
 import (
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccountCreate(t *testing.T) {
@@ -120,6 +123,15 @@ func TestAccountAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT SET SESSION POLICY "db"."schema"."sesspol"`)
 	})
 
+	t.Run("with set authentication policy", func(t *testing.T) {
+		opts := &AlterAccountOptions{
+			Set: &AccountSet{
+				AuthenticationPolicy: NewSchemaObjectIdentifier("db", "schema", "authpol"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT SET AUTHENTICATION POLICY "db"."schema"."authpol"`)
+	})
+
 	t.Run("with unset password policy", func(t *testing.T) {
 		opts := &AlterAccountOptions{
 			Unset: &AccountUnset{
@@ -138,6 +150,15 @@ func TestAccountAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT UNSET SESSION POLICY`)
 	})
 
+	t.Run("with unset authentication policy", func(t *testing.T) {
+		opts := &AlterAccountOptions{
+			Unset: &AccountUnset{
+				AuthenticationPolicy: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT UNSET AUTHENTICATION POLICY`)
+	})
+
 	t.Run("with set tag", func(t *testing.T) {
 		opts := &AlterAccountOptions{
 			SetTag: []TagAssociation{
@@ -186,6 +207,41 @@ func TestAccountAlter(t *testing.T) {
 		}
 		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT "oldname" DROP OLD URL`)
 	})
+
+	t.Run("with set params on a named account", func(t *testing.T) {
+		opts := &AlterAccountOptions{
+			Name: Pointer(NewAccountObjectIdentifier("myaccount")),
+			Set: &AccountSet{
+				Parameters: &AccountLevelParameters{
+					SessionParameters: &SessionParameters{
+						JSONIndent: Int(16),
+					},
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT "myaccount" SET JSON_INDENT = 16`)
+	})
+
+	t.Run("with unset resource monitor on a named account", func(t *testing.T) {
+		opts := &AlterAccountOptions{
+			Name: Pointer(NewAccountObjectIdentifier("myaccount")),
+			Unset: &AccountUnset{
+				ResourceMonitor: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT "myaccount" UNSET RESOURCE_MONITOR`)
+	})
+
+	t.Run("validation: name with rename", func(t *testing.T) {
+		opts := &AlterAccountOptions{
+			Name: Pointer(NewAccountObjectIdentifier("myaccount")),
+			Rename: &AccountRename{
+				Name:    NewAccountObjectIdentifier("oldname"),
+				NewName: NewAccountObjectIdentifier("newname"),
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("AlterAccountOptions", "Name", "Drop", "Rename"))
+	})
 }
 
 func TestAccountShow(t *testing.T) {
@@ -202,4 +258,24 @@ func TestAccountShow(t *testing.T) {
 		}
 		assertOptsValidAndSQLEquals(t, opts, `SHOW ORGANIZATION ACCOUNTS LIKE 'myaccount'`)
 	})
+
+	t.Run("with history", func(t *testing.T) {
+		opts := &ShowAccountOptions{
+			History: Bool(true),
+			Like: &Like{
+				Pattern: String("myaccount"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW ORGANIZATION ACCOUNTS HISTORY LIKE 'myaccount'`)
+	})
+}
+
+func TestAccountIsDropped(t *testing.T) {
+	now := time.Now()
+	hourAgo, inADay := now.Add(-time.Hour), now.Add(24*time.Hour)
+
+	assert.False(t, (&Account{}).IsDropped())
+	assert.True(t, (&Account{DroppedOn: &hourAgo, ScheduledDeletionTime: &inADay}).IsDropped())
+	assert.False(t, (&Account{DroppedOn: &hourAgo, ScheduledDeletionTime: &hourAgo}).IsDropped())
+	assert.False(t, (&Account{DroppedOn: &hourAgo, ScheduledDeletionTime: &inADay, RestoredOn: &now}).IsDropped())
 }
@@ -34,7 +34,7 @@ var ApiIntegrationsDef = g.NewInterface(
 				g.NewQueryStruct("AwsApiParams").
 					Assignment("API_PROVIDER", g.KindOfT[ApiIntegrationAwsApiProviderType](), g.ParameterOptions().NoQuotes().Required()).
 					TextAssignment("API_AWS_ROLE_ARN", g.ParameterOptions().SingleQuotes().Required()).
-					OptionalTextAssignment("API_KEY", g.ParameterOptions().SingleQuotes()),
+					OptionalTextAssignment("API_KEY", g.ParameterOptions().SingleQuotes().Secret()),
 				g.KeywordOptions(),
 			).
 			OptionalQueryStructField(
@@ -43,7 +43,7 @@ var ApiIntegrationsDef = g.NewInterface(
 					PredefinedQueryStructField("apiProvider", "string", g.StaticOptions().SQL("API_PROVIDER = azure_api_management")).
 					TextAssignment("AZURE_TENANT_ID", g.ParameterOptions().SingleQuotes().Required()).
 					TextAssignment("AZURE_AD_APPLICATION_ID", g.ParameterOptions().SingleQuotes().Required()).
-					OptionalTextAssignment("API_KEY", g.ParameterOptions().SingleQuotes()),
+					OptionalTextAssignment("API_KEY", g.ParameterOptions().SingleQuotes().Secret()),
 				g.KeywordOptions(),
 			).
 			OptionalQueryStructField(
@@ -76,7 +76,7 @@ var ApiIntegrationsDef = g.NewInterface(
 						"AwsParams",
 						g.NewQueryStruct("SetAwsApiParams").
 							OptionalTextAssignment("API_AWS_ROLE_ARN", g.ParameterOptions().SingleQuotes()).
-							OptionalTextAssignment("API_KEY", g.ParameterOptions().SingleQuotes()).
+							OptionalTextAssignment("API_KEY", g.ParameterOptions().SingleQuotes().Secret()).
 							WithValidation(g.AtLeastOneValueSet, "ApiAwsRoleArn", "ApiKey"),
 						g.KeywordOptions(),
 					).
@@ -85,7 +85,7 @@ var ApiIntegrationsDef = g.NewInterface(
 						g.NewQueryStruct("SetAzureApiParams").
 							OptionalTextAssignment("AZURE_TENANT_ID", g.ParameterOptions().SingleQuotes()).
 							OptionalTextAssignment("AZURE_AD_APPLICATION_ID", g.ParameterOptions().SingleQuotes()).
-							OptionalTextAssignment("API_KEY", g.ParameterOptions().SingleQuotes()).
+							OptionalTextAssignment("API_KEY", g.ParameterOptions().SingleQuotes().Secret()).
 							WithValidation(g.AtLeastOneValueSet, "AzureTenantId", "AzureAdApplicationId", "ApiKey"),
 						g.KeywordOptions(),
 					).
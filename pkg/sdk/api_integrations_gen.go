@@ -38,14 +38,14 @@ type ApiIntegrationEndpointPrefix struct {
 type AwsApiParams struct {
 	ApiProvider   ApiIntegrationAwsApiProviderType `ddl:"parameter,no_quotes" sql:"API_PROVIDER"`
 	ApiAwsRoleArn string                           `ddl:"parameter,single_quotes" sql:"API_AWS_ROLE_ARN"`
-	ApiKey        *string                          `ddl:"parameter,single_quotes" sql:"API_KEY"`
+	ApiKey        *string                          `ddl:"parameter,single_quotes,secret" sql:"API_KEY"`
 }
 
 type AzureApiParams struct {
 	apiProvider          string  `ddl:"static" sql:"API_PROVIDER = azure_api_management"`
 	AzureTenantId        string  `ddl:"parameter,single_quotes" sql:"AZURE_TENANT_ID"`
 	AzureAdApplicationId string  `ddl:"parameter,single_quotes" sql:"AZURE_AD_APPLICATION_ID"`
-	ApiKey               *string `ddl:"parameter,single_quotes" sql:"API_KEY"`
+	ApiKey               *string `ddl:"parameter,single_quotes,secret" sql:"API_KEY"`
 }
 
 type GoogleApiParams struct {
@@ -77,13 +77,13 @@ type ApiIntegrationSet struct {
 
 type SetAwsApiParams struct {
 	ApiAwsRoleArn *string `ddl:"parameter,single_quotes" sql:"API_AWS_ROLE_ARN"`
-	ApiKey        *string `ddl:"parameter,single_quotes" sql:"API_KEY"`
+	ApiKey        *string `ddl:"parameter,single_quotes,secret" sql:"API_KEY"`
 }
 
 type SetAzureApiParams struct {
 	AzureTenantId        *string `ddl:"parameter,single_quotes" sql:"AZURE_TENANT_ID"`
 	AzureAdApplicationId *string `ddl:"parameter,single_quotes" sql:"AZURE_AD_APPLICATION_ID"`
-	ApiKey               *string `ddl:"parameter,single_quotes" sql:"API_KEY"`
+	ApiKey               *string `ddl:"parameter,single_quotes,secret" sql:"API_KEY"`
 }
 
 type SetGoogleApiParams struct {
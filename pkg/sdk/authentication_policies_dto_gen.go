@@ -0,0 +1,79 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateAuthenticationPolicyOptions]   = new(CreateAuthenticationPolicyRequest)
+	_ optionsProvider[AlterAuthenticationPolicyOptions]    = new(AlterAuthenticationPolicyRequest)
+	_ optionsProvider[DropAuthenticationPolicyOptions]     = new(DropAuthenticationPolicyRequest)
+	_ optionsProvider[ShowAuthenticationPolicyOptions]     = new(ShowAuthenticationPolicyRequest)
+	_ optionsProvider[DescribeAuthenticationPolicyOptions] = new(DescribeAuthenticationPolicyRequest)
+)
+
+type CreateAuthenticationPolicyRequest struct {
+	OrReplace                *bool
+	IfNotExists              *bool
+	name                     SchemaObjectIdentifier // required
+	AuthenticationMethods    []AuthenticationMethodsRequest
+	MfaAuthenticationMethods []MfaAuthenticationMethodsRequest
+	MfaEnrollment            *MfaEnrollmentOption
+	ClientTypes              []ClientTypesRequest
+	SecurityIntegrations     []SecurityIntegrationsOptionRequest
+	Comment                  *string
+}
+
+type AuthenticationMethodsRequest struct {
+	Method AuthenticationMethodsOption // required
+}
+
+type MfaAuthenticationMethodsRequest struct {
+	Method MfaAuthenticationMethodsOption // required
+}
+
+type ClientTypesRequest struct {
+	ClientType ClientTypesOption // required
+}
+
+type SecurityIntegrationsOptionRequest struct {
+	Name string // required
+}
+
+type AlterAuthenticationPolicyRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier // required
+	Set      *AuthenticationPolicySetRequest
+	Unset    *AuthenticationPolicyUnsetRequest
+	RenameTo *SchemaObjectIdentifier
+}
+
+type AuthenticationPolicySetRequest struct {
+	AuthenticationMethods    []AuthenticationMethodsRequest
+	MfaAuthenticationMethods []MfaAuthenticationMethodsRequest
+	MfaEnrollment            *MfaEnrollmentOption
+	ClientTypes              []ClientTypesRequest
+	SecurityIntegrations     []SecurityIntegrationsOptionRequest
+	Comment                  *string
+}
+
+type AuthenticationPolicyUnsetRequest struct {
+	AuthenticationMethods    *bool
+	MfaAuthenticationMethods *bool
+	MfaEnrollment            *bool
+	ClientTypes              *bool
+	SecurityIntegrations     *bool
+	Comment                  *bool
+}
+
+type DropAuthenticationPolicyRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier // required
+}
+
+type ShowAuthenticationPolicyRequest struct {
+	Like *Like
+	In   *In
+}
+
+type DescribeAuthenticationPolicyRequest struct {
+	name SchemaObjectIdentifier // required
+}
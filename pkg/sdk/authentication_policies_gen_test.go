@@ -0,0 +1,233 @@
+package sdk
+
+import "testing"
+
+func TestAuthenticationPolicies_Create(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	// Minimal valid CreateAuthenticationPolicyOptions
+	defaultOpts := func() *CreateAuthenticationPolicyOptions {
+		return &CreateAuthenticationPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateAuthenticationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateAuthenticationPolicyOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE AUTHENTICATION POLICY %s", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.AuthenticationMethods = []AuthenticationMethods{
+			{Method: AuthenticationMethodsPassword},
+			{Method: AuthenticationMethodsSaml},
+		}
+		opts.MfaAuthenticationMethods = []MfaAuthenticationMethods{
+			{Method: MfaAuthenticationMethodsPassword},
+		}
+		opts.MfaEnrollment = Pointer(MfaEnrollmentRequired)
+		opts.ClientTypes = []ClientTypes{
+			{ClientType: ClientTypesSnowflakeUi},
+			{ClientType: ClientTypesDrivers},
+		}
+		opts.SecurityIntegrations = []SecurityIntegrationsOption{
+			{Name: "okta_integration"},
+		}
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE AUTHENTICATION POLICY %s AUTHENTICATION_METHODS = ('PASSWORD', 'SAML') MFA_AUTHENTICATION_METHODS = ('PASSWORD') MFA_ENROLLMENT = REQUIRED CLIENT_TYPES = ('SNOWFLAKE_UI', 'DRIVERS') SECURITY_INTEGRATIONS = ('okta_integration') COMMENT = 'some comment'", id.FullyQualifiedName())
+	})
+}
+
+func TestAuthenticationPolicies_Alter(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	// Minimal valid AlterAuthenticationPolicyOptions
+	defaultOpts := func() *AlterAuthenticationPolicyOptions {
+		return &AlterAuthenticationPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterAuthenticationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		opts.Unset = &AuthenticationPolicyUnset{Comment: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.RenameTo] should be present - none present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterAuthenticationPolicyOptions", "Set", "Unset", "RenameTo"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.RenameTo] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &AuthenticationPolicySet{Comment: String("some comment")}
+		opts.Unset = &AuthenticationPolicyUnset{Comment: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterAuthenticationPolicyOptions", "Set", "Unset", "RenameTo"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.AuthenticationMethods opts.Set.MfaAuthenticationMethods opts.Set.MfaEnrollment opts.Set.ClientTypes opts.Set.SecurityIntegrations opts.Set.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &AuthenticationPolicySet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterAuthenticationPolicyOptions.Set", "AuthenticationMethods", "MfaAuthenticationMethods", "MfaEnrollment", "ClientTypes", "SecurityIntegrations", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.AuthenticationMethods opts.Unset.MfaAuthenticationMethods opts.Unset.MfaEnrollment opts.Unset.ClientTypes opts.Unset.SecurityIntegrations opts.Unset.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &AuthenticationPolicyUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterAuthenticationPolicyOptions.Unset", "AuthenticationMethods", "MfaAuthenticationMethods", "MfaEnrollment", "ClientTypes", "SecurityIntegrations", "Comment"))
+	})
+
+	t.Run("alter: set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &AuthenticationPolicySet{
+			AuthenticationMethods: []AuthenticationMethods{
+				{Method: AuthenticationMethodsKeyPair},
+			},
+			MfaEnrollment: Pointer(MfaEnrollmentOptional),
+			ClientTypes: []ClientTypes{
+				{ClientType: ClientTypesSnowSql},
+			},
+			SecurityIntegrations: []SecurityIntegrationsOption{
+				{Name: "ALL"},
+			},
+			Comment: String("new comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AUTHENTICATION POLICY %s SET AUTHENTICATION_METHODS = ('KEYPAIR') MFA_ENROLLMENT = OPTIONAL CLIENT_TYPES = ('SNOWSQL') SECURITY_INTEGRATIONS = ('ALL') COMMENT = 'new comment'", id.FullyQualifiedName())
+	})
+
+	t.Run("alter: unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Unset = &AuthenticationPolicyUnset{
+			AuthenticationMethods:    Bool(true),
+			MfaAuthenticationMethods: Bool(true),
+			MfaEnrollment:            Bool(true),
+			ClientTypes:              Bool(true),
+			SecurityIntegrations:     Bool(true),
+			Comment:                  Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AUTHENTICATION POLICY IF EXISTS %s UNSET AUTHENTICATION_METHODS, MFA_AUTHENTICATION_METHODS, MFA_ENROLLMENT, CLIENT_TYPES, SECURITY_INTEGRATIONS, COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		opts := defaultOpts()
+		newId := NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), "new_name")
+		opts.RenameTo = &newId
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AUTHENTICATION POLICY %s RENAME TO %s", id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+}
+
+func TestAuthenticationPolicies_Drop(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	// Minimal valid DropAuthenticationPolicyOptions
+	defaultOpts := func() *DropAuthenticationPolicyOptions {
+		return &DropAuthenticationPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropAuthenticationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP AUTHENTICATION POLICY %s", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP AUTHENTICATION POLICY IF EXISTS %s", id.FullyQualifiedName())
+	})
+}
+
+func TestAuthenticationPolicies_Show(t *testing.T) {
+	// Minimal valid ShowAuthenticationPolicyOptions
+	defaultOpts := func() *ShowAuthenticationPolicyOptions {
+		return &ShowAuthenticationPolicyOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowAuthenticationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW AUTHENTICATION POLICIES")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("some_pattern"),
+		}
+		opts.In = &In{
+			Schema: NewDatabaseObjectIdentifier("db", "schema"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW AUTHENTICATION POLICIES LIKE 'some_pattern' IN SCHEMA "db"."schema"`)
+	})
+}
+
+func TestAuthenticationPolicies_Describe(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	// Minimal valid DescribeAuthenticationPolicyOptions
+	defaultOpts := func() *DescribeAuthenticationPolicyOptions {
+		return &DescribeAuthenticationPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeAuthenticationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE AUTHENTICATION POLICY %s", id.FullyQualifiedName())
+	})
+}
@@ -0,0 +1,222 @@
+package sdk
+
+import "context"
+
+var _ AuthenticationPolicies = (*authenticationPolicies)(nil)
+
+type authenticationPolicies struct {
+	client *Client
+}
+
+func (v *authenticationPolicies) Create(ctx context.Context, request *CreateAuthenticationPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *authenticationPolicies) Alter(ctx context.Context, request *AlterAuthenticationPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *authenticationPolicies) Drop(ctx context.Context, request *DropAuthenticationPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *authenticationPolicies) Show(ctx context.Context, request *ShowAuthenticationPolicyRequest) ([]AuthenticationPolicy, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[showAuthenticationPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[showAuthenticationPolicyDBRow, AuthenticationPolicy](dbRows)
+	return resultList, nil
+}
+
+func (v *authenticationPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*AuthenticationPolicy, error) {
+	request := NewShowAuthenticationPolicyRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{String(id.Name())})
+	authenticationPolicies, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	for _, authenticationPolicy := range authenticationPolicies {
+		if authenticationPolicy.Name == id.Name() {
+			return &authenticationPolicy, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+func (v *authenticationPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) ([]AuthenticationPolicyDescription, error) {
+	opts := &DescribeAuthenticationPolicyOptions{
+		name: id,
+	}
+	rows, err := validateAndQuery[describeAuthenticationPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[describeAuthenticationPolicyDBRow, AuthenticationPolicyDescription](rows), nil
+}
+
+func (r *CreateAuthenticationPolicyRequest) toOpts() *CreateAuthenticationPolicyOptions {
+	opts := &CreateAuthenticationPolicyOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+
+		MfaEnrollment: r.MfaEnrollment,
+
+		Comment: r.Comment,
+	}
+	if r.AuthenticationMethods != nil {
+		s := make([]AuthenticationMethods, len(r.AuthenticationMethods))
+		for i, v := range r.AuthenticationMethods {
+			s[i] = AuthenticationMethods{
+				Method: v.Method,
+			}
+		}
+		opts.AuthenticationMethods = s
+	}
+	if r.MfaAuthenticationMethods != nil {
+		s := make([]MfaAuthenticationMethods, len(r.MfaAuthenticationMethods))
+		for i, v := range r.MfaAuthenticationMethods {
+			s[i] = MfaAuthenticationMethods{
+				Method: v.Method,
+			}
+		}
+		opts.MfaAuthenticationMethods = s
+	}
+	if r.ClientTypes != nil {
+		s := make([]ClientTypes, len(r.ClientTypes))
+		for i, v := range r.ClientTypes {
+			s[i] = ClientTypes{
+				ClientType: v.ClientType,
+			}
+		}
+		opts.ClientTypes = s
+	}
+	if r.SecurityIntegrations != nil {
+		s := make([]SecurityIntegrationsOption, len(r.SecurityIntegrations))
+		for i, v := range r.SecurityIntegrations {
+			s[i] = SecurityIntegrationsOption{
+				Name: v.Name,
+			}
+		}
+		opts.SecurityIntegrations = s
+	}
+	return opts
+}
+
+func (r *AlterAuthenticationPolicyRequest) toOpts() *AlterAuthenticationPolicyOptions {
+	opts := &AlterAuthenticationPolicyOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+
+		RenameTo: r.RenameTo,
+	}
+	if r.Set != nil {
+		opts.Set = &AuthenticationPolicySet{
+
+			MfaEnrollment: r.Set.MfaEnrollment,
+
+			Comment: r.Set.Comment,
+		}
+		if r.Set.AuthenticationMethods != nil {
+			s := make([]AuthenticationMethods, len(r.Set.AuthenticationMethods))
+			for i, v := range r.Set.AuthenticationMethods {
+				s[i] = AuthenticationMethods{
+					Method: v.Method,
+				}
+			}
+			opts.Set.AuthenticationMethods = s
+		}
+		if r.Set.MfaAuthenticationMethods != nil {
+			s := make([]MfaAuthenticationMethods, len(r.Set.MfaAuthenticationMethods))
+			for i, v := range r.Set.MfaAuthenticationMethods {
+				s[i] = MfaAuthenticationMethods{
+					Method: v.Method,
+				}
+			}
+			opts.Set.MfaAuthenticationMethods = s
+		}
+		if r.Set.ClientTypes != nil {
+			s := make([]ClientTypes, len(r.Set.ClientTypes))
+			for i, v := range r.Set.ClientTypes {
+				s[i] = ClientTypes{
+					ClientType: v.ClientType,
+				}
+			}
+			opts.Set.ClientTypes = s
+		}
+		if r.Set.SecurityIntegrations != nil {
+			s := make([]SecurityIntegrationsOption, len(r.Set.SecurityIntegrations))
+			for i, v := range r.Set.SecurityIntegrations {
+				s[i] = SecurityIntegrationsOption{
+					Name: v.Name,
+				}
+			}
+			opts.Set.SecurityIntegrations = s
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &AuthenticationPolicyUnset{
+			AuthenticationMethods:    r.Unset.AuthenticationMethods,
+			MfaAuthenticationMethods: r.Unset.MfaAuthenticationMethods,
+			MfaEnrollment:            r.Unset.MfaEnrollment,
+			ClientTypes:              r.Unset.ClientTypes,
+			SecurityIntegrations:     r.Unset.SecurityIntegrations,
+			Comment:                  r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropAuthenticationPolicyRequest) toOpts() *DropAuthenticationPolicyOptions {
+	opts := &DropAuthenticationPolicyOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowAuthenticationPolicyRequest) toOpts() *ShowAuthenticationPolicyOptions {
+	opts := &ShowAuthenticationPolicyOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
+	return opts
+}
+
+func (r showAuthenticationPolicyDBRow) convert() *AuthenticationPolicy {
+	authenticationPolicy := &AuthenticationPolicy{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		DatabaseName:  r.DatabaseName,
+		SchemaName:    r.SchemaName,
+		Owner:         r.Owner,
+		OwnerRoleType: r.OwnerRoleType,
+	}
+	if r.Comment.Valid {
+		authenticationPolicy.Comment = r.Comment.String
+	}
+	if r.Options.Valid {
+		authenticationPolicy.Options = r.Options.String
+	}
+	return authenticationPolicy
+}
+
+func (r *DescribeAuthenticationPolicyRequest) toOpts() *DescribeAuthenticationPolicyOptions {
+	opts := &DescribeAuthenticationPolicyOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r describeAuthenticationPolicyDBRow) convert() *AuthenticationPolicyDescription {
+	return &AuthenticationPolicyDescription{
+		Property:    r.Property,
+		Value:       r.Value,
+		Default:     r.Default,
+		Description: r.Description,
+	}
+}
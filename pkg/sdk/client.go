@@ -30,6 +30,7 @@ type Client struct {
 	accountLocator string
 	dryRun         bool
 	traceLogs      []string
+	showCache      *showCache
 
 	// System-Defined Functions
 	ContextFunctions     ContextFunctions
@@ -44,6 +45,7 @@ type Client struct {
 	ApplicationPackages      ApplicationPackages
 	ApplicationRoles         ApplicationRoles
 	Applications             Applications
+	AuthenticationPolicies   AuthenticationPolicies
 	Comments                 Comments
 	DatabaseRoles            DatabaseRoles
 	Databases                Databases
@@ -74,11 +76,13 @@ type Client struct {
 	Sessions                 Sessions
 	Shares                   Shares
 	Stages                   Stages
+	StageFiles               StageFiles
 	StorageIntegrations      StorageIntegrations
 	Streamlits               Streamlits
 	Streams                  Streams
 	Tables                   Tables
 	Tags                     Tags
+	TagReferences            TagReferences
 	Tasks                    Tasks
 	Users                    Users
 	Views                    Views
@@ -111,6 +115,11 @@ func NewDryRunClient() *Client {
 }
 
 func NewClient(cfg *gosnowflake.Config) (*Client, error) {
+	return NewClientWithOptions(cfg, nil)
+}
+
+// NewClientWithOptions creates the client like NewClient, tuning its connection pool and sessions with opts.
+func NewClientWithOptions(cfg *gosnowflake.Config, opts *ConnectionOptions) (*Client, error) {
 	var err error
 	if cfg == nil {
 		log.Printf("[DEBUG] Searching for default config in credentials chain...\n")
@@ -127,7 +136,7 @@ func NewClient(cfg *gosnowflake.Config) (*Client, error) {
 			logger := instrumentedsql.LoggerFunc(func(ctx context.Context, s string, kv ...interface{}) {
 				switch s {
 				case "sql-conn-query", "sql-conn-exec":
-					log.Printf("[DEBUG] %s: %v (%s)\n", s, kv, ctx.Value(snowflakeAccountLocatorContextKey))
+					log.Printf("[DEBUG] %s: %s (%s)\n", s, RedactSecrets(fmt.Sprintf("%v", kv)), ctx.Value(snowflakeAccountLocatorContextKey))
 				default:
 					return
 				}
@@ -146,10 +155,12 @@ func NewClient(cfg *gosnowflake.Config) (*Client, error) {
 		return nil, err
 	}
 
-	db, err := sqlx.Connect(driverName, dsn)
+	db, err := connect(driverName, dsn, opts, &connectionState{})
 	if err != nil {
 		return nil, fmt.Errorf("open snowflake connection: %w", err)
 	}
+	opts.apply(db.DB)
+	registerExecutionContextSource(db.DB, driverName, cfg, opts)
 
 	client = &Client{
 		// snowflake does not adhere to the normal sql driver interface, so we have to use unsafe
@@ -182,7 +193,8 @@ func NewClient(cfg *gosnowflake.Config) (*Client, error) {
 func NewClientFromDB(db *sql.DB) *Client {
 	dbx := sqlx.NewDb(db, "snowflake")
 	client := &Client{
-		db: dbx.Unsafe(),
+		db:        dbx.Unsafe(),
+		showCache: showCacheForDB(db),
 	}
 	client.initialize()
 	return client
@@ -195,6 +207,7 @@ func (c *Client) initialize() {
 	c.ApplicationPackages = &applicationPackages{client: c}
 	c.ApplicationRoles = &applicationRoles{client: c}
 	c.Applications = &applications{client: c}
+	c.AuthenticationPolicies = &authenticationPolicies{client: c}
 	c.Comments = &comments{client: c}
 	c.ContextFunctions = &contextFunctions{client: c}
 	c.ConversionFunctions = &conversionFunctions{client: c}
@@ -228,12 +241,14 @@ func (c *Client) initialize() {
 	c.Sessions = &sessions{client: c}
 	c.Shares = &shares{client: c}
 	c.Stages = &stages{client: c}
+	c.StageFiles = &stageFiles{client: c}
 	c.StorageIntegrations = &storageIntegrations{client: c}
 	c.Streamlits = &streamlits{client: c}
 	c.Streams = &streams{client: c}
 	c.SystemFunctions = &systemFunctions{client: c}
 	c.Tables = &tables{client: c}
 	c.Tags = &tags{client: c}
+	c.TagReferences = &tagReferences{client: c}
 	c.Tasks = &tasks{client: c}
 	c.Users = &users{client: c}
 	c.Views = &views{client: c}
@@ -264,33 +279,41 @@ const (
 // Exec executes a query that does not return rows.
 func (c *Client) exec(ctx context.Context, sql string) (sql.Result, error) {
 	if c.dryRun {
-		c.traceLogs = append(c.traceLogs, sql)
-		log.Printf("[DEBUG] sql-conn-exec-dry: %v\n", sql)
+		c.traceLogs = append(c.traceLogs, RedactSecrets(sql))
+		log.Printf("[DEBUG] sql-conn-exec-dry: %v\n", RedactSecrets(sql))
 		return nil, nil
 	}
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
+	ctx, endSpans := startStatementSpans(ctx, sql)
 	result, err := c.db.ExecContext(ctx, sql)
+	endSpans(rowsAffected(result), err)
 	return result, decodeDriverError(err)
 }
 
 // query runs a query and returns the rows. dest is expected to be a slice of structs.
 func (c *Client) query(ctx context.Context, dest interface{}, sql string) error {
 	if c.dryRun {
-		c.traceLogs = append(c.traceLogs, sql)
-		log.Printf("[DEBUG] sql-conn-query-dry: %v\n", sql)
+		c.traceLogs = append(c.traceLogs, RedactSecrets(sql))
+		log.Printf("[DEBUG] sql-conn-query-dry: %v\n", RedactSecrets(sql))
 		return nil
 	}
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	return decodeDriverError(c.db.SelectContext(ctx, dest, sql))
+	ctx, endSpans := startStatementSpans(ctx, sql)
+	err := c.db.SelectContext(ctx, dest, sql)
+	endSpans(rowsReturned(dest), err)
+	return decodeDriverError(err)
 }
 
 // queryOne runs a query and returns one row. dest is expected to be a pointer to a struct.
 func (c *Client) queryOne(ctx context.Context, dest interface{}, sql string) error {
 	if c.dryRun {
-		c.traceLogs = append(c.traceLogs, sql)
-		log.Printf("[DEBUG] sql-conn-query-one-dry: %v\n", sql)
+		c.traceLogs = append(c.traceLogs, RedactSecrets(sql))
+		log.Printf("[DEBUG] sql-conn-query-one-dry: %v\n", RedactSecrets(sql))
 		return nil
 	}
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	return decodeDriverError(c.db.GetContext(ctx, dest, sql))
+	ctx, endSpans := startStatementSpans(ctx, sql)
+	err := c.db.GetContext(ctx, dest, sql)
+	endSpans(-1, err)
+	return decodeDriverError(err)
 }
@@ -10,7 +10,7 @@ import (
 )
 
 func DefaultConfig() *gosnowflake.Config {
-	config, err := ProfileConfig("default")
+	config, err := ProfileConfig("")
 	if err != nil || config == nil {
 		log.Printf("[DEBUG] No Snowflake config file found, falling back to environment variables: %v\n", err)
 		return EnvConfig()
@@ -18,33 +18,13 @@ func DefaultConfig() *gosnowflake.Config {
 	return config
 }
 
+// ProfileConfig returns the configuration of the connection (profile) read by LoadConnection.
 func ProfileConfig(profile string) (*gosnowflake.Config, error) {
-	configs, err := loadConfigFile()
-	if err != nil {
+	connection, err := LoadConnection(profile)
+	if err != nil || connection == nil {
 		return nil, err
 	}
-
-	if profile == "" {
-		profile = "default"
-	}
-	var config *gosnowflake.Config
-	if cfg, ok := configs[profile]; ok {
-		log.Printf("[DEBUG] loading config for profile: \"%s\"", profile)
-		config = cfg
-	}
-
-	if config == nil {
-		log.Printf("[DEBUG] no config found for profile: \"%s\"", profile)
-		return nil, nil
-	}
-
-	// us-west-2 is Snowflake's default region, but if you actually specify that it won't trigger the default code
-	//  https://github.com/snowflakedb/gosnowflake/blob/52137ce8c32eaf93b0bd22fc5c7297beff339812/dsn.go#L61
-	if config.Region == "us-west-2" {
-		config.Region = ""
-	}
-
-	return config, nil
+	return connection.Config()
 }
 
 func MergeConfig(baseConfig *gosnowflake.Config, mergeConfig *gosnowflake.Config) *gosnowflake.Config {
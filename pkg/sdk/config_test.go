@@ -105,6 +105,8 @@ func setupEnvVars(t *testing.T, account, user, password, role, configPath string
 	originalPassword := os.Getenv("SNOWFLAKE_PASSWORD")
 	originalRole := os.Getenv("SNOWFLAKE_ROLE")
 	originalPath := os.Getenv("SNOWFLAKE_CONFIG_PATH")
+	// connections.toml of the user must not be read by the tests
+	t.Setenv("SNOWFLAKE_HOME", t.TempDir())
 
 	os.Setenv("SNOWFLAKE_ACCOUNT", account)
 	os.Setenv("SNOWFLAKE_USER", user)
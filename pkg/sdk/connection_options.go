@@ -0,0 +1,175 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ConnectionOptions tune the connection pool and the sessions of the client created with NewClientWithOptions. The
+// zero values keep the database/sql defaults.
+type ConnectionOptions struct {
+	// MaxOpenConnections limits the number of the open connections (sessions).
+	MaxOpenConnections int
+	// MaxIdleConnections limits the number of the idle connections kept in the pool; zero keeps none. Nil keeps the
+	// database/sql default.
+	MaxIdleConnections *int
+	// ConnectionMaxLifetime is the maximum time a connection is reused for.
+	ConnectionMaxLifetime time.Duration
+	// MaxConcurrentStatements limits the number of the statements executed at the same time with the connection, by
+	// the SDK clients as well as by the *sql.DB itself. The statements over the limit wait for a free slot.
+	MaxConcurrentStatements int
+	// WarmUpStatements are executed on every new connection before it is used, e.g. ALTER SESSION statements.
+	WarmUpStatements []string
+}
+
+type statementLimiter struct {
+	slots        chan struct{}
+	waitCount    atomic.Int64
+	waitDuration atomic.Int64
+}
+
+func statementLimiterForDB(db *sql.DB) *statementLimiter {
+	if state := connectionStateForDB(db); state != nil {
+		return state.statementLimiter.Load()
+	}
+	return nil
+}
+
+// acquire waits for a free statement slot when the number of concurrent statements is limited. The returned function
+// releases the slot.
+func (l *statementLimiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	release := func() { <-l.slots }
+	select {
+	case l.slots <- struct{}{}:
+		return release, nil
+	default:
+	}
+	start := time.Now()
+	select {
+	case l.slots <- struct{}{}:
+		l.waitCount.Add(1)
+		l.waitDuration.Add(int64(time.Since(start)))
+		return release, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (opts *ConnectionOptions) apply(db *sql.DB) {
+	if opts == nil {
+		return
+	}
+	if opts.MaxOpenConnections > 0 {
+		db.SetMaxOpenConns(opts.MaxOpenConnections)
+	}
+	if opts.MaxIdleConnections != nil {
+		db.SetMaxIdleConns(*opts.MaxIdleConnections)
+	}
+	if opts.ConnectionMaxLifetime > 0 {
+		db.SetConnMaxLifetime(opts.ConnectionMaxLifetime)
+	}
+	if state := connectionStateForDB(db); state != nil && opts.MaxConcurrentStatements > 0 {
+		state.statementLimiter.Store(&statementLimiter{slots: make(chan struct{}, opts.MaxConcurrentStatements)})
+	}
+}
+
+// connect opens the connection like sqlx.Connect, running the warm-up statements on every new session. The
+// statements of the connection get the given state applied (see stateConnector).
+func connect(driverName string, dsn string, opts *ConnectionOptions, state *connectionState) (*sqlx.DB, error) {
+	// the only way to get the registered driver
+	registered, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	d := registered.Driver()
+	if err := registered.Close(); err != nil {
+		return nil, err
+	}
+
+	var connector driver.Connector
+	if driverContext, ok := d.(driver.DriverContext); ok {
+		if connector, err = driverContext.OpenConnector(dsn); err != nil {
+			return nil, err
+		}
+	} else {
+		connector = dsnConnector{dsn: dsn, driver: d}
+	}
+	if opts != nil && len(opts.WarmUpStatements) > 0 {
+		connector = warmUpConnector{Connector: connector, statements: opts.WarmUpStatements}
+	}
+	db := sqlx.NewDb(sql.OpenDB(stateConnector{Connector: connector, state: state}), driverName)
+	if err := db.Ping(); err != nil {
+		return nil, errors.Join(err, db.Close())
+	}
+	return db, nil
+}
+
+type dsnConnector struct {
+	dsn    string
+	driver driver.Driver
+}
+
+func (c dsnConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.driver.Open(c.dsn)
+}
+
+func (c dsnConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+type warmUpConnector struct {
+	driver.Connector
+	statements []string
+}
+
+func (c warmUpConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	execer, ok := conn.(driver.ExecerContext)
+	if !ok {
+		return nil, errors.Join(fmt.Errorf("connection %T does not support the warm-up statements", conn), conn.Close())
+	}
+	for _, statement := range c.statements {
+		log.Printf("[DEBUG] session warm-up: %s\n", RedactSecrets(statement))
+		if _, err := execer.ExecContext(ctx, statement, nil); err != nil {
+			return nil, errors.Join(fmt.Errorf("session warm-up statement %s failed: %w", RedactSecrets(statement), redactError(err)), conn.Close())
+		}
+	}
+	return conn, nil
+}
+
+// LogConnectionPoolStats writes the connection pool statistics and the number of waits for the statement slots to the
+// debug log, including the pools opened for the execution contexts (see DBWithExecutionContext).
+func LogConnectionPoolStats(db *sql.DB) {
+	logConnectionPoolStats("connection pool", db)
+	for key, contextDB := range executionContextDBs(db) {
+		logConnectionPoolStats(fmt.Sprintf("connection pool (%s)", key), contextDB)
+	}
+	if limiter := statementLimiterForDB(db); limiter != nil {
+		log.Printf("[DEBUG] statement slots stats: max_concurrent=%d wait_count=%d wait_duration=%s\n",
+			cap(limiter.slots), limiter.waitCount.Load(), time.Duration(limiter.waitDuration.Load()),
+		)
+	}
+}
+
+func logConnectionPoolStats(name string, db *sql.DB) {
+	stats := db.Stats()
+	log.Printf(
+		"[DEBUG] %s stats: max_open=%d open=%d in_use=%d idle=%d wait_count=%d wait_duration=%s max_idle_closed=%d max_idle_time_closed=%d max_lifetime_closed=%d\n",
+		name, stats.MaxOpenConnections, stats.OpenConnections, stats.InUse, stats.Idle, stats.WaitCount, stats.WaitDuration,
+		stats.MaxIdleClosed, stats.MaxIdleTimeClosed, stats.MaxLifetimeClosed,
+	)
+}
@@ -0,0 +1,105 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectionOptions_WarmUpStatements(t *testing.T) {
+	_, mock, err := sqlmock.NewWithDSN("warm_up_dsn", sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	mock.ExpectExec("ALTER SESSION SET QUERY_TAG = 'warm'").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("USE SECONDARY ROLES ALL").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	opts := &ConnectionOptions{
+		MaxOpenConnections:    1,
+		MaxIdleConnections:    Int(1),
+		ConnectionMaxLifetime: time.Minute,
+		WarmUpStatements:      []string{"ALTER SESSION SET QUERY_TAG = 'warm'", "USE SECONDARY ROLES ALL"},
+	}
+	db, err := connect("sqlmock", "warm_up_dsn", opts, &connectionState{})
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	opts.apply(db.DB)
+
+	assert.Equal(t, 1, db.Stats().MaxOpenConnections)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestConnectionOptions_NoIdleConnections(t *testing.T) {
+	_, mock, err := sqlmock.NewWithDSN("no_idle_dsn", sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	mock.ExpectExec("SELECT 1").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	opts := &ConnectionOptions{MaxIdleConnections: Int(0)}
+	db, err := connect("sqlmock", "no_idle_dsn", opts, &connectionState{})
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	opts.apply(db.DB)
+
+	_, err = db.ExecContext(context.Background(), "SELECT 1")
+	require.NoError(t, err)
+	assert.Zero(t, db.Stats().Idle)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestConnectionOptions_MaxConcurrentStatements(t *testing.T) {
+	_, mock, err := sqlmock.NewWithDSN("max_concurrent_statements_dsn", sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	mock.MatchExpectationsInOrder(false)
+	providerDB, err := connect("sqlmock", "max_concurrent_statements_dsn", nil, &connectionState{})
+	require.NoError(t, err)
+	db := providerDB.DB
+	t.Cleanup(func() { db.Close() })
+	(&ConnectionOptions{MaxConcurrentStatements: 2}).apply(db)
+
+	limiter := statementLimiterForDB(db)
+	require.NotNil(t, limiter)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		mock.ExpectExec(fmt.Sprintf(`CREATE SCHEMA "db"."s%d"`, i)).WillDelayFor(20 * time.Millisecond).WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// the limit applies to the SDK clients and to the statements executed on the connection directly
+			if i%2 == 0 {
+				assert.NoError(t, NewClientFromDB(db).Schemas.Create(context.Background(), NewDatabaseObjectIdentifier("db", fmt.Sprintf("s%d", i)), nil))
+			} else {
+				_, err := db.ExecContext(context.Background(), fmt.Sprintf(`CREATE SCHEMA "db"."s%d"`, i))
+				assert.NoError(t, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.GreaterOrEqual(t, time.Since(start), 60*time.Millisecond)
+	assert.Positive(t, limiter.waitCount.Load())
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	t.Run("canceled context", func(t *testing.T) {
+		releaseFirst, err := limiter.acquire(context.Background())
+		require.NoError(t, err)
+		defer releaseFirst()
+		releaseSecond, err := limiter.acquire(context.Background())
+		require.NoError(t, err)
+		defer releaseSecond()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = limiter.acquire(ctx)
+		require.ErrorIs(t, err, context.Canceled)
+		_, err = db.ExecContext(ctx, `CREATE SCHEMA "db"."s"`)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
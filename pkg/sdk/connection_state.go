@@ -0,0 +1,192 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"sync/atomic"
+)
+
+// connectionState is shared by all clients created from a connection opened by NewClientWithOptions and by the
+// connections opened for its execution contexts (see DBWithExecutionContext). The clients are usually created per
+// operation with NewClientFromDB, so the state is kept in the connector of the connection and found through its
+// driver.
+type connectionState struct {
+	queryTag         atomic.Pointer[QueryTag]
+	showCaches       atomic.Pointer[showCacheGroup]
+	statementLimiter atomic.Pointer[statementLimiter]
+}
+
+// connectionStateForDB returns the state of a connection opened by NewClientWithOptions, nil for other connections.
+func connectionStateForDB(db *sql.DB) *connectionState {
+	if d, ok := db.Driver().(stateDriver); ok {
+		return d.state
+	}
+	return nil
+}
+
+// stateConnector applies the connection state (the query tag, the statement concurrency limit and the SHOW result
+// cache invalidation) to the statements executed on its connections, whichever way they are executed (the SDK client,
+// the snowflake package helpers or the *sql.DB itself).
+type stateConnector struct {
+	driver.Connector
+	state *connectionState
+}
+
+func (c stateConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &stateConn{Conn: conn, state: c.state}, nil
+}
+
+func (c stateConnector) Driver() driver.Driver {
+	return stateDriver{Driver: c.Connector.Driver(), state: c.state}
+}
+
+type stateDriver struct {
+	driver.Driver
+	state *connectionState
+}
+
+// stateConn passes the optional interfaces of the wrapped connection through. The statements which the wrapped
+// connection cannot execute without the preparation (driver.ErrSkip) are executed without the query tag and they do
+// not invalidate the SHOW result caches; they are not limited either.
+type stateConn struct {
+	driver.Conn
+	state *connectionState
+	// tx is the transaction in progress; its statements invalidate the SHOW result caches when it ends
+	tx *stateTx
+}
+
+var (
+	_ driver.ExecerContext      = new(stateConn)
+	_ driver.QueryerContext     = new(stateConn)
+	_ driver.ConnPrepareContext = new(stateConn)
+	_ driver.ConnBeginTx        = new(stateConn)
+	_ driver.Pinger             = new(stateConn)
+	_ driver.SessionResetter    = new(stateConn)
+	_ driver.Validator          = new(stateConn)
+	_ driver.NamedValueChecker  = new(stateConn)
+)
+
+func (c *stateConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	release, err := c.state.statementLimiter.Load().acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := execer.ExecContext(c.state.withQueryTag(ctx), query, args)
+	release()
+	if err == driver.ErrSkip { //nolint:errorlint // database/sql compares the error in the same way
+		return nil, err
+	}
+	// the failed statements can be applied partially, so they invalidate the caches too
+	if c.tx != nil {
+		c.tx.statements = append(c.tx.statements, query)
+	} else {
+		c.state.invalidateShowCaches(query)
+	}
+	return result, err
+}
+
+func (c *stateConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	// the slot is released when the statement completes, before the rows are read, as the callers can execute other
+	// statements while reading them
+	release, err := c.state.statementLimiter.Load().acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return queryer.QueryContext(c.state.withQueryTag(ctx), query, args)
+}
+
+func (c *stateConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return preparer.PrepareContext(ctx, query)
+	}
+	return c.Conn.Prepare(query)
+}
+
+func (c *stateConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	var tx driver.Tx
+	var err error
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		tx, err = beginner.BeginTx(ctx, opts)
+	} else {
+		tx, err = c.Conn.Begin() //nolint:staticcheck // the fallback for the drivers without BeginTx, as in database/sql
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.tx = &stateTx{Tx: tx, conn: c}
+	return c.tx, nil
+}
+
+// stateTx invalidates the SHOW result caches after the transaction ends, as its statements are not visible to the
+// other connections before.
+type stateTx struct {
+	driver.Tx
+	conn       *stateConn
+	statements []string
+}
+
+func (t *stateTx) Commit() error {
+	defer t.end()
+	return t.Tx.Commit()
+}
+
+func (t *stateTx) Rollback() error {
+	defer t.end()
+	return t.Tx.Rollback()
+}
+
+func (t *stateTx) end() {
+	t.conn.tx = nil
+	for _, statement := range t.statements {
+		t.conn.state.invalidateShowCaches(statement)
+	}
+}
+
+func (c *stateConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *stateConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *stateConn) IsValid() bool {
+	if validator, ok := c.Conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+	return true
+}
+
+func (c *stateConn) CheckNamedValue(value *driver.NamedValue) error {
+	if checker, ok := c.Conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(value)
+	}
+	return driver.ErrSkip
+}
+
+// invalidateShowCaches drops the cached SHOW results affected by the completed statement.
+func (s *connectionState) invalidateShowCaches(statement string) {
+	if group := s.showCaches.Load(); group != nil {
+		group.invalidate(statement)
+	}
+}
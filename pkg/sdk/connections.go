@@ -0,0 +1,586 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"maps"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2/unstable"
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// connectionKeyType is the type of the values of a connection key.
+type connectionKeyType int
+
+const (
+	connectionString connectionKeyType = iota
+	connectionInteger
+	connectionBoolean
+)
+
+// connectionKeys are the keys known in the connections of connections.toml, named as by the other Snowflake tools,
+// with the types of their values. The timeouts are in seconds.
+var connectionKeys = map[string]connectionKeyType{
+	"account":                           connectionString,
+	"user":                              connectionString,
+	"password":                          connectionString,
+	"host":                              connectionString,
+	"port":                              connectionInteger,
+	"protocol":                          connectionString,
+	"region":                            connectionString,
+	"role":                              connectionString,
+	"warehouse":                         connectionString,
+	"database":                          connectionString,
+	"schema":                            connectionString,
+	"authenticator":                     connectionString,
+	"okta_url":                          connectionString,
+	"token":                             connectionString,
+	"passcode":                          connectionString,
+	"passcode_in_password":              connectionBoolean,
+	"private_key_path":                  connectionString,
+	"private_key_file":                  connectionString,
+	"private_key_raw":                   connectionString,
+	"private_key_passphrase":            connectionString,
+	"private_key_file_pwd":              connectionString,
+	"login_timeout":                     connectionInteger,
+	"request_timeout":                   connectionInteger,
+	"jwt_expire_timeout":                connectionInteger,
+	"client_timeout":                    connectionInteger,
+	"jwt_client_timeout":                connectionInteger,
+	"external_browser_timeout":          connectionInteger,
+	"client_session_keep_alive":         connectionBoolean,
+	"insecure_mode":                     connectionBoolean,
+	"ocsp_fail_open":                    connectionBoolean,
+	"disable_telemetry":                 connectionBoolean,
+	"validate_default_parameters":       connectionBoolean,
+	"client_request_mfa_token":          connectionBoolean,
+	"client_store_temporary_credential": connectionBoolean,
+	"disable_query_context_cache":       connectionBoolean,
+}
+
+// secretConnectionKeys are masked in the configuration reports.
+var secretConnectionKeys = []string{"password", "token", "passcode", "private_key_raw", "private_key_passphrase", "private_key_file_pwd"}
+
+// IsSecretConnectionKey returns true for the connection settings that must not be shown.
+func IsSecretConnectionKey(key string) bool {
+	return slices.Contains(secretConnectionKeys, key)
+}
+
+// connectionAuthenticators are the authenticator values of connections.toml (as used by the other Snowflake tools) and
+// of the authenticator provider field, compared case-insensitively. An Okta URL is accepted as well.
+var connectionAuthenticators = map[string]gosnowflake.AuthType{
+	"snowflake":             gosnowflake.AuthTypeSnowflake,
+	"oauth":                 gosnowflake.AuthTypeOAuth,
+	"externalbrowser":       gosnowflake.AuthTypeExternalBrowser,
+	"okta":                  gosnowflake.AuthTypeOkta,
+	"snowflake_jwt":         gosnowflake.AuthTypeJwt,
+	"jwt":                   gosnowflake.AuthTypeJwt,
+	"tokenaccessor":         gosnowflake.AuthTypeTokenAccessor,
+	"username_password_mfa": gosnowflake.AuthTypeUsernamePasswordMFA,
+	"usernamepasswordmfa":   gosnowflake.AuthTypeUsernamePasswordMFA,
+}
+
+// ConnectionSetting is a value of a connection setting together with the place it was read from: the file and the line
+// (e.g. /home/user/.snowflake/connections.toml:3) or the environment variable.
+type ConnectionSetting struct {
+	Value  string
+	Source string
+}
+
+// Connection is a named connection read from connections.toml or from the legacy config file, with the
+// SNOWFLAKE_CONNECTIONS_<NAME>_<KEY> environment variables applied on top.
+type Connection struct {
+	Name     string
+	Settings map[string]ConnectionSetting
+}
+
+// LoadConnection reads the connection with the given name. Without the name, the connection named by the
+// SNOWFLAKE_DEFAULT_CONNECTION_NAME environment variable or by default_connection_name of connections.toml is read,
+// falling back to "default". The connection is looked up in connections.toml first and then in the legacy config file.
+// Only the read connection is validated; its unknown keys are logged and ignored. It returns nil when the connection is
+// not defined anywhere.
+func LoadConnection(name string) (*Connection, error) {
+	connectionsPath, err := connectionsFile()
+	if err != nil {
+		return nil, err
+	}
+	connections, err := readConnectionsFileIfExists(connectionsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		name = defaultConnectionName(connections)
+	}
+
+	var settings map[string]ConnectionSetting
+	if connection, ok := connections.connections[name]; ok {
+		if err := errors.Join(connection.errs...); err != nil {
+			return nil, err
+		}
+		settings = connection.settings
+	} else {
+		configPath, err := configFile()
+		if err != nil {
+			return nil, err
+		}
+		legacy, err := loadConfigFile()
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		if config, ok := legacy[name]; ok && config != nil {
+			settings = legacyConnectionSettings(config, configPath)
+		}
+	}
+
+	overrides, err := connectionEnvOverrides(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(settings) == 0 && len(overrides) == 0 {
+		log.Printf("[DEBUG] no config found for connection: \"%s\"", name)
+		return nil, nil
+	}
+	connection := &Connection{Name: name, Settings: make(map[string]ConnectionSetting)}
+	for key, setting := range settings {
+		connection.Settings[key] = setting
+	}
+	for key, setting := range overrides {
+		connection.Settings[key] = setting
+	}
+	log.Printf("[DEBUG] loading config for connection: \"%s\"", name)
+	return connection, nil
+}
+
+// legacyConnectionSettings returns the settings of a profile of the legacy config file, which is decoded into
+// gosnowflake.Config as before the introduction of connections.toml.
+func legacyConnectionSettings(config *gosnowflake.Config, path string) map[string]ConnectionSetting {
+	settings := make(map[string]ConnectionSetting)
+	for key, value := range map[string]string{
+		"account":   config.Account,
+		"user":      config.User,
+		"password":  config.Password,
+		"host":      config.Host,
+		"protocol":  config.Protocol,
+		"region":    config.Region,
+		"role":      config.Role,
+		"warehouse": config.Warehouse,
+		"database":  config.Database,
+		"schema":    config.Schema,
+		"token":     config.Token,
+	} {
+		if value != "" {
+			settings[key] = ConnectionSetting{Value: value, Source: path}
+		}
+	}
+	if config.Port > 0 {
+		settings["port"] = ConnectionSetting{Value: strconv.Itoa(config.Port), Source: path}
+	}
+	return settings
+}
+
+func defaultConnectionName(connections *connectionsFileContent) string {
+	if name, ok := os.LookupEnv("SNOWFLAKE_DEFAULT_CONNECTION_NAME"); ok && name != "" {
+		return name
+	}
+	if connections.defaultConnectionName != "" {
+		return connections.defaultConnectionName
+	}
+	return "default"
+}
+
+// Config returns the driver configuration of the connection. The private key settings are not part of it, as they are
+// handled by the provider.
+func (c *Connection) Config() (*gosnowflake.Config, error) {
+	config := &gosnowflake.Config{}
+	var errs []error
+	for key, setting := range c.Settings {
+		if err := setConnectionConfig(config, key, setting.Value); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", setting.Source, err))
+		}
+	}
+	return config, errors.Join(errs...)
+}
+
+func setConnectionConfig(config *gosnowflake.Config, key string, value string) error {
+	switch connectionKeys[key] {
+	case connectionInteger:
+		number, err := parseConnectionInteger(key, value)
+		if err != nil {
+			return err
+		}
+		seconds := time.Duration(number) * time.Second
+		switch key {
+		case "port":
+			config.Port = number
+		case "login_timeout":
+			config.LoginTimeout = seconds
+		case "request_timeout":
+			config.RequestTimeout = seconds
+		case "jwt_expire_timeout":
+			config.JWTExpireTimeout = seconds
+		case "client_timeout":
+			config.ClientTimeout = seconds
+		case "jwt_client_timeout":
+			config.JWTClientTimeout = seconds
+		case "external_browser_timeout":
+			config.ExternalBrowserTimeout = seconds
+		}
+	case connectionBoolean:
+		enabled, err := parseConnectionBoolean(key, value)
+		if err != nil {
+			return err
+		}
+		configBool := gosnowflake.ConfigBoolFalse
+		if enabled {
+			configBool = gosnowflake.ConfigBoolTrue
+		}
+		switch key {
+		case "passcode_in_password":
+			config.PasscodeInPassword = enabled
+		case "client_session_keep_alive":
+			config.KeepSessionAlive = enabled
+		case "insecure_mode":
+			config.InsecureMode = enabled
+		case "ocsp_fail_open":
+			config.OCSPFailOpen = gosnowflake.OCSPFailOpenFalse
+			if enabled {
+				config.OCSPFailOpen = gosnowflake.OCSPFailOpenTrue
+			}
+		case "disable_telemetry":
+			config.DisableTelemetry = enabled
+		case "validate_default_parameters":
+			config.ValidateDefaultParameters = configBool
+		case "client_request_mfa_token":
+			config.ClientRequestMfaToken = configBool
+		case "client_store_temporary_credential":
+			config.ClientStoreTemporaryCredential = configBool
+		case "disable_query_context_cache":
+			config.DisableQueryContextCache = enabled
+		}
+	default:
+		switch key {
+		case "account":
+			config.Account = value
+		case "user":
+			config.User = value
+		case "password":
+			config.Password = value
+		case "host":
+			config.Host = value
+		case "protocol":
+			config.Protocol = value
+		case "region":
+			// us-west-2 is Snowflake's default region, but if you actually specify that it won't trigger the default code
+			//  https://github.com/snowflakedb/gosnowflake/blob/52137ce8c32eaf93b0bd22fc5c7297beff339812/dsn.go#L61
+			if value != "us-west-2" {
+				config.Region = value
+			}
+		case "role":
+			config.Role = value
+		case "warehouse":
+			config.Warehouse = value
+		case "database":
+			config.Database = value
+		case "schema":
+			config.Schema = value
+		case "authenticator":
+			authenticator, oktaURL, err := parseAuthenticator(value)
+			if err != nil {
+				return err
+			}
+			config.Authenticator = authenticator
+			if oktaURL != nil {
+				config.OktaURL = oktaURL
+			}
+		case "okta_url":
+			oktaURL, err := url.Parse(value)
+			if err != nil {
+				return fmt.Errorf("okta_url must be a URL, got %q", value)
+			}
+			config.OktaURL = oktaURL
+		case "token":
+			config.Token = value
+		case "passcode":
+			config.Passcode = value
+		}
+	}
+	return nil
+}
+
+// PrivateKeyPath returns the private key file of the connection set by either private_key_path or (as named by the
+// other Snowflake tools) private_key_file.
+func (c *Connection) PrivateKeyPath() (ConnectionSetting, bool) {
+	return c.firstSetting("private_key_path", "private_key_file")
+}
+
+// PrivateKeyPassphrase returns the passphrase of the private key set by either private_key_passphrase or (as named by
+// the other Snowflake tools) private_key_file_pwd.
+func (c *Connection) PrivateKeyPassphrase() (ConnectionSetting, bool) {
+	return c.firstSetting("private_key_passphrase", "private_key_file_pwd")
+}
+
+func (c *Connection) firstSetting(keys ...string) (ConnectionSetting, bool) {
+	for _, key := range keys {
+		if setting, ok := c.Settings[key]; ok {
+			return setting, true
+		}
+	}
+	return ConnectionSetting{}, false
+}
+
+func parseConnectionInteger(key string, value string) (int, error) {
+	number, err := strconv.Atoi(value)
+	switch {
+	case key == "port" && (err != nil || number <= 0):
+		return 0, fmt.Errorf("port must be a positive integer, got %q", value)
+	case err != nil || number < 0:
+		return 0, fmt.Errorf("%s must be a non-negative integer, got %q", key, value)
+	}
+	return number, nil
+}
+
+func parseConnectionBoolean(key string, value string) (bool, error) {
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("%s must be a boolean, got %q", key, value)
+	}
+	return enabled, nil
+}
+
+func parseAuthenticator(value string) (gosnowflake.AuthType, *url.URL, error) {
+	if authenticator, ok := connectionAuthenticators[strings.ToLower(value)]; ok {
+		return authenticator, nil, nil
+	}
+	if oktaURL, err := url.Parse(value); err == nil && oktaURL.Scheme == "https" && oktaURL.Host != "" {
+		return gosnowflake.AuthTypeOkta, oktaURL, nil
+	}
+	return 0, nil, fmt.Errorf("unknown authenticator %q", value)
+}
+
+func connectionsFile() (string, error) {
+	if home, ok := os.LookupEnv("SNOWFLAKE_HOME"); ok && home != "" {
+		return filepath.Join(home, "connections.toml"), nil
+	}
+	dir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	// default connections path is ~/.snowflake/connections.toml.
+	return filepath.Join(dir, ".snowflake", "connections.toml"), nil
+}
+
+// connectionEnvOverrides reads the SNOWFLAKE_CONNECTIONS_<NAME>_<KEY> environment variables of the connection.
+func connectionEnvOverrides(name string) (map[string]ConnectionSetting, error) {
+	prefix := "SNOWFLAKE_CONNECTIONS_" + strings.ToUpper(name) + "_"
+	overrides := make(map[string]ConnectionSetting)
+	var errs []error
+	for _, variable := range os.Environ() {
+		envName, value, _ := strings.Cut(variable, "=")
+		if !strings.HasPrefix(envName, prefix) {
+			continue
+		}
+		key := strings.ToLower(strings.TrimPrefix(envName, prefix))
+		if _, ok := connectionKeys[key]; !ok {
+			log.Printf("[WARN] environment variable %s: %s", envName, unknownConnectionKeyMessage(key))
+			continue
+		}
+		if err := validateConnectionSetting(key, value); err != nil {
+			errs = append(errs, fmt.Errorf("environment variable %s: %w", envName, err))
+			continue
+		}
+		overrides[key] = ConnectionSetting{Value: value, Source: "environment variable " + envName}
+	}
+	return overrides, errors.Join(errs...)
+}
+
+func validateConnectionSetting(key string, value string) error {
+	return setConnectionConfig(&gosnowflake.Config{}, key, value)
+}
+
+// unknownConnectionKeyMessage tells that the key is ignored, suggesting the closest known key.
+func unknownConnectionKeyMessage(key string) string {
+	known := slices.Sorted(maps.Keys(connectionKeys))
+	var closest string
+	closestDistance := 3
+	for _, knownKey := range known {
+		if distance := editDistance(key, knownKey); distance < closestDistance {
+			closest, closestDistance = knownKey, distance
+		}
+	}
+	if closest != "" {
+		return fmt.Sprintf("unknown key %q is ignored (did you mean %q?)", key, closest)
+	}
+	return fmt.Sprintf("unknown key %q is ignored, the known keys are: %s", key, strings.Join(known, ", "))
+}
+
+// editDistance is the Levenshtein distance of a and b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+type connectionsFileContent struct {
+	defaultConnectionName string
+	connections           map[string]*connectionsFileConnection
+}
+
+// connectionsFileConnection holds the settings of a connection and the errors found in it. The errors are reported only
+// when the connection is used, so that a broken connection does not break the others.
+type connectionsFileConnection struct {
+	settings map[string]ConnectionSetting
+	errs     []error
+}
+
+func readConnectionsFileIfExists(path string) (*connectionsFileContent, error) {
+	content, err := readConnectionsFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &connectionsFileContent{connections: make(map[string]*connectionsFileConnection)}, nil
+	}
+	return content, err
+}
+
+// readConnectionsFile reads the file with a table per connection, e.g.
+//
+//	default_connection_name = "prod"
+//
+//	[prod]
+//	account = "ORG-ACCOUNT"
+//	user = "TERRAFORM"
+//
+// All errors and warnings point to the file and the line of the offending key, so that the typos are easy to find. Only
+// the syntax errors and the invalid default_connection_name fail the whole file.
+func readConnectionsFile(path string) (*connectionsFileContent, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	content := &connectionsFileContent{connections: make(map[string]*connectionsFileConnection)}
+
+	parser := unstable.Parser{}
+	parser.Reset(data)
+	var errs []error
+	position := func(node *unstable.Node) string {
+		return fmt.Sprintf("%s:%d", path, parser.Shape(node.Raw).Start.Line)
+	}
+	var connection *connectionsFileConnection
+	openConnection := func(name string) *connectionsFileConnection {
+		if _, ok := content.connections[name]; !ok {
+			content.connections[name] = &connectionsFileConnection{settings: make(map[string]ConnectionSetting)}
+		}
+		return content.connections[name]
+	}
+	connectionName := ""
+	for parser.NextExpression() {
+		expression := parser.Expression()
+		keys, last := expressionKey(expression)
+		switch expression.Kind {
+		case unstable.Table:
+			connectionName = keys[0]
+			connection = openConnection(connectionName)
+			if len(keys) != 1 {
+				connection.errs = append(connection.errs, fmt.Errorf("%s: nested table [%s] is not supported, connections are top-level tables", position(last), strings.Join(keys, ".")))
+				connection = nil
+			}
+		case unstable.ArrayTable:
+			connectionName = keys[0]
+			arrayConnection := openConnection(connectionName)
+			arrayConnection.errs = append(arrayConnection.errs, fmt.Errorf("%s: array table [[%s]] is not supported", position(last), strings.Join(keys, ".")))
+			connection = nil
+		case unstable.KeyValue:
+			// the legacy config file was decoded into gosnowflake.Config, which matched the keys case-insensitively
+			key := strings.ToLower(strings.Join(keys, "."))
+			value := expression.Value()
+			switch {
+			case connectionName == "" && key == "default_connection_name":
+				if value.Kind != unstable.String {
+					errs = append(errs, fmt.Errorf("%s: default_connection_name must be a string", position(last)))
+				} else {
+					content.defaultConnectionName = string(value.Data)
+				}
+			case connectionName == "":
+				log.Printf("[WARN] %s: unexpected top-level key %q is ignored, only default_connection_name can be set outside of the connection tables", position(last), key)
+			case connection == nil:
+				// the keys of the unsupported tables are reported with the table
+			default:
+				if _, ok := connectionKeys[key]; !ok {
+					log.Printf("[WARN] %s: connection %q: %s", position(last), connectionName, unknownConnectionKeyMessage(key))
+					continue
+				}
+				stringValue, err := connectionValue(key, value)
+				if err == nil {
+					err = validateConnectionSetting(key, stringValue)
+				}
+				if err != nil {
+					connection.errs = append(connection.errs, fmt.Errorf("%s: connection %q: %w", position(last), connectionName, err))
+					continue
+				}
+				connection.settings[key] = ConnectionSetting{Value: stringValue, Source: position(last)}
+			}
+		}
+	}
+	if err := parser.Error(); err != nil {
+		var parserError *unstable.ParserError
+		if errors.As(err, &parserError) {
+			line := parser.Shape(parser.Range(parserError.Highlight)).Start.Line
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return content, nil
+}
+
+func expressionKey(expression *unstable.Node) ([]string, *unstable.Node) {
+	var keys []string
+	var last *unstable.Node
+	it := expression.Key()
+	for it.Next() {
+		last = it.Node()
+		keys = append(keys, string(last.Data))
+	}
+	return keys, last
+}
+
+func connectionValue(key string, value *unstable.Node) (string, error) {
+	switch keyType := connectionKeys[key]; {
+	case value.Kind == unstable.String:
+		return string(value.Data), nil
+	case keyType == connectionInteger && value.Kind == unstable.Integer:
+		return strings.ReplaceAll(string(value.Data), "_", ""), nil
+	case keyType == connectionBoolean && value.Kind == unstable.Bool:
+		return string(value.Data), nil
+	case keyType == connectionInteger:
+		return "", fmt.Errorf("%s must be an integer, got %s", key, value.Kind)
+	case keyType == connectionBoolean:
+		return "", fmt.Errorf("%s must be a boolean, got %s", key, value.Kind)
+	default:
+		return "", fmt.Errorf("%s must be a string, got %s", key, value.Kind)
+	}
+}
@@ -572,10 +572,15 @@ func (v *databases) Show(ctx context.Context, opts *ShowDatabasesOptions) ([]Dat
 }
 
 func (v *databases) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Database, error) {
-	databases, err := v.client.Databases.Show(ctx, &ShowDatabasesOptions{
-		Like: &Like{
+	opts := &ShowDatabasesOptions{}
+	// with the SHOW result cache, all databases are fetched once
+	if v.client.showCache == nil {
+		opts.Like = &Like{
 			Pattern: String(id.Name()),
-		},
+		}
+	}
+	databases, err := cachedShow(ctx, v.client, opts, accountScope(ObjectTypeDatabase), func(ctx context.Context) ([]Database, error) {
+		return v.client.Databases.Show(ctx, opts)
 	})
 	if err != nil {
 		return nil, err
@@ -3,6 +3,7 @@ package sdk
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 )
 
@@ -13,6 +14,7 @@ type DynamicTables interface {
 	Drop(ctx context.Context, request *DropDynamicTableRequest) error
 	Show(ctx context.Context, request *ShowDynamicTableRequest) ([]DynamicTable, error)
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*DynamicTable, error)
+	RefreshHistory(ctx context.Context, request *DynamicTableRefreshHistoryRequest) ([]DynamicTableRefreshHistory, error)
 }
 
 // createDynamicTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-dynamic-table
@@ -25,8 +27,12 @@ type createDynamicTableOptions struct {
 	Initialize   *DynamicTableInitialize  `ddl:"parameter,no_quotes" sql:"INITIALIZE"`
 	RefreshMode  *DynamicTableRefreshMode `ddl:"parameter,no_quotes" sql:"REFRESH_MODE"`
 	warehouse    AccountObjectIdentifier  `ddl:"identifier,equals" sql:"WAREHOUSE"`
-	Comment      *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
-	query        string                   `ddl:"parameter,no_equals,no_quotes" sql:"AS"`
+	ClusterBy    []string                 `ddl:"keyword,parentheses" sql:"CLUSTER BY"`
+
+	DataRetentionTimeInDays    *int    `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int    `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	Comment                    *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	query                      string  `ddl:"parameter,no_equals,no_quotes" sql:"AS"`
 }
 
 type TargetLag struct {
@@ -35,8 +41,17 @@ type TargetLag struct {
 }
 
 type DynamicTableSet struct {
-	TargetLag *TargetLag               `ddl:"parameter,no_quotes" sql:"TARGET_LAG"`
-	Warehouse *AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
+	TargetLag                  *TargetLag               `ddl:"parameter,no_quotes" sql:"TARGET_LAG"`
+	Warehouse                  *AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
+	DataRetentionTimeInDays    *int                     `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int                     `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	Comment                    *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type DynamicTableUnset struct {
+	DataRetentionTimeInDays    *bool `ddl:"keyword" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *bool `ddl:"keyword" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	Comment                    *bool `ddl:"keyword" sql:"COMMENT"`
 }
 
 // alterDynamicTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-dynamic-table
@@ -45,10 +60,13 @@ type alterDynamicTableOptions struct {
 	dynamicTable bool                   `ddl:"static" sql:"DYNAMIC TABLE"`
 	name         SchemaObjectIdentifier `ddl:"identifier"`
 
-	Suspend *bool            `ddl:"keyword" sql:"SUSPEND"`
-	Resume  *bool            `ddl:"keyword" sql:"RESUME"`
-	Refresh *bool            `ddl:"keyword" sql:"REFRESH"`
-	Set     *DynamicTableSet `ddl:"keyword" sql:"SET"`
+	Suspend           *bool              `ddl:"keyword" sql:"SUSPEND"`
+	Resume            *bool              `ddl:"keyword" sql:"RESUME"`
+	Refresh           *bool              `ddl:"keyword" sql:"REFRESH"`
+	Set               *DynamicTableSet   `ddl:"keyword" sql:"SET"`
+	Unset             *DynamicTableUnset `ddl:"list,no_parentheses" sql:"UNSET"`
+	ClusterBy         []string           `ddl:"keyword,parentheses" sql:"CLUSTER BY"`
+	DropClusteringKey *bool              `ddl:"keyword" sql:"DROP CLUSTERING KEY"`
 }
 
 // dropDynamicTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-dynamic-table
@@ -130,6 +148,21 @@ func (dt *DynamicTable) ID() SchemaObjectIdentifier {
 	return NewSchemaObjectIdentifier(dt.DatabaseName, dt.SchemaName, dt.Name)
 }
 
+func (dt *DynamicTable) GetClusterByKeys() []string {
+	if dt.ClusterBy == "" {
+		return nil
+	}
+
+	statementWithoutLinear := strings.TrimSuffix(strings.Replace(dt.ClusterBy, "LINEAR(", "", 1), ")")
+	keysRaw := strings.Split(statementWithoutLinear, ",")
+	keysClean := make([]string, 0, len(keysRaw))
+	for _, key := range keysRaw {
+		keysClean = append(keysClean, strings.TrimSpace(key))
+	}
+
+	return keysClean
+}
+
 type dynamicTableRow struct {
 	CreatedOn           time.Time      `db:"created_on"`
 	Name                string         `db:"name"`
@@ -249,3 +282,107 @@ func (row dynamicTableDetailsRow) convert() *DynamicTableDetails {
 	}
 	return dtd
 }
+
+// dynamicTableRefreshHistoryOptions is based on https://docs.snowflake.com/en/sql-reference/functions/dynamic_table_refresh_history
+type dynamicTableRefreshHistoryOptions struct {
+	selectEverythingFrom bool                                  `ddl:"static" sql:"SELECT * FROM TABLE"`
+	parameters           *dynamicTableRefreshHistoryParameters `ddl:"list,parentheses,no_comma"`
+}
+
+type dynamicTableRefreshHistoryParameters struct {
+	// function is the DYNAMIC_TABLE_REFRESH_HISTORY table function in the INFORMATION_SCHEMA of the dynamic table's database.
+	Function  SchemaObjectIdentifier               `ddl:"identifier"`
+	arguments *dynamicTableRefreshHistoryArguments `ddl:"list,parentheses"`
+}
+
+type dynamicTableRefreshHistoryArguments struct {
+	Name        string `ddl:"parameter,single_quotes,arrow_equals" sql:"NAME"`
+	ResultLimit *int   `ddl:"parameter,arrow_equals" sql:"RESULT_LIMIT"`
+	ErrorOnly   *bool  `ddl:"parameter,arrow_equals" sql:"ERROR_ONLY"`
+}
+
+type DynamicTableRefreshState string
+
+const (
+	DynamicTableRefreshStateScheduled      DynamicTableRefreshState = "SCHEDULED"
+	DynamicTableRefreshStateExecuting      DynamicTableRefreshState = "EXECUTING"
+	DynamicTableRefreshStateSucceeded      DynamicTableRefreshState = "SUCCEEDED"
+	DynamicTableRefreshStateFailed         DynamicTableRefreshState = "FAILED"
+	DynamicTableRefreshStateCancelled      DynamicTableRefreshState = "CANCELLED"
+	DynamicTableRefreshStateUpstreamFailed DynamicTableRefreshState = "UPSTREAM_FAILED"
+)
+
+type DynamicTableRefreshTrigger string
+
+const (
+	DynamicTableRefreshTriggerScheduled DynamicTableRefreshTrigger = "SCHEDULED"
+	DynamicTableRefreshTriggerManual    DynamicTableRefreshTrigger = "MANUAL"
+	DynamicTableRefreshTriggerCreation  DynamicTableRefreshTrigger = "CREATION"
+)
+
+type DynamicTableRefreshHistory struct {
+	Name             string
+	SchemaName       string
+	DatabaseName     string
+	State            DynamicTableRefreshState
+	StateCode        string
+	StateMessage     string
+	QueryId          string
+	DataTimestamp    time.Time
+	RefreshStartTime *time.Time
+	RefreshEndTime   *time.Time
+	CompletionTarget *time.Time
+	RefreshAction    string
+	RefreshTrigger   DynamicTableRefreshTrigger
+}
+
+type dynamicTableRefreshHistoryRow struct {
+	Name             string         `db:"NAME"`
+	SchemaName       string         `db:"SCHEMA_NAME"`
+	DatabaseName     string         `db:"DATABASE_NAME"`
+	State            string         `db:"STATE"`
+	StateCode        sql.NullString `db:"STATE_CODE"`
+	StateMessage     sql.NullString `db:"STATE_MESSAGE"`
+	QueryId          sql.NullString `db:"QUERY_ID"`
+	DataTimestamp    time.Time      `db:"DATA_TIMESTAMP"`
+	RefreshStartTime sql.NullTime   `db:"REFRESH_START_TIME"`
+	RefreshEndTime   sql.NullTime   `db:"REFRESH_END_TIME"`
+	CompletionTarget sql.NullTime   `db:"COMPLETION_TARGET"`
+	RefreshAction    sql.NullString `db:"REFRESH_ACTION"`
+	RefreshTrigger   sql.NullString `db:"REFRESH_TRIGGER"`
+}
+
+func (row dynamicTableRefreshHistoryRow) convert() *DynamicTableRefreshHistory {
+	history := &DynamicTableRefreshHistory{
+		Name:          row.Name,
+		SchemaName:    row.SchemaName,
+		DatabaseName:  row.DatabaseName,
+		State:         DynamicTableRefreshState(row.State),
+		DataTimestamp: row.DataTimestamp,
+	}
+	if row.StateCode.Valid {
+		history.StateCode = row.StateCode.String
+	}
+	if row.StateMessage.Valid {
+		history.StateMessage = row.StateMessage.String
+	}
+	if row.QueryId.Valid {
+		history.QueryId = row.QueryId.String
+	}
+	if row.RefreshStartTime.Valid {
+		history.RefreshStartTime = &row.RefreshStartTime.Time
+	}
+	if row.RefreshEndTime.Valid {
+		history.RefreshEndTime = &row.RefreshEndTime.Time
+	}
+	if row.CompletionTarget.Valid {
+		history.CompletionTarget = &row.CompletionTarget.Time
+	}
+	if row.RefreshAction.Valid {
+		history.RefreshAction = row.RefreshAction.String
+	}
+	if row.RefreshTrigger.Valid {
+		history.RefreshTrigger = DynamicTableRefreshTrigger(row.RefreshTrigger.String)
+	}
+	return history
+}
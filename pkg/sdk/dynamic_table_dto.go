@@ -7,6 +7,8 @@ var (
 	_ optionsProvider[alterDynamicTableOptions]  = new(AlterDynamicTableRequest)
 	_ optionsProvider[dropDynamicTableOptions]   = new(DropDynamicTableRequest)
 	_ optionsProvider[showDynamicTableOptions]   = new(ShowDynamicTableRequest)
+
+	_ optionsProvider[dynamicTableRefreshHistoryOptions] = new(DynamicTableRefreshHistoryRequest)
 )
 
 type CreateDynamicTableRequest struct {
@@ -17,24 +19,39 @@ type CreateDynamicTableRequest struct {
 	targetLag TargetLag               // required
 	query     string                  // required
 
-	comment     *string
-	refreshMode *DynamicTableRefreshMode
-	initialize  *DynamicTableInitialize
+	comment                    *string
+	refreshMode                *DynamicTableRefreshMode
+	initialize                 *DynamicTableInitialize
+	clusterBy                  []string
+	dataRetentionTimeInDays    *int
+	maxDataExtensionTimeInDays *int
 }
 
 type AlterDynamicTableRequest struct {
 	name SchemaObjectIdentifier // required
 
 	// One of
-	suspend *bool
-	resume  *bool
-	refresh *bool
-	set     *DynamicTableSetRequest
+	suspend           *bool
+	resume            *bool
+	refresh           *bool
+	set               *DynamicTableSetRequest
+	unset             *DynamicTableUnsetRequest
+	clusterBy         []string
+	dropClusteringKey *bool
 }
 
 type DynamicTableSetRequest struct {
-	targetLag  *TargetLag
-	warehourse *AccountObjectIdentifier
+	targetLag                  *TargetLag
+	warehourse                 *AccountObjectIdentifier
+	dataRetentionTimeInDays    *int
+	maxDataExtensionTimeInDays *int
+	comment                    *string
+}
+
+type DynamicTableUnsetRequest struct {
+	dataRetentionTimeInDays    *bool
+	maxDataExtensionTimeInDays *bool
+	comment                    *bool
 }
 
 type DropDynamicTableRequest struct {
@@ -51,3 +68,10 @@ type ShowDynamicTableRequest struct {
 	startsWith *string
 	limit      *LimitFrom
 }
+
+type DynamicTableRefreshHistoryRequest struct {
+	name SchemaObjectIdentifier // required
+
+	resultLimit *int
+	errorOnly   *bool
+}
@@ -34,6 +34,21 @@ func (s *CreateDynamicTableRequest) WithInitialize(initialize DynamicTableInitia
 	return s
 }
 
+func (s *CreateDynamicTableRequest) WithClusterBy(clusterBy []string) *CreateDynamicTableRequest {
+	s.clusterBy = clusterBy
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithDataRetentionTimeInDays(dataRetentionTimeInDays *int) *CreateDynamicTableRequest {
+	s.dataRetentionTimeInDays = dataRetentionTimeInDays
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithMaxDataExtensionTimeInDays(maxDataExtensionTimeInDays *int) *CreateDynamicTableRequest {
+	s.maxDataExtensionTimeInDays = maxDataExtensionTimeInDays
+	return s
+}
+
 func NewAlterDynamicTableRequest(
 	name SchemaObjectIdentifier,
 ) *AlterDynamicTableRequest {
@@ -62,6 +77,21 @@ func (s *AlterDynamicTableRequest) WithSet(set *DynamicTableSetRequest) *AlterDy
 	return s
 }
 
+func (s *AlterDynamicTableRequest) WithUnset(unset *DynamicTableUnsetRequest) *AlterDynamicTableRequest {
+	s.unset = unset
+	return s
+}
+
+func (s *AlterDynamicTableRequest) WithClusterBy(clusterBy []string) *AlterDynamicTableRequest {
+	s.clusterBy = clusterBy
+	return s
+}
+
+func (s *AlterDynamicTableRequest) WithDropClusteringKey(dropClusteringKey *bool) *AlterDynamicTableRequest {
+	s.dropClusteringKey = dropClusteringKey
+	return s
+}
+
 func NewDynamicTableSetRequest() *DynamicTableSetRequest {
 	return &DynamicTableSetRequest{}
 }
@@ -76,6 +106,40 @@ func (s *DynamicTableSetRequest) WithWarehouse(warehourse AccountObjectIdentifie
 	return s
 }
 
+func (s *DynamicTableSetRequest) WithDataRetentionTimeInDays(dataRetentionTimeInDays *int) *DynamicTableSetRequest {
+	s.dataRetentionTimeInDays = dataRetentionTimeInDays
+	return s
+}
+
+func (s *DynamicTableSetRequest) WithMaxDataExtensionTimeInDays(maxDataExtensionTimeInDays *int) *DynamicTableSetRequest {
+	s.maxDataExtensionTimeInDays = maxDataExtensionTimeInDays
+	return s
+}
+
+func (s *DynamicTableSetRequest) WithComment(comment *string) *DynamicTableSetRequest {
+	s.comment = comment
+	return s
+}
+
+func NewDynamicTableUnsetRequest() *DynamicTableUnsetRequest {
+	return &DynamicTableUnsetRequest{}
+}
+
+func (s *DynamicTableUnsetRequest) WithDataRetentionTimeInDays(dataRetentionTimeInDays *bool) *DynamicTableUnsetRequest {
+	s.dataRetentionTimeInDays = dataRetentionTimeInDays
+	return s
+}
+
+func (s *DynamicTableUnsetRequest) WithMaxDataExtensionTimeInDays(maxDataExtensionTimeInDays *bool) *DynamicTableUnsetRequest {
+	s.maxDataExtensionTimeInDays = maxDataExtensionTimeInDays
+	return s
+}
+
+func (s *DynamicTableUnsetRequest) WithComment(comment *bool) *DynamicTableUnsetRequest {
+	s.comment = comment
+	return s
+}
+
 func NewDropDynamicTableRequest(
 	name SchemaObjectIdentifier,
 ) *DropDynamicTableRequest {
@@ -115,3 +179,21 @@ func (s *ShowDynamicTableRequest) WithLimit(limit *LimitFrom) *ShowDynamicTableR
 	s.limit = limit
 	return s
 }
+
+func NewDynamicTableRefreshHistoryRequest(
+	name SchemaObjectIdentifier,
+) *DynamicTableRefreshHistoryRequest {
+	s := DynamicTableRefreshHistoryRequest{}
+	s.name = name
+	return &s
+}
+
+func (s *DynamicTableRefreshHistoryRequest) WithResultLimit(resultLimit *int) *DynamicTableRefreshHistoryRequest {
+	s.resultLimit = resultLimit
+	return s
+}
+
+func (s *DynamicTableRefreshHistoryRequest) WithErrorOnly(errorOnly *bool) *DynamicTableRefreshHistoryRequest {
+	s.errorOnly = errorOnly
+	return s
+}
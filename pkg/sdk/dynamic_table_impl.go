@@ -55,6 +55,15 @@ func (v *dynamicTables) ShowByID(ctx context.Context, id SchemaObjectIdentifier)
 	return collections.FindOne(dynamicTables, func(r DynamicTable) bool { return r.Name == id.Name() })
 }
 
+func (v *dynamicTables) RefreshHistory(ctx context.Context, request *DynamicTableRefreshHistoryRequest) ([]DynamicTableRefreshHistory, error) {
+	opts := request.toOpts()
+	rows, err := validateAndQuery[dynamicTableRefreshHistoryRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[dynamicTableRefreshHistoryRow, DynamicTableRefreshHistory](rows), nil
+}
+
 func (s *CreateDynamicTableRequest) toOpts() *createDynamicTableOptions {
 	return &createDynamicTableOptions{
 		OrReplace:   Bool(s.orReplace),
@@ -65,6 +74,10 @@ func (s *CreateDynamicTableRequest) toOpts() *createDynamicTableOptions {
 		Comment:     s.comment,
 		RefreshMode: s.refreshMode,
 		Initialize:  s.initialize,
+		ClusterBy:   s.clusterBy,
+
+		DataRetentionTimeInDays:    s.dataRetentionTimeInDays,
+		MaxDataExtensionTimeInDays: s.maxDataExtensionTimeInDays,
 	}
 }
 
@@ -82,8 +95,23 @@ func (s *AlterDynamicTableRequest) toOpts() *alterDynamicTableOptions {
 		opts.Refresh = s.refresh
 	}
 	if s.set != nil {
-		opts.Set = &DynamicTableSet{s.set.targetLag, s.set.warehourse}
+		opts.Set = &DynamicTableSet{
+			TargetLag:                  s.set.targetLag,
+			Warehouse:                  s.set.warehourse,
+			DataRetentionTimeInDays:    s.set.dataRetentionTimeInDays,
+			MaxDataExtensionTimeInDays: s.set.maxDataExtensionTimeInDays,
+			Comment:                    s.set.comment,
+		}
+	}
+	if s.unset != nil {
+		opts.Unset = &DynamicTableUnset{
+			DataRetentionTimeInDays:    s.unset.dataRetentionTimeInDays,
+			MaxDataExtensionTimeInDays: s.unset.maxDataExtensionTimeInDays,
+			Comment:                    s.unset.comment,
+		}
 	}
+	opts.ClusterBy = s.clusterBy
+	opts.DropClusteringKey = s.dropClusteringKey
 	return &opts
 }
 
@@ -115,3 +143,16 @@ func (s *ShowDynamicTableRequest) toOpts() *showDynamicTableOptions {
 	}
 	return &opts
 }
+
+func (s *DynamicTableRefreshHistoryRequest) toOpts() *dynamicTableRefreshHistoryOptions {
+	return &dynamicTableRefreshHistoryOptions{
+		parameters: &dynamicTableRefreshHistoryParameters{
+			Function: NewSchemaObjectIdentifier(s.name.DatabaseName(), "INFORMATION_SCHEMA", "DYNAMIC_TABLE_REFRESH_HISTORY"),
+			arguments: &dynamicTableRefreshHistoryArguments{
+				Name:        s.name.FullyQualifiedName(),
+				ResultLimit: s.resultLimit,
+				ErrorOnly:   s.errorOnly,
+			},
+		},
+	}
+}
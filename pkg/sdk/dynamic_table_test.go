@@ -41,7 +41,16 @@ func TestDynamicTableCreate(t *testing.T) {
 		opts.Comment = String("comment")
 		opts.RefreshMode = DynamicTableRefreshModeFull.ToPointer()
 		opts.Initialize = DynamicTableInitializeOnSchedule.ToPointer()
-		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE DYNAMIC TABLE %s TARGET_LAG = '1 minutes' INITIALIZE = ON_SCHEDULE REFRESH_MODE = FULL WAREHOUSE = "warehouse_name" COMMENT = 'comment' AS SELECT product_id, product_name FROM staging_table`, id.FullyQualifiedName())
+		opts.ClusterBy = []string{"product_id", "product_name"}
+		opts.DataRetentionTimeInDays = Int(2)
+		opts.MaxDataExtensionTimeInDays = Int(7)
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE DYNAMIC TABLE %s TARGET_LAG = '1 minutes' INITIALIZE = ON_SCHEDULE REFRESH_MODE = FULL WAREHOUSE = "warehouse_name" CLUSTER BY (product_id, product_name) DATA_RETENTION_TIME_IN_DAYS = 2 MAX_DATA_EXTENSION_TIME_IN_DAYS = 7 COMMENT = 'comment' AS SELECT product_id, product_name FROM staging_table`, id.FullyQualifiedName())
+	})
+
+	t.Run("downstream target lag", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.targetLag = TargetLag{Downstream: Bool(true)}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE DYNAMIC TABLE %s TARGET_LAG = DOWNSTREAM WAREHOUSE = "warehouse_name" AS SELECT product_id, product_name FROM staging_table`, id.FullyQualifiedName())
 	})
 }
 
@@ -66,19 +75,19 @@ func TestDynamicTableAlter(t *testing.T) {
 
 	t.Run("validation: no alter action", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set", "Unset", "ClusterBy", "DropClusteringKey"))
 	})
 
 	t.Run("validation: multiple alter actions", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Resume = Bool(true)
 		opts.Suspend = Bool(true)
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set", "Unset", "ClusterBy", "DropClusteringKey"))
 	})
 
 	t.Run("validation: no property to unset", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set", "Unset", "ClusterBy", "DropClusteringKey"))
 	})
 
 	t.Run("suspend", func(t *testing.T) {
@@ -105,6 +114,57 @@ func TestDynamicTableAlter(t *testing.T) {
 		}
 		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s SET TARGET_LAG = '1 minutes' WAREHOUSE = "warehouse_name"`, id.FullyQualifiedName())
 	})
+
+	t.Run("validation: empty set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &DynamicTableSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("DynamicTableSet", "TargetLag", "Warehouse", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "Comment"))
+	})
+
+	t.Run("validation: empty unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &DynamicTableUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("DynamicTableUnset", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "Comment"))
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s REFRESH`, id.FullyQualifiedName())
+	})
+
+	t.Run("set data retention and comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &DynamicTableSet{
+			TargetLag:                  &TargetLag{Downstream: Bool(true)},
+			DataRetentionTimeInDays:    Int(1),
+			MaxDataExtensionTimeInDays: Int(3),
+			Comment:                    String("comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s SET TARGET_LAG = DOWNSTREAM DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 3 COMMENT = 'comment'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &DynamicTableUnset{
+			DataRetentionTimeInDays:    Bool(true),
+			MaxDataExtensionTimeInDays: Bool(true),
+			Comment:                    Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s UNSET DATA_RETENTION_TIME_IN_DAYS, MAX_DATA_EXTENSION_TIME_IN_DAYS, COMMENT`, id.FullyQualifiedName())
+	})
+
+	t.Run("cluster by", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ClusterBy = []string{"a", "b"}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s CLUSTER BY (a, b)`, id.FullyQualifiedName())
+	})
+
+	t.Run("drop clustering key", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DropClusteringKey = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s DROP CLUSTERING KEY`, id.FullyQualifiedName())
+	})
 }
 
 func TestDynamicTableDrop(t *testing.T) {
@@ -201,3 +261,37 @@ func TestDynamicTableDescribe(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE DYNAMIC TABLE %s`, id.FullyQualifiedName())
 	})
 }
+
+func TestDynamicTableRefreshHistory(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "dynamic_table")
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *dynamicTableRefreshHistoryOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: result limit out of range", func(t *testing.T) {
+		opts := NewDynamicTableRefreshHistoryRequest(id).WithResultLimit(Int(0)).toOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errIntBetween("dynamicTableRefreshHistoryArguments", "ResultLimit", 1, 10000))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := NewDynamicTableRefreshHistoryRequest(id).toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SELECT * FROM TABLE ("db"."INFORMATION_SCHEMA"."DYNAMIC_TABLE_REFRESH_HISTORY" (NAME => '\"db\".\"schema\".\"dynamic_table\"'))`)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := NewDynamicTableRefreshHistoryRequest(id).WithResultLimit(Int(10)).WithErrorOnly(Bool(true)).toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SELECT * FROM TABLE ("db"."INFORMATION_SCHEMA"."DYNAMIC_TABLE_REFRESH_HISTORY" (NAME => '\"db\".\"schema\".\"dynamic_table\"', RESULT_LIMIT => 10, ERROR_ONLY => true))`)
+	})
+}
+
+func TestDynamicTable_GetClusterByKeys(t *testing.T) {
+	dt := DynamicTable{ClusterBy: "LINEAR(a, upper(b))"}
+	if keys := dt.GetClusterByKeys(); len(keys) != 2 || keys[0] != "a" || keys[1] != "upper(b)" {
+		t.Errorf("unexpected cluster by keys: %v", keys)
+	}
+	if keys := (&DynamicTable{}).GetClusterByKeys(); keys != nil {
+		t.Errorf("expected no cluster by keys, got: %v", keys)
+	}
+}
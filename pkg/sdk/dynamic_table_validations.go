@@ -7,6 +7,8 @@ var (
 	_ validatable = new(showDynamicTableOptions)
 	_ validatable = new(describeDynamicTableOptions)
 	_ validatable = new(DynamicTableSet)
+	_ validatable = new(DynamicTableUnset)
+	_ validatable = new(dynamicTableRefreshHistoryOptions)
 )
 
 func (tl *TargetLag) validate() error {
@@ -41,9 +43,19 @@ func (dts *DynamicTableSet) validate() error {
 	if dts.Warehouse != nil && !ValidObjectIdentifier(*dts.Warehouse) {
 		errs = append(errs, errInvalidIdentifier("DynamicTableSet", "Warehouse"))
 	}
+	if !anyValueSet(dts.TargetLag, dts.Warehouse, dts.DataRetentionTimeInDays, dts.MaxDataExtensionTimeInDays, dts.Comment) {
+		errs = append(errs, errAtLeastOneOf("DynamicTableSet", "TargetLag", "Warehouse", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "Comment"))
+	}
 	return JoinErrors(errs...)
 }
 
+func (dtu *DynamicTableUnset) validate() error {
+	if !anyValueSet(dtu.DataRetentionTimeInDays, dtu.MaxDataExtensionTimeInDays, dtu.Comment) {
+		return errAtLeastOneOf("DynamicTableUnset", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "Comment")
+	}
+	return nil
+}
+
 func (opts *alterDynamicTableOptions) validate() error {
 	if opts == nil {
 		return ErrNilOptions
@@ -52,11 +64,14 @@ func (opts *alterDynamicTableOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if ok := exactlyOneValueSet(opts.Suspend, opts.Resume, opts.Refresh, opts.Set); !ok {
-		errs = append(errs, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set"))
+	if ok := exactlyOneValueSet(opts.Suspend, opts.Resume, opts.Refresh, opts.Set, opts.Unset, opts.ClusterBy, opts.DropClusteringKey); !ok {
+		errs = append(errs, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set", "Unset", "ClusterBy", "DropClusteringKey"))
+	}
+	if valueSet(opts.Set) {
+		errs = append(errs, opts.Set.validate())
 	}
-	if valueSet(opts.Set) && valueSet(opts.Set.TargetLag) {
-		errs = append(errs, opts.Set.TargetLag.validate())
+	if valueSet(opts.Unset) {
+		errs = append(errs, opts.Unset.validate())
 	}
 	return JoinErrors(errs...)
 }
@@ -94,3 +109,19 @@ func (opts *describeDynamicTableOptions) validate() error {
 	}
 	return nil
 }
+
+func (opts *dynamicTableRefreshHistoryOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	if opts.parameters == nil || opts.parameters.arguments == nil {
+		return errNotSet("dynamicTableRefreshHistoryOptions", "parameters")
+	}
+	if !ValidObjectIdentifier(opts.parameters.Function) {
+		return ErrInvalidObjectIdentifier
+	}
+	if opts.parameters.arguments.ResultLimit != nil && (*opts.parameters.arguments.ResultLimit < 1 || *opts.parameters.arguments.ResultLimit > 10000) {
+		return errIntBetween("dynamicTableRefreshHistoryArguments", "ResultLimit", 1, 10000)
+	}
+	return nil
+}
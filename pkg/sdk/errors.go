@@ -16,10 +16,12 @@ var (
 	// go-snowflake errors.
 	ErrObjectNotExistOrAuthorized = NewError("object does not exist or not authorized")
 	ErrAccountIsEmpty             = NewError("account is empty")
+	ErrInsufficientPrivileges     = NewError("insufficient privileges")
 
 	// snowflake-sdk errors.
 	ErrInvalidObjectIdentifier = NewError("invalid object identifier")
 	ErrDifferentDatabase       = NewError("database must be the same")
+	ErrTagNotSet               = NewError("tag is not set on the object")
 )
 
 type IntErrType string
@@ -76,6 +78,7 @@ func decodeDriverError(err error) error {
 	if err == nil {
 		return nil
 	}
+	err = redactError(err)
 	log.Printf("[DEBUG] err: %v\n", err)
 	m := map[string]error{
 		"does not exist or not authorized": ErrObjectNotExistOrAuthorized,
@@ -86,6 +89,10 @@ func decodeDriverError(err error) error {
 			return v
 		}
 	}
+	// the message is kept, as it names the object and the privilege
+	if strings.Contains(err.Error(), "Insufficient privileges") {
+		return fmt.Errorf("%w: %w", ErrInsufficientPrivileges, err)
+	}
 
 	return err
 }
@@ -104,7 +111,7 @@ type Error struct {
 func (e *Error) Error() string {
 	builder := new(strings.Builder)
 	writeTree(e, builder, 0)
-	return builder.String()
+	return RedactSecrets(builder.String())
 }
 
 // NewError creates new sdk.Error with information like filename or line number (depending on where NewError was called)
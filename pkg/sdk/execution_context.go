@@ -0,0 +1,159 @@
+package sdk
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// ExecutionContext is the role, the secondary roles and the warehouse the statements of an operation are executed with
+// instead of the ones of the provider connection.
+type ExecutionContext struct {
+	Role string
+	// SecondaryRoles are the names of the secondary roles, or a single ALL or NONE.
+	SecondaryRoles []string
+	Warehouse      string
+}
+
+func (ec ExecutionContext) IsEmpty() bool {
+	return ec.Role == "" && len(ec.SecondaryRoles) == 0 && ec.Warehouse == ""
+}
+
+func (ec ExecutionContext) validate() error {
+	if len(ec.SecondaryRoles) > 1 && slices.ContainsFunc(ec.SecondaryRoles, func(role string) bool {
+		return strings.EqualFold(role, "ALL") || strings.EqualFold(role, "NONE")
+	}) {
+		return fmt.Errorf("secondary roles %q: ALL and NONE cannot be combined with other roles", ec.SecondaryRoles)
+	}
+	return nil
+}
+
+func (ec ExecutionContext) String() string {
+	return fmt.Sprintf("role: %q, secondary roles: %q, warehouse: %q", ec.Role, ec.SecondaryRoles, ec.Warehouse)
+}
+
+// useSecondaryRolesStatement returns the USE SECONDARY ROLES statement setting the secondary roles of the sessions.
+func (ec ExecutionContext) useSecondaryRolesStatement() string {
+	if len(ec.SecondaryRoles) == 1 {
+		if keyword := strings.ToUpper(ec.SecondaryRoles[0]); keyword == "ALL" || keyword == "NONE" {
+			return "USE SECONDARY ROLES " + keyword
+		}
+	}
+	roles := make([]string, len(ec.SecondaryRoles))
+	for i, role := range ec.SecondaryRoles {
+		roles[i] = NewAccountObjectIdentifier(role).FullyQualifiedName()
+	}
+	return "USE SECONDARY ROLES " + strings.Join(roles, ", ")
+}
+
+// executionContextSources hold the configuration of the connections created with NewClientWithOptions, needed to open
+// their counterparts with another execution context. It is kept next to the connection.
+var executionContextSources sync.Map
+
+type executionContextSource struct {
+	driverName string
+	config     *gosnowflake.Config
+	opts       *ConnectionOptions
+
+	mu  sync.Mutex
+	dbs map[string]*sql.DB
+}
+
+func registerExecutionContextSource(db *sql.DB, driverName string, config *gosnowflake.Config, opts *ConnectionOptions) {
+	executionContextSources.Store(db, &executionContextSource{
+		driverName: driverName,
+		config:     config,
+		opts:       opts,
+		dbs:        make(map[string]*sql.DB),
+	})
+}
+
+// DBWithExecutionContext returns the connection of db executing all statements with the given execution context. Each
+// execution context gets its own connection pool, opened on the first use and reused afterwards, so the sessions never
+// switch between the roles: there is nothing to restore after an operation and the concurrent operations with
+// different execution contexts cannot affect each other. The pools share the query tag and the statement concurrency
+// limit of db. The SHOW results depend on the role, so each pool gets its own SHOW result cache, invalidated together with
+// the cache of db.
+func DBWithExecutionContext(db *sql.DB, ec ExecutionContext) (*sql.DB, error) {
+	if ec.IsEmpty() {
+		return db, nil
+	}
+	if err := ec.validate(); err != nil {
+		return nil, err
+	}
+	value, ok := executionContextSources.Load(db)
+	if !ok {
+		return nil, fmt.Errorf("the connection does not support the execution context (%s), it has to be created with NewClientWithOptions", ec)
+	}
+	source := value.(*executionContextSource)
+	key := ec.String()
+
+	source.mu.Lock()
+	defer source.mu.Unlock()
+	if contextDB, ok := source.dbs[key]; ok {
+		return contextDB, nil
+	}
+	contextDB, err := source.open(ec, connectionStateForDB(db))
+	if err != nil {
+		return nil, fmt.Errorf("open snowflake connection with execution context (%s): %w", ec, err)
+	}
+	if cache := showCacheForDB(db); cache != nil {
+		enableLinkedShowCache(contextDB, cache)
+	}
+	source.dbs[key] = contextDB
+	log.Printf("[DEBUG] opened connection with execution context (%s)\n", ec)
+	return contextDB, nil
+}
+
+func (s *executionContextSource) open(ec ExecutionContext, state *connectionState) (*sql.DB, error) {
+	config := *s.config
+	config.Params = maps.Clone(s.config.Params)
+	if ec.Role != "" {
+		config.Role = ec.Role
+	}
+	if ec.Warehouse != "" {
+		config.Warehouse = ec.Warehouse
+	}
+	opts := ConnectionOptions{}
+	if s.opts != nil {
+		opts = *s.opts
+		opts.WarmUpStatements = slices.Clone(s.opts.WarmUpStatements)
+	}
+	// the limit is shared with the provider connection
+	opts.MaxConcurrentStatements = 0
+	if len(ec.SecondaryRoles) > 0 {
+		opts.WarmUpStatements = append(opts.WarmUpStatements, ec.useSecondaryRolesStatement())
+	}
+
+	dsn, err := gosnowflake.DSN(&config)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		state = &connectionState{}
+	}
+	db, err := connect(s.driverName, dsn, &opts, state)
+	if err != nil {
+		return nil, err
+	}
+	opts.apply(db.DB)
+	return db.DB, nil
+}
+
+// executionContextDBs returns the connections opened by DBWithExecutionContext for db.
+func executionContextDBs(db *sql.DB) map[string]*sql.DB {
+	value, ok := executionContextSources.Load(db)
+	if !ok {
+		return nil
+	}
+	source := value.(*executionContextSource)
+	source.mu.Lock()
+	defer source.mu.Unlock()
+	return maps.Clone(source.dbs)
+}
@@ -0,0 +1,80 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionContext_useSecondaryRolesStatement(t *testing.T) {
+	assert.Equal(t, "USE SECONDARY ROLES ALL", ExecutionContext{SecondaryRoles: []string{"all"}}.useSecondaryRolesStatement())
+	assert.Equal(t, "USE SECONDARY ROLES NONE", ExecutionContext{SecondaryRoles: []string{"NONE"}}.useSecondaryRolesStatement())
+	assert.Equal(t, `USE SECONDARY ROLES "ANALYST", "loader"`, ExecutionContext{SecondaryRoles: []string{"ANALYST", "loader"}}.useSecondaryRolesStatement())
+}
+
+func TestDBWithExecutionContext(t *testing.T) {
+	config := &gosnowflake.Config{Account: "ACCOUNT", User: "USER", Password: "password", Role: "PROVIDER_ROLE"}
+	contextConfig := *config
+	contextConfig.Role = "OWNER_ROLE"
+	contextConfig.Warehouse = "OWNER_WAREHOUSE"
+	contextDSN, err := gosnowflake.DSN(&contextConfig)
+	require.NoError(t, err)
+
+	_, contextMock, err := sqlmock.NewWithDSN(contextDSN, sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	contextMock.ExpectExec("USE SECONDARY ROLES ALL").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	_, _, err = sqlmock.NewWithDSN("execution_context_dsn")
+	require.NoError(t, err)
+	providerDB, err := connect("sqlmock", "execution_context_dsn", nil, &connectionState{})
+	require.NoError(t, err)
+	db := providerDB.DB
+	t.Cleanup(func() { db.Close() })
+	opts := &ConnectionOptions{MaxOpenConnections: 3, MaxConcurrentStatements: 2}
+	opts.apply(db)
+	EnableQueryTag(db, QueryTag{RunId: "run"})
+	registerExecutionContextSource(db, "sqlmock", config, opts)
+
+	t.Run("empty execution context", func(t *testing.T) {
+		contextDB, err := DBWithExecutionContext(db, ExecutionContext{})
+		require.NoError(t, err)
+		assert.Same(t, db, contextDB)
+	})
+
+	t.Run("pool per execution context", func(t *testing.T) {
+		ec := ExecutionContext{Role: "OWNER_ROLE", SecondaryRoles: []string{"ALL"}, Warehouse: "OWNER_WAREHOUSE"}
+		contextDB, err := DBWithExecutionContext(db, ec)
+		require.NoError(t, err)
+		assert.NotSame(t, db, contextDB)
+		require.NoError(t, contextMock.ExpectationsWereMet())
+
+		assert.Equal(t, 3, contextDB.Stats().MaxOpenConnections)
+		assert.Same(t, statementLimiterForDB(db), statementLimiterForDB(contextDB))
+		require.NotNil(t, connectionStateForDB(contextDB))
+		assert.Same(t, connectionStateForDB(db), connectionStateForDB(contextDB))
+		assert.Equal(t, &QueryTag{RunId: "run"}, connectionStateForDB(contextDB).queryTag.Load())
+		assert.Equal(t, "PROVIDER_ROLE", config.Role)
+
+		sameContextDB, err := DBWithExecutionContext(db, ec)
+		require.NoError(t, err)
+		assert.Same(t, contextDB, sameContextDB)
+		assert.Len(t, executionContextDBs(db), 1)
+	})
+
+	t.Run("invalid secondary roles", func(t *testing.T) {
+		_, err := DBWithExecutionContext(db, ExecutionContext{SecondaryRoles: []string{"ALL", "ANALYST"}})
+		require.ErrorContains(t, err, "ALL and NONE cannot be combined with other roles")
+	})
+
+	t.Run("connection without the source", func(t *testing.T) {
+		other, _, err := sqlmock.New()
+		require.NoError(t, err)
+		t.Cleanup(func() { other.Close() })
+
+		_, err = DBWithExecutionContext(other, ExecutionContext{Role: "OWNER_ROLE"})
+		require.ErrorContains(t, err, "has to be created with NewClientWithOptions")
+	})
+}
@@ -150,6 +150,7 @@ var FunctionsDef = g.NewInterface(
 			functionPackages,
 			g.ParameterOptions().Parentheses().SQL("PACKAGES"),
 		).
+		OptionalTextAssignment("ARTIFACT_REPOSITORY", g.ParameterOptions().NoQuotes()).
 		TextAssignment("HANDLER", g.ParameterOptions().SingleQuotes().Required()).
 		ListAssignment("EXTERNAL_ACCESS_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
 		ListAssignment("SECRETS", "Secret", g.ParameterOptions().Parentheses()).
@@ -192,6 +193,8 @@ var FunctionsDef = g.NewInterface(
 			g.ParameterOptions().Parentheses().SQL("PACKAGES"),
 		).
 		TextAssignment("HANDLER", g.ParameterOptions().SingleQuotes().Required()).
+		ListAssignment("EXTERNAL_ACCESS_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
+		ListAssignment("SECRETS", "Secret", g.ParameterOptions().Parentheses()).
 		OptionalTextAssignment("TARGET_PATH", g.ParameterOptions().SingleQuotes()).
 		PredefinedQueryStructField("FunctionDefinition", "*string", g.ParameterOptions().NoEquals().SingleQuotes().SQL("AS")).
 		WithValidation(g.ValidIdentifier, "name").
@@ -236,6 +239,8 @@ var FunctionsDef = g.NewInterface(
 		OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
 		OptionalTextAssignment("SET LOG_LEVEL", g.ParameterOptions().SingleQuotes()).
 		OptionalTextAssignment("SET TRACE_LEVEL", g.ParameterOptions().SingleQuotes()).
+		ListAssignment("SET EXTERNAL_ACCESS_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
+		ListAssignment("SET SECRETS", "Secret", g.ParameterOptions().Parentheses()).
 		OptionalSQL("SET SECURE").
 		OptionalSQL("UNSET SECURE").
 		OptionalSQL("UNSET LOG_LEVEL").
@@ -245,7 +250,7 @@ var FunctionsDef = g.NewInterface(
 		OptionalUnsetTags().
 		WithValidation(g.ValidIdentifier, "name").
 		WithValidation(g.ValidIdentifierIfSet, "RenameTo").
-		WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "SetSecure", "UnsetLogLevel", "UnsetTraceLevel", "UnsetSecure", "UnsetComment", "SetTags", "UnsetTags"),
+		WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "SetExternalAccessIntegrations", "SetSecrets", "SetSecure", "UnsetLogLevel", "UnsetTraceLevel", "UnsetSecure", "UnsetComment", "SetTags", "UnsetTags"),
 ).DropOperation(
 	"https://docs.snowflake.com/en/sql-reference/sql/drop-function",
 	g.NewQueryStruct("DropFunction").
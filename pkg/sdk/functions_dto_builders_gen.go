@@ -306,6 +306,11 @@ func (s *CreateForPythonFunctionRequest) WithPackages(Packages []FunctionPackage
 	return s
 }
 
+func (s *CreateForPythonFunctionRequest) WithArtifactRepository(ArtifactRepository *string) *CreateForPythonFunctionRequest {
+	s.ArtifactRepository = ArtifactRepository
+	return s
+}
+
 func (s *CreateForPythonFunctionRequest) WithExternalAccessIntegrations(ExternalAccessIntegrations []AccountObjectIdentifier) *CreateForPythonFunctionRequest {
 	s.ExternalAccessIntegrations = ExternalAccessIntegrations
 	return s
@@ -398,6 +403,16 @@ func (s *CreateForScalaFunctionRequest) WithPackages(Packages []FunctionPackageR
 	return s
 }
 
+func (s *CreateForScalaFunctionRequest) WithExternalAccessIntegrations(ExternalAccessIntegrations []AccountObjectIdentifier) *CreateForScalaFunctionRequest {
+	s.ExternalAccessIntegrations = ExternalAccessIntegrations
+	return s
+}
+
+func (s *CreateForScalaFunctionRequest) WithSecrets(Secrets []Secret) *CreateForScalaFunctionRequest {
+	s.Secrets = Secrets
+	return s
+}
+
 func (s *CreateForScalaFunctionRequest) WithTargetPath(TargetPath *string) *CreateForScalaFunctionRequest {
 	s.TargetPath = TargetPath
 	return s
@@ -500,6 +515,16 @@ func (s *AlterFunctionRequest) WithSetTraceLevel(SetTraceLevel *string) *AlterFu
 	return s
 }
 
+func (s *AlterFunctionRequest) WithSetExternalAccessIntegrations(SetExternalAccessIntegrations []AccountObjectIdentifier) *AlterFunctionRequest {
+	s.SetExternalAccessIntegrations = SetExternalAccessIntegrations
+	return s
+}
+
+func (s *AlterFunctionRequest) WithSetSecrets(SetSecrets []Secret) *AlterFunctionRequest {
+	s.SetSecrets = SetSecrets
+	return s
+}
+
 func (s *AlterFunctionRequest) WithSetSecure(SetSecure *bool) *AlterFunctionRequest {
 	s.SetSecure = SetSecure
 	return s
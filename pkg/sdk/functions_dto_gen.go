@@ -100,6 +100,7 @@ type CreateForPythonFunctionRequest struct {
 	Comment                    *string
 	Imports                    []FunctionImportRequest
 	Packages                   []FunctionPackageRequest
+	ArtifactRepository         *string
 	Handler                    string // required
 	ExternalAccessIntegrations []AccountObjectIdentifier
 	Secrets                    []Secret
@@ -107,24 +108,26 @@ type CreateForPythonFunctionRequest struct {
 }
 
 type CreateForScalaFunctionRequest struct {
-	OrReplace             *bool
-	Temporary             *bool
-	Secure                *bool
-	IfNotExists           *bool
-	name                  SchemaObjectIdentifier // required
-	Arguments             []FunctionArgumentRequest
-	CopyGrants            *bool
-	ResultDataType        DataType // required
-	ReturnNullValues      *ReturnNullValues
-	NullInputBehavior     *NullInputBehavior
-	ReturnResultsBehavior *ReturnResultsBehavior
-	RuntimeVersion        *string
-	Comment               *string
-	Imports               []FunctionImportRequest
-	Packages              []FunctionPackageRequest
-	Handler               string // required
-	TargetPath            *string
-	FunctionDefinition    *string
+	OrReplace                  *bool
+	Temporary                  *bool
+	Secure                     *bool
+	IfNotExists                *bool
+	name                       SchemaObjectIdentifier // required
+	Arguments                  []FunctionArgumentRequest
+	CopyGrants                 *bool
+	ResultDataType             DataType // required
+	ReturnNullValues           *ReturnNullValues
+	NullInputBehavior          *NullInputBehavior
+	ReturnResultsBehavior      *ReturnResultsBehavior
+	RuntimeVersion             *string
+	Comment                    *string
+	Imports                    []FunctionImportRequest
+	Packages                   []FunctionPackageRequest
+	Handler                    string // required
+	ExternalAccessIntegrations []AccountObjectIdentifier
+	Secrets                    []Secret
+	TargetPath                 *string
+	FunctionDefinition         *string
 }
 
 type CreateForSQLFunctionRequest struct {
@@ -143,20 +146,22 @@ type CreateForSQLFunctionRequest struct {
 }
 
 type AlterFunctionRequest struct {
-	IfExists          *bool
-	name              SchemaObjectIdentifier // required
-	ArgumentDataTypes []DataType             // required
-	RenameTo          *SchemaObjectIdentifier
-	SetComment        *string
-	SetLogLevel       *string
-	SetTraceLevel     *string
-	SetSecure         *bool
-	UnsetSecure       *bool
-	UnsetLogLevel     *bool
-	UnsetTraceLevel   *bool
-	UnsetComment      *bool
-	SetTags           []TagAssociation
-	UnsetTags         []ObjectIdentifier
+	IfExists                      *bool
+	name                          SchemaObjectIdentifier // required
+	ArgumentDataTypes             []DataType             // required
+	RenameTo                      *SchemaObjectIdentifier
+	SetComment                    *string
+	SetLogLevel                   *string
+	SetTraceLevel                 *string
+	SetExternalAccessIntegrations []AccountObjectIdentifier
+	SetSecrets                    []Secret
+	SetSecure                     *bool
+	UnsetSecure                   *bool
+	UnsetLogLevel                 *bool
+	UnsetTraceLevel               *bool
+	UnsetComment                  *bool
+	SetTags                       []TagAssociation
+	UnsetTags                     []ObjectIdentifier
 }
 
 type DropFunctionRequest struct {
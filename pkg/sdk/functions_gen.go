@@ -116,6 +116,7 @@ type CreateForPythonFunctionOptions struct {
 	Comment                    *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
 	Imports                    []FunctionImport          `ddl:"parameter,parentheses" sql:"IMPORTS"`
 	Packages                   []FunctionPackage         `ddl:"parameter,parentheses" sql:"PACKAGES"`
+	ArtifactRepository         *string                   `ddl:"parameter,no_quotes" sql:"ARTIFACT_REPOSITORY"`
 	Handler                    string                    `ddl:"parameter,single_quotes" sql:"HANDLER"`
 	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
 	Secrets                    []Secret                  `ddl:"parameter,parentheses" sql:"SECRETS"`
@@ -124,27 +125,29 @@ type CreateForPythonFunctionOptions struct {
 
 // CreateForScalaFunctionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-function#scala-handler.
 type CreateForScalaFunctionOptions struct {
-	create                bool                   `ddl:"static" sql:"CREATE"`
-	OrReplace             *bool                  `ddl:"keyword" sql:"OR REPLACE"`
-	Temporary             *bool                  `ddl:"keyword" sql:"TEMPORARY"`
-	Secure                *bool                  `ddl:"keyword" sql:"SECURE"`
-	function              bool                   `ddl:"static" sql:"FUNCTION"`
-	IfNotExists           *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
-	name                  SchemaObjectIdentifier `ddl:"identifier"`
-	Arguments             []FunctionArgument     `ddl:"list,must_parentheses"`
-	CopyGrants            *bool                  `ddl:"keyword" sql:"COPY GRANTS"`
-	ResultDataType        DataType               `ddl:"parameter,no_equals" sql:"RETURNS"`
-	ReturnNullValues      *ReturnNullValues      `ddl:"keyword"`
-	languageScala         bool                   `ddl:"static" sql:"LANGUAGE SCALA"`
-	NullInputBehavior     *NullInputBehavior     `ddl:"keyword"`
-	ReturnResultsBehavior *ReturnResultsBehavior `ddl:"keyword"`
-	RuntimeVersion        *string                `ddl:"parameter,single_quotes" sql:"RUNTIME_VERSION"`
-	Comment               *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
-	Imports               []FunctionImport       `ddl:"parameter,parentheses" sql:"IMPORTS"`
-	Packages              []FunctionPackage      `ddl:"parameter,parentheses" sql:"PACKAGES"`
-	Handler               string                 `ddl:"parameter,single_quotes" sql:"HANDLER"`
-	TargetPath            *string                `ddl:"parameter,single_quotes" sql:"TARGET_PATH"`
-	FunctionDefinition    *string                `ddl:"parameter,single_quotes,no_equals" sql:"AS"`
+	create                     bool                      `ddl:"static" sql:"CREATE"`
+	OrReplace                  *bool                     `ddl:"keyword" sql:"OR REPLACE"`
+	Temporary                  *bool                     `ddl:"keyword" sql:"TEMPORARY"`
+	Secure                     *bool                     `ddl:"keyword" sql:"SECURE"`
+	function                   bool                      `ddl:"static" sql:"FUNCTION"`
+	IfNotExists                *bool                     `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                       SchemaObjectIdentifier    `ddl:"identifier"`
+	Arguments                  []FunctionArgument        `ddl:"list,must_parentheses"`
+	CopyGrants                 *bool                     `ddl:"keyword" sql:"COPY GRANTS"`
+	ResultDataType             DataType                  `ddl:"parameter,no_equals" sql:"RETURNS"`
+	ReturnNullValues           *ReturnNullValues         `ddl:"keyword"`
+	languageScala              bool                      `ddl:"static" sql:"LANGUAGE SCALA"`
+	NullInputBehavior          *NullInputBehavior        `ddl:"keyword"`
+	ReturnResultsBehavior      *ReturnResultsBehavior    `ddl:"keyword"`
+	RuntimeVersion             *string                   `ddl:"parameter,single_quotes" sql:"RUNTIME_VERSION"`
+	Comment                    *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Imports                    []FunctionImport          `ddl:"parameter,parentheses" sql:"IMPORTS"`
+	Packages                   []FunctionPackage         `ddl:"parameter,parentheses" sql:"PACKAGES"`
+	Handler                    string                    `ddl:"parameter,single_quotes" sql:"HANDLER"`
+	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	Secrets                    []Secret                  `ddl:"parameter,parentheses" sql:"SECRETS"`
+	TargetPath                 *string                   `ddl:"parameter,single_quotes" sql:"TARGET_PATH"`
+	FunctionDefinition         *string                   `ddl:"parameter,single_quotes,no_equals" sql:"AS"`
 }
 
 // CreateForSQLFunctionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-function#sql-handler.
@@ -167,22 +170,24 @@ type CreateForSQLFunctionOptions struct {
 
 // AlterFunctionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-function.
 type AlterFunctionOptions struct {
-	alter             bool                    `ddl:"static" sql:"ALTER"`
-	function          bool                    `ddl:"static" sql:"FUNCTION"`
-	IfExists          *bool                   `ddl:"keyword" sql:"IF EXISTS"`
-	name              SchemaObjectIdentifier  `ddl:"identifier"`
-	ArgumentDataTypes []DataType              `ddl:"keyword,must_parentheses"`
-	RenameTo          *SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
-	SetComment        *string                 `ddl:"parameter,single_quotes" sql:"SET COMMENT"`
-	SetLogLevel       *string                 `ddl:"parameter,single_quotes" sql:"SET LOG_LEVEL"`
-	SetTraceLevel     *string                 `ddl:"parameter,single_quotes" sql:"SET TRACE_LEVEL"`
-	SetSecure         *bool                   `ddl:"keyword" sql:"SET SECURE"`
-	UnsetSecure       *bool                   `ddl:"keyword" sql:"UNSET SECURE"`
-	UnsetLogLevel     *bool                   `ddl:"keyword" sql:"UNSET LOG_LEVEL"`
-	UnsetTraceLevel   *bool                   `ddl:"keyword" sql:"UNSET TRACE_LEVEL"`
-	UnsetComment      *bool                   `ddl:"keyword" sql:"UNSET COMMENT"`
-	SetTags           []TagAssociation        `ddl:"keyword" sql:"SET TAG"`
-	UnsetTags         []ObjectIdentifier      `ddl:"keyword" sql:"UNSET TAG"`
+	alter                         bool                      `ddl:"static" sql:"ALTER"`
+	function                      bool                      `ddl:"static" sql:"FUNCTION"`
+	IfExists                      *bool                     `ddl:"keyword" sql:"IF EXISTS"`
+	name                          SchemaObjectIdentifier    `ddl:"identifier"`
+	ArgumentDataTypes             []DataType                `ddl:"keyword,must_parentheses"`
+	RenameTo                      *SchemaObjectIdentifier   `ddl:"identifier" sql:"RENAME TO"`
+	SetComment                    *string                   `ddl:"parameter,single_quotes" sql:"SET COMMENT"`
+	SetLogLevel                   *string                   `ddl:"parameter,single_quotes" sql:"SET LOG_LEVEL"`
+	SetTraceLevel                 *string                   `ddl:"parameter,single_quotes" sql:"SET TRACE_LEVEL"`
+	SetExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"SET EXTERNAL_ACCESS_INTEGRATIONS"`
+	SetSecrets                    []Secret                  `ddl:"parameter,parentheses" sql:"SET SECRETS"`
+	SetSecure                     *bool                     `ddl:"keyword" sql:"SET SECURE"`
+	UnsetSecure                   *bool                     `ddl:"keyword" sql:"UNSET SECURE"`
+	UnsetLogLevel                 *bool                     `ddl:"keyword" sql:"UNSET LOG_LEVEL"`
+	UnsetTraceLevel               *bool                     `ddl:"keyword" sql:"UNSET TRACE_LEVEL"`
+	UnsetComment                  *bool                     `ddl:"keyword" sql:"UNSET COMMENT"`
+	SetTags                       []TagAssociation          `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags                     []ObjectIdentifier        `ddl:"keyword" sql:"UNSET TAG"`
 }
 
 // DropFunctionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-function.
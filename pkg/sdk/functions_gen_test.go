@@ -287,6 +287,25 @@ func TestFunctions_CreateForPython(t *testing.T) {
 		opts.FunctionDefinition = String("import numpy as np")
 		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE TEMPORARY SECURE FUNCTION %s (i NUMBER DEFAULT 1) COPY GRANTS RETURNS VARIANT NOT NULL LANGUAGE PYTHON CALLED ON NULL INPUT IMMUTABLE RUNTIME_VERSION = '3.8' COMMENT = 'comment' IMPORTS = ('numpy', 'pandas') PACKAGES = ('numpy', 'pandas') HANDLER = 'udf' EXTERNAL_ACCESS_INTEGRATIONS = ("ext_integration") SECRETS = ('variable1' = name1, 'variable2' = name2) AS 'import numpy as np'`, id.FullyQualifiedName())
 	})
+
+	t.Run("artifact repository", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Returns = FunctionReturns{
+			ResultDataType: &FunctionReturnsResultDataType{
+				ResultDataType: DataTypeVariant,
+			},
+		}
+		opts.RuntimeVersion = "3.11"
+		opts.Packages = []FunctionPackage{
+			{
+				Package: "scikit-learn",
+			},
+		}
+		opts.ArtifactRepository = String("snowflake.snowpark.pypi_shared_repository")
+		opts.Handler = "udf"
+		opts.FunctionDefinition = String("def udf(): pass")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE FUNCTION %s () RETURNS VARIANT LANGUAGE PYTHON RUNTIME_VERSION = '3.11' PACKAGES = ('scikit-learn') ARTIFACT_REPOSITORY = snowflake.snowpark.pypi_shared_repository HANDLER = 'udf' AS 'def udf(): pass'`, id.FullyQualifiedName())
+	})
 }
 
 func TestFunctions_CreateForScala(t *testing.T) {
@@ -356,6 +375,24 @@ func TestFunctions_CreateForScala(t *testing.T) {
 		opts.FunctionDefinition = String("return x")
 		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE TEMPORARY SECURE FUNCTION %s (x VARCHAR DEFAULT 'test') COPY GRANTS RETURNS VARCHAR NOT NULL LANGUAGE SCALA CALLED ON NULL INPUT IMMUTABLE RUNTIME_VERSION = '2.0' COMMENT = 'comment' IMPORTS = ('@udf_libs/echohandler.jar') HANDLER = 'Echo.echoVarchar' AS 'return x'`, id.FullyQualifiedName())
 	})
+
+	t.Run("external access integrations and secrets", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ResultDataType = DataTypeVARCHAR
+		opts.RuntimeVersion = String("2.12")
+		opts.Handler = "Echo.echoVarchar"
+		opts.ExternalAccessIntegrations = []AccountObjectIdentifier{
+			NewAccountObjectIdentifier("ext_integration"),
+		}
+		opts.Secrets = []Secret{
+			{
+				VariableName: "variable1",
+				Name:         "name1",
+			},
+		}
+		opts.FunctionDefinition = String("return x")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE FUNCTION %s () RETURNS VARCHAR LANGUAGE SCALA RUNTIME_VERSION = '2.12' HANDLER = 'Echo.echoVarchar' EXTERNAL_ACCESS_INTEGRATIONS = ("ext_integration") SECRETS = ('variable1' = name1) AS 'return x'`, id.FullyQualifiedName())
+	})
 }
 
 func TestFunctions_CreateForSQL(t *testing.T) {
@@ -491,14 +528,14 @@ func TestFunctions_Alter(t *testing.T) {
 
 	t.Run("validation: exactly one field should be present", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterFunctionOptions", "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "SetSecure", "UnsetLogLevel", "UnsetTraceLevel", "UnsetSecure", "UnsetComment", "SetTags", "UnsetTags"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterFunctionOptions", "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "SetExternalAccessIntegrations", "SetSecrets", "SetSecure", "UnsetLogLevel", "UnsetTraceLevel", "UnsetSecure", "UnsetComment", "SetTags", "UnsetTags"))
 	})
 
 	t.Run("validation: exactly one field should be present", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.SetLogLevel = String("DEBUG")
 		opts.UnsetComment = Bool(true)
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterFunctionOptions", "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "SetSecure", "UnsetLogLevel", "UnsetTraceLevel", "UnsetSecure", "UnsetComment", "SetTags", "UnsetTags"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterFunctionOptions", "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "SetExternalAccessIntegrations", "SetSecrets", "SetSecure", "UnsetLogLevel", "UnsetTraceLevel", "UnsetSecure", "UnsetComment", "SetTags", "UnsetTags"))
 	})
 
 	t.Run("alter: rename to", func(t *testing.T) {
@@ -533,6 +570,18 @@ func TestFunctions_Alter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `ALTER FUNCTION IF EXISTS %s (VARCHAR, NUMBER) SET COMMENT = 'comment'`, id.FullyQualifiedName())
 	})
 
+	t.Run("alter: set external access integrations", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetExternalAccessIntegrations = []AccountObjectIdentifier{NewAccountObjectIdentifier("ext_integration")}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER FUNCTION IF EXISTS %s (VARCHAR, NUMBER) SET EXTERNAL_ACCESS_INTEGRATIONS = ("ext_integration")`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: set secrets", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetSecrets = []Secret{{VariableName: "variable1", Name: "name1"}}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER FUNCTION IF EXISTS %s (VARCHAR, NUMBER) SET SECRETS = ('variable1' = name1)`, id.FullyQualifiedName())
+	})
+
 	t.Run("alter: set secure", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.SetSecure = Bool(true)
@@ -194,6 +194,7 @@ func (r *CreateForPythonFunctionRequest) toOpts() *CreateForPythonFunctionOption
 		RuntimeVersion:        r.RuntimeVersion,
 		Comment:               r.Comment,
 
+		ArtifactRepository:         r.ArtifactRepository,
 		Handler:                    r.Handler,
 		ExternalAccessIntegrations: r.ExternalAccessIntegrations,
 		Secrets:                    r.Secrets,
@@ -255,9 +256,11 @@ func (r *CreateForScalaFunctionRequest) toOpts() *CreateForScalaFunctionOptions
 		RuntimeVersion:        r.RuntimeVersion,
 		Comment:               r.Comment,
 
-		Handler:            r.Handler,
-		TargetPath:         r.TargetPath,
-		FunctionDefinition: r.FunctionDefinition,
+		Handler:                    r.Handler,
+		ExternalAccessIntegrations: r.ExternalAccessIntegrations,
+		Secrets:                    r.Secrets,
+		TargetPath:                 r.TargetPath,
+		FunctionDefinition:         r.FunctionDefinition,
 	}
 	if r.Arguments != nil {
 		s := make([]FunctionArgument, len(r.Arguments))
@@ -326,20 +329,22 @@ func (r *CreateForSQLFunctionRequest) toOpts() *CreateForSQLFunctionOptions {
 
 func (r *AlterFunctionRequest) toOpts() *AlterFunctionOptions {
 	opts := &AlterFunctionOptions{
-		IfExists:          r.IfExists,
-		name:              r.name,
-		ArgumentDataTypes: r.ArgumentDataTypes,
-		RenameTo:          r.RenameTo,
-		SetComment:        r.SetComment,
-		SetLogLevel:       r.SetLogLevel,
-		SetTraceLevel:     r.SetTraceLevel,
-		SetSecure:         r.SetSecure,
-		UnsetSecure:       r.UnsetSecure,
-		UnsetLogLevel:     r.UnsetLogLevel,
-		UnsetTraceLevel:   r.UnsetTraceLevel,
-		UnsetComment:      r.UnsetComment,
-		SetTags:           r.SetTags,
-		UnsetTags:         r.UnsetTags,
+		IfExists:                      r.IfExists,
+		name:                          r.name,
+		ArgumentDataTypes:             r.ArgumentDataTypes,
+		RenameTo:                      r.RenameTo,
+		SetComment:                    r.SetComment,
+		SetLogLevel:                   r.SetLogLevel,
+		SetTraceLevel:                 r.SetTraceLevel,
+		SetExternalAccessIntegrations: r.SetExternalAccessIntegrations,
+		SetSecrets:                    r.SetSecrets,
+		SetSecure:                     r.SetSecure,
+		UnsetSecure:                   r.UnsetSecure,
+		UnsetLogLevel:                 r.UnsetLogLevel,
+		UnsetTraceLevel:               r.UnsetTraceLevel,
+		UnsetComment:                  r.UnsetComment,
+		SetTags:                       r.SetTags,
+		UnsetTags:                     r.UnsetTags,
 	}
 	return opts
 }
@@ -215,15 +215,17 @@ func (v *grants) Show(ctx context.Context, opts *ShowGrantOptions) ([]Grant, err
 	}
 
 	logging.DebugLogger.Printf("[DEBUG] Show grants: opts %+v", opts)
-	dbRows, err := validateAndQuery[grantRow](v.client, ctx, opts)
-	logging.DebugLogger.Printf("[DEBUG] Show grants: query finished err = %v", err)
-	if err != nil {
-		return nil, err
-	}
-	logging.DebugLogger.Printf("[DEBUG] Show grants: converting rows")
-	resultList := convertRows[grantRow, Grant](dbRows)
-	logging.DebugLogger.Printf("[DEBUG] Show grants: rows converted")
-	return resultList, nil
+	return cachedShow(ctx, v.client, opts, grantsScope(opts), func(ctx context.Context) ([]Grant, error) {
+		dbRows, err := validateAndQuery[grantRow](v.client, ctx, opts)
+		logging.DebugLogger.Printf("[DEBUG] Show grants: query finished err = %v", err)
+		if err != nil {
+			return nil, err
+		}
+		logging.DebugLogger.Printf("[DEBUG] Show grants: converting rows")
+		resultList := convertRows[grantRow, Grant](dbRows)
+		logging.DebugLogger.Printf("[DEBUG] Show grants: rows converted")
+		return resultList, nil
+	})
 }
 
 func (v *grants) runOnAllPipes(ctx context.Context, inDatabase *AccountObjectIdentifier, inSchema *DatabaseObjectIdentifier, command func(Pipe) error) error {
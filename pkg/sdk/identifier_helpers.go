@@ -29,6 +29,32 @@ func NewObjectIdentifierFromFullyQualifiedName(fullyQualifiedName string) Object
 	return NewAccountObjectIdentifier(fullyQualifiedName)
 }
 
+// NewObjectIdentifierFromParts returns the identifier matching the number of given parts,
+// e.g. two parts produce a DatabaseObjectIdentifier and four parts produce a TableColumnIdentifier.
+func NewObjectIdentifierFromParts(parts ...string) (ObjectIdentifier, error) {
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("identifier parts cannot be empty, got: %v", parts)
+		}
+	}
+	switch len(parts) {
+	case 1:
+		return NewAccountObjectIdentifier(parts[0]), nil
+	case 2:
+		return NewDatabaseObjectIdentifier(parts[0], parts[1]), nil
+	case 3:
+		return NewSchemaObjectIdentifier(parts[0], parts[1], parts[2]), nil
+	case 4:
+		return NewTableColumnIdentifier(parts[0], parts[1], parts[2], parts[3]), nil
+	}
+	return nil, fmt.Errorf("identifier should consist of 1 to 4 parts, got %d: %v", len(parts), parts)
+}
+
+// QuoteIdentifier wraps a single identifier part in double quotes, escaping any double quotes inside it.
+func QuoteIdentifier(name string) string {
+	return fmt.Sprintf(`"%v"`, strings.ReplaceAll(name, `"`, `""`))
+}
+
 // for objects that live in other accounts
 type ExternalObjectIdentifier struct {
 	objectIdentifier  ObjectIdentifier
@@ -139,7 +165,7 @@ func (i AccountObjectIdentifier) FullyQualifiedName() string {
 	if i.name == "" {
 		return ""
 	}
-	return fmt.Sprintf(`"%v"`, i.name)
+	return QuoteIdentifier(i.name)
 }
 
 type DatabaseObjectIdentifier struct {
@@ -174,7 +200,7 @@ func (i DatabaseObjectIdentifier) FullyQualifiedName() string {
 	if i.name == "" && i.databaseName == "" {
 		return ""
 	}
-	return fmt.Sprintf(`"%v"."%v"`, i.databaseName, i.name)
+	return fmt.Sprintf(`%v.%v`, QuoteIdentifier(i.databaseName), QuoteIdentifier(i.name))
 }
 
 type SchemaObjectIdentifier struct {
@@ -252,14 +278,14 @@ func (i SchemaObjectIdentifier) FullyQualifiedName() string {
 		return ""
 	}
 	if len(i.arguments) == 0 {
-		return fmt.Sprintf(`"%v"."%v"."%v"`, i.databaseName, i.schemaName, i.name)
+		return fmt.Sprintf(`%v.%v.%v`, QuoteIdentifier(i.databaseName), QuoteIdentifier(i.schemaName), QuoteIdentifier(i.name))
 	}
 	// if this is a function or procedure, we need to include the arguments
 	args := make([]string, len(i.arguments))
 	for i, arg := range i.arguments {
 		args[i] = string(arg)
 	}
-	return fmt.Sprintf(`"%v"."%v"."%v"(%v)`, i.databaseName, i.schemaName, i.name, strings.Join(args, ", "))
+	return fmt.Sprintf(`%v.%v.%v(%v)`, QuoteIdentifier(i.databaseName), QuoteIdentifier(i.schemaName), QuoteIdentifier(i.name), strings.Join(args, ", "))
 }
 
 func (i SchemaObjectIdentifier) WithoutArguments() SchemaObjectIdentifier {
@@ -320,5 +346,5 @@ func (i TableColumnIdentifier) FullyQualifiedName() string {
 	if i.schemaName == "" && i.databaseName == "" && i.tableName == "" && i.columnName == "" {
 		return ""
 	}
-	return fmt.Sprintf(`"%v"."%v"."%v"."%v"`, i.databaseName, i.schemaName, i.tableName, i.columnName)
+	return fmt.Sprintf(`%v.%v.%v.%v`, QuoteIdentifier(i.databaseName), QuoteIdentifier(i.schemaName), QuoteIdentifier(i.tableName), QuoteIdentifier(i.columnName))
 }
@@ -82,3 +82,49 @@ func TestDatabaseObjectIdentifier(t *testing.T) {
 		assert.Equal(t, `"aaa"."bbb"`, identifier.FullyQualifiedName())
 	})
 }
+
+func TestNewObjectIdentifierFromParts(t *testing.T) {
+	t.Run("returns identifier matching the number of parts", func(t *testing.T) {
+		id, err := NewObjectIdentifierFromParts("db")
+		require.NoError(t, err)
+		assert.Equal(t, NewAccountObjectIdentifier("db"), id)
+
+		id, err = NewObjectIdentifierFromParts("db", "schema")
+		require.NoError(t, err)
+		assert.Equal(t, NewDatabaseObjectIdentifier("db", "schema"), id)
+
+		id, err = NewObjectIdentifierFromParts("db", "schema", "table")
+		require.NoError(t, err)
+		assert.Equal(t, NewSchemaObjectIdentifier("db", "schema", "table"), id)
+
+		id, err = NewObjectIdentifierFromParts("db", "schema", "table", "column")
+		require.NoError(t, err)
+		assert.Equal(t, NewTableColumnIdentifier("db", "schema", "table", "column"), id)
+	})
+
+	t.Run("validates number of parts", func(t *testing.T) {
+		_, err := NewObjectIdentifierFromParts()
+		require.ErrorContains(t, err, "identifier should consist of 1 to 4 parts, got 0")
+
+		_, err = NewObjectIdentifierFromParts("a", "b", "c", "d", "e")
+		require.ErrorContains(t, err, "identifier should consist of 1 to 4 parts, got 5")
+	})
+
+	t.Run("validates empty parts", func(t *testing.T) {
+		_, err := NewObjectIdentifierFromParts("db", "")
+		require.ErrorContains(t, err, "identifier parts cannot be empty")
+	})
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	assert.Equal(t, `"name"`, QuoteIdentifier("name"))
+	assert.Equal(t, `"na.me"`, QuoteIdentifier("na.me"))
+	assert.Equal(t, `"na""me"`, QuoteIdentifier(`na"me`))
+}
+
+func TestFullyQualifiedName_EscapesQuotes(t *testing.T) {
+	assert.Equal(t, `"a""b"`, NewAccountObjectIdentifier(`a"b`).FullyQualifiedName())
+	assert.Equal(t, `"db"."a""b"`, NewDatabaseObjectIdentifier("db", `a"b`).FullyQualifiedName())
+	assert.Equal(t, `"db"."schema"."a""b"`, NewSchemaObjectIdentifier("db", "schema", `a"b`).FullyQualifiedName())
+	assert.Equal(t, `"db"."schema"."table"."a""b"`, NewTableColumnIdentifier("db", "schema", "table", `a"b`).FullyQualifiedName())
+}
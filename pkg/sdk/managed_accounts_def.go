@@ -41,7 +41,7 @@ var ManagedAccountsDef = g.NewInterface(
 				"CreateManagedAccountParams",
 				g.NewQueryStruct("CreateManagedAccountParams").
 					TextAssignment("ADMIN_NAME", g.ParameterOptions().SingleQuotes().Required()).
-					TextAssignment("ADMIN_PASSWORD", g.ParameterOptions().SingleQuotes().Required()).
+					TextAssignment("ADMIN_PASSWORD", g.ParameterOptions().SingleQuotes().Required().Secret()).
 					PredefinedQueryStructField("typeProvider", "string", g.StaticOptions().SQL("TYPE = READER")).
 					OptionalComment().
 					WithValidation(g.ValidateValueSet, "AdminName").
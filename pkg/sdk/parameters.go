@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -16,6 +18,7 @@ var (
 	_ validatable = new(ObjectParameters)
 	_ validatable = new(UserParameters)
 	_ validatable = new(setParameterOnObject)
+	_ validatable = new(alterParametersOnObject)
 )
 
 var _ Parameters = (*parameters)(nil)
@@ -31,6 +34,9 @@ type Parameters interface {
 	ShowSessionParameter(ctx context.Context, parameter SessionParameter) (*Parameter, error)
 	ShowUserParameter(ctx context.Context, parameter UserParameter, user AccountObjectIdentifier) (*Parameter, error)
 	ShowObjectParameter(ctx context.Context, parameter ObjectParameter, object Object) (*Parameter, error)
+	ShowParametersForObject(ctx context.Context, object Object) ([]*Parameter, error)
+	SetParametersOnObject(ctx context.Context, object Object, parameters map[string]string) error
+	UnsetParametersOnObject(ctx context.Context, object Object, parameters []string) error
 }
 
 type parameters struct {
@@ -298,6 +304,134 @@ func (parameters *parameters) SetObjectParameterOnObject(ctx context.Context, ob
 	return err
 }
 
+// alterParametersOnObject sets or unsets any number of parameters on an object. For the current account the object name
+// should be left empty (ALTER ACCOUNT SET ...); an organization administrator can name another account instead.
+type alterParametersOnObject struct {
+	alter            bool                  `ddl:"static" sql:"ALTER"`
+	objectType       ObjectType            `ddl:"keyword"`
+	objectIdentifier ObjectIdentifier      `ddl:"identifier"`
+	set              []parameterAssignment `ddl:"keyword,no_comma" sql:"SET"`
+	unset            []string              `ddl:"keyword" sql:"UNSET"`
+}
+
+type parameterAssignment struct {
+	Key    string `ddl:"keyword"`
+	equals bool   `ddl:"static" sql:"="`
+	Value  string `ddl:"keyword"`
+}
+
+func (v *alterParametersOnObject) validate() error {
+	var errs []error
+	if !exactlyOneValueSet(v.set, v.unset) {
+		errs = append(errs, errExactlyOneOf("alterParametersOnObject", "set", "unset"))
+	}
+	if v.objectType != ObjectTypeAccount && (v.objectIdentifier == nil || !ValidObjectIdentifier(v.objectIdentifier)) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
+
+func newAlterParametersOnObject(object Object) *alterParametersOnObject {
+	opts := &alterParametersOnObject{
+		objectType:       object.ObjectType,
+		objectIdentifier: object.Name,
+	}
+	if object.ObjectType == ObjectTypeAccount && object.Name == nil {
+		opts.objectIdentifier = AccountObjectIdentifier{}
+	}
+	return opts
+}
+
+// SetParametersOnObject sets all the given parameters on the object in one statement. The values are quoted according
+// to the types of the parameters shown in the account: numbers and booleans are passed as is, while all other values
+// (including the values of unknown parameters) are single-quoted.
+func (parameters *parameters) SetParametersOnObject(ctx context.Context, object Object, values map[string]string) error {
+	if len(values) == 0 {
+		return nil
+	}
+	types, err := parameters.parameterTypes(ctx)
+	if err != nil {
+		return err
+	}
+	opts := newAlterParametersOnObject(object)
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		key := strings.ToUpper(k)
+		value, err := parameterValueToSQL(key, types[key], values[k])
+		if err != nil {
+			return err
+		}
+		opts.set = append(opts.set, parameterAssignment{Key: key, Value: value})
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = parameters.client.exec(ctx, sql)
+	return err
+}
+
+// UnsetParametersOnObject resets all the given parameters on the object to their inherited or default values.
+func (parameters *parameters) UnsetParametersOnObject(ctx context.Context, object Object, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	opts := newAlterParametersOnObject(object)
+	for _, k := range keys {
+		opts.unset = append(opts.unset, strings.ToUpper(k))
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = parameters.client.exec(ctx, sql)
+	return err
+}
+
+// parameterTypes returns the declared types of the parameters (BOOLEAN, NUMBER or STRING) by their keys. The account
+// parameters include the session, object and user parameters.
+func (parameters *parameters) parameterTypes(ctx context.Context) (map[string]string, error) {
+	shown, err := parameters.ShowParameters(ctx, &ShowParametersOptions{In: &ParametersIn{Account: Bool(true)}})
+	if err != nil {
+		return nil, err
+	}
+	types := make(map[string]string, len(shown))
+	for _, parameter := range shown {
+		types[parameter.Key] = parameter.Type
+	}
+	return types, nil
+}
+
+var numberParameterValueRegexp = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)([eE][+-]?\d+)?$`)
+
+func parameterValueToSQL(parameter string, parameterType string, value string) (string, error) {
+	switch parameterType {
+	case "BOOLEAN":
+		b, err := parseBooleanParameter(parameter, value)
+		if err != nil {
+			return "", err
+		}
+		return strings.ToUpper(strconv.FormatBool(*b)), nil
+	case "NUMBER":
+		if !numberParameterValueRegexp.MatchString(value) {
+			return "", fmt.Errorf("Number value expected for %v parameter, got %v instead", parameter, value)
+		}
+		return value, nil
+	default:
+		return SingleQuotes.Modify(value), nil
+	}
+}
+
 func parseBooleanParameter(parameter, value string) (_ *bool, err error) {
 	b, err := strconv.ParseBool(value)
 	if err != nil {
@@ -881,6 +1015,7 @@ type Parameter struct {
 	Default     string
 	Level       ParameterType
 	Description string
+	Type        string
 }
 
 type parameterRow struct {
@@ -889,6 +1024,7 @@ type parameterRow struct {
 	Default     sql.NullString `db:"default"`
 	Level       sql.NullString `db:"level"`
 	Description sql.NullString `db:"description"`
+	Type        sql.NullString `db:"type"`
 }
 
 func (row *parameterRow) toParameter() *Parameter {
@@ -898,6 +1034,7 @@ func (row *parameterRow) toParameter() *Parameter {
 		Default:     row.Default.String,
 		Level:       ParameterType(row.Level.String),
 		Description: row.Description.String,
+		Type:        row.Type.String,
 	}
 }
 
@@ -1011,3 +1148,31 @@ func (v *parameters) ShowObjectParameter(ctx context.Context, parameter ObjectPa
 	}
 	return parameters[0], nil
 }
+
+// ShowParametersForObject returns all parameters available for the given object together with their effective values,
+// defaults, and the level at which they are set. Supported object types are: account, user, warehouse, database,
+// schema, task, and table.
+func (v *parameters) ShowParametersForObject(ctx context.Context, object Object) ([]*Parameter, error) {
+	opts := &ShowParametersOptions{
+		In: &ParametersIn{},
+	}
+	switch object.ObjectType {
+	case ObjectTypeAccount:
+		opts.In.Account = Bool(true)
+	case ObjectTypeUser:
+		opts.In.User = object.Name.(AccountObjectIdentifier)
+	case ObjectTypeWarehouse:
+		opts.In.Warehouse = object.Name.(AccountObjectIdentifier)
+	case ObjectTypeDatabase:
+		opts.In.Database = object.Name.(AccountObjectIdentifier)
+	case ObjectTypeSchema:
+		opts.In.Schema = object.Name.(DatabaseObjectIdentifier)
+	case ObjectTypeTask:
+		opts.In.Task = object.Name.(SchemaObjectIdentifier)
+	case ObjectTypeTable:
+		opts.In.Table = object.Name.(SchemaObjectIdentifier)
+	default:
+		return nil, fmt.Errorf("unsupported object type %s", object.ObjectType)
+	}
+	return v.ShowParameters(ctx, opts)
+}
@@ -1,7 +1,12 @@
 package sdk
 
 import (
+	"context"
 	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TODO: add more tests
@@ -22,3 +27,100 @@ func TestSetObjectParameterOnObject(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s SET ENABLE_UNREDACTED_QUERY_SYNTAX_ERROR = TRUE", id.FullyQualifiedName())
 	})
 }
+
+func TestAlterParametersOnObject(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	t.Run("validation: neither set nor unset", func(t *testing.T) {
+		opts := newAlterParametersOnObject(Object{ObjectType: ObjectTypeTable, Name: id})
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterParametersOnObject", "set", "unset"))
+	})
+
+	t.Run("validation: missing identifier", func(t *testing.T) {
+		opts := newAlterParametersOnObject(Object{ObjectType: ObjectTypeTable})
+		opts.unset = []string{"DATA_RETENTION_TIME_IN_DAYS"}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("set on object", func(t *testing.T) {
+		opts := newAlterParametersOnObject(Object{ObjectType: ObjectTypeTable, Name: id})
+		opts.set = []parameterAssignment{
+			{Key: "DATA_RETENTION_TIME_IN_DAYS", Value: "5"},
+			{Key: "ENABLE_SCHEMA_EVOLUTION", Value: "TRUE"},
+			{Key: "DEFAULT_DDL_COLLATION", Value: "'en-ci'"},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s SET DATA_RETENTION_TIME_IN_DAYS = 5 ENABLE_SCHEMA_EVOLUTION = TRUE DEFAULT_DDL_COLLATION = 'en-ci'", id.FullyQualifiedName())
+	})
+
+	t.Run("unset on object", func(t *testing.T) {
+		opts := newAlterParametersOnObject(Object{ObjectType: ObjectTypeTable, Name: id})
+		opts.unset = []string{"DATA_RETENTION_TIME_IN_DAYS", "DEFAULT_DDL_COLLATION"}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s UNSET DATA_RETENTION_TIME_IN_DAYS, DEFAULT_DDL_COLLATION", id.FullyQualifiedName())
+	})
+
+	t.Run("set on account", func(t *testing.T) {
+		opts := newAlterParametersOnObject(Object{ObjectType: ObjectTypeAccount})
+		opts.set = []parameterAssignment{{Key: "TIMEZONE", Value: "'Europe/Warsaw'"}}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ACCOUNT SET TIMEZONE = 'Europe/Warsaw'")
+	})
+
+	t.Run("set on a named account", func(t *testing.T) {
+		opts := newAlterParametersOnObject(Object{ObjectType: ObjectTypeAccount, Name: NewAccountObjectIdentifier("myaccount")})
+		opts.set = []parameterAssignment{{Key: "TIMEZONE", Value: "'Europe/Warsaw'"}}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT "myaccount" SET TIMEZONE = 'Europe/Warsaw'`)
+	})
+}
+
+func TestParameterValueToSQL(t *testing.T) {
+	testCases := []struct {
+		parameterType string
+		value         string
+		expected      string
+	}{
+		{parameterType: "NUMBER", value: "5", expected: "5"},
+		{parameterType: "NUMBER", value: "-0.5e3", expected: "-0.5e3"},
+		{parameterType: "BOOLEAN", value: "true", expected: "TRUE"},
+		{parameterType: "BOOLEAN", value: "f", expected: "FALSE"},
+		{parameterType: "STRING", value: "NaN", expected: "'NaN'"},
+		{parameterType: "STRING", value: "Inf", expected: "'Inf'"},
+		{parameterType: "STRING", value: "t", expected: "'t'"},
+		{parameterType: "STRING", value: "1", expected: "'1'"},
+		{parameterType: "", value: "0", expected: "'0'"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.parameterType+" "+tc.value, func(t *testing.T) {
+			value, err := parameterValueToSQL("P", tc.parameterType, tc.value)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, value)
+		})
+	}
+
+	for _, value := range []string{"NaN", "Inf", "0x1p-2", "1_000"} {
+		_, err := parameterValueToSQL("P", "NUMBER", value)
+		require.ErrorContains(t, err, "Number value expected for P parameter")
+	}
+	_, err := parameterValueToSQL("P", "BOOLEAN", "yes")
+	require.ErrorContains(t, err, "Boolean value")
+}
+
+func TestSetParametersOnObject(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	client := NewClientFromDB(db)
+
+	mock.ExpectQuery(`SHOW PARAMETERS IN ACCOUNT`).WillReturnRows(sqlmock.NewRows([]string{"key", "value", "type"}).
+		AddRow("LOCK_TIMEOUT", "43200", "NUMBER").
+		AddRow("ENABLE_UNREDACTED_QUERY_SYNTAX_ERROR", "false", "BOOLEAN").
+		AddRow("QUERY_TAG", "", "STRING"))
+	mock.ExpectExec(`ALTER USER "u" SET ENABLE_UNREDACTED_QUERY_SYNTAX_ERROR = TRUE LOCK_TIMEOUT = 5 QUERY_TAG = '1'`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = client.Parameters.SetParametersOnObject(context.Background(), Object{ObjectType: ObjectTypeUser, Name: NewAccountObjectIdentifier("u")}, map[string]string{
+		"lock_timeout":                         "5",
+		"enable_unredacted_query_syntax_error": "true",
+		"query_tag":                            "1",
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
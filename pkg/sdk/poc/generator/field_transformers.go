@@ -88,6 +88,7 @@ type ParameterTransformer struct {
 	quotes      string
 	parentheses string
 	equals      string
+	secret      bool
 }
 
 func ParameterOptions() *ParameterTransformer {
@@ -149,6 +150,12 @@ func (v *ParameterTransformer) MustParentheses() *ParameterTransformer {
 	return v
 }
 
+// Secret marks the parameter value to be masked in the logged statements and the error messages.
+func (v *ParameterTransformer) Secret() *ParameterTransformer {
+	v.secret = true
+	return v
+}
+
 func (v *ParameterTransformer) Transform(f *Field) *Field {
 	addTagIfMissing(f.Tags, "ddl", "parameter")
 	if v.required {
@@ -158,6 +165,9 @@ func (v *ParameterTransformer) Transform(f *Field) *Field {
 	addTagIfMissing(f.Tags, "ddl", v.quotes)
 	addTagIfMissing(f.Tags, "ddl", v.parentheses)
 	addTagIfMissing(f.Tags, "ddl", v.equals)
+	if v.secret {
+		addTagIfMissing(f.Tags, "ddl", "secret")
+	}
 	return f
 }
 
@@ -19,6 +19,7 @@ var definitionMapping = map[string]*generator.Interface{
 	"database_role_def.go":             example.DatabaseRole,
 	"network_policies_def.go":          sdk.NetworkPoliciesDef,
 	"session_policies_def.go":          sdk.SessionPoliciesDef,
+	"authentication_policies_def.go":   sdk.AuthenticationPoliciesDef,
 	"tasks_def.go":                     sdk.TasksDef,
 	"streams_def.go":                   sdk.StreamsDef,
 	"application_roles_def.go":         sdk.ApplicationRolesDef,
@@ -32,6 +32,14 @@ const (
 	PolicyEntityDomainView        PolicyEntityDomain = "VIEW"
 )
 
+type PolicyKind string
+
+const (
+	PolicyKindAuthenticationPolicy PolicyKind = "AUTHENTICATION_POLICY"
+	PolicyKindPasswordPolicy       PolicyKind = "PASSWORD_POLICY"
+	PolicyKindSessionPolicy        PolicyKind = "SESSION_POLICY"
+)
+
 type policyReferenceFunctionArguments struct {
 	refEntityName   []ObjectIdentifier  `ddl:"parameter,single_quotes,arrow_equals" sql:"REF_ENTITY_NAME"`
 	refEntityDomain *PolicyEntityDomain `ddl:"parameter,single_quotes,arrow_equals" sql:"REF_ENTITY_DOMAIN"`
@@ -167,6 +167,7 @@ var ProceduresDef = g.NewInterface(
 			procedureImport,
 			g.ParameterOptions().Parentheses().SQL("IMPORTS"),
 		).
+		OptionalTextAssignment("ARTIFACT_REPOSITORY", g.ParameterOptions().NoQuotes()).
 		TextAssignment("HANDLER", g.ParameterOptions().SingleQuotes().Required()).
 		ListAssignment("EXTERNAL_ACCESS_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
 		ListAssignment("SECRETS", "Secret", g.ParameterOptions().Parentheses()).
@@ -211,6 +212,8 @@ var ProceduresDef = g.NewInterface(
 			g.ParameterOptions().Parentheses().SQL("IMPORTS"),
 		).
 		TextAssignment("HANDLER", g.ParameterOptions().SingleQuotes().Required()).
+		ListAssignment("EXTERNAL_ACCESS_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
+		ListAssignment("SECRETS", "Secret", g.ParameterOptions().Parentheses()).
 		OptionalTextAssignment("TARGET_PATH", g.ParameterOptions().SingleQuotes()).
 		PredefinedQueryStructField("NullInputBehavior", "*NullInputBehavior", g.KeywordOptions()).
 		OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
@@ -259,13 +262,17 @@ var ProceduresDef = g.NewInterface(
 		OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
 		OptionalTextAssignment("SET LOG_LEVEL", g.ParameterOptions().SingleQuotes()).
 		OptionalTextAssignment("SET TRACE_LEVEL", g.ParameterOptions().SingleQuotes()).
+		ListAssignment("SET EXTERNAL_ACCESS_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
+		ListAssignment("SET SECRETS", "Secret", g.ParameterOptions().Parentheses()).
+		OptionalSQL("UNSET LOG_LEVEL").
+		OptionalSQL("UNSET TRACE_LEVEL").
 		OptionalSQL("UNSET COMMENT").
 		OptionalSetTags().
 		OptionalUnsetTags().
 		PredefinedQueryStructField("ExecuteAs", "*ExecuteAs", g.KeywordOptions()).
 		WithValidation(g.ValidIdentifier, "name").
 		WithValidation(g.ValidIdentifierIfSet, "RenameTo").
-		WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "UnsetComment", "SetTags", "UnsetTags", "ExecuteAs"),
+		WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "SetExternalAccessIntegrations", "SetSecrets", "UnsetLogLevel", "UnsetTraceLevel", "UnsetComment", "SetTags", "UnsetTags", "ExecuteAs"),
 ).DropOperation(
 	"https://docs.snowflake.com/en/sql-reference/sql/drop-procedure",
 	g.NewQueryStruct("DropProcedure").
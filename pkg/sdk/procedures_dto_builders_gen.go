@@ -255,6 +255,11 @@ func (s *CreateForPythonProcedureRequest) WithImports(Imports []ProcedureImportR
 	return s
 }
 
+func (s *CreateForPythonProcedureRequest) WithArtifactRepository(ArtifactRepository *string) *CreateForPythonProcedureRequest {
+	s.ArtifactRepository = ArtifactRepository
+	return s
+}
+
 func (s *CreateForPythonProcedureRequest) WithExternalAccessIntegrations(ExternalAccessIntegrations []AccountObjectIdentifier) *CreateForPythonProcedureRequest {
 	s.ExternalAccessIntegrations = ExternalAccessIntegrations
 	return s
@@ -326,6 +331,16 @@ func (s *CreateForScalaProcedureRequest) WithImports(Imports []ProcedureImportRe
 	return s
 }
 
+func (s *CreateForScalaProcedureRequest) WithExternalAccessIntegrations(ExternalAccessIntegrations []AccountObjectIdentifier) *CreateForScalaProcedureRequest {
+	s.ExternalAccessIntegrations = ExternalAccessIntegrations
+	return s
+}
+
+func (s *CreateForScalaProcedureRequest) WithSecrets(Secrets []Secret) *CreateForScalaProcedureRequest {
+	s.Secrets = Secrets
+	return s
+}
+
 func (s *CreateForScalaProcedureRequest) WithTargetPath(TargetPath *string) *CreateForScalaProcedureRequest {
 	s.TargetPath = TargetPath
 	return s
@@ -452,6 +467,26 @@ func (s *AlterProcedureRequest) WithSetTraceLevel(SetTraceLevel *string) *AlterP
 	return s
 }
 
+func (s *AlterProcedureRequest) WithSetExternalAccessIntegrations(SetExternalAccessIntegrations []AccountObjectIdentifier) *AlterProcedureRequest {
+	s.SetExternalAccessIntegrations = SetExternalAccessIntegrations
+	return s
+}
+
+func (s *AlterProcedureRequest) WithSetSecrets(SetSecrets []Secret) *AlterProcedureRequest {
+	s.SetSecrets = SetSecrets
+	return s
+}
+
+func (s *AlterProcedureRequest) WithUnsetLogLevel(UnsetLogLevel *bool) *AlterProcedureRequest {
+	s.UnsetLogLevel = UnsetLogLevel
+	return s
+}
+
+func (s *AlterProcedureRequest) WithUnsetTraceLevel(UnsetTraceLevel *bool) *AlterProcedureRequest {
+	s.UnsetTraceLevel = UnsetTraceLevel
+	return s
+}
+
 func (s *AlterProcedureRequest) WithUnsetComment(UnsetComment *bool) *AlterProcedureRequest {
 	s.UnsetComment = UnsetComment
 	return s
@@ -98,6 +98,7 @@ type CreateForPythonProcedureRequest struct {
 	RuntimeVersion             string                    // required
 	Packages                   []ProcedurePackageRequest // required
 	Imports                    []ProcedureImportRequest
+	ArtifactRepository         *string
 	Handler                    string // required
 	ExternalAccessIntegrations []AccountObjectIdentifier
 	Secrets                    []Secret
@@ -108,21 +109,23 @@ type CreateForPythonProcedureRequest struct {
 }
 
 type CreateForScalaProcedureRequest struct {
-	OrReplace           *bool
-	Secure              *bool
-	name                SchemaObjectIdentifier // required
-	Arguments           []ProcedureArgumentRequest
-	CopyGrants          *bool
-	Returns             ProcedureReturnsRequest   // required
-	RuntimeVersion      string                    // required
-	Packages            []ProcedurePackageRequest // required
-	Imports             []ProcedureImportRequest
-	Handler             string // required
-	TargetPath          *string
-	NullInputBehavior   *NullInputBehavior
-	Comment             *string
-	ExecuteAs           *ExecuteAs
-	ProcedureDefinition *string
+	OrReplace                  *bool
+	Secure                     *bool
+	name                       SchemaObjectIdentifier // required
+	Arguments                  []ProcedureArgumentRequest
+	CopyGrants                 *bool
+	Returns                    ProcedureReturnsRequest   // required
+	RuntimeVersion             string                    // required
+	Packages                   []ProcedurePackageRequest // required
+	Imports                    []ProcedureImportRequest
+	Handler                    string // required
+	ExternalAccessIntegrations []AccountObjectIdentifier
+	Secrets                    []Secret
+	TargetPath                 *string
+	NullInputBehavior          *NullInputBehavior
+	Comment                    *string
+	ExecuteAs                  *ExecuteAs
+	ProcedureDefinition        *string
 }
 
 type CreateForSQLProcedureRequest struct {
@@ -145,17 +148,21 @@ type ProcedureSQLReturnsRequest struct {
 }
 
 type AlterProcedureRequest struct {
-	IfExists          *bool
-	name              SchemaObjectIdentifier // required
-	ArgumentDataTypes []DataType             // required
-	RenameTo          *SchemaObjectIdentifier
-	SetComment        *string
-	SetLogLevel       *string
-	SetTraceLevel     *string
-	UnsetComment      *bool
-	SetTags           []TagAssociation
-	UnsetTags         []ObjectIdentifier
-	ExecuteAs         *ExecuteAs
+	IfExists                      *bool
+	name                          SchemaObjectIdentifier // required
+	ArgumentDataTypes             []DataType             // required
+	RenameTo                      *SchemaObjectIdentifier
+	SetComment                    *string
+	SetLogLevel                   *string
+	SetTraceLevel                 *string
+	SetExternalAccessIntegrations []AccountObjectIdentifier
+	SetSecrets                    []Secret
+	UnsetLogLevel                 *bool
+	UnsetTraceLevel               *bool
+	UnsetComment                  *bool
+	SetTags                       []TagAssociation
+	UnsetTags                     []ObjectIdentifier
+	ExecuteAs                     *ExecuteAs
 }
 
 type DropProcedureRequest struct {
@@ -114,6 +114,7 @@ type CreateForPythonProcedureOptions struct {
 	RuntimeVersion             string                    `ddl:"parameter,single_quotes" sql:"RUNTIME_VERSION"`
 	Packages                   []ProcedurePackage        `ddl:"parameter,parentheses" sql:"PACKAGES"`
 	Imports                    []ProcedureImport         `ddl:"parameter,parentheses" sql:"IMPORTS"`
+	ArtifactRepository         *string                   `ddl:"parameter,no_quotes" sql:"ARTIFACT_REPOSITORY"`
 	Handler                    string                    `ddl:"parameter,single_quotes" sql:"HANDLER"`
 	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
 	Secrets                    []Secret                  `ddl:"parameter,parentheses" sql:"SECRETS"`
@@ -125,24 +126,26 @@ type CreateForPythonProcedureOptions struct {
 
 // CreateForScalaProcedureOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-procedure#scala-handler.
 type CreateForScalaProcedureOptions struct {
-	create              bool                   `ddl:"static" sql:"CREATE"`
-	OrReplace           *bool                  `ddl:"keyword" sql:"OR REPLACE"`
-	Secure              *bool                  `ddl:"keyword" sql:"SECURE"`
-	procedure           bool                   `ddl:"static" sql:"PROCEDURE"`
-	name                SchemaObjectIdentifier `ddl:"identifier"`
-	Arguments           []ProcedureArgument    `ddl:"list,must_parentheses"`
-	CopyGrants          *bool                  `ddl:"keyword" sql:"COPY GRANTS"`
-	Returns             ProcedureReturns       `ddl:"keyword" sql:"RETURNS"`
-	languageScala       bool                   `ddl:"static" sql:"LANGUAGE SCALA"`
-	RuntimeVersion      string                 `ddl:"parameter,single_quotes" sql:"RUNTIME_VERSION"`
-	Packages            []ProcedurePackage     `ddl:"parameter,parentheses" sql:"PACKAGES"`
-	Imports             []ProcedureImport      `ddl:"parameter,parentheses" sql:"IMPORTS"`
-	Handler             string                 `ddl:"parameter,single_quotes" sql:"HANDLER"`
-	TargetPath          *string                `ddl:"parameter,single_quotes" sql:"TARGET_PATH"`
-	NullInputBehavior   *NullInputBehavior     `ddl:"keyword"`
-	Comment             *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
-	ExecuteAs           *ExecuteAs             `ddl:"keyword"`
-	ProcedureDefinition *string                `ddl:"parameter,single_quotes,no_equals" sql:"AS"`
+	create                     bool                      `ddl:"static" sql:"CREATE"`
+	OrReplace                  *bool                     `ddl:"keyword" sql:"OR REPLACE"`
+	Secure                     *bool                     `ddl:"keyword" sql:"SECURE"`
+	procedure                  bool                      `ddl:"static" sql:"PROCEDURE"`
+	name                       SchemaObjectIdentifier    `ddl:"identifier"`
+	Arguments                  []ProcedureArgument       `ddl:"list,must_parentheses"`
+	CopyGrants                 *bool                     `ddl:"keyword" sql:"COPY GRANTS"`
+	Returns                    ProcedureReturns          `ddl:"keyword" sql:"RETURNS"`
+	languageScala              bool                      `ddl:"static" sql:"LANGUAGE SCALA"`
+	RuntimeVersion             string                    `ddl:"parameter,single_quotes" sql:"RUNTIME_VERSION"`
+	Packages                   []ProcedurePackage        `ddl:"parameter,parentheses" sql:"PACKAGES"`
+	Imports                    []ProcedureImport         `ddl:"parameter,parentheses" sql:"IMPORTS"`
+	Handler                    string                    `ddl:"parameter,single_quotes" sql:"HANDLER"`
+	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	Secrets                    []Secret                  `ddl:"parameter,parentheses" sql:"SECRETS"`
+	TargetPath                 *string                   `ddl:"parameter,single_quotes" sql:"TARGET_PATH"`
+	NullInputBehavior          *NullInputBehavior        `ddl:"keyword"`
+	Comment                    *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	ExecuteAs                  *ExecuteAs                `ddl:"keyword"`
+	ProcedureDefinition        *string                   `ddl:"parameter,single_quotes,no_equals" sql:"AS"`
 }
 
 // CreateForSQLProcedureOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-procedure#snowflake-scripting-handler.
@@ -170,19 +173,23 @@ type ProcedureSQLReturns struct {
 
 // AlterProcedureOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-procedure.
 type AlterProcedureOptions struct {
-	alter             bool                    `ddl:"static" sql:"ALTER"`
-	procedure         bool                    `ddl:"static" sql:"PROCEDURE"`
-	IfExists          *bool                   `ddl:"keyword" sql:"IF EXISTS"`
-	name              SchemaObjectIdentifier  `ddl:"identifier"`
-	ArgumentDataTypes []DataType              `ddl:"keyword,must_parentheses"`
-	RenameTo          *SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
-	SetComment        *string                 `ddl:"parameter,single_quotes" sql:"SET COMMENT"`
-	SetLogLevel       *string                 `ddl:"parameter,single_quotes" sql:"SET LOG_LEVEL"`
-	SetTraceLevel     *string                 `ddl:"parameter,single_quotes" sql:"SET TRACE_LEVEL"`
-	UnsetComment      *bool                   `ddl:"keyword" sql:"UNSET COMMENT"`
-	SetTags           []TagAssociation        `ddl:"keyword" sql:"SET TAG"`
-	UnsetTags         []ObjectIdentifier      `ddl:"keyword" sql:"UNSET TAG"`
-	ExecuteAs         *ExecuteAs              `ddl:"keyword"`
+	alter                         bool                      `ddl:"static" sql:"ALTER"`
+	procedure                     bool                      `ddl:"static" sql:"PROCEDURE"`
+	IfExists                      *bool                     `ddl:"keyword" sql:"IF EXISTS"`
+	name                          SchemaObjectIdentifier    `ddl:"identifier"`
+	ArgumentDataTypes             []DataType                `ddl:"keyword,must_parentheses"`
+	RenameTo                      *SchemaObjectIdentifier   `ddl:"identifier" sql:"RENAME TO"`
+	SetComment                    *string                   `ddl:"parameter,single_quotes" sql:"SET COMMENT"`
+	SetLogLevel                   *string                   `ddl:"parameter,single_quotes" sql:"SET LOG_LEVEL"`
+	SetTraceLevel                 *string                   `ddl:"parameter,single_quotes" sql:"SET TRACE_LEVEL"`
+	SetExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"SET EXTERNAL_ACCESS_INTEGRATIONS"`
+	SetSecrets                    []Secret                  `ddl:"parameter,parentheses" sql:"SET SECRETS"`
+	UnsetLogLevel                 *bool                     `ddl:"keyword" sql:"UNSET LOG_LEVEL"`
+	UnsetTraceLevel               *bool                     `ddl:"keyword" sql:"UNSET TRACE_LEVEL"`
+	UnsetComment                  *bool                     `ddl:"keyword" sql:"UNSET COMMENT"`
+	SetTags                       []TagAssociation          `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags                     []ObjectIdentifier        `ddl:"keyword" sql:"UNSET TAG"`
+	ExecuteAs                     *ExecuteAs                `ddl:"keyword"`
 }
 
 // DropProcedureOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-procedure.
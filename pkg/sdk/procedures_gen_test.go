@@ -253,6 +253,25 @@ func TestProcedures_CreateForPython(t *testing.T) {
 		opts.ProcedureDefinition = String("import numpy as np")
 		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE SECURE PROCEDURE %s (i int DEFAULT 1) COPY GRANTS RETURNS VARIANT NULL LANGUAGE PYTHON RUNTIME_VERSION = '3.8' PACKAGES = ('numpy', 'pandas') IMPORTS = ('numpy', 'pandas') HANDLER = 'udf' EXTERNAL_ACCESS_INTEGRATIONS = ("ext_integration") SECRETS = ('variable1' = name1, 'variable2' = name2) STRICT COMMENT = 'test comment' EXECUTE AS CALLER AS 'import numpy as np'`, id.FullyQualifiedName())
 	})
+
+	t.Run("artifact repository", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Returns = ProcedureReturns{
+			ResultDataType: &ProcedureReturnsResultDataType{
+				ResultDataType: DataTypeVARCHAR,
+			},
+		}
+		opts.RuntimeVersion = "3.11"
+		opts.Packages = []ProcedurePackage{
+			{
+				Package: "snowflake-snowpark-python",
+			},
+		}
+		opts.ArtifactRepository = String("snowflake.snowpark.pypi_shared_repository")
+		opts.Handler = "run"
+		opts.ProcedureDefinition = String("def run(session): pass")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE PROCEDURE %s () RETURNS VARCHAR LANGUAGE PYTHON RUNTIME_VERSION = '3.11' PACKAGES = ('snowflake-snowpark-python') ARTIFACT_REPOSITORY = snowflake.snowpark.pypi_shared_repository HANDLER = 'run' AS 'def run(session): pass'`, id.FullyQualifiedName())
+	})
 }
 
 func TestProcedures_CreateForScala(t *testing.T) {
@@ -340,6 +359,33 @@ func TestProcedures_CreateForScala(t *testing.T) {
 		opts.ProcedureDefinition = String("return x")
 		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE SECURE PROCEDURE %s (x VARCHAR DEFAULT 'test') COPY GRANTS RETURNS VARCHAR NOT NULL LANGUAGE SCALA RUNTIME_VERSION = '2.0' PACKAGES = ('com.snowflake:snowpark:1.2.0') IMPORTS = ('@udf_libs/echohandler.jar') HANDLER = 'Echo.echoVarchar' TARGET_PATH = '@~/testfunc.jar' STRICT COMMENT = 'test comment' EXECUTE AS CALLER AS 'return x'`, id.FullyQualifiedName())
 	})
+
+	t.Run("external access integrations and secrets", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Returns = ProcedureReturns{
+			ResultDataType: &ProcedureReturnsResultDataType{
+				ResultDataType: DataTypeVARCHAR,
+			},
+		}
+		opts.RuntimeVersion = "2.12"
+		opts.Packages = []ProcedurePackage{
+			{
+				Package: "com.snowflake:snowpark:1.14.0",
+			},
+		}
+		opts.Handler = "Echo.echoVarchar"
+		opts.ExternalAccessIntegrations = []AccountObjectIdentifier{
+			NewAccountObjectIdentifier("ext_integration"),
+		}
+		opts.Secrets = []Secret{
+			{
+				VariableName: "variable1",
+				Name:         "name1",
+			},
+		}
+		opts.ProcedureDefinition = String("return x")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE PROCEDURE %s () RETURNS VARCHAR LANGUAGE SCALA RUNTIME_VERSION = '2.12' PACKAGES = ('com.snowflake:snowpark:1.14.0') HANDLER = 'Echo.echoVarchar' EXTERNAL_ACCESS_INTEGRATIONS = ("ext_integration") SECRETS = ('variable1' = name1) AS 'return x'`, id.FullyQualifiedName())
+	})
 }
 
 func TestProcedures_CreateForSQL(t *testing.T) {
@@ -462,7 +508,7 @@ func TestProcedures_Alter(t *testing.T) {
 		opts := defaultOpts()
 		opts.SetLogLevel = String("DEBUG")
 		opts.UnsetComment = Bool(true)
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterProcedureOptions", "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "UnsetComment", "SetTags", "UnsetTags", "ExecuteAs"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterProcedureOptions", "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "SetExternalAccessIntegrations", "SetSecrets", "UnsetLogLevel", "UnsetTraceLevel", "UnsetComment", "SetTags", "UnsetTags", "ExecuteAs"))
 	})
 
 	t.Run("alter: rename to", func(t *testing.T) {
@@ -504,6 +550,30 @@ func TestProcedures_Alter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `ALTER PROCEDURE IF EXISTS %s (VARCHAR, NUMBER) SET COMMENT = 'comment'`, id.FullyQualifiedName())
 	})
 
+	t.Run("alter: set external access integrations", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetExternalAccessIntegrations = []AccountObjectIdentifier{NewAccountObjectIdentifier("ext_integration")}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER PROCEDURE IF EXISTS %s (VARCHAR, NUMBER) SET EXTERNAL_ACCESS_INTEGRATIONS = ("ext_integration")`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: set secrets", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetSecrets = []Secret{{VariableName: "variable1", Name: "name1"}}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER PROCEDURE IF EXISTS %s (VARCHAR, NUMBER) SET SECRETS = ('variable1' = name1)`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: unset log level", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetLogLevel = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER PROCEDURE IF EXISTS %s (VARCHAR, NUMBER) UNSET LOG_LEVEL`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: unset trace level", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetTraceLevel = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER PROCEDURE IF EXISTS %s (VARCHAR, NUMBER) UNSET TRACE_LEVEL`, id.FullyQualifiedName())
+	})
+
 	t.Run("alter: unset comment", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.UnsetComment = Bool(true)
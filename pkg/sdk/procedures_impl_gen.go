@@ -200,6 +200,7 @@ func (r *CreateForPythonProcedureRequest) toOpts() *CreateForPythonProcedureOpti
 
 		RuntimeVersion: r.RuntimeVersion,
 
+		ArtifactRepository:         r.ArtifactRepository,
 		Handler:                    r.Handler,
 		ExternalAccessIntegrations: r.ExternalAccessIntegrations,
 		Secrets:                    r.Secrets,
@@ -260,12 +261,14 @@ func (r *CreateForScalaProcedureRequest) toOpts() *CreateForScalaProcedureOption
 
 		RuntimeVersion: r.RuntimeVersion,
 
-		Handler:             r.Handler,
-		TargetPath:          r.TargetPath,
-		NullInputBehavior:   r.NullInputBehavior,
-		Comment:             r.Comment,
-		ExecuteAs:           r.ExecuteAs,
-		ProcedureDefinition: r.ProcedureDefinition,
+		Handler:                    r.Handler,
+		ExternalAccessIntegrations: r.ExternalAccessIntegrations,
+		Secrets:                    r.Secrets,
+		TargetPath:                 r.TargetPath,
+		NullInputBehavior:          r.NullInputBehavior,
+		Comment:                    r.Comment,
+		ExecuteAs:                  r.ExecuteAs,
+		ProcedureDefinition:        r.ProcedureDefinition,
 	}
 	if r.Arguments != nil {
 		s := make([]ProcedureArgument, len(r.Arguments))
@@ -352,17 +355,21 @@ func (r *CreateForSQLProcedureRequest) toOpts() *CreateForSQLProcedureOptions {
 
 func (r *AlterProcedureRequest) toOpts() *AlterProcedureOptions {
 	opts := &AlterProcedureOptions{
-		IfExists:          r.IfExists,
-		name:              r.name,
-		ArgumentDataTypes: r.ArgumentDataTypes,
-		RenameTo:          r.RenameTo,
-		SetComment:        r.SetComment,
-		SetLogLevel:       r.SetLogLevel,
-		SetTraceLevel:     r.SetTraceLevel,
-		UnsetComment:      r.UnsetComment,
-		SetTags:           r.SetTags,
-		UnsetTags:         r.UnsetTags,
-		ExecuteAs:         r.ExecuteAs,
+		IfExists:                      r.IfExists,
+		name:                          r.name,
+		ArgumentDataTypes:             r.ArgumentDataTypes,
+		RenameTo:                      r.RenameTo,
+		SetComment:                    r.SetComment,
+		SetLogLevel:                   r.SetLogLevel,
+		SetTraceLevel:                 r.SetTraceLevel,
+		SetExternalAccessIntegrations: r.SetExternalAccessIntegrations,
+		SetSecrets:                    r.SetSecrets,
+		UnsetLogLevel:                 r.UnsetLogLevel,
+		UnsetTraceLevel:               r.UnsetTraceLevel,
+		UnsetComment:                  r.UnsetComment,
+		SetTags:                       r.SetTags,
+		UnsetTags:                     r.UnsetTags,
+		ExecuteAs:                     r.ExecuteAs,
 	}
 	return opts
 }
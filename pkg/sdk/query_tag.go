@@ -0,0 +1,100 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// QueryTag is the JSON document set as QUERY_TAG of every statement executed with a connection with the query tag
+// annotation enabled (see EnableQueryTag). It is sent together with the statement, so it does not change the session
+// and does not cost additional round trips.
+type QueryTag struct {
+	Provider        string `json:"provider,omitempty"`
+	ProviderVersion string `json:"provider_version,omitempty"`
+	RunId           string `json:"run_id,omitempty"`
+	ResourceType    string `json:"resource_type,omitempty"`
+	ResourceId      string `json:"resource_id,omitempty"`
+	Operation       string `json:"operation,omitempty"`
+}
+
+// merge returns a copy of the tag with the non-empty fields of other applied.
+func (tag QueryTag) merge(other QueryTag) QueryTag {
+	if other.Provider != "" {
+		tag.Provider = other.Provider
+	}
+	if other.ProviderVersion != "" {
+		tag.ProviderVersion = other.ProviderVersion
+	}
+	if other.RunId != "" {
+		tag.RunId = other.RunId
+	}
+	if other.ResourceType != "" {
+		tag.ResourceType = other.ResourceType
+	}
+	if other.ResourceId != "" {
+		tag.ResourceId = other.ResourceId
+	}
+	if other.Operation != "" {
+		tag.Operation = other.Operation
+	}
+	return tag
+}
+
+type queryTagContext string
+
+const queryTagContextKey queryTagContext = "snowflake_query_tag"
+
+// ContextWithQueryTag returns a context with the tag fields applied to the query tag of the statements executed
+// with it. It has no effect when the query tag annotation is not enabled for the connection.
+func ContextWithQueryTag(ctx context.Context, tag QueryTag) context.Context {
+	if current, ok := QueryTagFromContext(ctx); ok {
+		tag = current.merge(tag)
+	}
+	return context.WithValue(ctx, queryTagContextKey, tag)
+}
+
+// QueryTagFromContext returns the tag set with ContextWithQueryTag.
+func QueryTagFromContext(ctx context.Context) (QueryTag, bool) {
+	tag, ok := ctx.Value(queryTagContextKey).(QueryTag)
+	return tag, ok
+}
+
+// EnableQueryTag enables the query tag annotation for all statements executed with db, which has to be opened by
+// NewClientWithOptions. The tag is the base for the tags set with ContextWithQueryTag.
+func EnableQueryTag(db *sql.DB, tag QueryTag) {
+	state := connectionStateForDB(db)
+	if state == nil {
+		log.Printf("[DEBUG] the connection does not support the query tag annotation, it has to be created with NewClientWithOptions")
+		return
+	}
+	state.queryTag.Store(&tag)
+}
+
+// withQueryTag sets the QUERY_TAG of the statement executed with the returned context.
+func (s *connectionState) withQueryTag(ctx context.Context) context.Context {
+	if value, ok := s.queryTagValue(ctx); ok {
+		return gosnowflake.WithQueryTag(ctx, value)
+	}
+	return ctx
+}
+
+func (s *connectionState) queryTagValue(ctx context.Context) (string, bool) {
+	base := s.queryTag.Load()
+	if base == nil {
+		return "", false
+	}
+	tag := *base
+	if contextTag, ok := QueryTagFromContext(ctx); ok {
+		tag = tag.merge(contextTag)
+	}
+	value, err := json.Marshal(tag)
+	if err != nil {
+		log.Printf("[DEBUG] unable to marshal query tag: %v", err)
+		return "", false
+	}
+	return string(value), true
+}
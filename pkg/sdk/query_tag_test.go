@@ -0,0 +1,74 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextWithQueryTag(t *testing.T) {
+	ctx := ContextWithQueryTag(context.Background(), QueryTag{ResourceType: "snowflake_database", Operation: "CREATE"})
+	ctx = ContextWithQueryTag(ctx, QueryTag{ResourceId: "DB"})
+
+	tag, ok := QueryTagFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, QueryTag{ResourceType: "snowflake_database", Operation: "CREATE", ResourceId: "DB"}, tag)
+}
+
+func TestConnectionState_queryTagValue(t *testing.T) {
+	ctx := ContextWithQueryTag(context.Background(), QueryTag{ResourceType: "snowflake_database", ResourceId: "DB", Operation: "READ"})
+
+	t.Run("disabled", func(t *testing.T) {
+		state := &connectionState{}
+
+		_, ok := state.queryTagValue(ctx)
+		assert.False(t, ok)
+	})
+
+	t.Run("base tag only", func(t *testing.T) {
+		state := &connectionState{}
+		state.queryTag.Store(&QueryTag{Provider: "terraform-provider-snowflake", ProviderVersion: "0.87.0", RunId: "run-1"})
+
+		value, ok := state.queryTagValue(context.Background())
+		require.True(t, ok)
+		assert.JSONEq(t, `{"provider":"terraform-provider-snowflake","provider_version":"0.87.0","run_id":"run-1"}`, value)
+	})
+
+	t.Run("base tag merged with context tag", func(t *testing.T) {
+		state := &connectionState{}
+		state.queryTag.Store(&QueryTag{Provider: "terraform-provider-snowflake", ProviderVersion: "0.87.0", RunId: "run-1"})
+
+		value, ok := state.queryTagValue(ctx)
+		require.True(t, ok)
+		assert.JSONEq(t, `{"provider":"terraform-provider-snowflake","provider_version":"0.87.0","run_id":"run-1","resource_type":"snowflake_database","resource_id":"DB","operation":"READ"}`, value)
+	})
+}
+
+func TestEnableQueryTag(t *testing.T) {
+	_, mock, err := sqlmock.NewWithDSN("query_tag_dsn", sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	db, err := connect("sqlmock", "query_tag_dsn", nil, &connectionState{})
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	EnableQueryTag(db.DB, QueryTag{RunId: "run-1"})
+	assert.Equal(t, &QueryTag{RunId: "run-1"}, connectionStateForDB(db.DB).queryTag.Load())
+
+	// the statements executed without the client go through the same connection
+	mock.ExpectExec("SELECT 1").WillReturnResult(sqlmock.NewResult(0, 0))
+	_, err = db.ExecContext(context.Background(), "SELECT 1")
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	t.Run("connection opened elsewhere", func(t *testing.T) {
+		other, _, err := sqlmock.New()
+		require.NoError(t, err)
+		t.Cleanup(func() { other.Close() })
+
+		EnableQueryTag(other, QueryTag{RunId: "run-1"})
+		assert.Nil(t, connectionStateForDB(other))
+	})
+}
@@ -0,0 +1,113 @@
+package sdk
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// RedactedValue replaces the secret values in the logged statements and the error messages.
+const RedactedValue = "***"
+
+// minSecretLength prevents masking of common words when a secret rendered without quotes is too short to be
+// distinguishable.
+const minSecretLength = 4
+
+// maxSecretPatterns limits the registry, which lives as long as the provider process; the oldest patterns are forgotten
+// first, as their statements were logged already.
+const maxSecretPatterns = 1000
+
+// secrets holds the values of the option fields marked with the "secret" ddl modifier (e.g.
+// `ddl:"parameter,single_quotes,secret"`) that were rendered into statements by sql_builder.go. The statements are
+// logged in more than one place (traceLogs, instrumentedsql, the dry-run preview, the error messages), so the values are
+// masked with RedactSecrets at the output instead of rendering a second, redacted statement.
+var secrets = &secretRegistry{replacements: make(map[string]string)}
+
+type secretRegistry struct {
+	mu           sync.RWMutex
+	replacements map[string]string
+	// patterns are the keys of replacements, longer first, so that a secret containing another one is masked as a whole
+	patterns []string
+	// registered are the keys of replacements in the order of registration, oldest first
+	registered []string
+}
+
+func (r *secretRegistry) register(pattern string, replacement string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.replacements[pattern]; ok {
+		return
+	}
+	if len(r.registered) >= maxSecretPatterns {
+		oldest := r.registered[0]
+		r.registered = r.registered[1:]
+		delete(r.replacements, oldest)
+		r.patterns = slices.DeleteFunc(r.patterns, func(p string) bool { return p == oldest })
+	}
+	r.replacements[pattern] = replacement
+	r.registered = append(r.registered, pattern)
+	r.patterns = append(r.patterns, pattern)
+	slices.SortStableFunc(r.patterns, func(a, b string) int { return len(b) - len(a) })
+}
+
+// registerLiteral masks the value only as a whole literal rendered with the given quotes (both in the escaped and in
+// the raw form, as the error messages can contain either), so that a secret equal to a common word does not mask the
+// word everywhere else.
+func (r *secretRegistry) registerLiteral(value string, qm quoteModifier) {
+	if value == "" {
+		return
+	}
+	if qm != SingleQuotes && qm != DoubleQuotes {
+		if len(value) >= minSecretLength {
+			r.register(value, RedactedValue)
+		}
+		return
+	}
+	replacement := qm.String() + RedactedValue + qm.String()
+	r.register(qm.Modify(value), replacement)
+	r.register(qm.String()+value+qm.String(), replacement)
+}
+
+func (r *secretRegistry) redact(s string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, pattern := range r.patterns {
+		s = strings.ReplaceAll(s, pattern, r.replacements[pattern])
+	}
+	return s
+}
+
+// RedactSecrets masks all the secret values known to the provider in s.
+func RedactSecrets(s string) string {
+	return secrets.redact(s)
+}
+
+func isSecretField(tag reflect.StructTag) bool {
+	return slices.Contains(strings.Split(tag.Get("ddl"), ","), "secret")
+}
+
+// redactedError masks the secret values in the message of the wrapped error.
+type redactedError struct {
+	err error
+}
+
+func (e *redactedError) Error() string {
+	return RedactSecrets(e.err.Error())
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+// redactError wraps err only when its message contains a secret, so that the errors without the secrets are returned
+// as they are.
+func redactError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if RedactSecrets(err.Error()) == err.Error() {
+		return err
+	}
+	return &redactedError{err: err}
+}
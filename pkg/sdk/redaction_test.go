@@ -0,0 +1,118 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactSecrets(t *testing.T) {
+	t.Run("secret option field", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: NewAccountObjectIdentifier("redaction_user"),
+			ObjectProperties: &UserObjectProperties{
+				Password: String(`pass'word_from_builder`),
+				Comment:  String("not a secret"),
+			},
+		}
+		sql, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE USER "redaction_user" PASSWORD = 'pass\'word_from_builder' COMMENT = 'not a secret'`, sql)
+
+		assert.Equal(t, `CREATE USER "redaction_user" PASSWORD = '***' COMMENT = 'not a secret'`, RedactSecrets(sql))
+		assert.Equal(t, "invalid password: '***'", RedactSecrets(`invalid password: 'pass'word_from_builder'`))
+		assert.Equal(t, "pass'word_from_builder", RedactSecrets(`pass'word_from_builder`))
+	})
+
+	t.Run("secret in nested struct", func(t *testing.T) {
+		opts := &AlterExternalS3StageStageOptions{
+			name: NewSchemaObjectIdentifier("db", "schema", "stage"),
+			ExternalStageParams: &ExternalS3StageParams{
+				Url: "s3://bucket",
+				Credentials: &ExternalStageS3Credentials{
+					AWSKeyId:     String("aws_key_id_from_builder"),
+					AWSSecretKey: String("aws_secret_key_from_builder"),
+				},
+			},
+		}
+		sql, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER STAGE "db"."schema"."stage" SET URL = 's3://bucket' CREDENTIALS = (AWS_KEY_ID = '***' AWS_SECRET_KEY = '***')`, RedactSecrets(sql))
+	})
+
+	t.Run("stage encryption keys and tokens", func(t *testing.T) {
+		s3, err := structToSQL(&CreateOnS3StageOptions{
+			name: NewSchemaObjectIdentifier("db", "schema", "stage"),
+			ExternalStageParams: &ExternalS3StageParams{
+				Url:        "s3://bucket",
+				Encryption: &ExternalStageS3Encryption{Type: Pointer(ExternalStageS3EncryptionCSE), MasterKey: String("s3_master_key_from_builder")},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE STAGE "db"."schema"."stage" URL = 's3://bucket' ENCRYPTION = (TYPE = 'AWS_CSE' MASTER_KEY = '***')`, RedactSecrets(s3))
+
+		azure, err := structToSQL(&CreateOnAzureStageOptions{
+			name: NewSchemaObjectIdentifier("db", "schema", "stage"),
+			ExternalStageParams: &ExternalAzureStageParams{
+				Url:         "azure://account.blob.core.windows.net/container",
+				Credentials: &ExternalStageAzureCredentials{AzureSasToken: "azure_sas_token_from_builder"},
+				Encryption:  &ExternalStageAzureEncryption{Type: Pointer(ExternalStageAzureEncryptionCSE), MasterKey: String("azure_master_key_from_builder")},
+			},
+		})
+		require.NoError(t, err)
+		assert.NotContains(t, RedactSecrets(azure), "azure_sas_token_from_builder")
+		assert.NotContains(t, RedactSecrets(azure), "azure_master_key_from_builder")
+	})
+
+	t.Run("secret without quotes", func(t *testing.T) {
+		secrets.registerLiteral("unquoted_secret", NoQuotes)
+		assert.Equal(t, "KEY = ***", RedactSecrets("KEY = unquoted_secret"))
+	})
+
+	t.Run("too short values are not masked", func(t *testing.T) {
+		secrets.registerLiteral("abc", NoQuotes)
+		assert.Equal(t, "abc", RedactSecrets("abc"))
+	})
+}
+
+func TestSecretRegistryLimit(t *testing.T) {
+	registry := &secretRegistry{replacements: make(map[string]string)}
+	for i := 0; i < maxSecretPatterns; i++ {
+		registry.register(fmt.Sprintf("secret_%d", i), RedactedValue)
+	}
+	assert.Equal(t, "***", registry.redact("secret_0"))
+
+	registry.register("one_more_secret", RedactedValue)
+	assert.Len(t, registry.patterns, maxSecretPatterns)
+	assert.Len(t, registry.replacements, maxSecretPatterns)
+	assert.Equal(t, "secret_0", registry.redact("secret_0"))
+	assert.Equal(t, "***", registry.redact("secret_1"))
+	assert.Equal(t, "***", registry.redact("one_more_secret"))
+}
+
+func TestRedactError(t *testing.T) {
+	secrets.registerLiteral("secret_in_error", NoQuotes)
+
+	t.Run("driver error", func(t *testing.T) {
+		driverErr := &gosnowflake.SnowflakeError{Number: 1003, Message: "syntax error near 'secret_in_error'"}
+		err := decodeDriverError(driverErr)
+
+		assert.NotContains(t, err.Error(), "secret_in_error")
+		var snowflakeErr *gosnowflake.SnowflakeError
+		assert.True(t, errors.As(err, &snowflakeErr))
+	})
+
+	t.Run("error without secrets is not wrapped", func(t *testing.T) {
+		driverErr := errors.New("other error")
+		assert.Same(t, driverErr, decodeDriverError(driverErr))
+	})
+
+	t.Run("sdk error", func(t *testing.T) {
+		err := NewError("statement failed: ALTER USER SET PASSWORD = 'secret_in_error'")
+		assert.NotContains(t, err.Error(), "secret_in_error")
+	})
+}
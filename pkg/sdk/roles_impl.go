@@ -37,7 +37,14 @@ func (v *roles) Show(ctx context.Context, req *ShowRoleRequest) ([]Role, error)
 }
 
 func (v *roles) ShowByID(ctx context.Context, req *ShowRoleByIdRequest) (*Role, error) {
-	roleList, err := v.client.Roles.Show(ctx, NewShowRoleRequest().WithLike(NewLikeRequest(req.id.Name())))
+	request := NewShowRoleRequest()
+	// with the SHOW result cache, all roles are fetched once
+	if v.client.showCache == nil {
+		request.WithLike(NewLikeRequest(req.id.Name()))
+	}
+	roleList, err := cachedShow(ctx, v.client, request.toOpts(), accountScope(ObjectTypeRole), func(ctx context.Context) ([]Role, error) {
+		return v.client.Roles.Show(ctx, request)
+	})
 	if err != nil {
 		return nil, err
 	}
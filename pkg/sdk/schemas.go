@@ -388,14 +388,21 @@ func (v *schemas) Show(ctx context.Context, opts *ShowSchemaOptions) ([]Schema,
 }
 
 func (v *schemas) ShowByID(ctx context.Context, id DatabaseObjectIdentifier) (*Schema, error) {
-	schemas, err := v.client.Schemas.Show(ctx, &ShowSchemaOptions{
+	database := NewAccountObjectIdentifier(id.DatabaseName())
+	opts := &ShowSchemaOptions{
 		In: &SchemaIn{
 			Database: Bool(true),
-			Name:     NewAccountObjectIdentifier(id.DatabaseName()),
+			Name:     database,
 		},
-		Like: &Like{
+	}
+	// with the SHOW result cache, all schemas in the database are fetched once
+	if v.client.showCache == nil {
+		opts.Like = &Like{
 			Pattern: String(id.Name()),
-		},
+		}
+	}
+	schemas, err := cachedShow(ctx, v.client, opts, containerScope(database), func(ctx context.Context) ([]Schema, error) {
+		return v.client.Schemas.Show(ctx, opts)
 	})
 	if err != nil {
 		return nil, err
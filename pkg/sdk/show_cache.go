@@ -0,0 +1,265 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// showCaches holds the SHOW result caches of the connections with the cache enabled. The clients are usually created
+// per operation with NewClientFromDB, so the cache is kept next to the connection and lives as long as the provider
+// process, i.e. a single plan or apply.
+var showCaches sync.Map
+
+// EnableShowCache enables the SHOW result cache for all clients created from db, which has to be opened by
+// NewClientWithOptions. With the cache enabled, the ShowByID lookups of the supported objects fetch the SHOW results of
+// the whole container (e.g. all schemas in a database) once and answer the following lookups from them. The cached
+// results of a container are dropped when a statement mentioning it completes on the connection, whichever way it is
+// executed (see stateConn).
+func EnableShowCache(db *sql.DB) {
+	state := connectionStateForDB(db)
+	if state == nil {
+		log.Printf("[DEBUG] the connection does not support the SHOW result cache, it has to be created with NewClientWithOptions")
+		return
+	}
+	if _, ok := showCaches.Load(db); !ok {
+		cache := newShowCache(nil)
+		showCaches.Store(db, cache)
+		state.showCaches.Store(cache.group)
+	}
+}
+
+// enableLinkedShowCache enables the SHOW result cache for db, invalidated together with the cache of the linked
+// connection. It is used for the connections of the same account with another role, which see different SHOW results,
+// but are changed by the same statements. The connections share the connection state, so the statements executed on
+// either of them invalidate both caches.
+func enableLinkedShowCache(db *sql.DB, linked *showCache) {
+	if _, ok := showCaches.Load(db); !ok {
+		showCaches.Store(db, newShowCache(linked.group))
+	}
+}
+
+func showCacheForDB(db *sql.DB) *showCache {
+	if cache, ok := showCaches.Load(db); ok {
+		return cache.(*showCache)
+	}
+	return nil
+}
+
+type showCache struct {
+	mu      sync.Mutex
+	entries map[string]*showCacheEntry
+	hits    atomic.Int64
+	misses  atomic.Int64
+	// group holds the caches invalidated together with this one (including it)
+	group *showCacheGroup
+}
+
+type showCacheGroup struct {
+	mu     sync.Mutex
+	caches []*showCache
+}
+
+func newShowCache(group *showCacheGroup) *showCache {
+	if group == nil {
+		group = &showCacheGroup{}
+	}
+	cache := &showCache{entries: make(map[string]*showCacheEntry), group: group}
+	group.mu.Lock()
+	defer group.mu.Unlock()
+	group.caches = append(group.caches, cache)
+	return cache
+}
+
+// showCacheScope decides which executed statements make the cached SHOW results stale.
+type showCacheScope func(statement string) bool
+
+// containerScope is the scope of the objects in the container; it matches the statements mentioning the container or
+// one of its parents (e.g. dropping, renaming or replacing the database of the schema), quoted or not.
+func containerScope(container ObjectIdentifier) showCacheScope {
+	var mentions []*regexp.Regexp
+	for _, name := range identifierParts(container) {
+		mentions = append(mentions, nameMentionRegexp(name))
+	}
+	return func(statement string) bool {
+		return slices.ContainsFunc(mentions, func(mention *regexp.Regexp) bool { return mention.MatchString(statement) })
+	}
+}
+
+// accountScope is the scope of the account-level objects; it matches the statements mentioning the object type.
+func accountScope(objectType ObjectType) showCacheScope {
+	return func(statement string) bool {
+		return strings.Contains(strings.ToUpper(statement), string(objectType))
+	}
+}
+
+// anyStatementScope matches all statements. It is used for the results changed as a side effect of the statements on
+// other objects (e.g. the grants changed by the ownership transfer or by dropping the object).
+func anyStatementScope(string) bool {
+	return true
+}
+
+// grantsScope is the scope of the grants shown with opts. The grant statements change only the grants of the objects
+// and the grantees they mention, so they make the results stale only when they mention one of the names of opts (or
+// transfer the ownership, which changes the grants of the previous owner too). All other statements can change the
+// grants as a side effect (e.g. by dropping or renaming an object, or by creating one owned by the current role), so
+// they always make the results stale.
+func grantsScope(opts *ShowGrantOptions) showCacheScope {
+	var names []string
+	if opts.On != nil && opts.On.Object != nil {
+		names = append(names, identifierParts(opts.On.Object.Name)...)
+	}
+	if opts.To != nil {
+		names = append(names, identifierParts(opts.To.Role)...)
+		names = append(names, identifierParts(opts.To.User)...)
+		names = append(names, identifierParts(opts.To.Share)...)
+		names = append(names, identifierParts(opts.To.DatabaseRole)...)
+	}
+	if opts.Of != nil {
+		names = append(names, identifierParts(opts.Of.Role)...)
+		names = append(names, identifierParts(opts.Of.DatabaseRole)...)
+		names = append(names, identifierParts(opts.Of.Share)...)
+	}
+	if opts.In != nil {
+		if opts.In.Schema != nil {
+			names = append(names, identifierParts(*opts.In.Schema)...)
+		}
+		if opts.In.Database != nil {
+			names = append(names, identifierParts(*opts.In.Database)...)
+		}
+	}
+	var mentions []*regexp.Regexp
+	for _, name := range names {
+		if name != "" {
+			mentions = append(mentions, nameMentionRegexp(name))
+		}
+	}
+	if len(mentions) == 0 {
+		return anyStatementScope
+	}
+	return func(statement string) bool {
+		keywords := strings.ToUpper(strings.TrimSpace(statement))
+		if !strings.HasPrefix(keywords, "GRANT ") && !strings.HasPrefix(keywords, "REVOKE ") {
+			return true
+		}
+		if strings.Contains(keywords, "OWNERSHIP") {
+			return true
+		}
+		return slices.ContainsFunc(mentions, func(mention *regexp.Regexp) bool { return mention.MatchString(statement) })
+	}
+}
+
+// nameMentionRegexp matches the name quoted or unquoted, because the legacy statements do not always quote the
+// identifiers. The unquoted name is matched regardless of the case.
+func nameMentionRegexp(name string) *regexp.Regexp {
+	quoted := regexp.QuoteMeta(`"` + strings.ReplaceAll(name, `"`, `""`) + `"`)
+	unquoted := `(?i:(^|[^\w$"])` + regexp.QuoteMeta(name) + `($|[^\w$"]))`
+	return regexp.MustCompile(quoted + "|" + unquoted)
+}
+
+// identifierParts returns the names of the object and of its containers.
+func identifierParts(id ObjectIdentifier) []string {
+	switch id := id.(type) {
+	case AccountObjectIdentifier:
+		return []string{id.Name()}
+	case DatabaseObjectIdentifier:
+		return []string{id.DatabaseName(), id.Name()}
+	case SchemaObjectIdentifier:
+		return []string{id.DatabaseName(), id.SchemaName(), id.Name()}
+	case TableColumnIdentifier:
+		return []string{id.DatabaseName(), id.SchemaName(), id.TableName(), id.Name()}
+	case nil:
+		return nil
+	default:
+		return []string{id.Name()}
+	}
+}
+
+type showCacheEntry struct {
+	scope showCacheScope
+	ready chan struct{}
+	rows  any
+	err   error
+	// stale is set when the entry is invalidated before its SHOW completes; the SHOW could have been executed before
+	// the invalidating statement completed, so its results are not shared with the lookups waiting for it
+	stale atomic.Bool
+}
+
+// invalidate drops the cached SHOW results affected by the statement from the cache and from the caches linked with
+// it. It has to be called after the statement completes, so that the SHOW results fetched concurrently with the
+// statement are not cached.
+func (g *showCacheGroup) invalidate(statement string) {
+	g.mu.Lock()
+	caches := slices.Clone(g.caches)
+	g.mu.Unlock()
+	for _, cache := range caches {
+		cache.invalidateEntries(statement)
+	}
+}
+
+func (c *showCache) invalidateEntries(statement string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, entry := range c.entries {
+		if entry.scope(statement) {
+			log.Printf("[DEBUG] show cache invalidated: %s", key)
+			entry.stale.Store(true)
+			delete(c.entries, key)
+		}
+	}
+}
+
+// cachedShow returns the rows of the SHOW statement built from opts from the cache of the client, calling show only on
+// the first lookup of the statement. The concurrent lookups of the same statement wait for the first one, unless the
+// results are invalidated in the meantime. The errors are not cached. Without the cache enabled, show is called every time.
+func cachedShow[T any](ctx context.Context, client *Client, opts any, scope showCacheScope, show func(context.Context) ([]T, error)) ([]T, error) {
+	cache := client.showCache
+	if cache == nil {
+		return show(ctx)
+	}
+	statement, err := structToSQL(opts)
+	if err != nil {
+		return show(ctx)
+	}
+
+	cache.mu.Lock()
+	entry, ok := cache.entries[statement]
+	if !ok {
+		entry = &showCacheEntry{scope: scope, ready: make(chan struct{})}
+		cache.entries[statement] = entry
+	}
+	cache.mu.Unlock()
+
+	if ok {
+		select {
+		case <-entry.ready:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if entry.err == nil && !entry.stale.Load() {
+			log.Printf("[DEBUG] show cache hit: %s (hits: %d, misses: %d)", statement, cache.hits.Add(1), cache.misses.Load())
+			// the callers can modify the returned rows
+			return slices.Clone(entry.rows.([]T)), nil
+		}
+		// the first lookup failed or its results are stale; this one tries on its own
+		return show(ctx)
+	}
+
+	log.Printf("[DEBUG] show cache miss: %s (hits: %d, misses: %d)", statement, cache.hits.Load(), cache.misses.Add(1))
+	rows, err := show(ctx)
+	entry.rows, entry.err = slices.Clone(rows), err
+	close(entry.ready)
+	if err != nil {
+		cache.mu.Lock()
+		if cache.entries[statement] == entry {
+			delete(cache.entries, statement)
+		}
+		cache.mu.Unlock()
+	}
+	return rows, err
+}
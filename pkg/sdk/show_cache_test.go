@@ -0,0 +1,217 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShowCache_ShowByID(t *testing.T) {
+	_, mock, err := sqlmock.NewWithDSN("show_cache_dsn", sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	providerDB, err := connect("sqlmock", "show_cache_dsn", nil, &connectionState{})
+	require.NoError(t, err)
+	db := providerDB.DB
+	t.Cleanup(func() { db.Close() })
+	EnableShowCache(db)
+	ctx := context.Background()
+
+	schemaRows := func(names ...string) *sqlmock.Rows {
+		rows := sqlmock.NewRows([]string{"created_on", "name", "database_name"})
+		for _, name := range names {
+			rows.AddRow(time.Now(), name, "db")
+		}
+		return rows
+	}
+	mock.ExpectQuery(`SHOW SCHEMAS IN DATABASE "db"`).WillReturnRows(schemaRows("a", "b"))
+	mock.ExpectExec(`CREATE SCHEMA "db"."c"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SHOW SCHEMAS IN DATABASE "db"`).WillReturnRows(schemaRows("a", "b", "c"))
+
+	// the clients are created per operation
+	schemaA, err := NewClientFromDB(db).Schemas.ShowByID(ctx, NewDatabaseObjectIdentifier("db", "a"))
+	require.NoError(t, err)
+	assert.Equal(t, "a", schemaA.Name)
+	schemaB, err := NewClientFromDB(db).Schemas.ShowByID(ctx, NewDatabaseObjectIdentifier("db", "b"))
+	require.NoError(t, err)
+	assert.Equal(t, "b", schemaB.Name)
+	_, err = NewClientFromDB(db).Schemas.ShowByID(ctx, NewDatabaseObjectIdentifier("db", "c"))
+	require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+
+	// the statements executed outside the SDK client invalidate the cache too
+	_, err = db.ExecContext(ctx, `CREATE SCHEMA "db"."c"`)
+	require.NoError(t, err)
+
+	schemaC, err := NewClientFromDB(db).Schemas.ShowByID(ctx, NewDatabaseObjectIdentifier("db", "c"))
+	require.NoError(t, err)
+	assert.Equal(t, "c", schemaC.Name)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestShowCache_ShowByIDWithoutCache(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	mock.ExpectQuery(`SHOW SCHEMAS LIKE 'a' IN DATABASE "db"`).WillReturnRows(sqlmock.NewRows([]string{"name", "database_name"}).AddRow("a", "db"))
+
+	schema, err := NewClientFromDB(db).Schemas.ShowByID(context.Background(), NewDatabaseObjectIdentifier("db", "a"))
+	require.NoError(t, err)
+	assert.Equal(t, "a", schema.Name)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestShowCache_cachedShow(t *testing.T) {
+	ctx := context.Background()
+	opts := &ShowSchemaOptions{In: &SchemaIn{Database: Bool(true), Name: NewAccountObjectIdentifier("db")}}
+
+	t.Run("concurrent lookups", func(t *testing.T) {
+		client := &Client{showCache: newShowCache(nil)}
+		var calls int
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				rows, err := cachedShow(ctx, client, opts, anyStatementScope, func(context.Context) ([]string, error) {
+					calls++
+					time.Sleep(10 * time.Millisecond)
+					return []string{"a"}, nil
+				})
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"a"}, rows)
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, int64(9), client.showCache.hits.Load())
+		assert.Equal(t, int64(1), client.showCache.misses.Load())
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		client := &Client{showCache: newShowCache(nil)}
+		_, err := cachedShow(ctx, client, opts, anyStatementScope, func(context.Context) ([]string, error) {
+			return nil, errors.New("failed")
+		})
+		require.Error(t, err)
+
+		rows, err := cachedShow(ctx, client, opts, anyStatementScope, func(context.Context) ([]string, error) {
+			return []string{"a"}, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a"}, rows)
+	})
+}
+
+func TestShowCache_transaction(t *testing.T) {
+	_, mock, err := sqlmock.NewWithDSN("show_cache_transaction_dsn", sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	providerDB, err := connect("sqlmock", "show_cache_transaction_dsn", nil, &connectionState{})
+	require.NoError(t, err)
+	db := providerDB.DB
+	t.Cleanup(func() { db.Close() })
+	EnableShowCache(db)
+	ctx := context.Background()
+	opts := &ShowSchemaOptions{In: &SchemaIn{Database: Bool(true), Name: NewAccountObjectIdentifier("db")}}
+	cache := showCacheForDB(db)
+	_, err = cachedShow(ctx, &Client{showCache: cache}, opts, containerScope(NewAccountObjectIdentifier("db")), func(context.Context) ([]string, error) {
+		return []string{"a"}, nil
+	})
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`CREATE SCHEMA "db"."s"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	_, err = tx.ExecContext(ctx, `CREATE SCHEMA "db"."s"`)
+	require.NoError(t, err)
+	assert.Len(t, cache.entries, 1, "the statement is not visible to the other connections before the commit")
+	require.NoError(t, tx.Commit())
+	assert.Empty(t, cache.entries)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestShowCache_invalidatedWhileInFlight(t *testing.T) {
+	ctx := context.Background()
+	opts := &ShowSchemaOptions{In: &SchemaIn{Database: Bool(true), Name: NewAccountObjectIdentifier("db")}}
+	client := &Client{showCache: newShowCache(nil)}
+	scope := containerScope(NewAccountObjectIdentifier("db"))
+
+	started, release := make(chan struct{}), make(chan struct{})
+	go func() {
+		_, _ = cachedShow(ctx, client, opts, scope, func(context.Context) ([]string, error) {
+			close(started)
+			<-release
+			return []string{"a"}, nil
+		})
+	}()
+	<-started
+
+	waiter := make(chan []string)
+	go func() {
+		rows, _ := cachedShow(ctx, client, opts, scope, func(context.Context) ([]string, error) {
+			return []string{"a", "s"}, nil
+		})
+		waiter <- rows
+	}()
+	// let the second lookup wait for the first one
+	time.Sleep(10 * time.Millisecond)
+	client.showCache.group.invalidate(`CREATE SCHEMA "db"."s"`)
+	close(release)
+
+	assert.Equal(t, []string{"a", "s"}, <-waiter, "the results fetched before the statement completed are discarded")
+}
+
+func TestShowCache_linkedInvalidation(t *testing.T) {
+	ctx := context.Background()
+	opts := &ShowSchemaOptions{In: &SchemaIn{Database: Bool(true), Name: NewAccountObjectIdentifier("db")}}
+	show := func(context.Context) ([]string, error) { return []string{"a"}, nil }
+
+	cache := newShowCache(nil)
+	linked := newShowCache(cache.group)
+	for _, c := range []*showCache{cache, linked} {
+		_, err := cachedShow(ctx, &Client{showCache: c}, opts, containerScope(NewAccountObjectIdentifier("db")), show)
+		require.NoError(t, err)
+	}
+
+	linked.group.invalidate(`CREATE SCHEMA "db"."s"`)
+	assert.Empty(t, cache.entries)
+	assert.Empty(t, linked.entries)
+}
+
+func TestShowCache_scopes(t *testing.T) {
+	assert.True(t, containerScope(NewAccountObjectIdentifier("db"))(`DROP SCHEMA "db"."s"`))
+	assert.False(t, containerScope(NewAccountObjectIdentifier("db"))(`DROP SCHEMA "db2"."s"`))
+	assert.True(t, containerScope(NewDatabaseObjectIdentifier("db", "s"))(`ALTER TABLE "db"."s"."t" RENAME TO "db"."s"."t2"`))
+	assert.True(t, containerScope(NewDatabaseObjectIdentifier("db", "s"))(`DROP DATABASE "db"`), "DDL on the parent database")
+	assert.True(t, containerScope(NewDatabaseObjectIdentifier("db", "s"))(`ALTER DATABASE "db" RENAME TO "db2"`), "DDL on the parent database")
+	assert.False(t, containerScope(NewDatabaseObjectIdentifier("db", "s"))(`DROP DATABASE "db2"`))
+	assert.True(t, containerScope(NewAccountObjectIdentifier("DB"))(`create schema db.s`), "unquoted names")
+	assert.True(t, containerScope(NewDatabaseObjectIdentifier("DB", "S"))(`drop table db.s.t`), "unquoted names")
+	assert.False(t, containerScope(NewAccountObjectIdentifier("DB"))(`create schema db2.s`))
+	assert.True(t, accountScope(ObjectTypeDatabase)(`create database "db"`))
+	assert.False(t, accountScope(ObjectTypeDatabase)(`CREATE ROLE "r"`))
+	assert.True(t, anyStatementScope(`CREATE ROLE "r"`))
+}
+
+func TestShowCache_grantsScope(t *testing.T) {
+	toRole := grantsScope(&ShowGrantOptions{To: &ShowGrantsTo{Role: NewAccountObjectIdentifier("r")}})
+	assert.True(t, toRole(`GRANT USAGE ON DATABASE "db" TO ROLE "r"`))
+	assert.True(t, toRole(`revoke usage on database db from role r`))
+	assert.False(t, toRole(`GRANT USAGE ON DATABASE "db" TO ROLE "other"`))
+	assert.False(t, toRole(`GRANT USAGE ON DATABASE "db" TO ROLE "R"`), "the quoted names are case-sensitive")
+	assert.True(t, toRole(`GRANT OWNERSHIP ON DATABASE "db" TO ROLE "other" COPY CURRENT GRANTS`), "the ownership transfer revokes the grants of the previous owner")
+	assert.True(t, toRole(`DROP DATABASE "db"`), "dropping an object drops its grants")
+
+	onTable := grantsScope(&ShowGrantOptions{On: &ShowGrantsOn{Object: &Object{ObjectType: ObjectTypeTable, Name: NewSchemaObjectIdentifier("db", "s", "t")}}})
+	assert.True(t, onTable(`GRANT SELECT ON ALL TABLES IN SCHEMA "db"."s" TO ROLE "r"`))
+	assert.False(t, onTable(`GRANT USAGE ON WAREHOUSE "w" TO ROLE "r"`))
+
+	assert.True(t, grantsScope(&ShowGrantOptions{On: &ShowGrantsOn{Account: Bool(true)}})(`GRANT USAGE ON WAREHOUSE "w" TO ROLE "r"`))
+}
@@ -218,6 +218,9 @@ func (b sqlBuilder) sql(clauses ...sqlClause) string {
 	return strings.Trim(strings.Join(sList, " "), " ")
 }
 
+// timestampFormat is recognized by the AUTO timestamp input format in Snowflake.
+const timestampFormat = "2006-01-02 15:04:05.999999999 -0700"
+
 func (b sqlBuilder) parseInterface(v interface{}, tag reflect.StructTag) (sqlClause, error) {
 	ddlTag := tag.Get("ddl")
 	sqlTag := tag.Get("sql")
@@ -228,6 +231,10 @@ func (b sqlBuilder) parseInterface(v interface{}, tag reflect.StructTag) (sqlCla
 	ddlType := ddlTagParts[0]
 	switch ddlType {
 	case "parameter":
+		// time.Time.String() output (e.g. "2021-01-01 00:00:00 +0000 UTC") is not recognized by Snowflake
+		if tm, ok := v.(time.Time); ok {
+			v = tm.Format(timestampFormat)
+		}
 		return sqlParameterClause{
 			key:   sqlTag,
 			value: v,
@@ -540,6 +547,9 @@ func (b sqlBuilder) parseField(field reflect.StructField, value reflect.Value) (
 				return nil, nil
 			}
 		}
+		if isSecretField(field.Tag) {
+			secrets.registerLiteral(fmt.Sprintf("%v", reflectedValue), b.getModifier(field.Tag, "ddl", quoteModifierType, NoQuotes).(quoteModifier))
+		}
 		clause = sqlParameterClause{
 			key:   sqlTag,
 			value: reflectedValue,
@@ -0,0 +1,198 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	_ validatable = new(PutStageFileOptions)
+	_ validatable = new(ListStageFilesOptions)
+	_ validatable = new(RemoveStageFilesOptions)
+)
+
+// StageFiles transfers files between the local file system and stages. PUT is executed by the driver, which uploads the
+// file to the storage of the stage.
+type StageFiles interface {
+	Put(ctx context.Context, source string, location StageLocation, opts *PutStageFileOptions) ([]PutStageFileResult, error)
+	List(ctx context.Context, location StageLocation, opts *ListStageFilesOptions) ([]StageFile, error)
+	Remove(ctx context.Context, location StageLocation, opts *RemoveStageFilesOptions) error
+}
+
+var _ StageFiles = (*stageFiles)(nil)
+
+type stageFiles struct {
+	client *Client
+}
+
+// StageLocation is a path within a stage, e.g. @"db"."schema"."stage"/path/file.csv. The commands enclose it in single
+// quotes, so the path can contain spaces and quotes.
+type StageLocation struct {
+	Stage SchemaObjectIdentifier
+	Path  string
+}
+
+func NewStageLocation(stage SchemaObjectIdentifier, path string) StageLocation {
+	return StageLocation{Stage: stage, Path: strings.TrimPrefix(path, "/")}
+}
+
+func (l StageLocation) String() string {
+	if l.Path == "" {
+		return "@" + l.Stage.FullyQualifiedName()
+	}
+	return "@" + l.Stage.FullyQualifiedName() + "/" + l.Path
+}
+
+// PutStageFileOptions is based on https://docs.snowflake.com/en/sql-reference/sql/put.
+type PutStageFileOptions struct {
+	put          bool   `ddl:"static" sql:"PUT"`
+	source       string `ddl:"keyword,single_quotes"`
+	location     string `ddl:"keyword,single_quotes"`
+	Parallel     *int   `ddl:"parameter" sql:"PARALLEL"`
+	AutoCompress *bool  `ddl:"parameter" sql:"AUTO_COMPRESS"`
+	Overwrite    *bool  `ddl:"parameter" sql:"OVERWRITE"`
+}
+
+func (opts *PutStageFileOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if opts.source == "" {
+		errs = append(errs, errNotSet("PutStageFileOptions", "source"))
+	}
+	if !strings.HasPrefix(opts.location, "@") {
+		errs = append(errs, errNotSet("PutStageFileOptions", "location"))
+	}
+	if opts.Parallel != nil && !validateIntInRange(*opts.Parallel, 1, 99) {
+		errs = append(errs, errIntBetween("PutStageFileOptions", "Parallel", 1, 99))
+	}
+	return JoinErrors(errs...)
+}
+
+type PutStageFileResult struct {
+	Source            string
+	Target            string
+	SourceSize        int64
+	TargetSize        int64
+	SourceCompression string
+	TargetCompression string
+	Status            string
+	Message           string
+}
+
+type putStageFileRow struct {
+	Source            string         `db:"source"`
+	Target            string         `db:"target"`
+	SourceSize        int64          `db:"source_size"`
+	TargetSize        int64          `db:"target_size"`
+	SourceCompression string         `db:"source_compression"`
+	TargetCompression string         `db:"target_compression"`
+	Status            string         `db:"status"`
+	Message           sql.NullString `db:"message"`
+}
+
+func (r putStageFileRow) convert() *PutStageFileResult {
+	return &PutStageFileResult{
+		Source:            r.Source,
+		Target:            r.Target,
+		SourceSize:        r.SourceSize,
+		TargetSize:        r.TargetSize,
+		SourceCompression: r.SourceCompression,
+		TargetCompression: r.TargetCompression,
+		Status:            r.Status,
+		Message:           r.Message.String,
+	}
+}
+
+// Put uploads the local file (or the files matching the wildcard) to the stage location, which is the directory the file
+// is uploaded to.
+func (v *stageFiles) Put(ctx context.Context, source string, location StageLocation, opts *PutStageFileOptions) ([]PutStageFileResult, error) {
+	opts = createIfNil(opts)
+	opts.source = "file://" + filepath.ToSlash(source)
+	opts.location = location.String()
+	rows, err := validateAndQuery[putStageFileRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[putStageFileRow, PutStageFileResult](rows), nil
+}
+
+// ListStageFilesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/list.
+type ListStageFilesOptions struct {
+	list     bool    `ddl:"static" sql:"LIST"`
+	location string  `ddl:"keyword,single_quotes"`
+	Pattern  *string `ddl:"parameter,single_quotes" sql:"PATTERN"`
+}
+
+func (opts *ListStageFilesOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	if !strings.HasPrefix(opts.location, "@") {
+		return errNotSet("ListStageFilesOptions", "location")
+	}
+	return nil
+}
+
+// StageFile is a file returned by LIST. Name is prefixed with the stage name (internal stages) or the stage url
+// (external stages) and MD5 is the checksum of the file as stored, i.e. after compression.
+type StageFile struct {
+	Name         string
+	Size         int64
+	MD5          string
+	LastModified string
+}
+
+type stageFileRow struct {
+	Name         string         `db:"name"`
+	Size         int64          `db:"size"`
+	MD5          sql.NullString `db:"md5"`
+	LastModified string         `db:"last_modified"`
+}
+
+func (r stageFileRow) convert() *StageFile {
+	return &StageFile{
+		Name:         r.Name,
+		Size:         r.Size,
+		MD5:          r.MD5.String,
+		LastModified: r.LastModified,
+	}
+}
+
+func (v *stageFiles) List(ctx context.Context, location StageLocation, opts *ListStageFilesOptions) ([]StageFile, error) {
+	opts = createIfNil(opts)
+	opts.location = location.String()
+	rows, err := validateAndQuery[stageFileRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[stageFileRow, StageFile](rows), nil
+}
+
+// RemoveStageFilesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/remove.
+type RemoveStageFilesOptions struct {
+	remove   bool    `ddl:"static" sql:"REMOVE"`
+	location string  `ddl:"keyword,single_quotes"`
+	Pattern  *string `ddl:"parameter,single_quotes" sql:"PATTERN"`
+}
+
+func (opts *RemoveStageFilesOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	if !strings.HasPrefix(opts.location, "@") {
+		return errNotSet("RemoveStageFilesOptions", "location")
+	}
+	return nil
+}
+
+// Remove removes the files under the stage location; the location is a prefix, so a path of a directory removes all
+// the files in it.
+func (v *stageFiles) Remove(ctx context.Context, location StageLocation, opts *RemoveStageFilesOptions) error {
+	opts = createIfNil(opts)
+	opts.location = location.String()
+	return validateAndExec(v.client, ctx, opts)
+}
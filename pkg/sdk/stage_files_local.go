@@ -0,0 +1,118 @@
+package sdk
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// localStageFiles is a stand-in for the stage files keeping every stage in a directory of the local file system. It
+// follows the semantics of PUT, LIST and REMOVE the uploads rely on: compression adds the .gz extension, the existing
+// files are skipped without OVERWRITE, LIST returns the names prefixed with the stage name and REMOVE matches a prefix.
+type localStageFiles struct {
+	root string
+}
+
+var _ StageFiles = (*localStageFiles)(nil)
+
+// NewLocalStageFiles returns the stage files kept in the given directory of the local file system, with a subdirectory
+// per stage. It is meant for testing the uploads without Snowflake.
+func NewLocalStageFiles(root string) StageFiles {
+	return &localStageFiles{root: root}
+}
+
+func (s *localStageFiles) stageDirectory(location StageLocation) string {
+	return filepath.Join(s.root, strings.ToLower(location.Stage.Name()))
+}
+
+func (s *localStageFiles) Put(_ context.Context, source string, location StageLocation, opts *PutStageFileOptions) ([]PutStageFileResult, error) {
+	content, err := os.ReadFile(source)
+	if err != nil {
+		return nil, err
+	}
+	result := PutStageFileResult{
+		Source:     filepath.Base(source),
+		Target:     filepath.Base(source),
+		SourceSize: int64(len(content)),
+		Status:     "UPLOADED",
+	}
+	if opts.AutoCompress == nil || *opts.AutoCompress {
+		var compressed bytes.Buffer
+		w := gzip.NewWriter(&compressed)
+		if _, err := w.Write(content); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		content = compressed.Bytes()
+		result.Target += ".gz"
+		result.TargetCompression = "GZIP"
+	}
+	result.TargetSize = int64(len(content))
+
+	target := filepath.Join(s.stageDirectory(location), filepath.FromSlash(location.Path), result.Target)
+	if _, err := os.Stat(target); err == nil && (opts.Overwrite == nil || !*opts.Overwrite) {
+		result.Status = "SKIPPED"
+		return []PutStageFileResult{result}, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return nil, err
+	}
+	return []PutStageFileResult{result}, os.WriteFile(target, content, 0o600)
+}
+
+func (s *localStageFiles) List(_ context.Context, location StageLocation, _ *ListStageFilesOptions) ([]StageFile, error) {
+	stageDirectory := s.stageDirectory(location)
+	files := make([]StageFile, 0)
+	err := filepath.WalkDir(stageDirectory, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		relative, err := filepath.Rel(stageDirectory, p)
+		if err != nil || !strings.HasPrefix(filepath.ToSlash(relative), location.Path) {
+			return err
+		}
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		checksum := md5.Sum(content) //nolint:gosec
+		files = append(files, StageFile{
+			Name: path.Join(strings.ToLower(location.Stage.Name()), filepath.ToSlash(relative)),
+			Size: int64(len(content)),
+			MD5:  hex.EncodeToString(checksum[:]),
+		})
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return files, nil
+	}
+	return files, err
+}
+
+func (s *localStageFiles) Remove(ctx context.Context, location StageLocation, opts *RemoveStageFilesOptions) error {
+	files, err := s.List(ctx, location, nil)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if opts != nil && opts.Pattern != nil && !regexp.MustCompile("^(?:"+*opts.Pattern+")$").MatchString(file.Name) {
+			continue
+		}
+		relative := strings.TrimPrefix(file.Name, strings.ToLower(location.Stage.Name())+"/")
+		if err := os.Remove(filepath.Join(s.stageDirectory(location), filepath.FromSlash(relative))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package sdk
+
+import (
+	"testing"
+)
+
+func TestStageFiles_Put(t *testing.T) {
+	location := NewStageLocation(NewSchemaObjectIdentifier("db", "schema", "stage"), "/path/to")
+
+	t.Run("validation: missing source", func(t *testing.T) {
+		opts := &PutStageFileOptions{location: location.String()}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("PutStageFileOptions", "source"))
+	})
+
+	t.Run("validation: parallel out of range", func(t *testing.T) {
+		opts := &PutStageFileOptions{source: "file:///tmp/file.csv", location: location.String(), Parallel: Int(100)}
+		assertOptsInvalidJoinedErrors(t, opts, errIntBetween("PutStageFileOptions", "Parallel", 1, 99))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := &PutStageFileOptions{source: "file:///tmp/file.csv", location: location.String()}
+		assertOptsValidAndSQLEquals(t, opts, `PUT 'file:///tmp/file.csv' '@\"db\".\"schema\".\"stage\"/path/to'`)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := &PutStageFileOptions{
+			source:       "file:///tmp/file.csv",
+			location:     location.String(),
+			Parallel:     Int(4),
+			AutoCompress: Bool(false),
+			Overwrite:    Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `PUT 'file:///tmp/file.csv' '@\"db\".\"schema\".\"stage\"/path/to' PARALLEL = 4 AUTO_COMPRESS = false OVERWRITE = true`)
+	})
+}
+
+func TestStageFiles_List(t *testing.T) {
+	t.Run("validation: missing location", func(t *testing.T) {
+		opts := &ListStageFilesOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("ListStageFilesOptions", "location"))
+	})
+
+	t.Run("stage root with pattern", func(t *testing.T) {
+		opts := &ListStageFilesOptions{
+			location: NewStageLocation(NewSchemaObjectIdentifier("db", "schema", "stage"), "").String(),
+			Pattern:  String(".*[.]csv"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `LIST '@\"db\".\"schema\".\"stage\"' PATTERN = '.*[.]csv'`)
+	})
+}
+
+func TestStageFiles_Remove(t *testing.T) {
+	t.Run("validation: missing location", func(t *testing.T) {
+		opts := &RemoveStageFilesOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("RemoveStageFilesOptions", "location"))
+	})
+
+	t.Run("file", func(t *testing.T) {
+		opts := &RemoveStageFilesOptions{location: NewStageLocation(NewSchemaObjectIdentifier("db", "schema", "stage"), "path/file.csv.gz").String()}
+		assertOptsValidAndSQLEquals(t, opts, `REMOVE '@\"db\".\"schema\".\"stage\"/path/file.csv.gz'`)
+	})
+
+	t.Run("file name with spaces and quotes", func(t *testing.T) {
+		opts := &RemoveStageFilesOptions{location: NewStageLocation(NewSchemaObjectIdentifier("db", "schema", "stage"), "path/John's file.csv").String()}
+		assertOptsValidAndSQLEquals(t, opts, `REMOVE '@\"db\".\"schema\".\"stage\"/path/John\'s file.csv'`)
+	})
+}
@@ -104,9 +104,9 @@ var externalS3StageParamsDef = g.NewQueryStruct("ExternalS3StageParams").
 	OptionalQueryStructField(
 		"Credentials",
 		g.NewQueryStruct("ExternalStageS3Credentials").
-			OptionalTextAssignment("AWS_KEY_ID", g.ParameterOptions().SingleQuotes()).
-			OptionalTextAssignment("AWS_SECRET_KEY", g.ParameterOptions().SingleQuotes()).
-			OptionalTextAssignment("AWS_TOKEN", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("AWS_KEY_ID", g.ParameterOptions().SingleQuotes().Secret()).
+			OptionalTextAssignment("AWS_SECRET_KEY", g.ParameterOptions().SingleQuotes().Secret()).
+			OptionalTextAssignment("AWS_TOKEN", g.ParameterOptions().SingleQuotes().Secret()).
 			OptionalTextAssignment("AWS_ROLE", g.ParameterOptions().SingleQuotes()).
 			WithValidation(g.ConflictingFields, "AwsKeyId", "AwsRole"),
 		g.ListOptions().Parentheses().NoComma().SQL("CREDENTIALS ="),
@@ -117,7 +117,7 @@ var externalS3StageParamsDef = g.NewQueryStruct("ExternalS3StageParams").
 			g.KindOfT[ExternalStageS3EncryptionOption](),
 			g.ParameterOptions().SingleQuotes().Required(),
 		).
-		OptionalTextAssignment("MASTER_KEY", g.ParameterOptions().SingleQuotes()).
+		OptionalTextAssignment("MASTER_KEY", g.ParameterOptions().SingleQuotes().Secret()).
 		OptionalTextAssignment("KMS_KEY_ID", g.ParameterOptions().SingleQuotes()),
 		g.ListOptions().Parentheses().NoComma().SQL("ENCRYPTION ="),
 	).
@@ -144,7 +144,7 @@ var externalAzureStageParamsDef = g.NewQueryStruct("ExternalAzureStageParams").
 	OptionalQueryStructField(
 		"Credentials",
 		g.NewQueryStruct("ExternalStageAzureCredentials").
-			TextAssignment("AZURE_SAS_TOKEN", g.ParameterOptions().SingleQuotes()),
+			TextAssignment("AZURE_SAS_TOKEN", g.ParameterOptions().SingleQuotes().Secret()),
 		g.ListOptions().Parentheses().NoComma().SQL("CREDENTIALS ="),
 	).
 	OptionalQueryStructField(
@@ -155,7 +155,7 @@ var externalAzureStageParamsDef = g.NewQueryStruct("ExternalAzureStageParams").
 				g.KindOfT[ExternalStageAzureEncryptionOption](),
 				g.ParameterOptions().SingleQuotes().Required(),
 			).
-			OptionalTextAssignment("MASTER_KEY", g.ParameterOptions().SingleQuotes()),
+			OptionalTextAssignment("MASTER_KEY", g.ParameterOptions().SingleQuotes().Secret()),
 		g.ListOptions().Parentheses().NoComma().SQL("ENCRYPTION ="),
 	).
 	WithValidation(g.ConflictingFields, "StorageIntegration", "Credentials")
@@ -249,8 +249,8 @@ var StagesDef = g.NewInterface(
 				OptionalQueryStructField(
 					"Credentials",
 					g.NewQueryStruct("ExternalStageS3CompatibleCredentials").
-						OptionalTextAssignment("AWS_KEY_ID", g.ParameterOptions().SingleQuotes().Required()).
-						OptionalTextAssignment("AWS_SECRET_KEY", g.ParameterOptions().SingleQuotes().Required()),
+						OptionalTextAssignment("AWS_KEY_ID", g.ParameterOptions().SingleQuotes().Required().Secret()).
+						OptionalTextAssignment("AWS_SECRET_KEY", g.ParameterOptions().SingleQuotes().Required().Secret()),
 					g.ListOptions().Parentheses().NoComma().SQL("CREDENTIALS ="),
 				).
 				// TODO: Can be used with compat ?
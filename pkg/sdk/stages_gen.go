@@ -96,15 +96,15 @@ type ExternalS3StageParams struct {
 }
 
 type ExternalStageS3Credentials struct {
-	AWSKeyId     *string `ddl:"parameter,single_quotes" sql:"AWS_KEY_ID"`
-	AWSSecretKey *string `ddl:"parameter,single_quotes" sql:"AWS_SECRET_KEY"`
-	AWSToken     *string `ddl:"parameter,single_quotes" sql:"AWS_TOKEN"`
+	AWSKeyId     *string `ddl:"parameter,single_quotes,secret" sql:"AWS_KEY_ID"`
+	AWSSecretKey *string `ddl:"parameter,single_quotes,secret" sql:"AWS_SECRET_KEY"`
+	AWSToken     *string `ddl:"parameter,single_quotes,secret" sql:"AWS_TOKEN"`
 	AWSRole      *string `ddl:"parameter,single_quotes" sql:"AWS_ROLE"`
 }
 
 type ExternalStageS3Encryption struct {
 	Type      *ExternalStageS3EncryptionOption `ddl:"parameter,single_quotes" sql:"TYPE"`
-	MasterKey *string                          `ddl:"parameter,single_quotes" sql:"MASTER_KEY"`
+	MasterKey *string                          `ddl:"parameter,single_quotes,secret" sql:"MASTER_KEY"`
 	KmsKeyId  *string                          `ddl:"parameter,single_quotes" sql:"KMS_KEY_ID"`
 }
 
@@ -172,12 +172,12 @@ type ExternalAzureStageParams struct {
 }
 
 type ExternalStageAzureCredentials struct {
-	AzureSasToken string `ddl:"parameter,single_quotes" sql:"AZURE_SAS_TOKEN"`
+	AzureSasToken string `ddl:"parameter,single_quotes,secret" sql:"AZURE_SAS_TOKEN"`
 }
 
 type ExternalStageAzureEncryption struct {
 	Type      *ExternalStageAzureEncryptionOption `ddl:"parameter,single_quotes" sql:"TYPE"`
-	MasterKey *string                             `ddl:"parameter,single_quotes" sql:"MASTER_KEY"`
+	MasterKey *string                             `ddl:"parameter,single_quotes,secret" sql:"MASTER_KEY"`
 }
 
 type ExternalAzureDirectoryTableOptions struct {
@@ -206,8 +206,8 @@ type CreateOnS3CompatibleStageOptions struct {
 }
 
 type ExternalStageS3CompatibleCredentials struct {
-	AWSKeyId     *string `ddl:"parameter,single_quotes" sql:"AWS_KEY_ID"`
-	AWSSecretKey *string `ddl:"parameter,single_quotes" sql:"AWS_SECRET_KEY"`
+	AWSKeyId     *string `ddl:"parameter,single_quotes,secret" sql:"AWS_KEY_ID"`
+	AWSSecretKey *string `ddl:"parameter,single_quotes,secret" sql:"AWS_SECRET_KEY"`
 }
 
 // AlterStageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-stage.
@@ -2,11 +2,17 @@ package sdk
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 type SystemFunctions interface {
 	GetTag(ctx context.Context, tagID ObjectIdentifier, objectID ObjectIdentifier, objectType ObjectType) (string, error)
+	GenerateSCIMAccessToken(ctx context.Context, integrationID AccountObjectIdentifier) (string, error)
+	ShowOAuthClientSecrets(ctx context.Context, integrationID AccountObjectIdentifier) (*OAuthClientSecrets, error)
+	PipeStatus(ctx context.Context, pipeID SchemaObjectIdentifier) (*PipeStatus, error)
 }
 
 var _ SystemFunctions = (*systemFunctions)(nil)
@@ -15,14 +21,102 @@ type systemFunctions struct {
 	client *Client
 }
 
+// systemFunctionArgument returns the single-quoted string literal of a system function argument. Only the backslash and
+// the single quote are escaped, so the double quotes of the identifiers are passed as they are.
+func systemFunctionArgument(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `'`, `\'`)
+	return fmt.Sprintf(`'%s'`, s)
+}
+
+// GetTag calls SYSTEM$GET_TAG. It returns ErrTagNotSet when the tag is not set on the object.
 func (c *systemFunctions) GetTag(ctx context.Context, tagID ObjectIdentifier, objectID ObjectIdentifier, objectType ObjectType) (string, error) {
 	s := &struct {
-		Tag string `db:"TAG"`
+		Tag sql.NullString `db:"TAG"`
 	}{}
-	sql := fmt.Sprintf(`SELECT SYSTEM$GET_TAG('%s', '%s', '%v') AS "TAG"`, tagID.FullyQualifiedName(), objectID.FullyQualifiedName(), objectType)
+	query := fmt.Sprintf(`SELECT SYSTEM$GET_TAG(%s, %s, '%v') AS "TAG"`, systemFunctionArgument(tagID.FullyQualifiedName()), systemFunctionArgument(objectID.FullyQualifiedName()), objectType)
+	err := c.client.queryOne(ctx, s, query)
+	if err != nil {
+		return "", err
+	}
+	if !s.Tag.Valid {
+		return "", ErrTagNotSet
+	}
+	return s.Tag.String, nil
+}
+
+// GenerateSCIMAccessToken calls SYSTEM$GENERATE_SCIM_ACCESS_TOKEN. Every call generates a new token, valid for six months.
+func (c *systemFunctions) GenerateSCIMAccessToken(ctx context.Context, integrationID AccountObjectIdentifier) (string, error) {
+	s := &struct {
+		Token string `db:"TOKEN"`
+	}{}
+	sql := fmt.Sprintf(`SELECT SYSTEM$GENERATE_SCIM_ACCESS_TOKEN(%s) AS "TOKEN"`, systemFunctionArgument(integrationID.Name()))
 	err := c.client.queryOne(ctx, s, sql)
 	if err != nil {
 		return "", err
 	}
-	return s.Tag, nil
+	return s.Token, nil
+}
+
+type OAuthClientSecrets struct {
+	ClientId      string `json:"OAUTH_CLIENT_ID"`
+	ClientSecret  string `json:"OAUTH_CLIENT_SECRET"`
+	ClientSecret2 string `json:"OAUTH_CLIENT_SECRET_2"`
+}
+
+// ShowOAuthClientSecrets calls SYSTEM$SHOW_OAUTH_CLIENT_SECRETS for the given custom OAuth security integration.
+func (c *systemFunctions) ShowOAuthClientSecrets(ctx context.Context, integrationID AccountObjectIdentifier) (*OAuthClientSecrets, error) {
+	s := &struct {
+		Secrets string `db:"SECRETS"`
+	}{}
+	sql := fmt.Sprintf(`SELECT SYSTEM$SHOW_OAUTH_CLIENT_SECRETS(%s) AS "SECRETS"`, systemFunctionArgument(integrationID.Name()))
+	err := c.client.queryOne(ctx, s, sql)
+	if err != nil {
+		return nil, err
+	}
+	return parseOAuthClientSecrets(s.Secrets)
+}
+
+func parseOAuthClientSecrets(raw string) (*OAuthClientSecrets, error) {
+	secrets := &OAuthClientSecrets{}
+	if err := json.Unmarshal([]byte(raw), secrets); err != nil {
+		return nil, fmt.Errorf("unable to parse the OAuth client secrets: %w", err)
+	}
+	return secrets, nil
+}
+
+// PipeStatus is the parsed result of SYSTEM$PIPE_STATUS.
+//
+// Based on https://docs.snowflake.com/en/sql-reference/functions/system_pipe_status#returns.
+type PipeStatus struct {
+	ExecutionState                  string `json:"executionState"`
+	PendingFileCount                int    `json:"pendingFileCount"`
+	LastIngestedTimestamp           string `json:"lastIngestedTimestamp"`
+	LastIngestedFilePath            string `json:"lastIngestedFilePath"`
+	NotificationChannelName         string `json:"notificationChannelName"`
+	NumOutstandingMessagesOnChannel int    `json:"numOutstandingMessagesOnChannel"`
+	LastReceivedMessageTimestamp    string `json:"lastReceivedMessageTimestamp"`
+	LastForwardedMessageTimestamp   string `json:"lastForwardedMessageTimestamp"`
+	Error                           string `json:"error"`
+}
+
+// PipeStatus calls SYSTEM$PIPE_STATUS for the given pipe.
+func (c *systemFunctions) PipeStatus(ctx context.Context, pipeID SchemaObjectIdentifier) (*PipeStatus, error) {
+	s := &struct {
+		Status string `db:"STATUS"`
+	}{}
+	sql := fmt.Sprintf(`SELECT SYSTEM$PIPE_STATUS(%s) AS "STATUS"`, systemFunctionArgument(pipeID.FullyQualifiedName()))
+	err := c.client.queryOne(ctx, s, sql)
+	if err != nil {
+		return nil, err
+	}
+	return parsePipeStatus(s.Status)
+}
+
+func parsePipeStatus(raw string) (*PipeStatus, error) {
+	status := &PipeStatus{}
+	if err := json.Unmarshal([]byte(raw), status); err != nil {
+		return nil, fmt.Errorf("unable to parse the pipe status: %w", err)
+	}
+	return status, nil
 }
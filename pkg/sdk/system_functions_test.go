@@ -0,0 +1,102 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOAuthClientSecrets(t *testing.T) {
+	t.Run("all secrets", func(t *testing.T) {
+		secrets, err := parseOAuthClientSecrets(`{"OAUTH_CLIENT_SECRET_2":"secret2","OAUTH_CLIENT_SECRET":"secret","OAUTH_CLIENT_ID":"id"}`)
+		require.NoError(t, err)
+		assert.Equal(t, &OAuthClientSecrets{ClientId: "id", ClientSecret: "secret", ClientSecret2: "secret2"}, secrets)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := parseOAuthClientSecrets(`not json`)
+		require.ErrorContains(t, err, "unable to parse the OAuth client secrets")
+	})
+}
+
+func TestParsePipeStatus(t *testing.T) {
+	t.Run("running pipe", func(t *testing.T) {
+		status, err := parsePipeStatus(`{"executionState":"RUNNING","pendingFileCount":2,"lastIngestedTimestamp":"2024-01-01T10:00:00.000Z","lastIngestedFilePath":"data/file.csv","notificationChannelName":"arn:aws:sqs:us-west-2:1:sf-snowpipe","numOutstandingMessagesOnChannel":1,"lastReceivedMessageTimestamp":"2024-01-01T09:59:00.000Z","lastForwardedMessageTimestamp":"2024-01-01T09:59:01.000Z"}`)
+		require.NoError(t, err)
+		assert.Equal(t, &PipeStatus{
+			ExecutionState:                  "RUNNING",
+			PendingFileCount:                2,
+			LastIngestedTimestamp:           "2024-01-01T10:00:00.000Z",
+			LastIngestedFilePath:            "data/file.csv",
+			NotificationChannelName:         "arn:aws:sqs:us-west-2:1:sf-snowpipe",
+			NumOutstandingMessagesOnChannel: 1,
+			LastReceivedMessageTimestamp:    "2024-01-01T09:59:00.000Z",
+			LastForwardedMessageTimestamp:   "2024-01-01T09:59:01.000Z",
+		}, status)
+	})
+
+	t.Run("stopped pipe with error", func(t *testing.T) {
+		status, err := parsePipeStatus(`{"executionState":"STOPPED_STAGE_DROPPED","pendingFileCount":0,"error":"Stage does not exist"}`)
+		require.NoError(t, err)
+		assert.Equal(t, "STOPPED_STAGE_DROPPED", status.ExecutionState)
+		assert.Equal(t, "Stage does not exist", status.Error)
+		assert.Empty(t, status.LastIngestedTimestamp)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := parsePipeStatus(`not json`)
+		require.ErrorContains(t, err, "unable to parse the pipe status")
+	})
+}
+
+func TestSystemFunctions_PipeStatus(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	client := NewClientFromDB(db)
+	pipeID := NewSchemaObjectIdentifier("db", "schema", "pipe")
+
+	mock.ExpectQuery(`SELECT SYSTEM$PIPE_STATUS('"db"."schema"."pipe"') AS "STATUS"`).
+		WillReturnRows(sqlmock.NewRows([]string{"STATUS"}).AddRow(`{"executionState":"PAUSED","pendingFileCount":0}`))
+	status, err := client.SystemFunctions.PipeStatus(context.Background(), pipeID)
+	require.NoError(t, err)
+	assert.Equal(t, &PipeStatus{ExecutionState: "PAUSED"}, status)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSystemFunctions_GetTag(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	client := NewClientFromDB(db)
+	tagID := NewSchemaObjectIdentifier("db", "schema", "tag")
+	columnID := NewTableColumnIdentifier("db", "schema", "table", "column")
+	query := `SELECT SYSTEM$GET_TAG('"db"."schema"."tag"', '"db"."schema"."table"."column"', 'COLUMN') AS "TAG"`
+
+	mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"TAG"}).AddRow("value"))
+	value, err := client.SystemFunctions.GetTag(context.Background(), tagID, columnID, ObjectTypeColumn)
+	require.NoError(t, err)
+	assert.Equal(t, "value", value)
+
+	mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"TAG"}).AddRow(nil))
+	_, err = client.SystemFunctions.GetTag(context.Background(), tagID, columnID, ObjectTypeColumn)
+	require.ErrorIs(t, err, ErrTagNotSet)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSystemFunctions_GenerateSCIMAccessToken(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	client := NewClientFromDB(db)
+
+	mock.ExpectQuery(`SELECT SYSTEM$GENERATE_SCIM_ACCESS_TOKEN('it\'s\\scim') AS "TOKEN"`).
+		WillReturnRows(sqlmock.NewRows([]string{"TOKEN"}).AddRow("token"))
+	token, err := client.SystemFunctions.GenerateSCIMAccessToken(context.Background(), NewAccountObjectIdentifier(`it's\scim`))
+	require.NoError(t, err)
+	assert.Equal(t, "token", token)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
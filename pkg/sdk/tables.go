@@ -12,7 +12,6 @@ var _ convertibleRow[Table] = new(tableDBRow)
 // TODO [SNOW-1007542]: add missing features:
 // - show columns (https://docs.snowflake.com/en/sql-reference/sql/show-columns)
 // - show primary keys (https://docs.snowflake.com/en/sql-reference/sql/show-primary-keys)
-// - describe search optimization (https://docs.snowflake.com/en/sql-reference/sql/desc-search-optimization)
 // - truncate table (https://docs.snowflake.com/en/sql-reference/sql/truncate-table)
 // - undrop table (https://docs.snowflake.com/en/sql-reference/sql/undrop-table)
 type Tables interface {
@@ -27,6 +26,9 @@ type Tables interface {
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Table, error)
 	DescribeColumns(ctx context.Context, req *DescribeTableColumnsRequest) ([]TableColumnDetails, error)
 	DescribeStage(ctx context.Context, req *DescribeTableStageRequest) ([]TableStageDetails, error)
+	DescribeSearchOptimization(ctx context.Context, req *DescribeTableSearchOptimizationRequest) ([]TableSearchOptimizationDetails, error)
+	// ColumnIsPopulated tells if any row of the table has a non-NULL value in the column. It needs a warehouse.
+	ColumnIsPopulated(ctx context.Context, id SchemaObjectIdentifier, column string) (bool, error)
 }
 
 // TODO: check if [...] in the docs (like in https://docs.snowflake.com/en/sql-reference/sql/create-table#create-table-using-template) mean that we can reuse all parameters from "normal" createTableOptions
@@ -732,3 +734,44 @@ func (r tableStageDetailsRow) convert() *TableStageDetails {
 		PropertyDefault: r.PropertyDefault,
 	}
 }
+
+// describeTableSearchOptimizationOptions based on https://docs.snowflake.com/en/sql-reference/sql/desc-search-optimization
+type describeTableSearchOptimizationOptions struct {
+	describeSearchOptimization bool                   `ddl:"static" sql:"DESCRIBE SEARCH OPTIMIZATION ON"`
+	name                       SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+type SearchOptimizationMethod string
+
+const (
+	SearchOptimizationMethodEquality  SearchOptimizationMethod = "EQUALITY"
+	SearchOptimizationMethodSubstring SearchOptimizationMethod = "SUBSTRING"
+	SearchOptimizationMethodGeo       SearchOptimizationMethod = "GEO"
+)
+
+// TableSearchOptimizationDetails is a single search access path of the table, e.g. EQUALITY on one column.
+type TableSearchOptimizationDetails struct {
+	ExpressionId   int
+	Method         SearchOptimizationMethod
+	Target         string
+	TargetDataType string
+	Active         bool
+}
+
+type tableSearchOptimizationDetailsRow struct {
+	ExpressionId   int    `db:"expression_id"`
+	Method         string `db:"method"`
+	Target         string `db:"target"`
+	TargetDataType string `db:"target_data_type"`
+	Active         string `db:"active"`
+}
+
+func (r tableSearchOptimizationDetailsRow) convert() *TableSearchOptimizationDetails {
+	return &TableSearchOptimizationDetails{
+		ExpressionId:   r.ExpressionId,
+		Method:         SearchOptimizationMethod(strings.ToUpper(r.Method)),
+		Target:         r.Target,
+		TargetDataType: r.TargetDataType,
+		Active:         strings.EqualFold(r.Active, "true"),
+	}
+}
@@ -182,7 +182,7 @@ type AlterTableRequest struct {
 	Unset                     *TableUnsetRequest
 	AddRowAccessPolicy        *TableAddRowAccessPolicyRequest
 	DropRowAccessPolicy       *TableDropRowAccessPolicyRequest
-	DropAndAddRowAccessPolicy *TableDropAndAddRowAccessPolicy
+	DropAndAddRowAccessPolicy *TableDropAndAddRowAccessPolicyRequest
 	DropAllAccessRowPolicies  *bool
 }
 
@@ -539,3 +539,7 @@ type DescribeTableColumnsRequest struct {
 type DescribeTableStageRequest struct {
 	id SchemaObjectIdentifier // required
 }
+
+type DescribeTableSearchOptimizationRequest struct {
+	id SchemaObjectIdentifier // required
+}
@@ -642,7 +642,7 @@ func (s *AlterTableRequest) WithDropRowAccessPolicy(dropRowAccessPolicy *TableDr
 	return s
 }
 
-func (s *AlterTableRequest) WithDropAndAddRowAccessPolicy(dropAndAddRowAccessPolicy *TableDropAndAddRowAccessPolicy) *AlterTableRequest {
+func (s *AlterTableRequest) WithDropAndAddRowAccessPolicy(dropAndAddRowAccessPolicy *TableDropAndAddRowAccessPolicyRequest) *AlterTableRequest {
 	s.DropAndAddRowAccessPolicy = dropAndAddRowAccessPolicy
 	return s
 }
@@ -1667,3 +1667,11 @@ func NewDescribeTableStageRequest(
 	s.id = id
 	return &s
 }
+
+func NewDescribeTableSearchOptimizationRequest(
+	id SchemaObjectIdentifier,
+) *DescribeTableSearchOptimizationRequest {
+	s := DescribeTableSearchOptimizationRequest{}
+	s.id = id
+	return &s
+}
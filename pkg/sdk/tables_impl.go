@@ -2,6 +2,8 @@ package sdk
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
 )
@@ -9,21 +11,22 @@ import (
 var _ Tables = (*tables)(nil)
 
 var (
-	_ optionsProvider[createTableOptions]              = new(CreateTableRequest)
-	_ optionsProvider[createTableAsSelectOptions]      = new(CreateTableAsSelectRequest)
-	_ optionsProvider[createTableUsingTemplateOptions] = new(CreateTableUsingTemplateRequest)
-	_ optionsProvider[createTableLikeOptions]          = new(CreateTableLikeRequest)
-	_ optionsProvider[createTableCloneOptions]         = new(CreateTableCloneRequest)
-	_ optionsProvider[alterTableOptions]               = new(AlterTableRequest)
-	_ optionsProvider[dropTableOptions]                = new(DropTableRequest)
-	_ optionsProvider[showTableOptions]                = new(ShowTableRequest)
-	_ optionsProvider[describeTableColumnsOptions]     = new(DescribeTableColumnsRequest)
-	_ optionsProvider[describeTableStageOptions]       = new(DescribeTableStageRequest)
-	_ optionsProvider[TableColumnAction]               = new(TableColumnActionRequest)
-	_ optionsProvider[TableConstraintAction]           = new(TableConstraintActionRequest)
-	_ optionsProvider[TableExternalTableAction]        = new(TableExternalTableActionRequest)
-	_ optionsProvider[TableSearchOptimizationAction]   = new(TableSearchOptimizationActionRequest)
-	_ optionsProvider[TableSet]                        = new(TableSetRequest)
+	_ optionsProvider[createTableOptions]                     = new(CreateTableRequest)
+	_ optionsProvider[createTableAsSelectOptions]             = new(CreateTableAsSelectRequest)
+	_ optionsProvider[createTableUsingTemplateOptions]        = new(CreateTableUsingTemplateRequest)
+	_ optionsProvider[createTableLikeOptions]                 = new(CreateTableLikeRequest)
+	_ optionsProvider[createTableCloneOptions]                = new(CreateTableCloneRequest)
+	_ optionsProvider[alterTableOptions]                      = new(AlterTableRequest)
+	_ optionsProvider[dropTableOptions]                       = new(DropTableRequest)
+	_ optionsProvider[showTableOptions]                       = new(ShowTableRequest)
+	_ optionsProvider[describeTableColumnsOptions]            = new(DescribeTableColumnsRequest)
+	_ optionsProvider[describeTableStageOptions]              = new(DescribeTableStageRequest)
+	_ optionsProvider[describeTableSearchOptimizationOptions] = new(DescribeTableSearchOptimizationRequest)
+	_ optionsProvider[TableColumnAction]                      = new(TableColumnActionRequest)
+	_ optionsProvider[TableConstraintAction]                  = new(TableConstraintActionRequest)
+	_ optionsProvider[TableExternalTableAction]               = new(TableExternalTableActionRequest)
+	_ optionsProvider[TableSearchOptimizationAction]          = new(TableSearchOptimizationActionRequest)
+	_ optionsProvider[TableSet]                               = new(TableSetRequest)
 )
 
 type tables struct {
@@ -78,8 +81,15 @@ func (v *tables) Show(ctx context.Context, request *ShowTableRequest) ([]Table,
 }
 
 func (v *tables) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Table, error) {
-	request := NewShowTableRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLikePattern(id.Name())
-	returnedTables, err := v.Show(ctx, request)
+	schema := NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())
+	request := NewShowTableRequest().WithIn(&In{Schema: schema})
+	// with the SHOW result cache, all tables in the schema are fetched once
+	if v.client.showCache == nil {
+		request.WithLikePattern(id.Name())
+	}
+	returnedTables, err := cachedShow(ctx, v.client, request.toOpts(), containerScope(schema), func(ctx context.Context) ([]Table, error) {
+		return v.Show(ctx, request)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +112,25 @@ func (v *tables) DescribeStage(ctx context.Context, req *DescribeTableStageReque
 	return convertRows[tableStageDetailsRow, TableStageDetails](rows), nil
 }
 
+func (v *tables) DescribeSearchOptimization(ctx context.Context, req *DescribeTableSearchOptimizationRequest) ([]TableSearchOptimizationDetails, error) {
+	rows, err := validateAndQuery[tableSearchOptimizationDetailsRow](v.client, ctx, req.toOpts())
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[tableSearchOptimizationDetailsRow, TableSearchOptimizationDetails](rows), nil
+}
+
+func (v *tables) ColumnIsPopulated(ctx context.Context, id SchemaObjectIdentifier, column string) (bool, error) {
+	result := &struct {
+		Populated bool `db:"POPULATED"`
+	}{}
+	query := fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s WHERE "%s" IS NOT NULL) AS "POPULATED"`, id.FullyQualifiedName(), strings.ReplaceAll(column, `"`, `""`))
+	if err := v.client.queryOne(ctx, result, query); err != nil {
+		return false, err
+	}
+	return result.Populated, nil
+}
+
 func (s *AlterTableRequest) toOpts() *alterTableOptions {
 	var clusteringAction *TableClusteringAction
 	if s.ClusteringAction != nil {
@@ -817,3 +846,9 @@ func (v *DescribeTableStageRequest) toOpts() *describeTableStageOptions {
 		name: v.id,
 	}
 }
+
+func (v *DescribeTableSearchOptimizationRequest) toOpts() *describeTableSearchOptimizationOptions {
+	return &describeTableSearchOptimizationOptions{
+		name: v.id,
+	}
+}
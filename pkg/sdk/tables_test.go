@@ -1,8 +1,10 @@
 package sdk
 
 import (
+	"context"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -1551,6 +1553,31 @@ func TestTableDescribeStage(t *testing.T) {
 	})
 }
 
+func TestTableDescribeSearchOptimization(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+	defaultOpts := func() *describeTableSearchOptimizationOptions {
+		return &describeTableSearchOptimizationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *describeTableSearchOptimizationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("describeTableSearchOptimizationOptions", "name"))
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE SEARCH OPTIMIZATION ON %s`, id.FullyQualifiedName())
+	})
+}
+
 func TestTable_GetClusterByKeys(t *testing.T) {
 	t.Run("empty", func(t *testing.T) {
 		table := Table{ClusterBy: ""}
@@ -1576,3 +1603,17 @@ func TestTable_GetClusterByKeys(t *testing.T) {
 		assert.Equal(t, []string{"abc", "def"}, table.GetClusterByKeys())
 	})
 }
+
+func TestTableColumnIsPopulated(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	id := NewSchemaObjectIdentifier("db", "schema", "table")
+
+	mock.ExpectQuery(`SELECT EXISTS (SELECT 1 FROM "db"."schema"."table" WHERE "a ""quoted"" column" IS NOT NULL) AS "POPULATED"`).
+		WillReturnRows(sqlmock.NewRows([]string{"POPULATED"}).AddRow(true))
+	populated, err := NewClientFromDB(db).Tables.ColumnIsPopulated(context.Background(), id, `a "quoted" column`)
+	require.NoError(t, err)
+	assert.True(t, populated)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
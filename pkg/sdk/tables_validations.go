@@ -13,6 +13,7 @@ var (
 	_ validatable = new(showTableOptions)
 	_ validatable = new(describeTableColumnsOptions)
 	_ validatable = new(describeTableStageOptions)
+	_ validatable = new(describeTableSearchOptimizationOptions)
 )
 
 func (opts *createTableOptions) validate() error {
@@ -315,6 +316,17 @@ func (opts *describeTableStageOptions) validate() error {
 	return errors.Join(errs...)
 }
 
+func (opts *describeTableSearchOptimizationOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, errInvalidIdentifier("describeTableSearchOptimizationOptions", "name"))
+	}
+	return errors.Join(errs...)
+}
+
 func (v *OutOfLineConstraint) validate() error {
 	var errs []error
 	switch v.Type {
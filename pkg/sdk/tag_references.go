@@ -0,0 +1,86 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// TagReferences reads the tags set on the objects with the TAG_REFERENCES table functions of the information schema.
+type TagReferences interface {
+	GetForEntity(ctx context.Context, id SchemaObjectIdentifier, domain TagReferenceDomain) ([]TagReference, error)
+	GetForTableColumns(ctx context.Context, tableID SchemaObjectIdentifier) ([]TagReference, error)
+}
+
+var _ TagReferences = (*tagReferences)(nil)
+
+type tagReferences struct {
+	client *Client
+}
+
+type TagReferenceDomain string
+
+const (
+	TagReferenceDomainTable  TagReferenceDomain = "TABLE"
+	TagReferenceDomainColumn TagReferenceDomain = "COLUMN"
+)
+
+// TagReference is a tag set on the object or inherited by it; Level is the domain of the object the tag is set on.
+type TagReference struct {
+	TagDatabase string
+	TagSchema   string
+	TagName     string
+	TagValue    string
+	Level       TagReferenceDomain
+	ColumnName  *string
+}
+
+func (v *TagReference) TagID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.TagDatabase, v.TagSchema, v.TagName)
+}
+
+type tagReferenceRow struct {
+	TagDatabase string         `db:"TAG_DATABASE"`
+	TagSchema   string         `db:"TAG_SCHEMA"`
+	TagName     string         `db:"TAG_NAME"`
+	TagValue    string         `db:"TAG_VALUE"`
+	Level       string         `db:"LEVEL"`
+	ColumnName  sql.NullString `db:"COLUMN_NAME"`
+}
+
+func (row tagReferenceRow) convert() *TagReference {
+	reference := &TagReference{
+		TagDatabase: row.TagDatabase,
+		TagSchema:   row.TagSchema,
+		TagName:     row.TagName,
+		TagValue:    row.TagValue,
+		Level:       TagReferenceDomain(row.Level),
+	}
+	if row.ColumnName.Valid {
+		reference.ColumnName = &row.ColumnName.String
+	}
+	return reference
+}
+
+// GetForEntity calls TAG_REFERENCES for the given object.
+//
+// Based on https://docs.snowflake.com/en/sql-reference/functions/tag_references.
+func (v *tagReferences) GetForEntity(ctx context.Context, id SchemaObjectIdentifier, domain TagReferenceDomain) ([]TagReference, error) {
+	return v.get(ctx, id, fmt.Sprintf(`TAG_REFERENCES(%s, '%s')`, systemFunctionArgument(id.FullyQualifiedName()), domain))
+}
+
+// GetForTableColumns calls TAG_REFERENCES_ALL_COLUMNS for the given table.
+//
+// Based on https://docs.snowflake.com/en/sql-reference/functions/tag_references_all_columns.
+func (v *tagReferences) GetForTableColumns(ctx context.Context, tableID SchemaObjectIdentifier) ([]TagReference, error) {
+	return v.get(ctx, tableID, fmt.Sprintf(`TAG_REFERENCES_ALL_COLUMNS(%s, '%s')`, systemFunctionArgument(tableID.FullyQualifiedName()), TagReferenceDomainTable))
+}
+
+func (v *tagReferences) get(ctx context.Context, id SchemaObjectIdentifier, function string) ([]TagReference, error) {
+	var rows []tagReferenceRow
+	query := fmt.Sprintf(`SELECT TAG_DATABASE, TAG_SCHEMA, TAG_NAME, TAG_VALUE, LEVEL, COLUMN_NAME FROM TABLE(%s.INFORMATION_SCHEMA.%s)`, NewAccountObjectIdentifier(id.DatabaseName()).FullyQualifiedName(), function)
+	if err := v.client.query(ctx, &rows, query); err != nil {
+		return nil, err
+	}
+	return convertRows[tagReferenceRow, TagReference](rows), nil
+}
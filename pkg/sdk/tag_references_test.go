@@ -0,0 +1,56 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagReferences(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	client := NewClientFromDB(db)
+	ctx := context.Background()
+	tableID := NewSchemaObjectIdentifier("db", "schema", "table")
+	columns := []string{"TAG_DATABASE", "TAG_SCHEMA", "TAG_NAME", "TAG_VALUE", "LEVEL", "COLUMN_NAME"}
+
+	t.Run("table", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT TAG_DATABASE, TAG_SCHEMA, TAG_NAME, TAG_VALUE, LEVEL, COLUMN_NAME FROM TABLE("db".INFORMATION_SCHEMA.TAG_REFERENCES('"db"."schema"."table"', 'TABLE'))`).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow("db", "tags", "department", "finance", "TABLE", nil).
+				AddRow("db", "tags", "owner", "team", "SCHEMA", nil))
+		references, err := client.TagReferences.GetForEntity(ctx, tableID, TagReferenceDomainTable)
+		require.NoError(t, err)
+		assert.Equal(t, []TagReference{
+			{TagDatabase: "db", TagSchema: "tags", TagName: "department", TagValue: "finance", Level: TagReferenceDomainTable},
+			{TagDatabase: "db", TagSchema: "tags", TagName: "owner", TagValue: "team", Level: "SCHEMA"},
+		}, references)
+		assert.Equal(t, NewSchemaObjectIdentifier("db", "tags", "department"), references[0].TagID())
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("table columns", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT TAG_DATABASE, TAG_SCHEMA, TAG_NAME, TAG_VALUE, LEVEL, COLUMN_NAME FROM TABLE("db".INFORMATION_SCHEMA.TAG_REFERENCES_ALL_COLUMNS('"db"."schema"."table"', 'TABLE'))`).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow("db", "tags", "pii", "email", "COLUMN", "EMAIL"))
+		references, err := client.TagReferences.GetForTableColumns(ctx, tableID)
+		require.NoError(t, err)
+		assert.Equal(t, []TagReference{
+			{TagDatabase: "db", TagSchema: "tags", TagName: "pii", TagValue: "email", Level: TagReferenceDomainColumn, ColumnName: String("EMAIL")},
+		}, references)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("insufficient privileges", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT TAG_DATABASE, TAG_SCHEMA, TAG_NAME, TAG_VALUE, LEVEL, COLUMN_NAME FROM TABLE("db".INFORMATION_SCHEMA.TAG_REFERENCES_ALL_COLUMNS('"db"."schema"."table"', 'TABLE'))`).
+			WillReturnError(&gosnowflake.SnowflakeError{Number: 3001, Message: "Insufficient privileges to operate on table 'TABLE'"})
+		_, err := client.TagReferences.GetForTableColumns(ctx, tableID)
+		require.ErrorIs(t, err, ErrInsufficientPrivileges)
+		assert.ErrorContains(t, err, "Insufficient privileges to operate on table 'TABLE'")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
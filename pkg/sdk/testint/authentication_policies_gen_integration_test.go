@@ -0,0 +1,175 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_AuthenticationPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	assertAuthenticationPolicy := func(t *testing.T, authenticationPolicy *sdk.AuthenticationPolicy, id sdk.SchemaObjectIdentifier, expectedComment string) {
+		t.Helper()
+		assert.NotEmpty(t, authenticationPolicy.CreatedOn)
+		assert.Equal(t, id.Name(), authenticationPolicy.Name)
+		assert.Equal(t, id.SchemaName(), authenticationPolicy.SchemaName)
+		assert.Equal(t, id.DatabaseName(), authenticationPolicy.DatabaseName)
+		assert.Equal(t, "ACCOUNTADMIN", authenticationPolicy.Owner)
+		assert.Equal(t, expectedComment, authenticationPolicy.Comment)
+	}
+
+	findProperty := func(t *testing.T, properties []sdk.AuthenticationPolicyDescription, name string) sdk.AuthenticationPolicyDescription {
+		t.Helper()
+		property, err := collections.FindOne(properties, func(p sdk.AuthenticationPolicyDescription) bool { return p.Property == name })
+		require.NoError(t, err)
+		return *property
+	}
+
+	cleanupAuthenticationPolicyProvider := func(id sdk.SchemaObjectIdentifier) func() {
+		return func() {
+			err := client.AuthenticationPolicies.Drop(ctx, sdk.NewDropAuthenticationPolicyRequest(id).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("create authentication_policy: complete case", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, random.String())
+		comment := random.Comment()
+
+		request := sdk.NewCreateAuthenticationPolicyRequest(id).
+			WithAuthenticationMethods([]sdk.AuthenticationMethodsRequest{{Method: sdk.AuthenticationMethodsPassword}}).
+			WithMfaAuthenticationMethods([]sdk.MfaAuthenticationMethodsRequest{{Method: sdk.MfaAuthenticationMethodsPassword}}).
+			WithMfaEnrollment(sdk.Pointer(sdk.MfaEnrollmentOptional)).
+			WithClientTypes([]sdk.ClientTypesRequest{{ClientType: sdk.ClientTypesSnowflakeUi}}).
+			WithComment(&comment)
+
+		err := client.AuthenticationPolicies.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupAuthenticationPolicyProvider(id))
+
+		authenticationPolicy, err := client.AuthenticationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertAuthenticationPolicy(t, authenticationPolicy, id, comment)
+
+		properties, err := client.AuthenticationPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "[PASSWORD]", findProperty(t, properties, "AUTHENTICATION_METHODS").Value)
+		assert.Equal(t, "[SNOWFLAKE_UI]", findProperty(t, properties, "CLIENT_TYPES").Value)
+		assert.Equal(t, "OPTIONAL", findProperty(t, properties, "MFA_ENROLLMENT").Value)
+	})
+
+	t.Run("create authentication_policy: no optionals", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, random.String())
+
+		err := client.AuthenticationPolicies.Create(ctx, sdk.NewCreateAuthenticationPolicyRequest(id))
+		require.NoError(t, err)
+		t.Cleanup(cleanupAuthenticationPolicyProvider(id))
+
+		authenticationPolicy, err := client.AuthenticationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertAuthenticationPolicy(t, authenticationPolicy, id, "")
+	})
+
+	t.Run("drop authentication_policy: existing", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, random.String())
+
+		err := client.AuthenticationPolicies.Create(ctx, sdk.NewCreateAuthenticationPolicyRequest(id))
+		require.NoError(t, err)
+
+		err = client.AuthenticationPolicies.Drop(ctx, sdk.NewDropAuthenticationPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.AuthenticationPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("drop authentication_policy: non-existing", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, "does_not_exist")
+
+		err := client.AuthenticationPolicies.Drop(ctx, sdk.NewDropAuthenticationPolicyRequest(id))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("alter authentication_policy: set value and unset value", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, random.String())
+
+		err := client.AuthenticationPolicies.Create(ctx, sdk.NewCreateAuthenticationPolicyRequest(id))
+		require.NoError(t, err)
+		t.Cleanup(cleanupAuthenticationPolicyProvider(id))
+
+		alterRequest := sdk.NewAlterAuthenticationPolicyRequest(id).WithSet(
+			sdk.NewAuthenticationPolicySetRequest().
+				WithMfaEnrollment(sdk.Pointer(sdk.MfaEnrollmentRequired)).
+				WithComment(sdk.String("new comment")),
+		)
+		err = client.AuthenticationPolicies.Alter(ctx, alterRequest)
+		require.NoError(t, err)
+
+		authenticationPolicy, err := client.AuthenticationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", authenticationPolicy.Comment)
+
+		properties, err := client.AuthenticationPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "REQUIRED", findProperty(t, properties, "MFA_ENROLLMENT").Value)
+
+		alterRequest = sdk.NewAlterAuthenticationPolicyRequest(id).WithUnset(
+			sdk.NewAuthenticationPolicyUnsetRequest().
+				WithMfaEnrollment(sdk.Bool(true)).
+				WithComment(sdk.Bool(true)),
+		)
+		err = client.AuthenticationPolicies.Alter(ctx, alterRequest)
+		require.NoError(t, err)
+
+		authenticationPolicy, err = client.AuthenticationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "", authenticationPolicy.Comment)
+
+		properties, err = client.AuthenticationPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "OPTIONAL", findProperty(t, properties, "MFA_ENROLLMENT").Value)
+	})
+
+	t.Run("alter authentication_policy: rename", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, random.String())
+
+		err := client.AuthenticationPolicies.Create(ctx, sdk.NewCreateAuthenticationPolicyRequest(id))
+		require.NoError(t, err)
+
+		newId := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, random.String())
+		err = client.AuthenticationPolicies.Alter(ctx, sdk.NewAlterAuthenticationPolicyRequest(id).WithRenameTo(&newId))
+		if err != nil {
+			t.Cleanup(cleanupAuthenticationPolicyProvider(id))
+		} else {
+			t.Cleanup(cleanupAuthenticationPolicyProvider(newId))
+		}
+		require.NoError(t, err)
+
+		_, err = client.AuthenticationPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+
+		authenticationPolicy, err := client.AuthenticationPolicies.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assertAuthenticationPolicy(t, authenticationPolicy, newId, "")
+	})
+
+	t.Run("show authentication_policy: with like", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, random.String())
+
+		err := client.AuthenticationPolicies.Create(ctx, sdk.NewCreateAuthenticationPolicyRequest(id))
+		require.NoError(t, err)
+		t.Cleanup(cleanupAuthenticationPolicyProvider(id))
+
+		authenticationPolicies, err := client.AuthenticationPolicies.Show(ctx, sdk.NewShowAuthenticationPolicyRequest().
+			WithLike(&sdk.Like{Pattern: sdk.String(id.Name())}).
+			WithIn(&sdk.In{Schema: sdk.NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}))
+		require.NoError(t, err)
+		assert.Len(t, authenticationPolicies, 1)
+	})
+}
@@ -0,0 +1,156 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+	"unicode"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/tracing"
+	"github.com/snowflakedb/gosnowflake"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startStatementSpans starts the span of the SDK method executing the statement and its child span of the statement
+// itself. The statement span is ended by the returned function; it also ends the method span. A method executing
+// more than one statement gets a method span per statement.
+func startStatementSpans(ctx context.Context, sql string) (context.Context, func(rows int64, err error)) {
+	tracer := tracing.Tracer()
+	ctx, methodSpan := tracer.Start(ctx, sdkMethodName(), trace.WithSpanKind(trace.SpanKindInternal))
+	if !methodSpan.IsRecording() {
+		methodSpan.End()
+		return ctx, func(int64, error) {}
+	}
+
+	operation := statementOperation(sql)
+	ctx, statementSpan := tracer.Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "snowflake"),
+			attribute.String("db.operation", operation),
+			attribute.String("db.statement", redactStringLiterals(RedactSecrets(sql))),
+		),
+	)
+	queryIdChan := make(chan string, 1)
+	ctx = gosnowflake.WithQueryIDChan(ctx, queryIdChan)
+
+	return ctx, func(rows int64, err error) {
+		select {
+		case queryId := <-queryIdChan:
+			statementSpan.SetAttributes(attribute.String("snowflake.query_id", queryId))
+		default:
+		}
+		if rows >= 0 {
+			statementSpan.SetAttributes(attribute.Int64("db.rows_affected", rows))
+		}
+		if err != nil {
+			// the error message is not recorded, because it can contain the statement
+			errorClass := errorClass(err)
+			statementSpan.SetAttributes(attribute.String("error.type", errorClass))
+			statementSpan.SetStatus(codes.Error, errorClass)
+			methodSpan.SetStatus(codes.Error, errorClass)
+		}
+		statementSpan.End()
+		methodSpan.End()
+	}
+}
+
+// sdkMethodName returns the name of the first SDK method on the stack that is not a part of the client itself,
+// e.g. "Databases.Create".
+func sdkMethodName() string {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if name, ok := sdkMethodNameFromFunction(frame.Function); ok && !isClientInternalFile(frame.File) {
+			return name
+		}
+		if !more {
+			return "sdk"
+		}
+	}
+}
+
+func sdkMethodNameFromFunction(function string) (string, bool) {
+	_, name, found := strings.Cut(function, "/pkg/sdk.")
+	if !found || strings.Contains(name, "/") {
+		return "", false
+	}
+	// (*databases).Create -> Databases.Create
+	name = strings.NewReplacer("(*", "", ")", "").Replace(name)
+	if name == "" {
+		return "", false
+	}
+	runes := []rune(name)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes), true
+}
+
+func isClientInternalFile(file string) bool {
+	for _, internalFile := range []string{"/client.go", "/helpers_proposal.go", "/tracing.go", "/query_tag.go"} {
+		if strings.HasSuffix(file, "/pkg/sdk"+internalFile) {
+			return true
+		}
+	}
+	return false
+}
+
+// statementOperation returns the first two keywords of the statement, e.g. "CREATE DATABASE".
+func statementOperation(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) > 2 {
+		fields = fields[:2]
+	}
+	operation := strings.ToUpper(strings.Join(fields, " "))
+	if i := strings.Index(operation, "("); i > 0 {
+		operation = operation[:i]
+	}
+	return operation
+}
+
+var stringLiteralRegex = regexp.MustCompile(`'(?:[^'\\]|\\.|'')*'`)
+
+// redactStringLiterals masks all string literals of the statement, because besides the secrets known to RedactSecrets
+// they can contain other sensitive values (e.g. in the statements built outside the SDK).
+func redactStringLiterals(sql string) string {
+	return stringLiteralRegex.ReplaceAllString(sql, "'***'")
+}
+
+func errorClass(err error) string {
+	var snowflakeError *gosnowflake.SnowflakeError
+	if errors.As(err, &snowflakeError) {
+		return fmt.Sprintf("snowflake:%d", snowflakeError.Number)
+	}
+	return fmt.Sprintf("%T", err)
+}
+
+func rowsAffected(result sql.Result) int64 {
+	if result == nil {
+		return -1
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return -1
+	}
+	return rows
+}
+
+// rowsReturned returns the length of the slice the rows were scanned into.
+func rowsReturned(dest any) int64 {
+	v := reflect.ValueOf(dest)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice {
+		return -1
+	}
+	return int64(v.Len())
+}
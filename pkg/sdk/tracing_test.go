@@ -0,0 +1,63 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestStatementOperation(t *testing.T) {
+	assert.Equal(t, "CREATE DATABASE", statementOperation(`CREATE DATABASE "db"`))
+	assert.Equal(t, "SHOW GRANTS", statementOperation("SHOW  GRANTS ON ACCOUNT"))
+	assert.Equal(t, "SELECT SYSTEM$GET_TAG", statementOperation(`select SYSTEM$GET_TAG('tag', 'object', 'TABLE')`))
+	assert.Equal(t, "COMMIT", statementOperation("commit"))
+}
+
+func TestRedactStringLiterals(t *testing.T) {
+	assert.Equal(t, `CREATE USER "u" PASSWORD = '***' COMMENT = '***'`, redactStringLiterals(`CREATE USER "u" PASSWORD = 'se''cr\'et' COMMENT = 'c'`))
+	assert.Equal(t, `SHOW DATABASES`, redactStringLiterals(`SHOW DATABASES`))
+}
+
+func TestSdkMethodNameFromFunction(t *testing.T) {
+	name, ok := sdkMethodNameFromFunction("github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk.(*databases).Create")
+	require.True(t, ok)
+	assert.Equal(t, "Databases.Create", name)
+
+	_, ok = sdkMethodNameFromFunction("github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources.CreateDatabase")
+	assert.False(t, ok)
+}
+
+func TestStartStatementSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previousProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previousProvider) })
+
+	_, endSpans := startStatementSpans(context.Background(), `ALTER USER "u" SET PASSWORD = 'secret'`)
+	endSpans(1, &gosnowflake.SnowflakeError{Number: 2003})
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	statementSpan, methodSpan := spans[0], spans[1]
+	assert.Equal(t, "TestStartStatementSpans", methodSpan.Name())
+	assert.Equal(t, codes.Error, methodSpan.Status().Code)
+	assert.Equal(t, "ALTER USER", statementSpan.Name())
+	assert.Equal(t, methodSpan.SpanContext().SpanID(), statementSpan.Parent().SpanID())
+	assert.Contains(t, statementSpan.Attributes(), attribute.String("db.statement", `ALTER USER "u" SET PASSWORD = '***'`))
+	assert.Contains(t, statementSpan.Attributes(), attribute.Int64("db.rows_affected", 1))
+	assert.Contains(t, statementSpan.Attributes(), attribute.String("error.type", "snowflake:2003"))
+}
+
+func TestErrorClass(t *testing.T) {
+	assert.Equal(t, "snowflake:2003", errorClass(errors.Join(errors.New("wrapped"), &gosnowflake.SnowflakeError{Number: 2003})))
+	assert.Equal(t, "*errors.errorString", errorClass(errors.New("other")))
+}
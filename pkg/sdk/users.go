@@ -58,6 +58,7 @@ type User struct {
 	LockedUntilTime       time.Time
 	HasPassword           bool
 	HasRsaPublicKey       bool
+	Type                  string
 }
 type userDBRow struct {
 	Name                  string         `db:"name"`
@@ -86,6 +87,7 @@ type userDBRow struct {
 	LockedUntilTime       sql.NullTime   `db:"locked_until_time"`
 	HasPassword           bool           `db:"has_password"`
 	HasRsaPublicKey       bool           `db:"has_rsa_public_key"`
+	Type                  sql.NullString `db:"type"`
 }
 
 func (row userDBRow) convert() *User {
@@ -139,6 +141,9 @@ func (row userDBRow) convert() *User {
 	if row.LockedUntilTime.Valid {
 		user.LockedUntilTime = row.LockedUntilTime.Time
 	}
+	if row.Type.Valid {
+		user.Type = row.Type.String
+	}
 	return user
 }
 
@@ -195,8 +200,17 @@ func (v *users) Create(ctx context.Context, id AccountObjectIdentifier, opts *Cr
 	return err
 }
 
+type UserType string
+
+const (
+	UserTypePerson        UserType = "PERSON"
+	UserTypeService       UserType = "SERVICE"
+	UserTypeLegacyService UserType = "LEGACY_SERVICE"
+)
+
 type UserObjectProperties struct {
-	Password             *string         `ddl:"parameter,single_quotes" sql:"PASSWORD"`
+	Type                 *UserType       `ddl:"parameter,no_quotes" sql:"TYPE"`
+	Password             *string         `ddl:"parameter,single_quotes,secret" sql:"PASSWORD"`
 	LoginName            *string         `ddl:"parameter,single_quotes" sql:"LOGIN_NAME"`
 	DisplayName          *string         `ddl:"parameter,single_quotes" sql:"DISPLAY_NAME"`
 	FirstName            *string         `ddl:"parameter,single_quotes" sql:"FIRST_NAME"`
@@ -205,13 +219,14 @@ type UserObjectProperties struct {
 	Email                *string         `ddl:"parameter,single_quotes" sql:"EMAIL"`
 	MustChangePassword   *bool           `ddl:"parameter,no_quotes" sql:"MUST_CHANGE_PASSWORD"`
 	Disable              *bool           `ddl:"parameter,no_quotes" sql:"DISABLED"`
-	DaysToExpiry         *int            `ddl:"parameter,single_quotes" sql:"DAYS_TO_EXPIRY"`
-	MinsToUnlock         *int            `ddl:"parameter,single_quotes" sql:"MINS_TO_UNLOCK"`
+	DaysToExpiry         *int            `ddl:"parameter" sql:"DAYS_TO_EXPIRY"`
+	MinsToUnlock         *int            `ddl:"parameter" sql:"MINS_TO_UNLOCK"`
 	DefaultWarehosue     *string         `ddl:"parameter,single_quotes" sql:"DEFAULT_WAREHOUSE"`
 	DefaultNamespace     *string         `ddl:"parameter,single_quotes" sql:"DEFAULT_NAMESPACE"`
 	DefaultRole          *string         `ddl:"parameter,no_quotes" sql:"DEFAULT_ROLE"`
 	DefaultSeconaryRoles *SecondaryRoles `ddl:"keyword" sql:"DEFAULT_SECONDARY_ROLES"`
-	MinsToBypassMFA      *int            `ddl:"parameter,single_quotes" sql:"MINS_TO_BYPASS_MFA"`
+	MinsToBypassMFA      *int            `ddl:"parameter" sql:"MINS_TO_BYPASS_MFA"`
+	DisableMFA           *bool           `ddl:"parameter,no_quotes" sql:"DISABLE_MFA"`
 	RSAPublicKey         *string         `ddl:"parameter,single_quotes" sql:"RSA_PUBLIC_KEY"`
 	RSAPublicKey2        *string         `ddl:"parameter,single_quotes" sql:"RSA_PUBLIC_KEY_2"`
 	Comment              *string         `ddl:"parameter,single_quotes" sql:"COMMENT"`
@@ -228,6 +243,7 @@ type SecondaryRole struct {
 	Value string `ddl:"keyword,single_quotes"`
 }
 type UserObjectPropertiesUnset struct {
+	Type                 *bool `ddl:"keyword" sql:"TYPE"`
 	Password             *bool `ddl:"keyword" sql:"PASSWORD"`
 	LoginName            *bool `ddl:"keyword" sql:"LOGIN_NAME"`
 	DisplayName          *bool `ddl:"keyword" sql:"DISPLAY_NAME"`
@@ -244,6 +260,7 @@ type UserObjectPropertiesUnset struct {
 	DefaultRole          *bool `ddl:"keyword" sql:"DEFAULT_ROLE"`
 	DefaultSeconaryRoles *bool `ddl:"keyword" sql:"DEFAULT_SECONDARY_ROLES"`
 	MinsToBypassMFA      *bool `ddl:"keyword" sql:"MINS_TO_BYPASS_MFA"`
+	DisableMFA           *bool `ddl:"keyword" sql:"DISABLE_MFA"`
 	RSAPublicKey         *bool `ddl:"keyword" sql:"RSA_PUBLIC_KEY"`
 	RSAPublicKey2        *bool `ddl:"keyword" sql:"RSA_PUBLIC_KEY_2"`
 	Comment              *bool `ddl:"keyword" sql:"COMMENT"`
@@ -347,31 +364,33 @@ func (opts *RemoveDelegatedAuthorization) validate() error {
 }
 
 type UserSet struct {
-	PasswordPolicy    *SchemaObjectIdentifier `ddl:"identifier" sql:"PASSWORD POLICY"`
-	SessionPolicy     *string                 `ddl:"parameter" sql:"SESSION POLICY"`
-	ObjectProperties  *UserObjectProperties   `ddl:"keyword"`
-	ObjectParameters  *UserObjectParameters   `ddl:"keyword"`
-	SessionParameters *SessionParameters      `ddl:"keyword"`
+	PasswordPolicy       *SchemaObjectIdentifier `ddl:"identifier" sql:"PASSWORD POLICY"`
+	SessionPolicy        *string                 `ddl:"parameter" sql:"SESSION POLICY"`
+	AuthenticationPolicy *SchemaObjectIdentifier `ddl:"identifier" sql:"AUTHENTICATION POLICY"`
+	ObjectProperties     *UserObjectProperties   `ddl:"keyword"`
+	ObjectParameters     *UserObjectParameters   `ddl:"keyword"`
+	SessionParameters    *SessionParameters      `ddl:"keyword"`
 }
 
 func (opts *UserSet) validate() error {
-	if !exactlyOneValueSet(opts.PasswordPolicy, opts.SessionPolicy, opts.ObjectProperties, opts.ObjectParameters, opts.SessionParameters) {
-		return errExactlyOneOf("UserSet", "PasswordPolicy", "SessionPolicy", "ObjectProperties", "ObjectParameters", "SessionParameters")
+	if !exactlyOneValueSet(opts.PasswordPolicy, opts.SessionPolicy, opts.AuthenticationPolicy, opts.ObjectProperties, opts.ObjectParameters, opts.SessionParameters) {
+		return errExactlyOneOf("UserSet", "PasswordPolicy", "SessionPolicy", "AuthenticationPolicy", "ObjectProperties", "ObjectParameters", "SessionParameters")
 	}
 	return nil
 }
 
 type UserUnset struct {
-	PasswordPolicy    *bool                      `ddl:"keyword" sql:"PASSWORD POLICY"`
-	SessionPolicy     *bool                      `ddl:"keyword" sql:"SESSION POLICY"`
-	ObjectProperties  *UserObjectPropertiesUnset `ddl:"list"`
-	ObjectParameters  *UserObjectParametersUnset `ddl:"list"`
-	SessionParameters *SessionParametersUnset    `ddl:"list"`
+	PasswordPolicy       *bool                      `ddl:"keyword" sql:"PASSWORD POLICY"`
+	SessionPolicy        *bool                      `ddl:"keyword" sql:"SESSION POLICY"`
+	AuthenticationPolicy *bool                      `ddl:"keyword" sql:"AUTHENTICATION POLICY"`
+	ObjectProperties     *UserObjectPropertiesUnset `ddl:"list"`
+	ObjectParameters     *UserObjectParametersUnset `ddl:"list"`
+	SessionParameters    *SessionParametersUnset    `ddl:"list"`
 }
 
 func (opts *UserUnset) validate() error {
-	if !exactlyOneValueSet(opts.PasswordPolicy, opts.SessionPolicy, opts.ObjectProperties, opts.ObjectParameters, opts.SessionParameters) {
-		return errExactlyOneOf("UserUnset", "PasswordPolicy", "SessionPolicy", "ObjectProperties", "ObjectParameters", "SessionParameters")
+	if !exactlyOneValueSet(opts.PasswordPolicy, opts.SessionPolicy, opts.AuthenticationPolicy, opts.ObjectProperties, opts.ObjectParameters, opts.SessionParameters) {
+		return errExactlyOneOf("UserUnset", "PasswordPolicy", "SessionPolicy", "AuthenticationPolicy", "ObjectProperties", "ObjectParameters", "SessionParameters")
 	}
 	return nil
 }
@@ -412,6 +431,7 @@ func (v *users) Drop(ctx context.Context, id AccountObjectIdentifier) error {
 
 // UserDetails contains details about a user.
 type UserDetails struct {
+	Type                                *StringProperty
 	Name                                *StringProperty
 	Comment                             *StringProperty
 	DisplayName                         *StringProperty
@@ -448,6 +468,8 @@ func userDetailsFromRows(rows []propertyRow) *UserDetails {
 	v := &UserDetails{}
 	for _, row := range rows {
 		switch row.Property {
+		case "TYPE":
+			v.Type = row.toStringProperty()
 		case "NAME":
 			v.Name = row.toStringProperty()
 		case "COMMENT":
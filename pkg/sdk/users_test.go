@@ -45,6 +45,19 @@ func TestUserCreate(t *testing.T) {
 
 		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE USER IF NOT EXISTS %s PASSWORD = '%s' LOGIN_NAME = '%s' DEFAULT_ROLE = foo ENABLE_UNREDACTED_QUERY_SYNTAX_ERROR = true AUTOCOMMIT = true WITH TAG ("db"."schema"."tag1" = 'v1')`, id.FullyQualifiedName(), password, loginName)
 	})
+
+	t.Run("service user with expiry", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				Type:         Pointer(UserTypeService),
+				DaysToExpiry: Int(30),
+				MinsToUnlock: Int(5),
+				Comment:      String("service"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE USER %s TYPE = SERVICE DAYS_TO_EXPIRY = 30 MINS_TO_UNLOCK = 5 COMMENT = 'service'`, id.FullyQualifiedName())
+	})
 }
 
 func TestUserAlter(t *testing.T) {
@@ -73,6 +86,27 @@ func TestUserAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s SET PASSWORD POLICY %s", id.FullyQualifiedName(), passwordPolicy.FullyQualifiedName())
 	})
 
+	t.Run("with setting an authentication policy", func(t *testing.T) {
+		authenticationPolicy := NewSchemaObjectIdentifier("db", "schema", "AUTHENTICATION_POLICY1")
+		opts := &AlterUserOptions{
+			name: id,
+			Set: &UserSet{
+				AuthenticationPolicy: &authenticationPolicy,
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s SET AUTHENTICATION POLICY %s", id.FullyQualifiedName(), authenticationPolicy.FullyQualifiedName())
+	})
+
+	t.Run("with unsetting an authentication policy", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Unset: &UserUnset{
+				AuthenticationPolicy: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s UNSET AUTHENTICATION POLICY", id.FullyQualifiedName())
+	})
+
 	t.Run("with setting tags", func(t *testing.T) {
 		tags := []TagAssociation{
 			{
@@ -192,6 +226,34 @@ func TestUserAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s UNSET PASSWORD", id.FullyQualifiedName())
 	})
 
+	t.Run("with setting type and mfa properties", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Set: &UserSet{
+				ObjectProperties: &UserObjectProperties{
+					Type:            Pointer(UserTypeLegacyService),
+					MinsToBypassMFA: Int(10),
+					DisableMFA:      Bool(true),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s SET TYPE = LEGACY_SERVICE MINS_TO_BYPASS_MFA = 10 DISABLE_MFA = true", id.FullyQualifiedName())
+	})
+
+	t.Run("with unsetting type and mfa properties", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Unset: &UserUnset{
+				ObjectProperties: &UserObjectPropertiesUnset{
+					Type:            Bool(true),
+					MinsToBypassMFA: Bool(true),
+					DisableMFA:      Bool(true),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s UNSET TYPE, MINS_TO_BYPASS_MFA, DISABLE_MFA", id.FullyQualifiedName())
+	})
+
 	t.Run("with unsetting a policy", func(t *testing.T) {
 		sessionPolicy := "SESSION_POLICY1"
 		opts := &AlterUserOptions{
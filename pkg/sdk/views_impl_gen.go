@@ -38,8 +38,15 @@ func (v *views) Show(ctx context.Context, request *ShowViewRequest) ([]View, err
 }
 
 func (v *views) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*View, error) {
-	request := NewShowViewRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{String(id.Name())})
-	views, err := v.Show(ctx, request)
+	schema := NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())
+	request := NewShowViewRequest().WithIn(&In{Schema: schema})
+	// with the SHOW result cache, all views in the schema are fetched once
+	if v.client.showCache == nil {
+		request.WithLike(&Like{String(id.Name())})
+	}
+	views, err := cachedShow(ctx, v.client, request.toOpts(), containerScope(schema), func(ctx context.Context) ([]View, error) {
+		return v.Show(ctx, request)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1,29 +1,32 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"log"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/jmoiron/sqlx"
 )
 
-func Exec(db *sql.DB, query string) error {
-	log.Print("[DEBUG] exec stmt ", query)
+func Exec(ctx context.Context, db *sql.DB, query string) error {
+	log.Print("[DEBUG] exec stmt ", sdk.RedactSecrets(query))
 
-	_, err := db.Exec(query)
+	_, err := db.ExecContext(ctx, query)
 	return err
 }
 
-func ExecMulti(db *sql.DB, queries []string) error {
-	log.Print("[DEBUG] exec stmts ", queries)
+func ExecMulti(ctx context.Context, db *sql.DB, queries []string) error {
+	log.Print("[DEBUG] exec stmts ", sdk.RedactSecrets(fmt.Sprintf("%v", queries)))
 
-	tx, err := db.Begin()
+	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 
 	for _, query := range queries {
-		_, err = tx.Exec(query)
+		_, err = tx.ExecContext(ctx, query)
 		if err != nil {
 			return tx.Rollback()
 		}
@@ -34,17 +37,17 @@ func ExecMulti(db *sql.DB, queries []string) error {
 // QueryRow will run stmt against the db and return the row. We use
 // [DB.Unsafe](https://godoc.org/github.com/jmoiron/sqlx#DB.Unsafe) so that we can scan to structs
 // without worrying about newly introduced columns.
-func QueryRow(db *sql.DB, stmt string) *sqlx.Row {
-	log.Print("[DEBUG] query stmt ", stmt)
+func QueryRow(ctx context.Context, db *sql.DB, stmt string) *sqlx.Row {
+	log.Print("[DEBUG] query stmt ", sdk.RedactSecrets(stmt))
 	sdb := sqlx.NewDb(db, "snowflake").Unsafe()
-	return sdb.QueryRowx(stmt)
+	return sdb.QueryRowxContext(ctx, stmt)
 }
 
 // Query will run stmt against the db and return the rows. We use
 // [DB.Unsafe](https://godoc.org/github.com/jmoiron/sqlx#DB.Unsafe) so that we can scan to structs
 // without worrying about newly introduced columns.
-func Query(db *sql.DB, stmt string) (*sqlx.Rows, error) {
-	log.Print("[DEBUG] query stmt ", stmt)
+func Query(ctx context.Context, db *sql.DB, stmt string) (*sqlx.Rows, error) {
+	log.Print("[DEBUG] query stmt ", sdk.RedactSecrets(stmt))
 	sdb := sqlx.NewDb(db, "snowflake").Unsafe()
-	return sdb.Queryx(stmt)
+	return sdb.QueryxContext(ctx, stmt)
 }
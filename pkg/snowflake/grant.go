@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -420,8 +421,8 @@ type GrantDetail struct {
 	GrantedBy   sql.NullString `db:"granted_by"`
 }
 
-func queryGrants(db *sql.DB, stmt string) ([]GrantDetail, error) {
-	rows, err := Query(db, stmt)
+func queryGrants(ctx context.Context, db *sql.DB, stmt string) ([]GrantDetail, error) {
+	rows, err := Query(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -439,32 +440,32 @@ func queryGrants(db *sql.DB, stmt string) ([]GrantDetail, error) {
 	return grantDetails, nil
 }
 
-func ShowGrantsOn(db *sql.DB, objectType, objectName string) ([]GrantDetail, error) {
+func ShowGrantsOn(ctx context.Context, db *sql.DB, objectType, objectName string) ([]GrantDetail, error) {
 	stmt := fmt.Sprintf(`SHOW GRANTS ON %v %v`, objectType, objectName)
-	return queryGrants(db, stmt)
+	return queryGrants(ctx, db, stmt)
 }
 
-func ShowGrantsOnAccount(db *sql.DB) ([]GrantDetail, error) {
+func ShowGrantsOnAccount(ctx context.Context, db *sql.DB) ([]GrantDetail, error) {
 	stmt := `SHOW GRANTS ON ACCOUNT`
-	return queryGrants(db, stmt)
+	return queryGrants(ctx, db, stmt)
 }
 
-func ShowGrantsTo(db *sql.DB, objectType, objectName string) ([]GrantDetail, error) {
+func ShowGrantsTo(ctx context.Context, db *sql.DB, objectType, objectName string) ([]GrantDetail, error) {
 	stmt := fmt.Sprintf(`SHOW GRANTS TO %v "%v"`, objectType, objectName)
-	return queryGrants(db, stmt)
+	return queryGrants(ctx, db, stmt)
 }
 
-func ShowGrantsOf(db *sql.DB, objectType, objectName string) ([]GrantDetail, error) {
+func ShowGrantsOf(ctx context.Context, db *sql.DB, objectType, objectName string) ([]GrantDetail, error) {
 	stmt := fmt.Sprintf(`SHOW GRANTS OF %v %v`, objectType, objectName)
-	return queryGrants(db, stmt)
+	return queryGrants(ctx, db, stmt)
 }
 
-func ShowFutureGrantsIn(db *sql.DB, objectType, objectName string) ([]GrantDetail, error) {
+func ShowFutureGrantsIn(ctx context.Context, db *sql.DB, objectType, objectName string) ([]GrantDetail, error) {
 	stmt := fmt.Sprintf(`SHOW FUTURE GRANTS IN %v %v`, objectType, objectName)
-	return queryGrants(db, stmt)
+	return queryGrants(ctx, db, stmt)
 }
 
-func ShowFutureGrantsTo(db *sql.DB, objectType, objectName string) ([]GrantDetail, error) {
+func ShowFutureGrantsTo(ctx context.Context, db *sql.DB, objectType, objectName string) ([]GrantDetail, error) {
 	stmt := fmt.Sprintf(`SHOW FUTURE GRANTS TO %v %v`, objectType, objectName)
-	return queryGrants(db, stmt)
+	return queryGrants(ctx, db, stmt)
 }
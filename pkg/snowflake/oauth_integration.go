@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -43,9 +44,9 @@ func ScanOAuthIntegration(row *sqlx.Row) (*OauthIntegration, error) {
 	return r, nil
 }
 
-func ListIntegrations(db *sql.DB) ([]OauthIntegration, error) {
+func ListIntegrations(ctx context.Context, db *sql.DB) ([]OauthIntegration, error) {
 	stmt := "SHOW INTEGRATIONS"
-	rows, err := db.Query(stmt)
+	rows, err := db.QueryContext(ctx, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +64,7 @@ func ListIntegrations(db *sql.DB) ([]OauthIntegration, error) {
 	return r, nil
 }
 
-func DropIntegration(db *sql.DB, name string) error {
+func DropIntegration(ctx context.Context, db *sql.DB, name string) error {
 	stmt := NewOAuthIntegrationBuilder(name).Drop()
-	return Exec(db, stmt)
+	return Exec(ctx, db, stmt)
 }
@@ -87,38 +87,6 @@ func (e *ViewSelectStatementExtractor) ExtractMaterializedView() (string, error)
 	return string(e.input[e.pos:]), nil
 }
 
-func (e *ViewSelectStatementExtractor) ExtractDynamicTable() (string, error) {
-	fmt.Printf("[DEBUG] extracting dynamic table query %s\n", string(e.input))
-	e.consumeSpace()
-	e.consumeToken("create")
-	e.consumeSpace()
-	e.consumeToken("or replace")
-	e.consumeSpace()
-	e.consumeToken("dynamic table")
-	e.consumeSpace()
-	e.consumeID()
-	// TODO column list
-	e.consumeSpace()
-	e.consumeComment()
-	e.consumeSpace()
-	e.consumeQuotedParameter("lag")
-	e.consumeSpace()
-	e.consumeTokenParameter("warehouse")
-	e.consumeSpace()
-	e.consumeTokenParameter("refresh_mode")
-	e.consumeSpace()
-	e.consumeTokenParameter("initialize")
-	e.consumeSpace()
-	e.consumeTokenParameter("warehouse")
-	e.consumeSpace()
-	e.consumeComment()
-	e.consumeSpace()
-	e.consumeToken("as")
-	e.consumeSpace()
-
-	return string(e.input[e.pos:]), nil
-}
-
 // consumeToken will move e.pos forward iff the token is the next part of the input. Comparison is
 // case-insensitive. Will return true if consumed.
 func (e *ViewSelectStatementExtractor) consumeToken(t string) bool {
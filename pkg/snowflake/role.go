@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -34,7 +35,7 @@ func (b *RoleBuilder) WithComment(comment string) *RoleBuilder {
 	return b
 }
 
-func (b *RoleBuilder) Create() error {
+func (b *RoleBuilder) Create(ctx context.Context) error {
 	q := strings.Builder{}
 	q.WriteString(fmt.Sprintf(`CREATE ROLE "%v"`, b.name))
 	if b.comment != "" {
@@ -50,51 +51,51 @@ func (b *RoleBuilder) Create() error {
 		}
 		q.WriteString(")")
 	}
-	_, err := b.db.Exec(q.String())
+	_, err := b.db.ExecContext(ctx, q.String())
 	return err
 }
 
-func (b *RoleBuilder) SetComment(comment string) error {
+func (b *RoleBuilder) SetComment(ctx context.Context, comment string) error {
 	q := fmt.Sprintf(`ALTER ROLE "%s" SET COMMENT = '%v'`, b.name, comment)
-	_, err := b.db.Exec(q)
+	_, err := b.db.ExecContext(ctx, q)
 	return err
 }
 
-func (b *RoleBuilder) UnsetComment() error {
+func (b *RoleBuilder) UnsetComment(ctx context.Context) error {
 	q := fmt.Sprintf(`ALTER ROLE "%v" UNSET COMMENT`, b.name)
-	_, err := b.db.Exec(q)
+	_, err := b.db.ExecContext(ctx, q)
 	return err
 }
 
-func (b *RoleBuilder) UnsetTag(tag TagValue) error {
+func (b *RoleBuilder) UnsetTag(ctx context.Context, tag TagValue) error {
 	q := fmt.Sprintf(`ALTER ROLE %s UNSET TAG "%v"."%v"."%v"`, b.name, tag.Database, tag.Schema, tag.Name)
-	_, err := b.db.Exec(q)
+	_, err := b.db.ExecContext(ctx, q)
 	return err
 }
 
-func (b *RoleBuilder) SetTag(tag TagValue) error {
+func (b *RoleBuilder) SetTag(ctx context.Context, tag TagValue) error {
 	q := fmt.Sprintf(`ALTER ROLE %s SET TAG  "%v"."%v"."%v" = "%v"`, b.name, tag.Database, tag.Schema, tag.Name, tag.Value)
-	_, err := b.db.Exec(q)
+	_, err := b.db.ExecContext(ctx, q)
 	return err
 }
 
-func (b *RoleBuilder) Drop() error {
+func (b *RoleBuilder) Drop(ctx context.Context) error {
 	q := fmt.Sprintf(`DROP ROLE "%s"`, b.name)
-	_, err := b.db.Exec(q)
+	_, err := b.db.ExecContext(ctx, q)
 	return err
 }
 
-func (b *RoleBuilder) Show() (*Role, error) {
+func (b *RoleBuilder) Show(ctx context.Context) (*Role, error) {
 	stmt := fmt.Sprintf(`SHOW ROLES LIKE '%s'`, b.name)
-	row := QueryRow(b.db, stmt)
+	row := QueryRow(ctx, b.db, stmt)
 	r := &Role{}
 	err := row.StructScan(r)
 	return r, err
 }
 
-func (b *RoleBuilder) Rename(newName string) error {
+func (b *RoleBuilder) Rename(ctx context.Context, newName string) error {
 	stmt := fmt.Sprintf(`ALTER ROLE "%s" RENAME TO "%s"`, b.name, newName)
-	_, err := b.db.Exec(stmt)
+	_, err := b.db.ExecContext(ctx, stmt)
 	return err
 }
 
@@ -104,13 +105,13 @@ type Role struct {
 	Owner   sql.NullString `db:"owner"`
 }
 
-func ListRoles(db *sql.DB, rolePattern string) ([]*Role, error) {
+func ListRoles(ctx context.Context, db *sql.DB, rolePattern string) ([]*Role, error) {
 	stmt := strings.Builder{}
 	stmt.WriteString("SHOW ROLES")
 	if rolePattern != "" {
 		stmt.WriteString(fmt.Sprintf(` LIKE '%v'`, rolePattern))
 	}
-	rows, err := Query(db, stmt.String())
+	rows, err := Query(ctx, db, stmt.String())
 	if err != nil {
 		return nil, err
 	}
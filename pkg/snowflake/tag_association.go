@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -121,9 +122,9 @@ func ScanTagAssociation(row *sqlx.Row) (*TagAssociation, error) {
 	return r, err
 }
 
-func ListTagAssociations(tb *TagAssociationBuilder, db *sql.DB) ([]TagAssociation, error) {
+func ListTagAssociations(ctx context.Context, tb *TagAssociationBuilder, db *sql.DB) ([]TagAssociation, error) {
 	stmt := `SELECT SYSTEM$GET_TAG('"?"."?"."?"', '?', '?') TAG_VALUE WHERE TAG_VALUE IS NOT NULL`
-	rows, err := db.Query(stmt,
+	rows, err := db.QueryContext(ctx, stmt,
 		tb.databaseName, tb.schemaName, tb.tagName, tb.objectIdentifier, tb.objectType)
 	if err != nil {
 		return nil, err
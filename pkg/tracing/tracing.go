@@ -0,0 +1,107 @@
+// Package tracing configures OpenTelemetry tracing of the provider. The traces are exported only when the
+// SNOWFLAKE_TRACES_EXPORTER environment variable is set:
+//   - "otlp" exports the spans to an OTLP/HTTP endpoint configured with the standard OTEL_EXPORTER_OTLP_* variables,
+//   - "file" writes the spans as JSON to the file from SNOWFLAKE_TRACES_FILE (snowflake-traces.json by default).
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	ExporterEnv = "SNOWFLAKE_TRACES_EXPORTER"
+	FileEnv     = "SNOWFLAKE_TRACES_FILE"
+
+	defaultFile = "snowflake-traces.json"
+	tracerName  = "github.com/Snowflake-Labs/terraform-provider-snowflake"
+)
+
+// Tracer returns the tracer of the provider. It does not record anything until Setup configures an exporter.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// Setup configures the global tracer provider from the environment. The returned function flushes and stops the
+// exporter; it should be called before the provider process exits.
+func Setup(ctx context.Context, version string) (func(context.Context) error, error) {
+	var exporter sdktrace.SpanExporter
+	var cleanup func() error
+	switch exporterName := os.Getenv(ExporterEnv); exporterName {
+	case "":
+		return func(context.Context) error { return nil }, nil
+	case "otlp":
+		otlpExporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not create the OTLP trace exporter: %w", err)
+		}
+		exporter = otlpExporter
+	case "file":
+		path := os.Getenv(FileEnv)
+		if path == "" {
+			path = defaultFile
+		}
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("could not open the trace file %s: %w", path, err)
+		}
+		fileExporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("could not create the file trace exporter: %w", err), f.Close())
+		}
+		exporter = fileExporter
+		cleanup = f.Close
+	default:
+		return nil, fmt.Errorf("invalid %s: %s, valid values are otlp and file", ExporterEnv, exporterName)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName("terraform-provider-snowflake"),
+			semconv.ServiceVersion(version),
+		)),
+	)
+	otel.SetTracerProvider(tracerProvider)
+
+	return func(ctx context.Context) error {
+		err := tracerProvider.Shutdown(ctx)
+		if cleanup != nil {
+			err = errors.Join(err, cleanup())
+		}
+		return err
+	}, nil
+}
+
+// StartOperation starts the span of a CRUD operation of a resource, a data source or an ephemeral resource.
+func StartOperation(ctx context.Context, resourceType string, id string, operation string) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, fmt.Sprintf("%s %s", resourceType, operation),
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			attribute.String("terraform.resource_type", resourceType),
+			attribute.String("terraform.resource_id", id),
+			attribute.String("terraform.operation", operation),
+		),
+	)
+}
+
+// EndOperation ends the span started with StartOperation. The error details are not recorded, because they can
+// contain the SQL statements.
+func EndOperation(span trace.Span, failed bool) {
+	if failed {
+		span.SetStatus(codes.Error, "operation failed")
+	}
+	span.End()
+}
@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+)
+
+func TestSetup(t *testing.T) {
+	previousProvider := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previousProvider) })
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Setenv(ExporterEnv, "")
+
+		shutdown, err := Setup(context.Background(), "test")
+		require.NoError(t, err)
+		require.NoError(t, shutdown(context.Background()))
+
+		_, span := StartOperation(context.Background(), "snowflake_database", "db", "CREATE")
+		assert.False(t, span.IsRecording())
+	})
+
+	t.Run("file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "traces.json")
+		t.Setenv(ExporterEnv, "file")
+		t.Setenv(FileEnv, path)
+
+		shutdown, err := Setup(context.Background(), "test")
+		require.NoError(t, err)
+
+		_, span := StartOperation(context.Background(), "snowflake_database", "db", "CREATE")
+		assert.True(t, span.IsRecording())
+		EndOperation(span, true)
+		require.NoError(t, shutdown(context.Background()))
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `"Name":"snowflake_database CREATE"`)
+		assert.Contains(t, string(content), `"Value":"terraform-provider-snowflake"`)
+	})
+
+	t.Run("invalid exporter", func(t *testing.T) {
+		t.Setenv(ExporterEnv, "zipkin")
+
+		_, err := Setup(context.Background(), "test")
+		require.ErrorContains(t, err, "invalid SNOWFLAKE_TRACES_EXPORTER: zipkin")
+	})
+}